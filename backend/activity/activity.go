@@ -0,0 +1,210 @@
+// Package activity records the changes of repair slips and merges them with
+// the comments into the timeline of a slip.
+package activity
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/comments"
+	"github.com/darksford123x/app/ent"
+	entactivity "github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/repairslip"
+)
+
+// Hook records the status, priority and assignee changes and the
+// escalations of repair slips, with the signed in user of the context as
+// the actor. It also deletes the comments and activities of slips being
+// deleted.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.RepairSlipFunc(func(ctx context.Context, m *ent.RepairSlipMutation) (ent.Value, error) {
+			switch {
+			case m.Op().Is(ent.OpUpdateOne):
+				return record(ctx, m, next)
+			case m.Op().Is(ent.OpDeleteOne):
+				if err := purge(ctx, m); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// record runs an update and adds an activity for each change it makes.
+func record(ctx context.Context, m *ent.RepairSlipMutation, next ent.Mutator) (ent.Value, error) {
+	id, _ := m.ID()
+	client := m.Client()
+	var changes []*ent.ActivityCreate
+	change := func(kind entactivity.Kind) *ent.ActivityCreate {
+		c := client.Activity.
+			Create().
+			SetKind(kind).
+			SetRepairSlipID(id)
+		if u := auth.FromContext(ctx); u != nil {
+			c.SetActorID(u.ID)
+		}
+		changes = append(changes, c)
+		return c
+	}
+
+	if status, ok := m.Status(); ok {
+		old, err := m.OldStatus(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if old != status {
+			change(entactivity.KindStatusChanged).
+				SetFrom(string(old)).
+				SetTo(string(status))
+		}
+	}
+	if priority, ok := m.Priority(); ok {
+		old, err := m.OldPriority(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if old != priority {
+			change(entactivity.KindPriorityChanged).
+				SetFrom(string(old)).
+				SetTo(string(priority))
+		}
+	}
+	if ids := m.AssigneeIDs(); len(ids) > 0 || m.AssigneeCleared() {
+		old, err := client.RepairSlip.
+			Query().
+			Where(repairslip.ID(id)).
+			QueryAssignee().
+			OnlyID(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		switch {
+		case len(ids) > 0 && ids[0] != old:
+			change(entactivity.KindAssigned).SetTechnicianID(ids[0])
+		case len(ids) == 0 && old != 0:
+			change(entactivity.KindUnassigned).SetTechnicianID(old)
+		}
+	}
+	if _, ok := m.EscalatedAt(); ok {
+		change(entactivity.KindEscalated)
+	}
+
+	v, err := next.Mutate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range changes {
+		if _, err := c.Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// purge deletes the comments and activities of a slip being deleted. They
+// are deleted by the IDs queried, which are those of the organization of the
+// context.
+func purge(ctx context.Context, m *ent.RepairSlipMutation) error {
+	id, ok := m.ID()
+	if !ok {
+		return nil
+	}
+	client := m.Client()
+	comments, err := client.Comment.
+		Query().
+		Where(comment.HasRepairSlipWith(repairslip.ID(id))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	revisions, err := client.CommentRevision.
+		Query().
+		Where(commentrevision.HasCommentWith(comment.IDIn(comments...))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	activities, err := client.Activity.
+		Query().
+		Where(entactivity.HasRepairSlipWith(repairslip.ID(id))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = client.CommentRevision.
+		Delete().
+		Where(commentrevision.IDIn(revisions...)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := client.Comment.Delete().Where(comment.IDIn(comments...)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err = client.Activity.
+		Delete().
+		Where(entactivity.IDIn(activities...)).
+		Exec(ctx)
+	return err
+}
+
+// The types of timeline entries.
+const (
+	TypeCreated  = "created"
+	TypeComment  = "comment"
+	TypeActivity = "activity"
+)
+
+// Entry is an item of the timeline of a repair slip: its creation, a
+// comment or an activity.
+type Entry struct {
+	Type     string        `json:"type"`
+	Time     time.Time     `json:"time"`
+	Comment  *ent.Comment  `json:"comment,omitempty"`
+	Activity *ent.Activity `json:"activity,omitempty"`
+}
+
+// Timeline returns the creation, comments and activities of a repair slip
+// in the order they happened. Internal comments are left out for readers
+// who may not read them.
+func Timeline(ctx context.Context, client *ent.Client, slipID int, reader *ent.User) ([]Entry, error) {
+	slip, err := client.RepairSlip.Get(ctx, slipID)
+	if err != nil {
+		return nil, err
+	}
+	cs, err := comments.List(ctx, client, slipID, reader)
+	if err != nil {
+		return nil, err
+	}
+	as, err := client.Activity.
+		Query().
+		Where(entactivity.HasRepairSlipWith(repairslip.ID(slipID))).
+		WithActor().
+		WithTechnician().
+		Order(ent.Asc(entactivity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{{Type: TypeCreated, Time: slip.CreateTime}}
+	for _, c := range cs {
+		entries = append(entries, Entry{Type: TypeComment, Time: c.CreateTime, Comment: c})
+	}
+	for _, a := range as {
+		entries = append(entries, Entry{Type: TypeActivity, Time: a.CreateTime, Activity: a})
+	}
+	// Both lists are in order already; the stable sort keeps the order of
+	// entries made at the same time.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+	return entries, nil
+}
@@ -0,0 +1,293 @@
+// Package assignment routes repair slips to technicians, either explicitly or
+// by balancing the open workload across the technicians of a skill category.
+package assignment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/txn"
+)
+
+var (
+	// ErrNotTechnician is returned when a slip is assigned to a user
+	// that does not have the technician role.
+	ErrNotTechnician = errors.New("assignment: user is not a technician")
+	// ErrTechnicianDisabled is returned when a slip is assigned to a
+	// technician whose account is disabled.
+	ErrTechnicianDisabled = errors.New("assignment: technician is disabled")
+	// ErrNoTechnician is returned by AutoAssign when no technician
+	// handles the category of the slip.
+	ErrNoTechnician = errors.New("assignment: no technician available for category")
+	// ErrNotOpen is returned when a slip that is no longer open is
+	// assigned.
+	ErrNotOpen = errors.New("assignment: slip is not open")
+	// ErrWorkloadChanged is returned when the technician picked was
+	// assigned another slip concurrently on every attempt.
+	ErrWorkloadChanged = errors.New("assignment: the workload changed concurrently")
+)
+
+// assignAttempts bounds how often an automatic assignment is tried again
+// when the workload changed under it.
+const assignAttempts = 3
+
+// OpenStatuses are the repair slip statuses that count towards the
+// workload of a technician.
+var OpenStatuses = []repairslip.Status{
+	repairslip.StatusReceived,
+	repairslip.StatusInProgress,
+	repairslip.StatusWaitingParts,
+}
+
+// Load is the number of open slips assigned to a technician.
+type Load struct {
+	Technician int `sql:"user_assigned_slips" json:"technician"`
+	Count      int `sql:"count" json:"count"`
+	// version is the assignment version of the technician the count was
+	// read at.
+	version int
+}
+
+// Balancer assigns repair slips to technicians. Every assignment changes
+// the assignment version of the technician, and automatic assignments only
+// apply while the version they read the workload at is unchanged, so that
+// slips arriving at the same moment, on this process or another, never
+// pick a technician from the same workload snapshot.
+type Balancer struct {
+	client *ent.Client
+}
+
+// NewBalancer creates a balancer that works on the given client.
+func NewBalancer(client *ent.Client) *Balancer {
+	return &Balancer{client: client}
+}
+
+// Create saves the slip that build makes on the client of a transaction
+// and, when auto is set, assigns it as AutoAssign does in the same
+// transaction. Warranty claims, which are repaired by the warranty
+// provider, and slips of a category no technician handles are saved
+// unassigned.
+func (b *Balancer) Create(ctx context.Context, build func(*ent.Client) *ent.RepairSlipCreate, auto bool) (*ent.RepairSlip, error) {
+	return b.balance(ctx, func(ctx context.Context, tx *ent.Tx) (*ent.RepairSlip, error) {
+		s, err := build(tx.Client()).Save(ctx)
+		if err != nil || !auto || s.Route == repairslip.RouteWarrantyClaim {
+			return s, err
+		}
+		slip, err := autoAssign(ctx, tx, s, 0)
+		if errors.Is(err, ErrNoTechnician) {
+			return s, nil
+		}
+		return slip, err
+	})
+}
+
+// Assign sets the assignee of the slip to the given technician. The slip
+// must be open and the technician enabled.
+func (b *Balancer) Assign(ctx context.Context, slipID, technicianID int) (*ent.RepairSlip, error) {
+	var slip *ent.RepairSlip
+	err := txn.WithTx(ctx, b.client, func(ctx context.Context, tx *ent.Tx) error {
+		s, err := tx.RepairSlip.Get(ctx, slipID)
+		if err != nil {
+			return err
+		}
+		if !isOpen(s.Status) {
+			return ErrNotOpen
+		}
+		tech, err := tx.User.Get(ctx, technicianID)
+		if err != nil {
+			return err
+		}
+		if tech.Role != user.RoleTechnician {
+			return ErrNotTechnician
+		}
+		if tech.Disabled {
+			return ErrTechnicianDisabled
+		}
+		// Automatic assignments that read the workload of the technician
+		// before this one fail their claim and balance again.
+		_, err = tx.User.
+			Update().
+			Where(user.ID(tech.ID)).
+			AddAssignmentVersion(1).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		slip, err = tx.RepairSlip.
+			UpdateOneID(slipID).
+			SetAssignee(tech).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slip, nil
+}
+
+// Unassign clears the assignee of the slip.
+func (b *Balancer) Unassign(ctx context.Context, slipID int) (*ent.RepairSlip, error) {
+	return b.client.RepairSlip.
+		UpdateOneID(slipID).
+		ClearAssignee().
+		Save(ctx)
+}
+
+// AutoAssign assigns the open slip to the technician of its category with
+// the fewest open slips. Ties are broken in favour of the lowest user ID, so
+// the result is deterministic.
+func (b *Balancer) AutoAssign(ctx context.Context, slipID int) (*ent.RepairSlip, error) {
+	return b.balance(ctx, func(ctx context.Context, tx *ent.Tx) (*ent.RepairSlip, error) {
+		s, err := tx.RepairSlip.Get(ctx, slipID)
+		if err != nil {
+			return nil, err
+		}
+		return autoAssign(ctx, tx, s, 0)
+	})
+}
+
+// Reassign moves the open slip to the least loaded technician of its
+// category other than its current assignee. It returns ErrNoTechnician when
+// there is nobody else to take it.
+func (b *Balancer) Reassign(ctx context.Context, slipID int) (*ent.RepairSlip, error) {
+	return b.balance(ctx, func(ctx context.Context, tx *ent.Tx) (*ent.RepairSlip, error) {
+		s, err := tx.RepairSlip.Get(ctx, slipID)
+		if err != nil {
+			return nil, err
+		}
+		current, err := s.QueryAssignee().OnlyID(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		return autoAssign(ctx, tx, s, current)
+	})
+}
+
+// balance runs fn in a transaction, and again when the workload it
+// balanced changed before it was committed.
+func (b *Balancer) balance(ctx context.Context, fn func(context.Context, *ent.Tx) (*ent.RepairSlip, error)) (slip *ent.RepairSlip, err error) {
+	for i := 0; i < assignAttempts; i++ {
+		err = txn.WithTx(ctx, b.client, func(ctx context.Context, tx *ent.Tx) error {
+			s, err := fn(ctx, tx)
+			slip = s
+			return err
+		})
+		if !errors.Is(err, ErrWorkloadChanged) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return slip, nil
+}
+
+// autoAssign assigns the slip to the least loaded technician of its
+// category other than the excluded one, claiming the assignment version
+// the workload of the technician was read at.
+func autoAssign(ctx context.Context, tx *ent.Tx, s *ent.RepairSlip, exclude int) (*ent.RepairSlip, error) {
+	if !isOpen(s.Status) {
+		return nil, ErrNotOpen
+	}
+	load, err := leastLoaded(ctx, tx.Client(), s.Category, exclude)
+	if err != nil {
+		return nil, err
+	}
+	n, err := tx.User.
+		Update().
+		Where(user.ID(load.Technician), user.AssignmentVersion(load.version)).
+		AddAssignmentVersion(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n != 1 {
+		return nil, ErrWorkloadChanged
+	}
+	return s.Update().
+		SetAssigneeID(load.Technician).
+		Save(ctx)
+}
+
+func isOpen(status repairslip.Status) bool {
+	for _, st := range OpenStatuses {
+		if st == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Workload returns the open slip count of every enabled technician that
+// handles the given category, including technicians without any open slip.
+func (b *Balancer) Workload(ctx context.Context, category string) ([]Load, error) {
+	return workload(ctx, b.client, category)
+}
+
+func workload(ctx context.Context, client *ent.Client, category string) ([]Load, error) {
+	users, err := client.User.
+		Query().
+		Where(
+			user.RoleEQ(user.RoleTechnician),
+			user.SkillEQ(category),
+			user.DisabledEQ(false),
+		).
+		Order(ent.Asc(user.FieldID)).
+		All(ctx)
+	if err != nil || len(users) == 0 {
+		return nil, err
+	}
+	techs := make([]int, len(users))
+	for i, u := range users {
+		techs[i] = u.ID
+	}
+
+	var counts []Load
+	err = client.RepairSlip.
+		Query().
+		Where(
+			repairslip.StatusIn(OpenStatuses...),
+			repairslip.HasAssigneeWith(user.IDIn(techs...)),
+		).
+		GroupBy(repairslip.AssigneeColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	byTech := make(map[int]int, len(counts))
+	for _, c := range counts {
+		byTech[c.Technician] = c.Count
+	}
+	loads := make([]Load, len(users))
+	for i, u := range users {
+		loads[i] = Load{Technician: u.ID, Count: byTech[u.ID], version: u.AssignmentVersion}
+	}
+	return loads, nil
+}
+
+// leastLoaded returns the load of the technician of the category with the
+// fewest open slips, leaving out the excluded one. Zero excludes nobody.
+func leastLoaded(ctx context.Context, client *ent.Client, category string, exclude int) (Load, error) {
+	loads, err := workload(ctx, client, category)
+	if err != nil {
+		return Load{}, err
+	}
+	best := -1
+	for i, l := range loads {
+		if l.Technician == exclude {
+			continue
+		}
+		if best < 0 || l.Count < loads[best].Count {
+			best = i
+		}
+	}
+	if best < 0 {
+		return Load{}, ErrNoTechnician
+	}
+	return loads[best], nil
+}
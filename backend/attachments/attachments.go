@@ -0,0 +1,249 @@
+// Package attachments stores the photos and documents uploaded to repair
+// slips. The content goes to a storage backend; the database keeps an
+// Attachment per file with the key of its content and, for images, of a
+// thumbnail.
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/storage"
+)
+
+var (
+	// ErrTooLarge is returned for files above the size limit.
+	ErrTooLarge = errors.New("attachments: file too large")
+	// ErrType is returned for files of a type that is not allowed.
+	ErrType = errors.New("attachments: file type not allowed")
+	// ErrNoThumbnail is returned for attachments without a thumbnail.
+	ErrNoThumbnail = errors.New("attachments: attachment has no thumbnail")
+)
+
+// Store saves attachments and their content.
+type Store struct {
+	client  *ent.Client
+	backend storage.Backend
+	maxSize int64
+	types   map[string]bool
+}
+
+// NewStore creates a store keeping content in backend. Files may have at
+// most maxSize bytes and one of the given MIME types.
+func NewStore(client *ent.Client, backend storage.Backend, maxSize int64, types []string) *Store {
+	s := &Store{
+		client:  client,
+		backend: backend,
+		maxSize: maxSize,
+		types:   make(map[string]bool),
+	}
+	for _, t := range types {
+		s.types[t] = true
+	}
+	return s
+}
+
+// MaxSize returns the size limit of files.
+func (s *Store) MaxSize() int64 {
+	return s.maxSize
+}
+
+// Upload is a file to attach.
+type Upload struct {
+	Filename string
+	Content  io.Reader
+	// Uploader is the signed in user, if any.
+	Uploader *ent.User
+}
+
+// Add attaches a file to a repair slip. The type is detected from the
+// content; a thumbnail is made for images.
+func (s *Store) Add(ctx context.Context, slipID int, up Upload) (*ent.Attachment, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(up.Content, s.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > s.maxSize {
+		return nil, ErrTooLarge
+	}
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	if !s.types[contentType] {
+		return nil, fmt.Errorf("%w: %s", ErrType, contentType)
+	}
+	if _, err := s.client.RepairSlip.Get(ctx, slipID); err != nil {
+		return nil, err
+	}
+
+	key, err := newKey(slipID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.backend.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		return nil, err
+	}
+	thumb, err := thumbnail(data)
+	if err != nil {
+		// The file is kept; it merely shows without a preview.
+		log.Printf("attachments: no thumbnail for %s: %v", key, err)
+	}
+	if thumb != nil {
+		err = s.backend.Put(ctx, thumbnailKey(key), bytes.NewReader(thumb), int64(len(thumb)), "image/jpeg")
+		if err != nil {
+			s.remove(ctx, key, false)
+			return nil, err
+		}
+	}
+
+	builder := s.client.Attachment.
+		Create().
+		SetFilename(filename(up.Filename)).
+		SetContentType(contentType).
+		SetSize(int64(len(data))).
+		SetKey(key).
+		SetThumbnail(thumb != nil).
+		SetRepairSlipID(slipID)
+	if up.Uploader != nil {
+		builder.SetUploaderID(up.Uploader.ID)
+	}
+	a, err := builder.Save(ctx)
+	if err != nil {
+		s.remove(ctx, key, thumb != nil)
+		return nil, err
+	}
+	return a, nil
+}
+
+// Open returns the content of an attachment.
+func (s *Store) Open(ctx context.Context, a *ent.Attachment) (io.ReadCloser, error) {
+	return s.backend.Get(ctx, a.Key)
+}
+
+// OpenThumbnail returns the JPEG thumbnail of an image attachment.
+func (s *Store) OpenThumbnail(ctx context.Context, a *ent.Attachment) (io.ReadCloser, error) {
+	if !a.Thumbnail {
+		return nil, ErrNoThumbnail
+	}
+	return s.backend.Get(ctx, thumbnailKey(a.Key))
+}
+
+// Delete deletes an attachment and its content.
+func (s *Store) Delete(ctx context.Context, id int) error {
+	a, err := s.client.Attachment.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Attachment.DeleteOne(a).Exec(ctx); err != nil {
+		return err
+	}
+	s.remove(ctx, a.Key, a.Thumbnail)
+	return nil
+}
+
+// Hook deletes the attachments of repair slips being deleted. Their content
+// is removed once the deletion is committed.
+func (s *Store) Hook() ent.Hook {
+	hk := func(next ent.Mutator) ent.Mutator {
+		return hook.RepairSlipFunc(func(ctx context.Context, m *ent.RepairSlipMutation) (ent.Value, error) {
+			id, ok := m.ID()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			client := m.Client()
+			as, err := client.Attachment.
+				Query().
+				Where(attachment.HasRepairSlipWith(repairslip.ID(id))).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// The attachments are deleted by the IDs queried, which are
+			// those of the organization of the context.
+			ids := make([]int, len(as))
+			for i, a := range as {
+				ids[i] = a.ID
+			}
+			_, err = client.Attachment.
+				Delete().
+				Where(attachment.IDIn(ids...)).
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			cleanup := func() {
+				for _, a := range as {
+					s.remove(context.Background(), a.Key, a.Thumbnail)
+				}
+			}
+			if tx := ent.TxFromContext(ctx); tx != nil {
+				tx.OnCommit(func(next ent.Committer) ent.Committer {
+					return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+						if err := next.Commit(ctx, tx); err != nil {
+							return err
+						}
+						cleanup()
+						return nil
+					})
+				})
+			} else {
+				cleanup()
+			}
+			return v, nil
+		})
+	}
+	return hook.On(hk, ent.OpDeleteOne)
+}
+
+// remove deletes the stored content of an attachment. Failures are only
+// logged, since the attachment is gone either way.
+func (s *Store) remove(ctx context.Context, key string, thumb bool) {
+	keys := []string{key}
+	if thumb {
+		keys = append(keys, thumbnailKey(key))
+	}
+	for _, k := range keys {
+		if err := s.backend.Delete(ctx, k); err != nil {
+			log.Printf("attachments: removing %s: %v", k, err)
+		}
+	}
+}
+
+// newKey returns a fresh storage key below the directory of a repair slip.
+func newKey(slipID int) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("repairslips/%d/%s", slipID, hex.EncodeToString(b)), nil
+}
+
+func thumbnailKey(key string) string {
+	return key + ".thumb.jpg"
+}
+
+// filename strips the directories some browsers send with the name.
+func filename(name string) string {
+	name = filepath.Base(strings.Replace(name, `\`, "/", -1))
+	if name == "." || name == "/" {
+		return "upload"
+	}
+	return name
+}
@@ -0,0 +1,187 @@
+// Package auth signs users in with their email and password and identifies
+// the caller of a request from the bearer token it carries.
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/tenant"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	// ErrBadCredentials is returned by Login for an unknown email or a
+	// wrong password.
+	ErrBadCredentials = errors.New("auth: wrong email or password")
+	// ErrUnauthenticated is returned when a request carries no valid
+	// token.
+	ErrUnauthenticated = errors.New("auth: authentication required")
+	// ErrForbidden is returned when the caller lacks the role required.
+	ErrForbidden = errors.New("auth: permission denied")
+	// ErrDisabled is returned when the user has been disabled.
+	ErrDisabled = errors.New("auth: account disabled")
+)
+
+// MinPasswordLength is the length passwords must have at least.
+const MinPasswordLength = 8
+
+// HashPassword returns the hash of a password to store on a user.
+func HashPassword(password string) (string, error) {
+	if len(password) < MinPasswordLength {
+		return "", errors.New("auth: password must have at least 8 characters")
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Login returns the user with the given email when the password matches
+// and the user is not disabled. Emails are unique across the organizations,
+// so the user is looked for in all of them; the organization of the user is
+// loaded in its edges.
+func Login(ctx context.Context, client *ent.Client, email, password string) (*ent.User, error) {
+	u, err := client.User.
+		Query().
+		Where(user.EmailEQ(email)).
+		WithOrganization().
+		Only(tenant.System(ctx))
+	if ent.IsNotFound(err) {
+		return nil, ErrBadCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.PasswordHash == "" || u.Edges.Organization == nil || bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) != nil {
+		return nil, ErrBadCredentials
+	}
+	if u.Disabled {
+		return nil, ErrDisabled
+	}
+	return u, nil
+}
+
+type ctxKey struct{}
+
+// NewContext returns a context carrying the signed in user.
+func NewContext(ctx context.Context, u *ent.User) context.Context {
+	return context.WithValue(ctx, ctxKey{}, u)
+}
+
+// FromContext returns the signed in user of the context, or nil.
+func FromContext(ctx context.Context) *ent.User {
+	u, _ := ctx.Value(ctxKey{}).(*ent.User)
+	return u
+}
+
+// Middleware identifies the caller from the bearer token of the
+// Authorization header and acts for the organization of the token. Requests
+// without a token pass through anonymously, for no organization; requests
+// with an invalid one, or one of a disabled user, are refused.
+func Middleware(client *ent.Client, tokens *Tokens) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		h := c.GetHeader("Authorization")
+		if !strings.HasPrefix(h, "Bearer ") {
+			c.Next()
+			return
+		}
+		id, org, err := tokens.Verify(strings.TrimPrefix(h, "Bearer "))
+		if err != nil {
+			c.AbortWithStatusJSON(401, gin.H{"error": err.Error()})
+			return
+		}
+		signIn(c, client, id, org)
+	}
+}
+
+// TicketMiddleware identifies the callers of the event streams that have no
+// bearer token, such as EventSource clients, which cannot set headers, from
+// the stream ticket of the ticket query parameter. It comes after
+// Middleware, on the stream routes only.
+func TicketMiddleware(client *ent.Client, tokens *Tokens) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ticket := c.Query("ticket")
+		if ticket == "" || FromContext(c.Request.Context()) != nil {
+			c.Next()
+			return
+		}
+		id, org, err := tokens.RedeemTicket(ticket)
+		if err != nil {
+			c.AbortWithStatusJSON(401, gin.H{"error": err.Error()})
+			return
+		}
+		signIn(c, client, id, org)
+	}
+}
+
+// signIn continues the request as the user of the organization, unless the
+// user is gone or disabled.
+func signIn(c *gin.Context, client *ent.Client, id, org int) {
+	ctx := tenant.NewContext(c.Request.Context(), org)
+	u, err := client.User.Get(ctx, id)
+	if err != nil {
+		c.AbortWithStatusJSON(401, gin.H{"error": ErrUnauthenticated.Error()})
+		return
+	}
+	if u.Disabled {
+		c.AbortWithStatusJSON(401, gin.H{"error": ErrDisabled.Error()})
+		return
+	}
+	c.Request = c.Request.WithContext(NewContext(ctx, u))
+	c.Next()
+}
+
+// Require refuses requests of anonymous callers and, when roles are given,
+// of callers with none of them.
+func Require(roles ...user.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		u := FromContext(c.Request.Context())
+		if u == nil {
+			c.AbortWithStatusJSON(401, gin.H{"error": ErrUnauthenticated.Error()})
+			return
+		}
+		if len(roles) > 0 && !HasRole(u, roles...) {
+			c.AbortWithStatusJSON(403, gin.H{"error": ErrForbidden.Error()})
+			return
+		}
+		c.Next()
+	}
+}
+
+// HasRole reports whether the user has one of the roles.
+func HasRole(u *ent.User, roles ...user.Role) bool {
+	for _, r := range roles {
+		if u.Role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// MayManageUsers reports whether the user may create and delete users and
+// set their roles, whichever API they use: only admins may, so that nobody
+// grants themselves a role. The first admin is created with the user create
+// command.
+func MayManageUsers(u *ent.User) bool {
+	return u != nil && HasRole(u, user.RoleAdmin)
+}
+
+// CheckUserUpdate returns ErrForbidden unless the caller may update the user
+// of the given ID and, when role is not nil, set it to role: users may
+// update themselves but keep their role, and admins may update anyone. All
+// the APIs check updates of users with it.
+func CheckUserUpdate(caller *ent.User, id int, role *user.Role) error {
+	if MayManageUsers(caller) {
+		return nil
+	}
+	if caller == nil || caller.ID != id || role != nil && *role != caller.Role {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidToken is returned for tokens that are malformed, were not
+// signed by the server or expired.
+var ErrInvalidToken = errors.New("auth: invalid or expired token")
+
+// claims are the signed content of a token.
+type claims struct {
+	Subject int `json:"sub"`
+	// Organization is the tenant the user acts for.
+	Organization int   `json:"org"`
+	Expires      int64 `json:"exp"`
+	// Ticket is the random ID of a stream ticket; bearer tokens have
+	// none.
+	Ticket string `json:"tkt,omitempty"`
+}
+
+// TicketTTL is how long stream tickets are valid.
+const TicketTTL = 30 * time.Second
+
+// Tokens issues and verifies the bearer tokens of signed in users. A token
+// is the base64 encoded claims and their HMAC-SHA256 signature, joined by a
+// dot.
+//
+// Stream tickets are tokens of their own for the event streams, which
+// browsers open with EventSource and WebSocket and so cannot send a header:
+// the ticket goes in the URL, where proxies and histories keep it, so it is
+// valid for TicketTTL and redeemed once.
+type Tokens struct {
+	secret []byte
+	ttl    time.Duration
+
+	mu sync.Mutex
+	// redeemed are the IDs of the tickets redeemed by this server, until
+	// they expire.
+	redeemed map[string]int64
+}
+
+// NewTokens creates tokens signed with secret and valid for ttl.
+func NewTokens(secret []byte, ttl time.Duration) *Tokens {
+	return &Tokens{secret: secret, ttl: ttl, redeemed: map[string]int64{}}
+}
+
+// Issue returns a token for the user of the organization and when it
+// expires.
+func (t *Tokens) Issue(userID, org int) (string, time.Time, error) {
+	expires := time.Now().Add(t.ttl)
+	token, err := t.encode(claims{Subject: userID, Organization: org, Expires: expires.Unix()})
+	return token, expires, err
+}
+
+// Verify returns the user and the organization a valid token was issued
+// for. Tokens issued before users belonged to organizations have none and
+// are refused, and so are stream tickets.
+func (t *Tokens) Verify(token string) (userID, org int, err error) {
+	c, err := t.decode(token)
+	if err != nil || c.Ticket != "" {
+		return 0, 0, ErrInvalidToken
+	}
+	return c.Subject, c.Organization, nil
+}
+
+// IssueTicket returns a stream ticket for the user of the organization and
+// when it expires.
+func (t *Tokens) IssueTicket(userID, org int) (string, time.Time, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", time.Time{}, err
+	}
+	expires := time.Now().Add(TicketTTL)
+	ticket, err := t.encode(claims{
+		Subject:      userID,
+		Organization: org,
+		Expires:      expires.Unix(),
+		Ticket:       base64.RawURLEncoding.EncodeToString(id),
+	})
+	return ticket, expires, err
+}
+
+// RedeemTicket returns the user and the organization a valid stream ticket
+// was issued for, unless it was redeemed before. Tickets are only
+// remembered by the server that redeemed them; their short life bounds how
+// long the others accept them again.
+func (t *Tokens) RedeemTicket(ticket string) (userID, org int, err error) {
+	c, err := t.decode(ticket)
+	if err != nil || c.Ticket == "" {
+		return 0, 0, ErrInvalidToken
+	}
+	now := time.Now().Unix()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id, expires := range t.redeemed {
+		if now >= expires {
+			delete(t.redeemed, id)
+		}
+	}
+	if _, ok := t.redeemed[c.Ticket]; ok {
+		return 0, 0, ErrInvalidToken
+	}
+	t.redeemed[c.Ticket] = c.Expires
+	return c.Subject, c.Organization, nil
+}
+
+// encode returns the signed token of the claims.
+func (t *Tokens) encode(c claims) (string, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	p := base64.RawURLEncoding.EncodeToString(payload)
+	return p + "." + t.sign(p), nil
+}
+
+// decode returns the claims of a token signed by the server that has not
+// expired and has an organization.
+func (t *Tokens) decode(token string) (claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 || !hmac.Equal([]byte(parts[1]), []byte(t.sign(parts[0]))) {
+		return claims{}, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return claims{}, ErrInvalidToken
+	}
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return claims{}, ErrInvalidToken
+	}
+	if time.Now().Unix() >= c.Expires || c.Organization == 0 {
+		return claims{}, ErrInvalidToken
+	}
+	return c, nil
+}
+
+func (t *Tokens) sign(payload string) string {
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
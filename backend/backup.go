@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/darksford123x/app/backup"
+	"github.com/darksford123x/app/labels"
+	"github.com/darksford123x/app/search"
+	"github.com/darksford123x/app/tenant"
+)
+
+// runBackup writes the data of the database to a portable archive, which
+// restore restores into a database of any supported kind. The attachment
+// files are not in the archive; back up their storage as well.
+func runBackup(ctx context.Context, args []string) error {
+	fs := flags("backup", "[-o file]")
+	out := fs.String("o", "-", "file to write the archive to, - for the standard output")
+	fs.Parse(args)
+
+	_, drv, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("failed creating the archive: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	m, err := backup.Write(ctx, drv.Dialect(), drv.DB(), w)
+	if err != nil {
+		if *out != "-" {
+			os.Remove(*out)
+		}
+		return fmt.Errorf("failed writing the archive: %w", err)
+	}
+	if f, ok := w.(*os.File); ok && f != os.Stdout {
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed writing the archive: %w", err)
+		}
+	}
+	for _, t := range m.Tables {
+		fmt.Fprintf(os.Stderr, "%-20s %8d rows  %s\n", t.Name, t.Rows, t.Checksum)
+	}
+	return nil
+}
+
+// runRestore restores an archive written by backup, e.g. to move from
+// SQLite to PostgreSQL. The schema is migrated first, and the database must
+// be empty unless -merge is given. Nothing is restored unless every table
+// restored matches the archive.
+func runRestore(ctx context.Context, args []string) error {
+	fs := flags("restore", "[-merge] archive")
+	merge := fs.Bool("merge", false, "restore into a database that already has data, with new IDs")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: %s restore [-merge] archive\n\nThe archive is read from the standard input when it is -.\n\n", program())
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cfg, drv, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := persistent(cfg); err != nil {
+		return err
+	}
+	var r io.Reader = os.Stdin
+	if name := fs.Arg(0); name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return fmt.Errorf("failed opening the archive: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	if err := migrateSchema(ctx, drv, client); err != nil {
+		return err
+	}
+	m, err := backup.Restore(ctx, drv.Dialect(), drv.DB(), r, backup.Options{Merge: *merge})
+	if errors.Is(err, backup.ErrConflict) {
+		return fmt.Errorf("failed merging the archive, nothing was restored: %w; an archive of this database cannot be merged into it", err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed restoring the archive: %w", err)
+	}
+	// The rows of an archive written before the deployment had
+	// organizations are given the default one.
+	if _, err := tenant.Adopt(ctx, drv, client); err != nil {
+		return err
+	}
+	// The equipment of an archive written before there were asset tags is
+	// given tags.
+	if _, err := labels.Backfill(ctx, client); err != nil {
+		return fmt.Errorf("failed tagging equipment: %w", err)
+	}
+	// The search index is derived from the data and not archived.
+	index, err := search.Open(ctx, client, drv.Dialect(), drv.DB())
+	if err != nil {
+		return fmt.Errorf("failed opening the search index: %w", err)
+	}
+	if err := index.Reindex(ctx); err != nil {
+		return fmt.Errorf("failed indexing the data: %w", err)
+	}
+	for _, t := range m.Tables {
+		fmt.Fprintf(os.Stderr, "%-20s %8d rows  %s  verified\n", t.Name, t.Rows, t.Checksum)
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+// Package backup writes the data of the database to a portable archive and
+// restores it, into the same or another kind of database.
+//
+// The tables, columns and foreign keys are those of the ent schema, so an
+// archive holds every entity and every edge, including the join tables of
+// many-to-many edges. The search index, which is derived from them, is not
+// archived and must be rebuilt after a restore; nor are the attachment
+// files, which are kept in storage.
+//
+// An archive is gzip compressed JSON, one value per line: a header with the
+// format version and the columns of the tables, then the rows of each table
+// as arrays in the order of their primary key, then a manifest with the
+// number of rows and a checksum of every table. The values are the same
+// whatever the database: times are in UTC, JSON is embedded as is and bytes
+// are base64 encoded.
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+
+	"github.com/darksford123x/app/ent/migrate"
+	"github.com/facebookincubator/ent/dialect"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/schema"
+)
+
+// Version is the version of the archive format written by Write. Restore
+// reads archives of this version and older.
+const Version = 1
+
+// format identifies backup archives.
+const format = "repairs-backup"
+
+var (
+	// ErrFormat is returned when restoring something else than an archive.
+	ErrFormat = errors.New("backup: not a backup archive")
+	// ErrNotEmpty is returned when restoring into a database with data
+	// without merging.
+	ErrNotEmpty = errors.New("backup: the database is not empty")
+	// ErrChecksum is returned when the rows read from an archive or
+	// restored into the database differ from those backed up.
+	ErrChecksum = errors.New("backup: checksum mismatch")
+	// ErrConflict is returned when merging a row whose unique values are
+	// those of a row already in the database, as those of an archive of
+	// the same database are.
+	ErrConflict = errors.New("backup: unique values already in the database")
+)
+
+// header is the first line of an archive.
+type header struct {
+	Format    string        `json:"format"`
+	Version   int           `json:"version"`
+	CreatedAt time.Time     `json:"created_at"`
+	Dialect   string        `json:"dialect"`
+	Tables    []tableSchema `json:"tables"`
+}
+
+// tableSchema is a table of the archive.
+type tableSchema struct {
+	Name    string         `json:"name"`
+	Columns []columnSchema `json:"columns"`
+}
+
+// columnSchema is a column of a table of the archive.
+type columnSchema struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable,omitempty"`
+}
+
+// marker is a line of an archive other than the header and the rows: the
+// start of the rows of a table, or the manifest that ends the archive.
+type marker struct {
+	Table    string    `json:"table,omitempty"`
+	Manifest *Manifest `json:"manifest,omitempty"`
+}
+
+// Manifest lists the tables of an archive.
+type Manifest struct {
+	Tables []Table `json:"tables"`
+}
+
+// Table is a table of an archive.
+type Table struct {
+	Name string `json:"name"`
+	Rows int    `json:"rows"`
+	// Checksum is the SHA-256 of the lines of the rows.
+	Checksum string `json:"checksum"`
+}
+
+// Write writes the data of the database to w, as the ent driver of the
+// given dialect sees it. The tables are read in a single read-only
+// transaction, so the archive is consistent while the database is in use.
+func Write(ctx context.Context, name string, db *sql.DB, w io.Writer) (*Manifest, error) {
+	opts := &sql.TxOptions{ReadOnly: true}
+	if name == dialect.Postgres {
+		opts.Isolation = sql.LevelRepeatableRead
+	}
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	zw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(zw)
+	line := func(v interface{}) error {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		bw.Write(b)
+		return bw.WriteByte('\n')
+	}
+
+	tables := ordered()
+	h := header{
+		Format:    format,
+		Version:   Version,
+		CreatedAt: time.Now().UTC(),
+		Dialect:   name,
+	}
+	for _, t := range tables {
+		ts := tableSchema{Name: t.Name}
+		for _, c := range t.Columns {
+			ts.Columns = append(ts.Columns, columnSchema{Name: c.Name, Type: c.Type.String(), Nullable: c.Nullable})
+		}
+		h.Tables = append(h.Tables, ts)
+	}
+	if err := line(h); err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	for _, t := range tables {
+		if err := line(marker{Table: t.Name}); err != nil {
+			return nil, err
+		}
+		sum := newChecksum()
+		err := scan(ctx, tx, name, t.Name, t.Columns, func(row []interface{}) error {
+			b, err := json.Marshal(row)
+			if err != nil {
+				return err
+			}
+			sum.add(b)
+			bw.Write(b)
+			return bw.WriteByte('\n')
+		})
+		if err != nil {
+			return nil, fmt.Errorf("backup: table %s: %w", t.Name, err)
+		}
+		m.Tables = append(m.Tables, sum.table(t.Name))
+	}
+	if err := line(marker{Manifest: m}); err != nil {
+		return nil, err
+	}
+	if err := bw.Flush(); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ordered returns the tables of the schema so that every table comes after
+// the tables its foreign keys reference, apart from itself.
+func ordered() []*schema.Table {
+	var tables []*schema.Table
+	done := map[string]bool{}
+	for len(tables) < len(migrate.Tables) {
+		progress := false
+		for _, t := range migrate.Tables {
+			if done[t.Name] {
+				continue
+			}
+			ready := true
+			for _, fk := range t.ForeignKeys {
+				if fk.RefTable != t && !done[fk.RefTable.Name] {
+					ready = false
+				}
+			}
+			if ready {
+				tables = append(tables, t)
+				done[t.Name] = true
+				progress = true
+			}
+		}
+		if !progress {
+			panic("backup: the foreign keys of the schema have a cycle")
+		}
+	}
+	return tables
+}
+
+// scan calls fn with the rows of a table in the archive form, in the order
+// of the primary key.
+func scan(ctx context.Context, tx *sql.Tx, name, table string, columns []*schema.Column, fn func(row []interface{}) error) error {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	pk := primaryKey(table)
+	query, args := entsql.Dialect(name).
+		Select(names...).
+		From(entsql.Dialect(name).Table(table)).
+		OrderBy(pk...).
+		Query()
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	values := make([]interface{}, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		row := make([]interface{}, len(columns))
+		for i, c := range columns {
+			if row[i], err = encode(c, values[i]); err != nil {
+				return err
+			}
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// primaryKey returns the primary key columns of a table of the schema.
+func primaryKey(table string) []string {
+	for _, t := range migrate.Tables {
+		if t.Name == table {
+			pk := make([]string, len(t.PrimaryKey))
+			for i, c := range t.PrimaryKey {
+				pk[i] = c.Name
+			}
+			return pk
+		}
+	}
+	return nil
+}
+
+// checksum sums the rows of a table.
+type checksum struct {
+	hash hash.Hash
+	rows int
+}
+
+func newChecksum() *checksum {
+	return &checksum{hash: sha256.New()}
+}
+
+// add adds the line of a row, without its newline.
+func (c *checksum) add(line []byte) {
+	c.hash.Write(line)
+	c.hash.Write([]byte{'\n'})
+	c.rows++
+}
+
+// table returns the entry of the manifest of the rows added.
+func (c *checksum) table(name string) Table {
+	return Table{
+		Name:     name,
+		Rows:     c.rows,
+		Checksum: "sha256:" + hex.EncodeToString(c.hash.Sum(nil)),
+	}
+}
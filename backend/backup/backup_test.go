@@ -0,0 +1,123 @@
+package backup_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/darksford123x/app/backup"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/enttest"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+	"github.com/darksford123x/app/tenant"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
+)
+
+// databases numbers the empty databases restored into.
+var databases int64
+
+// empty opens an empty database with the schema migrated.
+func empty(t *testing.T) (*entsql.Driver, *ent.Client) {
+	t.Helper()
+	name := fmt.Sprintf("restore%d", atomic.AddInt64(&databases, 1))
+	drv, err := entsql.Open("sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	t.Cleanup(func() { client.Close() })
+	return drv, client
+}
+
+// archive fills a backend with fixtures and returns its archive.
+func archive(t *testing.T) (*servertest.Harness, *backup.Manifest, []byte) {
+	t.Helper()
+	h := servertest.New(t)
+	ctx := h.Context()
+	reporter := h.User().SaveX(ctx)
+	tech := h.User().SetRole(user.RoleTechnician).SetSkill("projector").SaveX(ctx)
+	eq := h.Equipment().SaveX(ctx)
+	h.RepairSlip(reporter).SetEquipment(eq).SetAssignee(tech).SaveX(ctx)
+	h.RepairSlip(reporter).SetStatus(repairslip.StatusClosed).SaveX(ctx)
+	h.Part().SaveX(ctx)
+
+	var buf bytes.Buffer
+	m, err := backup.Write(context.Background(), h.Driver.Dialect(), h.Driver.DB(), &buf)
+	if err != nil {
+		t.Fatalf("writing the archive: %v", err)
+	}
+	return h, m, buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	_, want, data := archive(t)
+	drv, _ := empty(t)
+	ctx := context.Background()
+
+	got, err := backup.Restore(ctx, drv.Dialect(), drv.DB(), bytes.NewReader(data), backup.Options{})
+	if err != nil {
+		t.Fatalf("restoring: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("restored manifest %+v, want %+v", got, want)
+	}
+	// The database restored archives as the one backed up, every table
+	// with the same rows and checksum.
+	var buf bytes.Buffer
+	again, err := backup.Write(ctx, drv.Dialect(), drv.DB(), &buf)
+	if err != nil {
+		t.Fatalf("writing the restored database: %v", err)
+	}
+	for i, w := range want.Tables {
+		if i >= len(again.Tables) || again.Tables[i] != w {
+			t.Errorf("table %d of the restored database: %+v, want %+v", i, again.Tables[i:], w)
+			break
+		}
+	}
+	if len(again.Tables) != len(want.Tables) {
+		t.Errorf("the restored database has %d tables, want %d", len(again.Tables), len(want.Tables))
+	}
+
+	if _, err := backup.Restore(ctx, drv.Dialect(), drv.DB(), bytes.NewReader(data), backup.Options{}); !errors.Is(err, backup.ErrNotEmpty) {
+		t.Errorf("restoring into a database with data: %v, want %v", err, backup.ErrNotEmpty)
+	}
+	data[len(data)/2] ^= 0xff
+	if _, err := backup.Restore(ctx, drv.Dialect(), drv.DB(), bytes.NewReader(data), backup.Options{Merge: true}); err == nil {
+		t.Error("restored a damaged archive")
+	}
+}
+
+func TestRestoreMerge(t *testing.T) {
+	h, _, data := archive(t)
+	drv, client := empty(t)
+	ctx := context.Background()
+	org := client.Organization.Create().SetName("Other").SaveX(ctx)
+	octx := tenant.NewContext(ctx, org.ID)
+	client.User.Create().SetName("Someone").SetAge(40).SetEmail("someone@example.com").SaveX(octx)
+
+	if _, err := backup.Restore(ctx, drv.Dialect(), drv.DB(), bytes.NewReader(data), backup.Options{Merge: true}); err != nil {
+		t.Fatalf("merging: %v", err)
+	}
+	users := client.User.Query().CountX(tenant.System(ctx))
+	if want := h.Client.User.Query().CountX(tenant.System(ctx)) + 1; users != want {
+		t.Errorf("%d users after merging, want %d", users, want)
+	}
+
+	// Merging an archive of the same database clashes on its unique
+	// values, and nothing is restored.
+	before := client.RepairSlip.Query().CountX(tenant.System(ctx))
+	_, err := backup.Restore(ctx, drv.Dialect(), drv.DB(), bytes.NewReader(data), backup.Options{Merge: true})
+	if !errors.Is(err, backup.ErrConflict) || !strings.Contains(err.Error(), "table organizations, row 1: name = "+tenant.DefaultName) {
+		t.Errorf("merging twice: %v, want %v naming the organization", err, backup.ErrConflict)
+	}
+	if n := client.RepairSlip.Query().CountX(tenant.System(ctx)); n != before {
+		t.Errorf("%d slips after a failed merge, want %d", n, before)
+	}
+}
@@ -0,0 +1,534 @@
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/darksford123x/app/ent/migrate"
+	"github.com/facebookincubator/ent/dialect"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/schema"
+)
+
+// Options configure a restore.
+type Options struct {
+	// Merge restores into a database that already has data. The rows
+	// restored into tables that have rows get new IDs, and the foreign
+	// keys referencing them are remapped; the unique fields of the
+	// restored rows must not clash with those of the rows already there,
+	// or the restore fails with ErrConflict.
+	Merge bool
+}
+
+// Restore restores an archive written by Write into the database, as the
+// ent driver of the given dialect sees it, whose schema must be migrated.
+//
+// The archive is restored in a single transaction, which is committed only
+// after the rows in the database are checked against the manifest of the
+// archive: their number, and their checksum with the IDs mapped back to
+// those of the archive.
+func Restore(ctx context.Context, name string, db *sql.DB, r io.Reader, opts Options) (*Manifest, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+	dec := json.NewDecoder(bufio.NewReader(zr))
+	var h header
+	if err := dec.Decode(&h); err != nil || h.Format != format {
+		return nil, ErrFormat
+	}
+	if h.Version > Version {
+		return nil, fmt.Errorf("backup: archive version %d is newer than %d", h.Version, Version)
+	}
+	tables, err := match(h.Tables)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	rs := &restore{
+		name:   name,
+		tx:     tx,
+		merge:  opts.Merge,
+		before: map[string]int{},
+		ids:    map[string]map[int64]int64{},
+	}
+	m, err := rs.run(ctx, dec, tables)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return m, nil
+}
+
+// table is a table of the archive matched with that of the schema.
+type table struct {
+	*schema.Table
+	// columns are the columns of the archive, in its order.
+	columns []*schema.Column
+}
+
+// match matches the tables of an archive with those of the schema. Every
+// column archived must be in the schema with the same type, and the
+// columns added to the schema since must be nullable or have a default.
+func match(archived []tableSchema) (map[string]*table, error) {
+	current := map[string]*schema.Table{}
+	for _, t := range migrate.Tables {
+		current[t.Name] = t
+	}
+	tables := map[string]*table{}
+	for _, ts := range archived {
+		t, ok := current[ts.Name]
+		if !ok {
+			return nil, fmt.Errorf("backup: table %s is not in the schema", ts.Name)
+		}
+		mt := &table{Table: t}
+		seen := map[string]bool{}
+		for _, cs := range ts.Columns {
+			c, ok := column(t, cs.Name)
+			if !ok {
+				return nil, fmt.Errorf("backup: column %s.%s is not in the schema", ts.Name, cs.Name)
+			}
+			if c.Type.String() != cs.Type {
+				return nil, fmt.Errorf("backup: column %s.%s is %s in the archive and %s in the schema", ts.Name, cs.Name, cs.Type, c.Type)
+			}
+			mt.columns = append(mt.columns, c)
+			seen[c.Name] = true
+		}
+		for _, c := range t.Columns {
+			if !seen[c.Name] && !c.Nullable && c.Default == nil && !c.Increment {
+				return nil, fmt.Errorf("backup: column %s.%s is not in the archive and has no default", ts.Name, c.Name)
+			}
+		}
+		tables[t.Name] = mt
+	}
+	return tables, nil
+}
+
+// restore is a restore in progress.
+type restore struct {
+	name  string
+	tx    *sql.Tx
+	merge bool
+	// before are the numbers of rows of the tables before the restore.
+	before map[string]int
+	// ids map the IDs of the archive to those of the rows restored, for
+	// each table with an ID.
+	ids map[string]map[int64]int64
+}
+
+// deferred is a foreign key to a row of the same table that was not
+// restored yet when its row was.
+type deferred struct {
+	id     int64
+	column string
+	ref    int64
+}
+
+func (rs *restore) run(ctx context.Context, dec *json.Decoder, tables map[string]*table) (*Manifest, error) {
+	for _, t := range migrate.Tables {
+		n, err := rs.count(ctx, t.Name)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 && !rs.merge {
+			return nil, ErrNotEmpty
+		}
+		rs.before[t.Name] = n
+	}
+
+	var (
+		current *table
+		sum     *checksum
+		pending []deferred
+		read    = map[string]Table{}
+	)
+	end := func() error {
+		if current == nil {
+			return nil
+		}
+		read[current.Name] = sum.table(current.Name)
+		return rs.finish(ctx, current, pending)
+	}
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, fmt.Errorf("backup: reading the archive: %w", err)
+		}
+		if len(raw) > 0 && raw[0] == '[' {
+			if current == nil {
+				return nil, ErrFormat
+			}
+			sum.add(raw)
+			d, err := rs.insert(ctx, current, raw)
+			if errors.Is(err, ErrConflict) {
+				return nil, err
+			}
+			if err != nil {
+				return nil, fmt.Errorf("backup: table %s: %w", current.Name, err)
+			}
+			pending = append(pending, d...)
+			continue
+		}
+		var mk marker
+		if err := json.Unmarshal(raw, &mk); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+		}
+		if err := end(); err != nil {
+			return nil, err
+		}
+		if mk.Manifest != nil {
+			return mk.Manifest, rs.verify(ctx, tables, mk.Manifest, read)
+		}
+		if current = tables[mk.Table]; current == nil {
+			return nil, fmt.Errorf("%w: rows of unknown table %q", ErrFormat, mk.Table)
+		}
+		if _, ok := read[current.Name]; ok {
+			return nil, fmt.Errorf("%w: table %s is archived twice", ErrFormat, current.Name)
+		}
+		sum, pending = newChecksum(), nil
+		if _, ok := column(current.Table, "id"); ok {
+			rs.ids[current.Name] = map[int64]int64{}
+		}
+	}
+}
+
+// keepIDs reports whether the rows of a table are restored with the IDs of
+// the archive, which they are unless the table had rows.
+func (rs *restore) keepIDs(t *table) bool {
+	return rs.before[t.Name] == 0
+}
+
+// insert inserts a row of the archive, and returns the foreign keys to rows
+// of the same table that are yet to be restored.
+func (rs *restore) insert(ctx context.Context, t *table, raw json.RawMessage) ([]deferred, error) {
+	var values []json.RawMessage
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+	if len(values) != len(t.columns) {
+		return nil, fmt.Errorf("row has %d values for %d columns", len(values), len(t.columns))
+	}
+	refs := references(t.Table)
+	ids := rs.ids[t.Name]
+	var (
+		id      int64
+		columns []string
+		args    []interface{}
+		later   []deferred
+	)
+	for i, c := range t.columns {
+		v, err := decode(c, values[i])
+		if err != nil {
+			return nil, err
+		}
+		if c.Name == "id" && ids != nil {
+			id = v.(int64)
+			if !rs.keepIDs(t) {
+				continue
+			}
+		}
+		if ref, ok := refs[c.Name]; ok && v != nil {
+			mapped, ok := rs.ids[ref][v.(int64)]
+			switch {
+			case ok:
+				v = mapped
+			case ref == t.Name && c.Nullable:
+				later = append(later, deferred{column: c.Name, ref: v.(int64)})
+				v = nil
+			default:
+				return nil, fmt.Errorf("column %s references %s %d, which is not in the archive", c.Name, ref, v)
+			}
+		}
+		columns = append(columns, c.Name)
+		args = append(args, v)
+	}
+
+	if !rs.keepIDs(t) {
+		taken, err := rs.taken(ctx, t, columns, args)
+		if err != nil {
+			return nil, err
+		}
+		if taken != "" {
+			return nil, fmt.Errorf("%w: table %s, row %d: %s", ErrConflict, t.Name, id, taken)
+		}
+	}
+
+	insert := entsql.Dialect(rs.name).
+		Insert(t.Name).
+		Columns(columns...).
+		Values(args...)
+	if ids == nil {
+		query, args := insert.Query()
+		_, err := rs.tx.ExecContext(ctx, query, args...)
+		return nil, err
+	}
+	restored := id
+	if !rs.keepIDs(t) {
+		var err error
+		if restored, err = rs.insertID(ctx, insert); err != nil {
+			return nil, err
+		}
+	} else {
+		query, args := insert.Query()
+		if _, err := rs.tx.ExecContext(ctx, query, args...); err != nil {
+			return nil, err
+		}
+	}
+	ids[id] = restored
+	for i := range later {
+		later[i].id = restored
+	}
+	return later, nil
+}
+
+// taken returns the unique values of a row, given by its columns and
+// values, that a row of the table already has, as "name = Acme", or ""
+// when none clash. NULLs never clash.
+func (rs *restore) taken(ctx context.Context, t *table, columns []string, args []interface{}) (string, error) {
+	values := make(map[string]interface{}, len(columns))
+	for i, c := range columns {
+		values[c] = args[i]
+	}
+	var sets [][]string
+	for _, c := range t.Columns {
+		if c.Unique {
+			sets = append(sets, []string{c.Name})
+		}
+	}
+	for _, idx := range t.Indexes {
+		if idx.Unique {
+			var set []string
+			for _, c := range idx.Columns {
+				set = append(set, c.Name)
+			}
+			sets = append(sets, set)
+		}
+	}
+	for _, set := range sets {
+		var (
+			preds []*entsql.Predicate
+			desc  []string
+		)
+		for _, c := range set {
+			if v := values[c]; v != nil {
+				preds = append(preds, entsql.EQ(c, v))
+				desc = append(desc, fmt.Sprintf("%s = %v", c, v))
+			}
+		}
+		if len(preds) < len(set) {
+			continue
+		}
+		query, qargs := entsql.Dialect(rs.name).
+			Select(entsql.Count("*")).
+			From(entsql.Dialect(rs.name).Table(t.Name)).
+			Where(entsql.And(preds...)).
+			Query()
+		var n int
+		if err := rs.tx.QueryRowContext(ctx, query, qargs...).Scan(&n); err != nil {
+			return "", err
+		}
+		if n > 0 {
+			return strings.Join(desc, ", "), nil
+		}
+	}
+	return "", nil
+}
+
+// insertID runs an insert and returns the ID the database gave the row.
+func (rs *restore) insertID(ctx context.Context, insert *entsql.InsertBuilder) (int64, error) {
+	if rs.name == dialect.Postgres {
+		query, args := insert.Returning("id").Query()
+		var id int64
+		err := rs.tx.QueryRowContext(ctx, query, args...).Scan(&id)
+		return id, err
+	}
+	query, args := insert.Query()
+	res, err := rs.tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+// finish sets the foreign keys deferred while restoring a table, and moves
+// the ID sequence of a PostgreSQL table past the IDs restored.
+func (rs *restore) finish(ctx context.Context, t *table, pending []deferred) error {
+	ids := rs.ids[t.Name]
+	for _, d := range pending {
+		ref, ok := ids[d.ref]
+		if !ok {
+			return fmt.Errorf("backup: table %s: column %s references %d, which is not in the archive", t.Name, d.column, d.ref)
+		}
+		query, args := entsql.Dialect(rs.name).
+			Update(t.Name).
+			Set(d.column, ref).
+			Where(entsql.EQ("id", d.id)).
+			Query()
+		if _, err := rs.tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("backup: table %s: %w", t.Name, err)
+		}
+	}
+	if rs.name == dialect.Postgres && ids != nil && len(ids) > 0 && rs.keepIDs(t) {
+		query := fmt.Sprintf(`SELECT setval(pg_get_serial_sequence('%s', 'id'), (SELECT MAX("id") FROM "%s"))`, t.Name, t.Name)
+		if _, err := rs.tx.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("backup: table %s: resetting the ID sequence: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+// column returns the column of a table of the schema with the given name.
+func column(t *schema.Table, name string) (*schema.Column, bool) {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// references returns the tables the foreign key columns of a table
+// reference, by column.
+func references(t *schema.Table) map[string]string {
+	refs := map[string]string{}
+	for _, fk := range t.ForeignKeys {
+		refs[fk.Columns[0].Name] = fk.RefTable.Name
+	}
+	return refs
+}
+
+// count returns the number of rows of a table.
+func (rs *restore) count(ctx context.Context, table string) (int, error) {
+	query, args := entsql.Dialect(rs.name).
+		Select(entsql.Count("*")).
+		From(entsql.Dialect(rs.name).Table(table)).
+		Query()
+	var n int
+	err := rs.tx.QueryRowContext(ctx, query, args...).Scan(&n)
+	return n, err
+}
+
+// verify checks the rows read from the archive against its manifest, then
+// the rows restored in the database.
+func (rs *restore) verify(ctx context.Context, tables map[string]*table, m *Manifest, read map[string]Table) error {
+	if len(m.Tables) != len(read) {
+		return fmt.Errorf("%w: the archive has %d tables, its manifest %d", ErrChecksum, len(read), len(m.Tables))
+	}
+	for _, want := range m.Tables {
+		if got := read[want.Name]; got != want {
+			return fmt.Errorf("%w: table %s of the archive has %d rows with checksum %s, its manifest %d with %s",
+				ErrChecksum, want.Name, got.Rows, got.Checksum, want.Rows, want.Checksum)
+		}
+	}
+
+	// The IDs restored, mapped back to those of the archive.
+	archived := map[string]map[int64]int64{}
+	for name, ids := range rs.ids {
+		archived[name] = make(map[int64]int64, len(ids))
+		for from, to := range ids {
+			archived[name][to] = from
+		}
+	}
+	for _, want := range m.Tables {
+		t := tables[want.Name]
+		n, err := rs.count(ctx, t.Name)
+		if err != nil {
+			return err
+		}
+		if n-rs.before[t.Name] != want.Rows {
+			return fmt.Errorf("%w: table %s has %d rows restored, the archive %d", ErrChecksum, t.Name, n-rs.before[t.Name], want.Rows)
+		}
+		got, err := rs.checksum(ctx, t, archived)
+		if err != nil {
+			return fmt.Errorf("backup: table %s: %w", t.Name, err)
+		}
+		if got != want {
+			return fmt.Errorf("%w: table %s has %d rows restored with checksum %s, the archive %d with %s",
+				ErrChecksum, t.Name, got.Rows, got.Checksum, want.Rows, want.Checksum)
+		}
+	}
+	return nil
+}
+
+// checksum sums the rows of a table restored, with the IDs of the archive,
+// in the order of their primary key in the archive.
+func (rs *restore) checksum(ctx context.Context, t *table, archived map[string]map[int64]int64) (Table, error) {
+	refs := references(t.Table)
+	pk := map[string]bool{}
+	for _, c := range t.PrimaryKey {
+		pk[c.Name] = true
+	}
+	type row struct {
+		key  []int64
+		line []byte
+	}
+	var rows []row
+	err := scan(ctx, rs.tx, rs.name, t.Name, t.columns, func(values []interface{}) error {
+		var key []int64
+		for i, c := range t.columns {
+			ref := t.Name
+			if r, ok := refs[c.Name]; ok {
+				ref = r
+			} else if c.Name != "id" {
+				continue
+			}
+			v, ok := values[i].(int64)
+			if !ok {
+				continue
+			}
+			from, ok := archived[ref][v]
+			if !ok {
+				if pk[c.Name] {
+					// The row was in the database before the restore.
+					return nil
+				}
+				continue
+			}
+			values[i] = from
+			if pk[c.Name] {
+				key = append(key, from)
+			}
+		}
+		b, err := json.Marshal(values)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row{key: key, line: b})
+		return nil
+	})
+	if err != nil {
+		return Table{}, err
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i].key, rows[j].key
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return false
+	})
+	sum := newChecksum()
+	for _, r := range rows {
+		sum.add(r.line)
+	}
+	return sum.table(t.Name), nil
+}
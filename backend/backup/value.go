@@ -0,0 +1,138 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql/schema"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// encode converts a value scanned from a column to its form in the
+// archive, which is the same whatever the database: times are in UTC with
+// microseconds, the precision of PostgreSQL, and booleans, numbers and
+// JSON are converted from the text or integers some drivers return.
+func encode(c *schema.Column, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch c.Type {
+	case field.TypeBool:
+		switch v := v.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			return v != 0, nil
+		case []byte:
+			return strconv.ParseBool(string(v))
+		case string:
+			return strconv.ParseBool(v)
+		}
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		switch v := v.(type) {
+		case int64:
+			return v, nil
+		case []byte:
+			return strconv.ParseInt(string(v), 10, 64)
+		case string:
+			return strconv.ParseInt(v, 10, 64)
+		}
+	case field.TypeFloat32, field.TypeFloat64:
+		switch v := v.(type) {
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		case []byte:
+			return strconv.ParseFloat(string(v), 64)
+		}
+	case field.TypeTime:
+		switch v := v.(type) {
+		case time.Time:
+			return v.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano), nil
+		case []byte:
+			return encode(c, string(v))
+		case string:
+			for _, layout := range timeLayouts {
+				if t, err := time.Parse(layout, v); err == nil {
+					return encode(c, t)
+				}
+			}
+		}
+	case field.TypeJSON:
+		switch v := v.(type) {
+		case []byte:
+			return json.RawMessage(v), nil
+		case string:
+			return json.RawMessage(v), nil
+		}
+	case field.TypeBytes:
+		switch v := v.(type) {
+		case []byte:
+			return v, nil
+		case string:
+			return []byte(v), nil
+		}
+	default:
+		switch v := v.(type) {
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		}
+	}
+	return nil, fmt.Errorf("column %s: unexpected %T for %s", c.Name, v, c.Type)
+}
+
+// timeLayouts are the layouts SQLite drivers write times in.
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	time.RFC3339Nano,
+}
+
+// decode converts a value of the archive to the value written to a column.
+func decode(c *schema.Column, raw json.RawMessage) (v interface{}, err error) {
+	if string(raw) == "null" {
+		return nil, nil
+	}
+	switch c.Type {
+	case field.TypeBool:
+		var b bool
+		err = json.Unmarshal(raw, &b)
+		v = b
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		var n int64
+		err = json.Unmarshal(raw, &n)
+		v = n
+	case field.TypeFloat32, field.TypeFloat64:
+		var f float64
+		err = json.Unmarshal(raw, &f)
+		v = f
+	case field.TypeTime:
+		var t time.Time
+		err = json.Unmarshal(raw, &t)
+		v = t
+	case field.TypeJSON:
+		// The JSON is written back as the text ent stores.
+		v = []byte(raw)
+	case field.TypeBytes:
+		var b []byte
+		err = json.Unmarshal(raw, &b)
+		v = b
+	default:
+		var s string
+		err = json.Unmarshal(raw, &s)
+		v = s
+	}
+	if err != nil {
+		return nil, fmt.Errorf("column %s: %w", c.Name, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,373 @@
+// Package billing turns closed repair slips into invoices.
+//
+// An invoice is computed from the labour, the parts consumed by the slip and
+// any flat fees, and is issued in a single transaction together with its
+// number. Issued invoices are immutable; they are corrected by issuing credit
+// notes against them.
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/tenant"
+	"github.com/darksford123x/app/txn"
+)
+
+var (
+	// ErrSlipNotClosed is returned when invoicing a slip that is still open.
+	ErrSlipNotClosed = errors.New("billing: repair slip is not closed")
+	// ErrAlreadyInvoiced is returned when the slip already has an invoice.
+	ErrAlreadyInvoiced = errors.New("billing: repair slip is already invoiced")
+	// ErrEmptyInvoice is returned when there is nothing to charge.
+	ErrEmptyInvoice = errors.New("billing: invoice has no lines")
+	// ErrCreditExceeded is returned when a credit note is larger than the
+	// part of the invoice that has not been credited yet.
+	ErrCreditExceeded = errors.New("billing: credit exceeds the remaining invoice total")
+	// ErrNotInvoice is returned when crediting a credit note.
+	ErrNotInvoice = errors.New("billing: only invoices can be credited")
+)
+
+// issueAttempts bounds the retries when two invoices race for a number.
+const issueAttempts = 3
+
+// Fee is a flat fee charged on an invoice.
+type Fee struct {
+	Description string       `json:"description"`
+	Amount      money.Amount `json:"amount" swaggertype:"string" format:"decimal"`
+}
+
+// Request describes how a repair slip is charged. Parts are taken from the
+// stock consumed by the slip.
+type Request struct {
+	LabourHours  money.Quantity `json:"labour_hours" swaggertype:"string" format:"decimal"`
+	LabourRate   money.Amount   `json:"labour_rate" swaggertype:"string" format:"decimal"`
+	Fees         []Fee          `json:"fees"`
+	DiscountRate money.Rate     `json:"discount_rate" swaggertype:"string" format:"decimal"`
+	Discount     money.Amount   `json:"discount" swaggertype:"string" format:"decimal"`
+}
+
+// Line is a computed invoice line.
+type Line struct {
+	Kind        invoiceline.Kind `json:"kind" enums:"labour,part,fee"`
+	Description string           `json:"description"`
+	Quantity    money.Quantity   `json:"quantity" swaggertype:"string" format:"decimal"`
+	UnitPrice   money.Amount     `json:"unit_price" swaggertype:"string" format:"decimal"`
+	Amount      money.Amount     `json:"amount" swaggertype:"string" format:"decimal"`
+}
+
+// Draft is a computed invoice that has not been issued.
+type Draft struct {
+	Lines    []Line       `json:"lines"`
+	Subtotal money.Amount `json:"subtotal" swaggertype:"string" format:"decimal"`
+	Discount money.Amount `json:"discount" swaggertype:"string" format:"decimal"`
+	VATRate  money.Rate   `json:"vat_rate" swaggertype:"string" format:"decimal"`
+	VAT      money.Amount `json:"vat" swaggertype:"string" format:"decimal"`
+	Total    money.Amount `json:"total" swaggertype:"string" format:"decimal"`
+}
+
+// Biller issues invoices and credit notes.
+type Biller struct {
+	client  *ent.Client
+	vatRate money.Rate
+}
+
+// NewBiller creates a biller charging the given VAT rate.
+func NewBiller(client *ent.Client, vatRate money.Rate) *Biller {
+	return &Biller{client: client, vatRate: vatRate}
+}
+
+// Preview computes the invoice of a closed repair slip without issuing it.
+func (b *Biller) Preview(ctx context.Context, slipID int, req Request) (*Draft, error) {
+	slip, err := b.client.RepairSlip.Get(ctx, slipID)
+	if err != nil {
+		return nil, err
+	}
+	return b.draft(ctx, b.client, slip, req)
+}
+
+// Issue computes the invoice of a closed repair slip and issues it.
+func (b *Biller) Issue(ctx context.Context, slipID int, req Request) (inv *ent.Invoice, err error) {
+	for i := 0; i < issueAttempts; i++ {
+		err = txn.WithTx(ctx, b.client, func(ctx context.Context, tx *ent.Tx) error {
+			slip, err := tx.RepairSlip.Get(ctx, slipID)
+			if err != nil {
+				return err
+			}
+			exists, err := slip.QueryInvoice().Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return ErrAlreadyInvoiced
+			}
+			d, err := b.draft(ctx, tx.Client(), slip, req)
+			if err != nil {
+				return err
+			}
+			number, err := nextNumber(ctx, tx, invoice.KindInvoice, time.Now())
+			if err != nil {
+				return err
+			}
+			builder := tx.Invoice.
+				Create().
+				SetNumber(number).
+				SetRepairSlip(slip)
+			if reporter, err := slip.QueryReporter().OnlyID(ctx); err == nil {
+				builder.SetCustomerID(reporter)
+			} else if !ent.IsNotFound(err) {
+				return err
+			}
+			inv, err = save(ctx, tx, builder, d)
+			return err
+		})
+		if !ent.IsConstraintError(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// CreditNote issues a credit note for the given lines against an invoice.
+// VAT is charged at the rate of the original invoice. As payments are, the
+// credit is claimed with an update of the invoice that only applies while
+// it is unchanged, so that concurrent credit notes cannot together credit
+// more than the invoice total; the one that loses tries again.
+func (b *Biller) CreditNote(ctx context.Context, invoiceID int, reason string, lines []Line) (cn *ent.Invoice, err error) {
+	for i := 0; i < issueAttempts; i++ {
+		err = txn.WithTx(ctx, b.client, func(ctx context.Context, tx *ent.Tx) error {
+			orig, err := tx.Invoice.Get(ctx, invoiceID)
+			if err != nil {
+				return err
+			}
+			if orig.Kind != invoice.KindInvoice {
+				return ErrNotInvoice
+			}
+			remaining, err := balanceDue(ctx, orig)
+			if err != nil {
+				return err
+			}
+			d, err := compute(lines, 0, 0, orig.VatRate)
+			if err != nil {
+				return err
+			}
+			if d.Total > remaining {
+				return fmt.Errorf("%w: %s left", ErrCreditExceeded, remaining)
+			}
+			n, err := tx.Invoice.
+				Update().
+				Where(
+					invoice.ID(orig.ID),
+					invoice.AmountPaid(orig.AmountPaid),
+					invoice.AmountCredited(orig.AmountCredited),
+				).
+				SetAmountCredited(orig.Total - remaining + d.Total).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n != 1 {
+				return ErrInvoiceChanged
+			}
+			number, err := nextNumber(ctx, tx, invoice.KindCreditNote, time.Now())
+			if err != nil {
+				return err
+			}
+			builder := tx.Invoice.
+				Create().
+				SetNumber(number).
+				SetKind(invoice.KindCreditNote).
+				SetCurrency(orig.Currency).
+				SetReason(reason).
+				SetOriginal(orig)
+			if customer, err := orig.QueryCustomer().OnlyID(ctx); err == nil {
+				builder.SetCustomerID(customer)
+			} else if !ent.IsNotFound(err) {
+				return err
+			}
+			if cn, err = save(ctx, tx, builder, d); err != nil {
+				return err
+			}
+			// The credit lowers what the customer owes on the original, and
+			// a refund is due when it was paid already.
+			return settle(ctx, tx, orig.ID)
+		})
+		if !ent.IsConstraintError(err) && !errors.Is(err, ErrInvoiceChanged) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cn, nil
+}
+
+// draft computes the invoice of the slip from its labour, parts and fees.
+func (b *Biller) draft(ctx context.Context, client *ent.Client, slip *ent.RepairSlip, req Request) (*Draft, error) {
+	if slip.Status != repairslip.StatusClosed {
+		return nil, ErrSlipNotClosed
+	}
+
+	var lines []Line
+	if req.LabourHours > 0 {
+		lines = append(lines, Line{
+			Kind:        invoiceline.KindLabour,
+			Description: "Labour",
+			Quantity:    req.LabourHours,
+			UnitPrice:   req.LabourRate,
+		})
+	}
+
+	movements, err := client.StockMovement.
+		Query().
+		Where(
+			stockmovement.KindEQ(stockmovement.KindConsumption),
+			stockmovement.HasRepairSlipWith(repairslip.IDEQ(slip.ID)),
+		).
+		WithPart().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[int]*Line)
+	for _, mv := range movements {
+		p := mv.Edges.Part
+		l, ok := used[p.ID]
+		if !ok {
+			l = &Line{
+				Kind:        invoiceline.KindPart,
+				Description: fmt.Sprintf("%s (%s)", p.Name, p.Sku),
+				UnitPrice:   p.UnitPrice,
+			}
+			used[p.ID] = l
+		}
+		// Consumptions are booked as negative quantities.
+		l.Quantity -= money.Units(mv.Quantity)
+	}
+	ids := make([]int, 0, len(used))
+	for id := range used {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		lines = append(lines, *used[id])
+	}
+
+	for _, f := range req.Fees {
+		lines = append(lines, Line{
+			Kind:        invoiceline.KindFee,
+			Description: f.Description,
+			Quantity:    money.Units(1),
+			UnitPrice:   f.Amount,
+		})
+	}
+	return compute(lines, req.DiscountRate, req.Discount, b.vatRate)
+}
+
+// compute prices the lines and applies the discount and VAT. The discount
+// rate and the flat discount are both taken off the subtotal, and VAT is
+// charged on what remains.
+func compute(lines []Line, discountRate money.Rate, discount money.Amount, vatRate money.Rate) (*Draft, error) {
+	if len(lines) == 0 {
+		return nil, ErrEmptyInvoice
+	}
+	d := &Draft{Lines: lines, VATRate: vatRate}
+	for i := range d.Lines {
+		l := &d.Lines[i]
+		if l.Description == "" || l.Quantity <= 0 || l.UnitPrice < 0 {
+			return nil, fmt.Errorf("billing: invalid line %q", l.Description)
+		}
+		l.Amount = l.UnitPrice.Times(l.Quantity)
+		d.Subtotal += l.Amount
+	}
+	if discountRate < 0 || discount < 0 {
+		return nil, errors.New("billing: discount must not be negative")
+	}
+	d.Discount = d.Subtotal.Percent(discountRate) + discount
+	if d.Discount > d.Subtotal {
+		d.Discount = d.Subtotal
+	}
+	d.VAT = (d.Subtotal - d.Discount).Percent(vatRate)
+	d.Total = d.Subtotal - d.Discount + d.VAT
+	return d, nil
+}
+
+// save stores the computed amounts and lines on the invoice being created.
+func save(ctx context.Context, tx *ent.Tx, builder *ent.InvoiceCreate, d *Draft) (*ent.Invoice, error) {
+	inv, err := builder.
+		SetSubtotal(d.Subtotal).
+		SetDiscount(d.Discount).
+		SetVatRate(d.VATRate).
+		SetVat(d.VAT).
+		SetTotal(d.Total).
+		SetAmountPaid(0).
+		SetAmountCredited(0).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range d.Lines {
+		_, err := tx.InvoiceLine.
+			Create().
+			SetKind(l.Kind).
+			SetDescription(l.Description).
+			SetQuantity(l.Quantity).
+			SetUnitPrice(l.UnitPrice).
+			SetAmount(l.Amount).
+			SetInvoice(inv).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return inv, nil
+}
+
+// nextNumber returns the next number in the yearly sequence of the kind,
+// e.g. INV-2020-000042. The unique index on the number guards against two
+// transactions taking the same one. The sequence is shared by the
+// organizations of the deployment, so the last number is looked for among
+// the invoices of all of them.
+func nextNumber(ctx context.Context, tx *ent.Tx, kind invoice.Kind, now time.Time) (string, error) {
+	prefix := "INV"
+	if kind == invoice.KindCreditNote {
+		prefix = "CN"
+	}
+	prefix = fmt.Sprintf("%s-%d-", prefix, now.Year())
+	last, err := tx.Invoice.
+		Query().
+		Where(invoice.NumberHasPrefix(prefix)).
+		Order(ent.Desc(invoice.FieldNumber)).
+		First(tenant.System(ctx))
+	switch {
+	case ent.IsNotFound(err):
+		return sequence(prefix, "")
+	case err != nil:
+		return "", err
+	}
+	return sequence(prefix, last.Number)
+}
+
+// sequence returns the number following last in the sequence of the prefix,
+// or the first one when last is empty.
+func sequence(prefix, last string) (string, error) {
+	seq := 0
+	if last != "" {
+		if _, err := fmt.Sscanf(last[len(prefix):], "%d", &seq); err != nil {
+			return "", fmt.Errorf("billing: malformed number %q", last)
+		}
+	}
+	return fmt.Sprintf("%s%06d", prefix, seq+1), nil
+}
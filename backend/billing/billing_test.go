@@ -0,0 +1,334 @@
+package billing_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/billing"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/servertest"
+)
+
+// closed returns a closed repair slip.
+func closed(h *servertest.Harness) *ent.RepairSlip {
+	h.T.Helper()
+	return h.RepairSlip(h.User().SaveX(h.Context())).SetStatus(repairslip.StatusClosed).SaveX(h.Context())
+}
+
+// amounts formats the amounts of a draft.
+func amounts(d *billing.Draft) string {
+	return fmt.Sprintf("subtotal %s, discount %s, VAT %s, total %s", d.Subtotal, d.Discount, d.VAT, d.Total)
+}
+
+func TestCompute(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	biller := billing.NewBiller(h.Client, 700)
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+
+	// Two lamps are used on the slip before it is closed.
+	p := h.Part().SaveX(ctx)
+	slip := h.RepairSlip(supervisor).SaveX(ctx)
+	h.Post(fmt.Sprintf("/api/v1/parts/%d/receipts", p.ID), map[string]interface{}{"location": "Store A", "quantity": 5}, supervisor).Status(200)
+	h.Post(fmt.Sprintf("/api/v1/repairslips/%d/parts", slip.ID), map[string]interface{}{"part": p.ID, "location": "Store A", "quantity": 2}, supervisor).Status(200)
+	if _, err := biller.Preview(ctx, slip.ID, billing.Request{Fees: []billing.Fee{{Description: "Call-out", Amount: 10000}}}); !errors.Is(err, billing.ErrSlipNotClosed) {
+		t.Errorf("previewing an open slip: %v, want %v", err, billing.ErrSlipNotClosed)
+	}
+	h.Client.RepairSlip.UpdateOne(slip).SetStatus(repairslip.StatusClosed).ExecX(ctx)
+
+	// 1.5 hours at 333.33 is 499.995, rounded to 500.00. The discount of
+	// 10% and 15.50 is taken off the 5,600.00 before the VAT of 7% of
+	// 5,024.50, 351.715, rounded to 351.72.
+	d, err := biller.Preview(ctx, slip.ID, billing.Request{
+		LabourHours:  150,
+		LabourRate:   33333,
+		Fees:         []billing.Fee{{Description: "Call-out", Amount: 10000}},
+		DiscountRate: 1000,
+		Discount:     1550,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, l := range d.Lines {
+		lines = append(lines, fmt.Sprintf("%s %s: %s x %s = %s", l.Kind, l.Description, l.Quantity, l.UnitPrice, l.Amount))
+	}
+	want := fmt.Sprintf("[labour Labour: 1.50 x 333.33 = 500.00 part %s (%s): 2.00 x 2500.00 = 5000.00 fee Call-out: 1.00 x 100.00 = 100.00]", p.Name, p.Sku)
+	if got := fmt.Sprint(lines); got != want {
+		t.Errorf("lines = %s, want %s", got, want)
+	}
+	if got, want := amounts(d), "subtotal 5600.00, discount 575.50, VAT 351.72, total 5376.22"; got != want {
+		t.Errorf("%s, want %s", got, want)
+	}
+
+	// A discount larger than the subtotal leaves nothing to pay.
+	d, err = biller.Preview(ctx, slip.ID, billing.Request{Discount: 1000000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := amounts(d), "subtotal 5000.00, discount 5000.00, VAT 0.00, total 0.00"; got != want {
+		t.Errorf("%s, want %s", got, want)
+	}
+
+	empty := closed(h)
+	if _, err := biller.Preview(ctx, empty.ID, billing.Request{}); !errors.Is(err, billing.ErrEmptyInvoice) {
+		t.Errorf("previewing a slip with nothing to charge: %v, want %v", err, billing.ErrEmptyInvoice)
+	}
+	for _, req := range []billing.Request{
+		{Fees: []billing.Fee{{Description: "Call-out", Amount: -100}}},
+		{Fees: []billing.Fee{{Amount: 100}}},
+		{Fees: []billing.Fee{{Description: "Call-out", Amount: 100}}, Discount: -1},
+		{Fees: []billing.Fee{{Description: "Call-out", Amount: 100}}, DiscountRate: -1},
+	} {
+		if _, err := biller.Preview(ctx, empty.ID, req); err == nil {
+			t.Errorf("previewed %+v", req)
+		}
+	}
+}
+
+func TestNumbering(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	biller := billing.NewBiller(h.Client, 700)
+	year := time.Now().Year()
+	fee := billing.Request{Fees: []billing.Fee{{Description: "Call-out", Amount: 10000}}}
+	issue := func() *ent.Invoice {
+		t.Helper()
+		inv, err := biller.Issue(ctx, closed(h).ID, fee)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return inv
+	}
+
+	first, second := issue(), issue()
+	cn, err := biller.CreditNote(ctx, first.ID, "Goodwill", []billing.Line{{Kind: invoiceline.KindFee, Description: "Call-out", Quantity: 100, UnitPrice: 1000}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each kind has its own yearly sequence, and the numbers of other years
+	// do not count.
+	for _, number := range []string{fmt.Sprintf("INV-%d-000099", year-1), fmt.Sprintf("INV-%d-000041", year)} {
+		h.Client.Invoice.Create().SetNumber(number).SetSubtotal(100).SetDiscount(0).SetVatRate(0).SetVat(0).SetTotal(100).SetAmountPaid(0).SetAmountCredited(0).SaveX(ctx)
+	}
+	third := issue()
+	for _, tc := range []struct {
+		inv  *ent.Invoice
+		want string
+	}{
+		{first, "INV-%d-000001"},
+		{second, "INV-%d-000002"},
+		{cn, "CN-%d-000001"},
+		{third, "INV-%d-000042"},
+	} {
+		if want := fmt.Sprintf(tc.want, year); tc.inv.Number != want {
+			t.Errorf("invoice %d numbered %s, want %s", tc.inv.ID, tc.inv.Number, want)
+		}
+	}
+
+	if _, err := biller.Issue(ctx, h.Client.Invoice.QueryRepairSlip(first).OnlyIDX(ctx), fee); !errors.Is(err, billing.ErrAlreadyInvoiced) {
+		t.Errorf("invoicing a slip twice: %v, want %v", err, billing.ErrAlreadyInvoiced)
+	}
+}
+
+func TestCreditNoteLimit(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	inv, err := billing.NewBiller(h.Client, 700).Issue(ctx, closed(h).ID, billing.Request{
+		Fees: []billing.Fee{{Description: "Call-out", Amount: 100000}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Credit notes charge the VAT of the invoice, whatever the rate now.
+	biller := billing.NewBiller(h.Client, 1000)
+	credit := func(amount money.Amount) (*ent.Invoice, error) {
+		return biller.CreditNote(ctx, inv.ID, "Overcharged", []billing.Line{{
+			Kind:        invoiceline.KindFee,
+			Description: "Call-out",
+			Quantity:    money.Units(1),
+			UnitPrice:   amount,
+		}})
+	}
+
+	// 1,070.00 was invoiced: 600.00 and 400.00 with their VAT are credited,
+	// and nothing more.
+	cn, err := credit(60000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cn.Total != 64200 || cn.VatRate != 700 || cn.Kind != invoice.KindCreditNote {
+		t.Errorf("credit note of %s at %s%% as %s, want 642.00 at 7%% as a credit note", cn.Total, cn.VatRate, cn.Kind)
+	}
+	if _, err := credit(40001); !errors.Is(err, billing.ErrCreditExceeded) {
+		t.Errorf("crediting more than is left: %v, want %v", err, billing.ErrCreditExceeded)
+	}
+	if _, err := credit(40000); err != nil {
+		t.Errorf("crediting what is left: %v", err)
+	}
+	if _, err := credit(1); !errors.Is(err, billing.ErrCreditExceeded) {
+		t.Errorf("crediting a fully credited invoice: %v, want %v", err, billing.ErrCreditExceeded)
+	}
+	if _, err := biller.CreditNote(ctx, cn.ID, "Twice", []billing.Line{{Kind: invoiceline.KindFee, Description: "Call-out", Quantity: 100, UnitPrice: 1}}); !errors.Is(err, billing.ErrNotInvoice) {
+		t.Errorf("crediting a credit note: %v, want %v", err, billing.ErrNotInvoice)
+	}
+	if n := h.Client.Invoice.Query().Where(invoice.KindEQ(invoice.KindCreditNote)).CountX(ctx); n != 2 {
+		t.Errorf("%d credit notes, want 2", n)
+	}
+}
+
+func TestCreditNoteRace(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	biller := billing.NewBiller(h.Client, 700)
+	inv, err := biller.Issue(ctx, closed(h).ID, billing.Request{
+		Fees: []billing.Fee{{Description: "Call-out", Amount: 100000}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another credit note is issued against the invoice whenever one of the
+	// next conflicts credit notes claims the amount credited, as if both
+	// read the invoice at the same time.
+	conflicts, claims := 0, 0
+	h.Client.Invoice.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.InvoiceFunc(func(ctx context.Context, m *ent.InvoiceMutation) (ent.Value, error) {
+			if _, ok := m.AmountCredited(); ok && m.Op().Is(ent.OpUpdate) {
+				claims++
+				if conflicts > 0 {
+					conflicts--
+					tx := ent.TxFromContext(ctx)
+					credited := tx.Invoice.GetX(ctx, inv.ID).AmountCredited
+					if err := tx.Invoice.UpdateOneID(inv.ID).SetAmountCredited(credited + 1).Exec(ctx); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	credit := func(amount money.Amount) (*ent.Invoice, error) {
+		return biller.CreditNote(ctx, inv.ID, "Overcharged", []billing.Line{{
+			Kind:        invoiceline.KindFee,
+			Description: "Call-out",
+			Quantity:    money.Units(1),
+			UnitPrice:   amount,
+		}})
+	}
+
+	conflicts = 1
+	if _, err := credit(60000); err != nil {
+		t.Fatal(err)
+	}
+	if claims != 2 {
+		t.Errorf("the amount credited was claimed %d times, want 2: once lost, once retried", claims)
+	}
+	// The credit note that loses every attempt is not issued.
+	conflicts = 3
+	if _, err := credit(10000); !errors.Is(err, billing.ErrInvoiceChanged) {
+		t.Errorf("crediting while losing every claim: %v, want %v", err, billing.ErrInvoiceChanged)
+	}
+	if got := h.Client.Invoice.GetX(ctx, inv.ID).AmountCredited; got != 64200 {
+		t.Errorf("%s credited, want 642.00", got)
+	}
+	if n := h.Client.Invoice.Query().Where(invoice.KindEQ(invoice.KindCreditNote)).CountX(ctx); n != 1 {
+		t.Errorf("%d credit notes, want 1", n)
+	}
+}
+
+func TestCreditNoteRefundDue(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	biller := billing.NewBiller(h.Client, 700)
+	inv, err := biller.Issue(ctx, closed(h).ID, billing.Request{
+		Fees: []billing.Fee{{Description: "Call-out", Amount: 100000}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pay := func(kind payment.Kind, amount money.Amount) error {
+		_, err := biller.Pay(ctx, inv.ID, billing.Payment{Kind: kind, Method: payment.MethodCash, Amount: amount})
+		return err
+	}
+	check := func(paid, credited money.Amount, status invoice.PaymentStatus) {
+		t.Helper()
+		got := h.Client.Invoice.GetX(ctx, inv.ID)
+		if got.AmountPaid != paid || got.AmountCredited != credited || got.PaymentStatus != status {
+			t.Errorf("invoice paid %s, credited %s and %s, want %s, %s and %s",
+				got.AmountPaid, got.AmountCredited, got.PaymentStatus, paid, credited, status)
+		}
+	}
+
+	if err := pay(payment.KindPayment, 107000); err != nil {
+		t.Fatal(err)
+	}
+	check(107000, 0, invoice.PaymentStatusPaid)
+
+	// Crediting 100.00 and its VAT on a paid invoice leaves 107.00 to
+	// refund, which is not owed by the customer.
+	if _, err := biller.CreditNote(ctx, inv.ID, "Overcharged", []billing.Line{{
+		Kind:        invoiceline.KindFee,
+		Description: "Call-out",
+		Quantity:    money.Units(1),
+		UnitPrice:   10000,
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	check(107000, 10700, invoice.PaymentStatusRefundDue)
+	if balances, err := biller.Outstanding(ctx, billing.ByCustomer); err != nil || len(balances) != 0 {
+		t.Errorf("outstanding %+v, %v, want nothing", balances, err)
+	}
+	if err := pay(payment.KindPayment, 1); !errors.Is(err, billing.ErrOverpayment) {
+		t.Errorf("paying an invoice due a refund: %v, want %v", err, billing.ErrOverpayment)
+	}
+
+	if err := pay(payment.KindRefund, 10700); err != nil {
+		t.Fatal(err)
+	}
+	check(96300, 10700, invoice.PaymentStatusPaid)
+}
+
+func TestInvoicesImmutable(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	inv, err := billing.NewBiller(h.Client, 700).Issue(ctx, closed(h).ID, billing.Request{
+		Fees: []billing.Fee{{Description: "Call-out", Amount: 100000}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	line := h.Client.Invoice.QueryLines(inv).OnlyX(ctx)
+
+	// Only the payment fields change once an invoice is issued.
+	if err := h.Client.Invoice.UpdateOne(inv).SetPaymentStatus(invoice.PaymentStatusPartial).Exec(ctx); err != nil {
+		t.Errorf("updating the payment status: %v", err)
+	}
+	for name, err := range map[string]error{
+		"clearing the slip":   h.Client.Invoice.UpdateOne(inv).ClearRepairSlip().Exec(ctx),
+		"removing a line":     h.Client.Invoice.UpdateOne(inv).RemoveLineIDs(line.ID).Exec(ctx),
+		"deleting":            h.Client.Invoice.DeleteOne(inv).Exec(ctx),
+		"deleting them all":   func() error { _, err := h.Client.Invoice.Delete().Exec(ctx); return err }(),
+		"moving a line":       h.Client.InvoiceLine.UpdateOne(line).ClearInvoice().Exec(ctx),
+		"deleting a line":     h.Client.InvoiceLine.DeleteOne(line).Exec(ctx),
+		"changing a customer": h.Client.Invoice.UpdateOne(inv).ClearCustomer().Exec(ctx),
+	} {
+		if err == nil {
+			t.Errorf("%s of an issued invoice succeeded", name)
+		}
+	}
+	got := h.Client.Invoice.GetX(ctx, inv.ID)
+	if got.Total != inv.Total || got.QueryLines().CountX(ctx) != 1 || got.QueryRepairSlip().CountX(ctx) != 1 {
+		t.Errorf("invoice changed to %+v", got)
+	}
+}
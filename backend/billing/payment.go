@@ -0,0 +1,306 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/tenant"
+	"github.com/darksford123x/app/txn"
+)
+
+var (
+	// ErrOverpayment is returned when a payment is larger than the balance
+	// due on the invoice.
+	ErrOverpayment = errors.New("billing: payment exceeds the balance due")
+	// ErrRefundExceeded is returned when a refund is larger than what has
+	// been paid on the invoice.
+	ErrRefundExceeded = errors.New("billing: refund exceeds the amount paid")
+	// ErrNoTx is returned when a payment is recorded outside a transaction.
+	ErrNoTx = errors.New("billing: payments must be recorded in a transaction")
+	// ErrInvoiceChanged is returned when another payment or credit note was
+	// settled on the invoice since it was read; Pay and CreditNote try
+	// again.
+	ErrInvoiceChanged = errors.New("billing: the invoice was paid or credited concurrently")
+)
+
+// Payment describes a payment or refund to record against an invoice.
+type Payment struct {
+	Kind      payment.Kind   `json:"kind,omitempty" enums:"payment,refund"`
+	Method    payment.Method `json:"method" enums:"cash,bank_transfer,promptpay"`
+	Amount    money.Amount   `json:"amount" swaggertype:"string" format:"decimal"`
+	Reference string         `json:"reference"`
+	PaidAt    time.Time      `json:"paid_at" format:"date-time"`
+}
+
+// Group selects how outstanding balances are summed.
+type Group string
+
+// Groups of outstanding balances.
+const (
+	ByCustomer   Group = "customer"
+	ByDepartment Group = "department"
+)
+
+// Balance is the amount still owed by a customer or a department.
+type Balance struct {
+	Customer    int          `json:"customer,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Department  string       `json:"department"`
+	Invoices    int          `json:"invoices"`
+	Outstanding money.Amount `json:"outstanding" swaggertype:"string" format:"decimal"`
+}
+
+// Pay records a payment or refund against an invoice and returns it with
+// its receipt number. It tries again when another payment took the number
+// or was settled on the invoice at the same time.
+func (b *Biller) Pay(ctx context.Context, invoiceID int, p Payment) (pm *ent.Payment, err error) {
+	if p.Kind == "" {
+		p.Kind = payment.KindPayment
+	}
+	if p.PaidAt.IsZero() {
+		p.PaidAt = time.Now()
+	}
+	for i := 0; i < issueAttempts; i++ {
+		err = txn.WithTx(ctx, b.client, func(ctx context.Context, tx *ent.Tx) error {
+			prefix := "RC"
+			if p.Kind == payment.KindRefund {
+				prefix = "RF"
+			}
+			prefix = fmt.Sprintf("%s-%d-", prefix, p.PaidAt.Year())
+			// Like those of the invoices, the sequences are shared by the
+			// organizations.
+			last := ""
+			prev, err := tx.Payment.
+				Query().
+				Where(payment.NumberHasPrefix(prefix)).
+				Order(ent.Desc(payment.FieldNumber)).
+				First(tenant.System(ctx))
+			switch {
+			case ent.IsNotFound(err):
+			case err != nil:
+				return err
+			default:
+				last = prev.Number
+			}
+			number, err := sequence(prefix, last)
+			if err != nil {
+				return err
+			}
+			builder := tx.Payment.
+				Create().
+				SetNumber(number).
+				SetKind(p.Kind).
+				SetMethod(p.Method).
+				SetAmount(p.Amount).
+				SetPaidAt(p.PaidAt).
+				SetInvoiceID(invoiceID)
+			if p.Reference != "" {
+				builder.SetReference(p.Reference)
+			}
+			pm, err = builder.Save(ctx)
+			return err
+		})
+		if !ent.IsConstraintError(err) && !errors.Is(err, ErrInvoiceChanged) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return pm, nil
+}
+
+// SettlePayment is the hook on payment creation. It rejects overpayments
+// and refunds of more than was paid, and updates the payment status of the
+// invoice in the same transaction. The amount paid checked is claimed with
+// an update that only applies while it and the amount credited are
+// unchanged, so that concurrent payments and credit notes cannot together
+// pay more than is due: the one that loses fails with ErrInvoiceChanged.
+func SettlePayment(next ent.Mutator) ent.Mutator {
+	return hook.PaymentFunc(func(ctx context.Context, m *ent.PaymentMutation) (ent.Value, error) {
+		tx := ent.TxFromContext(ctx)
+		if tx == nil {
+			return nil, ErrNoTx
+		}
+		invoiceID, ok := m.InvoiceID()
+		if !ok {
+			return nil, errors.New("billing: payment has no invoice")
+		}
+		amount, _ := m.Amount()
+		if amount <= 0 {
+			return nil, errors.New("billing: payment amount must be positive")
+		}
+		inv, err := tx.Invoice.Get(ctx, invoiceID)
+		if err != nil {
+			return nil, err
+		}
+		if inv.Kind != invoice.KindInvoice {
+			return nil, ErrNotInvoice
+		}
+		due, err := balanceDue(ctx, inv)
+		if err != nil {
+			return nil, err
+		}
+		paid := inv.AmountPaid + amount
+		if kind, _ := m.Kind(); kind == payment.KindRefund {
+			if amount > inv.AmountPaid {
+				return nil, fmt.Errorf("%w: %s paid", ErrRefundExceeded, inv.AmountPaid)
+			}
+			paid = inv.AmountPaid - amount
+		} else if paid > due {
+			return nil, fmt.Errorf("%w: %s due", ErrOverpayment, due-inv.AmountPaid)
+		}
+		n, err := tx.Invoice.
+			Update().
+			Where(
+				invoice.ID(invoiceID),
+				invoice.AmountPaid(inv.AmountPaid),
+				invoice.AmountCredited(inv.AmountCredited),
+			).
+			SetAmountPaid(paid).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n != 1 {
+			return nil, ErrInvoiceChanged
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if err := settle(ctx, tx, invoiceID); err != nil {
+			return nil, err
+		}
+		return v, nil
+	})
+}
+
+// settle recomputes the amount paid and the payment status of an invoice
+// from its payments and credit notes. A refund is due when more was paid
+// than is left to pay after the credit notes.
+func settle(ctx context.Context, tx *ent.Tx, invoiceID int) error {
+	inv, err := tx.Invoice.Get(ctx, invoiceID)
+	if err != nil {
+		return err
+	}
+	payments, err := inv.QueryPayments().All(ctx)
+	if err != nil {
+		return err
+	}
+	paid := net(payments)
+	due, err := balanceDue(ctx, inv)
+	if err != nil {
+		return err
+	}
+	status := invoice.PaymentStatusUnpaid
+	switch {
+	case paid > due:
+		status = invoice.PaymentStatusRefundDue
+	case paid >= due:
+		status = invoice.PaymentStatusPaid
+	case paid > 0:
+		status = invoice.PaymentStatusPartial
+	}
+	return tx.Invoice.
+		UpdateOneID(invoiceID).
+		SetAmountPaid(paid).
+		SetPaymentStatus(status).
+		Exec(ctx)
+}
+
+// net returns the sum of the payments less the refunds.
+func net(payments []*ent.Payment) money.Amount {
+	var paid money.Amount
+	for _, p := range payments {
+		if p.Kind == payment.KindRefund {
+			paid -= p.Amount
+		} else {
+			paid += p.Amount
+		}
+	}
+	return paid
+}
+
+// balanceDue returns the invoice total less the credit notes issued
+// against it.
+func balanceDue(ctx context.Context, inv *ent.Invoice) (money.Amount, error) {
+	credited, err := inv.QueryCreditNotes().All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	due := inv.Total
+	for _, c := range credited {
+		due -= c.Total
+	}
+	return due, nil
+}
+
+// Outstanding returns the unpaid balances of all invoices summed per
+// customer or per department, largest first.
+func (b *Biller) Outstanding(ctx context.Context, by Group) ([]Balance, error) {
+	if by != ByCustomer && by != ByDepartment {
+		return nil, fmt.Errorf("billing: unknown balance group %q", by)
+	}
+	invoices, err := b.client.Invoice.
+		Query().
+		Where(
+			invoice.KindEQ(invoice.KindInvoice),
+			invoice.PaymentStatusIn(invoice.PaymentStatusUnpaid, invoice.PaymentStatusPartial),
+		).
+		WithCustomer().
+		WithCreditNotes().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]*Balance)
+	for _, inv := range invoices {
+		owed := inv.Total - inv.AmountPaid
+		for _, c := range inv.Edges.CreditNotes {
+			owed -= c.Total
+		}
+		if owed <= 0 {
+			continue
+		}
+		key := Balance{}
+		if cu := inv.Edges.Customer; cu != nil {
+			key.Department = cu.Department
+			if by == ByCustomer {
+				key.Customer, key.Name = cu.ID, cu.Name
+			}
+		}
+		k := fmt.Sprintf("%d/%s", key.Customer, key.Department)
+		bal, ok := balances[k]
+		if !ok {
+			bal = &key
+			balances[k] = bal
+		}
+		bal.Invoices++
+		bal.Outstanding += owed
+	}
+
+	out := make([]Balance, 0, len(balances))
+	for _, bal := range balances {
+		out = append(out, *bal)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Outstanding != out[j].Outstanding {
+			return out[i].Outstanding > out[j].Outstanding
+		}
+		if out[i].Department != out[j].Department {
+			return out[i].Department < out[j].Department
+		}
+		return out[i].Customer < out[j].Customer
+	})
+	return out, nil
+}
@@ -0,0 +1,102 @@
+package billing
+
+import (
+	"context"
+	"html/template"
+	"io"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/money"
+)
+
+// Receipt is the document handed to the customer for a payment or refund.
+type Receipt struct {
+	Payment  *ent.Payment
+	Invoice  *ent.Invoice
+	Customer *ent.User
+	// PaidToDate and Balance are what had been paid and what was still
+	// owed on the invoice once the payment was recorded.
+	PaidToDate money.Amount
+	Balance    money.Amount
+}
+
+// Receipt loads the receipt of a payment.
+func (b *Biller) Receipt(ctx context.Context, paymentID int) (*Receipt, error) {
+	p, err := b.client.Payment.Get(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	inv, err := p.QueryInvoice().WithCustomer().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	credited, err := inv.QueryCreditNotes().
+		Where(invoice.CreateTimeLTE(p.CreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	due := inv.Total
+	for _, c := range credited {
+		due -= c.Total
+	}
+	earlier, err := inv.QueryPayments().
+		Where(payment.IDLTE(p.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paid := net(earlier)
+	return &Receipt{
+		Payment:    p,
+		Invoice:    inv,
+		Customer:   inv.Edges.Customer,
+		PaidToDate: paid,
+		Balance:    due - paid,
+	}, nil
+}
+
+// Refund reports whether the receipt is for a refund.
+func (r *Receipt) Refund() bool {
+	return r.Payment.Kind == payment.KindRefund
+}
+
+// WriteHTML renders the receipt as a printable HTML page.
+func (r *Receipt) WriteHTML(w io.Writer) error {
+	return receiptTemplate.Execute(w, r)
+}
+
+var receiptTemplate = template.Must(template.New("receipt").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Payment.Number}}</title>
+<style>
+body { font-family: sans-serif; max-width: 40em; margin: 2em auto; }
+table { width: 100%; border-collapse: collapse; }
+td { padding: .3em 0; }
+td.amount { text-align: right; }
+</style>
+</head>
+<body>
+<h1>{{if .Refund}}Refund Receipt{{else}}Receipt{{end}}</h1>
+<table>
+<tr><td>Receipt No.</td><td class="amount">{{.Payment.Number}}</td></tr>
+<tr><td>Date</td><td class="amount">{{.Payment.PaidAt.Format "2006-01-02 15:04"}}</td></tr>
+{{with .Customer}}<tr><td>Customer</td><td class="amount">{{.Name}}{{with .Department}} ({{.}}){{end}}</td></tr>
+{{end}}<tr><td>Invoice No.</td><td class="amount">{{.Invoice.Number}}</td></tr>
+<tr><td>Method</td><td class="amount">{{.Payment.Method}}</td></tr>
+{{with .Payment.Reference}}<tr><td>Reference</td><td class="amount">{{.}}</td></tr>
+{{end}}</table>
+<hr>
+<table>
+<tr><td>Invoice total</td><td class="amount">{{.Invoice.Total}} {{.Invoice.Currency}}</td></tr>
+<tr><td><strong>{{if .Refund}}Refunded{{else}}Amount received{{end}}</strong></td><td class="amount"><strong>{{.Payment.Amount}} {{.Invoice.Currency}}</strong></td></tr>
+<tr><td>Paid to date</td><td class="amount">{{.PaidToDate}} {{.Invoice.Currency}}</td></tr>
+<tr><td>Balance due</td><td class="amount">{{.Balance}} {{.Invoice.Currency}}</td></tr>
+</table>
+</body>
+</html>
+`))
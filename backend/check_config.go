@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/darksford123x/app/config"
+	"github.com/darksford123x/app/storage"
+)
+
+// secrets are the settings check-config does not print.
+var secrets = map[string]bool{
+	"AuthSecret":   true,
+	"SMTPPassword": true,
+	"S3AccessKey":  true,
+	"S3SecretKey":  true,
+}
+
+// runCheckConfig prints the settings of the environment and checks the
+// services they name: the database and its schema, the storage and the
+// mail server. It fails when a setting is invalid or a service cannot be
+// reached, and warns about settings unfit for production.
+func runCheckConfig(ctx context.Context, args []string) error {
+	fs := flags("check-config", "[-timeout duration]")
+	timeout := fs.Duration("timeout", 5*time.Second, "how long each service may take to answer")
+	fs.Parse(args)
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	printConfig(cfg)
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	var failed int
+	report := func(err error, warning, what, ok string) {
+		switch {
+		case err != nil:
+			failed++
+			fmt.Fprintf(w, "error\t%s\t%v\n", what, err)
+		case warning != "":
+			fmt.Fprintf(w, "warning\t%s\t%s\n", what, warning)
+		default:
+			fmt.Fprintf(w, "ok\t%s\t%s\n", what, ok)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	pending, err := checkDatabase(ctx)
+	cancel()
+	report(err, "", "database", "reachable")
+	if err == nil {
+		var warning string
+		if pending > 0 {
+			warning = fmt.Sprintf("%d changes to make, run migrate", pending)
+		}
+		report(nil, warning, "schema", "up to date")
+	}
+	if err := persistent(cfg); err != nil {
+		report(nil, "the database is in memory and lost on exit", "database", "")
+	}
+
+	switch cfg.Storage {
+	case "s3":
+		report(nil, "", "storage", "s3 bucket "+cfg.S3Bucket+", not checked")
+	default:
+		report(checkStorage(cfg.StorageDir), "", "storage", "writable directory "+cfg.StorageDir)
+	}
+
+	if cfg.SMTPHost == "" {
+		report(nil, "SMTP_HOST is not set, notifications are only logged", "mail", "")
+	} else {
+		conn, err := net.DialTimeout("tcp", net.JoinHostPort(cfg.SMTPHost, cfg.SMTPPort), *timeout)
+		if err == nil {
+			conn.Close()
+		}
+		report(err, "", "mail", "reachable")
+	}
+
+	if cfg.AuthSecret == "" {
+		report(nil, "AUTH_SECRET is not set, tokens do not survive a restart", "auth", "")
+	} else {
+		report(nil, "", "auth", "tokens signed with AUTH_SECRET")
+	}
+	w.Flush()
+
+	if failed > 0 {
+		return fmt.Errorf("%d checks failed", failed)
+	}
+	return nil
+}
+
+// printConfig prints the settings, without the secrets.
+func printConfig(cfg *config.Config) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	v := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		value := fmt.Sprint(v.Field(i).Interface())
+		switch {
+		case secrets[name] && value != "":
+			value = "(set)"
+		case name == "DatabaseURL":
+			value = redact(value)
+		}
+		fmt.Fprintf(w, "%s\t%s\n", name, value)
+	}
+	w.Flush()
+}
+
+// redact hides the password of a database URL.
+func redact(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err != nil || u.User == nil {
+		return dsn
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), "xxxxx")
+	}
+	return u.String()
+}
+
+// checkDatabase connects to the database and returns the number of
+// statements migrate would run.
+func checkDatabase(ctx context.Context) (int, error) {
+	_, drv, client, err := open()
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+	if err := drv.DB().PingContext(ctx); err != nil {
+		return 0, err
+	}
+	var b bytes.Buffer
+	if err := client.Schema.WriteTo(ctx, &b); err != nil {
+		return 0, err
+	}
+	pending := 0
+	for _, line := range strings.Split(b.String(), "\n") {
+		if line != "" && line != "BEGIN;" && line != "COMMIT;" {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+// checkStorage checks that files can be written to the directory of the
+// local storage.
+func checkStorage(dir string) error {
+	if _, err := storage.NewLocal(dir); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, ".check-config-")
+	if err != nil {
+		return errors.New("the directory is not writable")
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
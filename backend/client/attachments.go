@@ -0,0 +1,91 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+
+	"github.com/darksford123x/app/ent"
+)
+
+// UploadAttachment attaches a file to a repair slip. The file is read whole
+// before it is sent, so that the upload can be retried.
+func (c *Client) UploadAttachment(ctx context.Context, slipID int, filename string, file io.Reader) (*ent.Attachment, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	resp, err := c.send(ctx, request{
+		method:      http.MethodPost,
+		path:        fmt.Sprintf("/repairslips/%d/attachments", slipID),
+		body:        body.Bytes(),
+		contentType: w.FormDataContentType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var a ent.Attachment
+	if err := decode(resp, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ListAttachments returns the attachments of a repair slip.
+func (c *Client) ListAttachments(ctx context.Context, slipID int) ([]*ent.Attachment, error) {
+	var as []*ent.Attachment
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/repairslips/%d/attachments", slipID), nil, nil, &as); err != nil {
+		return nil, err
+	}
+	return as, nil
+}
+
+// GetAttachment returns the details of an attachment by ID.
+func (c *Client) GetAttachment(ctx context.Context, id int) (*ent.Attachment, error) {
+	var a ent.Attachment
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/attachments/%d", id), nil, nil, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// DeleteAttachment deletes an attachment and its file.
+func (c *Client) DeleteAttachment(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/attachments/%d", id), nil, nil, nil)
+}
+
+// AttachmentContent returns the file of an attachment, which the caller
+// must close, and its content type.
+func (c *Client) AttachmentContent(ctx context.Context, id int) (io.ReadCloser, string, error) {
+	return c.download(ctx, fmt.Sprintf("/attachments/%d/content", id), nil)
+}
+
+// AttachmentThumbnail returns the JPEG thumbnail of an image attachment,
+// which the caller must close.
+func (c *Client) AttachmentThumbnail(ctx context.Context, id int) (io.ReadCloser, error) {
+	body, _, err := c.download(ctx, fmt.Sprintf("/attachments/%d/thumbnail", id), nil)
+	return body, err
+}
+
+// download returns the body of a response that is not JSON, and its
+// content type.
+func (c *Client) download(ctx context.Context, path string, query url.Values) (io.ReadCloser, string, error) {
+	resp, err := c.send(ctx, request{method: http.MethodGet, path: path, query: query})
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+)
+
+// Login signs in with an email and password, and signs the following
+// requests with the token it returns.
+func (c *Client) Login(ctx context.Context, email, password string) (*controllers.Token, error) {
+	var t controllers.Token
+	err := c.do(ctx, http.MethodPost, "/auth/login", nil, controllers.Login{Email: email, Password: password}, &t)
+	if err != nil {
+		return nil, err
+	}
+	c.SetToken(t.Token)
+	return &t, nil
+}
+
+// Me returns the signed in user.
+func (c *Client) Me(ctx context.Context) (*ent.User, error) {
+	var u ent.User
+	if err := c.do(ctx, http.MethodGet, "/auth/me", nil, nil, &u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/darksford123x/app/billing"
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/invoice"
+)
+
+// InvoiceFilter filters the invoices listed; the zero values match all
+// invoices and credit notes.
+type InvoiceFilter struct {
+	Kind     invoice.Kind
+	Customer int
+}
+
+// values returns the query of a filter and a page.
+func (f InvoiceFilter) values(p Page) url.Values {
+	q := p.values()
+	setString(q, "kind", string(f.Kind))
+	setInt(q, "customer", f.Customer)
+	return q
+}
+
+// ListInvoices returns a page of the invoices and credit notes matching the
+// filter.
+func (c *Client) ListInvoices(ctx context.Context, f InvoiceFilter, p Page) ([]*ent.Invoice, error) {
+	var is []*ent.Invoice
+	if err := c.do(ctx, http.MethodGet, "/invoices", f.values(p), nil, &is); err != nil {
+		return nil, err
+	}
+	return is, nil
+}
+
+// Invoices iterates over the invoices and credit notes matching the filter.
+func (c *Client) Invoices(f InvoiceFilter) *InvoiceIterator {
+	return &InvoiceIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.ListInvoices(ctx, f, p))
+	}}}
+}
+
+// GetInvoice returns an invoice with its lines.
+func (c *Client) GetInvoice(ctx context.Context, id int) (*ent.Invoice, error) {
+	return c.invoice(ctx, http.MethodGet, fmt.Sprintf("/invoices/%d", id), nil)
+}
+
+// CreateInvoice invoices a repair slip.
+func (c *Client) CreateInvoice(ctx context.Context, slipID int, r billing.Request) (*ent.Invoice, error) {
+	return c.invoice(ctx, http.MethodPost, fmt.Sprintf("/repairslips/%d/invoice", slipID), r)
+}
+
+// PreviewInvoice computes the invoice of a repair slip without issuing it.
+func (c *Client) PreviewInvoice(ctx context.Context, slipID int, r billing.Request) (*billing.Draft, error) {
+	var d billing.Draft
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/repairslips/%d/invoice/preview", slipID), nil, r, &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// CreateCreditNote credits lines of an invoice.
+func (c *Client) CreateCreditNote(ctx context.Context, invoiceID int, cn controllers.CreditNote) (*ent.Invoice, error) {
+	return c.invoice(ctx, http.MethodPost, fmt.Sprintf("/invoices/%d/credit-notes", invoiceID), cn)
+}
+
+// invoice sends a request responded to with an invoice.
+func (c *Client) invoice(ctx context.Context, method, path string, in interface{}) (*ent.Invoice, error) {
+	var i ent.Invoice
+	if err := c.do(ctx, method, path, nil, in, &i); err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+// Pay records a payment or refund against an invoice.
+func (c *Client) Pay(ctx context.Context, invoiceID int, p billing.Payment) (*ent.Payment, error) {
+	var pm ent.Payment
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/invoices/%d/payments", invoiceID), nil, p, &pm); err != nil {
+		return nil, err
+	}
+	return &pm, nil
+}
+
+// ListPayments returns the payments and refunds of an invoice.
+func (c *Client) ListPayments(ctx context.Context, invoiceID int) ([]*ent.Payment, error) {
+	var ps []*ent.Payment
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/invoices/%d/payments", invoiceID), nil, nil, &ps); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// GetPayment returns a payment by ID.
+func (c *Client) GetPayment(ctx context.Context, id int) (*ent.Payment, error) {
+	var pm ent.Payment
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/payments/%d", id), nil, nil, &pm); err != nil {
+		return nil, err
+	}
+	return &pm, nil
+}
+
+// Receipt returns the HTML receipt of a payment, which the caller must
+// close.
+func (c *Client) Receipt(ctx context.Context, paymentID int) (io.ReadCloser, error) {
+	body, _, err := c.download(ctx, fmt.Sprintf("/payments/%d/receipt", paymentID), nil)
+	return body, err
+}
+
+// ListBalances returns what is still owed on invoices, grouped by customer
+// or department; by customer when by is empty.
+func (c *Client) ListBalances(ctx context.Context, by billing.Group) ([]billing.Balance, error) {
+	q := url.Values{}
+	setString(q, "by", string(by))
+	var bs []billing.Balance
+	if err := c.do(ctx, http.MethodGet, "/balances", q, nil, &bs); err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
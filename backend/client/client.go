@@ -0,0 +1,236 @@
+// Package client is a typed Go client of the API. Its methods mirror the
+// endpoints of the controllers and take and return the same types, the
+// entities of the ent package and the request structs of the controllers.
+//
+// Requests refused with 429 or 503 are retried with exponential backoff.
+// Failed requests return an *Error, which matches the Err variables of the
+// package with errors.Is, e.g. errors.Is(err, client.ErrNotFound).
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options configure a client. The zero values select the defaults.
+type Options struct {
+	// HTTPClient sends the requests; http.DefaultClient by default.
+	HTTPClient *http.Client
+	// Token is the bearer token the requests are signed with. Login sets it
+	// as well.
+	Token string
+	// Retries is how many times a request refused with 429 or 503 is sent
+	// again; 3 by default, and none when negative.
+	Retries int
+	// Backoff is the delay before the first retry, 500ms by default; it
+	// doubles with every retry up to MaxBackoff, 30s by default. A
+	// Retry-After header sent by the server takes precedence.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// Client is a client of the API. It is safe for concurrent use.
+type Client struct {
+	baseURL string
+	http    *http.Client
+	opts    Options
+
+	mu    sync.RWMutex
+	token string
+}
+
+// New creates a client of the API at baseURL, e.g.
+// "http://localhost:8080/api/v1".
+func New(baseURL string, opts Options) *Client {
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	switch {
+	case opts.Retries == 0:
+		opts.Retries = 3
+	case opts.Retries < 0:
+		opts.Retries = 0
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = 500 * time.Millisecond
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 30 * time.Second
+	}
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		http:    opts.HTTPClient,
+		opts:    opts,
+		token:   opts.Token,
+	}
+}
+
+// Token returns the bearer token the requests are signed with.
+func (c *Client) Token() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token
+}
+
+// SetToken sets the bearer token the requests are signed with; an empty
+// token sends them unauthenticated.
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	c.token = token
+	c.mu.Unlock()
+}
+
+// request is a request to send, possibly several times.
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	body        []byte
+	contentType string
+	header      http.Header
+}
+
+// do sends a request with a JSON body, unless in is nil, and decodes the JSON
+// response into out, unless out is nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	r := request{method: method, path: path, query: query}
+	if in != nil {
+		body, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		r.body = body
+		r.contentType = "application/json"
+	}
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decode(resp, out)
+}
+
+// decode decodes a JSON response into out, or discards it if out is nil.
+func decode(resp *http.Response, out interface{}) error {
+	if out == nil {
+		_, err := io.Copy(ioutil.Discard, resp.Body)
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// send sends a request until it succeeds or fails for a reason other than
+// the server being overloaded, and returns the successful response.
+func (c *Client) send(ctx context.Context, r request) (*http.Response, error) {
+	u := c.baseURL + r.path
+	if len(r.query) > 0 {
+		u += "?" + r.query.Encode()
+	}
+	for attempt := 0; ; attempt++ {
+		var body io.Reader
+		if r.body != nil {
+			body = bytes.NewReader(r.body)
+		}
+		req, err := http.NewRequestWithContext(ctx, r.method, u, body)
+		if err != nil {
+			return nil, err
+		}
+		for key, values := range r.header {
+			req.Header[key] = values
+		}
+		if r.contentType != "" {
+			req.Header.Set("Content-Type", r.contentType)
+		}
+		if token := c.Token(); token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode < 300 {
+			return resp, nil
+		}
+
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+		if !retry || attempt >= c.opts.Retries {
+			defer resp.Body.Close()
+			return nil, responseError(resp)
+		}
+		wait := c.backoff(attempt, resp.Header.Get("Retry-After"))
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before a retry: the delay the server
+// asked for in Retry-After, or else the backoff doubled with every attempt.
+func (c *Client) backoff(attempt int, retryAfter string) time.Duration {
+	if s, err := strconv.Atoi(retryAfter); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second
+	}
+	if t, err := http.ParseTime(retryAfter); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+		return 0
+	}
+	d := c.opts.Backoff
+	for i := 0; i < attempt && d < c.opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > c.opts.MaxBackoff {
+		d = c.opts.MaxBackoff
+	}
+	return d + time.Duration(rand.Int63n(int64(d)/4+1))
+}
+
+// Page selects a page of a list. The server returns 10 items when Limit is
+// zero.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+// values returns the query of a page.
+func (p Page) values() url.Values {
+	q := url.Values{}
+	if p.Limit > 0 {
+		q.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Offset > 0 {
+		q.Set("offset", strconv.Itoa(p.Offset))
+	}
+	return q
+}
+
+// setInt sets a query parameter when n is not zero.
+func setInt(q url.Values, key string, n int) {
+	if n != 0 {
+		q.Set(key, strconv.Itoa(n))
+	}
+}
+
+// setString sets a query parameter when s is not empty.
+func setString(q url.Values, key, s string) {
+	if s != "" {
+		q.Set(key, s)
+	}
+}
@@ -0,0 +1,329 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/client"
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/gql"
+)
+
+// newClient starts a server of the handler and returns a client of it that
+// retries quickly.
+func newClient(t *testing.T, handler http.Handler) *client.Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return client.New(srv.URL+"/api/v1", client.Options{Backoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestLoginSignsRequests(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/auth/login", func(w http.ResponseWriter, r *http.Request) {
+		var l controllers.Login
+		if err := json.NewDecoder(r.Body).Decode(&l); err != nil || r.Method != http.MethodPost {
+			writeJSON(w, 400, controllers.ErrorResponse{Error: "bad login"})
+			return
+		}
+		if l.Email != "ann@example.com" || l.Password != "password1" {
+			writeJSON(w, 401, controllers.ErrorResponse{Error: "invalid email or password"})
+			return
+		}
+		writeJSON(w, 200, controllers.Token{Token: "secret", ExpiresAt: time.Now().Add(time.Hour), User: &ent.User{ID: 1}})
+	})
+	mux.HandleFunc("/api/v1/auth/me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			writeJSON(w, 401, controllers.ErrorResponse{Error: "missing or invalid token"})
+			return
+		}
+		writeJSON(w, 200, &ent.User{ID: 1, Name: "Ann"})
+	})
+	c := newClient(t, mux)
+	ctx := context.Background()
+
+	if _, err := c.Me(ctx); !errors.Is(err, client.ErrUnauthenticated) {
+		t.Fatalf("Me before login: got %v, want ErrUnauthenticated", err)
+	}
+	if _, err := c.Login(ctx, "ann@example.com", "wrong-password"); !errors.Is(err, client.ErrUnauthenticated) {
+		t.Fatalf("Login with a wrong password: got %v, want ErrUnauthenticated", err)
+	}
+	if _, err := c.Login(ctx, "ann@example.com", "password1"); err != nil {
+		t.Fatal(err)
+	}
+	if c.Token() != "secret" {
+		t.Fatalf("token = %q, want secret", c.Token())
+	}
+	u, err := c.Me(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.ID != 1 || u.Name != "Ann" {
+		t.Fatalf("Me = %+v", u)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var calls int32
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			writeJSON(w, 429, controllers.ErrorResponse{Error: "slow down"})
+		case 2:
+			writeJSON(w, 503, controllers.ErrorResponse{Error: "unavailable"})
+		default:
+			writeJSON(w, 200, &ent.User{ID: 7})
+		}
+	}))
+
+	u, err := c.GetUser(context.Background(), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.ID != 7 {
+		t.Fatalf("user ID = %d, want 7", u.ID)
+	}
+	if calls != 3 {
+		t.Fatalf("%d requests, want 3", calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls int32
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeJSON(w, 503, controllers.ErrorResponse{Error: "down for maintenance"})
+	}))
+
+	_, err := c.GetUser(context.Background(), 1)
+	if !errors.Is(err, client.ErrUnavailable) {
+		t.Fatalf("got %v, want ErrUnavailable", err)
+	}
+	if calls != 4 {
+		t.Fatalf("%d requests, want 4", calls)
+	}
+}
+
+func TestRetryStopsWithContext(t *testing.T) {
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		writeJSON(w, 429, controllers.ErrorResponse{Error: "slow down"})
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := c.GetUser(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users/1":
+			writeJSON(w, 404, controllers.ErrorResponse{Error: "user not found"})
+		case "/api/v1/users/2":
+			writeJSON(w, 403, controllers.ErrorResponse{Error: "forbidden"})
+		default:
+			http.Error(w, "upstream failure", 500)
+		}
+	}))
+	ctx := context.Background()
+
+	_, err := c.GetUser(ctx, 1)
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("got %v, want ErrNotFound", err)
+	}
+	var e *client.Error
+	if !errors.As(err, &e) || e.StatusCode != 404 || e.Message != "user not found" {
+		t.Fatalf("got %#v, want a 404 with the message of the body", err)
+	}
+	if errors.Is(err, client.ErrForbidden) {
+		t.Fatal("a 404 matches ErrForbidden")
+	}
+
+	if err := c.DeleteUser(ctx, 2); !errors.Is(err, client.ErrForbidden) {
+		t.Fatalf("got %v, want ErrForbidden", err)
+	}
+
+	_, err = c.GetUser(ctx, 3)
+	if !errors.Is(err, client.ErrInternal) || !errors.As(err, &e) || e.Message != "upstream failure" {
+		t.Fatalf("got %v, want ErrInternal with the text of the body", err)
+	}
+}
+
+func TestIterator(t *testing.T) {
+	const total = 250
+	var calls int32
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		users := []*ent.User{}
+		for id := offset + 1; id <= total && id <= offset+limit; id++ {
+			users = append(users, &ent.User{ID: id})
+		}
+		writeJSON(w, 200, users)
+	}))
+	ctx := context.Background()
+
+	it := c.Users()
+	for want := 1; ; want++ {
+		u, err := it.Next(ctx)
+		if err == client.Done {
+			if want != total+1 {
+				t.Fatalf("iterated over %d users, want %d", want-1, total)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.ID != want {
+			t.Fatalf("user ID = %d, want %d", u.ID, want)
+		}
+	}
+	if calls != 3 {
+		t.Fatalf("%d requests, want 3", calls)
+	}
+	if _, err := it.Next(ctx); err != client.Done {
+		t.Fatalf("Next after the end: got %v, want Done", err)
+	}
+}
+
+func TestRepairSlipFilter(t *testing.T) {
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "breached=false&limit=5&offset=10&priority=high&status=open"
+		if got := r.URL.RawQuery; got != want {
+			writeJSON(w, 400, controllers.ErrorResponse{Error: "query " + got})
+			return
+		}
+		writeJSON(w, 200, []*ent.RepairSlip{{ID: 1}})
+	}))
+	breached := false
+	f := client.RepairSlipFilter{Status: "open", Priority: "high", Breached: &breached}
+
+	slips, err := c.ListRepairSlips(context.Background(), f, client.Page{Limit: 5, Offset: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slips) != 1 {
+		t.Fatalf("%d slips, want 1", len(slips))
+	}
+}
+
+func TestUploadAttachment(t *testing.T) {
+	var calls int32
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The upload is sent again after a 503.
+		if atomic.AddInt32(&calls, 1) == 1 {
+			writeJSON(w, 503, controllers.ErrorResponse{Error: "unavailable"})
+			return
+		}
+		if r.URL.Path != "/api/v1/repairslips/3/attachments" {
+			http.NotFound(w, r)
+			return
+		}
+		f, h, err := r.FormFile("file")
+		if err != nil {
+			writeJSON(w, 400, controllers.ErrorResponse{Error: err.Error()})
+			return
+		}
+		body, _ := ioutil.ReadAll(f)
+		writeJSON(w, 200, &ent.Attachment{ID: 9, Filename: h.Filename, Size: int64(len(body))})
+	}))
+
+	a, err := c.UploadAttachment(context.Background(), 3, "photo.jpg", strings.NewReader("jpeg data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.ID != 9 || a.Filename != "photo.jpg" || a.Size != int64(len("jpeg data")) {
+		t.Fatalf("attachment = %+v", a)
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, 200, map[string]interface{}{
+			"data":   map[string]interface{}{"user": map[string]interface{}{"name": "Ann"}},
+			"errors": []map[string]interface{}{{"message": "forbidden", "path": []string{"deleteUser"}}},
+		})
+	}))
+	var data struct {
+		User struct{ Name string }
+	}
+
+	err := c.GraphQL(context.Background(), gql.Request{Query: "{ user(id: 1) { name } }"}, &data)
+	var errs client.GraphQLErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Message != "forbidden" {
+		t.Fatalf("got %v, want the errors of the result", err)
+	}
+	if data.User.Name != "Ann" {
+		t.Fatalf("data = %+v, want the partial data decoded", data)
+	}
+}
+
+func TestEventsResume(t *testing.T) {
+	var calls int32
+	c := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			fmt.Fprint(w, "retry: 1\n\n: ping\n\n")
+			fmt.Fprint(w, "id: 1\nevent: user.created\ndata: {\"event_id\":\"1\",\"type\":\"user.created\",\"id\":5,\"data\":{\"id\":5,\"name\":\"Ann\"}}\n\n")
+		default:
+			if id := r.Header.Get("Last-Event-ID"); id != "1" {
+				http.Error(w, "Last-Event-ID "+id, 400)
+				return
+			}
+			fmt.Fprint(w, "id: 2\nevent: user.deleted\ndata: {\"event_id\":\"2\",\"type\":\"user.deleted\",\"id\":5}\n\n")
+		}
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	s, err := c.Events(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	e, err := s.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var u ent.User
+	if err := e.Decode(&u); err != nil {
+		t.Fatal(err)
+	}
+	if e.Type != "user.created" || u.Name != "Ann" {
+		t.Fatalf("first event = %+v, user %+v", e, u)
+	}
+
+	// The first response ends, and the stream resumes after event 1.
+	e, err = s.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Type != "user.deleted" || e.ID != 5 || s.LastEventID() != "2" {
+		t.Fatalf("second event = %+v, last ID %q", e, s.LastEventID())
+	}
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+)
+
+// PostComment comments on a repair slip.
+func (c *Client) PostComment(ctx context.Context, slipID int, cm controllers.Comment) (*ent.Comment, error) {
+	return c.comment(ctx, http.MethodPost, fmt.Sprintf("/repairslips/%d/comments", slipID), cm)
+}
+
+// ListComments returns the comments on a repair slip the caller may read.
+func (c *Client) ListComments(ctx context.Context, slipID int) ([]*ent.Comment, error) {
+	var cs []*ent.Comment
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/repairslips/%d/comments", slipID), nil, nil, &cs); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// GetComment returns a comment by ID.
+func (c *Client) GetComment(ctx context.Context, id int) (*ent.Comment, error) {
+	return c.comment(ctx, http.MethodGet, fmt.Sprintf("/comments/%d", id), nil)
+}
+
+// EditComment changes the body of a comment, keeping the old one as a
+// revision.
+func (c *Client) EditComment(ctx context.Context, id int, body string) (*ent.Comment, error) {
+	return c.comment(ctx, http.MethodPut, fmt.Sprintf("/comments/%d", id), controllers.CommentEdit{Body: body})
+}
+
+// ListCommentRevisions returns the earlier bodies of a comment.
+func (c *Client) ListCommentRevisions(ctx context.Context, id int) ([]*ent.CommentRevision, error) {
+	var rs []*ent.CommentRevision
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/comments/%d/revisions", id), nil, nil, &rs); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// comment sends a request responded to with a comment.
+func (c *Client) comment(ctx context.Context, method, path string, in interface{}) (*ent.Comment, error) {
+	var cm ent.Comment
+	if err := c.do(ctx, method, path, nil, in, &cm); err != nil {
+		return nil, err
+	}
+	return &cm, nil
+}
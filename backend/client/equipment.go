@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/labels"
+)
+
+// ListEquipment returns a page of equipment.
+func (c *Client) ListEquipment(ctx context.Context, p Page) ([]*ent.Equipment, error) {
+	var es []*ent.Equipment
+	if err := c.do(ctx, http.MethodGet, "/equipment", p.values(), nil, &es); err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+// Equipment iterates over all equipment.
+func (c *Client) Equipment() *EquipmentIterator {
+	return &EquipmentIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.ListEquipment(ctx, p))
+	}}}
+}
+
+// CreateEquipment creates equipment.
+func (c *Client) CreateEquipment(ctx context.Context, e controllers.Equipment) (*ent.Equipment, error) {
+	return c.equipment(ctx, http.MethodPost, "/equipment", e)
+}
+
+// GetEquipment returns equipment by ID.
+func (c *Client) GetEquipment(ctx context.Context, id int) (*ent.Equipment, error) {
+	return c.equipment(ctx, http.MethodGet, fmt.Sprintf("/equipment/%d", id), nil)
+}
+
+// GetEquipmentByTag returns equipment by its asset tag, as scanned from its
+// label.
+func (c *Client) GetEquipmentByTag(ctx context.Context, tag string) (*ent.Equipment, error) {
+	return c.equipment(ctx, http.MethodGet, "/equipment/by-tag/"+url.PathEscape(tag), nil)
+}
+
+// EquipmentBarcode returns the asset tag of equipment rendered as a code of
+// a symbology, in the format "png" or "svg", at scale pixels per module,
+// which the caller must close. The zero values select a QR code in PNG at
+// the default scale.
+func (c *Client) EquipmentBarcode(ctx context.Context, id int, s labels.Symbology, format string, scale int) (io.ReadCloser, error) {
+	q := url.Values{}
+	setString(q, "type", string(s))
+	setString(q, "format", format)
+	setInt(q, "scale", scale)
+	body, _, err := c.download(ctx, fmt.Sprintf("/equipment/%d/barcode", id), q)
+	return body, err
+}
+
+// LabelFilter selects the equipment whose labels are printed; the zero
+// value selects all the equipment.
+type LabelFilter struct {
+	Equipment []int
+	// Location selects the equipment at the location or under it.
+	Location int
+}
+
+// EquipmentLabels returns the PDF sheets of the labels of equipment, which
+// the caller must close.
+func (c *Client) EquipmentLabels(ctx context.Context, f LabelFilter) (io.ReadCloser, error) {
+	q := url.Values{}
+	for _, id := range f.Equipment {
+		q.Add("equipment", strconv.Itoa(id))
+	}
+	setInt(q, "location", f.Location)
+	body, _, err := c.download(ctx, "/equipment/labels", q)
+	return body, err
+}
+
+// DeleteEquipment deletes equipment.
+func (c *Client) DeleteEquipment(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/equipment/%d", id), nil, nil, nil)
+}
+
+// SetWarranty sets the warranty of equipment.
+func (c *Client) SetWarranty(ctx context.Context, id int, w controllers.Warranty) (*ent.Equipment, error) {
+	return c.equipment(ctx, http.MethodPut, fmt.Sprintf("/equipment/%d/warranty", id), w)
+}
+
+// MoveEquipment moves equipment to a location.
+func (c *Client) MoveEquipment(ctx context.Context, id int, m controllers.EquipmentMove) (*ent.EquipmentMove, error) {
+	var mv ent.EquipmentMove
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/equipment/%d/moves", id), nil, m, &mv); err != nil {
+		return nil, err
+	}
+	return &mv, nil
+}
+
+// ListEquipmentMoves returns a page of the moves of equipment, latest first.
+func (c *Client) ListEquipmentMoves(ctx context.Context, id int, p Page) ([]*ent.EquipmentMove, error) {
+	var ms []*ent.EquipmentMove
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/equipment/%d/moves", id), p.values(), nil, &ms); err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// EquipmentMoves iterates over the moves of equipment.
+func (c *Client) EquipmentMoves(id int) *EquipmentMoveIterator {
+	return &EquipmentMoveIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.ListEquipmentMoves(ctx, id, p))
+	}}}
+}
+
+// ListExpiringWarranties returns the equipment whose warranty expires within
+// the given number of days; 30 when days is zero.
+func (c *Client) ListExpiringWarranties(ctx context.Context, days int) ([]*ent.Equipment, error) {
+	q := url.Values{}
+	setInt(q, "days", days)
+	var es []*ent.Equipment
+	if err := c.do(ctx, http.MethodGet, "/warranties/expiring", q, nil, &es); err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+// ListWarrantyTerms returns the warranty terms.
+func (c *Client) ListWarrantyTerms(ctx context.Context) ([]*ent.WarrantyTerm, error) {
+	var ts []*ent.WarrantyTerm
+	if err := c.do(ctx, http.MethodGet, "/warranty-terms", nil, nil, &ts); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// CreateWarrantyTerm creates a warranty term.
+func (c *Client) CreateWarrantyTerm(ctx context.Context, t *ent.WarrantyTerm) (*ent.WarrantyTerm, error) {
+	var created ent.WarrantyTerm
+	if err := c.do(ctx, http.MethodPost, "/warranty-terms", nil, t, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// equipment sends a request responded to with equipment.
+func (c *Client) equipment(ctx context.Context, method, path string, in interface{}) (*ent.Equipment, error) {
+	var e ent.Equipment
+	if err := c.do(ctx, method, path, nil, in, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// The errors an *Error matches by its status code.
+var (
+	ErrBadRequest      = errors.New("client: bad request")
+	ErrUnauthenticated = errors.New("client: unauthenticated")
+	ErrForbidden       = errors.New("client: forbidden")
+	ErrNotFound        = errors.New("client: not found")
+	ErrConflict        = errors.New("client: conflict")
+	ErrTooLarge        = errors.New("client: request too large")
+	ErrUnsupportedType = errors.New("client: unsupported media type")
+	ErrTooManyRequests = errors.New("client: too many requests")
+	ErrInternal        = errors.New("client: internal server error")
+	ErrUnavailable     = errors.New("client: service unavailable")
+	statusErrors       = map[int]error{
+		http.StatusBadRequest:            ErrBadRequest,
+		http.StatusUnauthorized:          ErrUnauthenticated,
+		http.StatusForbidden:             ErrForbidden,
+		http.StatusNotFound:              ErrNotFound,
+		http.StatusConflict:              ErrConflict,
+		http.StatusRequestEntityTooLarge: ErrTooLarge,
+		http.StatusUnsupportedMediaType:  ErrUnsupportedType,
+		http.StatusTooManyRequests:       ErrTooManyRequests,
+		http.StatusInternalServerError:   ErrInternal,
+		http.StatusServiceUnavailable:    ErrUnavailable,
+	}
+)
+
+// Error is a failed request: the status code of the response and the error
+// message of its body, as in controllers.ErrorResponse.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("client: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Is reports whether target is the error of the status code.
+func (e *Error) Is(target error) bool {
+	err, ok := statusErrors[e.StatusCode]
+	return ok && err == target
+}
+
+// maxErrorBody bounds how much of an error response is read.
+const maxErrorBody = 64 << 10
+
+// responseError returns the error of a failed response. Bodies that are not
+// an error response are used as the message as they are.
+func responseError(resp *http.Response) error {
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if err != nil {
+		return err
+	}
+	e := &Error{StatusCode: resp.StatusCode}
+	var r struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(body, &r) == nil && r.Error != "" {
+		e.Message = r.Error
+	} else {
+		e.Message = strings.TrimSpace(string(body))
+	}
+	if e.Message == "" {
+		e.Message = http.StatusText(resp.StatusCode)
+	}
+	return e
+}
@@ -0,0 +1,235 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/stream"
+	"golang.org/x/net/websocket"
+)
+
+// Event is an event of the live stream, as in stream.Message. Data holds the
+// entity encoded as JSON, to be decoded with Decode.
+type Event struct {
+	// EventID is the ID to resume the stream after.
+	EventID string `json:"event_id"`
+	// Type is the entity and the operation, e.g. "repairslip.updated", or
+	// stream.Reset when events were missed.
+	Type   string          `json:"type"`
+	Entity string          `json:"entity"`
+	Op     string          `json:"op"`
+	ID     int             `json:"id"`
+	Fields []string        `json:"fields,omitempty"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	Time   time.Time       `json:"time"`
+}
+
+// Reset reports whether events were missed and the data should be reloaded.
+func (e *Event) Reset() bool {
+	return e.Type == stream.Reset
+}
+
+// Decode decodes the entity of the event, e.g. into an *ent.RepairSlip.
+func (e *Event) Decode(v interface{}) error {
+	return json.Unmarshal(e.Data, v)
+}
+
+// EventStream reads the Server-Sent Events of /events. When the connection
+// drops it reconnects and resumes after the last event read.
+type EventStream struct {
+	c      *Client
+	ctx    context.Context
+	lastID string
+	retry  time.Duration
+	body   io.ReadCloser
+	r      *bufio.Reader
+}
+
+// Events opens the live event stream, resuming after lastEventID unless it
+// is empty. The stream ends when ctx is done.
+func (c *Client) Events(ctx context.Context, lastEventID string) (*EventStream, error) {
+	s := &EventStream{c: c, ctx: ctx, lastID: lastEventID, retry: 3 * time.Second}
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// LastEventID returns the ID of the last event read.
+func (s *EventStream) LastEventID() string {
+	return s.lastID
+}
+
+// Close closes the stream.
+func (s *EventStream) Close() error {
+	if s.body == nil {
+		return nil
+	}
+	err := s.body.Close()
+	s.body = nil
+	return err
+}
+
+// connect opens the stream after the last event read.
+func (s *EventStream) connect() error {
+	header := http.Header{"Accept": {"text/event-stream"}}
+	if s.lastID != "" {
+		header.Set("Last-Event-ID", s.lastID)
+	}
+	resp, err := s.c.send(s.ctx, request{method: http.MethodGet, path: "/events", header: header})
+	if err != nil {
+		return err
+	}
+	s.body = resp.Body
+	s.r = bufio.NewReader(resp.Body)
+	return nil
+}
+
+// Next returns the next event, waiting for it. It returns an error when ctx
+// is done or the stream cannot be resumed.
+func (s *EventStream) Next() (*Event, error) {
+	for {
+		if s.body == nil {
+			if err := s.connect(); err != nil {
+				return nil, err
+			}
+		}
+		e, err := s.read()
+		if err == nil {
+			return e, nil
+		}
+		s.Close()
+		if s.ctx.Err() != nil {
+			return nil, s.ctx.Err()
+		}
+		timer := time.NewTimer(s.retry)
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return nil, s.ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// read reads events until one with data, skipping comments and the retry
+// delay, which it keeps.
+func (s *EventStream) read() (*Event, error) {
+	var id string
+	var data []string
+	for {
+		line, err := s.r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			if len(data) == 0 {
+				continue
+			}
+			var e Event
+			if err := json.Unmarshal([]byte(strings.Join(data, "\n")), &e); err != nil {
+				return nil, err
+			}
+			if id != "" {
+				s.lastID = id
+			}
+			return &e, nil
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		field, value := line, ""
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch field {
+		case "id":
+			id = value
+		case "data":
+			data = append(data, value)
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil {
+				s.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
+
+// EventSocket reads the events of /events/ws.
+type EventSocket struct {
+	ws *websocket.Conn
+}
+
+// EventsWebSocket opens the live event stream over a WebSocket, resuming
+// after lastEventID unless it is empty. Unlike EventStream it does not
+// reconnect.
+func (c *Client) EventsWebSocket(ctx context.Context, lastEventID string) (*EventSocket, error) {
+	u, err := url.Parse(c.baseURL + "/events/ws")
+	if err != nil {
+		return nil, err
+	}
+	origin := &url.URL{Scheme: u.Scheme, Host: u.Host}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+	if lastEventID != "" {
+		u.RawQuery = url.Values{"last_event_id": {lastEventID}}.Encode()
+	}
+	config, err := websocket.NewConfig(u.String(), origin.String())
+	if err != nil {
+		return nil, err
+	}
+	if token := c.Token(); token != "" {
+		config.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		if u.Scheme == "wss" {
+			host += ":443"
+		} else {
+			host += ":80"
+		}
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "wss" {
+		conn = tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+	}
+	ws, err := websocket.NewClient(config, conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &EventSocket{ws: ws}, nil
+}
+
+// Next returns the next event, waiting for it.
+func (s *EventSocket) Next() (*Event, error) {
+	var e Event
+	if err := websocket.JSON.Receive(s.ws, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// Close closes the WebSocket.
+func (s *EventSocket) Close() error {
+	return s.ws.Close()
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/darksford123x/app/ent/gql"
+)
+
+// GraphQLError is an error reported in the result of a GraphQL query.
+type GraphQLError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// GraphQLErrors are the errors of a GraphQL query that failed, in part or
+// whole.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Message
+	}
+	return "client: graphql: " + strings.Join(msgs, "; ")
+}
+
+// GraphQL runs a GraphQL query or mutation and decodes its data into out,
+// unless out is nil. The errors of the result are returned as GraphQLErrors,
+// after the data is decoded, as a query may partly succeed.
+func (c *Client) GraphQL(ctx context.Context, r gql.Request, out interface{}) error {
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors GraphQLErrors   `json:"errors"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/graphql", nil, r, &result); err != nil {
+		return err
+	}
+	if out != nil && len(result.Data) > 0 && string(result.Data) != "null" {
+		if err := json.Unmarshal(result.Data, out); err != nil {
+			return err
+		}
+	}
+	if len(result.Errors) > 0 {
+		return result.Errors
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/search"
+)
+
+// Done is returned by the iterators when there are no more items.
+var Done = errors.New("client: no more items")
+
+// pageSize is the number of items the iterators fetch per request.
+const pageSize = 100
+
+// iterator fetches the pages of a list as they are needed.
+type iterator struct {
+	fetch  func(ctx context.Context, p Page) ([]interface{}, error)
+	items  []interface{}
+	offset int
+	done   bool
+}
+
+// next returns the next item, fetching the next page when the current one is
+// used up. A page shorter than asked for is the last.
+func (it *iterator) next(ctx context.Context) (interface{}, error) {
+	for len(it.items) == 0 {
+		if it.done {
+			return nil, Done
+		}
+		items, err := it.fetch(ctx, Page{Limit: pageSize, Offset: it.offset})
+		if err != nil {
+			return nil, err
+		}
+		it.offset += len(items)
+		it.done = len(items) < pageSize
+		it.items = items
+	}
+	v := it.items[0]
+	it.items = it.items[1:]
+	return v, nil
+}
+
+// items returns the elements of a page returned by a list method.
+func items(page interface{}, err error) ([]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(page)
+	items := make([]interface{}, v.Len())
+	for i := range items {
+		items[i] = v.Index(i).Interface()
+	}
+	return items, nil
+}
+
+// UserIterator iterates over users.
+type UserIterator struct{ it iterator }
+
+// Next returns the next user, or Done.
+func (i *UserIterator) Next(ctx context.Context) (*ent.User, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ent.User), nil
+}
+
+// RepairSlipIterator iterates over repair slips.
+type RepairSlipIterator struct{ it iterator }
+
+// Next returns the next repair slip, or Done.
+func (i *RepairSlipIterator) Next(ctx context.Context) (*ent.RepairSlip, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ent.RepairSlip), nil
+}
+
+// EquipmentIterator iterates over equipment.
+type EquipmentIterator struct{ it iterator }
+
+// Next returns the next equipment, or Done.
+func (i *EquipmentIterator) Next(ctx context.Context) (*ent.Equipment, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ent.Equipment), nil
+}
+
+// EquipmentMoveIterator iterates over the moves of equipment.
+type EquipmentMoveIterator struct{ it iterator }
+
+// Next returns the next move, or Done.
+func (i *EquipmentMoveIterator) Next(ctx context.Context) (*ent.EquipmentMove, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ent.EquipmentMove), nil
+}
+
+// PartIterator iterates over parts.
+type PartIterator struct{ it iterator }
+
+// Next returns the next part, or Done.
+func (i *PartIterator) Next(ctx context.Context) (*ent.Part, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ent.Part), nil
+}
+
+// StockMovementIterator iterates over stock movements.
+type StockMovementIterator struct{ it iterator }
+
+// Next returns the next stock movement, or Done.
+func (i *StockMovementIterator) Next(ctx context.Context) (*ent.StockMovement, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ent.StockMovement), nil
+}
+
+// InvoiceIterator iterates over invoices.
+type InvoiceIterator struct{ it iterator }
+
+// Next returns the next invoice, or Done.
+func (i *InvoiceIterator) Next(ctx context.Context) (*ent.Invoice, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ent.Invoice), nil
+}
+
+// JobIterator iterates over jobs.
+type JobIterator struct{ it iterator }
+
+// Next returns the next job, or Done.
+func (i *JobIterator) Next(ctx context.Context) (*ent.Job, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ent.Job), nil
+}
+
+// WebhookDeliveryIterator iterates over webhook deliveries.
+type WebhookDeliveryIterator struct{ it iterator }
+
+// Next returns the next delivery, or Done.
+func (i *WebhookDeliveryIterator) Next(ctx context.Context) (*ent.WebhookDelivery, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ent.WebhookDelivery), nil
+}
+
+// SearchIterator iterates over search results.
+type SearchIterator struct{ it iterator }
+
+// Next returns the next result, or Done.
+func (i *SearchIterator) Next(ctx context.Context) (*search.Result, error) {
+	v, err := i.it.next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := v.(search.Result)
+	return &r, nil
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/job"
+)
+
+// JobFilter filters the jobs listed; the zero values match all jobs.
+type JobFilter struct {
+	Status job.Status
+	Kind   string
+}
+
+// values returns the query of a filter and a page.
+func (f JobFilter) values(p Page) url.Values {
+	q := p.values()
+	setString(q, "status", string(f.Status))
+	setString(q, "kind", f.Kind)
+	return q
+}
+
+// ListJobs returns a page of the background jobs matching the filter.
+func (c *Client) ListJobs(ctx context.Context, f JobFilter, p Page) ([]*ent.Job, error) {
+	var js []*ent.Job
+	if err := c.do(ctx, http.MethodGet, "/jobs", f.values(p), nil, &js); err != nil {
+		return nil, err
+	}
+	return js, nil
+}
+
+// Jobs iterates over the background jobs matching the filter.
+func (c *Client) Jobs(f JobFilter) *JobIterator {
+	return &JobIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.ListJobs(ctx, f, p))
+	}}}
+}
+
+// GetJob returns a background job by ID.
+func (c *Client) GetJob(ctx context.Context, id int) (*ent.Job, error) {
+	return c.job(ctx, http.MethodGet, fmt.Sprintf("/jobs/%d", id))
+}
+
+// RetryJob runs a dead or cancelled job again.
+func (c *Client) RetryJob(ctx context.Context, id int) (*ent.Job, error) {
+	return c.job(ctx, http.MethodPost, fmt.Sprintf("/jobs/%d/retry", id))
+}
+
+// CancelJob cancels a pending job.
+func (c *Client) CancelJob(ctx context.Context, id int) (*ent.Job, error) {
+	return c.job(ctx, http.MethodPost, fmt.Sprintf("/jobs/%d/cancel", id))
+}
+
+// job sends a request responded to with a job.
+func (c *Client) job(ctx context.Context, method, path string) (*ent.Job, error) {
+	var j ent.Job
+	if err := c.do(ctx, method, path, nil, nil, &j); err != nil {
+		return nil, err
+	}
+	return &j, nil
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+)
+
+// ListLocations returns the locations of a kind, in a parent, or both; all
+// of them when kind is empty and parent zero.
+func (c *Client) ListLocations(ctx context.Context, kind string, parent int) ([]*ent.Location, error) {
+	q := url.Values{}
+	setString(q, "kind", kind)
+	setInt(q, "parent", parent)
+	var ls []*ent.Location
+	if err := c.do(ctx, http.MethodGet, "/locations", q, nil, &ls); err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
+
+// CreateLocation creates a location.
+func (c *Client) CreateLocation(ctx context.Context, l controllers.Location) (*ent.Location, error) {
+	return c.location(ctx, http.MethodPost, "/locations", l)
+}
+
+// GetLocation returns a location by ID, with its parent and children.
+func (c *Client) GetLocation(ctx context.Context, id int) (*ent.Location, error) {
+	return c.location(ctx, http.MethodGet, fmt.Sprintf("/locations/%d", id), nil)
+}
+
+// UpdateLocation replaces the name, kind and parent of a location.
+func (c *Client) UpdateLocation(ctx context.Context, id int, l controllers.Location) (*ent.Location, error) {
+	return c.location(ctx, http.MethodPut, fmt.Sprintf("/locations/%d", id), l)
+}
+
+// DeleteLocation deletes a location.
+func (c *Client) DeleteLocation(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/locations/%d", id), nil, nil, nil)
+}
+
+// LocationTree returns a location with the locations under it nested as its
+// children.
+func (c *Client) LocationTree(ctx context.Context, id int) (*ent.Location, error) {
+	return c.location(ctx, http.MethodGet, fmt.Sprintf("/locations/%d/tree", id), nil)
+}
+
+// ListLocationEquipment returns the equipment at a location or any location
+// under it.
+func (c *Client) ListLocationEquipment(ctx context.Context, id int) ([]*ent.Equipment, error) {
+	var es []*ent.Equipment
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/locations/%d/equipment", id), nil, nil, &es); err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+// location sends a request responded to with a location.
+func (c *Client) location(ctx context.Context, method, path string, in interface{}) (*ent.Location, error) {
+	var l ent.Location
+	if err := c.do(ctx, method, path, nil, in, &l); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
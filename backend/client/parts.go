@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+)
+
+// ListParts returns a page of parts.
+func (c *Client) ListParts(ctx context.Context, p Page) ([]*ent.Part, error) {
+	var ps []*ent.Part
+	if err := c.do(ctx, http.MethodGet, "/parts", p.values(), nil, &ps); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// Parts iterates over all parts.
+func (c *Client) Parts() *PartIterator {
+	return &PartIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.ListParts(ctx, p))
+	}}}
+}
+
+// CreatePart creates a part.
+func (c *Client) CreatePart(ctx context.Context, p *ent.Part) (*ent.Part, error) {
+	var created ent.Part
+	if err := c.do(ctx, http.MethodPost, "/parts", nil, p, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetPart returns a part with its stock levels.
+func (c *Client) GetPart(ctx context.Context, id int) (*ent.Part, error) {
+	var p ent.Part
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/parts/%d", id), nil, nil, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// DeletePart deletes a part.
+func (c *Client) DeletePart(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/parts/%d", id), nil, nil, nil)
+}
+
+// ReceiveStock records stock of a part received at a location.
+func (c *Client) ReceiveStock(ctx context.Context, partID int, r controllers.StockReceipt) (*ent.StockMovement, error) {
+	return c.movement(ctx, fmt.Sprintf("/parts/%d/receipts", partID), r)
+}
+
+// AdjustStock corrects the stock of a part at a location.
+func (c *Client) AdjustStock(ctx context.Context, partID int, a controllers.StockAdjustment) (*ent.StockMovement, error) {
+	return c.movement(ctx, fmt.Sprintf("/parts/%d/adjustments", partID), a)
+}
+
+// movement posts a request responded to with a stock movement.
+func (c *Client) movement(ctx context.Context, path string, in interface{}) (*ent.StockMovement, error) {
+	var m ent.StockMovement
+	if err := c.do(ctx, http.MethodPost, path, nil, in, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// ListStockMovements returns a page of the stock movements of a part.
+func (c *Client) ListStockMovements(ctx context.Context, partID int, p Page) ([]*ent.StockMovement, error) {
+	var ms []*ent.StockMovement
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/parts/%d/movements", partID), p.values(), nil, &ms); err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// StockMovements iterates over the stock movements of a part.
+func (c *Client) StockMovements(partID int) *StockMovementIterator {
+	return &StockMovementIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.ListStockMovements(ctx, partID, p))
+	}}}
+}
+
+// ListStock returns the stock levels at a location, of a part, or both; all
+// of them when location is empty and partID zero.
+func (c *Client) ListStock(ctx context.Context, location string, partID int) ([]*ent.StockLevel, error) {
+	q := url.Values{}
+	setString(q, "location", location)
+	setInt(q, "part", partID)
+	var ls []*ent.StockLevel
+	if err := c.do(ctx, http.MethodGet, "/stock", q, nil, &ls); err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/darksford123x/app/activity"
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+)
+
+// RepairSlipFilter filters the repair slips listed; the zero values match
+// all slips.
+type RepairSlipFilter struct {
+	Status    string
+	Assignee  int
+	Route     string
+	Equipment int
+	// Location selects the slips of the equipment at a location or any
+	// location under it.
+	Location int
+	Priority string
+	// Breached selects the slips that did or did not miss a deadline.
+	Breached *bool
+}
+
+// values returns the query of a filter and a page.
+func (f RepairSlipFilter) values(p Page) url.Values {
+	q := p.values()
+	setString(q, "status", f.Status)
+	setInt(q, "assignee", f.Assignee)
+	setString(q, "route", f.Route)
+	setInt(q, "equipment", f.Equipment)
+	setInt(q, "location", f.Location)
+	setString(q, "priority", f.Priority)
+	if f.Breached != nil {
+		q.Set("breached", strconv.FormatBool(*f.Breached))
+	}
+	return q
+}
+
+// ListRepairSlips returns a page of the repair slips matching a filter.
+func (c *Client) ListRepairSlips(ctx context.Context, f RepairSlipFilter, p Page) ([]*ent.RepairSlip, error) {
+	var slips []*ent.RepairSlip
+	if err := c.do(ctx, http.MethodGet, "/repairslips", f.values(p), nil, &slips); err != nil {
+		return nil, err
+	}
+	return slips, nil
+}
+
+// RepairSlips iterates over all the repair slips matching a filter.
+func (c *Client) RepairSlips(f RepairSlipFilter) *RepairSlipIterator {
+	return &RepairSlipIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.ListRepairSlips(ctx, f, p))
+	}}}
+}
+
+// CreateRepairSlip creates a repair slip.
+func (c *Client) CreateRepairSlip(ctx context.Context, rs controllers.RepairSlip) (*ent.RepairSlip, error) {
+	return c.repairSlip(ctx, http.MethodPost, "/repairslips", rs)
+}
+
+// GetRepairSlip returns a repair slip by ID.
+func (c *Client) GetRepairSlip(ctx context.Context, id int) (*ent.RepairSlip, error) {
+	return c.repairSlip(ctx, http.MethodGet, fmt.Sprintf("/repairslips/%d", id), nil)
+}
+
+// DeleteRepairSlip deletes a repair slip by ID.
+func (c *Client) DeleteRepairSlip(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/repairslips/%d", id), nil, nil, nil)
+}
+
+// SetRepairSlipStatus changes the status of a repair slip.
+func (c *Client) SetRepairSlipStatus(ctx context.Context, id int, status string) (*ent.RepairSlip, error) {
+	return c.repairSlip(ctx, http.MethodPut, fmt.Sprintf("/repairslips/%d/status", id), controllers.RepairSlipStatus{Status: status})
+}
+
+// SetRepairSlipPriority changes the priority of a repair slip.
+func (c *Client) SetRepairSlipPriority(ctx context.Context, id int, priority string) (*ent.RepairSlip, error) {
+	return c.repairSlip(ctx, http.MethodPut, fmt.Sprintf("/repairslips/%d/priority", id), controllers.RepairSlipPriority{Priority: priority})
+}
+
+// AssignRepairSlip assigns a repair slip to a technician.
+func (c *Client) AssignRepairSlip(ctx context.Context, id, technician int) (*ent.RepairSlip, error) {
+	return c.repairSlip(ctx, http.MethodPut, fmt.Sprintf("/repairslips/%d/assignee", id), controllers.Assignment{Technician: technician})
+}
+
+// UnassignRepairSlip removes the technician of a repair slip.
+func (c *Client) UnassignRepairSlip(ctx context.Context, id int) (*ent.RepairSlip, error) {
+	return c.repairSlip(ctx, http.MethodDelete, fmt.Sprintf("/repairslips/%d/assignee", id), nil)
+}
+
+// AutoAssignRepairSlip assigns a repair slip to the technician of its
+// category with the fewest open slips.
+func (c *Client) AutoAssignRepairSlip(ctx context.Context, id int) (*ent.RepairSlip, error) {
+	return c.repairSlip(ctx, http.MethodPost, fmt.Sprintf("/repairslips/%d/assignee/auto", id), nil)
+}
+
+// repairSlip sends a request responded to with a repair slip.
+func (c *Client) repairSlip(ctx context.Context, method, path string, in interface{}) (*ent.RepairSlip, error) {
+	var rs ent.RepairSlip
+	if err := c.do(ctx, method, path, nil, in, &rs); err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// UsePart records parts used by a repair slip, taking them from the stock.
+func (c *Client) UsePart(ctx context.Context, id int, usage controllers.PartUsage) (*ent.StockMovement, error) {
+	var mv ent.StockMovement
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/repairslips/%d/parts", id), nil, usage, &mv); err != nil {
+		return nil, err
+	}
+	return &mv, nil
+}
+
+// ListRepairSlipParts returns the parts used by a repair slip.
+func (c *Client) ListRepairSlipParts(ctx context.Context, id int) ([]*ent.StockMovement, error) {
+	var mvs []*ent.StockMovement
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/repairslips/%d/parts", id), nil, nil, &mvs); err != nil {
+		return nil, err
+	}
+	return mvs, nil
+}
+
+// Timeline returns the comments and activities of a repair slip in
+// chronological order.
+func (c *Client) Timeline(ctx context.Context, id int) ([]activity.Entry, error) {
+	var entries []activity.Entry
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/repairslips/%d/timeline", id), nil, nil, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
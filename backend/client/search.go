@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/darksford123x/app/search"
+)
+
+// Search returns a page of the documents matching a query, best first, of
+// the given kinds or of all kinds when none is given.
+func (c *Client) Search(ctx context.Context, query string, kinds []string, p Page) ([]search.Result, error) {
+	q := p.values()
+	q.Set("q", query)
+	setString(q, "kind", strings.Join(kinds, ","))
+	var rs []search.Result
+	if err := c.do(ctx, http.MethodGet, "/search", q, nil, &rs); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// SearchAll iterates over the documents matching a query, best first.
+func (c *Client) SearchAll(query string, kinds ...string) *SearchIterator {
+	return &SearchIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.Search(ctx, query, kinds, p))
+	}}}
+}
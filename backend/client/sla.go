@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+)
+
+// ListSLAPolicies returns the SLA policies.
+func (c *Client) ListSLAPolicies(ctx context.Context) ([]*ent.SLAPolicy, error) {
+	var ps []*ent.SLAPolicy
+	if err := c.do(ctx, http.MethodGet, "/sla-policies", nil, nil, &ps); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// CreateSLAPolicy creates an SLA policy.
+func (c *Client) CreateSLAPolicy(ctx context.Context, p *ent.SLAPolicy) (*ent.SLAPolicy, error) {
+	var created ent.SLAPolicy
+	if err := c.do(ctx, http.MethodPost, "/sla-policies", nil, p, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// DeleteSLAPolicy deletes an SLA policy.
+func (c *Client) DeleteSLAPolicy(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/sla-policies/%d", id), nil, nil, nil)
+}
+
+// ListHolidays returns the holidays of a year, or all of them when year is
+// zero.
+func (c *Client) ListHolidays(ctx context.Context, year int) ([]*ent.Holiday, error) {
+	q := url.Values{}
+	setInt(q, "year", year)
+	var hs []*ent.Holiday
+	if err := c.do(ctx, http.MethodGet, "/holidays", q, nil, &hs); err != nil {
+		return nil, err
+	}
+	return hs, nil
+}
+
+// CreateHoliday creates a holiday.
+func (c *Client) CreateHoliday(ctx context.Context, h controllers.Holiday) (*ent.Holiday, error) {
+	var created ent.Holiday
+	if err := c.do(ctx, http.MethodPost, "/holidays", nil, h, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// DeleteHoliday deletes a holiday.
+func (c *Client) DeleteHoliday(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/holidays/%d", id), nil, nil, nil)
+}
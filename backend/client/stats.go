@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/darksford123x/app/stats"
+)
+
+// StatsQuery is an aggregation of an entity; its zero value counts them
+// all.
+type StatsQuery struct {
+	// GroupBy are the fields the rows are grouped by.
+	GroupBy []string
+	// Aggregates are count, or sum, mean, min or max of a number field,
+	// e.g. "sum:total".
+	Aggregates []string
+	// Bucket groups the rows by day, week or month of the Time field.
+	Bucket string
+	Time   string
+	// From and To are days (2006-01-02) or times (RFC 3339).
+	From string
+	To   string
+}
+
+// Stats aggregates an entity: user, repairslip, equipment, invoice or
+// payment.
+func (c *Client) Stats(ctx context.Context, entity string, s StatsQuery) (*stats.Table, error) {
+	q := url.Values{}
+	setString(q, "group_by", strings.Join(s.GroupBy, ","))
+	setString(q, "agg", strings.Join(s.Aggregates, ","))
+	setString(q, "bucket", s.Bucket)
+	setString(q, "time", s.Time)
+	setString(q, "from", s.From)
+	setString(q, "to", s.To)
+	var t stats.Table
+	if err := c.do(ctx, http.MethodGet, "/stats/"+entity, q, nil, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
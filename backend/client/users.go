@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+)
+
+// ListUsers returns a page of users.
+func (c *Client) ListUsers(ctx context.Context, p Page) ([]*ent.User, error) {
+	var users []*ent.User
+	if err := c.do(ctx, http.MethodGet, "/users", p.values(), nil, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// Users iterates over all users.
+func (c *Client) Users() *UserIterator {
+	return &UserIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.ListUsers(ctx, p))
+	}}}
+}
+
+// CreateUser creates a user.
+func (c *Client) CreateUser(ctx context.Context, u controllers.NewUser) (*ent.User, error) {
+	var created ent.User
+	if err := c.do(ctx, http.MethodPost, "/users", nil, u, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetUser returns a user by ID.
+func (c *Client) GetUser(ctx context.Context, id int) (*ent.User, error) {
+	var u ent.User
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/users/%d", id), nil, nil, &u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// UpdateUser changes the fields of a user that are set in the update.
+func (c *Client) UpdateUser(ctx context.Context, id int, update controllers.UserUpdate) (*ent.User, error) {
+	var u ent.User
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("/users/%d", id), nil, update, &u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// DeleteUser deletes a user by ID.
+func (c *Client) DeleteUser(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/users/%d", id), nil, nil, nil)
+}
+
+// SetUserPassword sets the password of a user; only the user and admins
+// may.
+func (c *Client) SetUserPassword(ctx context.Context, id int, password string) error {
+	return c.do(ctx, http.MethodPut, fmt.Sprintf("/users/%d/password", id), nil, controllers.Password{Password: password}, nil)
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/webhookdelivery"
+)
+
+// ListWebhooks returns the webhooks.
+func (c *Client) ListWebhooks(ctx context.Context) ([]*ent.Webhook, error) {
+	var ws []*ent.Webhook
+	if err := c.do(ctx, http.MethodGet, "/webhooks", nil, nil, &ws); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+// CreateWebhook subscribes a URL to events. The secret returned is not
+// shown again.
+func (c *Client) CreateWebhook(ctx context.Context, w controllers.Webhook) (*controllers.WebhookSecret, error) {
+	var created controllers.WebhookSecret
+	if err := c.do(ctx, http.MethodPost, "/webhooks", nil, w, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetWebhook returns a webhook by ID.
+func (c *Client) GetWebhook(ctx context.Context, id int) (*ent.Webhook, error) {
+	return c.webhook(ctx, http.MethodGet, fmt.Sprintf("/webhooks/%d", id), nil)
+}
+
+// UpdateWebhook changes a webhook.
+func (c *Client) UpdateWebhook(ctx context.Context, id int, w controllers.WebhookUpdate) (*ent.Webhook, error) {
+	return c.webhook(ctx, http.MethodPut, fmt.Sprintf("/webhooks/%d", id), w)
+}
+
+// DeleteWebhook deletes a webhook and its deliveries.
+func (c *Client) DeleteWebhook(ctx context.Context, id int) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/webhooks/%d", id), nil, nil, nil)
+}
+
+// webhook sends a request responded to with a webhook.
+func (c *Client) webhook(ctx context.Context, method, path string, in interface{}) (*ent.Webhook, error) {
+	var w ent.Webhook
+	if err := c.do(ctx, method, path, nil, in, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// ListWebhookDeliveries returns a page of the deliveries of a webhook with
+// the given status, or of all of them when status is empty.
+func (c *Client) ListWebhookDeliveries(ctx context.Context, webhookID int, status webhookdelivery.Status, p Page) ([]*ent.WebhookDelivery, error) {
+	q := p.values()
+	setString(q, "status", string(status))
+	var ds []*ent.WebhookDelivery
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/webhooks/%d/deliveries", webhookID), q, nil, &ds); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+// WebhookDeliveries iterates over the deliveries of a webhook with the given
+// status, or over all of them when status is empty.
+func (c *Client) WebhookDeliveries(webhookID int, status webhookdelivery.Status) *WebhookDeliveryIterator {
+	return &WebhookDeliveryIterator{iterator{fetch: func(ctx context.Context, p Page) ([]interface{}, error) {
+		return items(c.ListWebhookDeliveries(ctx, webhookID, status, p))
+	}}}
+}
+
+// GetWebhookDelivery returns a delivery of a webhook.
+func (c *Client) GetWebhookDelivery(ctx context.Context, webhookID, deliveryID int) (*ent.WebhookDelivery, error) {
+	return c.delivery(ctx, http.MethodGet, fmt.Sprintf("/webhooks/%d/deliveries/%d", webhookID, deliveryID))
+}
+
+// RedeliverWebhookDelivery sends a delivery of a webhook again.
+func (c *Client) RedeliverWebhookDelivery(ctx context.Context, webhookID, deliveryID int) (*ent.WebhookDelivery, error) {
+	return c.delivery(ctx, http.MethodPost, fmt.Sprintf("/webhooks/%d/deliveries/%d/redeliver", webhookID, deliveryID))
+}
+
+// delivery sends a request responded to with a webhook delivery.
+func (c *Client) delivery(ctx context.Context, method, path string) (*ent.WebhookDelivery, error) {
+	var d ent.WebhookDelivery
+	if err := c.do(ctx, method, path, nil, nil, &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
@@ -0,0 +1,199 @@
+// Package comments posts and edits the comments of repair slips. A comment
+// mentions users by their email, as in "@somchai@example.com"; mentions of
+// unknown addresses, and of users who may not read the comment, are
+// ignored.
+package comments
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/txn"
+)
+
+// mention matches an @ and an email address not preceded by a word, so that
+// a plain email address is not taken for a mention.
+var mention = regexp.MustCompile(`(?:^|[^\w.@])@([\w.%+-]+@[\w-]+(?:\.[\w-]+)*\.[A-Za-z]{2,})`)
+
+// Mentions returns the email addresses mentioned in a body, in order and
+// without duplicates.
+func Mentions(body string) []string {
+	var emails []string
+	seen := make(map[string]bool)
+	for _, m := range mention.FindAllStringSubmatch(body, -1) {
+		email := strings.ToLower(m[1])
+		if !seen[email] {
+			seen[email] = true
+			emails = append(emails, email)
+		}
+	}
+	return emails
+}
+
+// CanReadInternal reports whether the user may read internal comments; the
+// staff reporting repairs may not.
+func CanReadInternal(u *ent.User) bool {
+	return u != nil && u.Role != user.RoleStaff
+}
+
+// Visible reports whether the user may read the comment.
+func Visible(u *ent.User, c *ent.Comment) bool {
+	return c.Visibility == comment.VisibilityPublic || CanReadInternal(u)
+}
+
+// Readable narrows a comment query to the comments the user may read.
+func Readable(u *ent.User) func(*ent.CommentQuery) {
+	return func(q *ent.CommentQuery) {
+		if !CanReadInternal(u) {
+			q.Where(comment.VisibilityEQ(comment.VisibilityPublic))
+		}
+	}
+}
+
+// Post adds a comment to a repair slip.
+func Post(ctx context.Context, client *ent.Client, slipID int, author *ent.User, body string, visibility comment.Visibility) (*ent.Comment, error) {
+	if visibility == comment.VisibilityInternal && !CanReadInternal(author) {
+		return nil, auth.ErrForbidden
+	}
+	if _, err := client.RepairSlip.Get(ctx, slipID); err != nil {
+		return nil, err
+	}
+	mentioned, err := mentioned(ctx, client, body, visibility)
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.Comment.
+		Create().
+		SetBody(body).
+		SetVisibility(visibility).
+		SetRepairSlipID(slipID).
+		SetAuthor(author).
+		AddMentionIDs(mentioned...).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Get(ctx, client, c.ID)
+}
+
+// Edit changes the body of a comment, keeping the earlier body as a
+// revision. Only the author may edit a comment.
+func Edit(ctx context.Context, client *ent.Client, id int, editor *ent.User, body string) (*ent.Comment, error) {
+	err := txn.WithTx(ctx, client, func(ctx context.Context, tx *ent.Tx) error {
+		c, err := tx.Comment.
+			Query().
+			Where(comment.ID(id)).
+			WithAuthor().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if c.Edges.Author.ID != editor.ID {
+			return auth.ErrForbidden
+		}
+		if c.Body == body {
+			return nil
+		}
+		mentioned, err := mentioned(ctx, tx.Client(), body, c.Visibility)
+		if err != nil {
+			return err
+		}
+		previous, err := c.QueryMentions().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CommentRevision.
+			Create().
+			SetBody(c.Body).
+			SetComment(c).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		added, removed := diff(previous, mentioned)
+		return c.Update().
+			SetBody(body).
+			SetEdited(true).
+			AddMentionIDs(added...).
+			RemoveMentionIDs(removed...).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return Get(ctx, client, id)
+}
+
+// Get returns a comment with its author and mentions.
+func Get(ctx context.Context, client *ent.Client, id int) (*ent.Comment, error) {
+	return client.Comment.
+		Query().
+		Where(comment.ID(id)).
+		WithAuthor().
+		WithMentions().
+		Only(ctx)
+}
+
+// List returns the comments of a repair slip the user may read, oldest
+// first.
+func List(ctx context.Context, client *ent.Client, slipID int, reader *ent.User) ([]*ent.Comment, error) {
+	q := client.Comment.
+		Query().
+		Where(comment.HasRepairSlipWith(repairslip.ID(slipID))).
+		WithAuthor().
+		WithMentions().
+		Order(ent.Asc(comment.FieldCreateTime), ent.Asc(comment.FieldID))
+	Readable(reader)(q)
+	return q.All(ctx)
+}
+
+// mentioned returns the IDs of the users mentioned in a body who may read a
+// comment of the given visibility.
+func mentioned(ctx context.Context, client *ent.Client, body string, visibility comment.Visibility) ([]int, error) {
+	emails := Mentions(body)
+	if len(emails) == 0 {
+		return nil, nil
+	}
+	matches := make([]predicate.User, len(emails))
+	for i, email := range emails {
+		matches[i] = user.EmailEqualFold(email)
+	}
+	q := client.User.
+		Query().
+		Where(user.Or(matches...))
+	if visibility == comment.VisibilityInternal {
+		q.Where(user.RoleNEQ(user.RoleStaff))
+	}
+	return q.IDs(ctx)
+}
+
+// diff returns the IDs of next missing from prev, and those of prev missing
+// from next.
+func diff(prev, next []int) (added, removed []int) {
+	in := func(ids []int, id int) bool {
+		for _, v := range ids {
+			if v == id {
+				return true
+			}
+		}
+		return false
+	}
+	for _, id := range next {
+		if !in(prev, id) {
+			added = append(added, id)
+		}
+	}
+	for _, id := range prev {
+		if !in(next, id) {
+			removed = append(removed, id)
+		}
+	}
+	return added, removed
+}
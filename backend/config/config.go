@@ -0,0 +1,238 @@
+// Package config loads the backend settings from environment variables.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/money"
+)
+
+// Config holds the backend settings.
+type Config struct {
+	// DatabaseDriver and DatabaseURL select the database: sqlite3 or
+	// postgres. The default database is in memory and lost on exit.
+	DatabaseDriver string
+	DatabaseURL    string
+	// VATRate is the value added tax applied to invoices, in percent.
+	VATRate money.Rate
+	// Location is the time zone of the business hours.
+	Location *time.Location
+	// OpensAt and ClosesAt are the business hours as offsets from midnight.
+	OpensAt  time.Duration
+	ClosesAt time.Duration
+	// SLACheckInterval is how often overdue repair slips are looked for.
+	SLACheckInterval time.Duration
+	// Workers is the number of background jobs run at the same time; with
+	// none, this instance only enqueues jobs for other instances to run.
+	Workers int
+	// JobPollInterval is how often idle workers look for due jobs.
+	JobPollInterval time.Duration
+	// JobLease is how long a job may run before it is considered lost and
+	// run again.
+	JobLease time.Duration
+	// JobBackoff is the delay before a failed job is first retried; it
+	// doubles with every attempt up to JobMaxBackoff.
+	JobBackoff    time.Duration
+	JobMaxBackoff time.Duration
+	// SMTPHost is the mail server notifications are sent through; when it
+	// is empty they are only logged.
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	// MailFrom is the sender address of the notifications.
+	MailFrom string
+	// WebhookTimeout is how long a webhook endpoint may take to answer.
+	WebhookTimeout time.Duration
+	// WebhookFailureLimit is the number of failed deliveries in a row
+	// after which a webhook is disabled.
+	WebhookFailureLimit int
+	// WebhookAllowPrivate lets webhooks reach the loopback and private
+	// addresses. The delivery log keeps the start of the responses, so
+	// admins could read the services of the server's network with it.
+	WebhookAllowPrivate bool
+	// AuthSecret signs the bearer tokens. When it is empty a random one is
+	// used, and tokens do not survive a restart.
+	AuthSecret string
+	// TokenTTL is how long bearer tokens are valid.
+	TokenTTL time.Duration
+	// Storage is where attachments are kept: "local" for the directory
+	// StorageDir, or "s3" for a bucket of S3 or a compatible service.
+	Storage     string
+	StorageDir  string
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	// S3PathStyle puts the bucket in the path of the URLs instead of the
+	// host name, as most S3 compatible services require.
+	S3PathStyle bool
+	// MaxUploadSize is the largest attachment accepted, in bytes.
+	MaxUploadSize int64
+	// UploadTypes are the MIME types attachments may have.
+	UploadTypes []string
+	// GRPCPort is the port the gRPC server listens on, next to the HTTP
+	// server; when it is empty the gRPC server is not started.
+	GRPCPort string
+	// ValidateRequests checks the requests against the OpenAPI document of
+	// the API before they reach the handlers.
+	ValidateRequests bool
+	// AllowedOrigins are the origins, such as https://repairs.example.com,
+	// of the web frontends that may call the API from a browser and open
+	// the WebSocket event stream. When there are none, any origin may call
+	// the API, and only pages of the API's own origin may open WebSockets.
+	AllowedOrigins []string
+	// ScanURL is the address the QR codes of the asset tags open, with
+	// the tag appended, such as the repair slip form of the frontend.
+	// When it is empty the QR codes hold the bare tag.
+	ScanURL string
+	// ShutdownTimeout is how long running requests and jobs are waited for
+	// when the server stops.
+	ShutdownTimeout time.Duration
+}
+
+// Load reads the configuration from the environment, falling back to
+// defaults for unset variables.
+func Load() (*Config, error) {
+	cfg := &Config{}
+	var err error
+	cfg.DatabaseDriver = env("DATABASE_DRIVER", "sqlite3")
+	if cfg.DatabaseDriver != "sqlite3" && cfg.DatabaseDriver != "postgres" {
+		return nil, fmt.Errorf("config: DATABASE_DRIVER: unknown driver %q", cfg.DatabaseDriver)
+	}
+	cfg.DatabaseURL = env("DATABASE_URL", "file:ent?mode=memory&cache=shared&_fk=1")
+	if cfg.VATRate, err = money.ParseRate(env("VAT_RATE", "7")); err != nil {
+		return nil, fmt.Errorf("config: VAT_RATE: %w", err)
+	}
+	if cfg.Location, err = location(env("TIMEZONE", "Asia/Bangkok")); err != nil {
+		return nil, fmt.Errorf("config: TIMEZONE: %w", err)
+	}
+	if cfg.OpensAt, cfg.ClosesAt, err = hours(env("BUSINESS_HOURS", "08:30-16:30")); err != nil {
+		return nil, fmt.Errorf("config: BUSINESS_HOURS: %w", err)
+	}
+	durations := []struct {
+		key, def string
+		dst      *time.Duration
+	}{
+		{"SLA_CHECK_INTERVAL", "1m", &cfg.SLACheckInterval},
+		{"JOB_POLL_INTERVAL", "1s", &cfg.JobPollInterval},
+		{"JOB_LEASE", "5m", &cfg.JobLease},
+		{"JOB_BACKOFF", "10s", &cfg.JobBackoff},
+		{"JOB_MAX_BACKOFF", "1h", &cfg.JobMaxBackoff},
+		{"WEBHOOK_TIMEOUT", "10s", &cfg.WebhookTimeout},
+		{"TOKEN_TTL", "12h", &cfg.TokenTTL},
+		{"SHUTDOWN_TIMEOUT", "30s", &cfg.ShutdownTimeout},
+	}
+	for _, d := range durations {
+		if *d.dst, err = time.ParseDuration(env(d.key, d.def)); err != nil {
+			return nil, fmt.Errorf("config: %s: %w", d.key, err)
+		}
+		if *d.dst <= 0 {
+			return nil, fmt.Errorf("config: %s: must be positive", d.key)
+		}
+	}
+	if cfg.Workers, err = strconv.Atoi(env("WORKERS", "4")); err != nil {
+		return nil, fmt.Errorf("config: WORKERS: %w", err)
+	}
+	if cfg.Workers < 0 {
+		return nil, fmt.Errorf("config: WORKERS: must not be negative")
+	}
+	if cfg.WebhookFailureLimit, err = strconv.Atoi(env("WEBHOOK_FAILURE_LIMIT", "10")); err != nil {
+		return nil, fmt.Errorf("config: WEBHOOK_FAILURE_LIMIT: %w", err)
+	}
+	if cfg.WebhookFailureLimit <= 0 {
+		return nil, fmt.Errorf("config: WEBHOOK_FAILURE_LIMIT: must be positive")
+	}
+	cfg.SMTPHost = env("SMTP_HOST", "")
+	cfg.SMTPPort = env("SMTP_PORT", "25")
+	cfg.SMTPUsername = env("SMTP_USERNAME", "")
+	cfg.SMTPPassword = env("SMTP_PASSWORD", "")
+	cfg.MailFrom = env("MAIL_FROM", "repairs@localhost")
+	cfg.AuthSecret = env("AUTH_SECRET", "")
+	cfg.GRPCPort = env("GRPC_PORT", "9090")
+	cfg.Storage = env("STORAGE", "local")
+	cfg.StorageDir = env("STORAGE_DIR", "uploads")
+	cfg.S3Endpoint = env("S3_ENDPOINT", "")
+	cfg.S3Region = env("S3_REGION", "us-east-1")
+	cfg.S3Bucket = env("S3_BUCKET", "")
+	cfg.S3AccessKey = env("S3_ACCESS_KEY", "")
+	cfg.S3SecretKey = env("S3_SECRET_KEY", "")
+	cfg.ScanURL = env("SCAN_URL", "")
+	switch cfg.Storage {
+	case "local":
+	case "s3":
+		if cfg.S3Endpoint == "" || cfg.S3Bucket == "" {
+			return nil, fmt.Errorf("config: STORAGE: s3 needs S3_ENDPOINT and S3_BUCKET")
+		}
+	default:
+		return nil, fmt.Errorf("config: STORAGE: unknown storage %q", cfg.Storage)
+	}
+	if cfg.S3PathStyle, err = strconv.ParseBool(env("S3_PATH_STYLE", "true")); err != nil {
+		return nil, fmt.Errorf("config: S3_PATH_STYLE: %w", err)
+	}
+	if cfg.WebhookAllowPrivate, err = strconv.ParseBool(env("WEBHOOK_ALLOW_PRIVATE", "false")); err != nil {
+		return nil, fmt.Errorf("config: WEBHOOK_ALLOW_PRIVATE: %w", err)
+	}
+	if cfg.ValidateRequests, err = strconv.ParseBool(env("VALIDATE_REQUESTS", "false")); err != nil {
+		return nil, fmt.Errorf("config: VALIDATE_REQUESTS: %w", err)
+	}
+	if cfg.MaxUploadSize, err = strconv.ParseInt(env("MAX_UPLOAD_SIZE", "10485760"), 10, 64); err != nil {
+		return nil, fmt.Errorf("config: MAX_UPLOAD_SIZE: %w", err)
+	}
+	if cfg.MaxUploadSize <= 0 {
+		return nil, fmt.Errorf("config: MAX_UPLOAD_SIZE: must be positive")
+	}
+	for _, o := range strings.Split(env("ALLOWED_ORIGINS", ""), ",") {
+		if o = strings.TrimRight(strings.TrimSpace(o), "/"); o != "" {
+			cfg.AllowedOrigins = append(cfg.AllowedOrigins, o)
+		}
+	}
+	for _, t := range strings.Split(env("UPLOAD_TYPES", "image/jpeg,image/png,image/gif,image/webp,application/pdf"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			cfg.UploadTypes = append(cfg.UploadTypes, t)
+		}
+	}
+	return cfg, nil
+}
+
+func env(key, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
+
+// location loads a time zone. Asia/Bangkok has no daylight saving time, so
+// it falls back to a fixed offset on hosts without a time zone database.
+func location(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil && name == "Asia/Bangkok" {
+		return time.FixedZone("ICT", 7*60*60), nil
+	}
+	return loc, err
+}
+
+// hours parses business hours such as "08:30-16:30".
+func hours(s string) (open, close time.Duration, err error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("want HH:MM-HH:MM, got %q", s)
+	}
+	var t [2]time.Time
+	for i, p := range parts {
+		if t[i], err = time.Parse("15:04", strings.TrimSpace(p)); err != nil {
+			return 0, 0, err
+		}
+	}
+	open = time.Duration(t[0].Hour())*time.Hour + time.Duration(t[0].Minute())*time.Minute
+	close = time.Duration(t[1].Hour())*time.Hour + time.Duration(t[1].Minute())*time.Minute
+	if close <= open {
+		return 0, 0, fmt.Errorf("business hours %q close before they open", s)
+	}
+	return open, close, nil
+}
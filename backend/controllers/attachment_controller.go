@@ -0,0 +1,291 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/darksford123x/app/attachments"
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/storage"
+	"github.com/gin-gonic/gin"
+)
+
+// AttachmentController defines the struct for the attachment controller
+type AttachmentController struct {
+	client *ent.Client
+	store  *attachments.Store
+	router gin.IRouter
+}
+
+// CreateAttachment handles POST requests for uploading a file to a repair slip
+// @Summary Attach a file to a repairslip
+// @Description upload a photo or document as the multipart field "file". The type is detected from the content and must be one of the allowed types; images get a thumbnail.
+// @ID create-attachment
+// @Accept   multipart/form-data
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Param file formData file true "File"
+// @Success 200 {object} ent.Attachment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 413 {object} ErrorResponse
+// @Failure 415 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/attachments [post]
+func (ctl *AttachmentController) CreateAttachment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// Leave room for the multipart headers around the file.
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, ctl.store.MaxSize()+1<<20)
+	fh, err := c.FormFile("file")
+	if err != nil {
+		if err.Error() == "http: request body too large" {
+			ctl.fail(c, attachments.ErrTooLarge)
+			return
+		}
+		c.JSON(400, gin.H{"error": "file is required"})
+		return
+	}
+	if fh.Size > ctl.store.MaxSize() {
+		ctl.fail(c, attachments.ErrTooLarge)
+		return
+	}
+	f, err := fh.Open()
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	defer f.Close()
+
+	a, err := ctl.store.Add(c.Request.Context(), int(id), attachments.Upload{
+		Filename: fh.Filename,
+		Content:  f,
+		Uploader: auth.FromContext(c.Request.Context()),
+	})
+	if err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	c.JSON(200, a)
+}
+
+// ListAttachment handles GET requests to list the attachments of a repair slip
+// @Summary List the attachments of a repairslip
+// @Description list the files attached to a repairslip, oldest first
+// @ID list-attachment
+// @Produce json
+// @Param id path int true "RepairSlip ID"
+// @Success 200 {array} ent.Attachment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/attachments [get]
+func (ctl *AttachmentController) ListAttachment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	list, err := ctl.client.Attachment.
+		Query().
+		Where(attachment.HasRepairSlipWith(repairslip.IDEQ(int(id)))).
+		WithUploader().
+		Order(ent.Asc(attachment.FieldID)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, list)
+}
+
+// GetAttachment handles GET requests to retrieve an attachment entity
+// @Summary Get an attachment entity by ID
+// @Description get the details of an attachment by ID
+// @ID get-attachment
+// @Produce  json
+// @Param id path int true "Attachment ID"
+// @Success 200 {object} ent.Attachment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /attachments/{id} [get]
+func (ctl *AttachmentController) GetAttachment(c *gin.Context) {
+	a, ok := ctl.get(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(200, a)
+}
+
+// DownloadAttachment handles GET requests to download the content of an attachment
+// @Summary Download an attachment
+// @Description download the file of an attachment
+// @ID download-attachment
+// @Produce  octet-stream
+// @Param id path int true "Attachment ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /attachments/{id}/content [get]
+func (ctl *AttachmentController) DownloadAttachment(c *gin.Context) {
+	a, ok := ctl.get(c)
+	if !ok {
+		return
+	}
+
+	r, err := ctl.store.Open(c.Request.Context(), a)
+	if err != nil {
+		ctl.fail(c, err)
+		return
+	}
+	defer r.Close()
+
+	c.DataFromReader(200, a.Size, a.ContentType, r, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename}),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// GetAttachmentThumbnail handles GET requests for the thumbnail of an image attachment
+// @Summary Get the thumbnail of an attachment
+// @Description get a JPEG preview of an image attachment, at most 256 pixels wide and high
+// @ID get-attachment-thumbnail
+// @Produce  jpeg
+// @Param id path int true "Attachment ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /attachments/{id}/thumbnail [get]
+func (ctl *AttachmentController) GetAttachmentThumbnail(c *gin.Context) {
+	a, ok := ctl.get(c)
+	if !ok {
+		return
+	}
+
+	r, err := ctl.store.OpenThumbnail(c.Request.Context(), a)
+	if err != nil {
+		ctl.fail(c, err)
+		return
+	}
+	defer r.Close()
+
+	c.DataFromReader(200, -1, "image/jpeg", r, map[string]string{
+		"Cache-Control": "private, max-age=86400",
+	})
+}
+
+// DeleteAttachment handles DELETE requests to delete an attachment entity
+// @Summary Delete an attachment entity by ID
+// @Description delete an attachment and its file by ID
+// @ID delete-attachment
+// @Produce  json
+// @Param id path int true "Attachment ID"
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /attachments/{id} [delete]
+func (ctl *AttachmentController) DeleteAttachment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := ctl.store.Delete(c.Request.Context(), int(id)); err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{"result": fmt.Sprintf("ok deleted %v", id)})
+}
+
+// get loads the attachment of the request, writing the error response when
+// there is none.
+func (ctl *AttachmentController) get(c *gin.Context) (*ent.Attachment, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+
+	a, err := ctl.client.Attachment.
+		Get(c.Request.Context(), int(id))
+	if err != nil {
+		ctl.fail(c, err)
+		return nil, false
+	}
+	return a, true
+}
+
+// fail maps errors of the attachment operations to a response.
+func (ctl *AttachmentController) fail(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err),
+		errors.Is(err, storage.ErrNotFound),
+		errors.Is(err, attachments.ErrNoThumbnail):
+		c.JSON(404, gin.H{"error": err.Error()})
+	case errors.Is(err, attachments.ErrTooLarge):
+		c.JSON(413, gin.H{
+			"error": fmt.Sprintf("%v; the limit is %d bytes", err, ctl.store.MaxSize()),
+		})
+	case errors.Is(err, attachments.ErrType):
+		c.JSON(415, gin.H{"error": err.Error()})
+	default:
+		c.JSON(400, gin.H{"error": err.Error()})
+	}
+}
+
+// NewAttachmentController creates and registers handles for the attachment controller
+func NewAttachmentController(router gin.IRouter, client *ent.Client, store *attachments.Store) *AttachmentController {
+	ac := &AttachmentController{
+		client: client,
+		store:  store,
+		router: router,
+	}
+	ac.register()
+	return ac
+}
+
+// register registers routes to the main engine
+func (ctl *AttachmentController) register() {
+	repairslips := ctl.router.Group("/repairslips", auth.Require())
+	repairslips.POST(":id/attachments", ctl.CreateAttachment)
+	repairslips.GET(":id/attachments", ctl.ListAttachment)
+
+	group := ctl.router.Group("/attachments", auth.Require())
+	group.GET(":id", ctl.GetAttachment)
+	group.GET(":id/content", ctl.DownloadAttachment)
+	group.GET(":id/thumbnail", ctl.GetAttachmentThumbnail)
+	group.DELETE(":id", ctl.DeleteAttachment)
+}
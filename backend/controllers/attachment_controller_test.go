@@ -0,0 +1,119 @@
+package controllers_test
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/servertest"
+)
+
+// upload posts a file to the attachments of a slip.
+func upload(h *servertest.Harness, slipID int, name string, content []byte, as *ent.User) *servertest.Response {
+	h.T.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	fw, err := w.CreateFormFile("file", name)
+	if err != nil {
+		h.T.Fatal(err)
+	}
+	fw.Write(content)
+	w.Close()
+	return h.Do(servertest.Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/api/v1/repairslips/%d/attachments", slipID),
+		Body:   body.Bytes(),
+		As:     as,
+		Header: http.Header{"Content-Type": {w.FormDataContentType()}},
+	})
+}
+
+// stored returns the files kept in the storage directory.
+func stored(t *testing.T, dir string) []string {
+	t.Helper()
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			files = append(files, path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestAttachments(t *testing.T) {
+	cfg := servertest.Config(t)
+	cfg.MaxUploadSize = 64 << 10
+	h := servertest.NewWithConfig(t, cfg)
+	ctx := h.Context()
+	u := h.User().SaveX(ctx)
+	slip := h.RepairSlip(u).SaveX(ctx)
+
+	img := image.NewRGBA(image.Rect(0, 0, 800, 400))
+	for x := 0; x < 800; x++ {
+		img.Set(x, x/2, color.RGBA{R: 255, A: 255})
+	}
+	var photo bytes.Buffer
+	if err := png.Encode(&photo, img); err != nil {
+		t.Fatal(err)
+	}
+
+	// The type is detected from the content, whatever the name, and the
+	// directories sent with the name are dropped.
+	var a ent.Attachment
+	upload(h, slip.ID, `C:\Photos\broken.txt`, photo.Bytes(), u).Status(200).Decode(&a)
+	if a.Filename != "broken.txt" || a.ContentType != "image/png" || a.Size != int64(photo.Len()) || !a.Thumbnail {
+		t.Errorf("attachment = %+v, want broken.txt, a PNG of %d bytes with a thumbnail", a, photo.Len())
+	}
+	res := h.Get(fmt.Sprintf("/api/v1/attachments/%d/content", a.ID), u).Status(200)
+	if !bytes.Equal(res.Body.Bytes(), photo.Bytes()) {
+		t.Error("the content read back differs from the upload")
+	}
+	res = h.Get(fmt.Sprintf("/api/v1/attachments/%d/thumbnail", a.ID), u).Status(200)
+	thumb, err := jpeg.DecodeConfig(res.Body)
+	if err != nil {
+		t.Fatalf("decoding the thumbnail: %v", err)
+	}
+	if thumb.Width != 256 || thumb.Height != 128 {
+		t.Errorf("thumbnail is %dx%d, want 256x128", thumb.Width, thumb.Height)
+	}
+	if files := stored(t, cfg.StorageDir); len(files) != 2 {
+		t.Errorf("stored %v, want the photo and its thumbnail", files)
+	}
+
+	// Documents have no thumbnail.
+	var doc ent.Attachment
+	upload(h, slip.ID, "invoice.pdf", []byte("%PDF-1.4\n%%EOF\n"), u).Status(200).Decode(&doc)
+	if doc.ContentType != "application/pdf" || doc.Thumbnail {
+		t.Errorf("attachment = %+v, want a PDF without a thumbnail", doc)
+	}
+	h.Get(fmt.Sprintf("/api/v1/attachments/%d/thumbnail", doc.ID), u).Status(404)
+
+	// Files of other types and above the limit are refused, and nothing
+	// of them is kept.
+	upload(h, slip.ID, "notes.txt", []byte("the lamp flickers"), u).Status(415)
+	upload(h, slip.ID, "scan.pdf", append([]byte("%PDF-1.4\n"), make([]byte, cfg.MaxUploadSize)...), u).Status(413)
+	upload(h, 404, "invoice.pdf", []byte("%PDF-1.4\n%%EOF\n"), u).Status(404)
+	if files := stored(t, cfg.StorageDir); len(files) != 3 {
+		t.Errorf("stored %v, want the photo, its thumbnail and the PDF", files)
+	}
+
+	// Deleting an attachment removes its content and thumbnail.
+	h.Delete(fmt.Sprintf("/api/v1/attachments/%d", a.ID), u).Status(200)
+	h.Get(fmt.Sprintf("/api/v1/attachments/%d/content", a.ID), u).Status(404)
+	if files := stored(t, cfg.StorageDir); len(files) != 1 {
+		t.Errorf("stored %v after deleting the photo, want the PDF", files)
+	}
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/gin-gonic/gin"
+)
+
+// AuthController defines the struct for the auth controller
+type AuthController struct {
+	client *ent.Client
+	tokens *auth.Tokens
+	router gin.IRouter
+}
+
+// Login defines the struct for signing in
+type Login struct {
+	Email    string `json:"email" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+// Token defines the struct returned when signing in. The token is sent as
+// "Authorization: Bearer <token>".
+type Token struct {
+	Token     string    `json:"token" validate:"required"`
+	ExpiresAt time.Time `json:"expires_at" validate:"required" format:"date-time"`
+	User      *ent.User `json:"user" validate:"required"`
+}
+
+// Login handles POST requests to sign in
+// @Summary Sign in
+// @Description exchange the email and password of a user for a bearer token
+// @ID login
+// @Accept   json
+// @Produce  json
+// @Param login body Login true "Credentials"
+// @Success 200 {object} Token
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /auth/login [post]
+func (ctl *AuthController) Login(c *gin.Context) {
+	obj := Login{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "login binding failed",
+		})
+		return
+	}
+
+	u, err := auth.Login(c.Request.Context(), ctl.client, obj.Email, obj.Password)
+	if errors.Is(err, auth.ErrBadCredentials) {
+		c.JSON(401, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, auth.ErrDisabled) {
+		c.JSON(403, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	token, expires, err := ctl.tokens.Issue(u.ID, u.Edges.Organization.ID)
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, Token{Token: token, ExpiresAt: expires, User: u})
+}
+
+// Me handles GET requests to retrieve the signed in user
+// @Summary Get the signed in user
+// @Description get the user the bearer token was issued for
+// @ID me
+// @Produce  json
+// @Success 200 {object} ent.User
+// @Failure 401 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /auth/me [get]
+func (ctl *AuthController) Me(c *gin.Context) {
+	c.JSON(200, auth.FromContext(c.Request.Context()))
+}
+
+// NewAuthController creates and registers handles for the auth controller
+func NewAuthController(router gin.IRouter, client *ent.Client, tokens *auth.Tokens) *AuthController {
+	ac := &AuthController{
+		client: client,
+		tokens: tokens,
+		router: router,
+	}
+	ac.register()
+	return ac
+}
+
+// register registers routes to the main engine
+func (ctl *AuthController) register() {
+	group := ctl.router.Group("/auth")
+	group.POST("login", ctl.Login)
+	group.GET("me", auth.Require(), ctl.Me)
+}
@@ -0,0 +1,284 @@
+package controllers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/darksford123x/app/activity"
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/comments"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/gin-gonic/gin"
+)
+
+// CommentController defines the struct for the comment controller
+type CommentController struct {
+	client *ent.Client
+	router gin.IRouter
+}
+
+// Comment defines the struct for posting a comment
+type Comment struct {
+	// Body is markdown; users are mentioned as @ and their email.
+	Body string `json:"body" validate:"required" minLength:"1"`
+	// Visibility is "public" (the default) or "internal".
+	Visibility string `json:"visibility,omitempty" enums:"public,internal"`
+}
+
+// CommentEdit defines the struct for editing a comment
+type CommentEdit struct {
+	Body string `json:"body" validate:"required" minLength:"1"`
+}
+
+// CreateComment handles POST requests for commenting on a repair slip
+// @Summary Comment on a repairslip
+// @Description post a markdown comment on a repairslip as the signed in user. Users are mentioned as @ and their email. Internal comments are hidden from staff and cannot be posted by them.
+// @ID create-comment
+// @Accept   json
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Param comment body Comment true "Comment"
+// @Success 200 {object} ent.Comment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/comments [post]
+func (ctl *CommentController) CreateComment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := Comment{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "comment binding failed",
+		})
+		return
+	}
+	visibility := comment.VisibilityPublic
+	if obj.Visibility != "" {
+		visibility = comment.Visibility(obj.Visibility)
+	}
+
+	cm, err := comments.Post(c.Request.Context(), ctl.client, int(id), auth.FromContext(c.Request.Context()), obj.Body, visibility)
+	if err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	c.JSON(200, cm)
+}
+
+// ListComment handles GET requests to list the comments of a repair slip
+// @Summary List the comments of a repairslip
+// @Description list the comments of a repairslip the signed in user may read, oldest first
+// @ID list-comment
+// @Produce json
+// @Param id path int true "RepairSlip ID"
+// @Success 200 {array} ent.Comment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/comments [get]
+func (ctl *CommentController) ListComment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	list, err := comments.List(c.Request.Context(), ctl.client, int(id), auth.FromContext(c.Request.Context()))
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, list)
+}
+
+// GetTimeline handles GET requests for the activity timeline of a repair slip
+// @Summary Get the timeline of a repairslip
+// @Description get the creation, comments, status and priority changes, assignments and escalations of a repairslip in chronological order. Internal comments are left out for staff.
+// @ID get-timeline
+// @Produce json
+// @Param id path int true "RepairSlip ID"
+// @Success 200 {array} activity.Entry
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/timeline [get]
+func (ctl *CommentController) GetTimeline(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	entries, err := activity.Timeline(c.Request.Context(), ctl.client, int(id), auth.FromContext(c.Request.Context()))
+	if err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	c.JSON(200, entries)
+}
+
+// GetComment handles GET requests to retrieve a comment entity
+// @Summary Get a comment entity by ID
+// @Description get comment by ID
+// @ID get-comment
+// @Produce  json
+// @Param id path int true "Comment ID"
+// @Success 200 {object} ent.Comment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /comments/{id} [get]
+func (ctl *CommentController) GetComment(c *gin.Context) {
+	cm, ok := ctl.get(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(200, cm)
+}
+
+// UpdateComment handles PUT requests to edit a comment
+// @Summary Edit a comment
+// @Description change the body of a comment; only its author may. The earlier body is kept as a revision.
+// @ID update-comment
+// @Accept   json
+// @Produce  json
+// @Param id path int true "Comment ID"
+// @Param comment body CommentEdit true "New body"
+// @Success 200 {object} ent.Comment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /comments/{id} [put]
+func (ctl *CommentController) UpdateComment(c *gin.Context) {
+	cm, ok := ctl.get(c)
+	if !ok {
+		return
+	}
+
+	obj := CommentEdit{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "comment binding failed",
+		})
+		return
+	}
+
+	cm, err := comments.Edit(c.Request.Context(), ctl.client, cm.ID, auth.FromContext(c.Request.Context()), obj.Body)
+	if err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	c.JSON(200, cm)
+}
+
+// ListCommentRevision handles GET requests to list the earlier bodies of a comment
+// @Summary List the revisions of a comment
+// @Description list the bodies a comment had before it was edited, oldest first
+// @ID list-comment-revision
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Success 200 {array} ent.CommentRevision
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /comments/{id}/revisions [get]
+func (ctl *CommentController) ListCommentRevision(c *gin.Context) {
+	cm, ok := ctl.get(c)
+	if !ok {
+		return
+	}
+
+	list, err := cm.QueryRevisions().
+		Order(ent.Asc(commentrevision.FieldID)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, list)
+}
+
+// get loads the comment of the request, writing the error response when
+// there is none or the caller may not read it.
+func (ctl *CommentController) get(c *gin.Context) (*ent.Comment, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+
+	cm, err := comments.Get(c.Request.Context(), ctl.client, int(id))
+	if err != nil {
+		ctl.fail(c, err)
+		return nil, false
+	}
+	// Internal comments do not exist for those who may not read them.
+	if !comments.Visible(auth.FromContext(c.Request.Context()), cm) {
+		c.JSON(404, gin.H{"error": "ent: comment not found"})
+		return nil, false
+	}
+	return cm, true
+}
+
+// fail maps errors of the comment operations to a response.
+func (ctl *CommentController) fail(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(404, gin.H{"error": err.Error()})
+	case errors.Is(err, auth.ErrForbidden):
+		c.JSON(403, gin.H{"error": err.Error()})
+	default:
+		c.JSON(400, gin.H{"error": err.Error()})
+	}
+}
+
+// NewCommentController creates and registers handles for the comment controller
+func NewCommentController(router gin.IRouter, client *ent.Client) *CommentController {
+	cc := &CommentController{
+		client: client,
+		router: router,
+	}
+	cc.register()
+	return cc
+}
+
+// register registers routes to the main engine
+func (ctl *CommentController) register() {
+	repairslips := ctl.router.Group("/repairslips", auth.Require())
+	repairslips.POST(":id/comments", ctl.CreateComment)
+	repairslips.GET(":id/comments", ctl.ListComment)
+	repairslips.GET(":id/timeline", ctl.GetTimeline)
+
+	group := ctl.router.Group("/comments", auth.Require())
+	group.GET(":id", ctl.GetComment)
+	group.PUT(":id", ctl.UpdateComment)
+	group.GET(":id/revisions", ctl.ListCommentRevision)
+}
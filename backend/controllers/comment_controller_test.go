@@ -0,0 +1,131 @@
+package controllers_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/darksford123x/app/activity"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+)
+
+// mentioned returns the IDs of the users mentioned in a comment.
+func mentioned(c ent.Comment) []int {
+	var ids []int
+	for _, u := range c.Edges.Mentions {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
+
+func TestComments(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	tech := h.User().SetRole(user.RoleTechnician).SaveX(ctx)
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	slip := h.RepairSlip(staff).SaveX(ctx)
+	path := fmt.Sprintf("/api/v1/repairslips/%d/comments", slip.ID)
+
+	// Mentions of unknown addresses are ignored, as are plain addresses.
+	var public ent.Comment
+	h.Post(path, map[string]interface{}{
+		"body": fmt.Sprintf("Still broken @%s, cc @nobody@example.com and %s", strings.ToUpper(tech.Email), supervisor.Email),
+	}, staff).Status(200).Decode(&public)
+	if got, want := fmt.Sprint(mentioned(public)), fmt.Sprint([]int{tech.ID}); got != want {
+		t.Errorf("public comment mentions %s, want %s", got, want)
+	}
+	if public.Edges.Author == nil || public.Edges.Author.ID != staff.ID || public.Visibility != "public" {
+		t.Errorf("public comment = %+v, want a public comment of user %d", public, staff.ID)
+	}
+
+	// Staff neither post nor are mentioned in internal comments.
+	h.Post(path, map[string]interface{}{"body": "Between us", "visibility": "internal"}, staff).Status(403)
+	var internal ent.Comment
+	h.Post(path, map[string]interface{}{
+		"body":       fmt.Sprintf("Lamp on order @%s @%s", staff.Email, supervisor.Email),
+		"visibility": "internal",
+	}, tech).Status(200).Decode(&internal)
+	if got, want := fmt.Sprint(mentioned(internal)), fmt.Sprint([]int{supervisor.ID}); got != want {
+		t.Errorf("internal comment mentions %s, want %s", got, want)
+	}
+
+	list := func(as *ent.User) []int {
+		t.Helper()
+		var cs []ent.Comment
+		h.Get(path, as).Status(200).Decode(&cs)
+		var ids []int
+		for _, c := range cs {
+			ids = append(ids, c.ID)
+		}
+		return ids
+	}
+	if got, want := fmt.Sprint(list(staff)), fmt.Sprint([]int{public.ID}); got != want {
+		t.Errorf("staff list comments %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(list(supervisor)), fmt.Sprint([]int{public.ID, internal.ID}); got != want {
+		t.Errorf("supervisors list comments %s, want %s", got, want)
+	}
+	h.Get(fmt.Sprintf("/api/v1/comments/%d", internal.ID), staff).Status(404)
+	h.Get(fmt.Sprintf("/api/v1/comments/%d", internal.ID), tech).Status(200)
+
+	// Only the author edits a comment, and the earlier bodies are kept.
+	edit := fmt.Sprintf("/api/v1/comments/%d", public.ID)
+	h.Put(edit, map[string]interface{}{"body": "Fixed"}, supervisor).Status(403)
+	var edited ent.Comment
+	h.Put(edit, map[string]interface{}{"body": "Still broken, see @" + supervisor.Email}, staff).Status(200).Decode(&edited)
+	if !edited.Edited || fmt.Sprint(mentioned(edited)) != fmt.Sprint([]int{supervisor.ID}) {
+		t.Errorf("edited comment = %+v, want it marked edited and mentioning user %d", edited, supervisor.ID)
+	}
+	var revisions []ent.CommentRevision
+	h.Get(edit+"/revisions", staff).Status(200).Decode(&revisions)
+	if len(revisions) != 1 || revisions[0].Body != public.Body {
+		t.Errorf("revisions = %+v, want the first body", revisions)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	tech := h.User().SetRole(user.RoleTechnician).SaveX(ctx)
+	slip := h.RepairSlip(staff).SaveX(ctx)
+	path := fmt.Sprintf("/api/v1/repairslips/%d", slip.ID)
+
+	h.Post(path+"/comments", map[string]interface{}{"body": "Lamp on order", "visibility": "internal"}, tech).Status(200)
+	h.Client.RepairSlip.UpdateOne(slip).SetStatus(repairslip.StatusWaitingParts).ExecX(ctx)
+	h.Post(path+"/comments", map[string]interface{}{"body": "When will it be ready?"}, staff).Status(200)
+	h.Client.RepairSlip.UpdateOne(slip).SetPriority(repairslip.PriorityHigh).SetAssignee(tech).ExecX(ctx)
+
+	timeline := func(as *ent.User) string {
+		t.Helper()
+		var entries []activity.Entry
+		h.Get(path+"/timeline", as).Status(200).Decode(&entries)
+		var items []string
+		for i, e := range entries {
+			if i > 0 && e.Time.Before(entries[i-1].Time) {
+				t.Errorf("entry %d of the timeline is out of order", i)
+			}
+			switch {
+			case e.Comment != nil:
+				items = append(items, e.Type+" "+e.Comment.Body)
+			case e.Activity != nil:
+				items = append(items, fmt.Sprintf("%s %s %s %s", e.Type, e.Activity.Kind, e.Activity.From, e.Activity.To))
+			default:
+				items = append(items, e.Type)
+			}
+		}
+		return strings.Join(items, "; ")
+	}
+	changes := "activity status_changed received waiting_parts; comment When will it be ready?; activity priority_changed normal high; activity assigned  "
+	if got, want := timeline(tech), "created; comment Lamp on order; "+changes; got != want {
+		t.Errorf("timeline of a technician = %q, want %q", got, want)
+	}
+	if got, want := timeline(staff), "created; "+changes; got != want {
+		t.Errorf("timeline of staff = %q, want %q", got, want)
+	}
+	h.Get("/api/v1/repairslips/0/timeline", staff).Status(404)
+}
@@ -0,0 +1,777 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/labels"
+	"github.com/darksford123x/app/locations"
+	"github.com/darksford123x/app/txn"
+	"github.com/darksford123x/app/warranty"
+	"github.com/gin-gonic/gin"
+)
+
+// EquipmentController defines the struct for the equipment controller
+type EquipmentController struct {
+	client  *ent.Client
+	router  gin.IRouter
+	scanURL string
+}
+
+// Equipment defines the struct for creating equipment. Equipment created
+// without an asset tag is given the next EQ- tag of the organization.
+type Equipment struct {
+	Name         string `json:"name" validate:"required" minLength:"1"`
+	SerialNumber string `json:"serial_number" validate:"required" minLength:"1"`
+	Model        string `json:"model"`
+	AssetTag     string `json:"asset_tag" maxLength:"32"`
+	Location     int    `json:"location"`
+	Warranty
+}
+
+// EquipmentMove defines the struct for moving equipment to a location
+type EquipmentMove struct {
+	Location int    `json:"location" validate:"required"`
+	Note     string `json:"note"`
+}
+
+// Warranty defines the struct for setting the warranty of equipment.
+// When a warranty term is given, the provider defaults to the provider of
+// the term and the end to the start plus the duration of the term.
+type Warranty struct {
+	WarrantyStart    *time.Time `json:"warranty_start" format:"date-time" extensions:"x-nullable"`
+	WarrantyEnd      *time.Time `json:"warranty_end" format:"date-time" extensions:"x-nullable"`
+	WarrantyProvider string     `json:"warranty_provider"`
+	WarrantyTerm     int        `json:"warranty_term"`
+}
+
+// CreateEquipment handles POST requests for adding equipment entities
+// @Summary Create equipment
+// @Description Create equipment with its warranty and asset tag, at a location when one is given; only supervisors and admins may
+// @ID create-equipment
+// @Accept   json
+// @Produce  json
+// @Param equipment body Equipment true "Equipment entity"
+// @Success 200 {object} ent.Equipment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment [post]
+func (ctl *EquipmentController) CreateEquipment(c *gin.Context) {
+	obj := Equipment{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "equipment binding failed",
+		})
+		return
+	}
+
+	w, term, err := ctl.warranty(c.Request.Context(), obj.Warranty)
+	if err != nil {
+		equipmentError(c, err)
+		return
+	}
+
+	builder := ctl.client.Equipment.
+		Create().
+		SetName(obj.Name).
+		SetSerialNumber(obj.SerialNumber).
+		SetNillableWarrantyStart(w.WarrantyStart).
+		SetNillableWarrantyEnd(w.WarrantyEnd)
+	if obj.Model != "" {
+		builder.SetModel(obj.Model)
+	}
+	if obj.AssetTag != "" {
+		builder.SetAssetTag(strings.ToUpper(obj.AssetTag))
+	}
+	if w.WarrantyProvider != "" {
+		builder.SetWarrantyProvider(w.WarrantyProvider)
+	}
+	if term != nil {
+		builder.SetWarrantyTerm(term)
+	}
+	if obj.Location != 0 {
+		builder.SetLocationID(obj.Location)
+	}
+	eq, err := builder.Save(c.Request.Context())
+	if err != nil {
+		equipmentError(c, err)
+		return
+	}
+
+	ctl.respond(c, eq.ID)
+}
+
+// GetEquipment handles GET requests to retrieve an equipment entity
+// @Summary Get an equipment entity by ID
+// @Description get equipment by ID with its warranty term, location and repair slips
+// @ID get-equipment
+// @Produce  json
+// @Param id path int true "Equipment ID"
+// @Success 200 {object} ent.Equipment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment/{id} [get]
+func (ctl *EquipmentController) GetEquipment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// ListEquipment handles request to get a list of equipment entities
+// @Summary List equipment entities
+// @Description list equipment entities
+// @ID list-equipment
+// @Produce json
+// @Param limit  query int false "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {array} ent.Equipment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment [get]
+func (ctl *EquipmentController) ListEquipment(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	limit := 10
+	if limitQuery != "" {
+		limit64, err := strconv.ParseInt(limitQuery, 10, 64)
+		if err == nil {
+			limit = int(limit64)
+		}
+	}
+
+	offsetQuery := c.Query("offset")
+	offset := 0
+	if offsetQuery != "" {
+		offset64, err := strconv.ParseInt(offsetQuery, 10, 64)
+		if err == nil {
+			offset = int(offset64)
+		}
+	}
+
+	list, err := ctl.client.Equipment.
+		Query().
+		WithWarrantyTerm().
+		WithLocation().
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, list)
+}
+
+// DeleteEquipment handles DELETE requests to delete an equipment entity
+// @Summary Delete an equipment entity by ID
+// @Description delete equipment by ID; only supervisors and admins may
+// @ID delete-equipment
+// @Produce  json
+// @Param id path int true "Equipment ID"
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment/{id} [delete]
+func (ctl *EquipmentController) DeleteEquipment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	err = ctl.client.Equipment.
+		DeleteOneID(int(id)).
+		Exec(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, gin.H{"result": fmt.Sprintf("ok deleted %v", id)})
+}
+
+// GetEquipmentByTag handles GET requests to look up equipment by its asset tag
+// @Summary Get an equipment entity by asset tag
+// @Description get the equipment of a scanned label by its asset tag, with its warranty term, location and repair slips
+// @ID get-equipment-by-tag
+// @Produce  json
+// @Param tag path string true "Asset tag"
+// @Success 200 {object} ent.Equipment
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment/by-tag/{tag} [get]
+func (ctl *EquipmentController) GetEquipmentByTag(c *gin.Context) {
+	id, err := ctl.client.Equipment.
+		Query().
+		Where(equipment.AssetTagEQ(strings.ToUpper(c.Param("tag")))).
+		OnlyID(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctl.respond(c, id)
+}
+
+// GetEquipmentBarcode handles GET requests to render the asset tag of equipment as a code
+// @Summary Get the barcode of equipment
+// @Description render the asset tag of equipment as a QR code opening the scan URL of the tag, or as a Code128 barcode of the tag
+// @ID get-equipment-barcode
+// @Produce  png,image/svg+xml
+// @Param id     path  int    true  "Equipment ID"
+// @Param type   query string false "qr (default) or code128"
+// @Param format query string false "png (default) or svg"
+// @Param scale  query int    false "Pixels per module, 1 to 32 (default 4)"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment/{id}/barcode [get]
+func (ctl *EquipmentController) GetEquipmentBarcode(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	scale := 4
+	if scaleQuery := c.Query("scale"); scaleQuery != "" {
+		scale64, err := strconv.ParseInt(scaleQuery, 10, 64)
+		if err != nil || scale64 < 1 || scale64 > 32 {
+			c.JSON(400, gin.H{"error": "scale must be a number from 1 to 32"})
+			return
+		}
+		scale = int(scale64)
+	}
+
+	eq, err := ctl.client.Equipment.Get(c.Request.Context(), int(id))
+	if err != nil {
+		equipmentError(c, err)
+		return
+	}
+	if eq.AssetTag == "" {
+		c.JSON(404, gin.H{"error": "equipment has no asset tag"})
+		return
+	}
+
+	symbology := labels.Symbology(c.DefaultQuery("type", string(labels.QR)))
+	content := eq.AssetTag
+	if symbology == labels.QR {
+		content = labels.Content(ctl.scanURL, eq.AssetTag)
+	}
+	code, err := labels.Encode(symbology, content)
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	var (
+		buf         bytes.Buffer
+		contentType string
+	)
+	switch format := c.DefaultQuery("format", "png"); format {
+	case "png":
+		contentType, err = "image/png", code.PNG(&buf, scale)
+	case "svg":
+		contentType, err = "image/svg+xml", code.SVG(&buf, scale)
+	default:
+		c.JSON(400, gin.H{"error": fmt.Sprintf("unknown format %q", format)})
+		return
+	}
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=86400")
+	c.Data(200, contentType, buf.Bytes())
+}
+
+// GetEquipmentLabels handles GET requests to print the labels of equipment
+// @Summary Get a sheet of equipment labels
+// @Description render the labels of equipment as A4 sheets of 3 by 8 labels in PDF, in the order of their asset tags; the labels are those of the equipment given, or of the equipment under a location, or of all the equipment
+// @ID get-equipment-labels
+// @Produce  application/pdf
+// @Param equipment query []int false "Equipment IDs" collectionFormat(multi)
+// @Param location  query int   false "Location ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment/labels [get]
+func (ctl *EquipmentController) GetEquipmentLabels(c *gin.Context) {
+	ctx := c.Request.Context()
+	query := ctl.client.Equipment.
+		Query().
+		Where(equipment.AssetTagNotNil()).
+		WithLocation(func(q *ent.LocationQuery) {
+			q.WithParent(func(q *ent.LocationQuery) {
+				q.WithParent()
+			})
+		})
+
+	if ids := c.QueryArray("equipment"); len(ids) > 0 {
+		in := make([]int, len(ids))
+		for i, s := range ids {
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				c.JSON(400, gin.H{"error": err.Error()})
+				return
+			}
+			in[i] = int(id)
+		}
+		query.Where(equipment.IDIn(in...))
+	}
+
+	if locationQuery := c.Query("location"); locationQuery != "" {
+		id, err := strconv.ParseInt(locationQuery, 10, 64)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		if _, err := ctl.client.Location.Get(ctx, int(id)); err != nil {
+			equipmentError(c, err)
+			return
+		}
+		ids, err := locations.Descendants(ctx, ctl.client, int(id))
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(equipment.HasLocationWith(location.IDIn(ids...)))
+	}
+
+	list, err := query.All(ctx)
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].AssetTag < list[j].AssetTag
+	})
+
+	sheet := make([]labels.Label, len(list))
+	for i, eq := range list {
+		sheet[i] = labels.Label{
+			Tag:      eq.AssetTag,
+			Content:  labels.Content(ctl.scanURL, eq.AssetTag),
+			Name:     eq.Name,
+			Location: locationPath(eq.Edges.Location),
+		}
+	}
+	var buf bytes.Buffer
+	if err := labels.Sheet(&buf, sheet); err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Data(200, "application/pdf", buf.Bytes())
+}
+
+// UpdateWarranty handles PUT requests to replace the warranty of equipment
+// @Summary Replace the warranty of equipment
+// @Description set the warranty period, provider and term of equipment; omitted fields are cleared; only supervisors and admins may
+// @ID update-warranty
+// @Accept   json
+// @Produce  json
+// @Param id path int true "Equipment ID"
+// @Param warranty body Warranty true "Warranty"
+// @Success 200 {object} ent.Equipment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment/{id}/warranty [put]
+func (ctl *EquipmentController) UpdateWarranty(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := Warranty{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "warranty binding failed",
+		})
+		return
+	}
+
+	w, term, err := ctl.warranty(c.Request.Context(), obj)
+	if err != nil {
+		equipmentError(c, err)
+		return
+	}
+
+	update := ctl.client.Equipment.
+		UpdateOneID(int(id)).
+		ClearWarrantyStart().
+		ClearWarrantyEnd().
+		ClearWarrantyProvider().
+		ClearWarrantyTerm().
+		SetNillableWarrantyStart(w.WarrantyStart).
+		SetNillableWarrantyEnd(w.WarrantyEnd)
+	if w.WarrantyProvider != "" {
+		update.SetWarrantyProvider(w.WarrantyProvider)
+	}
+	if term != nil {
+		update.SetWarrantyTerm(term)
+	}
+	if _, err := update.Save(c.Request.Context()); err != nil {
+		equipmentError(c, err)
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// MoveEquipment handles POST requests to move equipment to a location
+// @Summary Move equipment
+// @Description move equipment to a location; the move is kept in the history of the equipment; only supervisors and admins may
+// @ID move-equipment
+// @Accept   json
+// @Produce  json
+// @Param id path int true "Equipment ID"
+// @Param move body EquipmentMove true "Move"
+// @Success 200 {object} ent.EquipmentMove
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment/{id}/moves [post]
+func (ctl *EquipmentController) MoveEquipment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := EquipmentMove{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "move binding failed",
+		})
+		return
+	}
+
+	var mv *ent.EquipmentMove
+	err = txn.WithTx(c.Request.Context(), ctl.client, func(ctx context.Context, tx *ent.Tx) error {
+		mv, err = locations.Move(ctx, tx, int(id), obj.Location, obj.Note)
+		return err
+	})
+	if err != nil {
+		locationError(c, err)
+		return
+	}
+
+	c.JSON(200, mv)
+}
+
+// ListEquipmentMove handles request to get the moves of equipment
+// @Summary List the moves of equipment
+// @Description list the moves of equipment between locations, latest first
+// @ID list-equipment-move
+// @Produce json
+// @Param id path int true "Equipment ID"
+// @Param limit  query int false "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {array} ent.EquipmentMove
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /equipment/{id}/moves [get]
+func (ctl *EquipmentController) ListEquipmentMove(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	limitQuery := c.Query("limit")
+	limit := 10
+	if limitQuery != "" {
+		limit64, err := strconv.ParseInt(limitQuery, 10, 64)
+		if err == nil {
+			limit = int(limit64)
+		}
+	}
+
+	offsetQuery := c.Query("offset")
+	offset := 0
+	if offsetQuery != "" {
+		offset64, err := strconv.ParseInt(offsetQuery, 10, 64)
+		if err == nil {
+			offset = int(offset64)
+		}
+	}
+
+	moves, err := ctl.client.EquipmentMove.
+		Query().
+		Where(equipmentmove.HasEquipmentWith(equipment.IDEQ(int(id)))).
+		WithFromLocation().
+		WithToLocation().
+		WithMovedBy().
+		Order(ent.Desc(equipmentmove.FieldCreateTime), ent.Desc(equipmentmove.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, moves)
+}
+
+// ListExpiringWarranty handles request to get the equipment whose warranty expires soon
+// @Summary List expiring warranties
+// @Description list equipment whose warranty is still running and ends within the next N days, soonest first
+// @ID list-expiring-warranty
+// @Produce json
+// @Param days query int false "Days ahead (default 30)"
+// @Success 200 {array} ent.Equipment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /warranties/expiring [get]
+func (ctl *EquipmentController) ListExpiringWarranty(c *gin.Context) {
+	days := 30
+	if daysQuery := c.Query("days"); daysQuery != "" {
+		days64, err := strconv.ParseInt(daysQuery, 10, 64)
+		if err != nil || days64 < 0 {
+			c.JSON(400, gin.H{"error": "days must be a non-negative number"})
+			return
+		}
+		days = int(days64)
+	}
+
+	list, err := warranty.Expiring(c.Request.Context(), ctl.client, days)
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, list)
+}
+
+// CreateWarrantyTerm handles POST requests for adding warrantyterm entities
+// @Summary Create warrantyterm
+// @Description Create warrantyterm; only supervisors and admins may
+// @ID create-warrantyterm
+// @Accept   json
+// @Produce  json
+// @Param warrantyterm body ent.WarrantyTerm true "WarrantyTerm entity"
+// @Success 200 {object} ent.WarrantyTerm
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /warranty-terms [post]
+func (ctl *EquipmentController) CreateWarrantyTerm(c *gin.Context) {
+	obj := ent.WarrantyTerm{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "warrantyterm binding failed",
+		})
+		return
+	}
+
+	builder := ctl.client.WarrantyTerm.
+		Create().
+		SetName(obj.Name).
+		SetProvider(obj.Provider).
+		SetMonths(obj.Months).
+		SetClaimContact(obj.ClaimContact).
+		SetConditions(obj.Conditions)
+	if obj.Coverage != "" {
+		builder.SetCoverage(obj.Coverage)
+	}
+	t, err := builder.Save(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, t)
+}
+
+// ListWarrantyTerm handles request to get a list of warrantyterm entities
+// @Summary List warrantyterm entities
+// @Description list warrantyterm entities
+// @ID list-warrantyterm
+// @Produce json
+// @Success 200 {array} ent.WarrantyTerm
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /warranty-terms [get]
+func (ctl *EquipmentController) ListWarrantyTerm(c *gin.Context) {
+	terms, err := ctl.client.WarrantyTerm.
+		Query().
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, terms)
+}
+
+// warranty fills in the warranty from its term.
+func (ctl *EquipmentController) warranty(ctx context.Context, w Warranty) (Warranty, *ent.WarrantyTerm, error) {
+	if w.WarrantyTerm == 0 {
+		return w, nil, nil
+	}
+	term, err := ctl.client.WarrantyTerm.Get(ctx, w.WarrantyTerm)
+	if err != nil {
+		return w, nil, err
+	}
+	if w.WarrantyProvider == "" {
+		w.WarrantyProvider = term.Provider
+	}
+	if w.WarrantyStart != nil && w.WarrantyEnd == nil {
+		end := warranty.End(*w.WarrantyStart, term)
+		w.WarrantyEnd = &end
+	}
+	return w, term, nil
+}
+
+// respond writes the equipment with its warranty term, location and repair
+// slips loaded.
+func (ctl *EquipmentController) respond(c *gin.Context, id int) {
+	eq, err := ctl.client.Equipment.
+		Query().
+		Where(equipment.IDEQ(id)).
+		WithWarrantyTerm().
+		WithLocation().
+		WithRepairSlips().
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, eq)
+}
+
+// locationPath returns the place of a location printed on labels, from the
+// building down, such as "Engineering, Floor 4, 4102".
+func locationPath(l *ent.Location) string {
+	var path []string
+	for ; l != nil && l.Kind != location.KindCampus; l = l.Edges.Parent {
+		path = append([]string{l.Name}, path...)
+	}
+	return strings.Join(path, ", ")
+}
+
+// equipmentError maps errors of the equipment operations to a response.
+func equipmentError(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(404, gin.H{"error": err.Error()})
+	case ent.IsConstraintError(err):
+		c.JSON(409, gin.H{"error": err.Error()})
+	default:
+		c.JSON(400, gin.H{"error": err.Error()})
+	}
+}
+
+// NewEquipmentController creates and registers handles for the equipment
+// controller. The QR codes of the asset tags open scanURL with the tag
+// appended, or hold the bare tag when it is empty.
+func NewEquipmentController(router gin.IRouter, client *ent.Client, scanURL string) *EquipmentController {
+	ec := &EquipmentController{
+		client:  client,
+		router:  router,
+		scanURL: scanURL,
+	}
+	ec.register()
+	return ec
+}
+
+// register registers routes to the main engine
+func (ctl *EquipmentController) register() {
+	equipment := ctl.router.Group("/equipment", auth.Require())
+
+	equipment.GET("", ctl.ListEquipment)
+
+	// CRUD
+	equipment.POST("", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.CreateEquipment)
+	equipment.GET(":id", ctl.GetEquipment)
+	equipment.DELETE(":id", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.DeleteEquipment)
+
+	// Warranty
+	equipment.PUT(":id/warranty", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.UpdateWarranty)
+
+	// Location
+	equipment.POST(":id/moves", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.MoveEquipment)
+	equipment.GET(":id/moves", ctl.ListEquipmentMove)
+
+	// Labels
+	equipment.GET("by-tag/:tag", ctl.GetEquipmentByTag)
+	equipment.GET(":id/barcode", ctl.GetEquipmentBarcode)
+	equipment.GET("labels", ctl.GetEquipmentLabels)
+	ctl.router.GET("/warranties/expiring", auth.Require(), ctl.ListExpiringWarranty)
+
+	terms := ctl.router.Group("/warranty-terms", auth.Require())
+	terms.GET("", ctl.ListWarrantyTerm)
+	terms.POST("", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.CreateWarrantyTerm)
+}
@@ -0,0 +1,93 @@
+package controllers_test
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+)
+
+func TestAssetTags(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+	locs := campus(h, admin)
+
+	first := h.Equipment().SetLocationID(locs["4102"].ID).SaveX(ctx)
+	var second, custom ent.Equipment
+	h.Post("/api/v1/equipment", controllers.Equipment{Name: "Printer", SerialNumber: "PR-1"}, admin).
+		Status(200).
+		Decode(&second)
+	h.Post("/api/v1/equipment", controllers.Equipment{Name: "Scope", SerialNumber: "SC-1", AssetTag: "lab-7"}, admin).
+		Status(200).
+		Decode(&custom)
+	if first.AssetTag != "EQ-000001" || second.AssetTag != "EQ-000002" || custom.AssetTag != "LAB-7" {
+		t.Errorf("asset tags = %q, %q, %q, want EQ-000001, EQ-000002 and LAB-7", first.AssetTag, second.AssetTag, custom.AssetTag)
+	}
+
+	// The tags of the sequence are only given automatically, and tags are
+	// unique.
+	h.Post("/api/v1/equipment", controllers.Equipment{Name: "Laptop", SerialNumber: "LT-1", AssetTag: "EQ-000009"}, admin).Status(400)
+	h.Post("/api/v1/equipment", controllers.Equipment{Name: "Laptop", SerialNumber: "LT-1", AssetTag: "LAB-7"}, admin).Status(409)
+
+	var found ent.Equipment
+	h.Get("/api/v1/equipment/by-tag/eq-000001", admin).Status(200).Decode(&found)
+	if found.ID != first.ID || found.Edges.Location == nil {
+		t.Errorf("equipment by tag = %d, want %d with its location", found.ID, first.ID)
+	}
+	h.Get("/api/v1/equipment/by-tag/EQ-000404", admin).Status(404)
+
+	path := fmt.Sprintf("/api/v1/equipment/%d/barcode", first.ID)
+	resp := h.Get(path+"?scale=2", admin).Status(200)
+	img, err := png.Decode(resp.Body)
+	if err != nil {
+		t.Fatalf("decoding the QR code: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != b.Dy() || b.Dx()%2 != 0 {
+		t.Errorf("QR code of %v, want a square of 2 pixels per module", b)
+	}
+	resp = h.Get(path+"?type=code128&format=svg", admin).Status(200)
+	if ct := resp.Header().Get("Content-Type"); ct != "image/svg+xml" || !strings.HasPrefix(resp.Body.String(), "<svg") {
+		t.Errorf("barcode of type %s: %.40s, want an SVG image", ct, resp.Body.String())
+	}
+	h.Get(path+"?type=ean13", admin).Status(400)
+	h.Get(path+"?scale=100", admin).Status(400)
+
+	resp = h.Get(fmt.Sprintf("/api/v1/equipment/labels?location=%d", locs["Bang Khen"].ID), admin).Status(200)
+	if ct := resp.Header().Get("Content-Type"); ct != "application/pdf" || !bytes.HasPrefix(resp.Body.Bytes(), []byte("%PDF")) {
+		t.Errorf("labels of type %s, want a PDF", ct)
+	}
+	h.Get(fmt.Sprintf("/api/v1/equipment/labels?equipment=%d&equipment=%d", first.ID, custom.ID), admin).Status(200)
+	h.Get("/api/v1/equipment/labels?location=404", admin).Status(404)
+}
+
+func TestEquipmentSupervisorsOnly(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	eq := h.Equipment().SaveX(ctx)
+	term := map[string]interface{}{"name": "Two years on site", "provider": "Epson", "months": 24, "coverage": "parts_and_labour"}
+	warranty := controllers.Warranty{WarrantyProvider: "Epson"}
+
+	// Staff look equipment up, but do not change it.
+	h.Post("/api/v1/equipment", controllers.Equipment{Name: "Printer", SerialNumber: "PR-1"}, staff).Status(403)
+	h.Put(fmt.Sprintf("/api/v1/equipment/%d/warranty", eq.ID), warranty, staff).Status(403)
+	h.Delete(fmt.Sprintf("/api/v1/equipment/%d", eq.ID), staff).Status(403)
+	h.Post("/api/v1/warranty-terms", term, staff).Status(403)
+	h.Get(fmt.Sprintf("/api/v1/equipment/%d", eq.ID), staff).Status(200)
+	if n := h.Client.WarrantyTerm.Query().CountX(ctx); n != 0 {
+		t.Errorf("%d warranty terms created by staff, want none", n)
+	}
+
+	h.Post("/api/v1/equipment", controllers.Equipment{Name: "Printer", SerialNumber: "PR-1"}, supervisor).Status(200)
+	h.Put(fmt.Sprintf("/api/v1/equipment/%d/warranty", eq.ID), warranty, supervisor).Status(200)
+	h.Post("/api/v1/warranty-terms", term, supervisor).Status(200)
+	h.Delete(fmt.Sprintf("/api/v1/equipment/%d", eq.ID), supervisor).Status(200)
+}
@@ -0,0 +1,228 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/stream"
+	"github.com/darksford123x/app/tenant"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// EventController defines the struct for the event controller
+type EventController struct {
+	client *ent.Client
+	broker *stream.Broker
+	tokens *auth.Tokens
+	// origins are the origins of the pages that may open WebSockets,
+	// besides the API's own.
+	origins []string
+	router  gin.IRouter
+}
+
+// StreamTicket defines the struct of a ticket to open an event stream with
+type StreamTicket struct {
+	Ticket    string    `json:"ticket"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// heartbeat is how often an idle stream is written to, so that proxies do
+// not close it.
+const heartbeat = 25 * time.Second
+
+// CreateStreamTicket handles POST requests for a ticket to open an event
+// stream with
+// @Summary Create a stream ticket
+// @Description a ticket for clients that cannot send the Authorization header, such as EventSource, to open one event stream with as the ticket parameter. It is valid for 30 seconds and only once, so unlike the bearer token it is of no use in logs and histories.
+// @ID create-stream-ticket
+// @Produce  json
+// @Success 200 {object} StreamTicket
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /events/ticket [post]
+func (ctl *EventController) CreateStreamTicket(c *gin.Context) {
+	ctx := c.Request.Context()
+	org, _ := tenant.FromContext(ctx)
+	ticket, expires, err := ctl.tokens.IssueTicket(auth.FromContext(ctx).ID, org)
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(200, StreamTicket{Ticket: ticket, ExpiresAt: expires})
+}
+
+// StreamEvents handles GET requests for the live event stream
+// @Summary Stream events
+// @Description Server-Sent Events of the creates, updates and deletes of users and repair slips the caller may see, as they are committed. Reconnecting clients resume after the Last-Event-ID header or the last_event_id parameter; a "reset" event means events were missed and the data should be reloaded. EventSource clients pass a stream ticket instead of the token.
+// @ID stream-events
+// @Produce  text/event-stream
+// @Param last_event_id query string false "ID of the last event received"
+// @Param ticket query string false "Stream ticket"
+// @Success 200 {object} stream.Message
+// @Failure 401 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /events [get]
+func (ctl *EventController) StreamEvents(c *gin.Context) {
+	lastID := c.GetHeader("Last-Event-ID")
+	if lastID == "" {
+		lastID = c.Query("last_event_id")
+	}
+	ctx := c.Request.Context()
+	org, _ := tenant.FromContext(ctx)
+	sub, backlog := ctl.broker.Subscribe(org, auth.FromContext(ctx), lastID)
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(200)
+
+	w := c.Writer
+	fmt.Fprint(w, "retry: 3000\n\n")
+	write := func(m stream.Message) error {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", m.ID, m.Type, data)
+		return err
+	}
+	for _, m := range backlog {
+		if write(m) != nil {
+			return
+		}
+	}
+	w.Flush()
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case m, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			if write(m) != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+		w.Flush()
+	}
+}
+
+// StreamEventsWebSocket handles WebSocket connections for the live event
+// stream
+// @Summary Stream events over a WebSocket
+// @Description the events of /events as JSON text messages over a WebSocket, for clients that prefer one. Resume with last_event_id. Browsers pass a stream ticket instead of the token, from the API's own origin or an allowed one.
+// @ID stream-events-websocket
+// @Param last_event_id query string false "ID of the last event received"
+// @Param ticket query string false "Stream ticket"
+// @Success 101 {object} stream.Message
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /events/ws [get]
+func (ctl *EventController) StreamEventsWebSocket(c *gin.Context) {
+	ctx := c.Request.Context()
+	org, _ := tenant.FromContext(ctx)
+	sub, backlog := ctl.broker.Subscribe(org, auth.FromContext(ctx), c.Query("last_event_id"))
+	defer sub.Close()
+
+	server := websocket.Server{
+		// CORS does not apply to WebSockets, so the origin is checked
+		// here.
+		Handshake: func(_ *websocket.Config, r *http.Request) error { return ctl.checkOrigin(r) },
+		Handler: func(ws *websocket.Conn) {
+			// The client sends nothing; reading tells when it leaves.
+			gone := make(chan struct{})
+			go func() {
+				var discard []byte
+				for websocket.Message.Receive(ws, &discard) == nil {
+				}
+				close(gone)
+			}()
+			for _, m := range backlog {
+				if websocket.JSON.Send(ws, m) != nil {
+					return
+				}
+			}
+			for {
+				select {
+				case <-gone:
+					return
+				case m, ok := <-sub.C:
+					if !ok {
+						return
+					}
+					if websocket.JSON.Send(ws, m) != nil {
+						return
+					}
+				}
+			}
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// errOrigin is returned for WebSockets opened by pages of other origins.
+var errOrigin = errors.New("origin not allowed")
+
+// checkOrigin refuses requests from browser pages of origins other than the
+// API's own and the allowed ones. Clients other than browsers send no
+// Origin.
+func (ctl *EventController) checkOrigin(r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	for _, o := range ctl.origins {
+		if strings.EqualFold(origin, o) {
+			return nil
+		}
+	}
+	u, err := url.Parse(origin)
+	if err != nil || !strings.EqualFold(u.Host, r.Host) {
+		return errOrigin
+	}
+	return nil
+}
+
+// NewEventController creates and registers handles for the event controller
+func NewEventController(router gin.IRouter, client *ent.Client, broker *stream.Broker, tokens *auth.Tokens, origins []string) *EventController {
+	ec := &EventController{
+		client:  client,
+		broker:  broker,
+		tokens:  tokens,
+		origins: origins,
+		router:  router,
+	}
+	ec.register()
+	return ec
+}
+
+// register registers routes to the main engine
+func (ctl *EventController) register() {
+	group := ctl.router.Group("/events")
+	group.POST("ticket", auth.Require(), ctl.CreateStreamTicket)
+
+	// Only the streams accept tickets.
+	streams := group.Group("", auth.TicketMiddleware(ctl.client, ctl.tokens), auth.Require())
+	streams.GET("", ctl.StreamEvents)
+	streams.GET("ws", ctl.StreamEventsWebSocket)
+}
@@ -0,0 +1,104 @@
+package controllers_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/servertest"
+	"golang.org/x/net/websocket"
+)
+
+func TestStreamTickets(t *testing.T) {
+	h := servertest.New(t)
+	u := h.User().SaveX(h.Context())
+	srv := httptest.NewServer(h.Server.Router)
+	defer srv.Close()
+
+	// open opens the event stream with the query and returns its status,
+	// reading the first line of the stream when it opens.
+	open := func(query string) int {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/api/v1/events?"+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == 200 {
+			line, err := bufio.NewReader(resp.Body).ReadString('\n')
+			if err != nil || !strings.HasPrefix(line, "retry:") {
+				t.Fatalf("first line of the stream = %q, %v", line, err)
+			}
+		}
+		return resp.StatusCode
+	}
+
+	h.Post("/api/v1/events/ticket", nil, nil).Status(401)
+	var ticket controllers.StreamTicket
+	h.Post("/api/v1/events/ticket", nil, u).Status(200).Decode(&ticket)
+	if until := time.Until(ticket.ExpiresAt); until <= 0 || until > time.Minute {
+		t.Errorf("ticket expires in %v, want within a minute", until)
+	}
+
+	if code := open("ticket=" + ticket.Ticket); code != 200 {
+		t.Fatalf("status %d with a ticket, want 200", code)
+	}
+	// Tickets are redeemed once, and only on the streams.
+	if code := open("ticket=" + ticket.Ticket); code != 401 {
+		t.Errorf("status %d with a redeemed ticket, want 401", code)
+	}
+	h.Post("/api/v1/events/ticket", nil, u).Status(200).Decode(&ticket)
+	h.Get("/api/v1/users?ticket="+ticket.Ticket, nil).Status(401)
+
+	// Bearer tokens are only accepted in the Authorization header, and
+	// tickets are no bearer tokens.
+	if code := open("access_token=" + h.Token(u)); code != 401 {
+		t.Errorf("status %d with a token in the query, want 401", code)
+	}
+	h.Do(servertest.Request{
+		Method: http.MethodGet,
+		Path:   "/api/v1/users",
+		Header: http.Header{"Authorization": {"Bearer " + ticket.Ticket}},
+	}).Status(401)
+}
+
+func TestStreamWebSocketOrigin(t *testing.T) {
+	cfg := servertest.Config(t)
+	cfg.AllowedOrigins = []string{"https://repairs.example.com"}
+	h := servertest.NewWithConfig(t, cfg)
+	u := h.User().SaveX(h.Context())
+	srv := httptest.NewServer(h.Server.Router)
+	defer srv.Close()
+
+	dial := func(origin string) error {
+		config, err := websocket.NewConfig("ws"+strings.TrimPrefix(srv.URL, "http")+"/api/v1/events/ws", origin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.Header.Set("Authorization", "Bearer "+h.Token(u))
+		ws, err := websocket.DialConfig(config)
+		if err == nil {
+			ws.Close()
+		}
+		return err
+	}
+
+	for _, origin := range []string{srv.URL, "https://repairs.example.com"} {
+		if err := dial(origin); err != nil {
+			t.Errorf("opening a WebSocket from %s: %v", origin, err)
+		}
+	}
+	if err := dial("https://evil.example.com"); err == nil {
+		t.Error("opened a WebSocket from an origin that is not allowed")
+	}
+}
@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"context"
+	"html/template"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/comments"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/gql"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+)
+
+// GraphQLController defines the struct for the GraphQL controller
+type GraphQLController struct {
+	schema *gql.Schema
+	router gin.IRouter
+}
+
+// graphqlMutable are the types with create, update and delete mutations.
+// Repair slips, stock, billing and the rest change through their own
+// endpoints, which keep them consistent.
+var graphqlMutable = []string{"User", "Equipment", "Part", "Holiday", "SLAPolicy", "WarrantyTerm"}
+
+// isAdmin reports whether the user of the request is an admin.
+func isAdmin(ctx context.Context) bool {
+	u := auth.FromContext(ctx)
+	return u != nil && auth.HasRole(u, user.RoleAdmin)
+}
+
+// GraphQLOptions returns the options of the GraphQL schema: the only
+// organization seen is that of the user, internal comments are hidden as
+// they are by REST, jobs and webhooks are only seen by admins, as their
+// endpoints are, and only supervisors and admins may run mutations, and
+// only admins those of users.
+func GraphQLOptions() gql.Options {
+	return gql.Options{
+		Scopes: gql.Scopes{
+			Organization: func(ctx context.Context) []predicate.Organization {
+				org, _ := tenant.FromContext(ctx)
+				return []predicate.Organization{organization.ID(org)}
+			},
+			Comment: func(ctx context.Context) []predicate.Comment {
+				if comments.CanReadInternal(auth.FromContext(ctx)) {
+					return nil
+				}
+				return []predicate.Comment{comment.VisibilityEQ(comment.VisibilityPublic)}
+			},
+			CommentRevision: func(ctx context.Context) []predicate.CommentRevision {
+				if comments.CanReadInternal(auth.FromContext(ctx)) {
+					return nil
+				}
+				return []predicate.CommentRevision{
+					commentrevision.HasCommentWith(comment.VisibilityEQ(comment.VisibilityPublic)),
+				}
+			},
+			// Job payloads, webhook secrets and what the endpoints answered
+			// are for admins.
+			Job: func(ctx context.Context) []predicate.Job {
+				if isAdmin(ctx) {
+					return nil
+				}
+				return []predicate.Job{func(s *sql.Selector) { s.Where(sql.False()) }}
+			},
+			Webhook: func(ctx context.Context) []predicate.Webhook {
+				if isAdmin(ctx) {
+					return nil
+				}
+				return []predicate.Webhook{func(s *sql.Selector) { s.Where(sql.False()) }}
+			},
+			WebhookDelivery: func(ctx context.Context) []predicate.WebhookDelivery {
+				if isAdmin(ctx) {
+					return nil
+				}
+				return []predicate.WebhookDelivery{func(s *sql.Selector) { s.Where(sql.False()) }}
+			},
+		},
+		Mutable: graphqlMutable,
+		Authorize: func(ctx context.Context, mutation string) error {
+			u := auth.FromContext(ctx)
+			if u == nil {
+				return auth.ErrUnauthenticated
+			}
+			switch mutation {
+			case "createUser", "updateUser", "deleteUser":
+				// Their inputs set roles, which only admins may, as over
+				// REST and gRPC.
+				if !auth.MayManageUsers(u) {
+					return auth.ErrForbidden
+				}
+				return nil
+			}
+			if !auth.HasRole(u, user.RoleSupervisor, user.RoleAdmin) {
+				return auth.ErrForbidden
+			}
+			return nil
+		},
+	}
+}
+
+// Query handles POST requests to run GraphQL queries and mutations
+// @Summary Run a GraphQL query
+// @Description run a query or mutation of the GraphQL schema generated from the ent schemas. Every type can be read by ID or listed as a Relay connection filtered by a where input; edges are loaded in batches. Equipment, parts, holidays, SLA policies and warranty terms can be created, updated and deleted by supervisors and admins, and users by admins. Internal comments are hidden from staff, and jobs, webhooks and their deliveries from all but admins. Errors are reported in the errors of the result.
+// @ID graphql
+// @Accept json
+// @Produce json
+// @Param request body gql.Request true "GraphQL request"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /graphql [post]
+func (ctl *GraphQLController) Query(c *gin.Context) {
+	var req gql.Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Query == "" {
+		c.JSON(400, gin.H{"error": "query is required"})
+		return
+	}
+
+	c.JSON(200, ctl.schema.Do(c.Request.Context(), req))
+}
+
+// NewGraphQLController creates and registers handles for the GraphQL controller
+func NewGraphQLController(router gin.IRouter, schema *gql.Schema) *GraphQLController {
+	gc := &GraphQLController{
+		schema: schema,
+		router: router,
+	}
+	gc.register()
+	return gc
+}
+
+// register registers routes to the main engine
+func (ctl *GraphQLController) register() {
+	ctl.router.POST("/graphql", auth.Require(), ctl.Query)
+}
+
+// graphiql is the GraphiQL playground page. Requests are signed with the
+// bearer token set in its headers tab.
+var graphiql = template.Must(template.New("graphiql").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>GraphiQL</title>
+  <link rel="stylesheet" href="https://unpkg.com/graphiql@2.4.7/graphiql.min.css">
+  <style>body { margin: 0; height: 100vh; } #graphiql { height: 100vh; }</style>
+</head>
+<body>
+  <div id="graphiql"></div>
+  <script src="https://unpkg.com/react@18/umd/react.production.min.js"></script>
+  <script src="https://unpkg.com/react-dom@18/umd/react-dom.production.min.js"></script>
+  <script src="https://unpkg.com/graphiql@2.4.7/graphiql.min.js"></script>
+  <script>
+    ReactDOM.createRoot(document.getElementById("graphiql")).render(
+      React.createElement(GraphiQL, {
+        fetcher: GraphiQL.createFetcher({ url: {{ .Endpoint }} }),
+        headerEditorEnabled: true,
+        shouldPersistHeaders: true,
+        defaultHeaders: '{"Authorization": "Bearer "}',
+      })
+    );
+  </script>
+</body>
+</html>
+`))
+
+// GraphiQL serves the GraphiQL playground querying the GraphQL endpoint.
+func GraphiQL(endpoint string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		if err := graphiql.Execute(c.Writer, struct{ Endpoint string }{endpoint}); err != nil {
+			c.Status(500)
+		}
+	}
+}
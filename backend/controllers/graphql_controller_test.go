@@ -0,0 +1,80 @@
+package controllers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+)
+
+func TestGraphQLUserMutations(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+
+	// mutate runs a mutation as a user and returns its error message.
+	mutate := func(query string, as *ent.User) string {
+		var res struct {
+			Errors []struct{ Message string } `json:"errors"`
+		}
+		h.Post("/api/v1/graphql", map[string]string{"query": query}, as).Status(200).Decode(&res)
+		if len(res.Errors) == 0 {
+			return ""
+		}
+		return res.Errors[0].Message
+	}
+
+	promote := fmt.Sprintf(`mutation { updateUser(id: %d, input: {role: admin}) { role } }`, supervisor.ID)
+	if msg := mutate(promote, supervisor); msg != auth.ErrForbidden.Error() {
+		t.Errorf("a supervisor making themselves an admin: %q, want %q", msg, auth.ErrForbidden)
+	}
+	if msg := mutate(`mutation { createUser(input: {name: "Malee", age: 20, role: admin}) { id } }`, supervisor); msg != auth.ErrForbidden.Error() {
+		t.Errorf("a supervisor creating an admin: %q, want %q", msg, auth.ErrForbidden)
+	}
+	if got := h.Client.User.GetX(ctx, supervisor.ID); got.Role != user.RoleSupervisor {
+		t.Fatalf("role = %s, want supervisor", got.Role)
+	}
+
+	if msg := mutate(promote, admin); msg != "" {
+		t.Errorf("an admin changing a role: %s", msg)
+	}
+	if msg := mutate(`mutation { createEquipment(input: {name: "Printer", serialNumber: "PR-1"}) { id } }`, supervisor); msg != "" {
+		t.Errorf("a supervisor creating equipment: %s", msg)
+	}
+}
+
+func TestGraphQLJobsAndWebhooksAdminsOnly(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+	h.Post("/api/v1/webhooks", controllers.Webhook{URL: "https://example.com/hook", Events: []string{"user.created"}}, admin).Status(200)
+	// The creation of a user is queued for delivery.
+	h.User().SaveX(ctx)
+
+	// counts returns the number of jobs, webhooks and deliveries a user
+	// reads.
+	counts := func(as *ent.User) string {
+		var res struct {
+			Data struct {
+				Jobs              struct{ TotalCount int } `json:"jobs"`
+				Webhooks          struct{ TotalCount int } `json:"webhooks"`
+				WebhookDeliveries struct{ TotalCount int } `json:"webhookDeliveries"`
+			} `json:"data"`
+		}
+		query := `{ jobs { totalCount } webhooks { totalCount } webhookDeliveries { totalCount } }`
+		h.Post("/api/v1/graphql", map[string]string{"query": query}, as).Status(200).Decode(&res)
+		return fmt.Sprint(res.Data.Jobs.TotalCount, res.Data.Webhooks.TotalCount, res.Data.WebhookDeliveries.TotalCount)
+	}
+	if got := counts(admin); got != "1 1 1" {
+		t.Errorf("an admin reads %s jobs, webhooks and deliveries, want 1 1 1", got)
+	}
+	if got := counts(staff); got != "0 0 0" {
+		t.Errorf("staff read %s jobs, webhooks and deliveries, want none", got)
+	}
+}
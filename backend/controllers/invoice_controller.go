@@ -0,0 +1,320 @@
+package controllers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/billing"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/gin-gonic/gin"
+)
+
+// InvoiceController defines the struct for the invoice controller
+type InvoiceController struct {
+	client *ent.Client
+	biller *billing.Biller
+	router gin.IRouter
+}
+
+// CreditNote defines the struct for issuing a credit note
+type CreditNote struct {
+	Reason string         `json:"reason" validate:"required" minLength:"1"`
+	Lines  []billing.Line `json:"lines"`
+}
+
+// CreateInvoice handles POST requests to issue the invoice of a repairslip
+// @Summary Issue the invoice of a repairslip
+// @Description issue an invoice for a closed repairslip from its labour, parts used and fees; only supervisors and admins may
+// @ID create-invoice
+// @Accept   json
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Param request body billing.Request true "Labour, fees and discount"
+// @Success 200 {object} ent.Invoice
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/invoice [post]
+func (ctl *InvoiceController) CreateInvoice(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := billing.Request{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "invoice binding failed",
+		})
+		return
+	}
+
+	inv, err := ctl.biller.Issue(c.Request.Context(), int(id), obj)
+	if err != nil {
+		billingError(c, err)
+		return
+	}
+
+	ctl.respond(c, inv.ID)
+}
+
+// PreviewInvoice handles POST requests to compute the invoice of a repairslip without issuing it
+// @Summary Preview the invoice of a repairslip
+// @Description compute the invoice of a closed repairslip without issuing it
+// @ID preview-invoice
+// @Accept   json
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Param request body billing.Request true "Labour, fees and discount"
+// @Success 200 {object} billing.Draft
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/invoice/preview [post]
+func (ctl *InvoiceController) PreviewInvoice(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := billing.Request{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "invoice binding failed",
+		})
+		return
+	}
+
+	d, err := ctl.biller.Preview(c.Request.Context(), int(id), obj)
+	if err != nil {
+		billingError(c, err)
+		return
+	}
+
+	c.JSON(200, d)
+}
+
+// GetInvoice handles GET requests to retrieve an invoice entity
+// @Summary Get an invoice entity by ID
+// @Description get invoice by ID with its lines, credit notes and payments
+// @ID get-invoice
+// @Produce  json
+// @Param id path int true "Invoice ID"
+// @Success 200 {object} ent.Invoice
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /invoices/{id} [get]
+func (ctl *InvoiceController) GetInvoice(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// ListInvoice handles request to get a list of invoice entities
+// @Summary List invoice entities
+// @Description list invoices and credit notes, newest first
+// @ID list-invoice
+// @Produce json
+// @Param limit  query int false "Limit"
+// @Param offset query int false "Offset"
+// @Param kind query string false "invoice or credit_note"
+// @Param customer query int false "Customer ID"
+// @Success 200 {array} ent.Invoice
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /invoices [get]
+func (ctl *InvoiceController) ListInvoice(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	limit := 10
+	if limitQuery != "" {
+		limit64, err := strconv.ParseInt(limitQuery, 10, 64)
+		if err == nil {
+			limit = int(limit64)
+		}
+	}
+
+	offsetQuery := c.Query("offset")
+	offset := 0
+	if offsetQuery != "" {
+		offset64, err := strconv.ParseInt(offsetQuery, 10, 64)
+		if err == nil {
+			offset = int(offset64)
+		}
+	}
+
+	query := ctl.client.Invoice.
+		Query().
+		WithCustomer()
+
+	if kind := c.Query("kind"); kind != "" {
+		k := invoice.Kind(kind)
+		if err := invoice.KindValidator(k); err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(invoice.KindEQ(k))
+	}
+
+	if customerQuery := c.Query("customer"); customerQuery != "" {
+		customer, err := strconv.ParseInt(customerQuery, 10, 64)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(invoice.HasCustomerWith(user.IDEQ(int(customer))))
+	}
+
+	invoices, err := query.
+		Order(ent.Desc(invoice.FieldCreateTime), ent.Desc(invoice.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, invoices)
+}
+
+// CreateCreditNote handles POST requests to issue a credit note against an invoice
+// @Summary Issue a credit note
+// @Description issue a credit note against an invoice; the credit may not exceed what is left of the invoice; only supervisors and admins may
+// @ID create-credit-note
+// @Accept   json
+// @Produce  json
+// @Param id path int true "Invoice ID"
+// @Param creditnote body CreditNote true "Reason and lines to credit"
+// @Success 200 {object} ent.Invoice
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /invoices/{id}/credit-notes [post]
+func (ctl *InvoiceController) CreateCreditNote(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := CreditNote{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "credit note binding failed",
+		})
+		return
+	}
+	if obj.Reason == "" {
+		c.JSON(400, gin.H{
+			"error": "a credit note needs a reason",
+		})
+		return
+	}
+	for i := range obj.Lines {
+		if obj.Lines[i].Kind == "" {
+			obj.Lines[i].Kind = "fee"
+		}
+	}
+
+	cn, err := ctl.biller.CreditNote(c.Request.Context(), int(id), obj.Reason, obj.Lines)
+	if err != nil {
+		billingError(c, err)
+		return
+	}
+
+	ctl.respond(c, cn.ID)
+}
+
+// respond writes the invoice with its lines, customer and related invoices loaded.
+func (ctl *InvoiceController) respond(c *gin.Context, id int) {
+	inv, err := ctl.client.Invoice.
+		Query().
+		Where(invoice.IDEQ(id)).
+		WithLines().
+		WithCustomer().
+		WithRepairSlip().
+		WithCreditNotes().
+		WithOriginal().
+		WithPayments().
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, inv)
+}
+
+// billingError maps errors of the billing operations to a response.
+func billingError(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(404, gin.H{"error": err.Error()})
+	case errors.Is(err, billing.ErrSlipNotClosed),
+		errors.Is(err, billing.ErrAlreadyInvoiced),
+		errors.Is(err, billing.ErrCreditExceeded),
+		errors.Is(err, billing.ErrNotInvoice),
+		errors.Is(err, billing.ErrOverpayment),
+		errors.Is(err, billing.ErrRefundExceeded),
+		errors.Is(err, billing.ErrInvoiceChanged):
+		c.JSON(409, gin.H{"error": err.Error()})
+	default:
+		c.JSON(400, gin.H{"error": err.Error()})
+	}
+}
+
+// NewInvoiceController creates and registers handles for the invoice controller
+func NewInvoiceController(router gin.IRouter, client *ent.Client, biller *billing.Biller) *InvoiceController {
+	ic := &InvoiceController{
+		client: client,
+		biller: biller,
+		router: router,
+	}
+	ic.register()
+	return ic
+}
+
+// register registers routes to the main engine
+func (ctl *InvoiceController) register() {
+	invoices := ctl.router.Group("/invoices", auth.Require())
+
+	invoices.GET("", ctl.ListInvoice)
+	invoices.GET(":id", ctl.GetInvoice)
+	invoices.POST(":id/credit-notes", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.CreateCreditNote)
+
+	// Invoices are issued from repair slips.
+	ctl.router.POST("/repairslips/:id/invoice", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.CreateInvoice)
+	ctl.router.POST("/repairslips/:id/invoice/preview", auth.Require(), ctl.PreviewInvoice)
+}
@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/job"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/jobs"
+	"github.com/gin-gonic/gin"
+)
+
+// JobController defines the struct for the job controller
+type JobController struct {
+	client *ent.Client
+	router gin.IRouter
+}
+
+// GetJob handles GET requests to retrieve a job entity
+// @Summary Get a job entity by ID
+// @Description get background job by ID; only admins may
+// @ID get-job
+// @Produce  json
+// @Param id path int true "Job ID"
+// @Success 200 {object} ent.Job
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /jobs/{id} [get]
+func (ctl *JobController) GetJob(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	j, err := ctl.client.Job.
+		Get(c.Request.Context(), int(id))
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, j)
+}
+
+// ListJob handles request to get a list of job entities
+// @Summary List job entities
+// @Description list background jobs, newest first; only admins may
+// @ID list-job
+// @Produce json
+// @Param limit  query int false "Limit"
+// @Param offset query int false "Offset"
+// @Param status query string false "pending, running, succeeded, dead or cancelled"
+// @Param kind query string false "Kind of job"
+// @Success 200 {array} ent.Job
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /jobs [get]
+func (ctl *JobController) ListJob(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	limit := 10
+	if limitQuery != "" {
+		limit64, err := strconv.ParseInt(limitQuery, 10, 64)
+		if err == nil {
+			limit = int(limit64)
+		}
+	}
+
+	offsetQuery := c.Query("offset")
+	offset := 0
+	if offsetQuery != "" {
+		offset64, err := strconv.ParseInt(offsetQuery, 10, 64)
+		if err == nil {
+			offset = int(offset64)
+		}
+	}
+
+	query := ctl.client.Job.Query()
+
+	if status := c.Query("status"); status != "" {
+		s := job.Status(status)
+		if err := job.StatusValidator(s); err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(job.StatusEQ(s))
+	}
+
+	if kind := c.Query("kind"); kind != "" {
+		query.Where(job.KindEQ(kind))
+	}
+
+	list, err := query.
+		Order(ent.Desc(job.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, list)
+}
+
+// RetryJob handles POST requests to run a dead or cancelled job again
+// @Summary Retry a job
+// @Description put a dead or cancelled job back in the queue with a fresh set of attempts; only admins may
+// @ID retry-job
+// @Produce  json
+// @Param id path int true "Job ID"
+// @Success 200 {object} ent.Job
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/retry [post]
+func (ctl *JobController) RetryJob(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	j, err := jobs.Retry(c.Request.Context(), ctl.client, int(id))
+	if err != nil {
+		jobError(c, err)
+		return
+	}
+
+	c.JSON(200, j)
+}
+
+// CancelJob handles POST requests to cancel a job
+// @Summary Cancel a job
+// @Description cancel a pending job; a running job finishes but its outcome is discarded. Only admins may.
+// @ID cancel-job
+// @Produce  json
+// @Param id path int true "Job ID"
+// @Success 200 {object} ent.Job
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/cancel [post]
+func (ctl *JobController) CancelJob(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	j, err := jobs.Cancel(c.Request.Context(), ctl.client, int(id))
+	if err != nil {
+		jobError(c, err)
+		return
+	}
+
+	c.JSON(200, j)
+}
+
+// jobError responds with the status code matching a job error.
+func jobError(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(404, gin.H{"error": err.Error()})
+	case errors.Is(err, jobs.ErrNotRetryable), errors.Is(err, jobs.ErrNotCancellable):
+		c.JSON(409, gin.H{"error": err.Error()})
+	default:
+		c.JSON(400, gin.H{"error": err.Error()})
+	}
+}
+
+// NewJobController creates and registers handles for the job controller
+func NewJobController(router gin.IRouter, client *ent.Client) *JobController {
+	jc := &JobController{
+		client: client,
+		router: router,
+	}
+	jc.register()
+	return jc
+}
+
+// register registers routes to the main engine
+func (ctl *JobController) register() {
+	queue := ctl.router.Group("/jobs", auth.Require(user.RoleAdmin))
+	queue.GET("", ctl.ListJob)
+	queue.GET(":id", ctl.GetJob)
+	queue.POST(":id/retry", ctl.RetryJob)
+	queue.POST(":id/cancel", ctl.CancelJob)
+}
@@ -0,0 +1,30 @@
+package controllers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/jobs"
+	"github.com/darksford123x/app/servertest"
+)
+
+func TestJobsAdminsOnly(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+	j, err := jobs.Enqueue(ctx, h.Client, "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := fmt.Sprintf("/api/v1/jobs/%d", j.ID)
+	h.Get("/api/v1/jobs", supervisor).Status(403)
+	h.Get(path, supervisor).Status(403)
+	h.Post(path+"/retry", nil, supervisor).Status(403)
+	h.Post(path+"/cancel", nil, supervisor).Status(403)
+
+	h.Get("/api/v1/jobs", admin).Status(200)
+	h.Post(path+"/cancel", nil, admin).Status(200)
+}
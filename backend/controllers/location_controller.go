@@ -0,0 +1,344 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/locations"
+	"github.com/darksford123x/app/txn"
+	"github.com/gin-gonic/gin"
+)
+
+// LocationController defines the struct for the location controller
+type LocationController struct {
+	client *ent.Client
+	router gin.IRouter
+}
+
+// Location defines the struct for creating and replacing a location.
+// Campuses have no parent; buildings are in a campus, floors in a building
+// and rooms in a floor.
+type Location struct {
+	Name   string `json:"name" validate:"required" minLength:"1"`
+	Kind   string `json:"kind" validate:"required" enums:"campus,building,floor,room"`
+	Parent int    `json:"parent"`
+}
+
+// CreateLocation handles POST requests for adding location entities
+// @Summary Create location
+// @Description Create a campus, or a building, floor or room in the location of the level above; only supervisors and admins may
+// @ID create-location
+// @Accept   json
+// @Produce  json
+// @Param location body Location true "Location entity"
+// @Success 200 {object} ent.Location
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /locations [post]
+func (ctl *LocationController) CreateLocation(c *gin.Context) {
+	obj := Location{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "location binding failed",
+		})
+		return
+	}
+
+	builder := ctl.client.Location.
+		Create().
+		SetName(obj.Name).
+		SetKind(location.Kind(obj.Kind))
+	if obj.Parent != 0 {
+		builder.SetParentID(obj.Parent)
+	}
+	l, err := builder.Save(c.Request.Context())
+	if err != nil {
+		locationError(c, err)
+		return
+	}
+
+	ctl.respond(c, l.ID)
+}
+
+// GetLocation handles GET requests to retrieve a location entity
+// @Summary Get a location entity by ID
+// @Description get location by ID with its parent and children
+// @ID get-location
+// @Produce  json
+// @Param id path int true "Location ID"
+// @Success 200 {object} ent.Location
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /locations/{id} [get]
+func (ctl *LocationController) GetLocation(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// ListLocation handles request to get a list of location entities
+// @Summary List location entities
+// @Description list locations by name, all of them or those of a kind or in a parent
+// @ID list-location
+// @Produce json
+// @Param kind   query string false "campus, building, floor or room"
+// @Param parent query int false "Parent location ID"
+// @Success 200 {array} ent.Location
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /locations [get]
+func (ctl *LocationController) ListLocation(c *gin.Context) {
+	query := ctl.client.Location.
+		Query().
+		WithParent()
+
+	if kind := c.Query("kind"); kind != "" {
+		k := location.Kind(kind)
+		if err := location.KindValidator(k); err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(location.KindEQ(k))
+	}
+
+	if parentQuery := c.Query("parent"); parentQuery != "" {
+		parent, err := strconv.ParseInt(parentQuery, 10, 64)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(location.HasParentWith(location.IDEQ(int(parent))))
+	}
+
+	list, err := query.
+		Order(ent.Asc(location.FieldName), ent.Asc(location.FieldID)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, list)
+}
+
+// UpdateLocation handles PUT requests to rename or move a location
+// @Summary Replace a location
+// @Description set the name, kind and parent of a location; the locations and equipment in it move with it; only supervisors and admins may
+// @ID update-location
+// @Accept   json
+// @Produce  json
+// @Param id path int true "Location ID"
+// @Param location body Location true "Location entity"
+// @Success 200 {object} ent.Location
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /locations/{id} [put]
+func (ctl *LocationController) UpdateLocation(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := Location{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "location binding failed",
+		})
+		return
+	}
+
+	update := ctl.client.Location.
+		UpdateOneID(int(id)).
+		SetName(obj.Name).
+		SetKind(location.Kind(obj.Kind)).
+		ClearParent()
+	if obj.Parent != 0 {
+		update.SetParentID(obj.Parent)
+	}
+	if _, err := update.Save(c.Request.Context()); err != nil {
+		locationError(c, err)
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// DeleteLocation handles DELETE requests to delete a location entity
+// @Summary Delete a location entity by ID
+// @Description delete a location without locations or equipment in it that equipment was never moved to or from; only supervisors and admins may
+// @ID delete-location
+// @Produce  json
+// @Param id path int true "Location ID"
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /locations/{id} [delete]
+func (ctl *LocationController) DeleteLocation(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	err = txn.WithTx(c.Request.Context(), ctl.client, func(ctx context.Context, tx *ent.Tx) error {
+		return locations.Delete(ctx, tx, int(id))
+	})
+	if err != nil {
+		locationError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{"result": fmt.Sprintf("ok deleted %v", id)})
+}
+
+// GetLocationTree handles GET requests to retrieve the tree under a location
+// @Summary Get the tree under a location
+// @Description get a location with the locations under it nested as its children, by name
+// @ID get-location-tree
+// @Produce  json
+// @Param id path int true "Location ID"
+// @Success 200 {object} ent.Location
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /locations/{id}/tree [get]
+func (ctl *LocationController) GetLocationTree(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tree, err := locations.Tree(c.Request.Context(), ctl.client, int(id))
+	if err != nil {
+		locationError(c, err)
+		return
+	}
+
+	c.JSON(200, tree)
+}
+
+// ListLocationEquipment handles request to get the equipment under a location
+// @Summary List the equipment under a location
+// @Description list the equipment kept at a location or any location under it, such as all the equipment of a building, by name
+// @ID list-location-equipment
+// @Produce json
+// @Param id path int true "Location ID"
+// @Success 200 {array} ent.Equipment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /locations/{id}/equipment [get]
+func (ctl *LocationController) ListLocationEquipment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	list, err := locations.Equipment(c.Request.Context(), ctl.client, int(id))
+	if err != nil {
+		locationError(c, err)
+		return
+	}
+
+	c.JSON(200, list)
+}
+
+// respond writes the location with its parent and children loaded.
+func (ctl *LocationController) respond(c *gin.Context, id int) {
+	l, err := ctl.client.Location.
+		Query().
+		Where(location.IDEQ(id)).
+		WithParent().
+		WithChildren(func(q *ent.LocationQuery) {
+			q.Order(ent.Asc(location.FieldName), ent.Asc(location.FieldID))
+		}).
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, l)
+}
+
+// locationError maps errors of the location operations to a response.
+func locationError(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(404, gin.H{"error": err.Error()})
+	case errors.Is(err, locations.ErrInUse), errors.Is(err, locations.ErrSameLocation), ent.IsConstraintError(err):
+		c.JSON(409, gin.H{"error": err.Error()})
+	default:
+		c.JSON(400, gin.H{"error": err.Error()})
+	}
+}
+
+// NewLocationController creates and registers handles for the location controller
+func NewLocationController(router gin.IRouter, client *ent.Client) *LocationController {
+	lc := &LocationController{
+		client: client,
+		router: router,
+	}
+	lc.register()
+	return lc
+}
+
+// register registers routes to the main engine
+func (ctl *LocationController) register() {
+	group := ctl.router.Group("/locations", auth.Require())
+
+	group.GET("", ctl.ListLocation)
+
+	// CRUD
+	group.POST("", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.CreateLocation)
+	group.GET(":id", ctl.GetLocation)
+	group.PUT(":id", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.UpdateLocation)
+	group.DELETE(":id", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.DeleteLocation)
+
+	// Tree
+	group.GET(":id/tree", ctl.GetLocationTree)
+	group.GET(":id/equipment", ctl.ListLocationEquipment)
+}
@@ -0,0 +1,157 @@
+package controllers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+)
+
+// campus creates a campus with two buildings, a floor in the first and two
+// rooms on that floor, and returns the locations by name.
+func campus(h *servertest.Harness, as *ent.User) map[string]*ent.Location {
+	locs := map[string]*ent.Location{}
+	for _, l := range []struct{ name, kind, parent string }{
+		{"Bang Khen", "campus", ""},
+		{"Engineering", "building", "Bang Khen"},
+		{"Science", "building", "Bang Khen"},
+		{"Floor 4", "floor", "Engineering"},
+		{"4102", "room", "Floor 4"},
+		{"4103", "room", "Floor 4"},
+	} {
+		body := controllers.Location{Name: l.name, Kind: l.kind}
+		if p := locs[l.parent]; p != nil {
+			body.Parent = p.ID
+		}
+		var loc ent.Location
+		h.Post("/api/v1/locations", body, as).Status(200).Decode(&loc)
+		locs[l.name] = &loc
+	}
+	return locs
+}
+
+func TestLocationTree(t *testing.T) {
+	h := servertest.New(t)
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(h.Context())
+	locs := campus(h, admin)
+
+	var tree ent.Location
+	h.Get(fmt.Sprintf("/api/v1/locations/%d/tree", locs["Bang Khen"].ID), admin).Status(200).Decode(&tree)
+	if n := len(tree.Edges.Children); n != 2 {
+		t.Fatalf("campus has %d buildings, want 2", n)
+	}
+	eng := tree.Edges.Children[0]
+	if eng.Name != "Engineering" || len(eng.Edges.Children) != 1 || len(eng.Edges.Children[0].Edges.Children) != 2 {
+		t.Errorf("tree of Engineering = %+v, want a floor with two rooms", eng)
+	}
+
+	// A location is in a location of the level above.
+	for _, body := range []controllers.Location{
+		{Name: "4104", Kind: "room", Parent: locs["Engineering"].ID},
+		{Name: "Annex", Kind: "building"},
+		{Name: "Rangsit", Kind: "campus", Parent: locs["Bang Khen"].ID},
+	} {
+		h.Post("/api/v1/locations", body, admin).Status(400)
+	}
+	h.Put(fmt.Sprintf("/api/v1/locations/%d", locs["Floor 4"].ID), controllers.Location{
+		Name: "Floor 4", Kind: "room", Parent: locs["Engineering"].ID,
+	}, admin).Status(400)
+	h.Post("/api/v1/locations", controllers.Location{
+		Name: "4102", Kind: "room", Parent: locs["Floor 4"].ID,
+	}, admin).Status(409)
+
+	// A floor moves to another building with its rooms.
+	h.Put(fmt.Sprintf("/api/v1/locations/%d", locs["Floor 4"].ID), controllers.Location{
+		Name: "Floor 4", Kind: "floor", Parent: locs["Science"].ID,
+	}, admin).Status(200)
+	var rooms []ent.Location
+	h.Get(fmt.Sprintf("/api/v1/locations?parent=%d", locs["Floor 4"].ID), admin).Status(200).Decode(&rooms)
+	if len(rooms) != 2 {
+		t.Errorf("%d rooms on the moved floor, want 2", len(rooms))
+	}
+}
+
+func TestEquipmentMoves(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+	locs := campus(h, admin)
+
+	var eq ent.Equipment
+	h.Post("/api/v1/equipment", controllers.Equipment{
+		Name:         "Projector",
+		SerialNumber: "PJ-1",
+		Location:     locs["4102"].ID,
+	}, admin).Status(200).Decode(&eq)
+	other := h.Equipment().SaveX(ctx)
+	slip := h.RepairSlip(admin).SetEquipment(h.Client.Equipment.GetX(ctx, eq.ID)).SaveX(ctx)
+	h.RepairSlip(admin).SetEquipment(other).SaveX(ctx)
+
+	under := func(name string) []int {
+		return ids(h.Get(fmt.Sprintf("/api/v1/locations/%d/equipment", locs[name].ID), admin).Status(200))
+	}
+	slips := func(name string) []int {
+		return ids(h.Get(fmt.Sprintf("/api/v1/repairslips?location=%d", locs[name].ID), admin).Status(200))
+	}
+	if got := under("Engineering"); len(got) != 1 || got[0] != eq.ID {
+		t.Errorf("equipment under Engineering = %v, want %d", got, eq.ID)
+	}
+	if got := slips("Bang Khen"); len(got) != 1 || got[0] != slip.ID {
+		t.Errorf("slips under Bang Khen = %v, want %d", got, slip.ID)
+	}
+
+	path := fmt.Sprintf("/api/v1/equipment/%d/moves", eq.ID)
+	h.Post(path, controllers.EquipmentMove{Location: locs["4102"].ID}, admin).Status(409)
+	var mv ent.EquipmentMove
+	h.Post(path, controllers.EquipmentMove{Location: locs["Science"].ID, Note: "Lent for a seminar"}, admin).
+		Status(200).
+		Decode(&mv)
+	if mv.Edges.FromLocation == nil || mv.Edges.FromLocation.ID != locs["4102"].ID || mv.Edges.MovedBy == nil || mv.Edges.MovedBy.ID != admin.ID {
+		t.Errorf("move = %+v, want a move from 4102 by user %d", mv.Edges, admin.ID)
+	}
+	if got := under("Engineering"); len(got) != 0 {
+		t.Errorf("equipment under Engineering after the move = %v, want none", got)
+	}
+	if got := slips("Science"); len(got) != 1 || got[0] != slip.ID {
+		t.Errorf("slips under Science = %v, want %d", got, slip.ID)
+	}
+
+	var moves []ent.EquipmentMove
+	h.Get(path, admin).Status(200).Decode(&moves)
+	if len(moves) != 2 || moves[0].Note != "Lent for a seminar" || moves[1].Edges.FromLocation != nil {
+		t.Fatalf("moves = %+v, want the move to Science and the first placement", moves)
+	}
+
+	// Locations kept in the history cannot be deleted.
+	h.Delete(fmt.Sprintf("/api/v1/locations/%d", locs["4102"].ID), admin).Status(409)
+	h.Delete(fmt.Sprintf("/api/v1/locations/%d", locs["4103"].ID), admin).Status(200)
+}
+
+func TestLocationsSupervisorsOnly(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	locs := campus(h, h.User().SetRole(user.RoleSupervisor).SaveX(ctx))
+	eq := h.Equipment().SaveX(ctx)
+	floor := fmt.Sprintf("/api/v1/locations/%d", locs["Floor 4"].ID)
+
+	h.Post("/api/v1/locations", controllers.Location{Name: "Annex", Kind: "building", Parent: locs["Bang Khen"].ID}, staff).Status(403)
+	h.Put(floor, controllers.Location{Name: "Floor 5", Kind: "floor", Parent: locs["Engineering"].ID}, staff).Status(403)
+	h.Delete(fmt.Sprintf("/api/v1/locations/%d", locs["4103"].ID), staff).Status(403)
+	h.Post(fmt.Sprintf("/api/v1/equipment/%d/moves", eq.ID), controllers.EquipmentMove{Location: locs["4102"].ID}, staff).Status(403)
+
+	var got ent.Location
+	h.Get(floor, staff).Status(200).Decode(&got)
+	if got.Name != "Floor 4" {
+		t.Errorf("floor renamed %q by staff", got.Name)
+	}
+	if n := h.Client.Location.Query().CountX(ctx); n != len(locs) {
+		t.Errorf("%d locations after staff changed them, want %d", n, len(locs))
+	}
+	if n := h.Client.EquipmentMove.Query().CountX(ctx); n != 0 {
+		t.Errorf("%d equipment moves by staff, want none", n)
+	}
+}
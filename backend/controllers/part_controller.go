@@ -0,0 +1,427 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/inventory"
+	"github.com/gin-gonic/gin"
+)
+
+// PartController defines the struct for the part controller
+type PartController struct {
+	client *ent.Client
+	router gin.IRouter
+}
+
+// StockReceipt defines the struct for receiving stock of a part
+type StockReceipt struct {
+	Location string `json:"location" validate:"required" minLength:"1"`
+	Quantity int    `json:"quantity" validate:"required" minimum:"1"`
+	Note     string `json:"note"`
+}
+
+// StockAdjustment defines the struct for adjusting stock of a part
+type StockAdjustment struct {
+	Location   string `json:"location" validate:"required" minLength:"1"`
+	Quantity   int    `json:"quantity" validate:"required"`
+	ReasonCode string `json:"reason_code" validate:"required" enums:"count_correction,damaged,lost,found,returned_to_vendor,other"`
+	Note       string `json:"note"`
+}
+
+// CreatePart handles POST requests for adding part entities
+// @Summary Create part
+// @Description Create part
+// @ID create-part
+// @Accept   json
+// @Produce  json
+// @Param part body ent.Part true "Part entity"
+// @Success 200 {object} ent.Part
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /parts [post]
+func (ctl *PartController) CreatePart(c *gin.Context) {
+	obj := ent.Part{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "part binding failed",
+		})
+		return
+	}
+
+	builder := ctl.client.Part.
+		Create().
+		SetSku(obj.Sku).
+		SetName(obj.Name).
+		SetUnitPrice(obj.UnitPrice)
+	if obj.Unit != "" {
+		builder.SetUnit(obj.Unit)
+	}
+	p, err := builder.Save(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": "saving failed",
+		})
+		return
+	}
+
+	c.JSON(200, p)
+}
+
+// GetPart handles GET requests to retrieve a part entity
+// @Summary Get a part entity by ID
+// @Description get part by ID with its stock levels
+// @ID get-part
+// @Produce  json
+// @Param id path int true "Part ID"
+// @Success 200 {object} ent.Part
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /parts/{id} [get]
+func (ctl *PartController) GetPart(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	p, err := ctl.client.Part.
+		Query().
+		Where(part.IDEQ(int(id))).
+		WithStockLevels().
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, p)
+}
+
+// ListPart handles request to get a list of part entities
+// @Summary List part entities
+// @Description list part entities
+// @ID list-part
+// @Produce json
+// @Param limit  query int false "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {array} ent.Part
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /parts [get]
+func (ctl *PartController) ListPart(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	limit := 10
+	if limitQuery != "" {
+		limit64, err := strconv.ParseInt(limitQuery, 10, 64)
+		if err == nil {
+			limit = int(limit64)
+		}
+	}
+
+	offsetQuery := c.Query("offset")
+	offset := 0
+	if offsetQuery != "" {
+		offset64, err := strconv.ParseInt(offsetQuery, 10, 64)
+		if err == nil {
+			offset = int(offset64)
+		}
+	}
+
+	parts, err := ctl.client.Part.
+		Query().
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, parts)
+}
+
+// DeletePart handles DELETE requests to delete a part entity
+// @Summary Delete a part entity by ID
+// @Description delete part by ID
+// @ID delete-part
+// @Produce  json
+// @Param id path int true "Part ID"
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /parts/{id} [delete]
+func (ctl *PartController) DeletePart(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	err = ctl.client.Part.
+		DeleteOneID(int(id)).
+		Exec(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, gin.H{"result": fmt.Sprintf("ok deleted %v", id)})
+}
+
+// ReceiveStock handles POST requests to book received stock of a part
+// @Summary Receive stock of a part
+// @Description book received units of a part into a location; only supervisors and admins may
+// @ID receive-stock
+// @Accept   json
+// @Produce  json
+// @Param id path int true "Part ID"
+// @Param receipt body StockReceipt true "Received stock"
+// @Success 200 {object} ent.StockMovement
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /parts/{id}/receipts [post]
+func (ctl *PartController) ReceiveStock(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := StockReceipt{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "receipt binding failed",
+		})
+		return
+	}
+
+	var mv *ent.StockMovement
+	err = inventory.Book(c.Request.Context(), ctl.client, func(ctx context.Context, tx *ent.Tx) error {
+		mv, err = inventory.Receive(ctx, tx, int(id), obj.Location, obj.Quantity, obj.Note)
+		return err
+	})
+	if err != nil {
+		stockError(c, err)
+		return
+	}
+
+	c.JSON(200, mv)
+}
+
+// AdjustStock handles POST requests to correct the stock of a part
+// @Summary Adjust stock of a part
+// @Description add or remove units of a part at a location with a reason code; only supervisors and admins may
+// @ID adjust-stock
+// @Accept   json
+// @Produce  json
+// @Param id path int true "Part ID"
+// @Param adjustment body StockAdjustment true "Stock adjustment"
+// @Success 200 {object} ent.StockMovement
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /parts/{id}/adjustments [post]
+func (ctl *PartController) AdjustStock(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := StockAdjustment{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "adjustment binding failed",
+		})
+		return
+	}
+
+	var mv *ent.StockMovement
+	err = inventory.Book(c.Request.Context(), ctl.client, func(ctx context.Context, tx *ent.Tx) error {
+		mv, err = inventory.Adjust(ctx, tx, int(id), obj.Location, obj.Quantity, stockmovement.ReasonCode(obj.ReasonCode), obj.Note)
+		return err
+	})
+	if err != nil {
+		stockError(c, err)
+		return
+	}
+
+	c.JSON(200, mv)
+}
+
+// ListStockMovement handles request to get the stock movements of a part
+// @Summary List stock movements of a part
+// @Description list stock movements of a part, newest first
+// @ID list-stock-movement
+// @Produce json
+// @Param id path int true "Part ID"
+// @Param limit  query int false "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {array} ent.StockMovement
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /parts/{id}/movements [get]
+func (ctl *PartController) ListStockMovement(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	limitQuery := c.Query("limit")
+	limit := 10
+	if limitQuery != "" {
+		limit64, err := strconv.ParseInt(limitQuery, 10, 64)
+		if err == nil {
+			limit = int(limit64)
+		}
+	}
+
+	offsetQuery := c.Query("offset")
+	offset := 0
+	if offsetQuery != "" {
+		offset64, err := strconv.ParseInt(offsetQuery, 10, 64)
+		if err == nil {
+			offset = int(offset64)
+		}
+	}
+
+	movements, err := ctl.client.StockMovement.
+		Query().
+		Where(stockmovement.HasPartWith(part.IDEQ(int(id)))).
+		WithRepairSlip().
+		Order(ent.Desc(stockmovement.FieldCreateTime), ent.Desc(stockmovement.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, movements)
+}
+
+// ListStock handles request to get the on-hand stock levels
+// @Summary List stock levels
+// @Description list on-hand quantities per part and location
+// @ID list-stock
+// @Produce json
+// @Param location query string false "Location"
+// @Param part query int false "Part ID"
+// @Success 200 {array} ent.StockLevel
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /stock [get]
+func (ctl *PartController) ListStock(c *gin.Context) {
+	query := ctl.client.StockLevel.
+		Query().
+		WithPart()
+
+	if location := c.Query("location"); location != "" {
+		query.Where(stocklevel.LocationEQ(location))
+	}
+
+	if partQuery := c.Query("part"); partQuery != "" {
+		partID, err := strconv.ParseInt(partQuery, 10, 64)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(stocklevel.HasPartWith(part.IDEQ(int(partID))))
+	}
+
+	levels, err := query.
+		Order(ent.Asc(stocklevel.FieldLocation), ent.Asc(stocklevel.FieldID)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, levels)
+}
+
+// stockError maps errors of the inventory operations to a response.
+func stockError(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(404, gin.H{"error": err.Error()})
+	case errors.Is(err, inventory.ErrInsufficientStock), errors.Is(err, inventory.ErrSlipClosed):
+		c.JSON(409, gin.H{"error": err.Error()})
+	default:
+		c.JSON(400, gin.H{"error": err.Error()})
+	}
+}
+
+// NewPartController creates and registers handles for the part controller
+func NewPartController(router gin.IRouter, client *ent.Client) *PartController {
+	pc := &PartController{
+		client: client,
+		router: router,
+	}
+	pc.register()
+	return pc
+}
+
+// register registers routes to the main engine
+func (ctl *PartController) register() {
+	parts := ctl.router.Group("/parts", auth.Require())
+
+	parts.GET("", ctl.ListPart)
+
+	// CRUD
+	parts.POST("", ctl.CreatePart)
+	parts.GET(":id", ctl.GetPart)
+	parts.DELETE(":id", ctl.DeletePart)
+
+	// Stock is received and adjusted by supervisors and admins, and used
+	// by whoever repairs.
+	parts.POST(":id/receipts", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.ReceiveStock)
+	parts.POST(":id/adjustments", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.AdjustStock)
+	parts.GET(":id/movements", ctl.ListStockMovement)
+	ctl.router.GET("/stock", auth.Require(), ctl.ListStock)
+}
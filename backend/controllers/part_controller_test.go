@@ -0,0 +1,150 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+)
+
+// onHand returns the stock of a part at a location.
+func onHand(t *testing.T, h *servertest.Harness, partID int, location string) int {
+	t.Helper()
+	level, err := h.Client.StockLevel.
+		Query().
+		Where(stocklevel.LocationEQ(location), stocklevel.HasPartWith(part.ID(partID))).
+		Only(h.Context())
+	if ent.IsNotFound(err) {
+		return 0
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return level.OnHand
+}
+
+func TestStock(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	u := h.User().SaveX(ctx)
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	p := h.Part().SaveX(ctx)
+	receipts := fmt.Sprintf("/api/v1/parts/%d/receipts", p.ID)
+	adjustments := fmt.Sprintf("/api/v1/parts/%d/adjustments", p.ID)
+	adjust := func(quantity int, reason string) *servertest.Response {
+		return h.Post(adjustments, map[string]interface{}{
+			"location":    "Store A",
+			"quantity":    quantity,
+			"reason_code": reason,
+		}, supervisor)
+	}
+
+	// Only supervisors and admins receive and adjust stock.
+	h.Post(receipts, map[string]interface{}{"location": "Store A", "quantity": 5}, u).Status(403)
+	h.Post(adjustments, map[string]interface{}{"location": "Store A", "quantity": 5, "reason_code": "found"}, u).Status(403)
+	if got := onHand(t, h, p.ID, "Store A"); got != 0 {
+		t.Errorf("%d on hand after staff changed the stock, want 0", got)
+	}
+
+	h.Post(receipts, map[string]interface{}{"location": "Store A", "quantity": 5}, supervisor).Status(200)
+	h.Post(receipts, map[string]interface{}{"location": "Store A", "quantity": 3}, supervisor).Status(200)
+	h.Post(receipts, map[string]interface{}{"location": "Store B", "quantity": 1}, supervisor).Status(200)
+	h.Post(receipts, map[string]interface{}{"location": "Store A", "quantity": 0}, supervisor).Status(400)
+	h.Post("/api/v1/parts/404/receipts", map[string]interface{}{"location": "Store A", "quantity": 1}, supervisor).Status(404)
+	if got := onHand(t, h, p.ID, "Store A"); got != 8 {
+		t.Errorf("%d on hand in Store A after receiving 5 and 3, want 8", got)
+	}
+
+	// Stock never goes below zero, and each location has its own. Whoever
+	// repairs uses it.
+	adjust(-9, "lost").Status(409)
+	adjust(-2, "damaged").Status(200)
+	adjust(-1, "no_reason").Status(400)
+	slip := h.RepairSlip(u).SaveX(ctx)
+	use := func(slipID, quantity int) *servertest.Response {
+		return h.Post(fmt.Sprintf("/api/v1/repairslips/%d/parts", slipID), map[string]interface{}{
+			"part":     p.ID,
+			"location": "Store A",
+			"quantity": quantity,
+		}, u)
+	}
+	use(slip.ID, 7).Status(409)
+	use(slip.ID, 6).Status(200)
+	if got := onHand(t, h, p.ID, "Store A"); got != 0 {
+		t.Errorf("%d on hand in Store A after using them all, want 0", got)
+	}
+	if got := onHand(t, h, p.ID, "Store B"); got != 1 {
+		t.Errorf("%d on hand in Store B, want 1", got)
+	}
+	closed := h.RepairSlip(u).SetStatus(repairslip.StatusClosed).SaveX(ctx)
+	h.Post(fmt.Sprintf("/api/v1/repairslips/%d/parts", closed.ID), map[string]interface{}{
+		"part":     p.ID,
+		"location": "Store B",
+		"quantity": 1,
+	}, u).Status(409)
+
+	// The ledger explains the stock.
+	var movements []ent.StockMovement
+	h.Get(fmt.Sprintf("/api/v1/parts/%d/movements", p.ID), u).Status(200).Decode(&movements)
+	sum := 0
+	for _, mv := range movements {
+		if mv.Location == "Store A" {
+			sum += mv.Quantity
+		}
+	}
+	if len(movements) != 5 || sum != 0 {
+		t.Errorf("%d movements adding up to %d in Store A, want 5 adding up to 0", len(movements), sum)
+	}
+}
+
+func TestStockFirstReceiptsConcurrently(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	u := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	p := h.Part().SaveX(ctx)
+
+	// The first time the stock level is created, another receipt creates
+	// it first, as if both had found none.
+	creates := 0
+	h.Client.StockLevel.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.StockLevelFunc(func(ctx context.Context, m *ent.StockLevelMutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpCreate) {
+				return next.Mutate(ctx, m)
+			}
+			creates++
+			if creates == 1 {
+				_, err := ent.TxFromContext(ctx).StockLevel.
+					Create().
+					SetLocation("Store A").
+					SetOnHand(2).
+					SetPartID(p.ID).
+					Save(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	h.Post(fmt.Sprintf("/api/v1/parts/%d/receipts", p.ID), map[string]interface{}{
+		"location": "Store A",
+		"quantity": 5,
+	}, u).Status(200)
+	if creates != 3 {
+		t.Errorf("the stock level was created %d times, want 3: by both receipts racing, then by the retry", creates)
+	}
+	if got := onHand(t, h, p.ID, "Store A"); got != 5 {
+		t.Errorf("%d on hand, want 5", got)
+	}
+	if n := h.Client.StockMovement.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d movements, want 1", n)
+	}
+}
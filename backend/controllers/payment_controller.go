@@ -0,0 +1,218 @@
+package controllers
+
+import (
+	"strconv"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/billing"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentController defines the struct for the payment controller
+type PaymentController struct {
+	client *ent.Client
+	biller *billing.Biller
+	router gin.IRouter
+}
+
+// CreatePayment handles POST requests to record a payment against an invoice
+// @Summary Record a payment
+// @Description record a cash, bank transfer or PromptPay payment, or a refund, against an invoice; only supervisors and admins may
+// @ID create-payment
+// @Accept   json
+// @Produce  json
+// @Param id path int true "Invoice ID"
+// @Param payment body billing.Payment true "Payment"
+// @Success 200 {object} ent.Payment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /invoices/{id}/payments [post]
+func (ctl *PaymentController) CreatePayment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := billing.Payment{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "payment binding failed",
+		})
+		return
+	}
+
+	p, err := ctl.biller.Pay(c.Request.Context(), int(id), obj)
+	if err != nil {
+		billingError(c, err)
+		return
+	}
+
+	c.JSON(200, p)
+}
+
+// ListPayment handles request to get the payments of an invoice
+// @Summary List payments of an invoice
+// @Description list payments and refunds of an invoice in the order they were recorded
+// @ID list-payment
+// @Produce json
+// @Param id path int true "Invoice ID"
+// @Success 200 {array} ent.Payment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /invoices/{id}/payments [get]
+func (ctl *PaymentController) ListPayment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	payments, err := ctl.client.Payment.
+		Query().
+		Where(payment.HasInvoiceWith(invoice.IDEQ(int(id)))).
+		Order(ent.Asc(payment.FieldID)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, payments)
+}
+
+// GetPayment handles GET requests to retrieve a payment entity
+// @Summary Get a payment entity by ID
+// @Description get payment by ID with its invoice
+// @ID get-payment
+// @Produce  json
+// @Param id path int true "Payment ID"
+// @Success 200 {object} ent.Payment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /payments/{id} [get]
+func (ctl *PaymentController) GetPayment(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	p, err := ctl.client.Payment.
+		Query().
+		Where(payment.IDEQ(int(id))).
+		WithInvoice().
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, p)
+}
+
+// GetReceipt handles GET requests to render the receipt of a payment
+// @Summary Get the receipt of a payment
+// @Description render the receipt of a payment or refund as a printable HTML page
+// @ID get-receipt
+// @Produce  html
+// @Param id path int true "Payment ID"
+// @Success 200 {string} string
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /payments/{id}/receipt [get]
+func (ctl *PaymentController) GetReceipt(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	r, err := ctl.biller.Receipt(c.Request.Context(), int(id))
+	if err != nil {
+		billingError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(200)
+	if err := r.WriteHTML(c.Writer); err != nil {
+		c.Error(err)
+	}
+}
+
+// ListBalance handles request to get the outstanding balances
+// @Summary List outstanding balances
+// @Description list what is still owed on invoices, per customer or per department
+// @ID list-balance
+// @Produce json
+// @Param by query string false "customer (default) or department"
+// @Success 200 {array} billing.Balance
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /balances [get]
+func (ctl *PaymentController) ListBalance(c *gin.Context) {
+	by := billing.ByCustomer
+	if q := c.Query("by"); q != "" {
+		by = billing.Group(q)
+	}
+
+	balances, err := ctl.biller.Outstanding(c.Request.Context(), by)
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, balances)
+}
+
+// NewPaymentController creates and registers handles for the payment controller
+func NewPaymentController(router gin.IRouter, client *ent.Client, biller *billing.Biller) *PaymentController {
+	pc := &PaymentController{
+		client: client,
+		biller: biller,
+		router: router,
+	}
+	pc.register()
+	return pc
+}
+
+// register registers routes to the main engine
+func (ctl *PaymentController) register() {
+	payments := ctl.router.Group("/payments", auth.Require())
+
+	payments.GET(":id", ctl.GetPayment)
+	payments.GET(":id/receipt", ctl.GetReceipt)
+
+	// Payments are recorded against invoices.
+	ctl.router.POST("/invoices/:id/payments", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.CreatePayment)
+	ctl.router.GET("/invoices/:id/payments", auth.Require(), ctl.ListPayment)
+	ctl.router.GET("/balances", auth.Require(), ctl.ListBalance)
+}
@@ -0,0 +1,102 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/servertest"
+)
+
+func TestPaymentsSettleInvoice(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	u := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	slip := h.RepairSlip(u).SaveX(ctx)
+	h.Client.RepairSlip.UpdateOne(slip).SetStatus(repairslip.StatusClosed).ExecX(ctx)
+	issue := map[string]interface{}{
+		"labour_hours": "1",
+		"labour_rate":  "500",
+	}
+	// Only supervisors and admins bill.
+	h.Post(fmt.Sprintf("/api/v1/repairslips/%d/invoice", slip.ID), issue, staff).Status(403)
+	var inv ent.Invoice
+	h.Post(fmt.Sprintf("/api/v1/repairslips/%d/invoice", slip.ID), issue, u).Status(200).Decode(&inv)
+	h.Post(fmt.Sprintf("/api/v1/invoices/%d/credit-notes", inv.ID), map[string]interface{}{
+		"reason": "Goodwill",
+		"lines":  []interface{}{},
+	}, staff).Status(403)
+
+	// Another payment is settled on the invoice whenever one of the next
+	// conflicts payments claims the amount paid, as if both read the
+	// invoice at the same time.
+	conflicts, claims := 0, 0
+	h.Client.Invoice.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.InvoiceFunc(func(ctx context.Context, m *ent.InvoiceMutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpUpdate) {
+				claims++
+				if conflicts > 0 {
+					conflicts--
+					tx := ent.TxFromContext(ctx)
+					paid := tx.Invoice.GetX(ctx, inv.ID).AmountPaid
+					if err := tx.Invoice.UpdateOneID(inv.ID).SetAmountPaid(paid + 1).Exec(ctx); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	pay := func(kind string, amount money.Amount) *servertest.Response {
+		return h.Post(fmt.Sprintf("/api/v1/invoices/%d/payments", inv.ID), map[string]interface{}{
+			"kind":   kind,
+			"method": "cash",
+			"amount": amount.String(),
+		}, u)
+	}
+	check := func(paid money.Amount, status invoice.PaymentStatus) {
+		t.Helper()
+		got := h.Client.Invoice.GetX(ctx, inv.ID)
+		if got.AmountPaid != paid || got.PaymentStatus != status {
+			t.Errorf("invoice paid %s and %s, want %s and %s", got.AmountPaid, got.PaymentStatus, paid, status)
+		}
+	}
+
+	h.Post(fmt.Sprintf("/api/v1/invoices/%d/payments", inv.ID), map[string]interface{}{
+		"kind":   "payment",
+		"method": "cash",
+		"amount": "100.00",
+	}, staff).Status(403)
+	if n := h.Client.Payment.Query().CountX(ctx); n != 0 {
+		t.Errorf("%d payments recorded by staff, want none", n)
+	}
+
+	conflicts = 1
+	pay("payment", 20000).Status(200)
+	if claims != 2 {
+		t.Errorf("the amount paid was claimed %d times, want 2: once lost, once retried", claims)
+	}
+	check(20000, invoice.PaymentStatusPartial)
+
+	// The payment that loses every attempt is not recorded.
+	conflicts = 3
+	pay("payment", 10000).Status(409)
+	check(20000, invoice.PaymentStatusPartial)
+	if n := h.Client.Payment.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d payments recorded, want 1", n)
+	}
+
+	pay("payment", inv.Total-19999).Status(409)
+	pay("refund", 20001).Status(409)
+	pay("payment", inv.Total-20000).Status(200)
+	check(inv.Total, invoice.PaymentStatusPaid)
+	pay("refund", 5000).Status(200)
+	check(inv.Total-5000, invoice.PaymentStatusPartial)
+}
@@ -0,0 +1,639 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/darksford123x/app/assignment"
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/inventory"
+	"github.com/darksford123x/app/locations"
+	"github.com/gin-gonic/gin"
+)
+
+// RepairSlipController defines the struct for the repair slip controller
+type RepairSlipController struct {
+	client   *ent.Client
+	balancer *assignment.Balancer
+	router   gin.IRouter
+}
+
+// RepairSlip defines the struct for creating a repair slip
+type RepairSlip struct {
+	Reporter   int    `json:"reporter"`
+	Symptom    string `json:"symptom" validate:"required" minLength:"1"`
+	Category   string `json:"category" validate:"required" minLength:"1"`
+	Equipment  int    `json:"equipment"`
+	Priority   string `json:"priority,omitempty" enums:"low,normal,high,urgent"`
+	AutoAssign bool   `json:"auto_assign"`
+}
+
+// RepairSlipStatus defines the struct for changing the status of a repair slip
+type RepairSlipStatus struct {
+	Status string `json:"status" validate:"required" enums:"received,in_progress,waiting_parts,ready,closed"`
+}
+
+// RepairSlipPriority defines the struct for changing the priority of a repair slip
+type RepairSlipPriority struct {
+	Priority string `json:"priority" validate:"required" enums:"low,normal,high,urgent"`
+}
+
+// Assignment defines the struct for assigning a repair slip to a technician
+type Assignment struct {
+	Technician int `json:"technician" validate:"required"`
+}
+
+// PartUsage defines the struct for attaching a used part to a repair slip
+type PartUsage struct {
+	Part     int    `json:"part" validate:"required"`
+	Location string `json:"location" validate:"required" minLength:"1"`
+	Quantity int    `json:"quantity" validate:"required" minimum:"1"`
+}
+
+// CreateRepairSlip handles POST requests for adding repairslip entities
+// @Summary Create repairslip
+// @Description Create repairslip, optionally assigning it to the least loaded technician of its category in the same transaction. Slips for equipment under warranty are routed to a warranty claim and are not assigned, nor are slips of a category no technician handles.
+// @ID create-repairslip
+// @Accept   json
+// @Produce  json
+// @Param repairslip body RepairSlip true "RepairSlip entity"
+// @Success 200 {object} ent.RepairSlip
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips [post]
+func (ctl *RepairSlipController) CreateRepairSlip(c *gin.Context) {
+	obj := RepairSlip{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "repairslip binding failed",
+		})
+		return
+	}
+
+	// The slip is saved and assigned in one transaction, so that it is not
+	// left unassigned when the assignment fails.
+	rs, err := ctl.balancer.Create(c.Request.Context(), func(client *ent.Client) *ent.RepairSlipCreate {
+		builder := client.RepairSlip.
+			Create().
+			SetSymptom(obj.Symptom).
+			SetCategory(obj.Category)
+		if obj.Reporter != 0 {
+			builder.SetReporterID(obj.Reporter)
+		}
+		if obj.Equipment != 0 {
+			builder.SetEquipmentID(obj.Equipment)
+		}
+		if obj.Priority != "" {
+			builder.SetPriority(repairslip.Priority(obj.Priority))
+		}
+		return builder
+	}, obj.AutoAssign)
+	switch {
+	case errors.Is(err, assignment.ErrWorkloadChanged):
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		c.JSON(400, gin.H{
+			"error": "saving failed",
+		})
+		return
+	}
+
+	ctl.respond(c, rs.ID)
+}
+
+// GetRepairSlip handles GET requests to retrieve a repairslip entity
+// @Summary Get a repairslip entity by ID
+// @Description get repairslip by ID
+// @ID get-repairslip
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Success 200 {object} ent.RepairSlip
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id} [get]
+func (ctl *RepairSlipController) GetRepairSlip(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// ListRepairSlip handles request to get a list of repairslip entities
+// @Summary List repairslip entities
+// @Description list repairslip entities
+// @ID list-repairslip
+// @Produce json
+// @Param limit  query int false "Limit"
+// @Param offset query int false "Offset"
+// @Param status query string false "Status"
+// @Param assignee query int false "Assignee ID"
+// @Param route query string false "in_house or warranty_claim"
+// @Param equipment query int false "Equipment ID"
+// @Param location query int false "Location ID; slips of the equipment at it or any location under it"
+// @Param priority query string false "Priority"
+// @Param breached query bool false "Only slips that missed an SLA target"
+// @Success 200 {array} ent.RepairSlip
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips [get]
+func (ctl *RepairSlipController) ListRepairSlip(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	limit := 10
+	if limitQuery != "" {
+		limit64, err := strconv.ParseInt(limitQuery, 10, 64)
+		if err == nil {
+			limit = int(limit64)
+		}
+	}
+
+	offsetQuery := c.Query("offset")
+	offset := 0
+	if offsetQuery != "" {
+		offset64, err := strconv.ParseInt(offsetQuery, 10, 64)
+		if err == nil {
+			offset = int(offset64)
+		}
+	}
+
+	query := ctl.client.RepairSlip.
+		Query().
+		WithReporter().
+		WithAssignee()
+
+	if status := c.Query("status"); status != "" {
+		s := repairslip.Status(status)
+		if err := repairslip.StatusValidator(s); err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(repairslip.StatusEQ(s))
+	}
+
+	if assigneeQuery := c.Query("assignee"); assigneeQuery != "" {
+		assignee, err := strconv.ParseInt(assigneeQuery, 10, 64)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(repairslip.HasAssigneeWith(user.IDEQ(int(assignee))))
+	}
+
+	if route := c.Query("route"); route != "" {
+		r := repairslip.Route(route)
+		if err := repairslip.RouteValidator(r); err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(repairslip.RouteEQ(r))
+	}
+
+	if equipmentQuery := c.Query("equipment"); equipmentQuery != "" {
+		eq, err := strconv.ParseInt(equipmentQuery, 10, 64)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(repairslip.HasEquipmentWith(equipment.IDEQ(int(eq))))
+	}
+
+	if locationQuery := c.Query("location"); locationQuery != "" {
+		loc, err := strconv.ParseInt(locationQuery, 10, 64)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		ids, err := locations.Descendants(c.Request.Context(), ctl.client, int(loc))
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(repairslip.HasEquipmentWith(equipment.HasLocationWith(location.IDIn(ids...))))
+	}
+
+	if priority := c.Query("priority"); priority != "" {
+		p := repairslip.Priority(priority)
+		if err := repairslip.PriorityValidator(p); err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(repairslip.PriorityEQ(p))
+	}
+
+	if breachedQuery := c.Query("breached"); breachedQuery != "" {
+		breached, err := strconv.ParseBool(breachedQuery)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		if breached {
+			query.Where(repairslip.Or(repairslip.ResponseBreached(true), repairslip.ResolutionBreached(true)))
+		} else {
+			query.Where(repairslip.ResponseBreached(false), repairslip.ResolutionBreached(false))
+		}
+	}
+
+	repairslips, err := query.
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, repairslips)
+}
+
+// DeleteRepairSlip handles DELETE requests to delete a repairslip entity
+// @Summary Delete a repairslip entity by ID
+// @Description delete repairslip by ID; only supervisors and admins may
+// @ID delete-repairslip
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id} [delete]
+func (ctl *RepairSlipController) DeleteRepairSlip(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	err = ctl.client.RepairSlip.
+		DeleteOneID(int(id)).
+		Exec(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, gin.H{"result": fmt.Sprintf("ok deleted %v", id)})
+}
+
+// UpdateRepairSlipStatus handles PUT requests to change the status of a repairslip entity
+// @Summary Change the status of a repairslip entity
+// @Description change repairslip status by ID
+// @ID update-repairslip-status
+// @Accept   json
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Param status body RepairSlipStatus true "New status"
+// @Success 200 {object} ent.RepairSlip
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/status [put]
+func (ctl *RepairSlipController) UpdateRepairSlipStatus(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := RepairSlipStatus{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "status binding failed",
+		})
+		return
+	}
+
+	_, err = ctl.client.RepairSlip.
+		UpdateOneID(int(id)).
+		SetStatus(repairslip.Status(obj.Status)).
+		Save(c.Request.Context())
+	if err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// UpdateRepairSlipPriority handles PUT requests to change the priority of a repairslip entity
+// @Summary Change the priority of a repairslip entity
+// @Description change repairslip priority by ID; its SLA due dates are computed again
+// @ID update-repairslip-priority
+// @Accept   json
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Param priority body RepairSlipPriority true "New priority"
+// @Success 200 {object} ent.RepairSlip
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/priority [put]
+func (ctl *RepairSlipController) UpdateRepairSlipPriority(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := RepairSlipPriority{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "priority binding failed",
+		})
+		return
+	}
+
+	_, err = ctl.client.RepairSlip.
+		UpdateOneID(int(id)).
+		SetPriority(repairslip.Priority(obj.Priority)).
+		Save(c.Request.Context())
+	if err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// AssignRepairSlip handles PUT requests to assign a repairslip to a technician
+// @Summary Assign a repairslip to a technician
+// @Description assign an open repairslip by ID to the given enabled technician; only supervisors and admins may
+// @ID assign-repairslip
+// @Accept   json
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Param assignment body Assignment true "Technician to assign"
+// @Success 200 {object} ent.RepairSlip
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/assignee [put]
+func (ctl *RepairSlipController) AssignRepairSlip(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := Assignment{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "assignment binding failed",
+		})
+		return
+	}
+
+	if _, err := ctl.balancer.Assign(c.Request.Context(), int(id), obj.Technician); err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// UnassignRepairSlip handles DELETE requests to clear the assignee of a repairslip
+// @Summary Unassign a repairslip
+// @Description clear the technician assigned to a repairslip; only supervisors and admins may
+// @ID unassign-repairslip
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Success 200 {object} ent.RepairSlip
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/assignee [delete]
+func (ctl *RepairSlipController) UnassignRepairSlip(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := ctl.balancer.Unassign(c.Request.Context(), int(id)); err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// AutoAssignRepairSlip handles POST requests to assign a repairslip to the least loaded technician
+// @Summary Auto-assign a repairslip
+// @Description assign repairslip to the technician of its category with the fewest open slips; only supervisors and admins may
+// @ID auto-assign-repairslip
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Success 200 {object} ent.RepairSlip
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/assignee/auto [post]
+func (ctl *RepairSlipController) AutoAssignRepairSlip(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if _, err := ctl.balancer.AutoAssign(c.Request.Context(), int(id)); err != nil {
+		ctl.fail(c, err)
+		return
+	}
+
+	ctl.respond(c, int(id))
+}
+
+// UsePart handles POST requests to attach a used part to a repairslip
+// @Summary Attach a used part to a repairslip
+// @Description take parts out of stock for a repairslip; fails with 409 when stock would go negative
+// @ID use-part
+// @Accept   json
+// @Produce  json
+// @Param id path int true "RepairSlip ID"
+// @Param usage body PartUsage true "Part usage"
+// @Success 200 {object} ent.StockMovement
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/parts [post]
+func (ctl *RepairSlipController) UsePart(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := PartUsage{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "part usage binding failed",
+		})
+		return
+	}
+
+	var mv *ent.StockMovement
+	err = inventory.Book(c.Request.Context(), ctl.client, func(ctx context.Context, tx *ent.Tx) error {
+		mv, err = inventory.Consume(ctx, tx, int(id), obj.Part, obj.Location, obj.Quantity)
+		return err
+	})
+	if err != nil {
+		stockError(c, err)
+		return
+	}
+
+	c.JSON(200, mv)
+}
+
+// ListPartsUsed handles request to get the parts used for a repairslip
+// @Summary List parts used for a repairslip
+// @Description list the stock movements consumed by a repairslip
+// @ID list-parts-used
+// @Produce json
+// @Param id path int true "RepairSlip ID"
+// @Success 200 {array} ent.StockMovement
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /repairslips/{id}/parts [get]
+func (ctl *RepairSlipController) ListPartsUsed(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	movements, err := ctl.client.StockMovement.
+		Query().
+		Where(stockmovement.HasRepairSlipWith(repairslip.IDEQ(int(id)))).
+		WithPart().
+		Order(ent.Asc(stockmovement.FieldID)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, movements)
+}
+
+// respond writes the repair slip with its reporter and assignee loaded.
+func (ctl *RepairSlipController) respond(c *gin.Context, id int) {
+	rs, err := ctl.client.RepairSlip.
+		Query().
+		Where(repairslip.IDEQ(id)).
+		WithReporter().
+		WithAssignee().
+		WithEquipment().
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, rs)
+}
+
+// fail maps errors of the repair slip operations to a response.
+func (ctl *RepairSlipController) fail(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(404, gin.H{"error": err.Error()})
+	case errors.Is(err, assignment.ErrNoTechnician),
+		errors.Is(err, assignment.ErrNotOpen),
+		errors.Is(err, assignment.ErrTechnicianDisabled),
+		errors.Is(err, assignment.ErrWorkloadChanged):
+		c.JSON(409, gin.H{"error": err.Error()})
+	default:
+		c.JSON(400, gin.H{"error": err.Error()})
+	}
+}
+
+// NewRepairSlipController creates and registers handles for the repair slip controller
+func NewRepairSlipController(router gin.IRouter, client *ent.Client, balancer *assignment.Balancer) *RepairSlipController {
+	rc := &RepairSlipController{
+		client:   client,
+		balancer: balancer,
+		router:   router,
+	}
+	rc.register()
+	return rc
+}
+
+// register registers routes to the main engine
+func (ctl *RepairSlipController) register() {
+	repairslips := ctl.router.Group("/repairslips", auth.Require())
+
+	repairslips.GET("", ctl.ListRepairSlip)
+
+	// CRUD
+	repairslips.POST("", ctl.CreateRepairSlip)
+	repairslips.GET(":id", ctl.GetRepairSlip)
+	repairslips.DELETE(":id", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.DeleteRepairSlip)
+	repairslips.PUT(":id/status", ctl.UpdateRepairSlipStatus)
+	repairslips.PUT(":id/priority", ctl.UpdateRepairSlipPriority)
+
+	// Assignment, by supervisors and admins
+	assign := repairslips.Group("", auth.Require(user.RoleSupervisor, user.RoleAdmin))
+	assign.PUT(":id/assignee", ctl.AssignRepairSlip)
+	assign.DELETE(":id/assignee", ctl.UnassignRepairSlip)
+	assign.POST(":id/assignee/auto", ctl.AutoAssignRepairSlip)
+
+	// Parts
+	repairslips.POST(":id/parts", ctl.UsePart)
+	repairslips.GET(":id/parts", ctl.ListPartsUsed)
+}
@@ -0,0 +1,198 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/darksford123x/app/assignment"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+)
+
+// assignee returns the ID of the assignee of a slip, zero when it has none.
+func assignee(t *testing.T, h *servertest.Harness, slipID int) int {
+	t.Helper()
+	id, err := h.Client.RepairSlip.GetX(h.Context(), slipID).QueryAssignee().OnlyID(h.Context())
+	if ent.IsNotFound(err) {
+		return 0
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestAutoAssign(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+	first := h.User().SetRole(user.RoleTechnician).SetSkill("projector").SaveX(ctx)
+	second := h.User().SetRole(user.RoleTechnician).SetSkill("projector").SaveX(ctx)
+	h.User().SetRole(user.RoleTechnician).SetSkill("projector").SetDisabled(true).SaveX(ctx)
+	h.User().SetRole(user.RoleTechnician).SetSkill("computer").SaveX(ctx)
+
+	create := func(category string) int {
+		var rs ent.RepairSlip
+		h.Post("/api/v1/repairslips", map[string]interface{}{
+			"reporter":    admin.ID,
+			"symptom":     "Does not turn on",
+			"category":    category,
+			"auto_assign": true,
+		}, admin).Status(200).Decode(&rs)
+		return rs.ID
+	}
+	// The slips go round the enabled technicians of their category.
+	for i, want := range []int{first.ID, second.ID, first.ID} {
+		if got := assignee(t, h, create("projector")); got != want {
+			t.Errorf("slip %d assigned to %d, want %d", i, got, want)
+		}
+	}
+	if got := assignee(t, h, create("printer")); got != 0 {
+		t.Errorf("slip of a category nobody handles assigned to %d", got)
+	}
+
+	loads, err := assignment.NewBalancer(h.Client).Workload(ctx, "projector")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, l := range loads {
+		got = append(got, fmt.Sprintf("%d:%d", l.Technician, l.Count))
+	}
+	want := fmt.Sprintf("[%d:2 %d:1]", first.ID, second.ID)
+	if fmt.Sprint(got) != want {
+		t.Errorf("Workload = %v, want %s", got, want)
+	}
+
+	closed := h.RepairSlip(admin).SetStatus(repairslip.StatusClosed).SaveX(ctx)
+	h.Post(fmt.Sprintf("/api/v1/repairslips/%d/assignee/auto", closed.ID), nil, admin).Status(409)
+	if got := assignee(t, h, closed.ID); got != 0 {
+		t.Errorf("closed slip assigned to %d", got)
+	}
+}
+
+func TestAssign(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+	tech := h.User().SetRole(user.RoleTechnician).SetSkill("projector").SaveX(ctx)
+	disabled := h.User().SetRole(user.RoleTechnician).SetSkill("projector").SetDisabled(true).SaveX(ctx)
+	open := h.RepairSlip(admin).SaveX(ctx)
+	ready := h.RepairSlip(admin).SetStatus(repairslip.StatusReady).SaveX(ctx)
+	closed := h.RepairSlip(admin).SetStatus(repairslip.StatusClosed).SaveX(ctx)
+	balancer := assignment.NewBalancer(h.Client)
+
+	for _, tc := range []struct {
+		name   string
+		slip   int
+		tech   int
+		err    error
+		status int
+	}{
+		{"a staff member", open.ID, admin.ID, assignment.ErrNotTechnician, 400},
+		{"a disabled technician", open.ID, disabled.ID, assignment.ErrTechnicianDisabled, 409},
+		{"a repaired slip", ready.ID, tech.ID, assignment.ErrNotOpen, 409},
+		{"a closed slip", closed.ID, tech.ID, assignment.ErrNotOpen, 409},
+	} {
+		if _, err := balancer.Assign(ctx, tc.slip, tc.tech); !errors.Is(err, tc.err) {
+			t.Errorf("assigning %s: %v, want %v", tc.name, err, tc.err)
+		}
+		h.Put(fmt.Sprintf("/api/v1/repairslips/%d/assignee", tc.slip), map[string]interface{}{"technician": tc.tech}, admin).Status(tc.status)
+		if got := assignee(t, h, tc.slip); got != 0 {
+			t.Errorf("assigning %s: slip assigned to %d", tc.name, got)
+		}
+	}
+
+	h.Put(fmt.Sprintf("/api/v1/repairslips/%d/assignee", open.ID), map[string]interface{}{"technician": tech.ID}, admin).Status(200)
+	if got := assignee(t, h, open.ID); got != tech.ID {
+		t.Errorf("slip assigned to %d, want %d", got, tech.ID)
+	}
+}
+
+func TestAutoAssignConcurrently(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+	tech := h.User().SetRole(user.RoleTechnician).SetSkill("projector").SaveX(ctx)
+	other := h.RepairSlip(admin).SaveX(ctx)
+
+	// Whenever one of the next conflicts assignments claims the technician,
+	// the technician is assigned another slip, as if by another server that
+	// read the same workload.
+	conflicts, claims := 0, 0
+	h.Client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpUpdate) {
+				return next.Mutate(ctx, m)
+			}
+			claims++
+			if conflicts > 0 {
+				conflicts--
+				tx := ent.TxFromContext(ctx)
+				if err := tx.User.UpdateOneID(tech.ID).AddAssignmentVersion(1).Exec(ctx); err != nil {
+					return nil, err
+				}
+				if err := tx.RepairSlip.UpdateOneID(other.ID).SetAssigneeID(tech.ID).Exec(ctx); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	slip := h.RepairSlip(admin).SaveX(ctx)
+	conflicts = 1
+	h.Post(fmt.Sprintf("/api/v1/repairslips/%d/assignee/auto", slip.ID), nil, admin).Status(200)
+	if got := assignee(t, h, slip.ID); got != tech.ID {
+		t.Errorf("slip assigned to %d, want %d", got, tech.ID)
+	}
+	if claims != 2 {
+		t.Errorf("the technician was claimed %d times, want 2: once lost, once retried", claims)
+	}
+
+	// A slip that loses every attempt is not created either.
+	before := h.Client.RepairSlip.Query().CountX(ctx)
+	conflicts = 3
+	h.Post("/api/v1/repairslips", map[string]interface{}{
+		"reporter":    admin.ID,
+		"symptom":     "Does not turn on",
+		"category":    "projector",
+		"auto_assign": true,
+	}, admin).Status(409)
+	if n := h.Client.RepairSlip.Query().CountX(ctx); n != before {
+		t.Errorf("%d slips after a failed assignment, want %d", n, before)
+	}
+}
+
+func TestRepairSlipsManagedBySupervisors(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	tech := h.User().SetRole(user.RoleTechnician).SetSkill("projector").SaveX(ctx)
+	slip := h.RepairSlip(staff).SaveX(ctx)
+	path := fmt.Sprintf("/api/v1/repairslips/%d", slip.ID)
+
+	// Staff report slips, but neither delete nor assign them.
+	h.Put(path+"/assignee", map[string]interface{}{"technician": tech.ID}, staff).Status(403)
+	h.Post(path+"/assignee/auto", nil, staff).Status(403)
+	if got := assignee(t, h, slip.ID); got != 0 {
+		t.Errorf("slip assigned to %d by staff", got)
+	}
+	h.Put(path+"/assignee", map[string]interface{}{"technician": tech.ID}, supervisor).Status(200)
+	h.Delete(path+"/assignee", staff).Status(403)
+	if got := assignee(t, h, slip.ID); got != tech.ID {
+		t.Errorf("slip assigned to %d after staff unassigned it, want %d", got, tech.ID)
+	}
+	h.Delete(path, staff).Status(403)
+	h.Get(path, staff).Status(200)
+
+	h.Delete(path+"/assignee", supervisor).Status(200)
+	h.Delete(path, supervisor).Status(200)
+	h.Get(path, staff).Status(404)
+}
@@ -0,0 +1,12 @@
+package controllers
+
+// ErrorResponse defines the struct of the responses of failed requests
+type ErrorResponse struct {
+	Error string `json:"error" validate:"required"`
+}
+
+// Result defines the struct of the responses of requests that return no
+// entity, such as deletes
+type Result struct {
+	Result string `json:"result" validate:"required"`
+}
@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/search"
+	"github.com/gin-gonic/gin"
+)
+
+// SearchController defines the struct for the search controller
+type SearchController struct {
+	index  *search.Index
+	router gin.IRouter
+}
+
+// Search handles GET requests to search repair slips, comments, equipment and users
+// @Summary Search
+// @Description find repair slips by symptom and equipment, comments by body, equipment by name, model and serial number, and users by name and email. Every word of the query must match, words of Latin script as prefixes and Thai anywhere. Results are ranked by relevance and have an HTML snippet with the matches in <mark>. Internal comments are only found by those who may read them.
+// @ID search
+// @Produce json
+// @Param q query string true "Query"
+// @Param kind query string false "Comma separated kinds: repairslip, comment, equipment, user"
+// @Param limit  query int false "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {array} search.Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /search [get]
+func (ctl *SearchController) Search(c *gin.Context) {
+	limitQuery := c.Query("limit")
+	limit := 20
+	if limitQuery != "" {
+		limit64, err := strconv.ParseInt(limitQuery, 10, 64)
+		if err == nil && limit64 > 0 && limit64 <= 100 {
+			limit = int(limit64)
+		}
+	}
+
+	offsetQuery := c.Query("offset")
+	offset := 0
+	if offsetQuery != "" {
+		offset64, err := strconv.ParseInt(offsetQuery, 10, 64)
+		if err == nil && offset64 >= 0 {
+			offset = int(offset64)
+		}
+	}
+
+	q := search.Query{
+		Text:   c.Query("q"),
+		Reader: auth.FromContext(c.Request.Context()),
+		Limit:  limit,
+		Offset: offset,
+	}
+	if kinds := c.Query("kind"); kinds != "" {
+		for _, k := range strings.Split(kinds, ",") {
+			if !searchable(k) {
+				c.JSON(400, gin.H{"error": "unknown kind " + k})
+				return
+			}
+			q.Kinds = append(q.Kinds, k)
+		}
+	}
+
+	results, err := ctl.index.Search(c.Request.Context(), q)
+	if errors.Is(err, search.ErrEmptyQuery) {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	if results == nil {
+		results = []search.Result{}
+	}
+
+	c.JSON(200, results)
+}
+
+// searchable reports whether documents of the kind are searched.
+func searchable(kind string) bool {
+	for _, k := range search.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// NewSearchController creates and registers handles for the search controller
+func NewSearchController(router gin.IRouter, index *search.Index) *SearchController {
+	sc := &SearchController{
+		index:  index,
+		router: router,
+	}
+	sc.register()
+	return sc
+}
+
+// register registers routes to the main engine
+func (ctl *SearchController) register() {
+	ctl.router.GET("/search", auth.Require(), ctl.Search)
+}
@@ -0,0 +1,56 @@
+package controllers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/search"
+	"github.com/darksford123x/app/servertest"
+)
+
+func TestSearchHidesInternalComments(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	tech := h.User().SetRole(user.RoleTechnician).SaveX(ctx)
+	slip := h.RepairSlip(staff).SetSymptom("Projector does not turn on").SaveX(ctx)
+	post := func(body, visibility string) ent.Comment {
+		var c ent.Comment
+		h.Post(fmt.Sprintf("/api/v1/repairslips/%d/comments", slip.ID), map[string]interface{}{
+			"body":       body,
+			"visibility": visibility,
+		}, tech).Status(200).Decode(&c)
+		return c
+	}
+	public := post("The **lamp** is ordered", "public")
+	internal := post("The lamp supplier overcharges us", "internal")
+
+	// found returns the comments found by the user.
+	found := func(q string, as *ent.User) []int {
+		t.Helper()
+		var results []search.Result
+		h.Get("/api/v1/search?kind=comment&q="+q, as).Status(200).Decode(&results)
+		var ids []int
+		for _, r := range results {
+			if r.RepairSlip != slip.ID {
+				t.Errorf("comment %d found on slip %d, want %d", r.ID, r.RepairSlip, slip.ID)
+			}
+			ids = append(ids, r.ID)
+		}
+		return ids
+	}
+	if got, want := fmt.Sprint(found("lamp", staff)), fmt.Sprint([]int{public.ID}); got != want {
+		t.Errorf("staff found comments %s, want %s", got, want)
+	}
+	if got := found("supplier", staff); len(got) != 0 {
+		t.Errorf("staff found comments %v, want none", got)
+	}
+	if got, want := fmt.Sprint(found("supplier", tech)), fmt.Sprint([]int{internal.ID}); got != want {
+		t.Errorf("technician found comments %s, want %s", got, want)
+	}
+	if got := found("lamp", tech); len(got) != 2 {
+		t.Errorf("technician found comments %v, want both", got)
+	}
+}
@@ -0,0 +1,271 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/holiday"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/sla"
+	"github.com/gin-gonic/gin"
+)
+
+// SLAController defines the struct for the SLA controller
+type SLAController struct {
+	client  *ent.Client
+	tracker *sla.Tracker
+	router  gin.IRouter
+}
+
+// Holiday defines the struct for adding a holiday
+type Holiday struct {
+	// Date is the day of the holiday, e.g. 2020-04-13.
+	Date string `json:"date" validate:"required" format:"date"`
+	Name string `json:"name" validate:"required" minLength:"1"`
+}
+
+// CreateSLAPolicy handles POST requests for adding slapolicy entities
+// @Summary Create slapolicy
+// @Description Create the SLA policy of a priority and category; an empty category applies to all categories without a policy of their own; only supervisors and admins may
+// @ID create-slapolicy
+// @Accept   json
+// @Produce  json
+// @Param slapolicy body ent.SLAPolicy true "SLAPolicy entity"
+// @Success 200 {object} ent.SLAPolicy
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /sla-policies [post]
+func (ctl *SLAController) CreateSLAPolicy(c *gin.Context) {
+	obj := ent.SLAPolicy{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "slapolicy binding failed",
+		})
+		return
+	}
+
+	p, err := ctl.client.SLAPolicy.
+		Create().
+		SetPriority(obj.Priority).
+		SetCategory(obj.Category).
+		SetResponseMinutes(obj.ResponseMinutes).
+		SetResolutionMinutes(obj.ResolutionMinutes).
+		Save(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, p)
+}
+
+// ListSLAPolicy handles request to get a list of slapolicy entities
+// @Summary List slapolicy entities
+// @Description list slapolicy entities
+// @ID list-slapolicy
+// @Produce json
+// @Success 200 {array} ent.SLAPolicy
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /sla-policies [get]
+func (ctl *SLAController) ListSLAPolicy(c *gin.Context) {
+	policies, err := ctl.client.SLAPolicy.
+		Query().
+		Order(ent.Asc(slapolicy.FieldCategory), ent.Asc(slapolicy.FieldPriority)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, policies)
+}
+
+// DeleteSLAPolicy handles DELETE requests to delete a slapolicy entity
+// @Summary Delete a slapolicy entity by ID
+// @Description delete slapolicy by ID; the due dates of existing slips are kept; only supervisors and admins may
+// @ID delete-slapolicy
+// @Produce  json
+// @Param id path int true "SLAPolicy ID"
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /sla-policies/{id} [delete]
+func (ctl *SLAController) DeleteSLAPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	err = ctl.client.SLAPolicy.
+		DeleteOneID(int(id)).
+		Exec(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, gin.H{"result": fmt.Sprintf("ok deleted %v", id)})
+}
+
+// CreateHoliday handles POST requests for adding holiday entities
+// @Summary Create holiday
+// @Description Add a holiday to the business calendar; only supervisors and admins may
+// @ID create-holiday
+// @Accept   json
+// @Produce  json
+// @Param holiday body Holiday true "Holiday"
+// @Success 200 {object} ent.Holiday
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /holidays [post]
+func (ctl *SLAController) CreateHoliday(c *gin.Context) {
+	obj := Holiday{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "holiday binding failed",
+		})
+		return
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", obj.Date, ctl.tracker.Location())
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	h, err := ctl.client.Holiday.
+		Create().
+		SetDate(date).
+		SetName(obj.Name).
+		Save(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, h)
+}
+
+// ListHoliday handles request to get a list of holiday entities
+// @Summary List holiday entities
+// @Description list holidays, optionally of a single year
+// @ID list-holiday
+// @Produce json
+// @Param year query int false "Year"
+// @Success 200 {array} ent.Holiday
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /holidays [get]
+func (ctl *SLAController) ListHoliday(c *gin.Context) {
+	query := ctl.client.Holiday.Query()
+
+	if yearQuery := c.Query("year"); yearQuery != "" {
+		year, err := strconv.ParseInt(yearQuery, 10, 64)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		from := time.Date(int(year), time.January, 1, 0, 0, 0, 0, ctl.tracker.Location())
+		query.Where(holiday.DateGTE(from), holiday.DateLT(from.AddDate(1, 0, 0)))
+	}
+
+	holidays, err := query.
+		Order(ent.Asc(holiday.FieldDate)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, holidays)
+}
+
+// DeleteHoliday handles DELETE requests to delete a holiday entity
+// @Summary Delete a holiday entity by ID
+// @Description delete holiday by ID; only supervisors and admins may
+// @ID delete-holiday
+// @Produce  json
+// @Param id path int true "Holiday ID"
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /holidays/{id} [delete]
+func (ctl *SLAController) DeleteHoliday(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	err = ctl.client.Holiday.
+		DeleteOneID(int(id)).
+		Exec(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, gin.H{"result": fmt.Sprintf("ok deleted %v", id)})
+}
+
+// NewSLAController creates and registers handles for the SLA controller
+func NewSLAController(router gin.IRouter, client *ent.Client, tracker *sla.Tracker) *SLAController {
+	sc := &SLAController{
+		client:  client,
+		tracker: tracker,
+		router:  router,
+	}
+	sc.register()
+	return sc
+}
+
+// register registers routes to the main engine
+func (ctl *SLAController) register() {
+	policies := ctl.router.Group("/sla-policies", auth.Require())
+	policies.GET("", ctl.ListSLAPolicy)
+	policies.POST("", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.CreateSLAPolicy)
+	policies.DELETE(":id", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.DeleteSLAPolicy)
+
+	holidays := ctl.router.Group("/holidays", auth.Require())
+	holidays.GET("", ctl.ListHoliday)
+	holidays.POST("", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.CreateHoliday)
+	holidays.DELETE(":id", auth.Require(user.RoleSupervisor, user.RoleAdmin), ctl.DeleteHoliday)
+}
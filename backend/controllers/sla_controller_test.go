@@ -0,0 +1,176 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/assignment"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+	"github.com/darksford123x/app/sla"
+)
+
+// notices records the notices of a monitor.
+type notices struct {
+	got   map[string]int
+	after func()
+}
+
+func (n *notices) Notify(ctx context.Context, notice sla.Notice) error {
+	n.got[fmt.Sprintf("%d %s", notice.Slip.ID, notice.Breach)]++
+	if f := n.after; f != nil {
+		n.after = nil
+		f()
+	}
+	return nil
+}
+
+func TestMonitorEscalatesOnce(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	h.Client.SLAPolicy.Create().
+		SetPriority(slapolicy.PriorityNormal).
+		SetResponseMinutes(60).
+		SetResolutionMinutes(120).
+		SaveX(ctx)
+	u := h.User().SaveX(ctx)
+	var slips []*ent.RepairSlip
+	for i := 0; i < 3; i++ {
+		slips = append(slips, h.RepairSlip(u).SaveX(ctx))
+	}
+	failing := slips[1].ID
+	h.Client.RepairSlip.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.RepairSlipFunc(func(ctx context.Context, m *ent.RepairSlipMutation) (ent.Value, error) {
+			if id, ok := m.ID(); ok && id == failing {
+				return nil, errors.New("the database is gone")
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	// The first monitor read the overdue slips when the second one checks
+	// them: each breach is escalated once all the same, and the slip that
+	// fails does not stop the others.
+	now := time.Now().Add(365 * 24 * time.Hour)
+	balancer := assignment.NewBalancer(h.Client)
+	first := &notices{got: map[string]int{}}
+	second := &notices{got: map[string]int{}}
+	first.after = func() {
+		if _, err := sla.NewMonitor(h.Client, balancer, second, time.Minute).Check(ctx, now); err != nil {
+			t.Errorf("second check: %v", err)
+		}
+	}
+	n, err := sla.NewMonitor(h.Client, balancer, first, time.Minute).Check(ctx, now)
+	if err != nil {
+		t.Fatalf("first check: %v", err)
+	}
+	for _, s := range []*ent.RepairSlip{slips[0], slips[2]} {
+		for _, b := range []sla.Breach{sla.ResponseBreach, sla.ResolutionBreach} {
+			key := fmt.Sprintf("%d %s", s.ID, b)
+			if c := first.got[key] + second.got[key]; c != 1 {
+				t.Errorf("%s breach of slip %d escalated %d times, want once", b, s.ID, c)
+			}
+		}
+	}
+	if c := len(first.got) + len(second.got); c != 4 || n != len(first.got) {
+		t.Errorf("%d breaches escalated, the first check counted %d of them; want 4, with the failing slip left", c, n)
+	}
+	if s := h.Client.RepairSlip.GetX(ctx, failing); s.ResponseBreached || s.ResolutionBreached {
+		t.Errorf("the failing slip was marked as breached")
+	}
+}
+
+func TestSLASettingsSupervisorsOnly(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	policy := map[string]interface{}{
+		"priority":           "urgent",
+		"response_minutes":   30,
+		"resolution_minutes": 240,
+	}
+	holiday := map[string]interface{}{"date": "2020-04-13", "name": "Songkran"}
+
+	h.Post("/api/v1/sla-policies", policy, staff).Status(403)
+	h.Post("/api/v1/holidays", holiday, staff).Status(403)
+	var p ent.SLAPolicy
+	h.Post("/api/v1/sla-policies", policy, supervisor).Status(200).Decode(&p)
+	var hol ent.Holiday
+	h.Post("/api/v1/holidays", holiday, supervisor).Status(200).Decode(&hol)
+
+	h.Delete(fmt.Sprintf("/api/v1/sla-policies/%d", p.ID), staff).Status(403)
+	h.Delete(fmt.Sprintf("/api/v1/holidays/%d", hol.ID), staff).Status(403)
+	if n := h.Client.SLAPolicy.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d policies after staff deleted one, want 1", n)
+	}
+	if n := h.Client.Holiday.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d holidays after staff deleted one, want 1", n)
+	}
+	// Everyone reads them.
+	h.Get("/api/v1/sla-policies", staff).Status(200)
+	h.Get("/api/v1/holidays", staff).Status(200)
+}
+
+func TestPriorityChangeKeepsBreaches(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	for _, p := range []struct {
+		priority   slapolicy.Priority
+		response   int
+		resolution int
+	}{
+		{slapolicy.PriorityNormal, 60, 120},
+		{slapolicy.PriorityUrgent, 30, 60},
+		{slapolicy.PriorityLow, 200000, 400000},
+	} {
+		h.Client.SLAPolicy.Create().
+			SetPriority(p.priority).
+			SetResponseMinutes(p.response).
+			SetResolutionMinutes(p.resolution).
+			SaveX(ctx)
+	}
+	u := h.User().SaveX(ctx)
+	month := time.Now().AddDate(0, -1, 0)
+	waiting := h.RepairSlip(u).SetCreateTime(month).SaveX(ctx)
+	answered := h.RepairSlip(u).
+		SetCreateTime(month).
+		SetStatus(repairslip.StatusInProgress).
+		SetRespondedAt(time.Now()).
+		SetResponseBreached(true).
+		SaveX(ctx)
+	monitor := sla.NewMonitor(h.Client, assignment.NewBalancer(h.Client), &notices{got: map[string]int{}}, time.Minute)
+	if _, err := monitor.Check(ctx, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	prioritize := func(id int, priority string) {
+		h.Put(fmt.Sprintf("/api/v1/repairslips/%d/priority", id), map[string]string{"priority": priority}, u).Status(200)
+	}
+	breached := func(id int) string {
+		s := h.Client.RepairSlip.GetX(ctx, id)
+		return fmt.Sprint(s.ResponseBreached, s.ResolutionBreached)
+	}
+
+	// A shorter deadline keeps the breaches.
+	prioritize(waiting.ID, "urgent")
+	if got := breached(waiting.ID); got != "true true" {
+		t.Errorf("breaches after raising the priority: %s, want true true", got)
+	}
+	// A deadline still ahead lifts them, but the response that came late
+	// stays late.
+	prioritize(waiting.ID, "low")
+	if got := breached(waiting.ID); got != "false false" {
+		t.Errorf("breaches after lowering the priority: %s, want false false", got)
+	}
+	prioritize(answered.ID, "low")
+	if got := breached(answered.ID); got != "true false" {
+		t.Errorf("breaches of the slip responded to late after lowering the priority: %s, want true false", got)
+	}
+}
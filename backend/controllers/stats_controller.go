@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/stats"
+	"github.com/gin-gonic/gin"
+)
+
+// StatsController defines the struct for the stats controller
+type StatsController struct {
+	aggregator *stats.Aggregator
+	location   *time.Location
+	router     gin.IRouter
+}
+
+// statsParams are the query parameters of the stats that are not filters.
+var statsParams = map[string]bool{
+	"group_by": true,
+	"agg":      true,
+	"bucket":   true,
+	"time":     true,
+	"from":     true,
+	"to":       true,
+}
+
+// GetStats handles GET requests to aggregate entities
+// @Summary Aggregate entities
+// @Description group user, repairslip, equipment, invoice or payment entities by fields and by the day, week or month of a time field, and count them or sum, average, or take the minimum or maximum of a number. Any other parameter named as a field filters by its comma separated values, e.g. status=received,in_progress. Weeks start on Monday and days in the business time zone. The table has a column for each group by field, one for the bucket named as it, and one for each aggregate named as its function and field, e.g. sum_total; amounts are decimal strings. Only supervisors and admins may aggregate invoices and payments.
+// @ID get-stats
+// @Produce json
+// @Param entity path string true "Entity: user, repairslip, equipment, invoice or payment"
+// @Param group_by query string false "Comma separated fields"
+// @Param agg query string false "Comma separated aggregates: count, or sum, mean, min or max of a number, e.g. sum:total; count by default"
+// @Param bucket query string false "day, week or month"
+// @Param time query string false "Time field bucketed and limited by from and to; create_time, or paid_at for payments, by default"
+// @Param from query string false "First day (2006-01-02) or time (RFC 3339)"
+// @Param to query string false "Last day (2006-01-02) or the time (RFC 3339) before which"
+// @Success 200 {object} stats.Table
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /stats/{entity} [get]
+func (ctl *StatsController) GetStats(c *gin.Context) {
+	q := stats.Query{
+		Entity:  c.Param("entity"),
+		Bucket:  c.Query("bucket"),
+		Time:    c.Query("time"),
+		Filters: map[string][]string{},
+	}
+	if fields := c.Query("group_by"); fields != "" {
+		q.GroupBy = strings.Split(fields, ",")
+	}
+	if aggs := c.Query("agg"); aggs != "" {
+		for _, a := range strings.Split(aggs, ",") {
+			q.Aggregates = append(q.Aggregates, stats.ParseAggregate(a))
+		}
+	}
+	var err error
+	if q.From, err = ctl.day(c.Query("from"), false); err != nil {
+		c.JSON(400, gin.H{"error": "from: " + err.Error()})
+		return
+	}
+	if q.To, err = ctl.day(c.Query("to"), true); err != nil {
+		c.JSON(400, gin.H{"error": "to: " + err.Error()})
+		return
+	}
+	for name, values := range c.Request.URL.Query() {
+		if statsParams[name] {
+			continue
+		}
+		for _, v := range values {
+			q.Filters[name] = append(q.Filters[name], strings.Split(v, ",")...)
+		}
+	}
+
+	t, err := ctl.aggregator.Aggregate(c.Request.Context(), q)
+	if errors.Is(err, stats.ErrInvalid) {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, auth.ErrForbidden) {
+		c.JSON(403, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	if t.Rows == nil {
+		t.Rows = [][]interface{}{}
+	}
+
+	c.JSON(200, t)
+}
+
+// day parses a day in the business time zone or an RFC 3339 time. The end
+// of a range is the start of the day after the day.
+func (ctl *StatsController) day(s string, end bool) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	d, err := time.ParseInLocation("2006-01-02", s, ctl.location)
+	if err != nil {
+		return time.Parse(time.RFC3339, s)
+	}
+	if end {
+		d = d.AddDate(0, 0, 1)
+	}
+	return d, nil
+}
+
+// NewStatsController creates and registers handles for the stats controller
+func NewStatsController(router gin.IRouter, aggregator *stats.Aggregator, location *time.Location) *StatsController {
+	sc := &StatsController{
+		aggregator: aggregator,
+		location:   location,
+		router:     router,
+	}
+	sc.register()
+	return sc
+}
+
+// register registers routes to the main engine
+func (ctl *StatsController) register() {
+	ctl.router.GET("/stats/:entity", auth.Require(), ctl.GetStats)
+}
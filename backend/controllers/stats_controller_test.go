@@ -0,0 +1,104 @@
+package controllers_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+	"github.com/darksford123x/app/stats"
+)
+
+func TestStats(t *testing.T) {
+	cfg := servertest.Config(t)
+	cfg.Location = time.FixedZone("ICT", 7*60*60)
+	h := servertest.NewWithConfig(t, cfg)
+	ctx := h.Context()
+	u := h.User().SaveX(ctx)
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2020, month, day, hour, 0, 0, 0, cfg.Location)
+	}
+	for _, s := range []struct {
+		created  time.Time
+		status   repairslip.Status
+		priority repairslip.Priority
+	}{
+		// Sunday night in UTC, and already Monday in Bangkok.
+		{time.Date(2020, time.April, 5, 20, 0, 0, 0, time.UTC), repairslip.StatusReceived, repairslip.PriorityNormal},
+		// Sunday, the last day of the same week.
+		{at(time.April, 12, 10), repairslip.StatusReceived, repairslip.PriorityHigh},
+		{at(time.April, 13, 9), repairslip.StatusInProgress, repairslip.PriorityHigh},
+		{at(time.May, 1, 9), repairslip.StatusClosed, repairslip.PriorityNormal},
+	} {
+		// Times are stored in the local time zone, as time.Now gives them.
+		h.RepairSlip(u).SetCreateTime(s.created.In(time.Local)).SetStatus(s.status).SetPriority(s.priority).SaveX(ctx)
+	}
+
+	// table returns the rows of the stats of the query.
+	table := func(query string) string {
+		t.Helper()
+		var tb stats.Table
+		h.Get("/api/v1/stats/repairslip?"+query, u).Status(200).Decode(&tb)
+		return fmt.Sprint(tb.Columns, tb.Rows)
+	}
+	for _, tc := range []struct {
+		query string
+		want  string
+	}{
+		{"group_by=status", "[status count] [[closed 1] [in_progress 1] [received 2]]"},
+		{"group_by=priority,status", "[priority status count] [[high in_progress 1] [high received 1] [normal closed 1] [normal received 1]]"},
+		{"bucket=day", "[day count] [[2020-04-06 1] [2020-04-12 1] [2020-04-13 1] [2020-05-01 1]]"},
+		// Weeks start on Monday, in the business time zone.
+		{"bucket=week", "[week count] [[2020-04-06 2] [2020-04-13 1] [2020-04-27 1]]"},
+		{"bucket=month&group_by=priority", "[priority month count] [[high 2020-04-01 2] [normal 2020-04-01 1] [normal 2020-05-01 1]]"},
+		{"group_by=status&status=received,in_progress", "[status count] [[in_progress 1] [received 2]]"},
+		{"bucket=day&from=2020-04-06&to=2020-04-12", "[day count] [[2020-04-06 1] [2020-04-12 1]]"},
+		{"group_by=priority&priority=urgent", "[priority count] []"},
+	} {
+		if got := table(tc.query); got != tc.want {
+			t.Errorf("stats of %s = %s, want %s", tc.query, got, tc.want)
+		}
+	}
+
+	// Fields, functions and buckets the entity does not have are refused.
+	for _, query := range []string{
+		"group_by=symptom",
+		"group_by=status&colour=red",
+		"group_by=status&agg=sum:status",
+		"group_by=status&agg=median:priority",
+		"bucket=year",
+		"bucket=day&time=symptom",
+		"group_by=status&from=yesterday",
+	} {
+		h.Get("/api/v1/stats/repairslip?"+query, u).Status(400)
+	}
+	h.Get("/api/v1/stats/part?group_by=name", u).Status(400)
+}
+
+func TestStatsOfTakings(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	slip := h.RepairSlip(staff).SetStatus(repairslip.StatusClosed).SaveX(ctx)
+	var inv ent.Invoice
+	h.Post(fmt.Sprintf("/api/v1/repairslips/%d/invoice", slip.ID), map[string]interface{}{
+		"labour_hours": "1",
+		"labour_rate":  "500",
+	}, supervisor).Status(200).Decode(&inv)
+
+	// Only those who bill see the takings.
+	h.Get("/api/v1/stats/invoice?agg=sum:total", staff).Status(403)
+	h.Get("/api/v1/stats/payment?group_by=method", staff).Status(403)
+	h.Get("/api/v1/stats/repairslip?group_by=status", staff).Status(200)
+
+	var tb stats.Table
+	h.Get("/api/v1/stats/invoice?group_by=payment_status&agg=count,sum:total", supervisor).Status(200).Decode(&tb)
+	if got, want := fmt.Sprint(tb.Columns, tb.Rows), fmt.Sprint("[payment_status count sum_total] [[unpaid 1 ", inv.Total, "]]"); got != want {
+		t.Errorf("stats of invoices = %s, want %s", got, want)
+	}
+	h.Get("/api/v1/stats/payment?group_by=method", supervisor).Status(200)
+}
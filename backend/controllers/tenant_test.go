@@ -0,0 +1,268 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/jobs"
+	"github.com/darksford123x/app/search"
+	"github.com/darksford123x/app/servertest"
+	"github.com/darksford123x/app/tenant"
+)
+
+// tenants are the data of two organizations sharing a backend.
+type tenants struct {
+	h, other *servertest.Harness
+	// admin, slip and equipment are those of h, theirs those of other.
+	admin, theirAdmin         *ent.User
+	slip, theirSlip           *ent.RepairSlip
+	equipment, theirEquipment *ent.Equipment
+}
+
+// newTenants boots a backend with the same fixtures in two organizations.
+func newTenants(t *testing.T) *tenants {
+	h := servertest.New(t)
+	ts := &tenants{h: h, other: h.Tenant("Science")}
+	ts.admin, ts.equipment, ts.slip = fixtures(ts.h)
+	ts.theirAdmin, ts.theirEquipment, ts.theirSlip = fixtures(ts.other)
+	return ts
+}
+
+// fixtures creates an admin, a technician, equipment under warranty and a
+// repair slip of it in the organization of the harness.
+func fixtures(h *servertest.Harness) (*ent.User, *ent.Equipment, *ent.RepairSlip) {
+	ctx := h.Context()
+	admin := h.User().SetName("Somchai Admin").SetRole(user.RoleAdmin).SaveX(ctx)
+	h.User().SetRole(user.RoleTechnician).SetSkill("projector").SaveX(ctx)
+	now := time.Now()
+	eq := h.Equipment().
+		SetWarrantyStart(now.AddDate(-1, 0, 0)).
+		SetWarrantyEnd(now.AddDate(0, 0, 10)).
+		SaveX(ctx)
+	slip := h.RepairSlip(admin).SetEquipment(eq).SaveX(ctx)
+	return admin, eq, slip
+}
+
+// ids returns the IDs of a list response.
+func ids(r *servertest.Response) []int {
+	var list []struct {
+		ID int `json:"id"`
+	}
+	r.Decode(&list)
+	ids := make([]int, len(list))
+	for i, e := range list {
+		ids[i] = e.ID
+	}
+	return ids
+}
+
+// has reports whether id is in ids.
+func has(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTenantList(t *testing.T) {
+	ts := newTenants(t)
+	h := ts.h
+
+	for _, path := range []string{"/api/v1/users", "/api/v1/repairslips", "/api/v1/equipment"} {
+		got := ids(h.Get(path+"?limit=100", ts.admin).Status(200))
+		theirs := ids(h.Get(path+"?limit=100", ts.theirAdmin).Status(200))
+		if len(got) == 0 || len(theirs) == 0 {
+			t.Fatalf("GET %s: %v and %v, want the entities of each organization", path, got, theirs)
+		}
+		for _, id := range got {
+			if has(theirs, id) {
+				t.Errorf("GET %s: %d is listed to both organizations", path, id)
+			}
+		}
+	}
+	if got := ids(h.Get("/api/v1/repairslips", ts.admin).Status(200)); len(got) != 1 || got[0] != ts.slip.ID {
+		t.Errorf("GET /repairslips = %v, want only slip %d", got, ts.slip.ID)
+	}
+}
+
+func TestTenantGet(t *testing.T) {
+	ts := newTenants(t)
+	h := ts.h
+
+	for _, path := range []string{
+		fmt.Sprintf("/api/v1/users/%d", ts.theirAdmin.ID),
+		fmt.Sprintf("/api/v1/repairslips/%d", ts.theirSlip.ID),
+		fmt.Sprintf("/api/v1/repairslips/%d/timeline", ts.theirSlip.ID),
+		fmt.Sprintf("/api/v1/equipment/%d", ts.theirEquipment.ID),
+	} {
+		h.Get(path, ts.admin).Status(404)
+	}
+	h.Get(fmt.Sprintf("/api/v1/repairslips/%d", ts.slip.ID), ts.admin).Status(200)
+}
+
+func TestTenantWrite(t *testing.T) {
+	ts := newTenants(t)
+	h := ts.h
+
+	h.Put(fmt.Sprintf("/api/v1/users/%d", ts.theirAdmin.ID), map[string]interface{}{"name": "Taken over"}, ts.admin).
+		Status(404)
+	h.Put(fmt.Sprintf("/api/v1/repairslips/%d/status", ts.theirSlip.ID), controllers.RepairSlipStatus{Status: "in_progress"}, ts.admin).
+		Status(404)
+	h.Delete(fmt.Sprintf("/api/v1/repairslips/%d", ts.theirSlip.ID), ts.admin).Status(404)
+	h.Delete(fmt.Sprintf("/api/v1/users/%d", ts.theirAdmin.ID), ts.admin).Status(404)
+
+	ctx := ts.other.Context()
+	if u := ts.other.Client.User.GetX(ctx, ts.theirAdmin.ID); u.Name != ts.theirAdmin.Name {
+		t.Errorf("the user of the other organization was renamed %q", u.Name)
+	}
+	if s := ts.other.Client.RepairSlip.GetX(ctx, ts.theirSlip.ID); s.Status != repairslip.StatusReceived {
+		t.Errorf("the slip of the other organization is %s", s.Status)
+	}
+
+	// Serial numbers are unique in an organization only.
+	h.Post("/api/v1/equipment", controllers.Equipment{Name: "Projector", SerialNumber: ts.theirEquipment.SerialNumber}, ts.admin).
+		Status(200)
+	h.Post("/api/v1/equipment", controllers.Equipment{Name: "Projector", SerialNumber: ts.equipment.SerialNumber}, ts.admin).
+		Status(409)
+}
+
+func TestTenantEdges(t *testing.T) {
+	ts := newTenants(t)
+	h := ts.h
+
+	// The entities of another organization cannot be linked to.
+	h.Post("/api/v1/repairslips", controllers.RepairSlip{
+		Symptom:   "Fan is loud",
+		Category:  "projector",
+		Equipment: ts.theirEquipment.ID,
+	}, ts.admin).Status(400)
+	if n := h.Client.RepairSlip.Query().CountX(h.Context()); n != 1 {
+		t.Errorf("%d slips, want the slip of the fixtures only", n)
+	}
+	tech := ts.other.Client.User.Query().Where(user.RoleEQ(user.RoleTechnician)).OnlyX(ts.other.Context())
+	h.Put(fmt.Sprintf("/api/v1/repairslips/%d/assignee", ts.slip.ID), controllers.Assignment{Technician: tech.ID}, ts.admin).
+		Status(404)
+
+	// Nor can an entity be created or moved into another organization
+	// directly on the client.
+	_, err := h.Part().
+		SetOrganization(ts.other.Organization).
+		Save(h.Context())
+	if err == nil {
+		t.Error("created a part of another organization")
+	}
+	_, err = h.Client.Equipment.
+		UpdateOne(ts.equipment).
+		SetOrganization(ts.other.Organization).
+		Save(h.Context())
+	if err == nil {
+		t.Error("moved equipment to another organization")
+	}
+}
+
+func TestTenantBulk(t *testing.T) {
+	ts := newTenants(t)
+	h := ts.h
+
+	// Search finds the users of both organizations by name, but each only
+	// sees its own.
+	var results []search.Result
+	h.Get("/api/v1/search?q=somchai", ts.admin).Status(200).Decode(&results)
+	if len(results) != 1 || results[0].ID != ts.admin.ID {
+		t.Errorf("GET /search = %+v, want only user %d", results, ts.admin.ID)
+	}
+
+	expiring := ids(h.Get("/api/v1/warranties/expiring", ts.admin).Status(200))
+	if len(expiring) != 1 || expiring[0] != ts.equipment.ID {
+		t.Errorf("GET /warranties/expiring = %v, want only equipment %d", expiring, ts.equipment.ID)
+	}
+
+	for _, o := range []*servertest.Harness{ts.h, ts.other} {
+		p := o.Part().SaveX(o.Context())
+		o.Client.StockLevel.Create().SetLocation("Store").SetOnHand(5).SetPart(p).SaveX(o.Context())
+	}
+	if stock := ids(h.Get("/api/v1/stock", ts.admin).Status(200)); len(stock) != 1 {
+		t.Errorf("GET /stock = %v, want the level of one part", stock)
+	}
+
+	// The organization of the admin is the only one GraphQL sees.
+	var res struct {
+		Data struct {
+			Users         struct{ TotalCount int } `json:"users"`
+			RepairSlips   struct{ TotalCount int } `json:"repairSlips"`
+			Organizations struct{ TotalCount int } `json:"organizations"`
+		} `json:"data"`
+	}
+	h.Post("/api/v1/graphql", map[string]string{
+		"query": "{ users { totalCount } repairSlips { totalCount } organizations { totalCount } }",
+	}, ts.admin).Status(200).Decode(&res)
+	if d := res.Data; d.Users.TotalCount != 2 || d.RepairSlips.TotalCount != 1 || d.Organizations.TotalCount != 1 {
+		t.Errorf("GraphQL counts = %+v, want 2 users, 1 slip and 1 organization", d)
+	}
+}
+
+func TestTenantInvoices(t *testing.T) {
+	ts := newTenants(t)
+	h := ts.h
+
+	var numbers []string
+	for _, o := range []struct {
+		h     *servertest.Harness
+		admin *ent.User
+		slip  *ent.RepairSlip
+	}{{ts.h, ts.admin, ts.slip}, {ts.other, ts.theirAdmin, ts.theirSlip}} {
+		o.h.Client.RepairSlip.UpdateOne(o.slip).SetStatus(repairslip.StatusClosed).ExecX(o.h.Context())
+		var inv ent.Invoice
+		h.Post(fmt.Sprintf("/api/v1/repairslips/%d/invoice", o.slip.ID), map[string]interface{}{
+			"labour_hours": "1",
+			"labour_rate":  "500",
+		}, o.admin).Status(200).Decode(&inv)
+		numbers = append(numbers, inv.Number)
+	}
+	// The numbers are a sequence shared by the organizations.
+	if numbers[0] == numbers[1] {
+		t.Errorf("both organizations were issued invoice %s", numbers[0])
+	}
+
+	var balances []struct {
+		Customer int `json:"customer"`
+	}
+	h.Get("/api/v1/balances", ts.admin).Status(200).Decode(&balances)
+	if len(balances) != 1 || balances[0].Customer != ts.admin.ID {
+		t.Errorf("GET /balances = %+v, want only the balance of user %d", balances, ts.admin.ID)
+	}
+	if got := ids(h.Get("/api/v1/invoices", ts.admin).Status(200)); len(got) != 1 {
+		t.Errorf("GET /invoices = %v, want one invoice", got)
+	}
+}
+
+func TestTenantJobs(t *testing.T) {
+	ts := newTenants(t)
+	h := ts.h
+
+	theirs, err := jobs.Enqueue(ts.other.Context(), h.Client, "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range ids(h.Get("/api/v1/jobs?limit=100", ts.admin).Status(200)) {
+		if id == theirs.ID {
+			t.Errorf("GET /jobs lists job %d of the other organization", id)
+		}
+	}
+	h.Get(fmt.Sprintf("/api/v1/jobs/%d", theirs.ID), ts.admin).Status(404)
+	h.Post(fmt.Sprintf("/api/v1/jobs/%d/cancel", theirs.ID), nil, ts.admin).Status(404)
+
+	// Without an organization, nothing is read.
+	if _, err := h.Client.Job.Query().All(context.Background()); !errors.Is(err, tenant.ErrMissing) {
+		t.Errorf("querying without an organization: %v, want %v", err, tenant.ErrMissing)
+	}
+}
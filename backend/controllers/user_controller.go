@@ -1,10 +1,10 @@
 package controllers
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
+	"github.com/darksford123x/app/auth"
 	"github.com/darksford123x/app/ent"
 	"github.com/darksford123x/app/ent/user"
 	"github.com/gin-gonic/gin"
@@ -16,19 +16,55 @@ type UserController struct {
 	router gin.IRouter
 }
 
+// NewUser defines the struct for creating a user
+type NewUser struct {
+	Age  int    `json:"age" validate:"required" minimum:"1"`
+	Name string `json:"name" validate:"required" minLength:"1"`
+	// Role is "staff" when empty.
+	Role user.Role `json:"role,omitempty" enums:"staff,technician,supervisor,admin"`
+	// Skill is the repair category a technician handles.
+	Skill      string `json:"skill"`
+	Department string `json:"department"`
+	Email      string `json:"email"`
+	// Locale is "th" when empty.
+	Locale user.Locale `json:"locale,omitempty" enums:"th,en"`
+	// Password lets the user sign in; users without one cannot.
+	Password string `json:"password"`
+}
+
+// UserUpdate defines the struct for updating a user; only the fields given
+// are changed, and the optional ones are cleared when they are empty
+type UserUpdate struct {
+	Age        *int         `json:"age" minimum:"1"`
+	Name       *string      `json:"name" minLength:"1"`
+	Role       *user.Role   `json:"role" enums:"staff,technician,supervisor,admin"`
+	Skill      *string      `json:"skill"`
+	Department *string      `json:"department"`
+	Email      *string      `json:"email"`
+	Locale     *user.Locale `json:"locale" enums:"th,en"`
+}
+
+// Password defines the struct for setting the password of a user
+type Password struct {
+	Password string `json:"password" validate:"required" minLength:"8"`
+}
+
 // CreateUser handles POST requests for adding user entities
 // @Summary Create user
-// @Description Create user
+// @Description Create user; only admins may
 // @ID create-user
 // @Accept   json
 // @Produce  json
-// @Param user body ent.User true "User entity"
+// @Param user body NewUser true "User entity"
 // @Success 200 {object} ent.User
-// @Failure 400 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /users [post]
 func (ctl *UserController) CreateUser(c *gin.Context) {
-	obj := ent.User{}
+	obj := NewUser{}
 	if err := c.ShouldBind(&obj); err != nil {
 		c.JSON(400, gin.H{
 			"error": "user binding failed",
@@ -36,11 +72,34 @@ func (ctl *UserController) CreateUser(c *gin.Context) {
 		return
 	}
 
-	u, err := ctl.client.User.
+	builder := ctl.client.User.
 		Create().
 		SetAge(obj.Age).
-		SetName(obj.Name).
-		Save(context.Background())
+		SetName(obj.Name)
+	if obj.Role != "" {
+		builder.SetRole(obj.Role)
+	}
+	if obj.Skill != "" {
+		builder.SetSkill(obj.Skill)
+	}
+	if obj.Department != "" {
+		builder.SetDepartment(obj.Department)
+	}
+	if obj.Email != "" {
+		builder.SetEmail(obj.Email)
+	}
+	if obj.Locale != "" {
+		builder.SetLocale(obj.Locale)
+	}
+	if obj.Password != "" {
+		hash, err := auth.HashPassword(obj.Password)
+		if err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		builder.SetPasswordHash(hash)
+	}
+	u, err := builder.Save(c.Request.Context())
 	if err != nil {
 		c.JSON(400, gin.H{
 			"error": "saving failed",
@@ -58,9 +117,11 @@ func (ctl *UserController) CreateUser(c *gin.Context) {
 // @Produce  json
 // @Param id path int true "User ID"
 // @Success 200 {object} ent.User
-// @Failure 400 {object} gin.H
-// @Failure 404 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /users/{id} [get]
 func (ctl *UserController) GetUser(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
@@ -74,7 +135,7 @@ func (ctl *UserController) GetUser(c *gin.Context) {
 	u, err := ctl.client.User.
 		Query().
 		Where(user.IDEQ(int(id))).
-		Only(context.Background())
+		Only(c.Request.Context())
 	if err != nil {
 		c.JSON(404, gin.H{
 			"error": err.Error(),
@@ -93,8 +154,10 @@ func (ctl *UserController) GetUser(c *gin.Context) {
 // @Param limit  query int false "Limit"
 // @Param offset query int false "Offset"
 // @Success 200 {array} ent.User
-// @Failure 400 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /users [get]
 func (ctl *UserController) ListUser(c *gin.Context) {
 	limitQuery := c.Query("limit")
@@ -119,7 +182,7 @@ func (ctl *UserController) ListUser(c *gin.Context) {
 		Query().
 		Limit(limit).
 		Offset(offset).
-		All(context.Background())
+		All(c.Request.Context())
 	if err != nil {
 		c.JSON(400, gin.H{"error": err.Error()})
 		return
@@ -130,14 +193,17 @@ func (ctl *UserController) ListUser(c *gin.Context) {
 
 // DeleteUser handles DELETE requests to delete a user entity
 // @Summary Delete a user entity by ID
-// @Description get user by ID
+// @Description delete user by ID; only admins may
 // @ID delete-user
 // @Produce  json
 // @Param id path int true "User ID"
-// @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H
-// @Failure 404 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /users/{id} [delete]
 func (ctl *UserController) DeleteUser(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
@@ -150,7 +216,7 @@ func (ctl *UserController) DeleteUser(c *gin.Context) {
 
 	err = ctl.client.User.
 		DeleteOneID(int(id)).
-		Exec(context.Background())
+		Exec(c.Request.Context())
 	if err != nil {
 		c.JSON(404, gin.H{
 			"error": err.Error(),
@@ -163,15 +229,18 @@ func (ctl *UserController) DeleteUser(c *gin.Context) {
 
 // UpdateUser handles PUT requests to update a user entity
 // @Summary Update a user entity by ID
-// @Description update user by ID
+// @Description update the given fields of a user by ID; empty skill, department and email are cleared. Only the user and admins may, and only admins may change roles.
 // @ID update-user
 // @Accept   json
 // @Produce  json
 // @Param id path int true "User ID"
-// @Param user body ent.User true "User entity"
+// @Param user body UserUpdate true "User fields"
 // @Success 200 {object} ent.User
-// @Failure 400 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /users/{id} [put]
 func (ctl *UserController) UpdateUser(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
@@ -182,25 +251,121 @@ func (ctl *UserController) UpdateUser(c *gin.Context) {
 		return
 	}
 
-	obj := ent.User{}
+	obj := UserUpdate{}
 	if err := c.ShouldBind(&obj); err != nil {
 		c.JSON(400, gin.H{
 			"error": "user binding failed",
 		})
 		return
 	}
-	obj.ID = int(id)
-	u, err := ctl.client.User.
-		UpdateOne(&obj).
-		Save(context.Background())
+	if err := auth.CheckUserUpdate(auth.FromContext(c.Request.Context()), int(id), obj.Role); err != nil {
+		c.JSON(403, gin.H{"error": err.Error()})
+		return
+	}
+
+	update := ctl.client.User.UpdateOneID(int(id))
+	if obj.Age != nil {
+		update.SetAge(*obj.Age)
+	}
+	if obj.Name != nil {
+		update.SetName(*obj.Name)
+	}
+	if obj.Role != nil {
+		update.SetRole(*obj.Role)
+	}
+	if obj.Skill != nil {
+		if *obj.Skill == "" {
+			update.ClearSkill()
+		} else {
+			update.SetSkill(*obj.Skill)
+		}
+	}
+	if obj.Department != nil {
+		if *obj.Department == "" {
+			update.ClearDepartment()
+		} else {
+			update.SetDepartment(*obj.Department)
+		}
+	}
+	if obj.Email != nil {
+		if *obj.Email == "" {
+			update.ClearEmail()
+		} else {
+			update.SetEmail(*obj.Email)
+		}
+	}
+	if obj.Locale != nil {
+		update.SetLocale(*obj.Locale)
+	}
+	u, err := update.Save(c.Request.Context())
 	if err != nil {
-		c.JSON(400, gin.H{"error": "update failed"})
+		code := 400
+		if ent.IsNotFound(err) {
+			code = 404
+		}
+		c.JSON(code, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(200, u)
 }
 
+// SetUserPassword handles PUT requests to set the password of a user
+// @Summary Set the password of a user
+// @Description set the password of a user; only the user and admins may
+// @ID set-user-password
+// @Accept   json
+// @Produce  json
+// @Param id path int true "User ID"
+// @Param password body Password true "Password"
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /users/{id}/password [put]
+func (ctl *UserController) SetUserPassword(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := auth.CheckUserUpdate(auth.FromContext(c.Request.Context()), int(id), nil); err != nil {
+		c.JSON(403, gin.H{"error": err.Error()})
+		return
+	}
+
+	obj := Password{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "password binding failed",
+		})
+		return
+	}
+	hash, err := auth.HashPassword(obj.Password)
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	err = ctl.client.User.
+		UpdateOneID(int(id)).
+		SetPasswordHash(hash).
+		Exec(c.Request.Context())
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, gin.H{"result": fmt.Sprintf("ok changed the password of %v", id)})
+}
+
 // NewUserController creates and registers handles for the user controller
 func NewUserController(router gin.IRouter, client *ent.Client) *UserController {
 	uc := &UserController{
@@ -213,13 +378,15 @@ func NewUserController(router gin.IRouter, client *ent.Client) *UserController {
 
 // InitUserController registers routes to the main engine
 func (ctl *UserController) register() {
-	users := ctl.router.Group("/users")
+	users := ctl.router.Group("/users", auth.Require())
 
 	users.GET("", ctl.ListUser)
 
 	// CRUD
-	users.POST("", ctl.CreateUser)
+	users.POST("", auth.Require(user.RoleAdmin), ctl.CreateUser)
 	users.GET(":id", ctl.GetUser)
 	users.PUT(":id", ctl.UpdateUser)
-	users.DELETE(":id", ctl.DeleteUser)
+	users.DELETE(":id", auth.Require(user.RoleAdmin), ctl.DeleteUser)
+
+	users.PUT(":id/password", ctl.SetUserPassword)
 }
@@ -0,0 +1,181 @@
+package controllers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/servertest"
+)
+
+func TestCreateUser(t *testing.T) {
+	h := servertest.New(t)
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(h.Context())
+
+	var u ent.User
+	h.Post("/api/v1/users", controllers.NewUser{
+		Age:        41,
+		Name:       "Somchai",
+		Role:       user.RoleTechnician,
+		Skill:      "projector",
+		Department: "Engineering",
+		Email:      "somchai@example.com",
+		Locale:     user.LocaleEn,
+		Password:   "correct horse",
+	}, admin).Status(200).Golden("created").Decode(&u)
+
+	stored := h.Client.User.GetX(h.Context(), u.ID)
+	if stored.Role != user.RoleTechnician || stored.PasswordHash == "" {
+		t.Fatalf("stored user = %+v, want a technician with a password", stored)
+	}
+	h.Post("/api/v1/auth/login", controllers.Login{Email: "somchai@example.com", Password: "correct horse"}, nil).
+		Status(200)
+
+	// Only admins may create users; the first admin is created with the
+	// user create command.
+	h.Post("/api/v1/users", controllers.NewUser{Age: 20, Name: "Malee", Role: user.RoleAdmin}, stored).Status(403)
+	h.Post("/api/v1/users", controllers.NewUser{Age: 20, Name: "Malee"}, nil).Status(401)
+}
+
+func TestCreateUserDefaults(t *testing.T) {
+	h := servertest.New(t)
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(h.Context())
+
+	h.Post("/api/v1/users", controllers.NewUser{Age: 20, Name: "Malee"}, admin).
+		Status(200).Golden("created")
+}
+
+func TestCreateUserErrors(t *testing.T) {
+	h := servertest.New(t)
+	admin := h.User().SetEmail("taken@example.com").SetRole(user.RoleAdmin).SaveX(h.Context())
+
+	tests := []struct {
+		name string
+		body interface{}
+	}{
+		{"missing name", `{"age": 30}`},
+		{"empty name", controllers.NewUser{Age: 30, Name: ""}},
+		{"zero age", controllers.NewUser{Age: 0, Name: "Malee"}},
+		{"unknown role", `{"age": 30, "name": "Malee", "role": "owner"}`},
+		{"unknown locale", `{"age": 30, "name": "Malee", "locale": "fr"}`},
+		{"short password", controllers.NewUser{Age: 30, Name: "Malee", Password: "short"}},
+		{"taken email", controllers.NewUser{Age: 30, Name: "Malee", Email: "taken@example.com"}},
+		{"invalid JSON", `{"age": 30,`},
+		{"no body", nil},
+	}
+	for _, tt := range tests {
+		h.Run(tt.name, func(h *servertest.Harness) {
+			h.Post("/api/v1/users", tt.body, admin).Status(400).Golden("error")
+		})
+	}
+	if n := h.Client.User.Query().CountX(h.Context()); n != 1 {
+		t.Fatalf("%d users, want only the one of the fixture", n)
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	h := servertest.New(t)
+	u := h.User().SetDepartment("Finance").SaveX(h.Context())
+
+	h.Get("/api/v1/users/1", nil).Status(401).Golden("unauthenticated")
+	h.Get("/api/v1/users/1", u).Status(200).Golden("found")
+	h.Get("/api/v1/users/2", u).Status(404).Golden("not-found")
+	h.Get("/api/v1/users/one", u).Status(400).Golden("invalid-id")
+
+	var got ent.User
+	h.Get("/api/v1/users/1", u).Status(200).Decode(&got)
+	if got.PasswordHash != "" {
+		t.Fatal("the password hash is in the response")
+	}
+}
+
+func TestListUser(t *testing.T) {
+	h := servertest.New(t)
+	var u *ent.User
+	for i := 0; i < 12; i++ {
+		u = h.User().SaveX(h.Context())
+	}
+
+	var users []*ent.User
+	h.Get("/api/v1/users", u).Status(200).Decode(&users)
+	if len(users) != 10 {
+		t.Fatalf("%d users, want the default limit of 10", len(users))
+	}
+	h.Get("/api/v1/users?limit=2&offset=10", u).Status(200).Golden("page")
+	h.Get("/api/v1/users?offset=20", u).Status(200).Golden("past-the-end")
+	h.Get("/api/v1/users?limit=two", u).Status(400).Golden("invalid-limit")
+}
+
+func TestUpdateUser(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	u := h.User().SetSkill("computer").SetDepartment("IT").SaveX(ctx)
+	h.User().SetEmail("taken@example.com").SaveX(ctx)
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+
+	// Only the fields given change; empty optional fields are cleared.
+	h.Put("/api/v1/users/1", map[string]interface{}{
+		"name":  "Renamed",
+		"role":  "supervisor",
+		"skill": "",
+	}, admin).Status(200).Golden("updated")
+	got := h.Client.User.GetX(ctx, u.ID)
+	if got.Name != "Renamed" || got.Role != user.RoleSupervisor || got.Skill != "" || got.Department != "IT" || got.Age != u.Age {
+		t.Fatalf("updated user = %+v", got)
+	}
+
+	// Only admins may change roles, their own included.
+	h.Put("/api/v1/users/1", map[string]interface{}{"role": "admin"}, u).Status(403)
+	if got := h.Client.User.GetX(ctx, u.ID); got.Role != user.RoleSupervisor {
+		t.Fatalf("role = %s after a forbidden change, want supervisor", got.Role)
+	}
+
+	// Other users may only be changed by admins.
+	staff := h.User().SaveX(ctx)
+	h.Put("/api/v1/users/1", map[string]interface{}{"name": "Hijacked"}, staff).Status(403)
+	h.Put(fmt.Sprintf("/api/v1/users/%d", staff.ID), map[string]interface{}{"name": "Renamed"}, staff).Status(200)
+
+	h.Put("/api/v1/users/404", map[string]interface{}{"name": "Nobody"}, admin).Status(404).Golden("not-found")
+	h.Put("/api/v1/users/one", map[string]interface{}{"name": "Nobody"}, u).Status(400).Golden("invalid-id")
+	h.Put("/api/v1/users/1", map[string]interface{}{"age": 0}, u).Status(400).Golden("zero-age")
+	h.Put("/api/v1/users/1", map[string]interface{}{"role": "owner"}, u).Status(400).Golden("unknown-role")
+	h.Put("/api/v1/users/1", map[string]interface{}{"email": "taken@example.com"}, u).Status(400).Golden("taken-email")
+	h.Put("/api/v1/users/1", `{"name": `, u).Status(400).Golden("invalid-json")
+}
+
+func TestDeleteUser(t *testing.T) {
+	h := servertest.New(t)
+	h.User().SaveX(h.Context())
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(h.Context())
+	staff := h.User().SaveX(h.Context())
+
+	h.Delete("/api/v1/users/1", staff).Status(403)
+	h.Delete("/api/v1/users/1", admin).Status(200).Golden("deleted")
+	h.Get("/api/v1/users/1", admin).Status(404)
+	h.Delete("/api/v1/users/1", admin).Status(404).Golden("not-found")
+	h.Delete("/api/v1/users/one", admin).Status(400).Golden("invalid-id")
+}
+
+func TestSetUserPassword(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	u := h.User().SaveX(ctx)
+	other := h.User().SaveX(ctx)
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+	password := controllers.Password{Password: "a new password"}
+
+	h.Put("/api/v1/users/1/password", password, nil).Status(401).Golden("unauthenticated")
+	h.Put("/api/v1/users/1/password", password, other).Status(403).Golden("other-user")
+	h.Put("/api/v1/users/1/password", controllers.Password{Password: "short"}, u).Status(400).Golden("short-password")
+	h.Put("/api/v1/users/one/password", password, admin).Status(400).Golden("invalid-id")
+	h.Put("/api/v1/users/9/password", password, admin).Status(404).Golden("not-found")
+
+	h.Put("/api/v1/users/1/password", password, u).Status(200).Golden("changed")
+	h.Post("/api/v1/auth/login", controllers.Login{Email: u.Email, Password: servertest.Password}, nil).Status(401)
+	h.Post("/api/v1/auth/login", controllers.Login{Email: u.Email, Password: password.Password}, nil).Status(200)
+
+	h.Put("/api/v1/users/2/password", password, admin).Status(200)
+	h.Post("/api/v1/auth/login", controllers.Login{Email: other.Email, Password: password.Password}, nil).Status(200)
+}
@@ -0,0 +1,469 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/ent/webhook"
+	"github.com/darksford123x/app/ent/webhookdelivery"
+	"github.com/darksford123x/app/txn"
+	"github.com/darksford123x/app/webhooks"
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookController defines the struct for the webhook controller
+type WebhookController struct {
+	client     *ent.Client
+	dispatcher *webhooks.Dispatcher
+	router     gin.IRouter
+}
+
+// Webhook defines the struct for subscribing a webhook
+type Webhook struct {
+	URL string `json:"url" validate:"required" format:"uri"`
+	// Secret signs the deliveries; a random one is generated when empty.
+	Secret string `json:"secret"`
+	// Events are the event types delivered, e.g. "repairslip.updated" or
+	// "user.*"; all events are delivered when empty.
+	Events []string `json:"events"`
+}
+
+// WebhookUpdate defines the struct for changing a webhook
+type WebhookUpdate struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	// Active re-enables a webhook disabled after failing too often.
+	Active *bool `json:"active" extensions:"x-nullable"`
+}
+
+// WebhookSecret is a webhook together with its secret, which is only shown
+// when the webhook is created
+type WebhookSecret struct {
+	*ent.Webhook
+	Secret string `json:"secret"`
+}
+
+// CreateWebhook handles POST requests for adding webhook entities
+// @Summary Create webhook
+// @Description Subscribe a URL to the changes of users and repair slips; only admins may. The secret is only returned here, and deliveries only reach public addresses.
+// @ID create-webhook
+// @Accept   json
+// @Produce  json
+// @Param webhook body Webhook true "Webhook"
+// @Success 200 {object} WebhookSecret
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /webhooks [post]
+func (ctl *WebhookController) CreateWebhook(c *gin.Context) {
+	obj := Webhook{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "webhook binding failed",
+		})
+		return
+	}
+
+	secret := obj.Secret
+	if secret == "" {
+		var err error
+		if secret, err = webhooks.NewSecret(); err != nil {
+			c.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	w, err := ctl.client.Webhook.
+		Create().
+		SetURL(obj.URL).
+		SetSecret(secret).
+		SetEvents(obj.Events).
+		Save(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, WebhookSecret{Webhook: w, Secret: secret})
+}
+
+// GetWebhook handles GET requests to retrieve a webhook entity
+// @Summary Get a webhook entity by ID
+// @Description get webhook by ID; only admins may
+// @ID get-webhook
+// @Produce  json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} ent.Webhook
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /webhooks/{id} [get]
+func (ctl *WebhookController) GetWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	w, err := ctl.client.Webhook.
+		Get(c.Request.Context(), int(id))
+	if err != nil {
+		c.JSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, w)
+}
+
+// ListWebhook handles request to get a list of webhook entities
+// @Summary List webhook entities
+// @Description list webhooks; only admins may
+// @ID list-webhook
+// @Produce json
+// @Success 200 {array} ent.Webhook
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /webhooks [get]
+func (ctl *WebhookController) ListWebhook(c *gin.Context) {
+	list, err := ctl.client.Webhook.
+		Query().
+		Order(ent.Asc(webhook.FieldID)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, list)
+}
+
+// UpdateWebhook handles PUT requests to update a webhook entity
+// @Summary Update a webhook entity by ID
+// @Description change the URL or events of a webhook, or re-enable it; only admins may
+// @ID update-webhook
+// @Accept   json
+// @Produce  json
+// @Param id path int true "Webhook ID"
+// @Param webhook body WebhookUpdate true "Webhook"
+// @Success 200 {object} ent.Webhook
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /webhooks/{id} [put]
+func (ctl *WebhookController) UpdateWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	obj := WebhookUpdate{}
+	if err := c.ShouldBind(&obj); err != nil {
+		c.JSON(400, gin.H{
+			"error": "webhook binding failed",
+		})
+		return
+	}
+
+	update := ctl.client.Webhook.UpdateOneID(int(id))
+	if obj.URL != "" {
+		update.SetURL(obj.URL)
+	}
+	if obj.Events != nil {
+		update.SetEvents(obj.Events)
+	}
+	if obj.Active != nil {
+		update.SetActive(*obj.Active)
+		if *obj.Active {
+			update.SetFailures(0).ClearDisabledAt()
+		} else {
+			update.SetDisabledAt(time.Now())
+		}
+	}
+	w, err := update.Save(c.Request.Context())
+	if err != nil {
+		webhookError(c, err)
+		return
+	}
+
+	c.JSON(200, w)
+}
+
+// DeleteWebhook handles DELETE requests to delete a webhook entity
+// @Summary Delete a webhook entity by ID
+// @Description delete webhook by ID together with its delivery log; only admins may
+// @ID delete-webhook
+// @Produce  json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} Result
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /webhooks/{id} [delete]
+func (ctl *WebhookController) DeleteWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	err = txn.WithTx(c.Request.Context(), ctl.client, func(ctx context.Context, tx *ent.Tx) error {
+		// The deliveries are deleted by the webhook, so it must be one of
+		// the organization.
+		if _, err := tx.Webhook.Get(ctx, int(id)); err != nil {
+			return err
+		}
+		_, err := tx.WebhookDelivery.
+			Delete().
+			Where(webhookdelivery.HasWebhookWith(webhook.ID(int(id)))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.Webhook.DeleteOneID(int(id)).Exec(ctx)
+	})
+	if err != nil {
+		webhookError(c, err)
+		return
+	}
+
+	c.JSON(200, gin.H{"result": fmt.Sprintf("ok deleted %v", id)})
+}
+
+// ListWebhookDelivery handles request to get the delivery log of a webhook
+// @Summary List the deliveries of a webhook
+// @Description list the deliveries of a webhook with the response to their last attempt, newest first; only admins may
+// @ID list-webhookdelivery
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param limit  query int false "Limit"
+// @Param offset query int false "Offset"
+// @Param status query string false "pending, succeeded or failed"
+// @Success 200 {array} ent.WebhookDelivery
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /webhooks/{id}/deliveries [get]
+func (ctl *WebhookController) ListWebhookDelivery(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	limitQuery := c.Query("limit")
+	limit := 10
+	if limitQuery != "" {
+		limit64, err := strconv.ParseInt(limitQuery, 10, 64)
+		if err == nil {
+			limit = int(limit64)
+		}
+	}
+
+	offsetQuery := c.Query("offset")
+	offset := 0
+	if offsetQuery != "" {
+		offset64, err := strconv.ParseInt(offsetQuery, 10, 64)
+		if err == nil {
+			offset = int(offset64)
+		}
+	}
+
+	query := ctl.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.HasWebhookWith(webhook.ID(int(id))))
+
+	if status := c.Query("status"); status != "" {
+		s := webhookdelivery.Status(status)
+		if err := webhookdelivery.StatusValidator(s); err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		query.Where(webhookdelivery.StatusEQ(s))
+	}
+
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(200, deliveries)
+}
+
+// GetWebhookDelivery handles GET requests to retrieve a delivery of a webhook
+// @Summary Get a delivery of a webhook
+// @Description get a delivery of a webhook with its payload and the response to its last attempt; only admins may
+// @ID get-webhookdelivery
+// @Produce  json
+// @Param id path int true "Webhook ID"
+// @Param delivery path int true "Delivery ID"
+// @Success 200 {object} ent.WebhookDelivery
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /webhooks/{id}/deliveries/{delivery} [get]
+func (ctl *WebhookController) GetWebhookDelivery(c *gin.Context) {
+	id, deliveryID, ok := ctl.deliveryParams(c)
+	if !ok {
+		return
+	}
+
+	d, err := ctl.client.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.ID(deliveryID),
+			webhookdelivery.HasWebhookWith(webhook.ID(id)),
+		).
+		Only(c.Request.Context())
+	if err != nil {
+		webhookError(c, err)
+		return
+	}
+
+	c.JSON(200, d)
+}
+
+// RedeliverWebhookDelivery handles POST requests to send a delivery again
+// @Summary Redeliver an event
+// @Description send the payload of a delivery to its webhook again, as a new delivery; only admins may
+// @ID redeliver-webhookdelivery
+// @Produce  json
+// @Param id path int true "Webhook ID"
+// @Param delivery path int true "Delivery ID"
+// @Success 200 {object} ent.WebhookDelivery
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /webhooks/{id}/deliveries/{delivery}/redeliver [post]
+func (ctl *WebhookController) RedeliverWebhookDelivery(c *gin.Context) {
+	id, deliveryID, ok := ctl.deliveryParams(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	exists, err := ctl.client.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.ID(deliveryID),
+			webhookdelivery.HasWebhookWith(webhook.ID(id)),
+		).
+		Exist(ctx)
+	if err != nil {
+		webhookError(c, err)
+		return
+	}
+	if !exists {
+		c.JSON(404, gin.H{"error": "ent: webhook_delivery not found"})
+		return
+	}
+
+	d, err := ctl.dispatcher.Redeliver(ctx, deliveryID)
+	if err != nil {
+		webhookError(c, err)
+		return
+	}
+
+	c.JSON(200, d)
+}
+
+// deliveryParams parses the webhook and delivery IDs of the path.
+func (ctl *WebhookController) deliveryParams(c *gin.Context) (id, deliveryID int, ok bool) {
+	id64, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return 0, 0, false
+	}
+	delivery64, err := strconv.ParseInt(c.Param("delivery"), 10, 64)
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return 0, 0, false
+	}
+	return int(id64), int(delivery64), true
+}
+
+// webhookError responds with the status code matching a webhook error.
+func webhookError(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(404, gin.H{"error": err.Error()})
+	case errors.Is(err, webhooks.ErrDisabled):
+		c.JSON(409, gin.H{"error": err.Error()})
+	default:
+		c.JSON(400, gin.H{"error": err.Error()})
+	}
+}
+
+// NewWebhookController creates and registers handles for the webhook controller
+func NewWebhookController(router gin.IRouter, client *ent.Client, dispatcher *webhooks.Dispatcher) *WebhookController {
+	wc := &WebhookController{
+		client:     client,
+		dispatcher: dispatcher,
+		router:     router,
+	}
+	wc.register()
+	return wc
+}
+
+// register registers routes to the main engine
+func (ctl *WebhookController) register() {
+	hooks := ctl.router.Group("/webhooks", auth.Require(user.RoleAdmin))
+
+	hooks.GET("", ctl.ListWebhook)
+
+	// CRUD
+	hooks.POST("", ctl.CreateWebhook)
+	hooks.GET(":id", ctl.GetWebhook)
+	hooks.PUT(":id", ctl.UpdateWebhook)
+	hooks.DELETE(":id", ctl.DeleteWebhook)
+
+	// Delivery log
+	hooks.GET(":id/deliveries", ctl.ListWebhookDelivery)
+	hooks.GET(":id/deliveries/:delivery", ctl.GetWebhookDelivery)
+	hooks.POST(":id/deliveries/:delivery/redeliver", ctl.RedeliverWebhookDelivery)
+}
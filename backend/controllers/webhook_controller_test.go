@@ -0,0 +1,75 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/ent/webhookdelivery"
+	"github.com/darksford123x/app/servertest"
+)
+
+func TestWebhooksAdminsOnly(t *testing.T) {
+	h := servertest.New(t)
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(h.Context())
+
+	h.Get("/api/v1/webhooks", supervisor).Status(403)
+	h.Post("/api/v1/webhooks", controllers.Webhook{URL: "https://example.com/hook", Events: []string{}}, supervisor).Status(403)
+}
+
+func TestWebhooksPrivateAddresses(t *testing.T) {
+	var received int32
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&received, 1)
+		w.Write([]byte("internal"))
+	}))
+	defer endpoint.Close()
+
+	// deliver delivers the creation of a user to the endpoint, which
+	// listens on the loopback, and returns the delivery.
+	deliver := func(t *testing.T, allowPrivate bool) *ent.WebhookDelivery {
+		cfg := servertest.Config(t)
+		cfg.Workers = 1
+		cfg.JobPollInterval = 10 * time.Millisecond
+		cfg.WebhookAllowPrivate = allowPrivate
+		h := servertest.NewWithConfig(t, cfg)
+		ctx := h.Context()
+		admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+		h.Post("/api/v1/webhooks", controllers.Webhook{URL: endpoint.URL, Events: []string{"user.created"}}, admin).Status(200)
+
+		h.Server.Start()
+		defer h.Server.Stop(context.Background())
+		h.User().SaveX(ctx)
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			del, err := h.Client.WebhookDelivery.Query().Only(ctx)
+			if err == nil && del.Status != webhookdelivery.StatusPending {
+				return del
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("delivery not made: %v %v", del, err)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	del := deliver(t, false)
+	if del.Status != webhookdelivery.StatusFailed || !strings.Contains(del.Error, "not public") || del.ResponseBody != "" {
+		t.Errorf("delivery to the loopback = %s %q %q, want failed as not public", del.Status, del.Error, del.ResponseBody)
+	}
+	if n := atomic.LoadInt32(&received); n != 0 {
+		t.Fatalf("the endpoint on the loopback got %d deliveries", n)
+	}
+
+	del = deliver(t, true)
+	if del.Status != webhookdelivery.StatusSucceeded || atomic.LoadInt32(&received) != 1 {
+		t.Errorf("delivery with private addresses allowed = %s %q", del.Status, del.Error)
+	}
+}
@@ -0,0 +1,255 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Activity is the model entity for the Activity schema.
+type Activity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind activity.Kind `json:"kind,omitempty"`
+	// From holds the value of the "from" field.
+	From string `json:"from,omitempty"`
+	// To holds the value of the "to" field.
+	To string `json:"to,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ActivityQuery when eager-loading is set.
+	Edges                  ActivityEdges `json:"edges"`
+	organization_id        *int
+	activity_actor         *int
+	activity_technician    *int
+	repair_slip_activities *int
+}
+
+// ActivityEdges holds the relations/edges for other nodes in the graph.
+type ActivityEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// RepairSlip holds the value of the repair_slip edge.
+	RepairSlip *RepairSlip
+	// Actor holds the value of the actor edge.
+	Actor *User
+	// Technician holds the value of the technician edge.
+	Technician *User
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActivityEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// RepairSlipOrErr returns the RepairSlip value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActivityEdges) RepairSlipOrErr() (*RepairSlip, error) {
+	if e.loadedTypes[1] {
+		if e.RepairSlip == nil {
+			// The edge repair_slip was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: repairslip.Label}
+		}
+		return e.RepairSlip, nil
+	}
+	return nil, &NotLoadedError{edge: "repair_slip"}
+}
+
+// ActorOrErr returns the Actor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActivityEdges) ActorOrErr() (*User, error) {
+	if e.loadedTypes[2] {
+		if e.Actor == nil {
+			// The edge actor was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Actor, nil
+	}
+	return nil, &NotLoadedError{edge: "actor"}
+}
+
+// TechnicianOrErr returns the Technician value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActivityEdges) TechnicianOrErr() (*User, error) {
+	if e.loadedTypes[3] {
+		if e.Technician == nil {
+			// The edge technician was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Technician, nil
+	}
+	return nil, &NotLoadedError{edge: "technician"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Activity) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullString{}, // kind
+		&sql.NullString{}, // from
+		&sql.NullString{}, // to
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Activity) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+		&sql.NullInt64{}, // activity_actor
+		&sql.NullInt64{}, // activity_technician
+		&sql.NullInt64{}, // repair_slip_activities
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Activity fields.
+func (a *Activity) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(activity.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	a.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		a.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field kind", values[1])
+	} else if value.Valid {
+		a.Kind = activity.Kind(value.String)
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field from", values[2])
+	} else if value.Valid {
+		a.From = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field to", values[3])
+	} else if value.Valid {
+		a.To = value.String
+	}
+	values = values[4:]
+	if len(values) == len(activity.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			a.organization_id = new(int)
+			*a.organization_id = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field activity_actor", value)
+		} else if value.Valid {
+			a.activity_actor = new(int)
+			*a.activity_actor = int(value.Int64)
+		}
+		if value, ok := values[2].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field activity_technician", value)
+		} else if value.Valid {
+			a.activity_technician = new(int)
+			*a.activity_technician = int(value.Int64)
+		}
+		if value, ok := values[3].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field repair_slip_activities", value)
+		} else if value.Valid {
+			a.repair_slip_activities = new(int)
+			*a.repair_slip_activities = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Activity.
+func (a *Activity) QueryOrganization() *OrganizationQuery {
+	return (&ActivityClient{config: a.config}).QueryOrganization(a)
+}
+
+// QueryRepairSlip queries the repair_slip edge of the Activity.
+func (a *Activity) QueryRepairSlip() *RepairSlipQuery {
+	return (&ActivityClient{config: a.config}).QueryRepairSlip(a)
+}
+
+// QueryActor queries the actor edge of the Activity.
+func (a *Activity) QueryActor() *UserQuery {
+	return (&ActivityClient{config: a.config}).QueryActor(a)
+}
+
+// QueryTechnician queries the technician edge of the Activity.
+func (a *Activity) QueryTechnician() *UserQuery {
+	return (&ActivityClient{config: a.config}).QueryTechnician(a)
+}
+
+// Update returns a builder for updating this Activity.
+// Note that, you need to call Activity.Unwrap() before calling this method, if this Activity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Activity) Update() *ActivityUpdateOne {
+	return (&ActivityClient{config: a.config}).UpdateOne(a)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (a *Activity) Unwrap() *Activity {
+	tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Activity is not a transactional entity")
+	}
+	a.config.driver = tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Activity) String() string {
+	var builder strings.Builder
+	builder.WriteString("Activity(")
+	builder.WriteString(fmt.Sprintf("id=%v", a.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(a.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", kind=")
+	builder.WriteString(fmt.Sprintf("%v", a.Kind))
+	builder.WriteString(", from=")
+	builder.WriteString(a.From)
+	builder.WriteString(", to=")
+	builder.WriteString(a.To)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Activities is a parsable slice of Activity.
+type Activities []*Activity
+
+func (a Activities) config(cfg config) {
+	for _i := range a {
+		a[_i].config = cfg
+	}
+}
@@ -0,0 +1,120 @@
+// Code generated by entc, DO NOT EDIT.
+
+package activity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the activity type in the database.
+	Label = "activity"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldFrom holds the string denoting the from field in the database.
+	FieldFrom = "from"
+	// FieldTo holds the string denoting the to field in the database.
+	FieldTo = "to"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeRepairSlip holds the string denoting the repair_slip edge name in mutations.
+	EdgeRepairSlip = "repair_slip"
+	// EdgeActor holds the string denoting the actor edge name in mutations.
+	EdgeActor = "actor"
+	// EdgeTechnician holds the string denoting the technician edge name in mutations.
+	EdgeTechnician = "technician"
+
+	// Table holds the table name of the activity in the database.
+	Table = "activities"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "activities"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// RepairSlipTable is the table the holds the repair_slip relation/edge.
+	RepairSlipTable = "activities"
+	// RepairSlipInverseTable is the table name for the RepairSlip entity.
+	// It exists in this package in order to avoid circular dependency with the "repairslip" package.
+	RepairSlipInverseTable = "repair_slips"
+	// RepairSlipColumn is the table column denoting the repair_slip relation/edge.
+	RepairSlipColumn = "repair_slip_activities"
+	// ActorTable is the table the holds the actor relation/edge.
+	ActorTable = "activities"
+	// ActorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ActorInverseTable = "users"
+	// ActorColumn is the table column denoting the actor relation/edge.
+	ActorColumn = "activity_actor"
+	// TechnicianTable is the table the holds the technician relation/edge.
+	TechnicianTable = "activities"
+	// TechnicianInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	TechnicianInverseTable = "users"
+	// TechnicianColumn is the table column denoting the technician relation/edge.
+	TechnicianColumn = "activity_technician"
+)
+
+// Columns holds all SQL columns for activity fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldKind,
+	FieldFrom,
+	FieldTo,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Activity type.
+var ForeignKeys = []string{
+	"organization_id",
+	"activity_actor",
+	"activity_technician",
+	"repair_slip_activities",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+)
+
+// Kind defines the type for the kind enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindStatusChanged   Kind = "status_changed"
+	KindPriorityChanged Kind = "priority_changed"
+	KindAssigned        Kind = "assigned"
+	KindUnassigned      Kind = "unassigned"
+	KindEscalated       Kind = "escalated"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "k" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindStatusChanged, KindPriorityChanged, KindAssigned, KindUnassigned, KindEscalated:
+		return nil
+	default:
+		return fmt.Errorf("activity: invalid enum value for kind field: %q", k)
+	}
+}
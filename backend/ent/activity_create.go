@@ -0,0 +1,333 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// ActivityCreate is the builder for creating a Activity entity.
+type ActivityCreate struct {
+	config
+	mutation *ActivityMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (ac *ActivityCreate) SetCreateTime(t time.Time) *ActivityCreate {
+	ac.mutation.SetCreateTime(t)
+	return ac
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableCreateTime(t *time.Time) *ActivityCreate {
+	if t != nil {
+		ac.SetCreateTime(*t)
+	}
+	return ac
+}
+
+// SetKind sets the kind field.
+func (ac *ActivityCreate) SetKind(a activity.Kind) *ActivityCreate {
+	ac.mutation.SetKind(a)
+	return ac
+}
+
+// SetFrom sets the from field.
+func (ac *ActivityCreate) SetFrom(s string) *ActivityCreate {
+	ac.mutation.SetFrom(s)
+	return ac
+}
+
+// SetNillableFrom sets the from field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableFrom(s *string) *ActivityCreate {
+	if s != nil {
+		ac.SetFrom(*s)
+	}
+	return ac
+}
+
+// SetTo sets the to field.
+func (ac *ActivityCreate) SetTo(s string) *ActivityCreate {
+	ac.mutation.SetTo(s)
+	return ac
+}
+
+// SetNillableTo sets the to field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableTo(s *string) *ActivityCreate {
+	if s != nil {
+		ac.SetTo(*s)
+	}
+	return ac
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (ac *ActivityCreate) SetOrganizationID(id int) *ActivityCreate {
+	ac.mutation.SetOrganizationID(id)
+	return ac
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (ac *ActivityCreate) SetNillableOrganizationID(id *int) *ActivityCreate {
+	if id != nil {
+		ac = ac.SetOrganizationID(*id)
+	}
+	return ac
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (ac *ActivityCreate) SetOrganization(o *Organization) *ActivityCreate {
+	return ac.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (ac *ActivityCreate) SetRepairSlipID(id int) *ActivityCreate {
+	ac.mutation.SetRepairSlipID(id)
+	return ac
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (ac *ActivityCreate) SetRepairSlip(r *RepairSlip) *ActivityCreate {
+	return ac.SetRepairSlipID(r.ID)
+}
+
+// SetActorID sets the actor edge to User by id.
+func (ac *ActivityCreate) SetActorID(id int) *ActivityCreate {
+	ac.mutation.SetActorID(id)
+	return ac
+}
+
+// SetNillableActorID sets the actor edge to User by id if the given value is not nil.
+func (ac *ActivityCreate) SetNillableActorID(id *int) *ActivityCreate {
+	if id != nil {
+		ac = ac.SetActorID(*id)
+	}
+	return ac
+}
+
+// SetActor sets the actor edge to User.
+func (ac *ActivityCreate) SetActor(u *User) *ActivityCreate {
+	return ac.SetActorID(u.ID)
+}
+
+// SetTechnicianID sets the technician edge to User by id.
+func (ac *ActivityCreate) SetTechnicianID(id int) *ActivityCreate {
+	ac.mutation.SetTechnicianID(id)
+	return ac
+}
+
+// SetNillableTechnicianID sets the technician edge to User by id if the given value is not nil.
+func (ac *ActivityCreate) SetNillableTechnicianID(id *int) *ActivityCreate {
+	if id != nil {
+		ac = ac.SetTechnicianID(*id)
+	}
+	return ac
+}
+
+// SetTechnician sets the technician edge to User.
+func (ac *ActivityCreate) SetTechnician(u *User) *ActivityCreate {
+	return ac.SetTechnicianID(u.ID)
+}
+
+// Mutation returns the ActivityMutation object of the builder.
+func (ac *ActivityCreate) Mutation() *ActivityMutation {
+	return ac.mutation
+}
+
+// Save creates the Activity in the database.
+func (ac *ActivityCreate) Save(ctx context.Context) (*Activity, error) {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		v := activity.DefaultCreateTime()
+		ac.mutation.SetCreateTime(v)
+	}
+	if _, ok := ac.mutation.Kind(); !ok {
+		return nil, &ValidationError{Name: "kind", err: errors.New("ent: missing required field \"kind\"")}
+	}
+	if v, ok := ac.mutation.Kind(); ok {
+		if err := activity.KindValidator(v); err != nil {
+			return nil, &ValidationError{Name: "kind", err: fmt.Errorf("ent: validator failed for field \"kind\": %w", err)}
+		}
+	}
+	if _, ok := ac.mutation.RepairSlipID(); !ok {
+		return nil, &ValidationError{Name: "repair_slip", err: errors.New("ent: missing required edge \"repair_slip\"")}
+	}
+	var (
+		err  error
+		node *Activity
+	)
+	if len(ac.hooks) == 0 {
+		node, err = ac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ActivityMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ac.mutation = mutation
+			node, err = ac.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ac.hooks) - 1; i >= 0; i-- {
+			mut = ac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *ActivityCreate) SaveX(ctx context.Context) *Activity {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ac *ActivityCreate) sqlSave(ctx context.Context) (*Activity, error) {
+	a, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	a.ID = int(id)
+	return a, nil
+}
+
+func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
+	var (
+		a     = &Activity{config: ac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: activity.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: activity.FieldID,
+			},
+		}
+	)
+	if value, ok := ac.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: activity.FieldCreateTime,
+		})
+		a.CreateTime = value
+	}
+	if value, ok := ac.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: activity.FieldKind,
+		})
+		a.Kind = value
+	}
+	if value, ok := ac.mutation.From(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: activity.FieldFrom,
+		})
+		a.From = value
+	}
+	if value, ok := ac.mutation.To(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: activity.FieldTo,
+		})
+		a.To = value
+	}
+	if nodes := ac.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.OrganizationTable,
+			Columns: []string{activity.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   activity.RepairSlipTable,
+			Columns: []string{activity.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.ActorTable,
+			Columns: []string{activity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.TechnicianIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.TechnicianTable,
+			Columns: []string{activity.TechnicianColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return a, _spec
+}
@@ -0,0 +1,708 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// ActivityUpdate is the builder for updating Activity entities.
+type ActivityUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *ActivityMutation
+	predicates []predicate.Activity
+}
+
+// Where adds a new predicate for the builder.
+func (au *ActivityUpdate) Where(ps ...predicate.Activity) *ActivityUpdate {
+	au.predicates = append(au.predicates, ps...)
+	return au
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (au *ActivityUpdate) SetOrganizationID(id int) *ActivityUpdate {
+	au.mutation.SetOrganizationID(id)
+	return au
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (au *ActivityUpdate) SetNillableOrganizationID(id *int) *ActivityUpdate {
+	if id != nil {
+		au = au.SetOrganizationID(*id)
+	}
+	return au
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (au *ActivityUpdate) SetOrganization(o *Organization) *ActivityUpdate {
+	return au.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (au *ActivityUpdate) SetRepairSlipID(id int) *ActivityUpdate {
+	au.mutation.SetRepairSlipID(id)
+	return au
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (au *ActivityUpdate) SetRepairSlip(r *RepairSlip) *ActivityUpdate {
+	return au.SetRepairSlipID(r.ID)
+}
+
+// SetActorID sets the actor edge to User by id.
+func (au *ActivityUpdate) SetActorID(id int) *ActivityUpdate {
+	au.mutation.SetActorID(id)
+	return au
+}
+
+// SetNillableActorID sets the actor edge to User by id if the given value is not nil.
+func (au *ActivityUpdate) SetNillableActorID(id *int) *ActivityUpdate {
+	if id != nil {
+		au = au.SetActorID(*id)
+	}
+	return au
+}
+
+// SetActor sets the actor edge to User.
+func (au *ActivityUpdate) SetActor(u *User) *ActivityUpdate {
+	return au.SetActorID(u.ID)
+}
+
+// SetTechnicianID sets the technician edge to User by id.
+func (au *ActivityUpdate) SetTechnicianID(id int) *ActivityUpdate {
+	au.mutation.SetTechnicianID(id)
+	return au
+}
+
+// SetNillableTechnicianID sets the technician edge to User by id if the given value is not nil.
+func (au *ActivityUpdate) SetNillableTechnicianID(id *int) *ActivityUpdate {
+	if id != nil {
+		au = au.SetTechnicianID(*id)
+	}
+	return au
+}
+
+// SetTechnician sets the technician edge to User.
+func (au *ActivityUpdate) SetTechnician(u *User) *ActivityUpdate {
+	return au.SetTechnicianID(u.ID)
+}
+
+// Mutation returns the ActivityMutation object of the builder.
+func (au *ActivityUpdate) Mutation() *ActivityMutation {
+	return au.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (au *ActivityUpdate) ClearOrganization() *ActivityUpdate {
+	au.mutation.ClearOrganization()
+	return au
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (au *ActivityUpdate) ClearRepairSlip() *ActivityUpdate {
+	au.mutation.ClearRepairSlip()
+	return au
+}
+
+// ClearActor clears the actor edge to User.
+func (au *ActivityUpdate) ClearActor() *ActivityUpdate {
+	au.mutation.ClearActor()
+	return au
+}
+
+// ClearTechnician clears the technician edge to User.
+func (au *ActivityUpdate) ClearTechnician() *ActivityUpdate {
+	au.mutation.ClearTechnician()
+	return au
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (au *ActivityUpdate) Save(ctx context.Context) (int, error) {
+
+	if _, ok := au.mutation.RepairSlipID(); au.mutation.RepairSlipCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"repair_slip\"")
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(au.hooks) == 0 {
+		affected, err = au.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ActivityMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			au.mutation = mutation
+			affected, err = au.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(au.hooks) - 1; i >= 0; i-- {
+			mut = au.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, au.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *ActivityUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *ActivityUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *ActivityUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   activity.Table,
+			Columns: activity.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: activity.FieldID,
+			},
+		},
+	}
+	if ps := au.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if au.mutation.FromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: activity.FieldFrom,
+		})
+	}
+	if au.mutation.ToCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: activity.FieldTo,
+		})
+	}
+	if au.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.OrganizationTable,
+			Columns: []string{activity.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.OrganizationTable,
+			Columns: []string{activity.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   activity.RepairSlipTable,
+			Columns: []string{activity.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   activity.RepairSlipTable,
+			Columns: []string{activity.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.ActorTable,
+			Columns: []string{activity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.ActorTable,
+			Columns: []string{activity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.TechnicianCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.TechnicianTable,
+			Columns: []string{activity.TechnicianColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.TechnicianIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.TechnicianTable,
+			Columns: []string{activity.TechnicianColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{activity.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ActivityUpdateOne is the builder for updating a single Activity entity.
+type ActivityUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ActivityMutation
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (auo *ActivityUpdateOne) SetOrganizationID(id int) *ActivityUpdateOne {
+	auo.mutation.SetOrganizationID(id)
+	return auo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableOrganizationID(id *int) *ActivityUpdateOne {
+	if id != nil {
+		auo = auo.SetOrganizationID(*id)
+	}
+	return auo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (auo *ActivityUpdateOne) SetOrganization(o *Organization) *ActivityUpdateOne {
+	return auo.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (auo *ActivityUpdateOne) SetRepairSlipID(id int) *ActivityUpdateOne {
+	auo.mutation.SetRepairSlipID(id)
+	return auo
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (auo *ActivityUpdateOne) SetRepairSlip(r *RepairSlip) *ActivityUpdateOne {
+	return auo.SetRepairSlipID(r.ID)
+}
+
+// SetActorID sets the actor edge to User by id.
+func (auo *ActivityUpdateOne) SetActorID(id int) *ActivityUpdateOne {
+	auo.mutation.SetActorID(id)
+	return auo
+}
+
+// SetNillableActorID sets the actor edge to User by id if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableActorID(id *int) *ActivityUpdateOne {
+	if id != nil {
+		auo = auo.SetActorID(*id)
+	}
+	return auo
+}
+
+// SetActor sets the actor edge to User.
+func (auo *ActivityUpdateOne) SetActor(u *User) *ActivityUpdateOne {
+	return auo.SetActorID(u.ID)
+}
+
+// SetTechnicianID sets the technician edge to User by id.
+func (auo *ActivityUpdateOne) SetTechnicianID(id int) *ActivityUpdateOne {
+	auo.mutation.SetTechnicianID(id)
+	return auo
+}
+
+// SetNillableTechnicianID sets the technician edge to User by id if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableTechnicianID(id *int) *ActivityUpdateOne {
+	if id != nil {
+		auo = auo.SetTechnicianID(*id)
+	}
+	return auo
+}
+
+// SetTechnician sets the technician edge to User.
+func (auo *ActivityUpdateOne) SetTechnician(u *User) *ActivityUpdateOne {
+	return auo.SetTechnicianID(u.ID)
+}
+
+// Mutation returns the ActivityMutation object of the builder.
+func (auo *ActivityUpdateOne) Mutation() *ActivityMutation {
+	return auo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (auo *ActivityUpdateOne) ClearOrganization() *ActivityUpdateOne {
+	auo.mutation.ClearOrganization()
+	return auo
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (auo *ActivityUpdateOne) ClearRepairSlip() *ActivityUpdateOne {
+	auo.mutation.ClearRepairSlip()
+	return auo
+}
+
+// ClearActor clears the actor edge to User.
+func (auo *ActivityUpdateOne) ClearActor() *ActivityUpdateOne {
+	auo.mutation.ClearActor()
+	return auo
+}
+
+// ClearTechnician clears the technician edge to User.
+func (auo *ActivityUpdateOne) ClearTechnician() *ActivityUpdateOne {
+	auo.mutation.ClearTechnician()
+	return auo
+}
+
+// Save executes the query and returns the updated entity.
+func (auo *ActivityUpdateOne) Save(ctx context.Context) (*Activity, error) {
+
+	if _, ok := auo.mutation.RepairSlipID(); auo.mutation.RepairSlipCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"repair_slip\"")
+	}
+
+	var (
+		err  error
+		node *Activity
+	)
+	if len(auo.hooks) == 0 {
+		node, err = auo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ActivityMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			auo.mutation = mutation
+			node, err = auo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(auo.hooks) - 1; i >= 0; i-- {
+			mut = auo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, auo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *ActivityUpdateOne) SaveX(ctx context.Context) *Activity {
+	a, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Exec executes the query on the entity.
+func (auo *ActivityUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *ActivityUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (auo *ActivityUpdateOne) sqlSave(ctx context.Context) (a *Activity, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   activity.Table,
+			Columns: activity.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: activity.FieldID,
+			},
+		},
+	}
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Activity.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if auo.mutation.FromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: activity.FieldFrom,
+		})
+	}
+	if auo.mutation.ToCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: activity.FieldTo,
+		})
+	}
+	if auo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.OrganizationTable,
+			Columns: []string{activity.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.OrganizationTable,
+			Columns: []string{activity.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   activity.RepairSlipTable,
+			Columns: []string{activity.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   activity.RepairSlipTable,
+			Columns: []string{activity.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.ActorTable,
+			Columns: []string{activity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.ActorTable,
+			Columns: []string{activity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.TechnicianCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.TechnicianTable,
+			Columns: []string{activity.TechnicianColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.TechnicianIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.TechnicianTable,
+			Columns: []string{activity.TechnicianColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	a = &Activity{config: auo.config}
+	_spec.Assign = a.assignValues
+	_spec.ScanValues = a.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{activity.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return a, nil
+}
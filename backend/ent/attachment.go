@@ -0,0 +1,245 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Attachment is the model entity for the Attachment schema.
+type Attachment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size"`
+	// Key holds the value of the "key" field.
+	Key string `json:"-"`
+	// Thumbnail holds the value of the "thumbnail" field.
+	Thumbnail bool `json:"thumbnail"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttachmentQuery when eager-loading is set.
+	Edges                   AttachmentEdges `json:"edges"`
+	organization_id         *int
+	repair_slip_attachments *int
+	user_attachments        *int
+}
+
+// AttachmentEdges holds the relations/edges for other nodes in the graph.
+type AttachmentEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// RepairSlip holds the value of the repair_slip edge.
+	RepairSlip *RepairSlip
+	// Uploader holds the value of the uploader edge.
+	Uploader *User
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// RepairSlipOrErr returns the RepairSlip value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentEdges) RepairSlipOrErr() (*RepairSlip, error) {
+	if e.loadedTypes[1] {
+		if e.RepairSlip == nil {
+			// The edge repair_slip was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: repairslip.Label}
+		}
+		return e.RepairSlip, nil
+	}
+	return nil, &NotLoadedError{edge: "repair_slip"}
+}
+
+// UploaderOrErr returns the Uploader value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentEdges) UploaderOrErr() (*User, error) {
+	if e.loadedTypes[2] {
+		if e.Uploader == nil {
+			// The edge uploader was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Uploader, nil
+	}
+	return nil, &NotLoadedError{edge: "uploader"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Attachment) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullString{}, // filename
+		&sql.NullString{}, // content_type
+		&sql.NullInt64{},  // size
+		&sql.NullString{}, // key
+		&sql.NullBool{},   // thumbnail
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Attachment) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+		&sql.NullInt64{}, // repair_slip_attachments
+		&sql.NullInt64{}, // user_attachments
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Attachment fields.
+func (a *Attachment) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(attachment.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	a.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		a.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field filename", values[1])
+	} else if value.Valid {
+		a.Filename = value.String
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field content_type", values[2])
+	} else if value.Valid {
+		a.ContentType = value.String
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field size", values[3])
+	} else if value.Valid {
+		a.Size = value.Int64
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field key", values[4])
+	} else if value.Valid {
+		a.Key = value.String
+	}
+	if value, ok := values[5].(*sql.NullBool); !ok {
+		return fmt.Errorf("unexpected type %T for field thumbnail", values[5])
+	} else if value.Valid {
+		a.Thumbnail = value.Bool
+	}
+	values = values[6:]
+	if len(values) == len(attachment.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			a.organization_id = new(int)
+			*a.organization_id = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field repair_slip_attachments", value)
+		} else if value.Valid {
+			a.repair_slip_attachments = new(int)
+			*a.repair_slip_attachments = int(value.Int64)
+		}
+		if value, ok := values[2].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_attachments", value)
+		} else if value.Valid {
+			a.user_attachments = new(int)
+			*a.user_attachments = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Attachment.
+func (a *Attachment) QueryOrganization() *OrganizationQuery {
+	return (&AttachmentClient{config: a.config}).QueryOrganization(a)
+}
+
+// QueryRepairSlip queries the repair_slip edge of the Attachment.
+func (a *Attachment) QueryRepairSlip() *RepairSlipQuery {
+	return (&AttachmentClient{config: a.config}).QueryRepairSlip(a)
+}
+
+// QueryUploader queries the uploader edge of the Attachment.
+func (a *Attachment) QueryUploader() *UserQuery {
+	return (&AttachmentClient{config: a.config}).QueryUploader(a)
+}
+
+// Update returns a builder for updating this Attachment.
+// Note that, you need to call Attachment.Unwrap() before calling this method, if this Attachment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Attachment) Update() *AttachmentUpdateOne {
+	return (&AttachmentClient{config: a.config}).UpdateOne(a)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (a *Attachment) Unwrap() *Attachment {
+	tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Attachment is not a transactional entity")
+	}
+	a.config.driver = tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Attachment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Attachment(")
+	builder.WriteString(fmt.Sprintf("id=%v", a.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(a.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", filename=")
+	builder.WriteString(a.Filename)
+	builder.WriteString(", content_type=")
+	builder.WriteString(a.ContentType)
+	builder.WriteString(", size=")
+	builder.WriteString(fmt.Sprintf("%v", a.Size))
+	builder.WriteString(", key=<sensitive>")
+	builder.WriteString(", thumbnail=")
+	builder.WriteString(fmt.Sprintf("%v", a.Thumbnail))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Attachments is a parsable slice of Attachment.
+type Attachments []*Attachment
+
+func (a Attachments) config(cfg config) {
+	for _i := range a {
+		a[_i].config = cfg
+	}
+}
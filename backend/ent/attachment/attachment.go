@@ -0,0 +1,97 @@
+// Code generated by entc, DO NOT EDIT.
+
+package attachment
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the attachment type in the database.
+	Label = "attachment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldFilename holds the string denoting the filename field in the database.
+	FieldFilename = "filename"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldThumbnail holds the string denoting the thumbnail field in the database.
+	FieldThumbnail = "thumbnail"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeRepairSlip holds the string denoting the repair_slip edge name in mutations.
+	EdgeRepairSlip = "repair_slip"
+	// EdgeUploader holds the string denoting the uploader edge name in mutations.
+	EdgeUploader = "uploader"
+
+	// Table holds the table name of the attachment in the database.
+	Table = "attachments"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "attachments"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// RepairSlipTable is the table the holds the repair_slip relation/edge.
+	RepairSlipTable = "attachments"
+	// RepairSlipInverseTable is the table name for the RepairSlip entity.
+	// It exists in this package in order to avoid circular dependency with the "repairslip" package.
+	RepairSlipInverseTable = "repair_slips"
+	// RepairSlipColumn is the table column denoting the repair_slip relation/edge.
+	RepairSlipColumn = "repair_slip_attachments"
+	// UploaderTable is the table the holds the uploader relation/edge.
+	UploaderTable = "attachments"
+	// UploaderInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UploaderInverseTable = "users"
+	// UploaderColumn is the table column denoting the uploader relation/edge.
+	UploaderColumn = "user_attachments"
+)
+
+// Columns holds all SQL columns for attachment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldFilename,
+	FieldContentType,
+	FieldSize,
+	FieldKey,
+	FieldThumbnail,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Attachment type.
+var ForeignKeys = []string{
+	"organization_id",
+	"repair_slip_attachments",
+	"user_attachments",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+	// FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	FilenameValidator func(string) error
+	// ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	ContentTypeValidator func(string) error
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// DefaultThumbnail holds the default value on creation for the thumbnail field.
+	DefaultThumbnail bool
+)
@@ -0,0 +1,338 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// AttachmentCreate is the builder for creating a Attachment entity.
+type AttachmentCreate struct {
+	config
+	mutation *AttachmentMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (ac *AttachmentCreate) SetCreateTime(t time.Time) *AttachmentCreate {
+	ac.mutation.SetCreateTime(t)
+	return ac
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableCreateTime(t *time.Time) *AttachmentCreate {
+	if t != nil {
+		ac.SetCreateTime(*t)
+	}
+	return ac
+}
+
+// SetFilename sets the filename field.
+func (ac *AttachmentCreate) SetFilename(s string) *AttachmentCreate {
+	ac.mutation.SetFilename(s)
+	return ac
+}
+
+// SetContentType sets the content_type field.
+func (ac *AttachmentCreate) SetContentType(s string) *AttachmentCreate {
+	ac.mutation.SetContentType(s)
+	return ac
+}
+
+// SetSize sets the size field.
+func (ac *AttachmentCreate) SetSize(i int64) *AttachmentCreate {
+	ac.mutation.SetSize(i)
+	return ac
+}
+
+// SetKey sets the key field.
+func (ac *AttachmentCreate) SetKey(s string) *AttachmentCreate {
+	ac.mutation.SetKey(s)
+	return ac
+}
+
+// SetThumbnail sets the thumbnail field.
+func (ac *AttachmentCreate) SetThumbnail(b bool) *AttachmentCreate {
+	ac.mutation.SetThumbnail(b)
+	return ac
+}
+
+// SetNillableThumbnail sets the thumbnail field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableThumbnail(b *bool) *AttachmentCreate {
+	if b != nil {
+		ac.SetThumbnail(*b)
+	}
+	return ac
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (ac *AttachmentCreate) SetOrganizationID(id int) *AttachmentCreate {
+	ac.mutation.SetOrganizationID(id)
+	return ac
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableOrganizationID(id *int) *AttachmentCreate {
+	if id != nil {
+		ac = ac.SetOrganizationID(*id)
+	}
+	return ac
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (ac *AttachmentCreate) SetOrganization(o *Organization) *AttachmentCreate {
+	return ac.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (ac *AttachmentCreate) SetRepairSlipID(id int) *AttachmentCreate {
+	ac.mutation.SetRepairSlipID(id)
+	return ac
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (ac *AttachmentCreate) SetRepairSlip(r *RepairSlip) *AttachmentCreate {
+	return ac.SetRepairSlipID(r.ID)
+}
+
+// SetUploaderID sets the uploader edge to User by id.
+func (ac *AttachmentCreate) SetUploaderID(id int) *AttachmentCreate {
+	ac.mutation.SetUploaderID(id)
+	return ac
+}
+
+// SetNillableUploaderID sets the uploader edge to User by id if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableUploaderID(id *int) *AttachmentCreate {
+	if id != nil {
+		ac = ac.SetUploaderID(*id)
+	}
+	return ac
+}
+
+// SetUploader sets the uploader edge to User.
+func (ac *AttachmentCreate) SetUploader(u *User) *AttachmentCreate {
+	return ac.SetUploaderID(u.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (ac *AttachmentCreate) Mutation() *AttachmentMutation {
+	return ac.mutation
+}
+
+// Save creates the Attachment in the database.
+func (ac *AttachmentCreate) Save(ctx context.Context) (*Attachment, error) {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		v := attachment.DefaultCreateTime()
+		ac.mutation.SetCreateTime(v)
+	}
+	if _, ok := ac.mutation.Filename(); !ok {
+		return nil, &ValidationError{Name: "filename", err: errors.New("ent: missing required field \"filename\"")}
+	}
+	if v, ok := ac.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "filename", err: fmt.Errorf("ent: validator failed for field \"filename\": %w", err)}
+		}
+	}
+	if _, ok := ac.mutation.ContentType(); !ok {
+		return nil, &ValidationError{Name: "content_type", err: errors.New("ent: missing required field \"content_type\"")}
+	}
+	if v, ok := ac.mutation.ContentType(); ok {
+		if err := attachment.ContentTypeValidator(v); err != nil {
+			return nil, &ValidationError{Name: "content_type", err: fmt.Errorf("ent: validator failed for field \"content_type\": %w", err)}
+		}
+	}
+	if _, ok := ac.mutation.Size(); !ok {
+		return nil, &ValidationError{Name: "size", err: errors.New("ent: missing required field \"size\"")}
+	}
+	if _, ok := ac.mutation.Key(); !ok {
+		return nil, &ValidationError{Name: "key", err: errors.New("ent: missing required field \"key\"")}
+	}
+	if v, ok := ac.mutation.Key(); ok {
+		if err := attachment.KeyValidator(v); err != nil {
+			return nil, &ValidationError{Name: "key", err: fmt.Errorf("ent: validator failed for field \"key\": %w", err)}
+		}
+	}
+	if _, ok := ac.mutation.Thumbnail(); !ok {
+		v := attachment.DefaultThumbnail
+		ac.mutation.SetThumbnail(v)
+	}
+	if _, ok := ac.mutation.RepairSlipID(); !ok {
+		return nil, &ValidationError{Name: "repair_slip", err: errors.New("ent: missing required edge \"repair_slip\"")}
+	}
+	var (
+		err  error
+		node *Attachment
+	)
+	if len(ac.hooks) == 0 {
+		node, err = ac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AttachmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ac.mutation = mutation
+			node, err = ac.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ac.hooks) - 1; i >= 0; i-- {
+			mut = ac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AttachmentCreate) SaveX(ctx context.Context) *Attachment {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ac *AttachmentCreate) sqlSave(ctx context.Context) (*Attachment, error) {
+	a, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	a.ID = int(id)
+	return a, nil
+}
+
+func (ac *AttachmentCreate) createSpec() (*Attachment, *sqlgraph.CreateSpec) {
+	var (
+		a     = &Attachment{config: ac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: attachment.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: attachment.FieldID,
+			},
+		}
+	)
+	if value, ok := ac.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: attachment.FieldCreateTime,
+		})
+		a.CreateTime = value
+	}
+	if value, ok := ac.mutation.Filename(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldFilename,
+		})
+		a.Filename = value
+	}
+	if value, ok := ac.mutation.ContentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldContentType,
+		})
+		a.ContentType = value
+	}
+	if value, ok := ac.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: attachment.FieldSize,
+		})
+		a.Size = value
+	}
+	if value, ok := ac.mutation.Key(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: attachment.FieldKey,
+		})
+		a.Key = value
+	}
+	if value, ok := ac.mutation.Thumbnail(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: attachment.FieldThumbnail,
+		})
+		a.Thumbnail = value
+	}
+	if nodes := ac.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.RepairSlipTable,
+			Columns: []string{attachment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return a, _spec
+}
@@ -0,0 +1,564 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *AttachmentMutation
+	predicates []predicate.Attachment
+}
+
+// Where adds a new predicate for the builder.
+func (au *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	au.predicates = append(au.predicates, ps...)
+	return au
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (au *AttachmentUpdate) SetOrganizationID(id int) *AttachmentUpdate {
+	au.mutation.SetOrganizationID(id)
+	return au
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableOrganizationID(id *int) *AttachmentUpdate {
+	if id != nil {
+		au = au.SetOrganizationID(*id)
+	}
+	return au
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (au *AttachmentUpdate) SetOrganization(o *Organization) *AttachmentUpdate {
+	return au.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (au *AttachmentUpdate) SetRepairSlipID(id int) *AttachmentUpdate {
+	au.mutation.SetRepairSlipID(id)
+	return au
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (au *AttachmentUpdate) SetRepairSlip(r *RepairSlip) *AttachmentUpdate {
+	return au.SetRepairSlipID(r.ID)
+}
+
+// SetUploaderID sets the uploader edge to User by id.
+func (au *AttachmentUpdate) SetUploaderID(id int) *AttachmentUpdate {
+	au.mutation.SetUploaderID(id)
+	return au
+}
+
+// SetNillableUploaderID sets the uploader edge to User by id if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableUploaderID(id *int) *AttachmentUpdate {
+	if id != nil {
+		au = au.SetUploaderID(*id)
+	}
+	return au
+}
+
+// SetUploader sets the uploader edge to User.
+func (au *AttachmentUpdate) SetUploader(u *User) *AttachmentUpdate {
+	return au.SetUploaderID(u.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (au *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return au.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (au *AttachmentUpdate) ClearOrganization() *AttachmentUpdate {
+	au.mutation.ClearOrganization()
+	return au
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (au *AttachmentUpdate) ClearRepairSlip() *AttachmentUpdate {
+	au.mutation.ClearRepairSlip()
+	return au
+}
+
+// ClearUploader clears the uploader edge to User.
+func (au *AttachmentUpdate) ClearUploader() *AttachmentUpdate {
+	au.mutation.ClearUploader()
+	return au
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (au *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+
+	if _, ok := au.mutation.RepairSlipID(); au.mutation.RepairSlipCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"repair_slip\"")
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(au.hooks) == 0 {
+		affected, err = au.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AttachmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			au.mutation = mutation
+			affected, err = au.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(au.hooks) - 1; i >= 0; i-- {
+			mut = au.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, au.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (au *AttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   attachment.Table,
+			Columns: attachment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: attachment.FieldID,
+			},
+		},
+	}
+	if ps := au.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if au.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.RepairSlipTable,
+			Columns: []string{attachment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.RepairSlipTable,
+			Columns: []string{attachment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (auo *AttachmentUpdateOne) SetOrganizationID(id int) *AttachmentUpdateOne {
+	auo.mutation.SetOrganizationID(id)
+	return auo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableOrganizationID(id *int) *AttachmentUpdateOne {
+	if id != nil {
+		auo = auo.SetOrganizationID(*id)
+	}
+	return auo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (auo *AttachmentUpdateOne) SetOrganization(o *Organization) *AttachmentUpdateOne {
+	return auo.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (auo *AttachmentUpdateOne) SetRepairSlipID(id int) *AttachmentUpdateOne {
+	auo.mutation.SetRepairSlipID(id)
+	return auo
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (auo *AttachmentUpdateOne) SetRepairSlip(r *RepairSlip) *AttachmentUpdateOne {
+	return auo.SetRepairSlipID(r.ID)
+}
+
+// SetUploaderID sets the uploader edge to User by id.
+func (auo *AttachmentUpdateOne) SetUploaderID(id int) *AttachmentUpdateOne {
+	auo.mutation.SetUploaderID(id)
+	return auo
+}
+
+// SetNillableUploaderID sets the uploader edge to User by id if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableUploaderID(id *int) *AttachmentUpdateOne {
+	if id != nil {
+		auo = auo.SetUploaderID(*id)
+	}
+	return auo
+}
+
+// SetUploader sets the uploader edge to User.
+func (auo *AttachmentUpdateOne) SetUploader(u *User) *AttachmentUpdateOne {
+	return auo.SetUploaderID(u.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (auo *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return auo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (auo *AttachmentUpdateOne) ClearOrganization() *AttachmentUpdateOne {
+	auo.mutation.ClearOrganization()
+	return auo
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (auo *AttachmentUpdateOne) ClearRepairSlip() *AttachmentUpdateOne {
+	auo.mutation.ClearRepairSlip()
+	return auo
+}
+
+// ClearUploader clears the uploader edge to User.
+func (auo *AttachmentUpdateOne) ClearUploader() *AttachmentUpdateOne {
+	auo.mutation.ClearUploader()
+	return auo
+}
+
+// Save executes the query and returns the updated entity.
+func (auo *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+
+	if _, ok := auo.mutation.RepairSlipID(); auo.mutation.RepairSlipCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"repair_slip\"")
+	}
+
+	var (
+		err  error
+		node *Attachment
+	)
+	if len(auo.hooks) == 0 {
+		node, err = auo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AttachmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			auo.mutation = mutation
+			node, err = auo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(auo.hooks) - 1; i >= 0; i-- {
+			mut = auo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, auo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	a, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Exec executes the query on the entity.
+func (auo *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (auo *AttachmentUpdateOne) sqlSave(ctx context.Context) (a *Attachment, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   attachment.Table,
+			Columns: attachment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: attachment.FieldID,
+			},
+		},
+	}
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Attachment.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if auo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.RepairSlipTable,
+			Columns: []string{attachment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.RepairSlipTable,
+			Columns: []string{attachment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	a = &Attachment{config: auo.config}
+	_spec.Assign = a.assignValues
+	_spec.ScanValues = a.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return a, nil
+}
@@ -0,0 +1,268 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Comment is the model entity for the Comment schema.
+type Comment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility comment.Visibility `json:"visibility,omitempty"`
+	// Edited holds the value of the "edited" field.
+	Edited bool `json:"edited"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentQuery when eager-loading is set.
+	Edges                CommentEdges `json:"edges"`
+	organization_id      *int
+	comment_author       *int
+	repair_slip_comments *int
+}
+
+// CommentEdges holds the relations/edges for other nodes in the graph.
+type CommentEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// RepairSlip holds the value of the repair_slip edge.
+	RepairSlip *RepairSlip
+	// Author holds the value of the author edge.
+	Author *User
+	// Mentions holds the value of the mentions edge.
+	Mentions []*User
+	// Revisions holds the value of the revisions edge.
+	Revisions []*CommentRevision
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// RepairSlipOrErr returns the RepairSlip value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) RepairSlipOrErr() (*RepairSlip, error) {
+	if e.loadedTypes[1] {
+		if e.RepairSlip == nil {
+			// The edge repair_slip was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: repairslip.Label}
+		}
+		return e.RepairSlip, nil
+	}
+	return nil, &NotLoadedError{edge: "repair_slip"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) AuthorOrErr() (*User, error) {
+	if e.loadedTypes[2] {
+		if e.Author == nil {
+			// The edge author was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Author, nil
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CommentEdges) MentionsOrErr() ([]*User, error) {
+	if e.loadedTypes[3] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CommentEdges) RevisionsOrErr() ([]*CommentRevision, error) {
+	if e.loadedTypes[4] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Comment) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullTime{},   // update_time
+		&sql.NullString{}, // body
+		&sql.NullString{}, // visibility
+		&sql.NullBool{},   // edited
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Comment) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+		&sql.NullInt64{}, // comment_author
+		&sql.NullInt64{}, // repair_slip_comments
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Comment fields.
+func (c *Comment) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(comment.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	c.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		c.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field update_time", values[1])
+	} else if value.Valid {
+		c.UpdateTime = value.Time
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field body", values[2])
+	} else if value.Valid {
+		c.Body = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field visibility", values[3])
+	} else if value.Valid {
+		c.Visibility = comment.Visibility(value.String)
+	}
+	if value, ok := values[4].(*sql.NullBool); !ok {
+		return fmt.Errorf("unexpected type %T for field edited", values[4])
+	} else if value.Valid {
+		c.Edited = value.Bool
+	}
+	values = values[5:]
+	if len(values) == len(comment.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			c.organization_id = new(int)
+			*c.organization_id = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field comment_author", value)
+		} else if value.Valid {
+			c.comment_author = new(int)
+			*c.comment_author = int(value.Int64)
+		}
+		if value, ok := values[2].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field repair_slip_comments", value)
+		} else if value.Valid {
+			c.repair_slip_comments = new(int)
+			*c.repair_slip_comments = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Comment.
+func (c *Comment) QueryOrganization() *OrganizationQuery {
+	return (&CommentClient{config: c.config}).QueryOrganization(c)
+}
+
+// QueryRepairSlip queries the repair_slip edge of the Comment.
+func (c *Comment) QueryRepairSlip() *RepairSlipQuery {
+	return (&CommentClient{config: c.config}).QueryRepairSlip(c)
+}
+
+// QueryAuthor queries the author edge of the Comment.
+func (c *Comment) QueryAuthor() *UserQuery {
+	return (&CommentClient{config: c.config}).QueryAuthor(c)
+}
+
+// QueryMentions queries the mentions edge of the Comment.
+func (c *Comment) QueryMentions() *UserQuery {
+	return (&CommentClient{config: c.config}).QueryMentions(c)
+}
+
+// QueryRevisions queries the revisions edge of the Comment.
+func (c *Comment) QueryRevisions() *CommentRevisionQuery {
+	return (&CommentClient{config: c.config}).QueryRevisions(c)
+}
+
+// Update returns a builder for updating this Comment.
+// Note that, you need to call Comment.Unwrap() before calling this method, if this Comment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Comment) Update() *CommentUpdateOne {
+	return (&CommentClient{config: c.config}).UpdateOne(c)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (c *Comment) Unwrap() *Comment {
+	tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Comment is not a transactional entity")
+	}
+	c.config.driver = tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Comment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Comment(")
+	builder.WriteString(fmt.Sprintf("id=%v", c.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(c.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", update_time=")
+	builder.WriteString(c.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", body=")
+	builder.WriteString(c.Body)
+	builder.WriteString(", visibility=")
+	builder.WriteString(fmt.Sprintf("%v", c.Visibility))
+	builder.WriteString(", edited=")
+	builder.WriteString(fmt.Sprintf("%v", c.Edited))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Comments is a parsable slice of Comment.
+type Comments []*Comment
+
+func (c Comments) config(cfg config) {
+	for _i := range c {
+		c[_i].config = cfg
+	}
+}
@@ -0,0 +1,143 @@
+// Code generated by entc, DO NOT EDIT.
+
+package comment
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the comment type in the database.
+	Label = "comment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldEdited holds the string denoting the edited field in the database.
+	FieldEdited = "edited"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeRepairSlip holds the string denoting the repair_slip edge name in mutations.
+	EdgeRepairSlip = "repair_slip"
+	// EdgeAuthor holds the string denoting the author edge name in mutations.
+	EdgeAuthor = "author"
+	// EdgeMentions holds the string denoting the mentions edge name in mutations.
+	EdgeMentions = "mentions"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+
+	// Table holds the table name of the comment in the database.
+	Table = "comments"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "comments"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// RepairSlipTable is the table the holds the repair_slip relation/edge.
+	RepairSlipTable = "comments"
+	// RepairSlipInverseTable is the table name for the RepairSlip entity.
+	// It exists in this package in order to avoid circular dependency with the "repairslip" package.
+	RepairSlipInverseTable = "repair_slips"
+	// RepairSlipColumn is the table column denoting the repair_slip relation/edge.
+	RepairSlipColumn = "repair_slip_comments"
+	// AuthorTable is the table the holds the author relation/edge.
+	AuthorTable = "comments"
+	// AuthorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	AuthorInverseTable = "users"
+	// AuthorColumn is the table column denoting the author relation/edge.
+	AuthorColumn = "comment_author"
+	// MentionsTable is the table the holds the mentions relation/edge. The primary key declared below.
+	MentionsTable = "comment_mentions"
+	// MentionsInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	MentionsInverseTable = "users"
+	// RevisionsTable is the table the holds the revisions relation/edge.
+	RevisionsTable = "comment_revisions"
+	// RevisionsInverseTable is the table name for the CommentRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "commentrevision" package.
+	RevisionsInverseTable = "comment_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "comment_revisions"
+)
+
+// Columns holds all SQL columns for comment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldBody,
+	FieldVisibility,
+	FieldEdited,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Comment type.
+var ForeignKeys = []string{
+	"organization_id",
+	"comment_author",
+	"repair_slip_comments",
+}
+
+var (
+	// MentionsPrimaryKey and MentionsColumn2 are the table columns denoting the
+	// primary key for the mentions relation (M2M).
+	MentionsPrimaryKey = []string{"comment_id", "user_id"}
+)
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the update_time field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	UpdateDefaultUpdateTime func() time.Time
+	// BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	BodyValidator func(string) error
+	// DefaultEdited holds the default value on creation for the edited field.
+	DefaultEdited bool
+)
+
+// Visibility defines the type for the visibility enum field.
+type Visibility string
+
+// VisibilityPublic is the default Visibility.
+const DefaultVisibility = VisibilityPublic
+
+// Visibility values.
+const (
+	VisibilityPublic   Visibility = "public"
+	VisibilityInternal Visibility = "internal"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "v" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityPublic, VisibilityInternal:
+		return nil
+	default:
+		return fmt.Errorf("comment: invalid enum value for visibility field: %q", v)
+	}
+}
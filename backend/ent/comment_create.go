@@ -0,0 +1,398 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// CommentCreate is the builder for creating a Comment entity.
+type CommentCreate struct {
+	config
+	mutation *CommentMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (cc *CommentCreate) SetCreateTime(t time.Time) *CommentCreate {
+	cc.mutation.SetCreateTime(t)
+	return cc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (cc *CommentCreate) SetNillableCreateTime(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetCreateTime(*t)
+	}
+	return cc
+}
+
+// SetUpdateTime sets the update_time field.
+func (cc *CommentCreate) SetUpdateTime(t time.Time) *CommentCreate {
+	cc.mutation.SetUpdateTime(t)
+	return cc
+}
+
+// SetNillableUpdateTime sets the update_time field if the given value is not nil.
+func (cc *CommentCreate) SetNillableUpdateTime(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetUpdateTime(*t)
+	}
+	return cc
+}
+
+// SetBody sets the body field.
+func (cc *CommentCreate) SetBody(s string) *CommentCreate {
+	cc.mutation.SetBody(s)
+	return cc
+}
+
+// SetVisibility sets the visibility field.
+func (cc *CommentCreate) SetVisibility(c comment.Visibility) *CommentCreate {
+	cc.mutation.SetVisibility(c)
+	return cc
+}
+
+// SetNillableVisibility sets the visibility field if the given value is not nil.
+func (cc *CommentCreate) SetNillableVisibility(c *comment.Visibility) *CommentCreate {
+	if c != nil {
+		cc.SetVisibility(*c)
+	}
+	return cc
+}
+
+// SetEdited sets the edited field.
+func (cc *CommentCreate) SetEdited(b bool) *CommentCreate {
+	cc.mutation.SetEdited(b)
+	return cc
+}
+
+// SetNillableEdited sets the edited field if the given value is not nil.
+func (cc *CommentCreate) SetNillableEdited(b *bool) *CommentCreate {
+	if b != nil {
+		cc.SetEdited(*b)
+	}
+	return cc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (cc *CommentCreate) SetOrganizationID(id int) *CommentCreate {
+	cc.mutation.SetOrganizationID(id)
+	return cc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (cc *CommentCreate) SetNillableOrganizationID(id *int) *CommentCreate {
+	if id != nil {
+		cc = cc.SetOrganizationID(*id)
+	}
+	return cc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (cc *CommentCreate) SetOrganization(o *Organization) *CommentCreate {
+	return cc.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (cc *CommentCreate) SetRepairSlipID(id int) *CommentCreate {
+	cc.mutation.SetRepairSlipID(id)
+	return cc
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (cc *CommentCreate) SetRepairSlip(r *RepairSlip) *CommentCreate {
+	return cc.SetRepairSlipID(r.ID)
+}
+
+// SetAuthorID sets the author edge to User by id.
+func (cc *CommentCreate) SetAuthorID(id int) *CommentCreate {
+	cc.mutation.SetAuthorID(id)
+	return cc
+}
+
+// SetAuthor sets the author edge to User.
+func (cc *CommentCreate) SetAuthor(u *User) *CommentCreate {
+	return cc.SetAuthorID(u.ID)
+}
+
+// AddMentionIDs adds the mentions edge to User by ids.
+func (cc *CommentCreate) AddMentionIDs(ids ...int) *CommentCreate {
+	cc.mutation.AddMentionIDs(ids...)
+	return cc
+}
+
+// AddMentions adds the mentions edges to User.
+func (cc *CommentCreate) AddMentions(u ...*User) *CommentCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cc.AddMentionIDs(ids...)
+}
+
+// AddRevisionIDs adds the revisions edge to CommentRevision by ids.
+func (cc *CommentCreate) AddRevisionIDs(ids ...int) *CommentCreate {
+	cc.mutation.AddRevisionIDs(ids...)
+	return cc
+}
+
+// AddRevisions adds the revisions edges to CommentRevision.
+func (cc *CommentCreate) AddRevisions(c ...*CommentRevision) *CommentCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddRevisionIDs(ids...)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cc *CommentCreate) Mutation() *CommentMutation {
+	return cc.mutation
+}
+
+// Save creates the Comment in the database.
+func (cc *CommentCreate) Save(ctx context.Context) (*Comment, error) {
+	if _, ok := cc.mutation.CreateTime(); !ok {
+		v := comment.DefaultCreateTime()
+		cc.mutation.SetCreateTime(v)
+	}
+	if _, ok := cc.mutation.UpdateTime(); !ok {
+		v := comment.DefaultUpdateTime()
+		cc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := cc.mutation.Body(); !ok {
+		return nil, &ValidationError{Name: "body", err: errors.New("ent: missing required field \"body\"")}
+	}
+	if v, ok := cc.mutation.Body(); ok {
+		if err := comment.BodyValidator(v); err != nil {
+			return nil, &ValidationError{Name: "body", err: fmt.Errorf("ent: validator failed for field \"body\": %w", err)}
+		}
+	}
+	if _, ok := cc.mutation.Visibility(); !ok {
+		v := comment.DefaultVisibility
+		cc.mutation.SetVisibility(v)
+	}
+	if v, ok := cc.mutation.Visibility(); ok {
+		if err := comment.VisibilityValidator(v); err != nil {
+			return nil, &ValidationError{Name: "visibility", err: fmt.Errorf("ent: validator failed for field \"visibility\": %w", err)}
+		}
+	}
+	if _, ok := cc.mutation.Edited(); !ok {
+		v := comment.DefaultEdited
+		cc.mutation.SetEdited(v)
+	}
+	if _, ok := cc.mutation.RepairSlipID(); !ok {
+		return nil, &ValidationError{Name: "repair_slip", err: errors.New("ent: missing required edge \"repair_slip\"")}
+	}
+	if _, ok := cc.mutation.AuthorID(); !ok {
+		return nil, &ValidationError{Name: "author", err: errors.New("ent: missing required edge \"author\"")}
+	}
+	var (
+		err  error
+		node *Comment
+	)
+	if len(cc.hooks) == 0 {
+		node, err = cc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CommentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cc.mutation = mutation
+			node, err = cc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cc.hooks) - 1; i >= 0; i-- {
+			mut = cc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CommentCreate) SaveX(ctx context.Context) *Comment {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
+	c, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	c.ID = int(id)
+	return c, nil
+}
+
+func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
+	var (
+		c     = &Comment{config: cc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: comment.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: comment.FieldID,
+			},
+		}
+	)
+	if value, ok := cc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: comment.FieldCreateTime,
+		})
+		c.CreateTime = value
+	}
+	if value, ok := cc.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: comment.FieldUpdateTime,
+		})
+		c.UpdateTime = value
+	}
+	if value, ok := cc.mutation.Body(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: comment.FieldBody,
+		})
+		c.Body = value
+	}
+	if value, ok := cc.mutation.Visibility(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: comment.FieldVisibility,
+		})
+		c.Visibility = value
+	}
+	if value, ok := cc.mutation.Edited(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: comment.FieldEdited,
+		})
+		c.Edited = value
+	}
+	if nodes := cc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.OrganizationTable,
+			Columns: []string{comment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.RepairSlipTable,
+			Columns: []string{comment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: commentrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return c, _spec
+}
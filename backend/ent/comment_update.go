@@ -0,0 +1,981 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// CommentUpdate is the builder for updating Comment entities.
+type CommentUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *CommentMutation
+	predicates []predicate.Comment
+}
+
+// Where adds a new predicate for the builder.
+func (cu *CommentUpdate) Where(ps ...predicate.Comment) *CommentUpdate {
+	cu.predicates = append(cu.predicates, ps...)
+	return cu
+}
+
+// SetBody sets the body field.
+func (cu *CommentUpdate) SetBody(s string) *CommentUpdate {
+	cu.mutation.SetBody(s)
+	return cu
+}
+
+// SetVisibility sets the visibility field.
+func (cu *CommentUpdate) SetVisibility(c comment.Visibility) *CommentUpdate {
+	cu.mutation.SetVisibility(c)
+	return cu
+}
+
+// SetNillableVisibility sets the visibility field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableVisibility(c *comment.Visibility) *CommentUpdate {
+	if c != nil {
+		cu.SetVisibility(*c)
+	}
+	return cu
+}
+
+// SetEdited sets the edited field.
+func (cu *CommentUpdate) SetEdited(b bool) *CommentUpdate {
+	cu.mutation.SetEdited(b)
+	return cu
+}
+
+// SetNillableEdited sets the edited field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableEdited(b *bool) *CommentUpdate {
+	if b != nil {
+		cu.SetEdited(*b)
+	}
+	return cu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (cu *CommentUpdate) SetOrganizationID(id int) *CommentUpdate {
+	cu.mutation.SetOrganizationID(id)
+	return cu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (cu *CommentUpdate) SetNillableOrganizationID(id *int) *CommentUpdate {
+	if id != nil {
+		cu = cu.SetOrganizationID(*id)
+	}
+	return cu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (cu *CommentUpdate) SetOrganization(o *Organization) *CommentUpdate {
+	return cu.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (cu *CommentUpdate) SetRepairSlipID(id int) *CommentUpdate {
+	cu.mutation.SetRepairSlipID(id)
+	return cu
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (cu *CommentUpdate) SetRepairSlip(r *RepairSlip) *CommentUpdate {
+	return cu.SetRepairSlipID(r.ID)
+}
+
+// SetAuthorID sets the author edge to User by id.
+func (cu *CommentUpdate) SetAuthorID(id int) *CommentUpdate {
+	cu.mutation.SetAuthorID(id)
+	return cu
+}
+
+// SetAuthor sets the author edge to User.
+func (cu *CommentUpdate) SetAuthor(u *User) *CommentUpdate {
+	return cu.SetAuthorID(u.ID)
+}
+
+// AddMentionIDs adds the mentions edge to User by ids.
+func (cu *CommentUpdate) AddMentionIDs(ids ...int) *CommentUpdate {
+	cu.mutation.AddMentionIDs(ids...)
+	return cu
+}
+
+// AddMentions adds the mentions edges to User.
+func (cu *CommentUpdate) AddMentions(u ...*User) *CommentUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cu.AddMentionIDs(ids...)
+}
+
+// AddRevisionIDs adds the revisions edge to CommentRevision by ids.
+func (cu *CommentUpdate) AddRevisionIDs(ids ...int) *CommentUpdate {
+	cu.mutation.AddRevisionIDs(ids...)
+	return cu
+}
+
+// AddRevisions adds the revisions edges to CommentRevision.
+func (cu *CommentUpdate) AddRevisions(c ...*CommentRevision) *CommentUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddRevisionIDs(ids...)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cu *CommentUpdate) Mutation() *CommentMutation {
+	return cu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (cu *CommentUpdate) ClearOrganization() *CommentUpdate {
+	cu.mutation.ClearOrganization()
+	return cu
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (cu *CommentUpdate) ClearRepairSlip() *CommentUpdate {
+	cu.mutation.ClearRepairSlip()
+	return cu
+}
+
+// ClearAuthor clears the author edge to User.
+func (cu *CommentUpdate) ClearAuthor() *CommentUpdate {
+	cu.mutation.ClearAuthor()
+	return cu
+}
+
+// RemoveMentionIDs removes the mentions edge to User by ids.
+func (cu *CommentUpdate) RemoveMentionIDs(ids ...int) *CommentUpdate {
+	cu.mutation.RemoveMentionIDs(ids...)
+	return cu
+}
+
+// RemoveMentions removes mentions edges to User.
+func (cu *CommentUpdate) RemoveMentions(u ...*User) *CommentUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cu.RemoveMentionIDs(ids...)
+}
+
+// RemoveRevisionIDs removes the revisions edge to CommentRevision by ids.
+func (cu *CommentUpdate) RemoveRevisionIDs(ids ...int) *CommentUpdate {
+	cu.mutation.RemoveRevisionIDs(ids...)
+	return cu
+}
+
+// RemoveRevisions removes revisions edges to CommentRevision.
+func (cu *CommentUpdate) RemoveRevisions(c ...*CommentRevision) *CommentUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveRevisionIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
+	if _, ok := cu.mutation.UpdateTime(); !ok {
+		v := comment.UpdateDefaultUpdateTime()
+		cu.mutation.SetUpdateTime(v)
+	}
+	if v, ok := cu.mutation.Body(); ok {
+		if err := comment.BodyValidator(v); err != nil {
+			return 0, &ValidationError{Name: "body", err: fmt.Errorf("ent: validator failed for field \"body\": %w", err)}
+		}
+	}
+	if v, ok := cu.mutation.Visibility(); ok {
+		if err := comment.VisibilityValidator(v); err != nil {
+			return 0, &ValidationError{Name: "visibility", err: fmt.Errorf("ent: validator failed for field \"visibility\": %w", err)}
+		}
+	}
+
+	if _, ok := cu.mutation.RepairSlipID(); cu.mutation.RepairSlipCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"repair_slip\"")
+	}
+
+	if _, ok := cu.mutation.AuthorID(); cu.mutation.AuthorCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"author\"")
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(cu.hooks) == 0 {
+		affected, err = cu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CommentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cu.mutation = mutation
+			affected, err = cu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cu.hooks) - 1; i >= 0; i-- {
+			mut = cu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CommentUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CommentUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   comment.Table,
+			Columns: comment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: comment.FieldID,
+			},
+		},
+	}
+	if ps := cu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: comment.FieldUpdateTime,
+		})
+	}
+	if value, ok := cu.mutation.Body(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: comment.FieldBody,
+		})
+	}
+	if value, ok := cu.mutation.Visibility(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: comment.FieldVisibility,
+		})
+	}
+	if value, ok := cu.mutation.Edited(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: comment.FieldEdited,
+		})
+	}
+	if cu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.OrganizationTable,
+			Columns: []string{comment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.OrganizationTable,
+			Columns: []string{comment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.RepairSlipTable,
+			Columns: []string{comment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.RepairSlipTable,
+			Columns: []string{comment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := cu.mutation.RemovedMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := cu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: commentrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: commentrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CommentUpdateOne is the builder for updating a single Comment entity.
+type CommentUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *CommentMutation
+}
+
+// SetBody sets the body field.
+func (cuo *CommentUpdateOne) SetBody(s string) *CommentUpdateOne {
+	cuo.mutation.SetBody(s)
+	return cuo
+}
+
+// SetVisibility sets the visibility field.
+func (cuo *CommentUpdateOne) SetVisibility(c comment.Visibility) *CommentUpdateOne {
+	cuo.mutation.SetVisibility(c)
+	return cuo
+}
+
+// SetNillableVisibility sets the visibility field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableVisibility(c *comment.Visibility) *CommentUpdateOne {
+	if c != nil {
+		cuo.SetVisibility(*c)
+	}
+	return cuo
+}
+
+// SetEdited sets the edited field.
+func (cuo *CommentUpdateOne) SetEdited(b bool) *CommentUpdateOne {
+	cuo.mutation.SetEdited(b)
+	return cuo
+}
+
+// SetNillableEdited sets the edited field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableEdited(b *bool) *CommentUpdateOne {
+	if b != nil {
+		cuo.SetEdited(*b)
+	}
+	return cuo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (cuo *CommentUpdateOne) SetOrganizationID(id int) *CommentUpdateOne {
+	cuo.mutation.SetOrganizationID(id)
+	return cuo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableOrganizationID(id *int) *CommentUpdateOne {
+	if id != nil {
+		cuo = cuo.SetOrganizationID(*id)
+	}
+	return cuo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (cuo *CommentUpdateOne) SetOrganization(o *Organization) *CommentUpdateOne {
+	return cuo.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (cuo *CommentUpdateOne) SetRepairSlipID(id int) *CommentUpdateOne {
+	cuo.mutation.SetRepairSlipID(id)
+	return cuo
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (cuo *CommentUpdateOne) SetRepairSlip(r *RepairSlip) *CommentUpdateOne {
+	return cuo.SetRepairSlipID(r.ID)
+}
+
+// SetAuthorID sets the author edge to User by id.
+func (cuo *CommentUpdateOne) SetAuthorID(id int) *CommentUpdateOne {
+	cuo.mutation.SetAuthorID(id)
+	return cuo
+}
+
+// SetAuthor sets the author edge to User.
+func (cuo *CommentUpdateOne) SetAuthor(u *User) *CommentUpdateOne {
+	return cuo.SetAuthorID(u.ID)
+}
+
+// AddMentionIDs adds the mentions edge to User by ids.
+func (cuo *CommentUpdateOne) AddMentionIDs(ids ...int) *CommentUpdateOne {
+	cuo.mutation.AddMentionIDs(ids...)
+	return cuo
+}
+
+// AddMentions adds the mentions edges to User.
+func (cuo *CommentUpdateOne) AddMentions(u ...*User) *CommentUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cuo.AddMentionIDs(ids...)
+}
+
+// AddRevisionIDs adds the revisions edge to CommentRevision by ids.
+func (cuo *CommentUpdateOne) AddRevisionIDs(ids ...int) *CommentUpdateOne {
+	cuo.mutation.AddRevisionIDs(ids...)
+	return cuo
+}
+
+// AddRevisions adds the revisions edges to CommentRevision.
+func (cuo *CommentUpdateOne) AddRevisions(c ...*CommentRevision) *CommentUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddRevisionIDs(ids...)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cuo *CommentUpdateOne) Mutation() *CommentMutation {
+	return cuo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (cuo *CommentUpdateOne) ClearOrganization() *CommentUpdateOne {
+	cuo.mutation.ClearOrganization()
+	return cuo
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (cuo *CommentUpdateOne) ClearRepairSlip() *CommentUpdateOne {
+	cuo.mutation.ClearRepairSlip()
+	return cuo
+}
+
+// ClearAuthor clears the author edge to User.
+func (cuo *CommentUpdateOne) ClearAuthor() *CommentUpdateOne {
+	cuo.mutation.ClearAuthor()
+	return cuo
+}
+
+// RemoveMentionIDs removes the mentions edge to User by ids.
+func (cuo *CommentUpdateOne) RemoveMentionIDs(ids ...int) *CommentUpdateOne {
+	cuo.mutation.RemoveMentionIDs(ids...)
+	return cuo
+}
+
+// RemoveMentions removes mentions edges to User.
+func (cuo *CommentUpdateOne) RemoveMentions(u ...*User) *CommentUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cuo.RemoveMentionIDs(ids...)
+}
+
+// RemoveRevisionIDs removes the revisions edge to CommentRevision by ids.
+func (cuo *CommentUpdateOne) RemoveRevisionIDs(ids ...int) *CommentUpdateOne {
+	cuo.mutation.RemoveRevisionIDs(ids...)
+	return cuo
+}
+
+// RemoveRevisions removes revisions edges to CommentRevision.
+func (cuo *CommentUpdateOne) RemoveRevisions(c ...*CommentRevision) *CommentUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveRevisionIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
+	if _, ok := cuo.mutation.UpdateTime(); !ok {
+		v := comment.UpdateDefaultUpdateTime()
+		cuo.mutation.SetUpdateTime(v)
+	}
+	if v, ok := cuo.mutation.Body(); ok {
+		if err := comment.BodyValidator(v); err != nil {
+			return nil, &ValidationError{Name: "body", err: fmt.Errorf("ent: validator failed for field \"body\": %w", err)}
+		}
+	}
+	if v, ok := cuo.mutation.Visibility(); ok {
+		if err := comment.VisibilityValidator(v); err != nil {
+			return nil, &ValidationError{Name: "visibility", err: fmt.Errorf("ent: validator failed for field \"visibility\": %w", err)}
+		}
+	}
+
+	if _, ok := cuo.mutation.RepairSlipID(); cuo.mutation.RepairSlipCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"repair_slip\"")
+	}
+
+	if _, ok := cuo.mutation.AuthorID(); cuo.mutation.AuthorCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"author\"")
+	}
+
+	var (
+		err  error
+		node *Comment
+	)
+	if len(cuo.hooks) == 0 {
+		node, err = cuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CommentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cuo.mutation = mutation
+			node, err = cuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cuo.hooks) - 1; i >= 0; i-- {
+			mut = cuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CommentUpdateOne) SaveX(ctx context.Context) *Comment {
+	c, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Exec executes the query on the entity.
+func (cuo *CommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (c *Comment, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   comment.Table,
+			Columns: comment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: comment.FieldID,
+			},
+		},
+	}
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Comment.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := cuo.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: comment.FieldUpdateTime,
+		})
+	}
+	if value, ok := cuo.mutation.Body(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: comment.FieldBody,
+		})
+	}
+	if value, ok := cuo.mutation.Visibility(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: comment.FieldVisibility,
+		})
+	}
+	if value, ok := cuo.mutation.Edited(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: comment.FieldEdited,
+		})
+	}
+	if cuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.OrganizationTable,
+			Columns: []string{comment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.OrganizationTable,
+			Columns: []string{comment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.RepairSlipTable,
+			Columns: []string{comment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.RepairSlipTable,
+			Columns: []string{comment.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := cuo.mutation.RemovedMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := cuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: commentrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: commentrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	c = &Comment{config: cuo.config}
+	_spec.Assign = c.assignValues
+	_spec.ScanValues = c.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return c, nil
+}
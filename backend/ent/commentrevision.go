@@ -0,0 +1,176 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// CommentRevision is the model entity for the CommentRevision schema.
+type CommentRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentRevisionQuery when eager-loading is set.
+	Edges             CommentRevisionEdges `json:"edges"`
+	comment_revisions *int
+	organization_id   *int
+}
+
+// CommentRevisionEdges holds the relations/edges for other nodes in the graph.
+type CommentRevisionEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Comment holds the value of the comment edge.
+	Comment *Comment
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentRevisionEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// CommentOrErr returns the Comment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentRevisionEdges) CommentOrErr() (*Comment, error) {
+	if e.loadedTypes[1] {
+		if e.Comment == nil {
+			// The edge comment was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: comment.Label}
+		}
+		return e.Comment, nil
+	}
+	return nil, &NotLoadedError{edge: "comment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommentRevision) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullString{}, // body
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*CommentRevision) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // comment_revisions
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommentRevision fields.
+func (cr *CommentRevision) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(commentrevision.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	cr.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		cr.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field body", values[1])
+	} else if value.Valid {
+		cr.Body = value.String
+	}
+	values = values[2:]
+	if len(values) == len(commentrevision.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field comment_revisions", value)
+		} else if value.Valid {
+			cr.comment_revisions = new(int)
+			*cr.comment_revisions = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			cr.organization_id = new(int)
+			*cr.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the CommentRevision.
+func (cr *CommentRevision) QueryOrganization() *OrganizationQuery {
+	return (&CommentRevisionClient{config: cr.config}).QueryOrganization(cr)
+}
+
+// QueryComment queries the comment edge of the CommentRevision.
+func (cr *CommentRevision) QueryComment() *CommentQuery {
+	return (&CommentRevisionClient{config: cr.config}).QueryComment(cr)
+}
+
+// Update returns a builder for updating this CommentRevision.
+// Note that, you need to call CommentRevision.Unwrap() before calling this method, if this CommentRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *CommentRevision) Update() *CommentRevisionUpdateOne {
+	return (&CommentRevisionClient{config: cr.config}).UpdateOne(cr)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (cr *CommentRevision) Unwrap() *CommentRevision {
+	tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentRevision is not a transactional entity")
+	}
+	cr.config.driver = tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *CommentRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommentRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v", cr.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(cr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", body=")
+	builder.WriteString(cr.Body)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommentRevisions is a parsable slice of CommentRevision.
+type CommentRevisions []*CommentRevision
+
+func (cr CommentRevisions) config(cfg config) {
+	for _i := range cr {
+		cr[_i].config = cfg
+	}
+}
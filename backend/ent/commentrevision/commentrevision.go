@@ -0,0 +1,67 @@
+// Code generated by entc, DO NOT EDIT.
+
+package commentrevision
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the commentrevision type in the database.
+	Label = "comment_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeComment holds the string denoting the comment edge name in mutations.
+	EdgeComment = "comment"
+
+	// Table holds the table name of the commentrevision in the database.
+	Table = "comment_revisions"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "comment_revisions"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// CommentTable is the table the holds the comment relation/edge.
+	CommentTable = "comment_revisions"
+	// CommentInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentInverseTable = "comments"
+	// CommentColumn is the table column denoting the comment relation/edge.
+	CommentColumn = "comment_revisions"
+)
+
+// Columns holds all SQL columns for commentrevision fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldBody,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the CommentRevision type.
+var ForeignKeys = []string{
+	"comment_revisions",
+	"organization_id",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+)
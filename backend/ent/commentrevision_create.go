@@ -0,0 +1,207 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// CommentRevisionCreate is the builder for creating a CommentRevision entity.
+type CommentRevisionCreate struct {
+	config
+	mutation *CommentRevisionMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (crc *CommentRevisionCreate) SetCreateTime(t time.Time) *CommentRevisionCreate {
+	crc.mutation.SetCreateTime(t)
+	return crc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (crc *CommentRevisionCreate) SetNillableCreateTime(t *time.Time) *CommentRevisionCreate {
+	if t != nil {
+		crc.SetCreateTime(*t)
+	}
+	return crc
+}
+
+// SetBody sets the body field.
+func (crc *CommentRevisionCreate) SetBody(s string) *CommentRevisionCreate {
+	crc.mutation.SetBody(s)
+	return crc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (crc *CommentRevisionCreate) SetOrganizationID(id int) *CommentRevisionCreate {
+	crc.mutation.SetOrganizationID(id)
+	return crc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (crc *CommentRevisionCreate) SetNillableOrganizationID(id *int) *CommentRevisionCreate {
+	if id != nil {
+		crc = crc.SetOrganizationID(*id)
+	}
+	return crc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (crc *CommentRevisionCreate) SetOrganization(o *Organization) *CommentRevisionCreate {
+	return crc.SetOrganizationID(o.ID)
+}
+
+// SetCommentID sets the comment edge to Comment by id.
+func (crc *CommentRevisionCreate) SetCommentID(id int) *CommentRevisionCreate {
+	crc.mutation.SetCommentID(id)
+	return crc
+}
+
+// SetComment sets the comment edge to Comment.
+func (crc *CommentRevisionCreate) SetComment(c *Comment) *CommentRevisionCreate {
+	return crc.SetCommentID(c.ID)
+}
+
+// Mutation returns the CommentRevisionMutation object of the builder.
+func (crc *CommentRevisionCreate) Mutation() *CommentRevisionMutation {
+	return crc.mutation
+}
+
+// Save creates the CommentRevision in the database.
+func (crc *CommentRevisionCreate) Save(ctx context.Context) (*CommentRevision, error) {
+	if _, ok := crc.mutation.CreateTime(); !ok {
+		v := commentrevision.DefaultCreateTime()
+		crc.mutation.SetCreateTime(v)
+	}
+	if _, ok := crc.mutation.Body(); !ok {
+		return nil, &ValidationError{Name: "body", err: errors.New("ent: missing required field \"body\"")}
+	}
+	if _, ok := crc.mutation.CommentID(); !ok {
+		return nil, &ValidationError{Name: "comment", err: errors.New("ent: missing required edge \"comment\"")}
+	}
+	var (
+		err  error
+		node *CommentRevision
+	)
+	if len(crc.hooks) == 0 {
+		node, err = crc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CommentRevisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			crc.mutation = mutation
+			node, err = crc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(crc.hooks) - 1; i >= 0; i-- {
+			mut = crc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, crc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *CommentRevisionCreate) SaveX(ctx context.Context) *CommentRevision {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (crc *CommentRevisionCreate) sqlSave(ctx context.Context) (*CommentRevision, error) {
+	cr, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	cr.ID = int(id)
+	return cr, nil
+}
+
+func (crc *CommentRevisionCreate) createSpec() (*CommentRevision, *sqlgraph.CreateSpec) {
+	var (
+		cr    = &CommentRevision{config: crc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: commentrevision.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: commentrevision.FieldID,
+			},
+		}
+	)
+	if value, ok := crc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: commentrevision.FieldCreateTime,
+		})
+		cr.CreateTime = value
+	}
+	if value, ok := crc.mutation.Body(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: commentrevision.FieldBody,
+		})
+		cr.Body = value
+	}
+	if nodes := crc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   commentrevision.OrganizationTable,
+			Columns: []string{commentrevision.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := crc.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return cr, _spec
+}
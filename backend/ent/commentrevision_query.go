@@ -0,0 +1,999 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// CommentRevisionQuery is the builder for querying CommentRevision entities.
+type CommentRevisionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.CommentRevision
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withComment      *CommentQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (crq *CommentRevisionQuery) Where(ps ...predicate.CommentRevision) *CommentRevisionQuery {
+	crq.predicates = append(crq.predicates, ps...)
+	return crq
+}
+
+// Limit adds a limit step to the query.
+func (crq *CommentRevisionQuery) Limit(limit int) *CommentRevisionQuery {
+	crq.limit = &limit
+	return crq
+}
+
+// Offset adds an offset step to the query.
+func (crq *CommentRevisionQuery) Offset(offset int) *CommentRevisionQuery {
+	crq.offset = &offset
+	return crq
+}
+
+// Order adds an order step to the query.
+func (crq *CommentRevisionQuery) Order(o ...OrderFunc) *CommentRevisionQuery {
+	crq.order = append(crq.order, o...)
+	return crq
+}
+
+// QueryOrganization chains the current query on the organization edge.
+func (crq *CommentRevisionQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: crq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(commentrevision.Table, commentrevision.FieldID, crq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, commentrevision.OrganizationTable, commentrevision.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComment chains the current query on the comment edge.
+func (crq *CommentRevisionQuery) QueryComment() *CommentQuery {
+	query := &CommentQuery{config: crq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(commentrevision.Table, commentrevision.FieldID, crq.sqlQuery()),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, commentrevision.CommentTable, commentrevision.CommentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CommentRevision entity in the query. Returns *NotFoundError when no commentrevision was found.
+func (crq *CommentRevisionQuery) First(ctx context.Context) (*CommentRevision, error) {
+	crs, err := crq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(crs) == 0 {
+		return nil, &NotFoundError{commentrevision.Label}
+	}
+	return crs[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (crq *CommentRevisionQuery) FirstX(ctx context.Context) *CommentRevision {
+	cr, err := crq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return cr
+}
+
+// FirstID returns the first CommentRevision id in the query. Returns *NotFoundError when no id was found.
+func (crq *CommentRevisionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = crq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{commentrevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (crq *CommentRevisionQuery) FirstXID(ctx context.Context) int {
+	id, err := crq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only CommentRevision entity in the query, returns an error if not exactly one entity was returned.
+func (crq *CommentRevisionQuery) Only(ctx context.Context) (*CommentRevision, error) {
+	crs, err := crq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(crs) {
+	case 1:
+		return crs[0], nil
+	case 0:
+		return nil, &NotFoundError{commentrevision.Label}
+	default:
+		return nil, &NotSingularError{commentrevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (crq *CommentRevisionQuery) OnlyX(ctx context.Context) *CommentRevision {
+	cr, err := crq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return cr
+}
+
+// OnlyID returns the only CommentRevision id in the query, returns an error if not exactly one id was returned.
+func (crq *CommentRevisionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = crq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{commentrevision.Label}
+	default:
+		err = &NotSingularError{commentrevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (crq *CommentRevisionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := crq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CommentRevisions.
+func (crq *CommentRevisionQuery) All(ctx context.Context) ([]*CommentRevision, error) {
+	if err := crq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return crq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (crq *CommentRevisionQuery) AllX(ctx context.Context) []*CommentRevision {
+	crs, err := crq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return crs
+}
+
+// IDs executes the query and returns a list of CommentRevision ids.
+func (crq *CommentRevisionQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := crq.Select(commentrevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (crq *CommentRevisionQuery) IDsX(ctx context.Context) []int {
+	ids, err := crq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (crq *CommentRevisionQuery) Count(ctx context.Context) (int, error) {
+	if err := crq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return crq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (crq *CommentRevisionQuery) CountX(ctx context.Context) int {
+	count, err := crq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (crq *CommentRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := crq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return crq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (crq *CommentRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := crq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (crq *CommentRevisionQuery) Clone() *CommentRevisionQuery {
+	return &CommentRevisionQuery{
+		config:     crq.config,
+		limit:      crq.limit,
+		offset:     crq.offset,
+		order:      append([]OrderFunc{}, crq.order...),
+		unique:     append([]string{}, crq.unique...),
+		predicates: append([]predicate.CommentRevision{}, crq.predicates...),
+		// clone intermediate query.
+		sql:  crq.sql.Clone(),
+		path: crq.path,
+	}
+}
+
+//  WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (crq *CommentRevisionQuery) WithOrganization(opts ...func(*OrganizationQuery)) *CommentRevisionQuery {
+	query := &OrganizationQuery{config: crq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withOrganization = query
+	return crq
+}
+
+//  WithComment tells the query-builder to eager-loads the nodes that are connected to
+// the "comment" edge. The optional arguments used to configure the query builder of the edge.
+func (crq *CommentRevisionQuery) WithComment(opts ...func(*CommentQuery)) *CommentRevisionQuery {
+	query := &CommentQuery{config: crq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withComment = query
+	return crq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CommentRevision.Query().
+//		GroupBy(commentrevision.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (crq *CommentRevisionQuery) GroupBy(field string, fields ...string) *CommentRevisionGroupBy {
+	group := &CommentRevisionGroupBy{config: crq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return crq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.CommentRevision.Query().
+//		Select(commentrevision.FieldCreateTime).
+//		Scan(ctx, &v)
+//
+func (crq *CommentRevisionQuery) Select(field string, fields ...string) *CommentRevisionSelect {
+	selector := &CommentRevisionSelect{config: crq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return crq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (crq *CommentRevisionQuery) prepareQuery(ctx context.Context) error {
+	if crq.path != nil {
+		prev, err := crq.path(ctx)
+		if err != nil {
+			return err
+		}
+		crq.sql = prev
+	}
+	if err := commentrevision.Policy.EvalQuery(ctx, crq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (crq *CommentRevisionQuery) sqlAll(ctx context.Context) ([]*CommentRevision, error) {
+	var (
+		nodes       = []*CommentRevision{}
+		withFKs     = crq.withFKs
+		_spec       = crq.querySpec()
+		loadedTypes = [2]bool{
+			crq.withOrganization != nil,
+			crq.withComment != nil,
+		}
+	)
+	if crq.withOrganization != nil || crq.withComment != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, commentrevision.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &CommentRevision{config: crq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, crq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := crq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*CommentRevision)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	if query := crq.withComment; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*CommentRevision)
+		for i := range nodes {
+			if fk := nodes[i].comment_revisions; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(comment.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "comment_revisions" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Comment = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (crq *CommentRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := crq.querySpec()
+	return sqlgraph.CountNodes(ctx, crq.driver, _spec)
+}
+
+func (crq *CommentRevisionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := crq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (crq *CommentRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   commentrevision.Table,
+			Columns: commentrevision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: commentrevision.FieldID,
+			},
+		},
+		From:   crq.sql,
+		Unique: true,
+	}
+	if ps := crq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := crq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := crq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := crq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (crq *CommentRevisionQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(crq.driver.Dialect())
+	t1 := builder.Table(commentrevision.Table)
+	selector := builder.Select(t1.Columns(commentrevision.Columns...)...).From(t1)
+	if crq.sql != nil {
+		selector = crq.sql
+		selector.Select(selector.Columns(commentrevision.Columns...)...)
+	}
+	for _, p := range crq.predicates {
+		p(selector)
+	}
+	for _, p := range crq.order {
+		p(selector)
+	}
+	if offset := crq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := crq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CommentRevisionGroupBy is the builder for group-by CommentRevision entities.
+type CommentRevisionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (crgb *CommentRevisionGroupBy) Aggregate(fns ...AggregateFunc) *CommentRevisionGroupBy {
+	crgb.fns = append(crgb.fns, fns...)
+	return crgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (crgb *CommentRevisionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := crgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	crgb.sql = query
+	return crgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (crgb *CommentRevisionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := crgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (crgb *CommentRevisionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(crgb.fields) > 1 {
+		return nil, errors.New("ent: CommentRevisionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := crgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (crgb *CommentRevisionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := crgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (crgb *CommentRevisionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = crgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{commentrevision.Label}
+	default:
+		err = fmt.Errorf("ent: CommentRevisionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (crgb *CommentRevisionGroupBy) StringX(ctx context.Context) string {
+	v, err := crgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (crgb *CommentRevisionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(crgb.fields) > 1 {
+		return nil, errors.New("ent: CommentRevisionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := crgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (crgb *CommentRevisionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := crgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (crgb *CommentRevisionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = crgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{commentrevision.Label}
+	default:
+		err = fmt.Errorf("ent: CommentRevisionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (crgb *CommentRevisionGroupBy) IntX(ctx context.Context) int {
+	v, err := crgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (crgb *CommentRevisionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(crgb.fields) > 1 {
+		return nil, errors.New("ent: CommentRevisionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := crgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (crgb *CommentRevisionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := crgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (crgb *CommentRevisionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = crgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{commentrevision.Label}
+	default:
+		err = fmt.Errorf("ent: CommentRevisionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (crgb *CommentRevisionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := crgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (crgb *CommentRevisionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(crgb.fields) > 1 {
+		return nil, errors.New("ent: CommentRevisionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := crgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (crgb *CommentRevisionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := crgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (crgb *CommentRevisionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = crgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{commentrevision.Label}
+	default:
+		err = fmt.Errorf("ent: CommentRevisionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (crgb *CommentRevisionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := crgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (crgb *CommentRevisionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := crgb.sqlQuery().Query()
+	if err := crgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (crgb *CommentRevisionGroupBy) sqlQuery() *sql.Selector {
+	selector := crgb.sql
+	columns := make([]string, 0, len(crgb.fields)+len(crgb.fns))
+	columns = append(columns, crgb.fields...)
+	for _, fn := range crgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(crgb.fields...)
+}
+
+// CommentRevisionSelect is the builder for select fields of CommentRevision entities.
+type CommentRevisionSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (crs *CommentRevisionSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := crs.path(ctx)
+	if err != nil {
+		return err
+	}
+	crs.sql = query
+	return crs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (crs *CommentRevisionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := crs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (crs *CommentRevisionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(crs.fields) > 1 {
+		return nil, errors.New("ent: CommentRevisionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := crs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (crs *CommentRevisionSelect) StringsX(ctx context.Context) []string {
+	v, err := crs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (crs *CommentRevisionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = crs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{commentrevision.Label}
+	default:
+		err = fmt.Errorf("ent: CommentRevisionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (crs *CommentRevisionSelect) StringX(ctx context.Context) string {
+	v, err := crs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (crs *CommentRevisionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(crs.fields) > 1 {
+		return nil, errors.New("ent: CommentRevisionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := crs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (crs *CommentRevisionSelect) IntsX(ctx context.Context) []int {
+	v, err := crs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (crs *CommentRevisionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = crs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{commentrevision.Label}
+	default:
+		err = fmt.Errorf("ent: CommentRevisionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (crs *CommentRevisionSelect) IntX(ctx context.Context) int {
+	v, err := crs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (crs *CommentRevisionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(crs.fields) > 1 {
+		return nil, errors.New("ent: CommentRevisionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := crs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (crs *CommentRevisionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := crs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (crs *CommentRevisionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = crs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{commentrevision.Label}
+	default:
+		err = fmt.Errorf("ent: CommentRevisionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (crs *CommentRevisionSelect) Float64X(ctx context.Context) float64 {
+	v, err := crs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (crs *CommentRevisionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(crs.fields) > 1 {
+		return nil, errors.New("ent: CommentRevisionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := crs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (crs *CommentRevisionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := crs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (crs *CommentRevisionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = crs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{commentrevision.Label}
+	default:
+		err = fmt.Errorf("ent: CommentRevisionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (crs *CommentRevisionSelect) BoolX(ctx context.Context) bool {
+	v, err := crs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (crs *CommentRevisionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := crs.sqlQuery().Query()
+	if err := crs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (crs *CommentRevisionSelect) sqlQuery() sql.Querier {
+	selector := crs.sql
+	selector.Select(selector.Columns(crs.fields...)...)
+	return selector
+}
@@ -0,0 +1,441 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// CommentRevisionUpdate is the builder for updating CommentRevision entities.
+type CommentRevisionUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *CommentRevisionMutation
+	predicates []predicate.CommentRevision
+}
+
+// Where adds a new predicate for the builder.
+func (cru *CommentRevisionUpdate) Where(ps ...predicate.CommentRevision) *CommentRevisionUpdate {
+	cru.predicates = append(cru.predicates, ps...)
+	return cru
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (cru *CommentRevisionUpdate) SetOrganizationID(id int) *CommentRevisionUpdate {
+	cru.mutation.SetOrganizationID(id)
+	return cru
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (cru *CommentRevisionUpdate) SetNillableOrganizationID(id *int) *CommentRevisionUpdate {
+	if id != nil {
+		cru = cru.SetOrganizationID(*id)
+	}
+	return cru
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (cru *CommentRevisionUpdate) SetOrganization(o *Organization) *CommentRevisionUpdate {
+	return cru.SetOrganizationID(o.ID)
+}
+
+// SetCommentID sets the comment edge to Comment by id.
+func (cru *CommentRevisionUpdate) SetCommentID(id int) *CommentRevisionUpdate {
+	cru.mutation.SetCommentID(id)
+	return cru
+}
+
+// SetComment sets the comment edge to Comment.
+func (cru *CommentRevisionUpdate) SetComment(c *Comment) *CommentRevisionUpdate {
+	return cru.SetCommentID(c.ID)
+}
+
+// Mutation returns the CommentRevisionMutation object of the builder.
+func (cru *CommentRevisionUpdate) Mutation() *CommentRevisionMutation {
+	return cru.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (cru *CommentRevisionUpdate) ClearOrganization() *CommentRevisionUpdate {
+	cru.mutation.ClearOrganization()
+	return cru
+}
+
+// ClearComment clears the comment edge to Comment.
+func (cru *CommentRevisionUpdate) ClearComment() *CommentRevisionUpdate {
+	cru.mutation.ClearComment()
+	return cru
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (cru *CommentRevisionUpdate) Save(ctx context.Context) (int, error) {
+
+	if _, ok := cru.mutation.CommentID(); cru.mutation.CommentCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"comment\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(cru.hooks) == 0 {
+		affected, err = cru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CommentRevisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cru.mutation = mutation
+			affected, err = cru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cru.hooks) - 1; i >= 0; i-- {
+			mut = cru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *CommentRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *CommentRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *CommentRevisionUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cru *CommentRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   commentrevision.Table,
+			Columns: commentrevision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: commentrevision.FieldID,
+			},
+		},
+	}
+	if ps := cru.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cru.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   commentrevision.OrganizationTable,
+			Columns: []string{commentrevision.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   commentrevision.OrganizationTable,
+			Columns: []string{commentrevision.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cru.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentrevision.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CommentRevisionUpdateOne is the builder for updating a single CommentRevision entity.
+type CommentRevisionUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *CommentRevisionMutation
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (cruo *CommentRevisionUpdateOne) SetOrganizationID(id int) *CommentRevisionUpdateOne {
+	cruo.mutation.SetOrganizationID(id)
+	return cruo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (cruo *CommentRevisionUpdateOne) SetNillableOrganizationID(id *int) *CommentRevisionUpdateOne {
+	if id != nil {
+		cruo = cruo.SetOrganizationID(*id)
+	}
+	return cruo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (cruo *CommentRevisionUpdateOne) SetOrganization(o *Organization) *CommentRevisionUpdateOne {
+	return cruo.SetOrganizationID(o.ID)
+}
+
+// SetCommentID sets the comment edge to Comment by id.
+func (cruo *CommentRevisionUpdateOne) SetCommentID(id int) *CommentRevisionUpdateOne {
+	cruo.mutation.SetCommentID(id)
+	return cruo
+}
+
+// SetComment sets the comment edge to Comment.
+func (cruo *CommentRevisionUpdateOne) SetComment(c *Comment) *CommentRevisionUpdateOne {
+	return cruo.SetCommentID(c.ID)
+}
+
+// Mutation returns the CommentRevisionMutation object of the builder.
+func (cruo *CommentRevisionUpdateOne) Mutation() *CommentRevisionMutation {
+	return cruo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (cruo *CommentRevisionUpdateOne) ClearOrganization() *CommentRevisionUpdateOne {
+	cruo.mutation.ClearOrganization()
+	return cruo
+}
+
+// ClearComment clears the comment edge to Comment.
+func (cruo *CommentRevisionUpdateOne) ClearComment() *CommentRevisionUpdateOne {
+	cruo.mutation.ClearComment()
+	return cruo
+}
+
+// Save executes the query and returns the updated entity.
+func (cruo *CommentRevisionUpdateOne) Save(ctx context.Context) (*CommentRevision, error) {
+
+	if _, ok := cruo.mutation.CommentID(); cruo.mutation.CommentCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"comment\"")
+	}
+	var (
+		err  error
+		node *CommentRevision
+	)
+	if len(cruo.hooks) == 0 {
+		node, err = cruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CommentRevisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cruo.mutation = mutation
+			node, err = cruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cruo.hooks) - 1; i >= 0; i-- {
+			mut = cruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *CommentRevisionUpdateOne) SaveX(ctx context.Context) *CommentRevision {
+	cr, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return cr
+}
+
+// Exec executes the query on the entity.
+func (cruo *CommentRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *CommentRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cruo *CommentRevisionUpdateOne) sqlSave(ctx context.Context) (cr *CommentRevision, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   commentrevision.Table,
+			Columns: commentrevision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: commentrevision.FieldID,
+			},
+		},
+	}
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing CommentRevision.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if cruo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   commentrevision.OrganizationTable,
+			Columns: []string{commentrevision.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   commentrevision.OrganizationTable,
+			Columns: []string{commentrevision.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cruo.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	cr = &CommentRevision{config: cruo.config}
+	_spec.Assign = cr.assignValues
+	_spec.ScanValues = cr.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentrevision.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return cr, nil
+}
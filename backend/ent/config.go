@@ -24,7 +24,28 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	User []ent.Hook
+	Activity        []ent.Hook
+	Attachment      []ent.Hook
+	Comment         []ent.Hook
+	CommentRevision []ent.Hook
+	Equipment       []ent.Hook
+	EquipmentMove   []ent.Hook
+	Holiday         []ent.Hook
+	Invoice         []ent.Hook
+	InvoiceLine     []ent.Hook
+	Job             []ent.Hook
+	Location        []ent.Hook
+	Organization    []ent.Hook
+	Part            []ent.Hook
+	Payment         []ent.Hook
+	RepairSlip      []ent.Hook
+	SLAPolicy       []ent.Hook
+	StockLevel      []ent.Hook
+	StockMovement   []ent.Hook
+	User            []ent.Hook
+	WarrantyTerm    []ent.Hook
+	Webhook         []ent.Hook
+	WebhookDelivery []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -0,0 +1,1160 @@
+// Code generated by entc, DO NOT EDIT.
+
+package entdoc
+
+import (
+	"time"
+
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/holiday"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/job"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/darksford123x/app/ent/webhook"
+	"github.com/darksford123x/app/ent/webhookdelivery"
+	"github.com/darksford123x/app/money"
+)
+
+// Type is an entity type.
+type Type struct {
+	Name   string
+	Fields []Field
+	Edges  []Edge
+}
+
+// Field is a field of a type as it is encoded in JSON. Sensitive fields and
+// those tagged json:"-", which are never encoded, are left out.
+type Field struct {
+	// Name is the name of the field in JSON.
+	Name string
+	// Value is the zero value of the Go type of the field.
+	Value interface{}
+	// OmitEmpty reports whether the field is left out of the JSON when it
+	// has its zero value.
+	OmitEmpty bool
+	Optional  bool
+	Nillable  bool
+	Default   bool
+	Immutable bool
+	// Enums are the values of an enum field.
+	Enums []string
+	// Validator is the func(T) error validating the values of the field,
+	// if it has any.
+	Validator interface{}
+}
+
+// Edge is an edge of a type, encoded in the edges of its JSON by the name
+// of its struct field.
+type Edge struct {
+	Name   string
+	Type   string
+	Unique bool
+}
+
+// Types returns the entity types. The validators are those set by the
+// runtime package when it is imported.
+func Types() []Type {
+	return []Type{
+		{
+			Name: "Activity",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "kind",
+					Value:     *new(activity.Kind),
+					OmitEmpty: true,
+					Immutable: true,
+					Enums:     []string{"status_changed", "priority_changed", "assigned", "unassigned", "escalated"},
+				},
+				{
+					Name:      "from",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+					Immutable: true,
+				},
+				{
+					Name:      "to",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+					Immutable: true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "RepairSlip", Type: "RepairSlip", Unique: true},
+				{Name: "Actor", Type: "User", Unique: true},
+				{Name: "Technician", Type: "User", Unique: true},
+			},
+		},
+		{
+			Name: "Attachment",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "filename",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+					Validator: attachment.FilenameValidator,
+				},
+				{
+					Name:      "content_type",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+					Validator: attachment.ContentTypeValidator,
+				},
+				{
+					Name:      "size",
+					Value:     *new(int64),
+					Immutable: true,
+				},
+				{
+					Name:      "thumbnail",
+					Value:     *new(bool),
+					Default:   true,
+					Immutable: true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "RepairSlip", Type: "RepairSlip", Unique: true},
+				{Name: "Uploader", Type: "User", Unique: true},
+			},
+		},
+		{
+			Name: "Comment",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "update_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "body",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: comment.BodyValidator,
+				},
+				{
+					Name:      "visibility",
+					Value:     *new(comment.Visibility),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"public", "internal"},
+				},
+				{
+					Name:    "edited",
+					Value:   *new(bool),
+					Default: true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "RepairSlip", Type: "RepairSlip", Unique: true},
+				{Name: "Author", Type: "User", Unique: true},
+				{Name: "Mentions", Type: "User"},
+				{Name: "Revisions", Type: "CommentRevision"},
+			},
+		},
+		{
+			Name: "CommentRevision",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "body",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Comment", Type: "Comment", Unique: true},
+			},
+		},
+		{
+			Name: "Equipment",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "update_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "name",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: equipment.NameValidator,
+				},
+				{
+					Name:      "serial_number",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: equipment.SerialNumberValidator,
+				},
+				{
+					Name:      "model",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "asset_tag",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+					Validator: equipment.AssetTagValidator,
+				},
+				{
+					Name:      "warranty_start",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+				{
+					Name:      "warranty_end",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+				{
+					Name:      "warranty_provider",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "WarrantyTerm", Type: "WarrantyTerm", Unique: true},
+				{Name: "RepairSlips", Type: "RepairSlip"},
+				{Name: "Location", Type: "Location", Unique: true},
+				{Name: "Moves", Type: "EquipmentMove"},
+			},
+		},
+		{
+			Name: "EquipmentMove",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "note",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+					Immutable: true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Equipment", Type: "Equipment", Unique: true},
+				{Name: "FromLocation", Type: "Location", Unique: true},
+				{Name: "ToLocation", Type: "Location", Unique: true},
+				{Name: "MovedBy", Type: "User", Unique: true},
+			},
+		},
+		{
+			Name: "Holiday",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "date",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+				},
+				{
+					Name:      "name",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: holiday.NameValidator,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+			},
+		},
+		{
+			Name: "Invoice",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "number",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+					Validator: invoice.NumberValidator,
+				},
+				{
+					Name:      "kind",
+					Value:     *new(invoice.Kind),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+					Enums:     []string{"invoice", "credit_note"},
+				},
+				{
+					Name:      "currency",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "subtotal",
+					Value:     *new(money.Amount),
+					Immutable: true,
+				},
+				{
+					Name:      "discount",
+					Value:     *new(money.Amount),
+					Immutable: true,
+				},
+				{
+					Name:      "vat_rate",
+					Value:     *new(money.Rate),
+					Immutable: true,
+				},
+				{
+					Name:      "vat",
+					Value:     *new(money.Amount),
+					Immutable: true,
+				},
+				{
+					Name:      "total",
+					Value:     *new(money.Amount),
+					Immutable: true,
+				},
+				{
+					Name:      "reason",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+					Immutable: true,
+				},
+				{
+					Name:      "payment_status",
+					Value:     *new(invoice.PaymentStatus),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"unpaid", "partial", "paid", "refund_due"},
+				},
+				{
+					Name:  "amount_paid",
+					Value: *new(money.Amount),
+				},
+				{
+					Name:  "amount_credited",
+					Value: *new(money.Amount),
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "RepairSlip", Type: "RepairSlip", Unique: true},
+				{Name: "Customer", Type: "User", Unique: true},
+				{Name: "Lines", Type: "InvoiceLine"},
+				{Name: "Original", Type: "Invoice", Unique: true},
+				{Name: "CreditNotes", Type: "Invoice"},
+				{Name: "Payments", Type: "Payment"},
+			},
+		},
+		{
+			Name: "InvoiceLine",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "kind",
+					Value:     *new(invoiceline.Kind),
+					OmitEmpty: true,
+					Immutable: true,
+					Enums:     []string{"labour", "part", "fee"},
+				},
+				{
+					Name:      "description",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+					Validator: invoiceline.DescriptionValidator,
+				},
+				{
+					Name:      "quantity",
+					Value:     *new(money.Quantity),
+					Immutable: true,
+				},
+				{
+					Name:      "unit_price",
+					Value:     *new(money.Amount),
+					Immutable: true,
+				},
+				{
+					Name:      "amount",
+					Value:     *new(money.Amount),
+					Immutable: true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Invoice", Type: "Invoice", Unique: true},
+			},
+		},
+		{
+			Name: "Job",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "update_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "kind",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+					Validator: job.KindValidator,
+				},
+				{
+					Name:      "payload",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "status",
+					Value:     *new(job.Status),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"pending", "running", "succeeded", "dead", "cancelled"},
+				},
+				{
+					Name:    "attempts",
+					Value:   *new(int),
+					Default: true,
+				},
+				{
+					Name:      "max_attempts",
+					Value:     *new(int),
+					OmitEmpty: true,
+					Default:   true,
+					Validator: job.MaxAttemptsValidator,
+				},
+				{
+					Name:      "run_at",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+				},
+				{
+					Name:      "locked_by",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "locked_until",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+				{
+					Name:      "last_error",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "finished_at",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+			},
+		},
+		{
+			Name: "Location",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "update_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "name",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: location.NameValidator,
+				},
+				{
+					Name:      "kind",
+					Value:     *new(location.Kind),
+					OmitEmpty: true,
+					Enums:     []string{"campus", "building", "floor", "room"},
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Parent", Type: "Location", Unique: true},
+				{Name: "Children", Type: "Location"},
+				{Name: "Equipment", Type: "Equipment"},
+			},
+		},
+		{
+			Name: "Organization",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "name",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: organization.NameValidator,
+				},
+			},
+		},
+		{
+			Name: "Part",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "sku",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: part.SkuValidator,
+				},
+				{
+					Name:      "name",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: part.NameValidator,
+				},
+				{
+					Name:      "unit",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Default:   true,
+				},
+				{
+					Name:  "unit_price",
+					Value: *new(money.Amount),
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "StockLevels", Type: "StockLevel"},
+				{Name: "Movements", Type: "StockMovement"},
+			},
+		},
+		{
+			Name: "Payment",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "number",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+					Validator: payment.NumberValidator,
+				},
+				{
+					Name:      "kind",
+					Value:     *new(payment.Kind),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+					Enums:     []string{"payment", "refund"},
+				},
+				{
+					Name:      "method",
+					Value:     *new(payment.Method),
+					OmitEmpty: true,
+					Immutable: true,
+					Enums:     []string{"cash", "bank_transfer", "promptpay"},
+				},
+				{
+					Name:      "amount",
+					Value:     *new(money.Amount),
+					Immutable: true,
+				},
+				{
+					Name:      "reference",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+					Immutable: true,
+				},
+				{
+					Name:      "paid_at",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Invoice", Type: "Invoice", Unique: true},
+			},
+		},
+		{
+			Name: "RepairSlip",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "update_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "symptom",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: repairslip.SymptomValidator,
+				},
+				{
+					Name:      "category",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: repairslip.CategoryValidator,
+				},
+				{
+					Name:      "status",
+					Value:     *new(repairslip.Status),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"received", "in_progress", "waiting_parts", "ready", "closed"},
+				},
+				{
+					Name:    "under_warranty",
+					Value:   *new(bool),
+					Default: true,
+				},
+				{
+					Name:      "route",
+					Value:     *new(repairslip.Route),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"in_house", "warranty_claim"},
+				},
+				{
+					Name:      "priority",
+					Value:     *new(repairslip.Priority),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"low", "normal", "high", "urgent"},
+				},
+				{
+					Name:      "response_due",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+				{
+					Name:      "resolution_due",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+				{
+					Name:      "responded_at",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+				{
+					Name:      "resolved_at",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+				{
+					Name:    "response_breached",
+					Value:   *new(bool),
+					Default: true,
+				},
+				{
+					Name:    "resolution_breached",
+					Value:   *new(bool),
+					Default: true,
+				},
+				{
+					Name:      "escalated_at",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Reporter", Type: "User", Unique: true},
+				{Name: "Assignee", Type: "User", Unique: true},
+				{Name: "PartsUsed", Type: "StockMovement"},
+				{Name: "Invoice", Type: "Invoice", Unique: true},
+				{Name: "Equipment", Type: "Equipment", Unique: true},
+				{Name: "Attachments", Type: "Attachment"},
+				{Name: "Comments", Type: "Comment"},
+				{Name: "Activities", Type: "Activity"},
+			},
+		},
+		{
+			Name: "SLAPolicy",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "priority",
+					Value:     *new(slapolicy.Priority),
+					OmitEmpty: true,
+					Enums:     []string{"low", "normal", "high", "urgent"},
+				},
+				{
+					Name:    "category",
+					Value:   *new(string),
+					Default: true,
+				},
+				{
+					Name:      "response_minutes",
+					Value:     *new(int),
+					OmitEmpty: true,
+					Validator: slapolicy.ResponseMinutesValidator,
+				},
+				{
+					Name:      "resolution_minutes",
+					Value:     *new(int),
+					OmitEmpty: true,
+					Validator: slapolicy.ResolutionMinutesValidator,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+			},
+		},
+		{
+			Name: "StockLevel",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "location",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: stocklevel.LocationValidator,
+				},
+				{
+					Name:      "on_hand",
+					Value:     *new(int),
+					Default:   true,
+					Validator: stocklevel.OnHandValidator,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Part", Type: "Part", Unique: true},
+			},
+		},
+		{
+			Name: "StockMovement",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "kind",
+					Value:     *new(stockmovement.Kind),
+					OmitEmpty: true,
+					Immutable: true,
+					Enums:     []string{"receipt", "consumption", "adjustment"},
+				},
+				{
+					Name:      "quantity",
+					Value:     *new(int),
+					OmitEmpty: true,
+					Immutable: true,
+				},
+				{
+					Name:      "location",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+					Validator: stockmovement.LocationValidator,
+				},
+				{
+					Name:      "reason_code",
+					Value:     *new(stockmovement.ReasonCode),
+					OmitEmpty: true,
+					Optional:  true,
+					Immutable: true,
+					Enums:     []string{"count_correction", "damaged", "lost", "found", "returned_to_vendor", "other"},
+				},
+				{
+					Name:      "note",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+					Immutable: true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Part", Type: "Part", Unique: true},
+				{Name: "RepairSlip", Type: "RepairSlip", Unique: true},
+			},
+		},
+		{
+			Name: "User",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "age",
+					Value:     *new(int),
+					OmitEmpty: true,
+					Validator: user.AgeValidator,
+				},
+				{
+					Name:      "name",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: user.NameValidator,
+				},
+				{
+					Name:      "role",
+					Value:     *new(user.Role),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"staff", "technician", "supervisor", "admin"},
+				},
+				{
+					Name:      "skill",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "department",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "email",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "locale",
+					Value:     *new(user.Locale),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"th", "en"},
+				},
+				{
+					Name:      "disabled",
+					Value:     *new(bool),
+					OmitEmpty: true,
+					Default:   true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "ReportedSlips", Type: "RepairSlip"},
+				{Name: "AssignedSlips", Type: "RepairSlip"},
+				{Name: "Invoices", Type: "Invoice"},
+				{Name: "Attachments", Type: "Attachment"},
+				{Name: "MentionedIn", Type: "Comment"},
+			},
+		},
+		{
+			Name: "WarrantyTerm",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "name",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: warrantyterm.NameValidator,
+				},
+				{
+					Name:      "provider",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: warrantyterm.ProviderValidator,
+				},
+				{
+					Name:      "months",
+					Value:     *new(int),
+					OmitEmpty: true,
+					Validator: warrantyterm.MonthsValidator,
+				},
+				{
+					Name:      "coverage",
+					Value:     *new(warrantyterm.Coverage),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"parts", "labour", "parts_and_labour"},
+				},
+				{
+					Name:      "claim_contact",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "conditions",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Equipment", Type: "Equipment"},
+			},
+		},
+		{
+			Name: "Webhook",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "update_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "url",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Validator: webhook.URLValidator,
+				},
+				{
+					Name:      "events",
+					Value:     *new([]string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:    "active",
+					Value:   *new(bool),
+					Default: true,
+				},
+				{
+					Name:    "failures",
+					Value:   *new(int),
+					Default: true,
+				},
+				{
+					Name:      "disabled_at",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Deliveries", Type: "WebhookDelivery"},
+			},
+		},
+		{
+			Name: "WebhookDelivery",
+			Fields: []Field{
+				{Name: "id", Value: int(0), OmitEmpty: true, Immutable: true},
+				{
+					Name:      "create_time",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Default:   true,
+					Immutable: true,
+				},
+				{
+					Name:      "event",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+					Validator: webhookdelivery.EventValidator,
+				},
+				{
+					Name:      "payload",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Immutable: true,
+				},
+				{
+					Name:      "status",
+					Value:     *new(webhookdelivery.Status),
+					OmitEmpty: true,
+					Default:   true,
+					Enums:     []string{"pending", "succeeded", "failed"},
+				},
+				{
+					Name:    "attempts",
+					Value:   *new(int),
+					Default: true,
+				},
+				{
+					Name:      "response_code",
+					Value:     *new(int),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "response_body",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "error",
+					Value:     *new(string),
+					OmitEmpty: true,
+					Optional:  true,
+				},
+				{
+					Name:      "delivered_at",
+					Value:     *new(time.Time),
+					OmitEmpty: true,
+					Optional:  true,
+					Nillable:  true,
+				},
+			},
+			Edges: []Edge{
+				{Name: "Organization", Type: "Organization", Unique: true},
+				{Name: "Webhook", Type: "Webhook", Unique: true},
+			},
+		},
+	}
+}
@@ -0,0 +1,314 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Equipment is the model entity for the Equipment schema.
+type Equipment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// SerialNumber holds the value of the "serial_number" field.
+	SerialNumber string `json:"serial_number,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// AssetTag holds the value of the "asset_tag" field.
+	AssetTag string `json:"asset_tag,omitempty"`
+	// WarrantyStart holds the value of the "warranty_start" field.
+	WarrantyStart *time.Time `json:"warranty_start,omitempty"`
+	// WarrantyEnd holds the value of the "warranty_end" field.
+	WarrantyEnd *time.Time `json:"warranty_end,omitempty"`
+	// WarrantyProvider holds the value of the "warranty_provider" field.
+	WarrantyProvider string `json:"warranty_provider,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EquipmentQuery when eager-loading is set.
+	Edges                   EquipmentEdges `json:"edges"`
+	organization_id         *int
+	location_equipment      *int
+	warranty_term_equipment *int
+}
+
+// EquipmentEdges holds the relations/edges for other nodes in the graph.
+type EquipmentEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// WarrantyTerm holds the value of the warranty_term edge.
+	WarrantyTerm *WarrantyTerm
+	// RepairSlips holds the value of the repair_slips edge.
+	RepairSlips []*RepairSlip
+	// Location holds the value of the location edge.
+	Location *Location
+	// Moves holds the value of the moves edge.
+	Moves []*EquipmentMove
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EquipmentEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// WarrantyTermOrErr returns the WarrantyTerm value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EquipmentEdges) WarrantyTermOrErr() (*WarrantyTerm, error) {
+	if e.loadedTypes[1] {
+		if e.WarrantyTerm == nil {
+			// The edge warranty_term was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: warrantyterm.Label}
+		}
+		return e.WarrantyTerm, nil
+	}
+	return nil, &NotLoadedError{edge: "warranty_term"}
+}
+
+// RepairSlipsOrErr returns the RepairSlips value or an error if the edge
+// was not loaded in eager-loading.
+func (e EquipmentEdges) RepairSlipsOrErr() ([]*RepairSlip, error) {
+	if e.loadedTypes[2] {
+		return e.RepairSlips, nil
+	}
+	return nil, &NotLoadedError{edge: "repair_slips"}
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EquipmentEdges) LocationOrErr() (*Location, error) {
+	if e.loadedTypes[3] {
+		if e.Location == nil {
+			// The edge location was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: location.Label}
+		}
+		return e.Location, nil
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// MovesOrErr returns the Moves value or an error if the edge
+// was not loaded in eager-loading.
+func (e EquipmentEdges) MovesOrErr() ([]*EquipmentMove, error) {
+	if e.loadedTypes[4] {
+		return e.Moves, nil
+	}
+	return nil, &NotLoadedError{edge: "moves"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Equipment) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullTime{},   // update_time
+		&sql.NullString{}, // name
+		&sql.NullString{}, // serial_number
+		&sql.NullString{}, // model
+		&sql.NullString{}, // asset_tag
+		&sql.NullTime{},   // warranty_start
+		&sql.NullTime{},   // warranty_end
+		&sql.NullString{}, // warranty_provider
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Equipment) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+		&sql.NullInt64{}, // location_equipment
+		&sql.NullInt64{}, // warranty_term_equipment
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Equipment fields.
+func (e *Equipment) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(equipment.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	e.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		e.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field update_time", values[1])
+	} else if value.Valid {
+		e.UpdateTime = value.Time
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[2])
+	} else if value.Valid {
+		e.Name = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field serial_number", values[3])
+	} else if value.Valid {
+		e.SerialNumber = value.String
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field model", values[4])
+	} else if value.Valid {
+		e.Model = value.String
+	}
+	if value, ok := values[5].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field asset_tag", values[5])
+	} else if value.Valid {
+		e.AssetTag = value.String
+	}
+	if value, ok := values[6].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field warranty_start", values[6])
+	} else if value.Valid {
+		e.WarrantyStart = new(time.Time)
+		*e.WarrantyStart = value.Time
+	}
+	if value, ok := values[7].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field warranty_end", values[7])
+	} else if value.Valid {
+		e.WarrantyEnd = new(time.Time)
+		*e.WarrantyEnd = value.Time
+	}
+	if value, ok := values[8].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field warranty_provider", values[8])
+	} else if value.Valid {
+		e.WarrantyProvider = value.String
+	}
+	values = values[9:]
+	if len(values) == len(equipment.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			e.organization_id = new(int)
+			*e.organization_id = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field location_equipment", value)
+		} else if value.Valid {
+			e.location_equipment = new(int)
+			*e.location_equipment = int(value.Int64)
+		}
+		if value, ok := values[2].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field warranty_term_equipment", value)
+		} else if value.Valid {
+			e.warranty_term_equipment = new(int)
+			*e.warranty_term_equipment = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Equipment.
+func (e *Equipment) QueryOrganization() *OrganizationQuery {
+	return (&EquipmentClient{config: e.config}).QueryOrganization(e)
+}
+
+// QueryWarrantyTerm queries the warranty_term edge of the Equipment.
+func (e *Equipment) QueryWarrantyTerm() *WarrantyTermQuery {
+	return (&EquipmentClient{config: e.config}).QueryWarrantyTerm(e)
+}
+
+// QueryRepairSlips queries the repair_slips edge of the Equipment.
+func (e *Equipment) QueryRepairSlips() *RepairSlipQuery {
+	return (&EquipmentClient{config: e.config}).QueryRepairSlips(e)
+}
+
+// QueryLocation queries the location edge of the Equipment.
+func (e *Equipment) QueryLocation() *LocationQuery {
+	return (&EquipmentClient{config: e.config}).QueryLocation(e)
+}
+
+// QueryMoves queries the moves edge of the Equipment.
+func (e *Equipment) QueryMoves() *EquipmentMoveQuery {
+	return (&EquipmentClient{config: e.config}).QueryMoves(e)
+}
+
+// Update returns a builder for updating this Equipment.
+// Note that, you need to call Equipment.Unwrap() before calling this method, if this Equipment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (e *Equipment) Update() *EquipmentUpdateOne {
+	return (&EquipmentClient{config: e.config}).UpdateOne(e)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (e *Equipment) Unwrap() *Equipment {
+	tx, ok := e.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Equipment is not a transactional entity")
+	}
+	e.config.driver = tx.drv
+	return e
+}
+
+// String implements the fmt.Stringer.
+func (e *Equipment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Equipment(")
+	builder.WriteString(fmt.Sprintf("id=%v", e.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(e.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", update_time=")
+	builder.WriteString(e.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", name=")
+	builder.WriteString(e.Name)
+	builder.WriteString(", serial_number=")
+	builder.WriteString(e.SerialNumber)
+	builder.WriteString(", model=")
+	builder.WriteString(e.Model)
+	builder.WriteString(", asset_tag=")
+	builder.WriteString(e.AssetTag)
+	if v := e.WarrantyStart; v != nil {
+		builder.WriteString(", warranty_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := e.WarrantyEnd; v != nil {
+		builder.WriteString(", warranty_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", warranty_provider=")
+	builder.WriteString(e.WarrantyProvider)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EquipmentSlice is a parsable slice of Equipment.
+type EquipmentSlice []*Equipment
+
+func (e EquipmentSlice) config(cfg config) {
+	for _i := range e {
+		e[_i].config = cfg
+	}
+}
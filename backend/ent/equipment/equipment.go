@@ -0,0 +1,126 @@
+// Code generated by entc, DO NOT EDIT.
+
+package equipment
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the equipment type in the database.
+	Label = "equipment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSerialNumber holds the string denoting the serial_number field in the database.
+	FieldSerialNumber = "serial_number"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldAssetTag holds the string denoting the asset_tag field in the database.
+	FieldAssetTag = "asset_tag"
+	// FieldWarrantyStart holds the string denoting the warranty_start field in the database.
+	FieldWarrantyStart = "warranty_start"
+	// FieldWarrantyEnd holds the string denoting the warranty_end field in the database.
+	FieldWarrantyEnd = "warranty_end"
+	// FieldWarrantyProvider holds the string denoting the warranty_provider field in the database.
+	FieldWarrantyProvider = "warranty_provider"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeWarrantyTerm holds the string denoting the warranty_term edge name in mutations.
+	EdgeWarrantyTerm = "warranty_term"
+	// EdgeRepairSlips holds the string denoting the repair_slips edge name in mutations.
+	EdgeRepairSlips = "repair_slips"
+	// EdgeLocation holds the string denoting the location edge name in mutations.
+	EdgeLocation = "location"
+	// EdgeMoves holds the string denoting the moves edge name in mutations.
+	EdgeMoves = "moves"
+
+	// Table holds the table name of the equipment in the database.
+	Table = "equipment"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "equipment"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// WarrantyTermTable is the table the holds the warranty_term relation/edge.
+	WarrantyTermTable = "equipment"
+	// WarrantyTermInverseTable is the table name for the WarrantyTerm entity.
+	// It exists in this package in order to avoid circular dependency with the "warrantyterm" package.
+	WarrantyTermInverseTable = "warranty_terms"
+	// WarrantyTermColumn is the table column denoting the warranty_term relation/edge.
+	WarrantyTermColumn = "warranty_term_equipment"
+	// RepairSlipsTable is the table the holds the repair_slips relation/edge.
+	RepairSlipsTable = "repair_slips"
+	// RepairSlipsInverseTable is the table name for the RepairSlip entity.
+	// It exists in this package in order to avoid circular dependency with the "repairslip" package.
+	RepairSlipsInverseTable = "repair_slips"
+	// RepairSlipsColumn is the table column denoting the repair_slips relation/edge.
+	RepairSlipsColumn = "equipment_repair_slips"
+	// LocationTable is the table the holds the location relation/edge.
+	LocationTable = "equipment"
+	// LocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	LocationInverseTable = "locations"
+	// LocationColumn is the table column denoting the location relation/edge.
+	LocationColumn = "location_equipment"
+	// MovesTable is the table the holds the moves relation/edge.
+	MovesTable = "equipment_moves"
+	// MovesInverseTable is the table name for the EquipmentMove entity.
+	// It exists in this package in order to avoid circular dependency with the "equipmentmove" package.
+	MovesInverseTable = "equipment_moves"
+	// MovesColumn is the table column denoting the moves relation/edge.
+	MovesColumn = "equipment_moves"
+)
+
+// Columns holds all SQL columns for equipment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldName,
+	FieldSerialNumber,
+	FieldModel,
+	FieldAssetTag,
+	FieldWarrantyStart,
+	FieldWarrantyEnd,
+	FieldWarrantyProvider,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Equipment type.
+var ForeignKeys = []string{
+	"organization_id",
+	"location_equipment",
+	"warranty_term_equipment",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [3]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the update_time field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	UpdateDefaultUpdateTime func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// SerialNumberValidator is a validator for the "serial_number" field. It is called by the builders before save.
+	SerialNumberValidator func(string) error
+	// AssetTagValidator is a validator for the "asset_tag" field. It is called by the builders before save.
+	AssetTagValidator func(string) error
+)
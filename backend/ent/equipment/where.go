@@ -0,0 +1,1258 @@
+// Code generated by entc, DO NOT EDIT.
+
+package equipment
+
+import (
+	"time"
+
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreateTime), v))
+	})
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdateTime), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// SerialNumber applies equality check predicate on the "serial_number" field. It's identical to SerialNumberEQ.
+func SerialNumber(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSerialNumber), v))
+	})
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModel), v))
+	})
+}
+
+// AssetTag applies equality check predicate on the "asset_tag" field. It's identical to AssetTagEQ.
+func AssetTag(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAssetTag), v))
+	})
+}
+
+// WarrantyStart applies equality check predicate on the "warranty_start" field. It's identical to WarrantyStartEQ.
+func WarrantyStart(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWarrantyStart), v))
+	})
+}
+
+// WarrantyEnd applies equality check predicate on the "warranty_end" field. It's identical to WarrantyEndEQ.
+func WarrantyEnd(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWarrantyEnd), v))
+	})
+}
+
+// WarrantyProvider applies equality check predicate on the "warranty_provider" field. It's identical to WarrantyProviderEQ.
+func WarrantyProvider(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreateTime), v...))
+	})
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreateTime), v...))
+	})
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreateTime), v))
+	})
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdateTime), v...))
+	})
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdateTime), v...))
+	})
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdateTime), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// SerialNumberEQ applies the EQ predicate on the "serial_number" field.
+func SerialNumberEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberNEQ applies the NEQ predicate on the "serial_number" field.
+func SerialNumberNEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberIn applies the In predicate on the "serial_number" field.
+func SerialNumberIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSerialNumber), v...))
+	})
+}
+
+// SerialNumberNotIn applies the NotIn predicate on the "serial_number" field.
+func SerialNumberNotIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSerialNumber), v...))
+	})
+}
+
+// SerialNumberGT applies the GT predicate on the "serial_number" field.
+func SerialNumberGT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberGTE applies the GTE predicate on the "serial_number" field.
+func SerialNumberGTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberLT applies the LT predicate on the "serial_number" field.
+func SerialNumberLT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberLTE applies the LTE predicate on the "serial_number" field.
+func SerialNumberLTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberContains applies the Contains predicate on the "serial_number" field.
+func SerialNumberContains(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberHasPrefix applies the HasPrefix predicate on the "serial_number" field.
+func SerialNumberHasPrefix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberHasSuffix applies the HasSuffix predicate on the "serial_number" field.
+func SerialNumberHasSuffix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberEqualFold applies the EqualFold predicate on the "serial_number" field.
+func SerialNumberEqualFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberContainsFold applies the ContainsFold predicate on the "serial_number" field.
+func SerialNumberContainsFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSerialNumber), v))
+	})
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldModel), v))
+	})
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldModel), v))
+	})
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldModel), v...))
+	})
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldModel), v...))
+	})
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldModel), v))
+	})
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldModel), v))
+	})
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldModel), v))
+	})
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldModel), v))
+	})
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldModel), v))
+	})
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldModel), v))
+	})
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldModel), v))
+	})
+}
+
+// ModelIsNil applies the IsNil predicate on the "model" field.
+func ModelIsNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldModel)))
+	})
+}
+
+// ModelNotNil applies the NotNil predicate on the "model" field.
+func ModelNotNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldModel)))
+	})
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldModel), v))
+	})
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldModel), v))
+	})
+}
+
+// AssetTagEQ applies the EQ predicate on the "asset_tag" field.
+func AssetTagEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagNEQ applies the NEQ predicate on the "asset_tag" field.
+func AssetTagNEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagIn applies the In predicate on the "asset_tag" field.
+func AssetTagIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAssetTag), v...))
+	})
+}
+
+// AssetTagNotIn applies the NotIn predicate on the "asset_tag" field.
+func AssetTagNotIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAssetTag), v...))
+	})
+}
+
+// AssetTagGT applies the GT predicate on the "asset_tag" field.
+func AssetTagGT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagGTE applies the GTE predicate on the "asset_tag" field.
+func AssetTagGTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagLT applies the LT predicate on the "asset_tag" field.
+func AssetTagLT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagLTE applies the LTE predicate on the "asset_tag" field.
+func AssetTagLTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagContains applies the Contains predicate on the "asset_tag" field.
+func AssetTagContains(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagHasPrefix applies the HasPrefix predicate on the "asset_tag" field.
+func AssetTagHasPrefix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagHasSuffix applies the HasSuffix predicate on the "asset_tag" field.
+func AssetTagHasSuffix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagIsNil applies the IsNil predicate on the "asset_tag" field.
+func AssetTagIsNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAssetTag)))
+	})
+}
+
+// AssetTagNotNil applies the NotNil predicate on the "asset_tag" field.
+func AssetTagNotNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAssetTag)))
+	})
+}
+
+// AssetTagEqualFold applies the EqualFold predicate on the "asset_tag" field.
+func AssetTagEqualFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAssetTag), v))
+	})
+}
+
+// AssetTagContainsFold applies the ContainsFold predicate on the "asset_tag" field.
+func AssetTagContainsFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAssetTag), v))
+	})
+}
+
+// WarrantyStartEQ applies the EQ predicate on the "warranty_start" field.
+func WarrantyStartEQ(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWarrantyStart), v))
+	})
+}
+
+// WarrantyStartNEQ applies the NEQ predicate on the "warranty_start" field.
+func WarrantyStartNEQ(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWarrantyStart), v))
+	})
+}
+
+// WarrantyStartIn applies the In predicate on the "warranty_start" field.
+func WarrantyStartIn(vs ...time.Time) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWarrantyStart), v...))
+	})
+}
+
+// WarrantyStartNotIn applies the NotIn predicate on the "warranty_start" field.
+func WarrantyStartNotIn(vs ...time.Time) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWarrantyStart), v...))
+	})
+}
+
+// WarrantyStartGT applies the GT predicate on the "warranty_start" field.
+func WarrantyStartGT(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWarrantyStart), v))
+	})
+}
+
+// WarrantyStartGTE applies the GTE predicate on the "warranty_start" field.
+func WarrantyStartGTE(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWarrantyStart), v))
+	})
+}
+
+// WarrantyStartLT applies the LT predicate on the "warranty_start" field.
+func WarrantyStartLT(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWarrantyStart), v))
+	})
+}
+
+// WarrantyStartLTE applies the LTE predicate on the "warranty_start" field.
+func WarrantyStartLTE(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWarrantyStart), v))
+	})
+}
+
+// WarrantyStartIsNil applies the IsNil predicate on the "warranty_start" field.
+func WarrantyStartIsNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWarrantyStart)))
+	})
+}
+
+// WarrantyStartNotNil applies the NotNil predicate on the "warranty_start" field.
+func WarrantyStartNotNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWarrantyStart)))
+	})
+}
+
+// WarrantyEndEQ applies the EQ predicate on the "warranty_end" field.
+func WarrantyEndEQ(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWarrantyEnd), v))
+	})
+}
+
+// WarrantyEndNEQ applies the NEQ predicate on the "warranty_end" field.
+func WarrantyEndNEQ(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWarrantyEnd), v))
+	})
+}
+
+// WarrantyEndIn applies the In predicate on the "warranty_end" field.
+func WarrantyEndIn(vs ...time.Time) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWarrantyEnd), v...))
+	})
+}
+
+// WarrantyEndNotIn applies the NotIn predicate on the "warranty_end" field.
+func WarrantyEndNotIn(vs ...time.Time) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWarrantyEnd), v...))
+	})
+}
+
+// WarrantyEndGT applies the GT predicate on the "warranty_end" field.
+func WarrantyEndGT(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWarrantyEnd), v))
+	})
+}
+
+// WarrantyEndGTE applies the GTE predicate on the "warranty_end" field.
+func WarrantyEndGTE(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWarrantyEnd), v))
+	})
+}
+
+// WarrantyEndLT applies the LT predicate on the "warranty_end" field.
+func WarrantyEndLT(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWarrantyEnd), v))
+	})
+}
+
+// WarrantyEndLTE applies the LTE predicate on the "warranty_end" field.
+func WarrantyEndLTE(v time.Time) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWarrantyEnd), v))
+	})
+}
+
+// WarrantyEndIsNil applies the IsNil predicate on the "warranty_end" field.
+func WarrantyEndIsNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWarrantyEnd)))
+	})
+}
+
+// WarrantyEndNotNil applies the NotNil predicate on the "warranty_end" field.
+func WarrantyEndNotNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWarrantyEnd)))
+	})
+}
+
+// WarrantyProviderEQ applies the EQ predicate on the "warranty_provider" field.
+func WarrantyProviderEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderNEQ applies the NEQ predicate on the "warranty_provider" field.
+func WarrantyProviderNEQ(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderIn applies the In predicate on the "warranty_provider" field.
+func WarrantyProviderIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWarrantyProvider), v...))
+	})
+}
+
+// WarrantyProviderNotIn applies the NotIn predicate on the "warranty_provider" field.
+func WarrantyProviderNotIn(vs ...string) predicate.Equipment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Equipment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWarrantyProvider), v...))
+	})
+}
+
+// WarrantyProviderGT applies the GT predicate on the "warranty_provider" field.
+func WarrantyProviderGT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderGTE applies the GTE predicate on the "warranty_provider" field.
+func WarrantyProviderGTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderLT applies the LT predicate on the "warranty_provider" field.
+func WarrantyProviderLT(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderLTE applies the LTE predicate on the "warranty_provider" field.
+func WarrantyProviderLTE(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderContains applies the Contains predicate on the "warranty_provider" field.
+func WarrantyProviderContains(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderHasPrefix applies the HasPrefix predicate on the "warranty_provider" field.
+func WarrantyProviderHasPrefix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderHasSuffix applies the HasSuffix predicate on the "warranty_provider" field.
+func WarrantyProviderHasSuffix(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderIsNil applies the IsNil predicate on the "warranty_provider" field.
+func WarrantyProviderIsNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWarrantyProvider)))
+	})
+}
+
+// WarrantyProviderNotNil applies the NotNil predicate on the "warranty_provider" field.
+func WarrantyProviderNotNil() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWarrantyProvider)))
+	})
+}
+
+// WarrantyProviderEqualFold applies the EqualFold predicate on the "warranty_provider" field.
+func WarrantyProviderEqualFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// WarrantyProviderContainsFold applies the ContainsFold predicate on the "warranty_provider" field.
+func WarrantyProviderContainsFold(v string) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldWarrantyProvider), v))
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWarrantyTerm applies the HasEdge predicate on the "warranty_term" edge.
+func HasWarrantyTerm() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WarrantyTermTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WarrantyTermTable, WarrantyTermColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarrantyTermWith applies the HasEdge predicate on the "warranty_term" edge with a given conditions (other predicates).
+func HasWarrantyTermWith(preds ...predicate.WarrantyTerm) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WarrantyTermInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WarrantyTermTable, WarrantyTermColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRepairSlips applies the HasEdge predicate on the "repair_slips" edge.
+func HasRepairSlips() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RepairSlipsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepairSlipsTable, RepairSlipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRepairSlipsWith applies the HasEdge predicate on the "repair_slips" edge with a given conditions (other predicates).
+func HasRepairSlipsWith(preds ...predicate.RepairSlip) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RepairSlipsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepairSlipsTable, RepairSlipsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LocationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LocationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMoves applies the HasEdge predicate on the "moves" edge.
+func HasMoves() predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MovesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MovesTable, MovesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMovesWith applies the HasEdge predicate on the "moves" edge with a given conditions (other predicates).
+func HasMovesWith(preds ...predicate.EquipmentMove) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MovesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MovesTable, MovesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.Equipment) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.Equipment) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Equipment) predicate.Equipment {
+	return predicate.Equipment(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,489 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// EquipmentCreate is the builder for creating a Equipment entity.
+type EquipmentCreate struct {
+	config
+	mutation *EquipmentMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (ec *EquipmentCreate) SetCreateTime(t time.Time) *EquipmentCreate {
+	ec.mutation.SetCreateTime(t)
+	return ec
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableCreateTime(t *time.Time) *EquipmentCreate {
+	if t != nil {
+		ec.SetCreateTime(*t)
+	}
+	return ec
+}
+
+// SetUpdateTime sets the update_time field.
+func (ec *EquipmentCreate) SetUpdateTime(t time.Time) *EquipmentCreate {
+	ec.mutation.SetUpdateTime(t)
+	return ec
+}
+
+// SetNillableUpdateTime sets the update_time field if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableUpdateTime(t *time.Time) *EquipmentCreate {
+	if t != nil {
+		ec.SetUpdateTime(*t)
+	}
+	return ec
+}
+
+// SetName sets the name field.
+func (ec *EquipmentCreate) SetName(s string) *EquipmentCreate {
+	ec.mutation.SetName(s)
+	return ec
+}
+
+// SetSerialNumber sets the serial_number field.
+func (ec *EquipmentCreate) SetSerialNumber(s string) *EquipmentCreate {
+	ec.mutation.SetSerialNumber(s)
+	return ec
+}
+
+// SetModel sets the model field.
+func (ec *EquipmentCreate) SetModel(s string) *EquipmentCreate {
+	ec.mutation.SetModel(s)
+	return ec
+}
+
+// SetNillableModel sets the model field if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableModel(s *string) *EquipmentCreate {
+	if s != nil {
+		ec.SetModel(*s)
+	}
+	return ec
+}
+
+// SetAssetTag sets the asset_tag field.
+func (ec *EquipmentCreate) SetAssetTag(s string) *EquipmentCreate {
+	ec.mutation.SetAssetTag(s)
+	return ec
+}
+
+// SetNillableAssetTag sets the asset_tag field if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableAssetTag(s *string) *EquipmentCreate {
+	if s != nil {
+		ec.SetAssetTag(*s)
+	}
+	return ec
+}
+
+// SetWarrantyStart sets the warranty_start field.
+func (ec *EquipmentCreate) SetWarrantyStart(t time.Time) *EquipmentCreate {
+	ec.mutation.SetWarrantyStart(t)
+	return ec
+}
+
+// SetNillableWarrantyStart sets the warranty_start field if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableWarrantyStart(t *time.Time) *EquipmentCreate {
+	if t != nil {
+		ec.SetWarrantyStart(*t)
+	}
+	return ec
+}
+
+// SetWarrantyEnd sets the warranty_end field.
+func (ec *EquipmentCreate) SetWarrantyEnd(t time.Time) *EquipmentCreate {
+	ec.mutation.SetWarrantyEnd(t)
+	return ec
+}
+
+// SetNillableWarrantyEnd sets the warranty_end field if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableWarrantyEnd(t *time.Time) *EquipmentCreate {
+	if t != nil {
+		ec.SetWarrantyEnd(*t)
+	}
+	return ec
+}
+
+// SetWarrantyProvider sets the warranty_provider field.
+func (ec *EquipmentCreate) SetWarrantyProvider(s string) *EquipmentCreate {
+	ec.mutation.SetWarrantyProvider(s)
+	return ec
+}
+
+// SetNillableWarrantyProvider sets the warranty_provider field if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableWarrantyProvider(s *string) *EquipmentCreate {
+	if s != nil {
+		ec.SetWarrantyProvider(*s)
+	}
+	return ec
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (ec *EquipmentCreate) SetOrganizationID(id int) *EquipmentCreate {
+	ec.mutation.SetOrganizationID(id)
+	return ec
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableOrganizationID(id *int) *EquipmentCreate {
+	if id != nil {
+		ec = ec.SetOrganizationID(*id)
+	}
+	return ec
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (ec *EquipmentCreate) SetOrganization(o *Organization) *EquipmentCreate {
+	return ec.SetOrganizationID(o.ID)
+}
+
+// SetWarrantyTermID sets the warranty_term edge to WarrantyTerm by id.
+func (ec *EquipmentCreate) SetWarrantyTermID(id int) *EquipmentCreate {
+	ec.mutation.SetWarrantyTermID(id)
+	return ec
+}
+
+// SetNillableWarrantyTermID sets the warranty_term edge to WarrantyTerm by id if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableWarrantyTermID(id *int) *EquipmentCreate {
+	if id != nil {
+		ec = ec.SetWarrantyTermID(*id)
+	}
+	return ec
+}
+
+// SetWarrantyTerm sets the warranty_term edge to WarrantyTerm.
+func (ec *EquipmentCreate) SetWarrantyTerm(w *WarrantyTerm) *EquipmentCreate {
+	return ec.SetWarrantyTermID(w.ID)
+}
+
+// AddRepairSlipIDs adds the repair_slips edge to RepairSlip by ids.
+func (ec *EquipmentCreate) AddRepairSlipIDs(ids ...int) *EquipmentCreate {
+	ec.mutation.AddRepairSlipIDs(ids...)
+	return ec
+}
+
+// AddRepairSlips adds the repair_slips edges to RepairSlip.
+func (ec *EquipmentCreate) AddRepairSlips(r ...*RepairSlip) *EquipmentCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddRepairSlipIDs(ids...)
+}
+
+// SetLocationID sets the location edge to Location by id.
+func (ec *EquipmentCreate) SetLocationID(id int) *EquipmentCreate {
+	ec.mutation.SetLocationID(id)
+	return ec
+}
+
+// SetNillableLocationID sets the location edge to Location by id if the given value is not nil.
+func (ec *EquipmentCreate) SetNillableLocationID(id *int) *EquipmentCreate {
+	if id != nil {
+		ec = ec.SetLocationID(*id)
+	}
+	return ec
+}
+
+// SetLocation sets the location edge to Location.
+func (ec *EquipmentCreate) SetLocation(l *Location) *EquipmentCreate {
+	return ec.SetLocationID(l.ID)
+}
+
+// AddMoveIDs adds the moves edge to EquipmentMove by ids.
+func (ec *EquipmentCreate) AddMoveIDs(ids ...int) *EquipmentCreate {
+	ec.mutation.AddMoveIDs(ids...)
+	return ec
+}
+
+// AddMoves adds the moves edges to EquipmentMove.
+func (ec *EquipmentCreate) AddMoves(e ...*EquipmentMove) *EquipmentCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ec.AddMoveIDs(ids...)
+}
+
+// Mutation returns the EquipmentMutation object of the builder.
+func (ec *EquipmentCreate) Mutation() *EquipmentMutation {
+	return ec.mutation
+}
+
+// Save creates the Equipment in the database.
+func (ec *EquipmentCreate) Save(ctx context.Context) (*Equipment, error) {
+	if _, ok := ec.mutation.CreateTime(); !ok {
+		v := equipment.DefaultCreateTime()
+		ec.mutation.SetCreateTime(v)
+	}
+	if _, ok := ec.mutation.UpdateTime(); !ok {
+		v := equipment.DefaultUpdateTime()
+		ec.mutation.SetUpdateTime(v)
+	}
+	if _, ok := ec.mutation.Name(); !ok {
+		return nil, &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := ec.mutation.Name(); ok {
+		if err := equipment.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := ec.mutation.SerialNumber(); !ok {
+		return nil, &ValidationError{Name: "serial_number", err: errors.New("ent: missing required field \"serial_number\"")}
+	}
+	if v, ok := ec.mutation.SerialNumber(); ok {
+		if err := equipment.SerialNumberValidator(v); err != nil {
+			return nil, &ValidationError{Name: "serial_number", err: fmt.Errorf("ent: validator failed for field \"serial_number\": %w", err)}
+		}
+	}
+	if v, ok := ec.mutation.AssetTag(); ok {
+		if err := equipment.AssetTagValidator(v); err != nil {
+			return nil, &ValidationError{Name: "asset_tag", err: fmt.Errorf("ent: validator failed for field \"asset_tag\": %w", err)}
+		}
+	}
+	var (
+		err  error
+		node *Equipment
+	)
+	if len(ec.hooks) == 0 {
+		node, err = ec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EquipmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ec.mutation = mutation
+			node, err = ec.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ec.hooks) - 1; i >= 0; i-- {
+			mut = ec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *EquipmentCreate) SaveX(ctx context.Context) *Equipment {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ec *EquipmentCreate) sqlSave(ctx context.Context) (*Equipment, error) {
+	e, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	e.ID = int(id)
+	return e, nil
+}
+
+func (ec *EquipmentCreate) createSpec() (*Equipment, *sqlgraph.CreateSpec) {
+	var (
+		e     = &Equipment{config: ec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: equipment.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: equipment.FieldID,
+			},
+		}
+	)
+	if value, ok := ec.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldCreateTime,
+		})
+		e.CreateTime = value
+	}
+	if value, ok := ec.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldUpdateTime,
+		})
+		e.UpdateTime = value
+	}
+	if value, ok := ec.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldName,
+		})
+		e.Name = value
+	}
+	if value, ok := ec.mutation.SerialNumber(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldSerialNumber,
+		})
+		e.SerialNumber = value
+	}
+	if value, ok := ec.mutation.Model(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldModel,
+		})
+		e.Model = value
+	}
+	if value, ok := ec.mutation.AssetTag(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldAssetTag,
+		})
+		e.AssetTag = value
+	}
+	if value, ok := ec.mutation.WarrantyStart(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldWarrantyStart,
+		})
+		e.WarrantyStart = &value
+	}
+	if value, ok := ec.mutation.WarrantyEnd(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldWarrantyEnd,
+		})
+		e.WarrantyEnd = &value
+	}
+	if value, ok := ec.mutation.WarrantyProvider(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldWarrantyProvider,
+		})
+		e.WarrantyProvider = value
+	}
+	if nodes := ec.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipment.OrganizationTable,
+			Columns: []string{equipment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.WarrantyTermIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.WarrantyTermTable,
+			Columns: []string{equipment.WarrantyTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: warrantyterm.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.RepairSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.RepairSlipsTable,
+			Columns: []string{equipment.RepairSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.LocationTable,
+			Columns: []string{equipment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.MovesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.MovesTable,
+			Columns: []string{equipment.MovesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipmentmove.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return e, _spec
+}
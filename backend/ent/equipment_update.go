@@ -0,0 +1,1280 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// EquipmentUpdate is the builder for updating Equipment entities.
+type EquipmentUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *EquipmentMutation
+	predicates []predicate.Equipment
+}
+
+// Where adds a new predicate for the builder.
+func (eu *EquipmentUpdate) Where(ps ...predicate.Equipment) *EquipmentUpdate {
+	eu.predicates = append(eu.predicates, ps...)
+	return eu
+}
+
+// SetName sets the name field.
+func (eu *EquipmentUpdate) SetName(s string) *EquipmentUpdate {
+	eu.mutation.SetName(s)
+	return eu
+}
+
+// SetSerialNumber sets the serial_number field.
+func (eu *EquipmentUpdate) SetSerialNumber(s string) *EquipmentUpdate {
+	eu.mutation.SetSerialNumber(s)
+	return eu
+}
+
+// SetModel sets the model field.
+func (eu *EquipmentUpdate) SetModel(s string) *EquipmentUpdate {
+	eu.mutation.SetModel(s)
+	return eu
+}
+
+// SetNillableModel sets the model field if the given value is not nil.
+func (eu *EquipmentUpdate) SetNillableModel(s *string) *EquipmentUpdate {
+	if s != nil {
+		eu.SetModel(*s)
+	}
+	return eu
+}
+
+// ClearModel clears the value of model.
+func (eu *EquipmentUpdate) ClearModel() *EquipmentUpdate {
+	eu.mutation.ClearModel()
+	return eu
+}
+
+// SetAssetTag sets the asset_tag field.
+func (eu *EquipmentUpdate) SetAssetTag(s string) *EquipmentUpdate {
+	eu.mutation.SetAssetTag(s)
+	return eu
+}
+
+// SetNillableAssetTag sets the asset_tag field if the given value is not nil.
+func (eu *EquipmentUpdate) SetNillableAssetTag(s *string) *EquipmentUpdate {
+	if s != nil {
+		eu.SetAssetTag(*s)
+	}
+	return eu
+}
+
+// ClearAssetTag clears the value of asset_tag.
+func (eu *EquipmentUpdate) ClearAssetTag() *EquipmentUpdate {
+	eu.mutation.ClearAssetTag()
+	return eu
+}
+
+// SetWarrantyStart sets the warranty_start field.
+func (eu *EquipmentUpdate) SetWarrantyStart(t time.Time) *EquipmentUpdate {
+	eu.mutation.SetWarrantyStart(t)
+	return eu
+}
+
+// SetNillableWarrantyStart sets the warranty_start field if the given value is not nil.
+func (eu *EquipmentUpdate) SetNillableWarrantyStart(t *time.Time) *EquipmentUpdate {
+	if t != nil {
+		eu.SetWarrantyStart(*t)
+	}
+	return eu
+}
+
+// ClearWarrantyStart clears the value of warranty_start.
+func (eu *EquipmentUpdate) ClearWarrantyStart() *EquipmentUpdate {
+	eu.mutation.ClearWarrantyStart()
+	return eu
+}
+
+// SetWarrantyEnd sets the warranty_end field.
+func (eu *EquipmentUpdate) SetWarrantyEnd(t time.Time) *EquipmentUpdate {
+	eu.mutation.SetWarrantyEnd(t)
+	return eu
+}
+
+// SetNillableWarrantyEnd sets the warranty_end field if the given value is not nil.
+func (eu *EquipmentUpdate) SetNillableWarrantyEnd(t *time.Time) *EquipmentUpdate {
+	if t != nil {
+		eu.SetWarrantyEnd(*t)
+	}
+	return eu
+}
+
+// ClearWarrantyEnd clears the value of warranty_end.
+func (eu *EquipmentUpdate) ClearWarrantyEnd() *EquipmentUpdate {
+	eu.mutation.ClearWarrantyEnd()
+	return eu
+}
+
+// SetWarrantyProvider sets the warranty_provider field.
+func (eu *EquipmentUpdate) SetWarrantyProvider(s string) *EquipmentUpdate {
+	eu.mutation.SetWarrantyProvider(s)
+	return eu
+}
+
+// SetNillableWarrantyProvider sets the warranty_provider field if the given value is not nil.
+func (eu *EquipmentUpdate) SetNillableWarrantyProvider(s *string) *EquipmentUpdate {
+	if s != nil {
+		eu.SetWarrantyProvider(*s)
+	}
+	return eu
+}
+
+// ClearWarrantyProvider clears the value of warranty_provider.
+func (eu *EquipmentUpdate) ClearWarrantyProvider() *EquipmentUpdate {
+	eu.mutation.ClearWarrantyProvider()
+	return eu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (eu *EquipmentUpdate) SetOrganizationID(id int) *EquipmentUpdate {
+	eu.mutation.SetOrganizationID(id)
+	return eu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (eu *EquipmentUpdate) SetNillableOrganizationID(id *int) *EquipmentUpdate {
+	if id != nil {
+		eu = eu.SetOrganizationID(*id)
+	}
+	return eu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (eu *EquipmentUpdate) SetOrganization(o *Organization) *EquipmentUpdate {
+	return eu.SetOrganizationID(o.ID)
+}
+
+// SetWarrantyTermID sets the warranty_term edge to WarrantyTerm by id.
+func (eu *EquipmentUpdate) SetWarrantyTermID(id int) *EquipmentUpdate {
+	eu.mutation.SetWarrantyTermID(id)
+	return eu
+}
+
+// SetNillableWarrantyTermID sets the warranty_term edge to WarrantyTerm by id if the given value is not nil.
+func (eu *EquipmentUpdate) SetNillableWarrantyTermID(id *int) *EquipmentUpdate {
+	if id != nil {
+		eu = eu.SetWarrantyTermID(*id)
+	}
+	return eu
+}
+
+// SetWarrantyTerm sets the warranty_term edge to WarrantyTerm.
+func (eu *EquipmentUpdate) SetWarrantyTerm(w *WarrantyTerm) *EquipmentUpdate {
+	return eu.SetWarrantyTermID(w.ID)
+}
+
+// AddRepairSlipIDs adds the repair_slips edge to RepairSlip by ids.
+func (eu *EquipmentUpdate) AddRepairSlipIDs(ids ...int) *EquipmentUpdate {
+	eu.mutation.AddRepairSlipIDs(ids...)
+	return eu
+}
+
+// AddRepairSlips adds the repair_slips edges to RepairSlip.
+func (eu *EquipmentUpdate) AddRepairSlips(r ...*RepairSlip) *EquipmentUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddRepairSlipIDs(ids...)
+}
+
+// SetLocationID sets the location edge to Location by id.
+func (eu *EquipmentUpdate) SetLocationID(id int) *EquipmentUpdate {
+	eu.mutation.SetLocationID(id)
+	return eu
+}
+
+// SetNillableLocationID sets the location edge to Location by id if the given value is not nil.
+func (eu *EquipmentUpdate) SetNillableLocationID(id *int) *EquipmentUpdate {
+	if id != nil {
+		eu = eu.SetLocationID(*id)
+	}
+	return eu
+}
+
+// SetLocation sets the location edge to Location.
+func (eu *EquipmentUpdate) SetLocation(l *Location) *EquipmentUpdate {
+	return eu.SetLocationID(l.ID)
+}
+
+// AddMoveIDs adds the moves edge to EquipmentMove by ids.
+func (eu *EquipmentUpdate) AddMoveIDs(ids ...int) *EquipmentUpdate {
+	eu.mutation.AddMoveIDs(ids...)
+	return eu
+}
+
+// AddMoves adds the moves edges to EquipmentMove.
+func (eu *EquipmentUpdate) AddMoves(e ...*EquipmentMove) *EquipmentUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.AddMoveIDs(ids...)
+}
+
+// Mutation returns the EquipmentMutation object of the builder.
+func (eu *EquipmentUpdate) Mutation() *EquipmentMutation {
+	return eu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (eu *EquipmentUpdate) ClearOrganization() *EquipmentUpdate {
+	eu.mutation.ClearOrganization()
+	return eu
+}
+
+// ClearWarrantyTerm clears the warranty_term edge to WarrantyTerm.
+func (eu *EquipmentUpdate) ClearWarrantyTerm() *EquipmentUpdate {
+	eu.mutation.ClearWarrantyTerm()
+	return eu
+}
+
+// RemoveRepairSlipIDs removes the repair_slips edge to RepairSlip by ids.
+func (eu *EquipmentUpdate) RemoveRepairSlipIDs(ids ...int) *EquipmentUpdate {
+	eu.mutation.RemoveRepairSlipIDs(ids...)
+	return eu
+}
+
+// RemoveRepairSlips removes repair_slips edges to RepairSlip.
+func (eu *EquipmentUpdate) RemoveRepairSlips(r ...*RepairSlip) *EquipmentUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveRepairSlipIDs(ids...)
+}
+
+// ClearLocation clears the location edge to Location.
+func (eu *EquipmentUpdate) ClearLocation() *EquipmentUpdate {
+	eu.mutation.ClearLocation()
+	return eu
+}
+
+// RemoveMoveIDs removes the moves edge to EquipmentMove by ids.
+func (eu *EquipmentUpdate) RemoveMoveIDs(ids ...int) *EquipmentUpdate {
+	eu.mutation.RemoveMoveIDs(ids...)
+	return eu
+}
+
+// RemoveMoves removes moves edges to EquipmentMove.
+func (eu *EquipmentUpdate) RemoveMoves(e ...*EquipmentMove) *EquipmentUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.RemoveMoveIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (eu *EquipmentUpdate) Save(ctx context.Context) (int, error) {
+	if _, ok := eu.mutation.UpdateTime(); !ok {
+		v := equipment.UpdateDefaultUpdateTime()
+		eu.mutation.SetUpdateTime(v)
+	}
+	if v, ok := eu.mutation.Name(); ok {
+		if err := equipment.NameValidator(v); err != nil {
+			return 0, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := eu.mutation.SerialNumber(); ok {
+		if err := equipment.SerialNumberValidator(v); err != nil {
+			return 0, &ValidationError{Name: "serial_number", err: fmt.Errorf("ent: validator failed for field \"serial_number\": %w", err)}
+		}
+	}
+	if v, ok := eu.mutation.AssetTag(); ok {
+		if err := equipment.AssetTagValidator(v); err != nil {
+			return 0, &ValidationError{Name: "asset_tag", err: fmt.Errorf("ent: validator failed for field \"asset_tag\": %w", err)}
+		}
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(eu.hooks) == 0 {
+		affected, err = eu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EquipmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			eu.mutation = mutation
+			affected, err = eu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(eu.hooks) - 1; i >= 0; i-- {
+			mut = eu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, eu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *EquipmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *EquipmentUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *EquipmentUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eu *EquipmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   equipment.Table,
+			Columns: equipment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: equipment.FieldID,
+			},
+		},
+	}
+	if ps := eu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldUpdateTime,
+		})
+	}
+	if value, ok := eu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldName,
+		})
+	}
+	if value, ok := eu.mutation.SerialNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldSerialNumber,
+		})
+	}
+	if value, ok := eu.mutation.Model(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldModel,
+		})
+	}
+	if eu.mutation.ModelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: equipment.FieldModel,
+		})
+	}
+	if value, ok := eu.mutation.AssetTag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldAssetTag,
+		})
+	}
+	if eu.mutation.AssetTagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: equipment.FieldAssetTag,
+		})
+	}
+	if value, ok := eu.mutation.WarrantyStart(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldWarrantyStart,
+		})
+	}
+	if eu.mutation.WarrantyStartCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: equipment.FieldWarrantyStart,
+		})
+	}
+	if value, ok := eu.mutation.WarrantyEnd(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldWarrantyEnd,
+		})
+	}
+	if eu.mutation.WarrantyEndCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: equipment.FieldWarrantyEnd,
+		})
+	}
+	if value, ok := eu.mutation.WarrantyProvider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldWarrantyProvider,
+		})
+	}
+	if eu.mutation.WarrantyProviderCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: equipment.FieldWarrantyProvider,
+		})
+	}
+	if eu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipment.OrganizationTable,
+			Columns: []string{equipment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipment.OrganizationTable,
+			Columns: []string{equipment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.WarrantyTermCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.WarrantyTermTable,
+			Columns: []string{equipment.WarrantyTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: warrantyterm.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.WarrantyTermIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.WarrantyTermTable,
+			Columns: []string{equipment.WarrantyTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: warrantyterm.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := eu.mutation.RemovedRepairSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.RepairSlipsTable,
+			Columns: []string{equipment.RepairSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RepairSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.RepairSlipsTable,
+			Columns: []string{equipment.RepairSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.LocationTable,
+			Columns: []string{equipment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.LocationTable,
+			Columns: []string{equipment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := eu.mutation.RemovedMovesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.MovesTable,
+			Columns: []string{equipment.MovesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipmentmove.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.MovesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.MovesTable,
+			Columns: []string{equipment.MovesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipmentmove.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{equipment.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// EquipmentUpdateOne is the builder for updating a single Equipment entity.
+type EquipmentUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *EquipmentMutation
+}
+
+// SetName sets the name field.
+func (euo *EquipmentUpdateOne) SetName(s string) *EquipmentUpdateOne {
+	euo.mutation.SetName(s)
+	return euo
+}
+
+// SetSerialNumber sets the serial_number field.
+func (euo *EquipmentUpdateOne) SetSerialNumber(s string) *EquipmentUpdateOne {
+	euo.mutation.SetSerialNumber(s)
+	return euo
+}
+
+// SetModel sets the model field.
+func (euo *EquipmentUpdateOne) SetModel(s string) *EquipmentUpdateOne {
+	euo.mutation.SetModel(s)
+	return euo
+}
+
+// SetNillableModel sets the model field if the given value is not nil.
+func (euo *EquipmentUpdateOne) SetNillableModel(s *string) *EquipmentUpdateOne {
+	if s != nil {
+		euo.SetModel(*s)
+	}
+	return euo
+}
+
+// ClearModel clears the value of model.
+func (euo *EquipmentUpdateOne) ClearModel() *EquipmentUpdateOne {
+	euo.mutation.ClearModel()
+	return euo
+}
+
+// SetAssetTag sets the asset_tag field.
+func (euo *EquipmentUpdateOne) SetAssetTag(s string) *EquipmentUpdateOne {
+	euo.mutation.SetAssetTag(s)
+	return euo
+}
+
+// SetNillableAssetTag sets the asset_tag field if the given value is not nil.
+func (euo *EquipmentUpdateOne) SetNillableAssetTag(s *string) *EquipmentUpdateOne {
+	if s != nil {
+		euo.SetAssetTag(*s)
+	}
+	return euo
+}
+
+// ClearAssetTag clears the value of asset_tag.
+func (euo *EquipmentUpdateOne) ClearAssetTag() *EquipmentUpdateOne {
+	euo.mutation.ClearAssetTag()
+	return euo
+}
+
+// SetWarrantyStart sets the warranty_start field.
+func (euo *EquipmentUpdateOne) SetWarrantyStart(t time.Time) *EquipmentUpdateOne {
+	euo.mutation.SetWarrantyStart(t)
+	return euo
+}
+
+// SetNillableWarrantyStart sets the warranty_start field if the given value is not nil.
+func (euo *EquipmentUpdateOne) SetNillableWarrantyStart(t *time.Time) *EquipmentUpdateOne {
+	if t != nil {
+		euo.SetWarrantyStart(*t)
+	}
+	return euo
+}
+
+// ClearWarrantyStart clears the value of warranty_start.
+func (euo *EquipmentUpdateOne) ClearWarrantyStart() *EquipmentUpdateOne {
+	euo.mutation.ClearWarrantyStart()
+	return euo
+}
+
+// SetWarrantyEnd sets the warranty_end field.
+func (euo *EquipmentUpdateOne) SetWarrantyEnd(t time.Time) *EquipmentUpdateOne {
+	euo.mutation.SetWarrantyEnd(t)
+	return euo
+}
+
+// SetNillableWarrantyEnd sets the warranty_end field if the given value is not nil.
+func (euo *EquipmentUpdateOne) SetNillableWarrantyEnd(t *time.Time) *EquipmentUpdateOne {
+	if t != nil {
+		euo.SetWarrantyEnd(*t)
+	}
+	return euo
+}
+
+// ClearWarrantyEnd clears the value of warranty_end.
+func (euo *EquipmentUpdateOne) ClearWarrantyEnd() *EquipmentUpdateOne {
+	euo.mutation.ClearWarrantyEnd()
+	return euo
+}
+
+// SetWarrantyProvider sets the warranty_provider field.
+func (euo *EquipmentUpdateOne) SetWarrantyProvider(s string) *EquipmentUpdateOne {
+	euo.mutation.SetWarrantyProvider(s)
+	return euo
+}
+
+// SetNillableWarrantyProvider sets the warranty_provider field if the given value is not nil.
+func (euo *EquipmentUpdateOne) SetNillableWarrantyProvider(s *string) *EquipmentUpdateOne {
+	if s != nil {
+		euo.SetWarrantyProvider(*s)
+	}
+	return euo
+}
+
+// ClearWarrantyProvider clears the value of warranty_provider.
+func (euo *EquipmentUpdateOne) ClearWarrantyProvider() *EquipmentUpdateOne {
+	euo.mutation.ClearWarrantyProvider()
+	return euo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (euo *EquipmentUpdateOne) SetOrganizationID(id int) *EquipmentUpdateOne {
+	euo.mutation.SetOrganizationID(id)
+	return euo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (euo *EquipmentUpdateOne) SetNillableOrganizationID(id *int) *EquipmentUpdateOne {
+	if id != nil {
+		euo = euo.SetOrganizationID(*id)
+	}
+	return euo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (euo *EquipmentUpdateOne) SetOrganization(o *Organization) *EquipmentUpdateOne {
+	return euo.SetOrganizationID(o.ID)
+}
+
+// SetWarrantyTermID sets the warranty_term edge to WarrantyTerm by id.
+func (euo *EquipmentUpdateOne) SetWarrantyTermID(id int) *EquipmentUpdateOne {
+	euo.mutation.SetWarrantyTermID(id)
+	return euo
+}
+
+// SetNillableWarrantyTermID sets the warranty_term edge to WarrantyTerm by id if the given value is not nil.
+func (euo *EquipmentUpdateOne) SetNillableWarrantyTermID(id *int) *EquipmentUpdateOne {
+	if id != nil {
+		euo = euo.SetWarrantyTermID(*id)
+	}
+	return euo
+}
+
+// SetWarrantyTerm sets the warranty_term edge to WarrantyTerm.
+func (euo *EquipmentUpdateOne) SetWarrantyTerm(w *WarrantyTerm) *EquipmentUpdateOne {
+	return euo.SetWarrantyTermID(w.ID)
+}
+
+// AddRepairSlipIDs adds the repair_slips edge to RepairSlip by ids.
+func (euo *EquipmentUpdateOne) AddRepairSlipIDs(ids ...int) *EquipmentUpdateOne {
+	euo.mutation.AddRepairSlipIDs(ids...)
+	return euo
+}
+
+// AddRepairSlips adds the repair_slips edges to RepairSlip.
+func (euo *EquipmentUpdateOne) AddRepairSlips(r ...*RepairSlip) *EquipmentUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddRepairSlipIDs(ids...)
+}
+
+// SetLocationID sets the location edge to Location by id.
+func (euo *EquipmentUpdateOne) SetLocationID(id int) *EquipmentUpdateOne {
+	euo.mutation.SetLocationID(id)
+	return euo
+}
+
+// SetNillableLocationID sets the location edge to Location by id if the given value is not nil.
+func (euo *EquipmentUpdateOne) SetNillableLocationID(id *int) *EquipmentUpdateOne {
+	if id != nil {
+		euo = euo.SetLocationID(*id)
+	}
+	return euo
+}
+
+// SetLocation sets the location edge to Location.
+func (euo *EquipmentUpdateOne) SetLocation(l *Location) *EquipmentUpdateOne {
+	return euo.SetLocationID(l.ID)
+}
+
+// AddMoveIDs adds the moves edge to EquipmentMove by ids.
+func (euo *EquipmentUpdateOne) AddMoveIDs(ids ...int) *EquipmentUpdateOne {
+	euo.mutation.AddMoveIDs(ids...)
+	return euo
+}
+
+// AddMoves adds the moves edges to EquipmentMove.
+func (euo *EquipmentUpdateOne) AddMoves(e ...*EquipmentMove) *EquipmentUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.AddMoveIDs(ids...)
+}
+
+// Mutation returns the EquipmentMutation object of the builder.
+func (euo *EquipmentUpdateOne) Mutation() *EquipmentMutation {
+	return euo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (euo *EquipmentUpdateOne) ClearOrganization() *EquipmentUpdateOne {
+	euo.mutation.ClearOrganization()
+	return euo
+}
+
+// ClearWarrantyTerm clears the warranty_term edge to WarrantyTerm.
+func (euo *EquipmentUpdateOne) ClearWarrantyTerm() *EquipmentUpdateOne {
+	euo.mutation.ClearWarrantyTerm()
+	return euo
+}
+
+// RemoveRepairSlipIDs removes the repair_slips edge to RepairSlip by ids.
+func (euo *EquipmentUpdateOne) RemoveRepairSlipIDs(ids ...int) *EquipmentUpdateOne {
+	euo.mutation.RemoveRepairSlipIDs(ids...)
+	return euo
+}
+
+// RemoveRepairSlips removes repair_slips edges to RepairSlip.
+func (euo *EquipmentUpdateOne) RemoveRepairSlips(r ...*RepairSlip) *EquipmentUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveRepairSlipIDs(ids...)
+}
+
+// ClearLocation clears the location edge to Location.
+func (euo *EquipmentUpdateOne) ClearLocation() *EquipmentUpdateOne {
+	euo.mutation.ClearLocation()
+	return euo
+}
+
+// RemoveMoveIDs removes the moves edge to EquipmentMove by ids.
+func (euo *EquipmentUpdateOne) RemoveMoveIDs(ids ...int) *EquipmentUpdateOne {
+	euo.mutation.RemoveMoveIDs(ids...)
+	return euo
+}
+
+// RemoveMoves removes moves edges to EquipmentMove.
+func (euo *EquipmentUpdateOne) RemoveMoves(e ...*EquipmentMove) *EquipmentUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.RemoveMoveIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (euo *EquipmentUpdateOne) Save(ctx context.Context) (*Equipment, error) {
+	if _, ok := euo.mutation.UpdateTime(); !ok {
+		v := equipment.UpdateDefaultUpdateTime()
+		euo.mutation.SetUpdateTime(v)
+	}
+	if v, ok := euo.mutation.Name(); ok {
+		if err := equipment.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := euo.mutation.SerialNumber(); ok {
+		if err := equipment.SerialNumberValidator(v); err != nil {
+			return nil, &ValidationError{Name: "serial_number", err: fmt.Errorf("ent: validator failed for field \"serial_number\": %w", err)}
+		}
+	}
+	if v, ok := euo.mutation.AssetTag(); ok {
+		if err := equipment.AssetTagValidator(v); err != nil {
+			return nil, &ValidationError{Name: "asset_tag", err: fmt.Errorf("ent: validator failed for field \"asset_tag\": %w", err)}
+		}
+	}
+
+	var (
+		err  error
+		node *Equipment
+	)
+	if len(euo.hooks) == 0 {
+		node, err = euo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EquipmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			euo.mutation = mutation
+			node, err = euo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(euo.hooks) - 1; i >= 0; i-- {
+			mut = euo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, euo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EquipmentUpdateOne) SaveX(ctx context.Context) *Equipment {
+	e, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Exec executes the query on the entity.
+func (euo *EquipmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EquipmentUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (euo *EquipmentUpdateOne) sqlSave(ctx context.Context) (e *Equipment, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   equipment.Table,
+			Columns: equipment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: equipment.FieldID,
+			},
+		},
+	}
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Equipment.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := euo.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldUpdateTime,
+		})
+	}
+	if value, ok := euo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldName,
+		})
+	}
+	if value, ok := euo.mutation.SerialNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldSerialNumber,
+		})
+	}
+	if value, ok := euo.mutation.Model(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldModel,
+		})
+	}
+	if euo.mutation.ModelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: equipment.FieldModel,
+		})
+	}
+	if value, ok := euo.mutation.AssetTag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldAssetTag,
+		})
+	}
+	if euo.mutation.AssetTagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: equipment.FieldAssetTag,
+		})
+	}
+	if value, ok := euo.mutation.WarrantyStart(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldWarrantyStart,
+		})
+	}
+	if euo.mutation.WarrantyStartCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: equipment.FieldWarrantyStart,
+		})
+	}
+	if value, ok := euo.mutation.WarrantyEnd(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipment.FieldWarrantyEnd,
+		})
+	}
+	if euo.mutation.WarrantyEndCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: equipment.FieldWarrantyEnd,
+		})
+	}
+	if value, ok := euo.mutation.WarrantyProvider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipment.FieldWarrantyProvider,
+		})
+	}
+	if euo.mutation.WarrantyProviderCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: equipment.FieldWarrantyProvider,
+		})
+	}
+	if euo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipment.OrganizationTable,
+			Columns: []string{equipment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipment.OrganizationTable,
+			Columns: []string{equipment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.WarrantyTermCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.WarrantyTermTable,
+			Columns: []string{equipment.WarrantyTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: warrantyterm.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.WarrantyTermIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.WarrantyTermTable,
+			Columns: []string{equipment.WarrantyTermColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: warrantyterm.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := euo.mutation.RemovedRepairSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.RepairSlipsTable,
+			Columns: []string{equipment.RepairSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RepairSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.RepairSlipsTable,
+			Columns: []string{equipment.RepairSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.LocationTable,
+			Columns: []string{equipment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipment.LocationTable,
+			Columns: []string{equipment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := euo.mutation.RemovedMovesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.MovesTable,
+			Columns: []string{equipment.MovesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipmentmove.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.MovesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   equipment.MovesTable,
+			Columns: []string{equipment.MovesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipmentmove.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	e = &Equipment{config: euo.config}
+	_spec.Assign = e.assignValues
+	_spec.ScanValues = e.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{equipment.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return e, nil
+}
@@ -0,0 +1,265 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// EquipmentMove is the model entity for the EquipmentMove schema.
+type EquipmentMove struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EquipmentMoveQuery when eager-loading is set.
+	Edges                        EquipmentMoveEdges `json:"edges"`
+	equipment_moves              *int
+	organization_id              *int
+	equipment_move_from_location *int
+	equipment_move_to_location   *int
+	equipment_move_moved_by      *int
+}
+
+// EquipmentMoveEdges holds the relations/edges for other nodes in the graph.
+type EquipmentMoveEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Equipment holds the value of the equipment edge.
+	Equipment *Equipment
+	// FromLocation holds the value of the from_location edge.
+	FromLocation *Location
+	// ToLocation holds the value of the to_location edge.
+	ToLocation *Location
+	// MovedBy holds the value of the moved_by edge.
+	MovedBy *User
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EquipmentMoveEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// EquipmentOrErr returns the Equipment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EquipmentMoveEdges) EquipmentOrErr() (*Equipment, error) {
+	if e.loadedTypes[1] {
+		if e.Equipment == nil {
+			// The edge equipment was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: equipment.Label}
+		}
+		return e.Equipment, nil
+	}
+	return nil, &NotLoadedError{edge: "equipment"}
+}
+
+// FromLocationOrErr returns the FromLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EquipmentMoveEdges) FromLocationOrErr() (*Location, error) {
+	if e.loadedTypes[2] {
+		if e.FromLocation == nil {
+			// The edge from_location was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: location.Label}
+		}
+		return e.FromLocation, nil
+	}
+	return nil, &NotLoadedError{edge: "from_location"}
+}
+
+// ToLocationOrErr returns the ToLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EquipmentMoveEdges) ToLocationOrErr() (*Location, error) {
+	if e.loadedTypes[3] {
+		if e.ToLocation == nil {
+			// The edge to_location was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: location.Label}
+		}
+		return e.ToLocation, nil
+	}
+	return nil, &NotLoadedError{edge: "to_location"}
+}
+
+// MovedByOrErr returns the MovedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EquipmentMoveEdges) MovedByOrErr() (*User, error) {
+	if e.loadedTypes[4] {
+		if e.MovedBy == nil {
+			// The edge moved_by was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.MovedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "moved_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EquipmentMove) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullString{}, // note
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*EquipmentMove) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // equipment_moves
+		&sql.NullInt64{}, // organization_id
+		&sql.NullInt64{}, // equipment_move_from_location
+		&sql.NullInt64{}, // equipment_move_to_location
+		&sql.NullInt64{}, // equipment_move_moved_by
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EquipmentMove fields.
+func (em *EquipmentMove) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(equipmentmove.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	em.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		em.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field note", values[1])
+	} else if value.Valid {
+		em.Note = value.String
+	}
+	values = values[2:]
+	if len(values) == len(equipmentmove.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field equipment_moves", value)
+		} else if value.Valid {
+			em.equipment_moves = new(int)
+			*em.equipment_moves = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			em.organization_id = new(int)
+			*em.organization_id = int(value.Int64)
+		}
+		if value, ok := values[2].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field equipment_move_from_location", value)
+		} else if value.Valid {
+			em.equipment_move_from_location = new(int)
+			*em.equipment_move_from_location = int(value.Int64)
+		}
+		if value, ok := values[3].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field equipment_move_to_location", value)
+		} else if value.Valid {
+			em.equipment_move_to_location = new(int)
+			*em.equipment_move_to_location = int(value.Int64)
+		}
+		if value, ok := values[4].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field equipment_move_moved_by", value)
+		} else if value.Valid {
+			em.equipment_move_moved_by = new(int)
+			*em.equipment_move_moved_by = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the EquipmentMove.
+func (em *EquipmentMove) QueryOrganization() *OrganizationQuery {
+	return (&EquipmentMoveClient{config: em.config}).QueryOrganization(em)
+}
+
+// QueryEquipment queries the equipment edge of the EquipmentMove.
+func (em *EquipmentMove) QueryEquipment() *EquipmentQuery {
+	return (&EquipmentMoveClient{config: em.config}).QueryEquipment(em)
+}
+
+// QueryFromLocation queries the from_location edge of the EquipmentMove.
+func (em *EquipmentMove) QueryFromLocation() *LocationQuery {
+	return (&EquipmentMoveClient{config: em.config}).QueryFromLocation(em)
+}
+
+// QueryToLocation queries the to_location edge of the EquipmentMove.
+func (em *EquipmentMove) QueryToLocation() *LocationQuery {
+	return (&EquipmentMoveClient{config: em.config}).QueryToLocation(em)
+}
+
+// QueryMovedBy queries the moved_by edge of the EquipmentMove.
+func (em *EquipmentMove) QueryMovedBy() *UserQuery {
+	return (&EquipmentMoveClient{config: em.config}).QueryMovedBy(em)
+}
+
+// Update returns a builder for updating this EquipmentMove.
+// Note that, you need to call EquipmentMove.Unwrap() before calling this method, if this EquipmentMove
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (em *EquipmentMove) Update() *EquipmentMoveUpdateOne {
+	return (&EquipmentMoveClient{config: em.config}).UpdateOne(em)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (em *EquipmentMove) Unwrap() *EquipmentMove {
+	tx, ok := em.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EquipmentMove is not a transactional entity")
+	}
+	em.config.driver = tx.drv
+	return em
+}
+
+// String implements the fmt.Stringer.
+func (em *EquipmentMove) String() string {
+	var builder strings.Builder
+	builder.WriteString("EquipmentMove(")
+	builder.WriteString(fmt.Sprintf("id=%v", em.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(em.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", note=")
+	builder.WriteString(em.Note)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EquipmentMoves is a parsable slice of EquipmentMove.
+type EquipmentMoves []*EquipmentMove
+
+func (em EquipmentMoves) config(cfg config) {
+	for _i := range em {
+		em[_i].config = cfg
+	}
+}
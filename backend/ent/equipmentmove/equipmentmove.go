@@ -0,0 +1,97 @@
+// Code generated by entc, DO NOT EDIT.
+
+package equipmentmove
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the equipmentmove type in the database.
+	Label = "equipment_move"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeEquipment holds the string denoting the equipment edge name in mutations.
+	EdgeEquipment = "equipment"
+	// EdgeFromLocation holds the string denoting the from_location edge name in mutations.
+	EdgeFromLocation = "from_location"
+	// EdgeToLocation holds the string denoting the to_location edge name in mutations.
+	EdgeToLocation = "to_location"
+	// EdgeMovedBy holds the string denoting the moved_by edge name in mutations.
+	EdgeMovedBy = "moved_by"
+
+	// Table holds the table name of the equipmentmove in the database.
+	Table = "equipment_moves"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "equipment_moves"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// EquipmentTable is the table the holds the equipment relation/edge.
+	EquipmentTable = "equipment_moves"
+	// EquipmentInverseTable is the table name for the Equipment entity.
+	// It exists in this package in order to avoid circular dependency with the "equipment" package.
+	EquipmentInverseTable = "equipment"
+	// EquipmentColumn is the table column denoting the equipment relation/edge.
+	EquipmentColumn = "equipment_moves"
+	// FromLocationTable is the table the holds the from_location relation/edge.
+	FromLocationTable = "equipment_moves"
+	// FromLocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	FromLocationInverseTable = "locations"
+	// FromLocationColumn is the table column denoting the from_location relation/edge.
+	FromLocationColumn = "equipment_move_from_location"
+	// ToLocationTable is the table the holds the to_location relation/edge.
+	ToLocationTable = "equipment_moves"
+	// ToLocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	ToLocationInverseTable = "locations"
+	// ToLocationColumn is the table column denoting the to_location relation/edge.
+	ToLocationColumn = "equipment_move_to_location"
+	// MovedByTable is the table the holds the moved_by relation/edge.
+	MovedByTable = "equipment_moves"
+	// MovedByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	MovedByInverseTable = "users"
+	// MovedByColumn is the table column denoting the moved_by relation/edge.
+	MovedByColumn = "equipment_move_moved_by"
+)
+
+// Columns holds all SQL columns for equipmentmove fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldNote,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the EquipmentMove type.
+var ForeignKeys = []string{
+	"equipment_moves",
+	"organization_id",
+	"equipment_move_from_location",
+	"equipment_move_to_location",
+	"equipment_move_moved_by",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+)
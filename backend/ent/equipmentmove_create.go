@@ -0,0 +1,328 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// EquipmentMoveCreate is the builder for creating a EquipmentMove entity.
+type EquipmentMoveCreate struct {
+	config
+	mutation *EquipmentMoveMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (emc *EquipmentMoveCreate) SetCreateTime(t time.Time) *EquipmentMoveCreate {
+	emc.mutation.SetCreateTime(t)
+	return emc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (emc *EquipmentMoveCreate) SetNillableCreateTime(t *time.Time) *EquipmentMoveCreate {
+	if t != nil {
+		emc.SetCreateTime(*t)
+	}
+	return emc
+}
+
+// SetNote sets the note field.
+func (emc *EquipmentMoveCreate) SetNote(s string) *EquipmentMoveCreate {
+	emc.mutation.SetNote(s)
+	return emc
+}
+
+// SetNillableNote sets the note field if the given value is not nil.
+func (emc *EquipmentMoveCreate) SetNillableNote(s *string) *EquipmentMoveCreate {
+	if s != nil {
+		emc.SetNote(*s)
+	}
+	return emc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (emc *EquipmentMoveCreate) SetOrganizationID(id int) *EquipmentMoveCreate {
+	emc.mutation.SetOrganizationID(id)
+	return emc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (emc *EquipmentMoveCreate) SetNillableOrganizationID(id *int) *EquipmentMoveCreate {
+	if id != nil {
+		emc = emc.SetOrganizationID(*id)
+	}
+	return emc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (emc *EquipmentMoveCreate) SetOrganization(o *Organization) *EquipmentMoveCreate {
+	return emc.SetOrganizationID(o.ID)
+}
+
+// SetEquipmentID sets the equipment edge to Equipment by id.
+func (emc *EquipmentMoveCreate) SetEquipmentID(id int) *EquipmentMoveCreate {
+	emc.mutation.SetEquipmentID(id)
+	return emc
+}
+
+// SetEquipment sets the equipment edge to Equipment.
+func (emc *EquipmentMoveCreate) SetEquipment(e *Equipment) *EquipmentMoveCreate {
+	return emc.SetEquipmentID(e.ID)
+}
+
+// SetFromLocationID sets the from_location edge to Location by id.
+func (emc *EquipmentMoveCreate) SetFromLocationID(id int) *EquipmentMoveCreate {
+	emc.mutation.SetFromLocationID(id)
+	return emc
+}
+
+// SetNillableFromLocationID sets the from_location edge to Location by id if the given value is not nil.
+func (emc *EquipmentMoveCreate) SetNillableFromLocationID(id *int) *EquipmentMoveCreate {
+	if id != nil {
+		emc = emc.SetFromLocationID(*id)
+	}
+	return emc
+}
+
+// SetFromLocation sets the from_location edge to Location.
+func (emc *EquipmentMoveCreate) SetFromLocation(l *Location) *EquipmentMoveCreate {
+	return emc.SetFromLocationID(l.ID)
+}
+
+// SetToLocationID sets the to_location edge to Location by id.
+func (emc *EquipmentMoveCreate) SetToLocationID(id int) *EquipmentMoveCreate {
+	emc.mutation.SetToLocationID(id)
+	return emc
+}
+
+// SetNillableToLocationID sets the to_location edge to Location by id if the given value is not nil.
+func (emc *EquipmentMoveCreate) SetNillableToLocationID(id *int) *EquipmentMoveCreate {
+	if id != nil {
+		emc = emc.SetToLocationID(*id)
+	}
+	return emc
+}
+
+// SetToLocation sets the to_location edge to Location.
+func (emc *EquipmentMoveCreate) SetToLocation(l *Location) *EquipmentMoveCreate {
+	return emc.SetToLocationID(l.ID)
+}
+
+// SetMovedByID sets the moved_by edge to User by id.
+func (emc *EquipmentMoveCreate) SetMovedByID(id int) *EquipmentMoveCreate {
+	emc.mutation.SetMovedByID(id)
+	return emc
+}
+
+// SetNillableMovedByID sets the moved_by edge to User by id if the given value is not nil.
+func (emc *EquipmentMoveCreate) SetNillableMovedByID(id *int) *EquipmentMoveCreate {
+	if id != nil {
+		emc = emc.SetMovedByID(*id)
+	}
+	return emc
+}
+
+// SetMovedBy sets the moved_by edge to User.
+func (emc *EquipmentMoveCreate) SetMovedBy(u *User) *EquipmentMoveCreate {
+	return emc.SetMovedByID(u.ID)
+}
+
+// Mutation returns the EquipmentMoveMutation object of the builder.
+func (emc *EquipmentMoveCreate) Mutation() *EquipmentMoveMutation {
+	return emc.mutation
+}
+
+// Save creates the EquipmentMove in the database.
+func (emc *EquipmentMoveCreate) Save(ctx context.Context) (*EquipmentMove, error) {
+	if _, ok := emc.mutation.CreateTime(); !ok {
+		v := equipmentmove.DefaultCreateTime()
+		emc.mutation.SetCreateTime(v)
+	}
+	if _, ok := emc.mutation.EquipmentID(); !ok {
+		return nil, &ValidationError{Name: "equipment", err: errors.New("ent: missing required edge \"equipment\"")}
+	}
+	var (
+		err  error
+		node *EquipmentMove
+	)
+	if len(emc.hooks) == 0 {
+		node, err = emc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EquipmentMoveMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			emc.mutation = mutation
+			node, err = emc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(emc.hooks) - 1; i >= 0; i-- {
+			mut = emc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, emc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (emc *EquipmentMoveCreate) SaveX(ctx context.Context) *EquipmentMove {
+	v, err := emc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (emc *EquipmentMoveCreate) sqlSave(ctx context.Context) (*EquipmentMove, error) {
+	em, _spec := emc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, emc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	em.ID = int(id)
+	return em, nil
+}
+
+func (emc *EquipmentMoveCreate) createSpec() (*EquipmentMove, *sqlgraph.CreateSpec) {
+	var (
+		em    = &EquipmentMove{config: emc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: equipmentmove.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: equipmentmove.FieldID,
+			},
+		}
+	)
+	if value, ok := emc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: equipmentmove.FieldCreateTime,
+		})
+		em.CreateTime = value
+	}
+	if value, ok := emc.mutation.Note(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: equipmentmove.FieldNote,
+		})
+		em.Note = value
+	}
+	if nodes := emc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.OrganizationTable,
+			Columns: []string{equipmentmove.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := emc.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipmentmove.EquipmentTable,
+			Columns: []string{equipmentmove.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := emc.mutation.FromLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.FromLocationTable,
+			Columns: []string{equipmentmove.FromLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := emc.mutation.ToLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.ToLocationTable,
+			Columns: []string{equipmentmove.ToLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := emc.mutation.MovedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.MovedByTable,
+			Columns: []string{equipmentmove.MovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return em, _spec
+}
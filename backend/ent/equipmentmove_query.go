@@ -0,0 +1,1167 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// EquipmentMoveQuery is the builder for querying EquipmentMove entities.
+type EquipmentMoveQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.EquipmentMove
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withEquipment    *EquipmentQuery
+	withFromLocation *LocationQuery
+	withToLocation   *LocationQuery
+	withMovedBy      *UserQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (emq *EquipmentMoveQuery) Where(ps ...predicate.EquipmentMove) *EquipmentMoveQuery {
+	emq.predicates = append(emq.predicates, ps...)
+	return emq
+}
+
+// Limit adds a limit step to the query.
+func (emq *EquipmentMoveQuery) Limit(limit int) *EquipmentMoveQuery {
+	emq.limit = &limit
+	return emq
+}
+
+// Offset adds an offset step to the query.
+func (emq *EquipmentMoveQuery) Offset(offset int) *EquipmentMoveQuery {
+	emq.offset = &offset
+	return emq
+}
+
+// Order adds an order step to the query.
+func (emq *EquipmentMoveQuery) Order(o ...OrderFunc) *EquipmentMoveQuery {
+	emq.order = append(emq.order, o...)
+	return emq
+}
+
+// QueryOrganization chains the current query on the organization edge.
+func (emq *EquipmentMoveQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: emq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := emq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(equipmentmove.Table, equipmentmove.FieldID, emq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, equipmentmove.OrganizationTable, equipmentmove.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(emq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEquipment chains the current query on the equipment edge.
+func (emq *EquipmentMoveQuery) QueryEquipment() *EquipmentQuery {
+	query := &EquipmentQuery{config: emq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := emq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(equipmentmove.Table, equipmentmove.FieldID, emq.sqlQuery()),
+			sqlgraph.To(equipment.Table, equipment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, equipmentmove.EquipmentTable, equipmentmove.EquipmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(emq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFromLocation chains the current query on the from_location edge.
+func (emq *EquipmentMoveQuery) QueryFromLocation() *LocationQuery {
+	query := &LocationQuery{config: emq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := emq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(equipmentmove.Table, equipmentmove.FieldID, emq.sqlQuery()),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, equipmentmove.FromLocationTable, equipmentmove.FromLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(emq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryToLocation chains the current query on the to_location edge.
+func (emq *EquipmentMoveQuery) QueryToLocation() *LocationQuery {
+	query := &LocationQuery{config: emq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := emq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(equipmentmove.Table, equipmentmove.FieldID, emq.sqlQuery()),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, equipmentmove.ToLocationTable, equipmentmove.ToLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(emq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMovedBy chains the current query on the moved_by edge.
+func (emq *EquipmentMoveQuery) QueryMovedBy() *UserQuery {
+	query := &UserQuery{config: emq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := emq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(equipmentmove.Table, equipmentmove.FieldID, emq.sqlQuery()),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, equipmentmove.MovedByTable, equipmentmove.MovedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(emq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EquipmentMove entity in the query. Returns *NotFoundError when no equipmentmove was found.
+func (emq *EquipmentMoveQuery) First(ctx context.Context) (*EquipmentMove, error) {
+	ems, err := emq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ems) == 0 {
+		return nil, &NotFoundError{equipmentmove.Label}
+	}
+	return ems[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (emq *EquipmentMoveQuery) FirstX(ctx context.Context) *EquipmentMove {
+	em, err := emq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return em
+}
+
+// FirstID returns the first EquipmentMove id in the query. Returns *NotFoundError when no id was found.
+func (emq *EquipmentMoveQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = emq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{equipmentmove.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (emq *EquipmentMoveQuery) FirstXID(ctx context.Context) int {
+	id, err := emq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only EquipmentMove entity in the query, returns an error if not exactly one entity was returned.
+func (emq *EquipmentMoveQuery) Only(ctx context.Context) (*EquipmentMove, error) {
+	ems, err := emq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(ems) {
+	case 1:
+		return ems[0], nil
+	case 0:
+		return nil, &NotFoundError{equipmentmove.Label}
+	default:
+		return nil, &NotSingularError{equipmentmove.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (emq *EquipmentMoveQuery) OnlyX(ctx context.Context) *EquipmentMove {
+	em, err := emq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return em
+}
+
+// OnlyID returns the only EquipmentMove id in the query, returns an error if not exactly one id was returned.
+func (emq *EquipmentMoveQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = emq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{equipmentmove.Label}
+	default:
+		err = &NotSingularError{equipmentmove.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (emq *EquipmentMoveQuery) OnlyIDX(ctx context.Context) int {
+	id, err := emq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EquipmentMoves.
+func (emq *EquipmentMoveQuery) All(ctx context.Context) ([]*EquipmentMove, error) {
+	if err := emq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return emq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (emq *EquipmentMoveQuery) AllX(ctx context.Context) []*EquipmentMove {
+	ems, err := emq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ems
+}
+
+// IDs executes the query and returns a list of EquipmentMove ids.
+func (emq *EquipmentMoveQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := emq.Select(equipmentmove.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (emq *EquipmentMoveQuery) IDsX(ctx context.Context) []int {
+	ids, err := emq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (emq *EquipmentMoveQuery) Count(ctx context.Context) (int, error) {
+	if err := emq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return emq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (emq *EquipmentMoveQuery) CountX(ctx context.Context) int {
+	count, err := emq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (emq *EquipmentMoveQuery) Exist(ctx context.Context) (bool, error) {
+	if err := emq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return emq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (emq *EquipmentMoveQuery) ExistX(ctx context.Context) bool {
+	exist, err := emq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (emq *EquipmentMoveQuery) Clone() *EquipmentMoveQuery {
+	return &EquipmentMoveQuery{
+		config:     emq.config,
+		limit:      emq.limit,
+		offset:     emq.offset,
+		order:      append([]OrderFunc{}, emq.order...),
+		unique:     append([]string{}, emq.unique...),
+		predicates: append([]predicate.EquipmentMove{}, emq.predicates...),
+		// clone intermediate query.
+		sql:  emq.sql.Clone(),
+		path: emq.path,
+	}
+}
+
+//  WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (emq *EquipmentMoveQuery) WithOrganization(opts ...func(*OrganizationQuery)) *EquipmentMoveQuery {
+	query := &OrganizationQuery{config: emq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	emq.withOrganization = query
+	return emq
+}
+
+//  WithEquipment tells the query-builder to eager-loads the nodes that are connected to
+// the "equipment" edge. The optional arguments used to configure the query builder of the edge.
+func (emq *EquipmentMoveQuery) WithEquipment(opts ...func(*EquipmentQuery)) *EquipmentMoveQuery {
+	query := &EquipmentQuery{config: emq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	emq.withEquipment = query
+	return emq
+}
+
+//  WithFromLocation tells the query-builder to eager-loads the nodes that are connected to
+// the "from_location" edge. The optional arguments used to configure the query builder of the edge.
+func (emq *EquipmentMoveQuery) WithFromLocation(opts ...func(*LocationQuery)) *EquipmentMoveQuery {
+	query := &LocationQuery{config: emq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	emq.withFromLocation = query
+	return emq
+}
+
+//  WithToLocation tells the query-builder to eager-loads the nodes that are connected to
+// the "to_location" edge. The optional arguments used to configure the query builder of the edge.
+func (emq *EquipmentMoveQuery) WithToLocation(opts ...func(*LocationQuery)) *EquipmentMoveQuery {
+	query := &LocationQuery{config: emq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	emq.withToLocation = query
+	return emq
+}
+
+//  WithMovedBy tells the query-builder to eager-loads the nodes that are connected to
+// the "moved_by" edge. The optional arguments used to configure the query builder of the edge.
+func (emq *EquipmentMoveQuery) WithMovedBy(opts ...func(*UserQuery)) *EquipmentMoveQuery {
+	query := &UserQuery{config: emq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	emq.withMovedBy = query
+	return emq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EquipmentMove.Query().
+//		GroupBy(equipmentmove.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (emq *EquipmentMoveQuery) GroupBy(field string, fields ...string) *EquipmentMoveGroupBy {
+	group := &EquipmentMoveGroupBy{config: emq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := emq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return emq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.EquipmentMove.Query().
+//		Select(equipmentmove.FieldCreateTime).
+//		Scan(ctx, &v)
+func (emq *EquipmentMoveQuery) Select(field string, fields ...string) *EquipmentMoveSelect {
+	selector := &EquipmentMoveSelect{config: emq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := emq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return emq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (emq *EquipmentMoveQuery) prepareQuery(ctx context.Context) error {
+	if emq.path != nil {
+		prev, err := emq.path(ctx)
+		if err != nil {
+			return err
+		}
+		emq.sql = prev
+	}
+	if err := equipmentmove.Policy.EvalQuery(ctx, emq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (emq *EquipmentMoveQuery) sqlAll(ctx context.Context) ([]*EquipmentMove, error) {
+	var (
+		nodes       = []*EquipmentMove{}
+		withFKs     = emq.withFKs
+		_spec       = emq.querySpec()
+		loadedTypes = [5]bool{
+			emq.withOrganization != nil,
+			emq.withEquipment != nil,
+			emq.withFromLocation != nil,
+			emq.withToLocation != nil,
+			emq.withMovedBy != nil,
+		}
+	)
+	if emq.withOrganization != nil || emq.withEquipment != nil || emq.withFromLocation != nil || emq.withToLocation != nil || emq.withMovedBy != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, equipmentmove.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &EquipmentMove{config: emq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, emq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := emq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*EquipmentMove)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	if query := emq.withEquipment; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*EquipmentMove)
+		for i := range nodes {
+			if fk := nodes[i].equipment_moves; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(equipment.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "equipment_moves" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Equipment = n
+			}
+		}
+	}
+
+	if query := emq.withFromLocation; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*EquipmentMove)
+		for i := range nodes {
+			if fk := nodes[i].equipment_move_from_location; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(location.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "equipment_move_from_location" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.FromLocation = n
+			}
+		}
+	}
+
+	if query := emq.withToLocation; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*EquipmentMove)
+		for i := range nodes {
+			if fk := nodes[i].equipment_move_to_location; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(location.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "equipment_move_to_location" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.ToLocation = n
+			}
+		}
+	}
+
+	if query := emq.withMovedBy; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*EquipmentMove)
+		for i := range nodes {
+			if fk := nodes[i].equipment_move_moved_by; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "equipment_move_moved_by" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.MovedBy = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (emq *EquipmentMoveQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := emq.querySpec()
+	return sqlgraph.CountNodes(ctx, emq.driver, _spec)
+}
+
+func (emq *EquipmentMoveQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := emq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (emq *EquipmentMoveQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   equipmentmove.Table,
+			Columns: equipmentmove.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: equipmentmove.FieldID,
+			},
+		},
+		From:   emq.sql,
+		Unique: true,
+	}
+	if ps := emq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := emq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := emq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := emq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (emq *EquipmentMoveQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(emq.driver.Dialect())
+	t1 := builder.Table(equipmentmove.Table)
+	selector := builder.Select(t1.Columns(equipmentmove.Columns...)...).From(t1)
+	if emq.sql != nil {
+		selector = emq.sql
+		selector.Select(selector.Columns(equipmentmove.Columns...)...)
+	}
+	for _, p := range emq.predicates {
+		p(selector)
+	}
+	for _, p := range emq.order {
+		p(selector)
+	}
+	if offset := emq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := emq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EquipmentMoveGroupBy is the builder for group-by EquipmentMove entities.
+type EquipmentMoveGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (emgb *EquipmentMoveGroupBy) Aggregate(fns ...AggregateFunc) *EquipmentMoveGroupBy {
+	emgb.fns = append(emgb.fns, fns...)
+	return emgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (emgb *EquipmentMoveGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := emgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	emgb.sql = query
+	return emgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (emgb *EquipmentMoveGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := emgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (emgb *EquipmentMoveGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(emgb.fields) > 1 {
+		return nil, errors.New("ent: EquipmentMoveGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := emgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (emgb *EquipmentMoveGroupBy) StringsX(ctx context.Context) []string {
+	v, err := emgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (emgb *EquipmentMoveGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = emgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{equipmentmove.Label}
+	default:
+		err = fmt.Errorf("ent: EquipmentMoveGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (emgb *EquipmentMoveGroupBy) StringX(ctx context.Context) string {
+	v, err := emgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (emgb *EquipmentMoveGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(emgb.fields) > 1 {
+		return nil, errors.New("ent: EquipmentMoveGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := emgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (emgb *EquipmentMoveGroupBy) IntsX(ctx context.Context) []int {
+	v, err := emgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (emgb *EquipmentMoveGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = emgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{equipmentmove.Label}
+	default:
+		err = fmt.Errorf("ent: EquipmentMoveGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (emgb *EquipmentMoveGroupBy) IntX(ctx context.Context) int {
+	v, err := emgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (emgb *EquipmentMoveGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(emgb.fields) > 1 {
+		return nil, errors.New("ent: EquipmentMoveGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := emgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (emgb *EquipmentMoveGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := emgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (emgb *EquipmentMoveGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = emgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{equipmentmove.Label}
+	default:
+		err = fmt.Errorf("ent: EquipmentMoveGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (emgb *EquipmentMoveGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := emgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (emgb *EquipmentMoveGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(emgb.fields) > 1 {
+		return nil, errors.New("ent: EquipmentMoveGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := emgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (emgb *EquipmentMoveGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := emgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (emgb *EquipmentMoveGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = emgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{equipmentmove.Label}
+	default:
+		err = fmt.Errorf("ent: EquipmentMoveGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (emgb *EquipmentMoveGroupBy) BoolX(ctx context.Context) bool {
+	v, err := emgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (emgb *EquipmentMoveGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := emgb.sqlQuery().Query()
+	if err := emgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (emgb *EquipmentMoveGroupBy) sqlQuery() *sql.Selector {
+	selector := emgb.sql
+	columns := make([]string, 0, len(emgb.fields)+len(emgb.fns))
+	columns = append(columns, emgb.fields...)
+	for _, fn := range emgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(emgb.fields...)
+}
+
+// EquipmentMoveSelect is the builder for select fields of EquipmentMove entities.
+type EquipmentMoveSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (ems *EquipmentMoveSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := ems.path(ctx)
+	if err != nil {
+		return err
+	}
+	ems.sql = query
+	return ems.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ems *EquipmentMoveSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ems.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (ems *EquipmentMoveSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ems.fields) > 1 {
+		return nil, errors.New("ent: EquipmentMoveSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ems.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ems *EquipmentMoveSelect) StringsX(ctx context.Context) []string {
+	v, err := ems.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (ems *EquipmentMoveSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ems.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{equipmentmove.Label}
+	default:
+		err = fmt.Errorf("ent: EquipmentMoveSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ems *EquipmentMoveSelect) StringX(ctx context.Context) string {
+	v, err := ems.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (ems *EquipmentMoveSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ems.fields) > 1 {
+		return nil, errors.New("ent: EquipmentMoveSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ems.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ems *EquipmentMoveSelect) IntsX(ctx context.Context) []int {
+	v, err := ems.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (ems *EquipmentMoveSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ems.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{equipmentmove.Label}
+	default:
+		err = fmt.Errorf("ent: EquipmentMoveSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ems *EquipmentMoveSelect) IntX(ctx context.Context) int {
+	v, err := ems.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (ems *EquipmentMoveSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ems.fields) > 1 {
+		return nil, errors.New("ent: EquipmentMoveSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ems.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ems *EquipmentMoveSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ems.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (ems *EquipmentMoveSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ems.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{equipmentmove.Label}
+	default:
+		err = fmt.Errorf("ent: EquipmentMoveSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ems *EquipmentMoveSelect) Float64X(ctx context.Context) float64 {
+	v, err := ems.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (ems *EquipmentMoveSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ems.fields) > 1 {
+		return nil, errors.New("ent: EquipmentMoveSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ems.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ems *EquipmentMoveSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ems.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (ems *EquipmentMoveSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ems.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{equipmentmove.Label}
+	default:
+		err = fmt.Errorf("ent: EquipmentMoveSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ems *EquipmentMoveSelect) BoolX(ctx context.Context) bool {
+	v, err := ems.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ems *EquipmentMoveSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ems.sqlQuery().Query()
+	if err := ems.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ems *EquipmentMoveSelect) sqlQuery() sql.Querier {
+	selector := ems.sql
+	selector.Select(selector.Columns(ems.fields...)...)
+	return selector
+}
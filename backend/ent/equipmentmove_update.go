@@ -0,0 +1,817 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// EquipmentMoveUpdate is the builder for updating EquipmentMove entities.
+type EquipmentMoveUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *EquipmentMoveMutation
+	predicates []predicate.EquipmentMove
+}
+
+// Where adds a new predicate for the builder.
+func (emu *EquipmentMoveUpdate) Where(ps ...predicate.EquipmentMove) *EquipmentMoveUpdate {
+	emu.predicates = append(emu.predicates, ps...)
+	return emu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (emu *EquipmentMoveUpdate) SetOrganizationID(id int) *EquipmentMoveUpdate {
+	emu.mutation.SetOrganizationID(id)
+	return emu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (emu *EquipmentMoveUpdate) SetNillableOrganizationID(id *int) *EquipmentMoveUpdate {
+	if id != nil {
+		emu = emu.SetOrganizationID(*id)
+	}
+	return emu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (emu *EquipmentMoveUpdate) SetOrganization(o *Organization) *EquipmentMoveUpdate {
+	return emu.SetOrganizationID(o.ID)
+}
+
+// SetEquipmentID sets the equipment edge to Equipment by id.
+func (emu *EquipmentMoveUpdate) SetEquipmentID(id int) *EquipmentMoveUpdate {
+	emu.mutation.SetEquipmentID(id)
+	return emu
+}
+
+// SetEquipment sets the equipment edge to Equipment.
+func (emu *EquipmentMoveUpdate) SetEquipment(e *Equipment) *EquipmentMoveUpdate {
+	return emu.SetEquipmentID(e.ID)
+}
+
+// SetFromLocationID sets the from_location edge to Location by id.
+func (emu *EquipmentMoveUpdate) SetFromLocationID(id int) *EquipmentMoveUpdate {
+	emu.mutation.SetFromLocationID(id)
+	return emu
+}
+
+// SetNillableFromLocationID sets the from_location edge to Location by id if the given value is not nil.
+func (emu *EquipmentMoveUpdate) SetNillableFromLocationID(id *int) *EquipmentMoveUpdate {
+	if id != nil {
+		emu = emu.SetFromLocationID(*id)
+	}
+	return emu
+}
+
+// SetFromLocation sets the from_location edge to Location.
+func (emu *EquipmentMoveUpdate) SetFromLocation(l *Location) *EquipmentMoveUpdate {
+	return emu.SetFromLocationID(l.ID)
+}
+
+// SetToLocationID sets the to_location edge to Location by id.
+func (emu *EquipmentMoveUpdate) SetToLocationID(id int) *EquipmentMoveUpdate {
+	emu.mutation.SetToLocationID(id)
+	return emu
+}
+
+// SetNillableToLocationID sets the to_location edge to Location by id if the given value is not nil.
+func (emu *EquipmentMoveUpdate) SetNillableToLocationID(id *int) *EquipmentMoveUpdate {
+	if id != nil {
+		emu = emu.SetToLocationID(*id)
+	}
+	return emu
+}
+
+// SetToLocation sets the to_location edge to Location.
+func (emu *EquipmentMoveUpdate) SetToLocation(l *Location) *EquipmentMoveUpdate {
+	return emu.SetToLocationID(l.ID)
+}
+
+// SetMovedByID sets the moved_by edge to User by id.
+func (emu *EquipmentMoveUpdate) SetMovedByID(id int) *EquipmentMoveUpdate {
+	emu.mutation.SetMovedByID(id)
+	return emu
+}
+
+// SetNillableMovedByID sets the moved_by edge to User by id if the given value is not nil.
+func (emu *EquipmentMoveUpdate) SetNillableMovedByID(id *int) *EquipmentMoveUpdate {
+	if id != nil {
+		emu = emu.SetMovedByID(*id)
+	}
+	return emu
+}
+
+// SetMovedBy sets the moved_by edge to User.
+func (emu *EquipmentMoveUpdate) SetMovedBy(u *User) *EquipmentMoveUpdate {
+	return emu.SetMovedByID(u.ID)
+}
+
+// Mutation returns the EquipmentMoveMutation object of the builder.
+func (emu *EquipmentMoveUpdate) Mutation() *EquipmentMoveMutation {
+	return emu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (emu *EquipmentMoveUpdate) ClearOrganization() *EquipmentMoveUpdate {
+	emu.mutation.ClearOrganization()
+	return emu
+}
+
+// ClearEquipment clears the equipment edge to Equipment.
+func (emu *EquipmentMoveUpdate) ClearEquipment() *EquipmentMoveUpdate {
+	emu.mutation.ClearEquipment()
+	return emu
+}
+
+// ClearFromLocation clears the from_location edge to Location.
+func (emu *EquipmentMoveUpdate) ClearFromLocation() *EquipmentMoveUpdate {
+	emu.mutation.ClearFromLocation()
+	return emu
+}
+
+// ClearToLocation clears the to_location edge to Location.
+func (emu *EquipmentMoveUpdate) ClearToLocation() *EquipmentMoveUpdate {
+	emu.mutation.ClearToLocation()
+	return emu
+}
+
+// ClearMovedBy clears the moved_by edge to User.
+func (emu *EquipmentMoveUpdate) ClearMovedBy() *EquipmentMoveUpdate {
+	emu.mutation.ClearMovedBy()
+	return emu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (emu *EquipmentMoveUpdate) Save(ctx context.Context) (int, error) {
+
+	if _, ok := emu.mutation.EquipmentID(); emu.mutation.EquipmentCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"equipment\"")
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(emu.hooks) == 0 {
+		affected, err = emu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EquipmentMoveMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			emu.mutation = mutation
+			affected, err = emu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(emu.hooks) - 1; i >= 0; i-- {
+			mut = emu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, emu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (emu *EquipmentMoveUpdate) SaveX(ctx context.Context) int {
+	affected, err := emu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (emu *EquipmentMoveUpdate) Exec(ctx context.Context) error {
+	_, err := emu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (emu *EquipmentMoveUpdate) ExecX(ctx context.Context) {
+	if err := emu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (emu *EquipmentMoveUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   equipmentmove.Table,
+			Columns: equipmentmove.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: equipmentmove.FieldID,
+			},
+		},
+	}
+	if ps := emu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if emu.mutation.NoteCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: equipmentmove.FieldNote,
+		})
+	}
+	if emu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.OrganizationTable,
+			Columns: []string{equipmentmove.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.OrganizationTable,
+			Columns: []string{equipmentmove.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if emu.mutation.EquipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipmentmove.EquipmentTable,
+			Columns: []string{equipmentmove.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emu.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipmentmove.EquipmentTable,
+			Columns: []string{equipmentmove.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if emu.mutation.FromLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.FromLocationTable,
+			Columns: []string{equipmentmove.FromLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emu.mutation.FromLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.FromLocationTable,
+			Columns: []string{equipmentmove.FromLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if emu.mutation.ToLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.ToLocationTable,
+			Columns: []string{equipmentmove.ToLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emu.mutation.ToLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.ToLocationTable,
+			Columns: []string{equipmentmove.ToLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if emu.mutation.MovedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.MovedByTable,
+			Columns: []string{equipmentmove.MovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emu.mutation.MovedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.MovedByTable,
+			Columns: []string{equipmentmove.MovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, emu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{equipmentmove.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// EquipmentMoveUpdateOne is the builder for updating a single EquipmentMove entity.
+type EquipmentMoveUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *EquipmentMoveMutation
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (emuo *EquipmentMoveUpdateOne) SetOrganizationID(id int) *EquipmentMoveUpdateOne {
+	emuo.mutation.SetOrganizationID(id)
+	return emuo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (emuo *EquipmentMoveUpdateOne) SetNillableOrganizationID(id *int) *EquipmentMoveUpdateOne {
+	if id != nil {
+		emuo = emuo.SetOrganizationID(*id)
+	}
+	return emuo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (emuo *EquipmentMoveUpdateOne) SetOrganization(o *Organization) *EquipmentMoveUpdateOne {
+	return emuo.SetOrganizationID(o.ID)
+}
+
+// SetEquipmentID sets the equipment edge to Equipment by id.
+func (emuo *EquipmentMoveUpdateOne) SetEquipmentID(id int) *EquipmentMoveUpdateOne {
+	emuo.mutation.SetEquipmentID(id)
+	return emuo
+}
+
+// SetEquipment sets the equipment edge to Equipment.
+func (emuo *EquipmentMoveUpdateOne) SetEquipment(e *Equipment) *EquipmentMoveUpdateOne {
+	return emuo.SetEquipmentID(e.ID)
+}
+
+// SetFromLocationID sets the from_location edge to Location by id.
+func (emuo *EquipmentMoveUpdateOne) SetFromLocationID(id int) *EquipmentMoveUpdateOne {
+	emuo.mutation.SetFromLocationID(id)
+	return emuo
+}
+
+// SetNillableFromLocationID sets the from_location edge to Location by id if the given value is not nil.
+func (emuo *EquipmentMoveUpdateOne) SetNillableFromLocationID(id *int) *EquipmentMoveUpdateOne {
+	if id != nil {
+		emuo = emuo.SetFromLocationID(*id)
+	}
+	return emuo
+}
+
+// SetFromLocation sets the from_location edge to Location.
+func (emuo *EquipmentMoveUpdateOne) SetFromLocation(l *Location) *EquipmentMoveUpdateOne {
+	return emuo.SetFromLocationID(l.ID)
+}
+
+// SetToLocationID sets the to_location edge to Location by id.
+func (emuo *EquipmentMoveUpdateOne) SetToLocationID(id int) *EquipmentMoveUpdateOne {
+	emuo.mutation.SetToLocationID(id)
+	return emuo
+}
+
+// SetNillableToLocationID sets the to_location edge to Location by id if the given value is not nil.
+func (emuo *EquipmentMoveUpdateOne) SetNillableToLocationID(id *int) *EquipmentMoveUpdateOne {
+	if id != nil {
+		emuo = emuo.SetToLocationID(*id)
+	}
+	return emuo
+}
+
+// SetToLocation sets the to_location edge to Location.
+func (emuo *EquipmentMoveUpdateOne) SetToLocation(l *Location) *EquipmentMoveUpdateOne {
+	return emuo.SetToLocationID(l.ID)
+}
+
+// SetMovedByID sets the moved_by edge to User by id.
+func (emuo *EquipmentMoveUpdateOne) SetMovedByID(id int) *EquipmentMoveUpdateOne {
+	emuo.mutation.SetMovedByID(id)
+	return emuo
+}
+
+// SetNillableMovedByID sets the moved_by edge to User by id if the given value is not nil.
+func (emuo *EquipmentMoveUpdateOne) SetNillableMovedByID(id *int) *EquipmentMoveUpdateOne {
+	if id != nil {
+		emuo = emuo.SetMovedByID(*id)
+	}
+	return emuo
+}
+
+// SetMovedBy sets the moved_by edge to User.
+func (emuo *EquipmentMoveUpdateOne) SetMovedBy(u *User) *EquipmentMoveUpdateOne {
+	return emuo.SetMovedByID(u.ID)
+}
+
+// Mutation returns the EquipmentMoveMutation object of the builder.
+func (emuo *EquipmentMoveUpdateOne) Mutation() *EquipmentMoveMutation {
+	return emuo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (emuo *EquipmentMoveUpdateOne) ClearOrganization() *EquipmentMoveUpdateOne {
+	emuo.mutation.ClearOrganization()
+	return emuo
+}
+
+// ClearEquipment clears the equipment edge to Equipment.
+func (emuo *EquipmentMoveUpdateOne) ClearEquipment() *EquipmentMoveUpdateOne {
+	emuo.mutation.ClearEquipment()
+	return emuo
+}
+
+// ClearFromLocation clears the from_location edge to Location.
+func (emuo *EquipmentMoveUpdateOne) ClearFromLocation() *EquipmentMoveUpdateOne {
+	emuo.mutation.ClearFromLocation()
+	return emuo
+}
+
+// ClearToLocation clears the to_location edge to Location.
+func (emuo *EquipmentMoveUpdateOne) ClearToLocation() *EquipmentMoveUpdateOne {
+	emuo.mutation.ClearToLocation()
+	return emuo
+}
+
+// ClearMovedBy clears the moved_by edge to User.
+func (emuo *EquipmentMoveUpdateOne) ClearMovedBy() *EquipmentMoveUpdateOne {
+	emuo.mutation.ClearMovedBy()
+	return emuo
+}
+
+// Save executes the query and returns the updated entity.
+func (emuo *EquipmentMoveUpdateOne) Save(ctx context.Context) (*EquipmentMove, error) {
+
+	if _, ok := emuo.mutation.EquipmentID(); emuo.mutation.EquipmentCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"equipment\"")
+	}
+
+	var (
+		err  error
+		node *EquipmentMove
+	)
+	if len(emuo.hooks) == 0 {
+		node, err = emuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EquipmentMoveMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			emuo.mutation = mutation
+			node, err = emuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(emuo.hooks) - 1; i >= 0; i-- {
+			mut = emuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, emuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (emuo *EquipmentMoveUpdateOne) SaveX(ctx context.Context) *EquipmentMove {
+	em, err := emuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return em
+}
+
+// Exec executes the query on the entity.
+func (emuo *EquipmentMoveUpdateOne) Exec(ctx context.Context) error {
+	_, err := emuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (emuo *EquipmentMoveUpdateOne) ExecX(ctx context.Context) {
+	if err := emuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (emuo *EquipmentMoveUpdateOne) sqlSave(ctx context.Context) (em *EquipmentMove, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   equipmentmove.Table,
+			Columns: equipmentmove.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: equipmentmove.FieldID,
+			},
+		},
+	}
+	id, ok := emuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing EquipmentMove.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if emuo.mutation.NoteCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: equipmentmove.FieldNote,
+		})
+	}
+	if emuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.OrganizationTable,
+			Columns: []string{equipmentmove.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.OrganizationTable,
+			Columns: []string{equipmentmove.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if emuo.mutation.EquipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipmentmove.EquipmentTable,
+			Columns: []string{equipmentmove.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emuo.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   equipmentmove.EquipmentTable,
+			Columns: []string{equipmentmove.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if emuo.mutation.FromLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.FromLocationTable,
+			Columns: []string{equipmentmove.FromLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emuo.mutation.FromLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.FromLocationTable,
+			Columns: []string{equipmentmove.FromLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if emuo.mutation.ToLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.ToLocationTable,
+			Columns: []string{equipmentmove.ToLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emuo.mutation.ToLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.ToLocationTable,
+			Columns: []string{equipmentmove.ToLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if emuo.mutation.MovedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.MovedByTable,
+			Columns: []string{equipmentmove.MovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := emuo.mutation.MovedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   equipmentmove.MovedByTable,
+			Columns: []string{equipmentmove.MovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	em = &EquipmentMove{config: emuo.config}
+	_spec.Assign = em.assignValues
+	_spec.ScanValues = em.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, emuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{equipmentmove.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return em, nil
+}
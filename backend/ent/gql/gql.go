@@ -0,0 +1,25460 @@
+// Code generated by entc, DO NOT EDIT.
+
+package gql
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/holiday"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/job"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/darksford123x/app/ent/webhook"
+	"github.com/darksford123x/app/ent/webhookdelivery"
+	"github.com/darksford123x/app/money"
+
+	"github.com/graphql-go/graphql"
+)
+
+// The sizes of the pages of the connections.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// Options configures a schema.
+type Options struct {
+	// Scopes narrow the entities of each type that can be read, e.g. to
+	// those the reader may see. They apply to the edges as well.
+	Scopes Scopes
+	// Mutable are the names of the types that have create, update and
+	// delete mutations.
+	Mutable []string
+	// Authorize is called with the name of every mutation before it runs;
+	// the mutation fails with its error.
+	Authorize func(ctx context.Context, mutation string) error
+}
+
+// Scopes narrow the entities of each type that can be read.
+type Scopes struct {
+	Activity        func(context.Context) []predicate.Activity
+	Attachment      func(context.Context) []predicate.Attachment
+	Comment         func(context.Context) []predicate.Comment
+	CommentRevision func(context.Context) []predicate.CommentRevision
+	Equipment       func(context.Context) []predicate.Equipment
+	EquipmentMove   func(context.Context) []predicate.EquipmentMove
+	Holiday         func(context.Context) []predicate.Holiday
+	Invoice         func(context.Context) []predicate.Invoice
+	InvoiceLine     func(context.Context) []predicate.InvoiceLine
+	Job             func(context.Context) []predicate.Job
+	Location        func(context.Context) []predicate.Location
+	Organization    func(context.Context) []predicate.Organization
+	Part            func(context.Context) []predicate.Part
+	Payment         func(context.Context) []predicate.Payment
+	RepairSlip      func(context.Context) []predicate.RepairSlip
+	SLAPolicy       func(context.Context) []predicate.SLAPolicy
+	StockLevel      func(context.Context) []predicate.StockLevel
+	StockMovement   func(context.Context) []predicate.StockMovement
+	User            func(context.Context) []predicate.User
+	WarrantyTerm    func(context.Context) []predicate.WarrantyTerm
+	Webhook         func(context.Context) []predicate.Webhook
+	WebhookDelivery func(context.Context) []predicate.WebhookDelivery
+}
+
+// Request is a GraphQL request.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Schema is the GraphQL schema of the ent schemas.
+type Schema struct {
+	client   *ent.Client
+	opts     Options
+	schema   graphql.Schema
+	objects  map[string]*graphql.Object
+	wheres   map[string]*graphql.InputObject
+	enums    map[string]*graphql.Enum
+	pageInfo *graphql.Object
+}
+
+// leaf is a scalar or enum type, which is both input and output.
+type leaf interface {
+	graphql.Input
+	graphql.Output
+}
+
+// NewSchema creates the schema reading and writing through the client.
+func NewSchema(client *ent.Client, opts Options) (*Schema, error) {
+	s := &Schema{
+		client:  client,
+		opts:    opts,
+		objects: make(map[string]*graphql.Object),
+		wheres:  make(map[string]*graphql.InputObject),
+		enums:   make(map[string]*graphql.Enum),
+	}
+	mutable := make(map[string]bool)
+	for _, name := range opts.Mutable {
+		mutable[name] = true
+	}
+	s.pageInfo = graphql.NewObject(graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage":     &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"hasPreviousPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"startCursor":     &graphql.Field{Type: graphql.String},
+			"endCursor":       &graphql.Field{Type: graphql.String},
+		},
+	})
+	query := graphql.Fields{}
+	mutation := graphql.Fields{}
+	s.defineActivity(query, mutation, mutable["Activity"])
+	s.defineAttachment(query, mutation, mutable["Attachment"])
+	s.defineComment(query, mutation, mutable["Comment"])
+	s.defineCommentRevision(query, mutation, mutable["CommentRevision"])
+	s.defineEquipment(query, mutation, mutable["Equipment"])
+	s.defineEquipmentMove(query, mutation, mutable["EquipmentMove"])
+	s.defineHoliday(query, mutation, mutable["Holiday"])
+	s.defineInvoice(query, mutation, mutable["Invoice"])
+	s.defineInvoiceLine(query, mutation, mutable["InvoiceLine"])
+	s.defineJob(query, mutation, mutable["Job"])
+	s.defineLocation(query, mutation, mutable["Location"])
+	s.defineOrganization(query, mutation, mutable["Organization"])
+	s.definePart(query, mutation, mutable["Part"])
+	s.definePayment(query, mutation, mutable["Payment"])
+	s.defineRepairSlip(query, mutation, mutable["RepairSlip"])
+	s.defineSLAPolicy(query, mutation, mutable["SLAPolicy"])
+	s.defineStockLevel(query, mutation, mutable["StockLevel"])
+	s.defineStockMovement(query, mutation, mutable["StockMovement"])
+	s.defineUser(query, mutation, mutable["User"])
+	s.defineWarrantyTerm(query, mutation, mutable["WarrantyTerm"])
+	s.defineWebhook(query, mutation, mutable["Webhook"])
+	s.defineWebhookDelivery(query, mutation, mutable["WebhookDelivery"])
+	cfg := graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: query}),
+	}
+	if len(mutation) > 0 {
+		cfg.Mutation = graphql.NewObject(graphql.ObjectConfig{Name: "Mutation", Fields: mutation})
+	}
+	schema, err := graphql.NewSchema(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("gql: %v", err)
+	}
+	s.schema = schema
+	return s, nil
+}
+
+// Do executes the request. The edges of the nodes it reads are loaded in
+// batches, a query per edge and level.
+func (s *Schema) Do(ctx context.Context, r Request) *graphql.Result {
+	return graphql.Do(graphql.Params{
+		Schema:         s.schema,
+		RequestString:  r.Query,
+		OperationName:  r.OperationName,
+		VariableValues: r.Variables,
+		Context:        context.WithValue(ctx, loaderKey{}, &loader{batches: make(map[string]*batch)}),
+	})
+}
+
+// authorize asks Options.Authorize whether the mutation may run.
+func (s *Schema) authorize(ctx context.Context, mutation string) error {
+	if s.opts.Authorize == nil {
+		return nil
+	}
+	return s.opts.Authorize(ctx, mutation)
+}
+
+// connection returns the connection type of the object type.
+func (s *Schema) connection(name string) *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: name + "Edge",
+		Fields: graphql.Fields{
+			"node":   &graphql.Field{Type: graphql.NewNonNull(s.objects[name])},
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name + "Connection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edge)))},
+			"pageInfo": &graphql.Field{Type: graphql.NewNonNull(s.pageInfo)},
+			"totalCount": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(map[string]interface{})["totalCount"].(func() (int, error))()
+				},
+			},
+		},
+	})
+}
+
+// connectionArgs are the arguments of a connection of nodes of the where
+// input.
+func connectionArgs(where *graphql.InputObject) graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		"first":  &graphql.ArgumentConfig{Type: graphql.Int},
+		"after":  &graphql.ArgumentConfig{Type: graphql.String},
+		"last":   &graphql.ArgumentConfig{Type: graphql.Int},
+		"before": &graphql.ArgumentConfig{Type: graphql.String},
+		"where":  &graphql.ArgumentConfig{Type: where},
+	}
+}
+
+// page is a page of a connection. Connections are ordered by ID, and the
+// cursors are the encoded IDs.
+type page struct {
+	size   int
+	last   bool
+	after  *int
+	before *int
+}
+
+// paginate returns the page of the connection arguments.
+func paginate(args map[string]interface{}) (*page, error) {
+	p := &page{size: defaultPageSize}
+	first, hasFirst := args["first"].(int)
+	last, hasLast := args["last"].(int)
+	switch {
+	case hasFirst && hasLast:
+		return nil, errors.New("gql: first and last cannot be used together")
+	case hasFirst:
+		p.size = first
+	case hasLast:
+		p.size, p.last = last, true
+	}
+	if p.size < 0 {
+		return nil, errors.New("gql: first and last cannot be negative")
+	}
+	if p.size > maxPageSize {
+		p.size = maxPageSize
+	}
+	for key, id := range map[string]**int{"after": &p.after, "before": &p.before} {
+		cursor, ok := args[key].(string)
+		if !ok {
+			continue
+		}
+		b, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, fmt.Errorf("gql: invalid cursor %q", cursor)
+		}
+		n, err := strconv.Atoi(string(b))
+		if err != nil {
+			return nil, fmt.Errorf("gql: invalid cursor %q", cursor)
+		}
+		*id = &n
+	}
+	return p, nil
+}
+
+// connection returns the connection of the nodes of the page, which were
+// read one past its size to tell whether there are more.
+func (p *page) connection(nodes []interface{}, ids []int, count func() (int, error)) map[string]interface{} {
+	more := len(nodes) > p.size
+	if more {
+		nodes, ids = nodes[:p.size], ids[:p.size]
+	}
+	if p.last {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+			ids[i], ids[j] = ids[j], ids[i]
+		}
+	}
+	edges := make([]interface{}, len(nodes))
+	for i := range nodes {
+		edges[i] = map[string]interface{}{
+			"node":   nodes[i],
+			"cursor": base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(ids[i]))),
+		}
+	}
+	info := map[string]interface{}{
+		"hasNextPage":     !p.last && more || p.last && p.before != nil,
+		"hasPreviousPage": p.last && more || !p.last && p.after != nil,
+	}
+	if len(edges) > 0 {
+		info["startCursor"] = edges[0].(map[string]interface{})["cursor"]
+		info["endCursor"] = edges[len(edges)-1].(map[string]interface{})["cursor"]
+	}
+	return map[string]interface{}{
+		"edges":      edges,
+		"pageInfo":   info,
+		"totalCount": count,
+	}
+}
+
+// toID returns the ID of an ID argument.
+func toID(v interface{}) (int, error) {
+	s, _ := v.(string)
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("gql: invalid id %q", s)
+	}
+	return id, nil
+}
+
+// toIDs returns the IDs of a list of ID arguments.
+func toIDs(v interface{}) ([]int, error) {
+	vs, _ := v.([]interface{})
+	ids := make([]int, len(vs))
+	for i := range vs {
+		id, err := toID(vs[i])
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// toStrings returns the strings of a list argument.
+func toStrings(v interface{}) ([]string, error) {
+	vs, _ := v.([]interface{})
+	strs := make([]string, len(vs))
+	for i := range vs {
+		s, ok := vs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("gql: %v is not a string", vs[i])
+		}
+		strs[i] = s
+	}
+	return strs, nil
+}
+
+// wheres returns the where inputs of a list argument.
+func wheres(v interface{}) []map[string]interface{} {
+	vs, _ := v.([]interface{})
+	ins := make([]map[string]interface{}, 0, len(vs))
+	for _, v := range vs {
+		if in, ok := v.(map[string]interface{}); ok {
+			ins = append(ins, in)
+		}
+	}
+	return ins
+}
+
+type loaderKey struct{}
+
+// loader loads the edges of the nodes of a request in batches. The
+// resolvers of an edge of the nodes of a level queue their IDs and return
+// thunks, which the executor calls once the level is resolved; the first
+// thunk called loads the edge of all the queued nodes.
+type loader struct {
+	mu      sync.Mutex
+	batches map[string]*batch
+}
+
+// batch is the queued nodes of an edge and, once loaded, their edges.
+type batch struct {
+	ids    []int
+	once   sync.Once
+	values map[int]interface{}
+	err    error
+}
+
+// load queues the node for the edge named key and returns a thunk of its
+// edge. fetch loads the edges of the nodes by their IDs.
+func (s *Schema) load(ctx context.Context, key string, id int, fetch func(context.Context, []int) (map[int]interface{}, error)) func() (interface{}, error) {
+	l := ctx.Value(loaderKey{}).(*loader)
+	l.mu.Lock()
+	b, ok := l.batches[key]
+	if !ok {
+		b = &batch{}
+		l.batches[key] = b
+	}
+	b.ids = append(b.ids, id)
+	l.mu.Unlock()
+	return func() (interface{}, error) {
+		b.once.Do(func() {
+			// Nodes queued from now on go to the next batch.
+			l.mu.Lock()
+			if l.batches[key] == b {
+				delete(l.batches, key)
+			}
+			ids := b.ids
+			l.mu.Unlock()
+			b.values, b.err = fetch(ctx, ids)
+		})
+		return b.values[id], b.err
+	}
+}
+
+// defineActivity defines the types of Activity and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineActivity(query, mutation graphql.Fields, mutable bool) {
+	s.enums["ActivityKind"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "ActivityKind",
+		Values: graphql.EnumValueConfigMap{
+			"status_changed":   &graphql.EnumValueConfig{Value: "status_changed"},
+			"priority_changed": &graphql.EnumValueConfig{Value: "priority_changed"},
+			"assigned":         &graphql.EnumValueConfig{Value: "assigned"},
+			"unassigned":       &graphql.EnumValueConfig{Value: "unassigned"},
+			"escalated":        &graphql.EnumValueConfig{Value: "escalated"},
+		},
+	})
+	leaves := map[string]leaf{
+		"create_time": graphql.DateTime,
+		"kind":        s.enums["ActivityKind"],
+		"from":        graphql.String,
+		"to":          graphql.String,
+	}
+
+	s.objects["Activity"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Activity",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Activity).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Activity).CreateTime
+						return v, nil
+					},
+				},
+				"kind": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["kind"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Activity).Kind
+						return string(v), nil
+					},
+				},
+				"from": &graphql.Field{
+					Type: leaves["from"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Activity).From
+						return v, nil
+					},
+				},
+				"to": &graphql.Field{
+					Type: leaves["to"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Activity).To
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Activity.organization", p.Source.(*ent.Activity).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Activity.Query().
+								Where(activity.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"repairSlip": &graphql.Field{
+					Type: s.objects["RepairSlip"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Activity.repair_slip", p.Source.(*ent.Activity).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Activity.Query().
+								Where(activity.IDIn(ids...)).
+								WithRepairSlip(func(q *ent.RepairSlipQuery) {
+									q.Where(s.scopeRepairSlip(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.RepairSlip != nil {
+									values[n.ID] = n.Edges.RepairSlip
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"actor": &graphql.Field{
+					Type: s.objects["User"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Activity.actor", p.Source.(*ent.Activity).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Activity.Query().
+								Where(activity.IDIn(ids...)).
+								WithActor(func(q *ent.UserQuery) {
+									q.Where(s.scopeUser(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Actor != nil {
+									values[n.ID] = n.Edges.Actor
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"technician": &graphql.Field{
+					Type: s.objects["User"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Activity.technician", p.Source.(*ent.Activity).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Activity.Query().
+								Where(activity.IDIn(ids...)).
+								WithTechnician(func(q *ent.UserQuery) {
+									q.Where(s.scopeUser(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Technician != nil {
+									values[n.ID] = n.Edges.Technician
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Activity"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ActivityWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Activity"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                 &graphql.InputObjectFieldConfig{Type: where},
+				"and":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"kind":                &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"kindNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"from":                &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["from"]))},
+				"fromNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["from"]))},
+				"fromGT":              &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromGTE":             &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromLT":              &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromLTE":             &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromContains":        &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromIsNil":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"fromNotNil":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"fromEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"fromContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+				"to":                  &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toNEQ":               &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["to"]))},
+				"toNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["to"]))},
+				"toGT":                &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toGTE":               &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toLT":                &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toLTE":               &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toContains":          &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toHasPrefix":         &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toHasSuffix":         &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toIsNil":             &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"toNotNil":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"toEqualFold":         &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"toContainsFold":      &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+				"hasOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasRepairSlip":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasRepairSlipWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["RepairSlip"]))},
+				"hasActor":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasActorWith":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["User"]))},
+				"hasTechnician":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasTechnicianWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["User"]))},
+			}
+		}),
+	})
+
+	query["activity"] = &graphql.Field{
+		Type: s.objects["Activity"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Activity.Query().
+				Where(activity.ID(id)).
+				Where(s.scopeActivity(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["activities"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Activity")),
+		Args: connectionArgs(s.wheres["Activity"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Activity.Query().Where(s.scopeActivity(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereActivity(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(activity.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(activity.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(activity.FieldID))
+			} else {
+				q.Order(ent.Asc(activity.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateActivityInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"kind":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["kind"])},
+			"from":           &graphql.InputObjectFieldConfig{Type: leaves["from"]},
+			"to":             &graphql.InputObjectFieldConfig{Type: leaves["to"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"repairSlipID":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"actorID":        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"technicianID":   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateActivityInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"repairSlipID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearRepairSlip":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"actorID":           &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearActor":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"technicianID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearTechnician":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createActivity"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Activity"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createActivity"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Activity.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertActivityCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["kind"]; ok && v != nil {
+				c, err := convertActivityKind(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetKind(c)
+			}
+			if v, ok := in["from"]; ok && v != nil {
+				c, err := convertActivityFrom(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetFrom(c)
+			}
+			if v, ok := in["to"]; ok && v != nil {
+				c, err := convertActivityTo(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetTo(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			if v, ok := in["actorID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetActorID(id)
+			}
+			if v, ok := in["technicianID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetTechnicianID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateActivity"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Activity"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateActivity"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Activity.UpdateOneID(id)
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			if v, ok := in["clearRepairSlip"]; ok && v == true {
+				b.ClearRepairSlip()
+			}
+			if v, ok := in["actorID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetActorID(id)
+			}
+			if v, ok := in["clearActor"]; ok && v == true {
+				b.ClearActor()
+			}
+			if v, ok := in["technicianID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetTechnicianID(id)
+			}
+			if v, ok := in["clearTechnician"]; ok && v == true {
+				b.ClearTechnician()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteActivity"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteActivity"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Activity.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeActivity returns the predicates narrowing the Activity entities
+// that can be read.
+func (s *Schema) scopeActivity(ctx context.Context) []predicate.Activity {
+	if s.opts.Scopes.Activity == nil {
+		return nil
+	}
+	return s.opts.Scopes.Activity(ctx)
+}
+
+// whereActivity returns the predicates of a ActivityWhereInput.
+func (s *Schema) whereActivity(in map[string]interface{}) ([]predicate.Activity, error) {
+	var ps []predicate.Activity
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereActivity(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, activity.Not(activity.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Activity
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereActivity(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, activity.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, activity.And(group...))
+		default:
+			ps = append(ps, activity.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertActivityCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertActivityCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertActivityCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, activity.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertActivityCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, activity.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertActivityCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertActivityCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertActivityCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertActivityCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.CreateTimeLTE(c))
+	}
+	if v, ok := in["kind"]; ok && v != nil {
+		c, err := convertActivityKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.KindEQ(c))
+	}
+	if v, ok := in["kindNEQ"]; ok && v != nil {
+		c, err := convertActivityKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.KindNEQ(c))
+	}
+	if v, ok := in["kindIn"].([]interface{}); ok {
+		vs := make([]activity.Kind, len(v))
+		for i := range v {
+			c, err := convertActivityKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, activity.KindIn(vs...))
+	}
+	if v, ok := in["kindNotIn"].([]interface{}); ok {
+		vs := make([]activity.Kind, len(v))
+		for i := range v {
+			c, err := convertActivityKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, activity.KindNotIn(vs...))
+	}
+	if v, ok := in["from"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromEQ(c))
+	}
+	if v, ok := in["fromNEQ"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromNEQ(c))
+	}
+	if v, ok := in["fromIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertActivityFrom(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, activity.FromIn(vs...))
+	}
+	if v, ok := in["fromNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertActivityFrom(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, activity.FromNotIn(vs...))
+	}
+	if v, ok := in["fromGT"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromGT(c))
+	}
+	if v, ok := in["fromGTE"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromGTE(c))
+	}
+	if v, ok := in["fromLT"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromLT(c))
+	}
+	if v, ok := in["fromLTE"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromLTE(c))
+	}
+	if v, ok := in["fromContains"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromContains(c))
+	}
+	if v, ok := in["fromHasPrefix"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromHasPrefix(c))
+	}
+	if v, ok := in["fromHasSuffix"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromHasSuffix(c))
+	}
+	if v, ok := in["fromIsNil"]; ok && v == true {
+		ps = append(ps, activity.FromIsNil())
+	}
+	if v, ok := in["fromNotNil"]; ok && v == true {
+		ps = append(ps, activity.FromNotNil())
+	}
+	if v, ok := in["fromEqualFold"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromEqualFold(c))
+	}
+	if v, ok := in["fromContainsFold"]; ok && v != nil {
+		c, err := convertActivityFrom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.FromContainsFold(c))
+	}
+	if v, ok := in["to"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToEQ(c))
+	}
+	if v, ok := in["toNEQ"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToNEQ(c))
+	}
+	if v, ok := in["toIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertActivityTo(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, activity.ToIn(vs...))
+	}
+	if v, ok := in["toNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertActivityTo(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, activity.ToNotIn(vs...))
+	}
+	if v, ok := in["toGT"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToGT(c))
+	}
+	if v, ok := in["toGTE"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToGTE(c))
+	}
+	if v, ok := in["toLT"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToLT(c))
+	}
+	if v, ok := in["toLTE"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToLTE(c))
+	}
+	if v, ok := in["toContains"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToContains(c))
+	}
+	if v, ok := in["toHasPrefix"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToHasPrefix(c))
+	}
+	if v, ok := in["toHasSuffix"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToHasSuffix(c))
+	}
+	if v, ok := in["toIsNil"]; ok && v == true {
+		ps = append(ps, activity.ToIsNil())
+	}
+	if v, ok := in["toNotNil"]; ok && v == true {
+		ps = append(ps, activity.ToNotNil())
+	}
+	if v, ok := in["toEqualFold"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToEqualFold(c))
+	}
+	if v, ok := in["toContainsFold"]; ok && v != nil {
+		c, err := convertActivityTo(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, activity.ToContainsFold(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, activity.HasOrganization())
+		} else {
+			ps = append(ps, activity.Not(activity.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, activity.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasRepairSlip"].(bool); ok {
+		if v {
+			ps = append(ps, activity.HasRepairSlip())
+		} else {
+			ps = append(ps, activity.Not(activity.HasRepairSlip()))
+		}
+	}
+	if v, ok := in["hasRepairSlipWith"]; ok && v != nil {
+		var with []predicate.RepairSlip
+		for _, v := range wheres(v) {
+			sub, err := s.whereRepairSlip(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, activity.HasRepairSlipWith(with...))
+	}
+	if v, ok := in["hasActor"].(bool); ok {
+		if v {
+			ps = append(ps, activity.HasActor())
+		} else {
+			ps = append(ps, activity.Not(activity.HasActor()))
+		}
+	}
+	if v, ok := in["hasActorWith"]; ok && v != nil {
+		var with []predicate.User
+		for _, v := range wheres(v) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, activity.HasActorWith(with...))
+	}
+	if v, ok := in["hasTechnician"].(bool); ok {
+		if v {
+			ps = append(ps, activity.HasTechnician())
+		} else {
+			ps = append(ps, activity.Not(activity.HasTechnician()))
+		}
+	}
+	if v, ok := in["hasTechnicianWith"]; ok && v != nil {
+		var with []predicate.User
+		for _, v := range wheres(v) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, activity.HasTechnicianWith(with...))
+	}
+	return ps, nil
+}
+
+// convertActivityCreateTime returns the create_time of Activity of an argument.
+func convertActivityCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertActivityKind returns the kind of Activity of an argument.
+func convertActivityKind(v interface{}) (activity.Kind, error) {
+	s, _ := v.(string)
+	return activity.Kind(s), nil
+}
+
+// convertActivityFrom returns the from of Activity of an argument.
+func convertActivityFrom(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertActivityTo returns the to of Activity of an argument.
+func convertActivityTo(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// defineAttachment defines the types of Attachment and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineAttachment(query, mutation graphql.Fields, mutable bool) {
+	leaves := map[string]leaf{
+		"create_time":  graphql.DateTime,
+		"filename":     graphql.String,
+		"content_type": graphql.String,
+		"size":         graphql.Int,
+		"thumbnail":    graphql.Boolean,
+	}
+
+	s.objects["Attachment"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Attachment",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Attachment).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Attachment).CreateTime
+						return v, nil
+					},
+				},
+				"filename": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["filename"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Attachment).Filename
+						return v, nil
+					},
+				},
+				"contentType": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["content_type"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Attachment).ContentType
+						return v, nil
+					},
+				},
+				"size": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["size"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Attachment).Size
+						return v, nil
+					},
+				},
+				"thumbnail": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["thumbnail"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Attachment).Thumbnail
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Attachment.organization", p.Source.(*ent.Attachment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Attachment.Query().
+								Where(attachment.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"repairSlip": &graphql.Field{
+					Type: s.objects["RepairSlip"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Attachment.repair_slip", p.Source.(*ent.Attachment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Attachment.Query().
+								Where(attachment.IDIn(ids...)).
+								WithRepairSlip(func(q *ent.RepairSlipQuery) {
+									q.Where(s.scopeRepairSlip(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.RepairSlip != nil {
+									values[n.ID] = n.Edges.RepairSlip
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"uploader": &graphql.Field{
+					Type: s.objects["User"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Attachment.uploader", p.Source.(*ent.Attachment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Attachment.Query().
+								Where(attachment.IDIn(ids...)).
+								WithUploader(func(q *ent.UserQuery) {
+									q.Where(s.scopeUser(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Uploader != nil {
+									values[n.ID] = n.Edges.Uploader
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Attachment"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AttachmentWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Attachment"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                     &graphql.InputObjectFieldConfig{Type: where},
+				"and":                     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":              &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":           &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":            &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":           &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":            &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":           &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"filename":                &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["filename"]))},
+				"filenameNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["filename"]))},
+				"filenameGT":              &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameGTE":             &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameLT":              &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameLTE":             &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameContains":        &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"filenameContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["filename"]},
+				"contentType":             &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["content_type"]))},
+				"contentTypeNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["content_type"]))},
+				"contentTypeGT":           &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeGTE":          &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeLT":           &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeLTE":          &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeContains":     &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"contentTypeContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["content_type"]},
+				"size":                    &graphql.InputObjectFieldConfig{Type: leaves["size"]},
+				"sizeNEQ":                 &graphql.InputObjectFieldConfig{Type: leaves["size"]},
+				"sizeIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["size"]))},
+				"sizeNotIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["size"]))},
+				"sizeGT":                  &graphql.InputObjectFieldConfig{Type: leaves["size"]},
+				"sizeGTE":                 &graphql.InputObjectFieldConfig{Type: leaves["size"]},
+				"sizeLT":                  &graphql.InputObjectFieldConfig{Type: leaves["size"]},
+				"sizeLTE":                 &graphql.InputObjectFieldConfig{Type: leaves["size"]},
+				"thumbnail":               &graphql.InputObjectFieldConfig{Type: leaves["thumbnail"]},
+				"thumbnailNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["thumbnail"]},
+				"hasOrganization":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasRepairSlip":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasRepairSlipWith":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["RepairSlip"]))},
+				"hasUploader":             &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasUploaderWith":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["User"]))},
+			}
+		}),
+	})
+
+	query["attachment"] = &graphql.Field{
+		Type: s.objects["Attachment"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Attachment.Query().
+				Where(attachment.ID(id)).
+				Where(s.scopeAttachment(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["attachments"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Attachment")),
+		Args: connectionArgs(s.wheres["Attachment"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Attachment.Query().Where(s.scopeAttachment(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereAttachment(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(attachment.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(attachment.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(attachment.FieldID))
+			} else {
+				q.Order(ent.Asc(attachment.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateAttachmentInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"filename":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["filename"])},
+			"contentType":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["content_type"])},
+			"size":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["size"])},
+			"thumbnail":      &graphql.InputObjectFieldConfig{Type: leaves["thumbnail"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"repairSlipID":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"uploaderID":     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateAttachmentInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"repairSlipID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearRepairSlip":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"uploaderID":        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearUploader":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createAttachment"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Attachment"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createAttachment"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Attachment.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertAttachmentCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["filename"]; ok && v != nil {
+				c, err := convertAttachmentFilename(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetFilename(c)
+			}
+			if v, ok := in["contentType"]; ok && v != nil {
+				c, err := convertAttachmentContentType(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetContentType(c)
+			}
+			if v, ok := in["size"]; ok && v != nil {
+				c, err := convertAttachmentSize(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSize(c)
+			}
+			if v, ok := in["thumbnail"]; ok && v != nil {
+				c, err := convertAttachmentThumbnail(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetThumbnail(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			if v, ok := in["uploaderID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUploaderID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateAttachment"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Attachment"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateAttachment"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Attachment.UpdateOneID(id)
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			if v, ok := in["clearRepairSlip"]; ok && v == true {
+				b.ClearRepairSlip()
+			}
+			if v, ok := in["uploaderID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUploaderID(id)
+			}
+			if v, ok := in["clearUploader"]; ok && v == true {
+				b.ClearUploader()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteAttachment"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteAttachment"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Attachment.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeAttachment returns the predicates narrowing the Attachment entities
+// that can be read.
+func (s *Schema) scopeAttachment(ctx context.Context) []predicate.Attachment {
+	if s.opts.Scopes.Attachment == nil {
+		return nil
+	}
+	return s.opts.Scopes.Attachment(ctx)
+}
+
+// whereAttachment returns the predicates of a AttachmentWhereInput.
+func (s *Schema) whereAttachment(in map[string]interface{}) ([]predicate.Attachment, error) {
+	var ps []predicate.Attachment
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereAttachment(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, attachment.Not(attachment.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Attachment
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereAttachment(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, attachment.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, attachment.And(group...))
+		default:
+			ps = append(ps, attachment.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertAttachmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertAttachmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertAttachmentCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, attachment.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertAttachmentCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, attachment.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertAttachmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertAttachmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertAttachmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertAttachmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.CreateTimeLTE(c))
+	}
+	if v, ok := in["filename"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameEQ(c))
+	}
+	if v, ok := in["filenameNEQ"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameNEQ(c))
+	}
+	if v, ok := in["filenameIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertAttachmentFilename(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, attachment.FilenameIn(vs...))
+	}
+	if v, ok := in["filenameNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertAttachmentFilename(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, attachment.FilenameNotIn(vs...))
+	}
+	if v, ok := in["filenameGT"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameGT(c))
+	}
+	if v, ok := in["filenameGTE"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameGTE(c))
+	}
+	if v, ok := in["filenameLT"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameLT(c))
+	}
+	if v, ok := in["filenameLTE"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameLTE(c))
+	}
+	if v, ok := in["filenameContains"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameContains(c))
+	}
+	if v, ok := in["filenameHasPrefix"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameHasPrefix(c))
+	}
+	if v, ok := in["filenameHasSuffix"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameHasSuffix(c))
+	}
+	if v, ok := in["filenameEqualFold"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameEqualFold(c))
+	}
+	if v, ok := in["filenameContainsFold"]; ok && v != nil {
+		c, err := convertAttachmentFilename(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.FilenameContainsFold(c))
+	}
+	if v, ok := in["contentType"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeEQ(c))
+	}
+	if v, ok := in["contentTypeNEQ"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeNEQ(c))
+	}
+	if v, ok := in["contentTypeIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertAttachmentContentType(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, attachment.ContentTypeIn(vs...))
+	}
+	if v, ok := in["contentTypeNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertAttachmentContentType(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, attachment.ContentTypeNotIn(vs...))
+	}
+	if v, ok := in["contentTypeGT"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeGT(c))
+	}
+	if v, ok := in["contentTypeGTE"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeGTE(c))
+	}
+	if v, ok := in["contentTypeLT"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeLT(c))
+	}
+	if v, ok := in["contentTypeLTE"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeLTE(c))
+	}
+	if v, ok := in["contentTypeContains"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeContains(c))
+	}
+	if v, ok := in["contentTypeHasPrefix"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeHasPrefix(c))
+	}
+	if v, ok := in["contentTypeHasSuffix"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeHasSuffix(c))
+	}
+	if v, ok := in["contentTypeEqualFold"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeEqualFold(c))
+	}
+	if v, ok := in["contentTypeContainsFold"]; ok && v != nil {
+		c, err := convertAttachmentContentType(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ContentTypeContainsFold(c))
+	}
+	if v, ok := in["size"]; ok && v != nil {
+		c, err := convertAttachmentSize(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.SizeEQ(c))
+	}
+	if v, ok := in["sizeNEQ"]; ok && v != nil {
+		c, err := convertAttachmentSize(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.SizeNEQ(c))
+	}
+	if v, ok := in["sizeIn"].([]interface{}); ok {
+		vs := make([]int64, len(v))
+		for i := range v {
+			c, err := convertAttachmentSize(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, attachment.SizeIn(vs...))
+	}
+	if v, ok := in["sizeNotIn"].([]interface{}); ok {
+		vs := make([]int64, len(v))
+		for i := range v {
+			c, err := convertAttachmentSize(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, attachment.SizeNotIn(vs...))
+	}
+	if v, ok := in["sizeGT"]; ok && v != nil {
+		c, err := convertAttachmentSize(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.SizeGT(c))
+	}
+	if v, ok := in["sizeGTE"]; ok && v != nil {
+		c, err := convertAttachmentSize(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.SizeGTE(c))
+	}
+	if v, ok := in["sizeLT"]; ok && v != nil {
+		c, err := convertAttachmentSize(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.SizeLT(c))
+	}
+	if v, ok := in["sizeLTE"]; ok && v != nil {
+		c, err := convertAttachmentSize(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.SizeLTE(c))
+	}
+	if v, ok := in["thumbnail"]; ok && v != nil {
+		c, err := convertAttachmentThumbnail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ThumbnailEQ(c))
+	}
+	if v, ok := in["thumbnailNEQ"]; ok && v != nil {
+		c, err := convertAttachmentThumbnail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, attachment.ThumbnailNEQ(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, attachment.HasOrganization())
+		} else {
+			ps = append(ps, attachment.Not(attachment.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, attachment.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasRepairSlip"].(bool); ok {
+		if v {
+			ps = append(ps, attachment.HasRepairSlip())
+		} else {
+			ps = append(ps, attachment.Not(attachment.HasRepairSlip()))
+		}
+	}
+	if v, ok := in["hasRepairSlipWith"]; ok && v != nil {
+		var with []predicate.RepairSlip
+		for _, v := range wheres(v) {
+			sub, err := s.whereRepairSlip(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, attachment.HasRepairSlipWith(with...))
+	}
+	if v, ok := in["hasUploader"].(bool); ok {
+		if v {
+			ps = append(ps, attachment.HasUploader())
+		} else {
+			ps = append(ps, attachment.Not(attachment.HasUploader()))
+		}
+	}
+	if v, ok := in["hasUploaderWith"]; ok && v != nil {
+		var with []predicate.User
+		for _, v := range wheres(v) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, attachment.HasUploaderWith(with...))
+	}
+	return ps, nil
+}
+
+// convertAttachmentCreateTime returns the create_time of Attachment of an argument.
+func convertAttachmentCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertAttachmentFilename returns the filename of Attachment of an argument.
+func convertAttachmentFilename(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertAttachmentContentType returns the content_type of Attachment of an argument.
+func convertAttachmentContentType(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertAttachmentSize returns the size of Attachment of an argument.
+func convertAttachmentSize(v interface{}) (int64, error) {
+	n, _ := v.(int)
+	return int64(n), nil
+}
+
+// convertAttachmentThumbnail returns the thumbnail of Attachment of an argument.
+func convertAttachmentThumbnail(v interface{}) (bool, error) {
+	b, _ := v.(bool)
+	return b, nil
+}
+
+// defineComment defines the types of Comment and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineComment(query, mutation graphql.Fields, mutable bool) {
+	s.enums["CommentVisibility"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "CommentVisibility",
+		Values: graphql.EnumValueConfigMap{
+			"public":   &graphql.EnumValueConfig{Value: "public"},
+			"internal": &graphql.EnumValueConfig{Value: "internal"},
+		},
+	})
+	leaves := map[string]leaf{
+		"create_time": graphql.DateTime,
+		"update_time": graphql.DateTime,
+		"body":        graphql.String,
+		"visibility":  s.enums["CommentVisibility"],
+		"edited":      graphql.Boolean,
+	}
+
+	s.objects["Comment"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Comment",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Comment).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Comment).CreateTime
+						return v, nil
+					},
+				},
+				"updateTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["update_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Comment).UpdateTime
+						return v, nil
+					},
+				},
+				"body": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["body"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Comment).Body
+						return v, nil
+					},
+				},
+				"visibility": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["visibility"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Comment).Visibility
+						return string(v), nil
+					},
+				},
+				"edited": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["edited"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Comment).Edited
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Comment.organization", p.Source.(*ent.Comment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Comment.Query().
+								Where(comment.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"repairSlip": &graphql.Field{
+					Type: s.objects["RepairSlip"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Comment.repair_slip", p.Source.(*ent.Comment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Comment.Query().
+								Where(comment.IDIn(ids...)).
+								WithRepairSlip(func(q *ent.RepairSlipQuery) {
+									q.Where(s.scopeRepairSlip(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.RepairSlip != nil {
+									values[n.ID] = n.Edges.RepairSlip
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"author": &graphql.Field{
+					Type: s.objects["User"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Comment.author", p.Source.(*ent.Comment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Comment.Query().
+								Where(comment.IDIn(ids...)).
+								WithAuthor(func(q *ent.UserQuery) {
+									q.Where(s.scopeUser(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Author != nil {
+									values[n.ID] = n.Edges.Author
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"mentions": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["User"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Comment.mentions", p.Source.(*ent.Comment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Comment.Query().
+								Where(comment.IDIn(ids...)).
+								WithMentions(func(q *ent.UserQuery) {
+									q.Where(s.scopeUser(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Mentions
+								if edges == nil {
+									edges = []*ent.User{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"revisions": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["CommentRevision"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Comment.revisions", p.Source.(*ent.Comment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Comment.Query().
+								Where(comment.IDIn(ids...)).
+								WithRevisions(func(q *ent.CommentRevisionQuery) {
+									q.Where(s.scopeCommentRevision(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Revisions
+								if edges == nil {
+									edges = []*ent.CommentRevision{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Comment"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CommentWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Comment"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                 &graphql.InputObjectFieldConfig{Type: where},
+				"and":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"updateTime":          &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeGT":        &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeGTE":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLT":        &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLTE":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"body":                &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["body"]))},
+				"bodyNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["body"]))},
+				"bodyGT":              &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyGTE":             &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyLT":              &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyLTE":             &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyContains":        &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"visibility":          &graphql.InputObjectFieldConfig{Type: leaves["visibility"]},
+				"visibilityNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["visibility"]},
+				"visibilityIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["visibility"]))},
+				"visibilityNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["visibility"]))},
+				"edited":              &graphql.InputObjectFieldConfig{Type: leaves["edited"]},
+				"editedNEQ":           &graphql.InputObjectFieldConfig{Type: leaves["edited"]},
+				"hasOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasRepairSlip":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasRepairSlipWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["RepairSlip"]))},
+				"hasAuthor":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasAuthorWith":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["User"]))},
+				"hasMentions":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasMentionsWith":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["User"]))},
+				"hasRevisions":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasRevisionsWith":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["CommentRevision"]))},
+			}
+		}),
+	})
+
+	query["comment"] = &graphql.Field{
+		Type: s.objects["Comment"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Comment.Query().
+				Where(comment.ID(id)).
+				Where(s.scopeComment(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["comments"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Comment")),
+		Args: connectionArgs(s.wheres["Comment"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Comment.Query().Where(s.scopeComment(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereComment(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(comment.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(comment.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(comment.FieldID))
+			} else {
+				q.Order(ent.Asc(comment.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateCommentInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"updateTime":     &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+			"body":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["body"])},
+			"visibility":     &graphql.InputObjectFieldConfig{Type: leaves["visibility"]},
+			"edited":         &graphql.InputObjectFieldConfig{Type: leaves["edited"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"repairSlipID":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"authorID":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"mentionIDs":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"revisionIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateCommentInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"body":              &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+			"visibility":        &graphql.InputObjectFieldConfig{Type: leaves["visibility"]},
+			"edited":            &graphql.InputObjectFieldConfig{Type: leaves["edited"]},
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"repairSlipID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearRepairSlip":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"authorID":          &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearAuthor":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addMentionIDs":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeMentionIDs":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addRevisionIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeRevisionIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	mutation["createComment"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Comment"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createComment"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Comment.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertCommentCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["updateTime"]; ok && v != nil {
+				c, err := convertCommentUpdateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUpdateTime(c)
+			}
+			if v, ok := in["body"]; ok && v != nil {
+				c, err := convertCommentBody(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetBody(c)
+			}
+			if v, ok := in["visibility"]; ok && v != nil {
+				c, err := convertCommentVisibility(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetVisibility(c)
+			}
+			if v, ok := in["edited"]; ok && v != nil {
+				c, err := convertCommentEdited(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEdited(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			if v, ok := in["authorID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAuthorID(id)
+			}
+			if v, ok := in["mentionIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddMentionIDs(ids...)
+			}
+			if v, ok := in["revisionIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddRevisionIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateComment"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Comment"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateComment"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Comment.UpdateOneID(id)
+			if v, ok := in["body"]; ok && v != nil {
+				c, err := convertCommentBody(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetBody(c)
+			}
+			if v, ok := in["visibility"]; ok && v != nil {
+				c, err := convertCommentVisibility(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetVisibility(c)
+			}
+			if v, ok := in["edited"]; ok && v != nil {
+				c, err := convertCommentEdited(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEdited(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			if v, ok := in["clearRepairSlip"]; ok && v == true {
+				b.ClearRepairSlip()
+			}
+			if v, ok := in["authorID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAuthorID(id)
+			}
+			if v, ok := in["clearAuthor"]; ok && v == true {
+				b.ClearAuthor()
+			}
+			if v, ok := in["addMentionIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddMentionIDs(ids...)
+			}
+			if v, ok := in["removeMentionIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveMentionIDs(ids...)
+			}
+			if v, ok := in["addRevisionIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddRevisionIDs(ids...)
+			}
+			if v, ok := in["removeRevisionIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveRevisionIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteComment"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteComment"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Comment.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeComment returns the predicates narrowing the Comment entities
+// that can be read.
+func (s *Schema) scopeComment(ctx context.Context) []predicate.Comment {
+	if s.opts.Scopes.Comment == nil {
+		return nil
+	}
+	return s.opts.Scopes.Comment(ctx)
+}
+
+// whereComment returns the predicates of a CommentWhereInput.
+func (s *Schema) whereComment(in map[string]interface{}) ([]predicate.Comment, error) {
+	var ps []predicate.Comment
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereComment(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, comment.Not(comment.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Comment
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereComment(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, comment.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, comment.And(group...))
+		default:
+			ps = append(ps, comment.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertCommentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertCommentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertCommentCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, comment.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertCommentCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, comment.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertCommentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertCommentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertCommentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertCommentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.CreateTimeLTE(c))
+	}
+	if v, ok := in["updateTime"]; ok && v != nil {
+		c, err := convertCommentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.UpdateTimeEQ(c))
+	}
+	if v, ok := in["updateTimeNEQ"]; ok && v != nil {
+		c, err := convertCommentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.UpdateTimeNEQ(c))
+	}
+	if v, ok := in["updateTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertCommentUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, comment.UpdateTimeIn(vs...))
+	}
+	if v, ok := in["updateTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertCommentUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, comment.UpdateTimeNotIn(vs...))
+	}
+	if v, ok := in["updateTimeGT"]; ok && v != nil {
+		c, err := convertCommentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.UpdateTimeGT(c))
+	}
+	if v, ok := in["updateTimeGTE"]; ok && v != nil {
+		c, err := convertCommentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.UpdateTimeGTE(c))
+	}
+	if v, ok := in["updateTimeLT"]; ok && v != nil {
+		c, err := convertCommentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.UpdateTimeLT(c))
+	}
+	if v, ok := in["updateTimeLTE"]; ok && v != nil {
+		c, err := convertCommentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.UpdateTimeLTE(c))
+	}
+	if v, ok := in["body"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyEQ(c))
+	}
+	if v, ok := in["bodyNEQ"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyNEQ(c))
+	}
+	if v, ok := in["bodyIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertCommentBody(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, comment.BodyIn(vs...))
+	}
+	if v, ok := in["bodyNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertCommentBody(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, comment.BodyNotIn(vs...))
+	}
+	if v, ok := in["bodyGT"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyGT(c))
+	}
+	if v, ok := in["bodyGTE"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyGTE(c))
+	}
+	if v, ok := in["bodyLT"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyLT(c))
+	}
+	if v, ok := in["bodyLTE"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyLTE(c))
+	}
+	if v, ok := in["bodyContains"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyContains(c))
+	}
+	if v, ok := in["bodyHasPrefix"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyHasPrefix(c))
+	}
+	if v, ok := in["bodyHasSuffix"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyHasSuffix(c))
+	}
+	if v, ok := in["bodyEqualFold"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyEqualFold(c))
+	}
+	if v, ok := in["bodyContainsFold"]; ok && v != nil {
+		c, err := convertCommentBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.BodyContainsFold(c))
+	}
+	if v, ok := in["visibility"]; ok && v != nil {
+		c, err := convertCommentVisibility(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.VisibilityEQ(c))
+	}
+	if v, ok := in["visibilityNEQ"]; ok && v != nil {
+		c, err := convertCommentVisibility(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.VisibilityNEQ(c))
+	}
+	if v, ok := in["visibilityIn"].([]interface{}); ok {
+		vs := make([]comment.Visibility, len(v))
+		for i := range v {
+			c, err := convertCommentVisibility(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, comment.VisibilityIn(vs...))
+	}
+	if v, ok := in["visibilityNotIn"].([]interface{}); ok {
+		vs := make([]comment.Visibility, len(v))
+		for i := range v {
+			c, err := convertCommentVisibility(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, comment.VisibilityNotIn(vs...))
+	}
+	if v, ok := in["edited"]; ok && v != nil {
+		c, err := convertCommentEdited(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.EditedEQ(c))
+	}
+	if v, ok := in["editedNEQ"]; ok && v != nil {
+		c, err := convertCommentEdited(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, comment.EditedNEQ(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, comment.HasOrganization())
+		} else {
+			ps = append(ps, comment.Not(comment.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, comment.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasRepairSlip"].(bool); ok {
+		if v {
+			ps = append(ps, comment.HasRepairSlip())
+		} else {
+			ps = append(ps, comment.Not(comment.HasRepairSlip()))
+		}
+	}
+	if v, ok := in["hasRepairSlipWith"]; ok && v != nil {
+		var with []predicate.RepairSlip
+		for _, v := range wheres(v) {
+			sub, err := s.whereRepairSlip(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, comment.HasRepairSlipWith(with...))
+	}
+	if v, ok := in["hasAuthor"].(bool); ok {
+		if v {
+			ps = append(ps, comment.HasAuthor())
+		} else {
+			ps = append(ps, comment.Not(comment.HasAuthor()))
+		}
+	}
+	if v, ok := in["hasAuthorWith"]; ok && v != nil {
+		var with []predicate.User
+		for _, v := range wheres(v) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, comment.HasAuthorWith(with...))
+	}
+	if v, ok := in["hasMentions"].(bool); ok {
+		if v {
+			ps = append(ps, comment.HasMentions())
+		} else {
+			ps = append(ps, comment.Not(comment.HasMentions()))
+		}
+	}
+	if v, ok := in["hasMentionsWith"]; ok && v != nil {
+		var with []predicate.User
+		for _, v := range wheres(v) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, comment.HasMentionsWith(with...))
+	}
+	if v, ok := in["hasRevisions"].(bool); ok {
+		if v {
+			ps = append(ps, comment.HasRevisions())
+		} else {
+			ps = append(ps, comment.Not(comment.HasRevisions()))
+		}
+	}
+	if v, ok := in["hasRevisionsWith"]; ok && v != nil {
+		var with []predicate.CommentRevision
+		for _, v := range wheres(v) {
+			sub, err := s.whereCommentRevision(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, comment.HasRevisionsWith(with...))
+	}
+	return ps, nil
+}
+
+// convertCommentCreateTime returns the create_time of Comment of an argument.
+func convertCommentCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertCommentUpdateTime returns the update_time of Comment of an argument.
+func convertCommentUpdateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: updateTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertCommentBody returns the body of Comment of an argument.
+func convertCommentBody(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertCommentVisibility returns the visibility of Comment of an argument.
+func convertCommentVisibility(v interface{}) (comment.Visibility, error) {
+	s, _ := v.(string)
+	return comment.Visibility(s), nil
+}
+
+// convertCommentEdited returns the edited of Comment of an argument.
+func convertCommentEdited(v interface{}) (bool, error) {
+	b, _ := v.(bool)
+	return b, nil
+}
+
+// defineCommentRevision defines the types of CommentRevision and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineCommentRevision(query, mutation graphql.Fields, mutable bool) {
+	leaves := map[string]leaf{
+		"create_time": graphql.DateTime,
+		"body":        graphql.String,
+	}
+
+	s.objects["CommentRevision"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "CommentRevision",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.CommentRevision).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.CommentRevision).CreateTime
+						return v, nil
+					},
+				},
+				"body": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["body"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.CommentRevision).Body
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "CommentRevision.organization", p.Source.(*ent.CommentRevision).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.CommentRevision.Query().
+								Where(commentrevision.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"comment": &graphql.Field{
+					Type: s.objects["Comment"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "CommentRevision.comment", p.Source.(*ent.CommentRevision).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.CommentRevision.Query().
+								Where(commentrevision.IDIn(ids...)).
+								WithComment(func(q *ent.CommentQuery) {
+									q.Where(s.scopeComment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Comment != nil {
+									values[n.ID] = n.Edges.Comment
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["CommentRevision"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CommentRevisionWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["CommentRevision"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                 &graphql.InputObjectFieldConfig{Type: where},
+				"and":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"body":                &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["body"]))},
+				"bodyNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["body"]))},
+				"bodyGT":              &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyGTE":             &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyLT":              &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyLTE":             &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyContains":        &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"bodyContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["body"]},
+				"hasOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasComment":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasCommentWith":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Comment"]))},
+			}
+		}),
+	})
+
+	query["commentRevision"] = &graphql.Field{
+		Type: s.objects["CommentRevision"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.CommentRevision.Query().
+				Where(commentrevision.ID(id)).
+				Where(s.scopeCommentRevision(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["commentRevisions"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("CommentRevision")),
+		Args: connectionArgs(s.wheres["CommentRevision"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.CommentRevision.Query().Where(s.scopeCommentRevision(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereCommentRevision(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(commentrevision.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(commentrevision.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(commentrevision.FieldID))
+			} else {
+				q.Order(ent.Asc(commentrevision.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateCommentRevisionInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"body":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["body"])},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"commentID":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateCommentRevisionInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"commentID":         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearComment":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createCommentRevision"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["CommentRevision"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createCommentRevision"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.CommentRevision.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertCommentRevisionCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["body"]; ok && v != nil {
+				c, err := convertCommentRevisionBody(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetBody(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["commentID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCommentID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateCommentRevision"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["CommentRevision"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateCommentRevision"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.CommentRevision.UpdateOneID(id)
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["commentID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCommentID(id)
+			}
+			if v, ok := in["clearComment"]; ok && v == true {
+				b.ClearComment()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteCommentRevision"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteCommentRevision"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.CommentRevision.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeCommentRevision returns the predicates narrowing the CommentRevision entities
+// that can be read.
+func (s *Schema) scopeCommentRevision(ctx context.Context) []predicate.CommentRevision {
+	if s.opts.Scopes.CommentRevision == nil {
+		return nil
+	}
+	return s.opts.Scopes.CommentRevision(ctx)
+}
+
+// whereCommentRevision returns the predicates of a CommentRevisionWhereInput.
+func (s *Schema) whereCommentRevision(in map[string]interface{}) ([]predicate.CommentRevision, error) {
+	var ps []predicate.CommentRevision
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereCommentRevision(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, commentrevision.Not(commentrevision.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.CommentRevision
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereCommentRevision(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, commentrevision.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, commentrevision.And(group...))
+		default:
+			ps = append(ps, commentrevision.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertCommentRevisionCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertCommentRevisionCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertCommentRevisionCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, commentrevision.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertCommentRevisionCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, commentrevision.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertCommentRevisionCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertCommentRevisionCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertCommentRevisionCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertCommentRevisionCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.CreateTimeLTE(c))
+	}
+	if v, ok := in["body"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyEQ(c))
+	}
+	if v, ok := in["bodyNEQ"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyNEQ(c))
+	}
+	if v, ok := in["bodyIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertCommentRevisionBody(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, commentrevision.BodyIn(vs...))
+	}
+	if v, ok := in["bodyNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertCommentRevisionBody(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, commentrevision.BodyNotIn(vs...))
+	}
+	if v, ok := in["bodyGT"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyGT(c))
+	}
+	if v, ok := in["bodyGTE"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyGTE(c))
+	}
+	if v, ok := in["bodyLT"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyLT(c))
+	}
+	if v, ok := in["bodyLTE"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyLTE(c))
+	}
+	if v, ok := in["bodyContains"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyContains(c))
+	}
+	if v, ok := in["bodyHasPrefix"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyHasPrefix(c))
+	}
+	if v, ok := in["bodyHasSuffix"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyHasSuffix(c))
+	}
+	if v, ok := in["bodyEqualFold"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyEqualFold(c))
+	}
+	if v, ok := in["bodyContainsFold"]; ok && v != nil {
+		c, err := convertCommentRevisionBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, commentrevision.BodyContainsFold(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, commentrevision.HasOrganization())
+		} else {
+			ps = append(ps, commentrevision.Not(commentrevision.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, commentrevision.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasComment"].(bool); ok {
+		if v {
+			ps = append(ps, commentrevision.HasComment())
+		} else {
+			ps = append(ps, commentrevision.Not(commentrevision.HasComment()))
+		}
+	}
+	if v, ok := in["hasCommentWith"]; ok && v != nil {
+		var with []predicate.Comment
+		for _, v := range wheres(v) {
+			sub, err := s.whereComment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, commentrevision.HasCommentWith(with...))
+	}
+	return ps, nil
+}
+
+// convertCommentRevisionCreateTime returns the create_time of CommentRevision of an argument.
+func convertCommentRevisionCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertCommentRevisionBody returns the body of CommentRevision of an argument.
+func convertCommentRevisionBody(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// defineEquipment defines the types of Equipment and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineEquipment(query, mutation graphql.Fields, mutable bool) {
+	leaves := map[string]leaf{
+		"create_time":       graphql.DateTime,
+		"update_time":       graphql.DateTime,
+		"name":              graphql.String,
+		"serial_number":     graphql.String,
+		"model":             graphql.String,
+		"asset_tag":         graphql.String,
+		"warranty_start":    graphql.DateTime,
+		"warranty_end":      graphql.DateTime,
+		"warranty_provider": graphql.String,
+	}
+
+	s.objects["Equipment"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Equipment",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Equipment).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Equipment).CreateTime
+						return v, nil
+					},
+				},
+				"updateTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["update_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Equipment).UpdateTime
+						return v, nil
+					},
+				},
+				"name": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["name"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Equipment).Name
+						return v, nil
+					},
+				},
+				"serialNumber": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["serial_number"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Equipment).SerialNumber
+						return v, nil
+					},
+				},
+				"model": &graphql.Field{
+					Type: leaves["model"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Equipment).Model
+						return v, nil
+					},
+				},
+				"assetTag": &graphql.Field{
+					Type: leaves["asset_tag"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Equipment).AssetTag
+						return v, nil
+					},
+				},
+				"warrantyStart": &graphql.Field{
+					Type: leaves["warranty_start"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Equipment).WarrantyStart
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"warrantyEnd": &graphql.Field{
+					Type: leaves["warranty_end"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Equipment).WarrantyEnd
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"warrantyProvider": &graphql.Field{
+					Type: leaves["warranty_provider"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Equipment).WarrantyProvider
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Equipment.organization", p.Source.(*ent.Equipment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Equipment.Query().
+								Where(equipment.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"warrantyTerm": &graphql.Field{
+					Type: s.objects["WarrantyTerm"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Equipment.warranty_term", p.Source.(*ent.Equipment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Equipment.Query().
+								Where(equipment.IDIn(ids...)).
+								WithWarrantyTerm(func(q *ent.WarrantyTermQuery) {
+									q.Where(s.scopeWarrantyTerm(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.WarrantyTerm != nil {
+									values[n.ID] = n.Edges.WarrantyTerm
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"repairSlips": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["RepairSlip"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Equipment.repair_slips", p.Source.(*ent.Equipment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Equipment.Query().
+								Where(equipment.IDIn(ids...)).
+								WithRepairSlips(func(q *ent.RepairSlipQuery) {
+									q.Where(s.scopeRepairSlip(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.RepairSlips
+								if edges == nil {
+									edges = []*ent.RepairSlip{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"location": &graphql.Field{
+					Type: s.objects["Location"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Equipment.location", p.Source.(*ent.Equipment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Equipment.Query().
+								Where(equipment.IDIn(ids...)).
+								WithLocation(func(q *ent.LocationQuery) {
+									q.Where(s.scopeLocation(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Location != nil {
+									values[n.ID] = n.Edges.Location
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"moves": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["EquipmentMove"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Equipment.moves", p.Source.(*ent.Equipment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Equipment.Query().
+								Where(equipment.IDIn(ids...)).
+								WithMoves(func(q *ent.EquipmentMoveQuery) {
+									q.Where(s.scopeEquipmentMove(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Moves
+								if edges == nil {
+									edges = []*ent.EquipmentMove{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Equipment"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "EquipmentWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Equipment"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                          &graphql.InputObjectFieldConfig{Type: where},
+				"and":                          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                           &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":                      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":                   &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":                &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":                 &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":                &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":                 &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":                &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"updateTime":                   &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeNEQ":                &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeNotIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeGT":                 &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeGTE":                &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLT":                 &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLTE":                &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"name":                         &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameNEQ":                      &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameIn":                       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameNotIn":                    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameGT":                       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameGTE":                      &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLT":                       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLTE":                      &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContains":                 &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasPrefix":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasSuffix":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameEqualFold":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContainsFold":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"serialNumber":                 &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["serial_number"]))},
+				"serialNumberNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["serial_number"]))},
+				"serialNumberGT":               &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberGTE":              &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberLT":               &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberLTE":              &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberContains":         &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberHasPrefix":        &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberHasSuffix":        &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberEqualFold":        &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"serialNumberContainsFold":     &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+				"model":                        &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelNEQ":                     &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelIn":                      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["model"]))},
+				"modelNotIn":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["model"]))},
+				"modelGT":                      &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelGTE":                     &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelLT":                      &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelLTE":                     &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelContains":                &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelHasPrefix":               &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelHasSuffix":               &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelIsNil":                   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"modelNotNil":                  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"modelEqualFold":               &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"modelContainsFold":            &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+				"assetTag":                     &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagNEQ":                  &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagIn":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["asset_tag"]))},
+				"assetTagNotIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["asset_tag"]))},
+				"assetTagGT":                   &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagGTE":                  &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagLT":                   &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagLTE":                  &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagContains":             &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagHasPrefix":            &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagHasSuffix":            &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagIsNil":                &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"assetTagNotNil":               &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"assetTagEqualFold":            &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"assetTagContainsFold":         &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+				"warrantyStart":                &graphql.InputObjectFieldConfig{Type: leaves["warranty_start"]},
+				"warrantyStartNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["warranty_start"]},
+				"warrantyStartIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["warranty_start"]))},
+				"warrantyStartNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["warranty_start"]))},
+				"warrantyStartGT":              &graphql.InputObjectFieldConfig{Type: leaves["warranty_start"]},
+				"warrantyStartGTE":             &graphql.InputObjectFieldConfig{Type: leaves["warranty_start"]},
+				"warrantyStartLT":              &graphql.InputObjectFieldConfig{Type: leaves["warranty_start"]},
+				"warrantyStartLTE":             &graphql.InputObjectFieldConfig{Type: leaves["warranty_start"]},
+				"warrantyStartIsNil":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"warrantyStartNotNil":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"warrantyEnd":                  &graphql.InputObjectFieldConfig{Type: leaves["warranty_end"]},
+				"warrantyEndNEQ":               &graphql.InputObjectFieldConfig{Type: leaves["warranty_end"]},
+				"warrantyEndIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["warranty_end"]))},
+				"warrantyEndNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["warranty_end"]))},
+				"warrantyEndGT":                &graphql.InputObjectFieldConfig{Type: leaves["warranty_end"]},
+				"warrantyEndGTE":               &graphql.InputObjectFieldConfig{Type: leaves["warranty_end"]},
+				"warrantyEndLT":                &graphql.InputObjectFieldConfig{Type: leaves["warranty_end"]},
+				"warrantyEndLTE":               &graphql.InputObjectFieldConfig{Type: leaves["warranty_end"]},
+				"warrantyEndIsNil":             &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"warrantyEndNotNil":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"warrantyProvider":             &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["warranty_provider"]))},
+				"warrantyProviderNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["warranty_provider"]))},
+				"warrantyProviderGT":           &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderGTE":          &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderLT":           &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderLTE":          &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderContains":     &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderIsNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"warrantyProviderNotNil":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"warrantyProviderEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"warrantyProviderContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+				"hasOrganization":              &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasWarrantyTerm":              &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasWarrantyTermWith":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["WarrantyTerm"]))},
+				"hasRepairSlips":               &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasRepairSlipsWith":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["RepairSlip"]))},
+				"hasLocation":                  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasLocationWith":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Location"]))},
+				"hasMoves":                     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasMovesWith":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["EquipmentMove"]))},
+			}
+		}),
+	})
+
+	query["equipment"] = &graphql.Field{
+		Type: s.objects["Equipment"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Equipment.Query().
+				Where(equipment.ID(id)).
+				Where(s.scopeEquipment(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["equipmentslice"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Equipment")),
+		Args: connectionArgs(s.wheres["Equipment"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Equipment.Query().Where(s.scopeEquipment(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereEquipment(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(equipment.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(equipment.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(equipment.FieldID))
+			} else {
+				q.Order(ent.Asc(equipment.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateEquipmentInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"updateTime":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+			"name":             &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["name"])},
+			"serialNumber":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["serial_number"])},
+			"model":            &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+			"assetTag":         &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+			"warrantyStart":    &graphql.InputObjectFieldConfig{Type: leaves["warranty_start"]},
+			"warrantyEnd":      &graphql.InputObjectFieldConfig{Type: leaves["warranty_end"]},
+			"warrantyProvider": &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+			"organizationID":   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"warrantyTermID":   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"repairSlipIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"locationID":       &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"moveIDs":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateEquipmentInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":                  &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+			"serialNumber":          &graphql.InputObjectFieldConfig{Type: leaves["serial_number"]},
+			"model":                 &graphql.InputObjectFieldConfig{Type: leaves["model"]},
+			"clearModel":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"assetTag":              &graphql.InputObjectFieldConfig{Type: leaves["asset_tag"]},
+			"clearAssetTag":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"warrantyStart":         &graphql.InputObjectFieldConfig{Type: leaves["warranty_start"]},
+			"clearWarrantyStart":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"warrantyEnd":           &graphql.InputObjectFieldConfig{Type: leaves["warranty_end"]},
+			"clearWarrantyEnd":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"warrantyProvider":      &graphql.InputObjectFieldConfig{Type: leaves["warranty_provider"]},
+			"clearWarrantyProvider": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"organizationID":        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"warrantyTermID":        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearWarrantyTerm":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addRepairSlipIDs":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeRepairSlipIDs":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"locationID":            &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearLocation":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addMoveIDs":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeMoveIDs":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	mutation["createEquipment"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Equipment"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createEquipment"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Equipment.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertEquipmentCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["updateTime"]; ok && v != nil {
+				c, err := convertEquipmentUpdateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUpdateTime(c)
+			}
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertEquipmentName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["serialNumber"]; ok && v != nil {
+				c, err := convertEquipmentSerialNumber(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSerialNumber(c)
+			}
+			if v, ok := in["model"]; ok && v != nil {
+				c, err := convertEquipmentModel(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetModel(c)
+			}
+			if v, ok := in["assetTag"]; ok && v != nil {
+				c, err := convertEquipmentAssetTag(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAssetTag(c)
+			}
+			if v, ok := in["warrantyStart"]; ok && v != nil {
+				c, err := convertEquipmentWarrantyStart(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWarrantyStart(c)
+			}
+			if v, ok := in["warrantyEnd"]; ok && v != nil {
+				c, err := convertEquipmentWarrantyEnd(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWarrantyEnd(c)
+			}
+			if v, ok := in["warrantyProvider"]; ok && v != nil {
+				c, err := convertEquipmentWarrantyProvider(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWarrantyProvider(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["warrantyTermID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWarrantyTermID(id)
+			}
+			if v, ok := in["repairSlipIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddRepairSlipIDs(ids...)
+			}
+			if v, ok := in["locationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLocationID(id)
+			}
+			if v, ok := in["moveIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddMoveIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateEquipment"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Equipment"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateEquipment"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Equipment.UpdateOneID(id)
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertEquipmentName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["serialNumber"]; ok && v != nil {
+				c, err := convertEquipmentSerialNumber(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSerialNumber(c)
+			}
+			if v, ok := in["model"]; ok && v != nil {
+				c, err := convertEquipmentModel(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetModel(c)
+			}
+			if v, ok := in["clearModel"]; ok && v == true {
+				b.ClearModel()
+			}
+			if v, ok := in["assetTag"]; ok && v != nil {
+				c, err := convertEquipmentAssetTag(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAssetTag(c)
+			}
+			if v, ok := in["clearAssetTag"]; ok && v == true {
+				b.ClearAssetTag()
+			}
+			if v, ok := in["warrantyStart"]; ok && v != nil {
+				c, err := convertEquipmentWarrantyStart(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWarrantyStart(c)
+			}
+			if v, ok := in["clearWarrantyStart"]; ok && v == true {
+				b.ClearWarrantyStart()
+			}
+			if v, ok := in["warrantyEnd"]; ok && v != nil {
+				c, err := convertEquipmentWarrantyEnd(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWarrantyEnd(c)
+			}
+			if v, ok := in["clearWarrantyEnd"]; ok && v == true {
+				b.ClearWarrantyEnd()
+			}
+			if v, ok := in["warrantyProvider"]; ok && v != nil {
+				c, err := convertEquipmentWarrantyProvider(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWarrantyProvider(c)
+			}
+			if v, ok := in["clearWarrantyProvider"]; ok && v == true {
+				b.ClearWarrantyProvider()
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["warrantyTermID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWarrantyTermID(id)
+			}
+			if v, ok := in["clearWarrantyTerm"]; ok && v == true {
+				b.ClearWarrantyTerm()
+			}
+			if v, ok := in["addRepairSlipIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddRepairSlipIDs(ids...)
+			}
+			if v, ok := in["removeRepairSlipIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveRepairSlipIDs(ids...)
+			}
+			if v, ok := in["locationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLocationID(id)
+			}
+			if v, ok := in["clearLocation"]; ok && v == true {
+				b.ClearLocation()
+			}
+			if v, ok := in["addMoveIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddMoveIDs(ids...)
+			}
+			if v, ok := in["removeMoveIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveMoveIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteEquipment"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteEquipment"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Equipment.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeEquipment returns the predicates narrowing the Equipment entities
+// that can be read.
+func (s *Schema) scopeEquipment(ctx context.Context) []predicate.Equipment {
+	if s.opts.Scopes.Equipment == nil {
+		return nil
+	}
+	return s.opts.Scopes.Equipment(ctx)
+}
+
+// whereEquipment returns the predicates of a EquipmentWhereInput.
+func (s *Schema) whereEquipment(in map[string]interface{}) ([]predicate.Equipment, error) {
+	var ps []predicate.Equipment
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereEquipment(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, equipment.Not(equipment.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Equipment
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereEquipment(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, equipment.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, equipment.And(group...))
+		default:
+			ps = append(ps, equipment.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertEquipmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertEquipmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertEquipmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertEquipmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertEquipmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertEquipmentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.CreateTimeLTE(c))
+	}
+	if v, ok := in["updateTime"]; ok && v != nil {
+		c, err := convertEquipmentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.UpdateTimeEQ(c))
+	}
+	if v, ok := in["updateTimeNEQ"]; ok && v != nil {
+		c, err := convertEquipmentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.UpdateTimeNEQ(c))
+	}
+	if v, ok := in["updateTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.UpdateTimeIn(vs...))
+	}
+	if v, ok := in["updateTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.UpdateTimeNotIn(vs...))
+	}
+	if v, ok := in["updateTimeGT"]; ok && v != nil {
+		c, err := convertEquipmentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.UpdateTimeGT(c))
+	}
+	if v, ok := in["updateTimeGTE"]; ok && v != nil {
+		c, err := convertEquipmentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.UpdateTimeGTE(c))
+	}
+	if v, ok := in["updateTimeLT"]; ok && v != nil {
+		c, err := convertEquipmentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.UpdateTimeLT(c))
+	}
+	if v, ok := in["updateTimeLTE"]; ok && v != nil {
+		c, err := convertEquipmentUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.UpdateTimeLTE(c))
+	}
+	if v, ok := in["name"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameEQ(c))
+	}
+	if v, ok := in["nameNEQ"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameNEQ(c))
+	}
+	if v, ok := in["nameIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.NameIn(vs...))
+	}
+	if v, ok := in["nameNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.NameNotIn(vs...))
+	}
+	if v, ok := in["nameGT"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameGT(c))
+	}
+	if v, ok := in["nameGTE"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameGTE(c))
+	}
+	if v, ok := in["nameLT"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameLT(c))
+	}
+	if v, ok := in["nameLTE"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameLTE(c))
+	}
+	if v, ok := in["nameContains"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameContains(c))
+	}
+	if v, ok := in["nameHasPrefix"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameHasPrefix(c))
+	}
+	if v, ok := in["nameHasSuffix"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameHasSuffix(c))
+	}
+	if v, ok := in["nameEqualFold"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameEqualFold(c))
+	}
+	if v, ok := in["nameContainsFold"]; ok && v != nil {
+		c, err := convertEquipmentName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.NameContainsFold(c))
+	}
+	if v, ok := in["serialNumber"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberEQ(c))
+	}
+	if v, ok := in["serialNumberNEQ"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberNEQ(c))
+	}
+	if v, ok := in["serialNumberIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentSerialNumber(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.SerialNumberIn(vs...))
+	}
+	if v, ok := in["serialNumberNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentSerialNumber(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.SerialNumberNotIn(vs...))
+	}
+	if v, ok := in["serialNumberGT"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberGT(c))
+	}
+	if v, ok := in["serialNumberGTE"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberGTE(c))
+	}
+	if v, ok := in["serialNumberLT"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberLT(c))
+	}
+	if v, ok := in["serialNumberLTE"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberLTE(c))
+	}
+	if v, ok := in["serialNumberContains"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberContains(c))
+	}
+	if v, ok := in["serialNumberHasPrefix"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberHasPrefix(c))
+	}
+	if v, ok := in["serialNumberHasSuffix"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberHasSuffix(c))
+	}
+	if v, ok := in["serialNumberEqualFold"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberEqualFold(c))
+	}
+	if v, ok := in["serialNumberContainsFold"]; ok && v != nil {
+		c, err := convertEquipmentSerialNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.SerialNumberContainsFold(c))
+	}
+	if v, ok := in["model"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelEQ(c))
+	}
+	if v, ok := in["modelNEQ"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelNEQ(c))
+	}
+	if v, ok := in["modelIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentModel(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.ModelIn(vs...))
+	}
+	if v, ok := in["modelNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentModel(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.ModelNotIn(vs...))
+	}
+	if v, ok := in["modelGT"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelGT(c))
+	}
+	if v, ok := in["modelGTE"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelGTE(c))
+	}
+	if v, ok := in["modelLT"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelLT(c))
+	}
+	if v, ok := in["modelLTE"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelLTE(c))
+	}
+	if v, ok := in["modelContains"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelContains(c))
+	}
+	if v, ok := in["modelHasPrefix"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelHasPrefix(c))
+	}
+	if v, ok := in["modelHasSuffix"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelHasSuffix(c))
+	}
+	if v, ok := in["modelIsNil"]; ok && v == true {
+		ps = append(ps, equipment.ModelIsNil())
+	}
+	if v, ok := in["modelNotNil"]; ok && v == true {
+		ps = append(ps, equipment.ModelNotNil())
+	}
+	if v, ok := in["modelEqualFold"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelEqualFold(c))
+	}
+	if v, ok := in["modelContainsFold"]; ok && v != nil {
+		c, err := convertEquipmentModel(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.ModelContainsFold(c))
+	}
+	if v, ok := in["assetTag"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagEQ(c))
+	}
+	if v, ok := in["assetTagNEQ"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagNEQ(c))
+	}
+	if v, ok := in["assetTagIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentAssetTag(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.AssetTagIn(vs...))
+	}
+	if v, ok := in["assetTagNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentAssetTag(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.AssetTagNotIn(vs...))
+	}
+	if v, ok := in["assetTagGT"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagGT(c))
+	}
+	if v, ok := in["assetTagGTE"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagGTE(c))
+	}
+	if v, ok := in["assetTagLT"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagLT(c))
+	}
+	if v, ok := in["assetTagLTE"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagLTE(c))
+	}
+	if v, ok := in["assetTagContains"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagContains(c))
+	}
+	if v, ok := in["assetTagHasPrefix"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagHasPrefix(c))
+	}
+	if v, ok := in["assetTagHasSuffix"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagHasSuffix(c))
+	}
+	if v, ok := in["assetTagIsNil"]; ok && v == true {
+		ps = append(ps, equipment.AssetTagIsNil())
+	}
+	if v, ok := in["assetTagNotNil"]; ok && v == true {
+		ps = append(ps, equipment.AssetTagNotNil())
+	}
+	if v, ok := in["assetTagEqualFold"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagEqualFold(c))
+	}
+	if v, ok := in["assetTagContainsFold"]; ok && v != nil {
+		c, err := convertEquipmentAssetTag(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.AssetTagContainsFold(c))
+	}
+	if v, ok := in["warrantyStart"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyStart(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyStartEQ(c))
+	}
+	if v, ok := in["warrantyStartNEQ"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyStart(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyStartNEQ(c))
+	}
+	if v, ok := in["warrantyStartIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentWarrantyStart(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.WarrantyStartIn(vs...))
+	}
+	if v, ok := in["warrantyStartNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentWarrantyStart(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.WarrantyStartNotIn(vs...))
+	}
+	if v, ok := in["warrantyStartGT"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyStart(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyStartGT(c))
+	}
+	if v, ok := in["warrantyStartGTE"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyStart(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyStartGTE(c))
+	}
+	if v, ok := in["warrantyStartLT"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyStart(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyStartLT(c))
+	}
+	if v, ok := in["warrantyStartLTE"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyStart(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyStartLTE(c))
+	}
+	if v, ok := in["warrantyStartIsNil"]; ok && v == true {
+		ps = append(ps, equipment.WarrantyStartIsNil())
+	}
+	if v, ok := in["warrantyStartNotNil"]; ok && v == true {
+		ps = append(ps, equipment.WarrantyStartNotNil())
+	}
+	if v, ok := in["warrantyEnd"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyEnd(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyEndEQ(c))
+	}
+	if v, ok := in["warrantyEndNEQ"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyEnd(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyEndNEQ(c))
+	}
+	if v, ok := in["warrantyEndIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentWarrantyEnd(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.WarrantyEndIn(vs...))
+	}
+	if v, ok := in["warrantyEndNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentWarrantyEnd(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.WarrantyEndNotIn(vs...))
+	}
+	if v, ok := in["warrantyEndGT"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyEnd(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyEndGT(c))
+	}
+	if v, ok := in["warrantyEndGTE"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyEnd(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyEndGTE(c))
+	}
+	if v, ok := in["warrantyEndLT"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyEnd(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyEndLT(c))
+	}
+	if v, ok := in["warrantyEndLTE"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyEnd(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyEndLTE(c))
+	}
+	if v, ok := in["warrantyEndIsNil"]; ok && v == true {
+		ps = append(ps, equipment.WarrantyEndIsNil())
+	}
+	if v, ok := in["warrantyEndNotNil"]; ok && v == true {
+		ps = append(ps, equipment.WarrantyEndNotNil())
+	}
+	if v, ok := in["warrantyProvider"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderEQ(c))
+	}
+	if v, ok := in["warrantyProviderNEQ"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderNEQ(c))
+	}
+	if v, ok := in["warrantyProviderIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentWarrantyProvider(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.WarrantyProviderIn(vs...))
+	}
+	if v, ok := in["warrantyProviderNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentWarrantyProvider(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipment.WarrantyProviderNotIn(vs...))
+	}
+	if v, ok := in["warrantyProviderGT"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderGT(c))
+	}
+	if v, ok := in["warrantyProviderGTE"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderGTE(c))
+	}
+	if v, ok := in["warrantyProviderLT"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderLT(c))
+	}
+	if v, ok := in["warrantyProviderLTE"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderLTE(c))
+	}
+	if v, ok := in["warrantyProviderContains"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderContains(c))
+	}
+	if v, ok := in["warrantyProviderHasPrefix"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderHasPrefix(c))
+	}
+	if v, ok := in["warrantyProviderHasSuffix"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderHasSuffix(c))
+	}
+	if v, ok := in["warrantyProviderIsNil"]; ok && v == true {
+		ps = append(ps, equipment.WarrantyProviderIsNil())
+	}
+	if v, ok := in["warrantyProviderNotNil"]; ok && v == true {
+		ps = append(ps, equipment.WarrantyProviderNotNil())
+	}
+	if v, ok := in["warrantyProviderEqualFold"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderEqualFold(c))
+	}
+	if v, ok := in["warrantyProviderContainsFold"]; ok && v != nil {
+		c, err := convertEquipmentWarrantyProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipment.WarrantyProviderContainsFold(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, equipment.HasOrganization())
+		} else {
+			ps = append(ps, equipment.Not(equipment.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipment.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasWarrantyTerm"].(bool); ok {
+		if v {
+			ps = append(ps, equipment.HasWarrantyTerm())
+		} else {
+			ps = append(ps, equipment.Not(equipment.HasWarrantyTerm()))
+		}
+	}
+	if v, ok := in["hasWarrantyTermWith"]; ok && v != nil {
+		var with []predicate.WarrantyTerm
+		for _, v := range wheres(v) {
+			sub, err := s.whereWarrantyTerm(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipment.HasWarrantyTermWith(with...))
+	}
+	if v, ok := in["hasRepairSlips"].(bool); ok {
+		if v {
+			ps = append(ps, equipment.HasRepairSlips())
+		} else {
+			ps = append(ps, equipment.Not(equipment.HasRepairSlips()))
+		}
+	}
+	if v, ok := in["hasRepairSlipsWith"]; ok && v != nil {
+		var with []predicate.RepairSlip
+		for _, v := range wheres(v) {
+			sub, err := s.whereRepairSlip(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipment.HasRepairSlipsWith(with...))
+	}
+	if v, ok := in["hasLocation"].(bool); ok {
+		if v {
+			ps = append(ps, equipment.HasLocation())
+		} else {
+			ps = append(ps, equipment.Not(equipment.HasLocation()))
+		}
+	}
+	if v, ok := in["hasLocationWith"]; ok && v != nil {
+		var with []predicate.Location
+		for _, v := range wheres(v) {
+			sub, err := s.whereLocation(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipment.HasLocationWith(with...))
+	}
+	if v, ok := in["hasMoves"].(bool); ok {
+		if v {
+			ps = append(ps, equipment.HasMoves())
+		} else {
+			ps = append(ps, equipment.Not(equipment.HasMoves()))
+		}
+	}
+	if v, ok := in["hasMovesWith"]; ok && v != nil {
+		var with []predicate.EquipmentMove
+		for _, v := range wheres(v) {
+			sub, err := s.whereEquipmentMove(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipment.HasMovesWith(with...))
+	}
+	return ps, nil
+}
+
+// convertEquipmentCreateTime returns the create_time of Equipment of an argument.
+func convertEquipmentCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertEquipmentUpdateTime returns the update_time of Equipment of an argument.
+func convertEquipmentUpdateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: updateTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertEquipmentName returns the name of Equipment of an argument.
+func convertEquipmentName(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertEquipmentSerialNumber returns the serial_number of Equipment of an argument.
+func convertEquipmentSerialNumber(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertEquipmentModel returns the model of Equipment of an argument.
+func convertEquipmentModel(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertEquipmentAssetTag returns the asset_tag of Equipment of an argument.
+func convertEquipmentAssetTag(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertEquipmentWarrantyStart returns the warranty_start of Equipment of an argument.
+func convertEquipmentWarrantyStart(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: warrantyStart: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertEquipmentWarrantyEnd returns the warranty_end of Equipment of an argument.
+func convertEquipmentWarrantyEnd(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: warrantyEnd: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertEquipmentWarrantyProvider returns the warranty_provider of Equipment of an argument.
+func convertEquipmentWarrantyProvider(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// defineEquipmentMove defines the types of EquipmentMove and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineEquipmentMove(query, mutation graphql.Fields, mutable bool) {
+	leaves := map[string]leaf{
+		"create_time": graphql.DateTime,
+		"note":        graphql.String,
+	}
+
+	s.objects["EquipmentMove"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "EquipmentMove",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.EquipmentMove).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.EquipmentMove).CreateTime
+						return v, nil
+					},
+				},
+				"note": &graphql.Field{
+					Type: leaves["note"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.EquipmentMove).Note
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "EquipmentMove.organization", p.Source.(*ent.EquipmentMove).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.EquipmentMove.Query().
+								Where(equipmentmove.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"equipment": &graphql.Field{
+					Type: s.objects["Equipment"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "EquipmentMove.equipment", p.Source.(*ent.EquipmentMove).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.EquipmentMove.Query().
+								Where(equipmentmove.IDIn(ids...)).
+								WithEquipment(func(q *ent.EquipmentQuery) {
+									q.Where(s.scopeEquipment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Equipment != nil {
+									values[n.ID] = n.Edges.Equipment
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"fromLocation": &graphql.Field{
+					Type: s.objects["Location"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "EquipmentMove.from_location", p.Source.(*ent.EquipmentMove).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.EquipmentMove.Query().
+								Where(equipmentmove.IDIn(ids...)).
+								WithFromLocation(func(q *ent.LocationQuery) {
+									q.Where(s.scopeLocation(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.FromLocation != nil {
+									values[n.ID] = n.Edges.FromLocation
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"toLocation": &graphql.Field{
+					Type: s.objects["Location"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "EquipmentMove.to_location", p.Source.(*ent.EquipmentMove).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.EquipmentMove.Query().
+								Where(equipmentmove.IDIn(ids...)).
+								WithToLocation(func(q *ent.LocationQuery) {
+									q.Where(s.scopeLocation(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.ToLocation != nil {
+									values[n.ID] = n.Edges.ToLocation
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"movedBy": &graphql.Field{
+					Type: s.objects["User"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "EquipmentMove.moved_by", p.Source.(*ent.EquipmentMove).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.EquipmentMove.Query().
+								Where(equipmentmove.IDIn(ids...)).
+								WithMovedBy(func(q *ent.UserQuery) {
+									q.Where(s.scopeUser(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.MovedBy != nil {
+									values[n.ID] = n.Edges.MovedBy
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["EquipmentMove"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "EquipmentMoveWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["EquipmentMove"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                 &graphql.InputObjectFieldConfig{Type: where},
+				"and":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"note":                &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["note"]))},
+				"noteNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["note"]))},
+				"noteGT":              &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteGTE":             &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteLT":              &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteLTE":             &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteContains":        &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteIsNil":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"noteNotNil":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"noteEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"hasOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasEquipment":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasEquipmentWith":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Equipment"]))},
+				"hasFromLocation":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasFromLocationWith": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Location"]))},
+				"hasToLocation":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasToLocationWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Location"]))},
+				"hasMovedBy":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasMovedByWith":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["User"]))},
+			}
+		}),
+	})
+
+	query["equipmentMove"] = &graphql.Field{
+		Type: s.objects["EquipmentMove"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.EquipmentMove.Query().
+				Where(equipmentmove.ID(id)).
+				Where(s.scopeEquipmentMove(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["equipmentMoves"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("EquipmentMove")),
+		Args: connectionArgs(s.wheres["EquipmentMove"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.EquipmentMove.Query().Where(s.scopeEquipmentMove(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereEquipmentMove(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(equipmentmove.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(equipmentmove.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(equipmentmove.FieldID))
+			} else {
+				q.Order(ent.Asc(equipmentmove.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateEquipmentMoveInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"note":           &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"equipmentID":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"fromLocationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"toLocationID":   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"movedByID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateEquipmentMoveInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"equipmentID":       &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearEquipment":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"fromLocationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearFromLocation": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"toLocationID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearToLocation":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"movedByID":         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearMovedBy":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createEquipmentMove"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["EquipmentMove"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createEquipmentMove"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.EquipmentMove.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertEquipmentMoveCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["note"]; ok && v != nil {
+				c, err := convertEquipmentMoveNote(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetNote(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["equipmentID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEquipmentID(id)
+			}
+			if v, ok := in["fromLocationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetFromLocationID(id)
+			}
+			if v, ok := in["toLocationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetToLocationID(id)
+			}
+			if v, ok := in["movedByID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetMovedByID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateEquipmentMove"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["EquipmentMove"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateEquipmentMove"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.EquipmentMove.UpdateOneID(id)
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["equipmentID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEquipmentID(id)
+			}
+			if v, ok := in["clearEquipment"]; ok && v == true {
+				b.ClearEquipment()
+			}
+			if v, ok := in["fromLocationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetFromLocationID(id)
+			}
+			if v, ok := in["clearFromLocation"]; ok && v == true {
+				b.ClearFromLocation()
+			}
+			if v, ok := in["toLocationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetToLocationID(id)
+			}
+			if v, ok := in["clearToLocation"]; ok && v == true {
+				b.ClearToLocation()
+			}
+			if v, ok := in["movedByID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetMovedByID(id)
+			}
+			if v, ok := in["clearMovedBy"]; ok && v == true {
+				b.ClearMovedBy()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteEquipmentMove"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteEquipmentMove"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.EquipmentMove.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeEquipmentMove returns the predicates narrowing the EquipmentMove entities
+// that can be read.
+func (s *Schema) scopeEquipmentMove(ctx context.Context) []predicate.EquipmentMove {
+	if s.opts.Scopes.EquipmentMove == nil {
+		return nil
+	}
+	return s.opts.Scopes.EquipmentMove(ctx)
+}
+
+// whereEquipmentMove returns the predicates of a EquipmentMoveWhereInput.
+func (s *Schema) whereEquipmentMove(in map[string]interface{}) ([]predicate.EquipmentMove, error) {
+	var ps []predicate.EquipmentMove
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereEquipmentMove(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, equipmentmove.Not(equipmentmove.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.EquipmentMove
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereEquipmentMove(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, equipmentmove.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, equipmentmove.And(group...))
+		default:
+			ps = append(ps, equipmentmove.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertEquipmentMoveCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertEquipmentMoveCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentMoveCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipmentmove.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertEquipmentMoveCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipmentmove.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertEquipmentMoveCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertEquipmentMoveCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertEquipmentMoveCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertEquipmentMoveCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.CreateTimeLTE(c))
+	}
+	if v, ok := in["note"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteEQ(c))
+	}
+	if v, ok := in["noteNEQ"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteNEQ(c))
+	}
+	if v, ok := in["noteIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentMoveNote(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipmentmove.NoteIn(vs...))
+	}
+	if v, ok := in["noteNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertEquipmentMoveNote(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, equipmentmove.NoteNotIn(vs...))
+	}
+	if v, ok := in["noteGT"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteGT(c))
+	}
+	if v, ok := in["noteGTE"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteGTE(c))
+	}
+	if v, ok := in["noteLT"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteLT(c))
+	}
+	if v, ok := in["noteLTE"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteLTE(c))
+	}
+	if v, ok := in["noteContains"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteContains(c))
+	}
+	if v, ok := in["noteHasPrefix"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteHasPrefix(c))
+	}
+	if v, ok := in["noteHasSuffix"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteHasSuffix(c))
+	}
+	if v, ok := in["noteIsNil"]; ok && v == true {
+		ps = append(ps, equipmentmove.NoteIsNil())
+	}
+	if v, ok := in["noteNotNil"]; ok && v == true {
+		ps = append(ps, equipmentmove.NoteNotNil())
+	}
+	if v, ok := in["noteEqualFold"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteEqualFold(c))
+	}
+	if v, ok := in["noteContainsFold"]; ok && v != nil {
+		c, err := convertEquipmentMoveNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, equipmentmove.NoteContainsFold(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, equipmentmove.HasOrganization())
+		} else {
+			ps = append(ps, equipmentmove.Not(equipmentmove.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipmentmove.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasEquipment"].(bool); ok {
+		if v {
+			ps = append(ps, equipmentmove.HasEquipment())
+		} else {
+			ps = append(ps, equipmentmove.Not(equipmentmove.HasEquipment()))
+		}
+	}
+	if v, ok := in["hasEquipmentWith"]; ok && v != nil {
+		var with []predicate.Equipment
+		for _, v := range wheres(v) {
+			sub, err := s.whereEquipment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipmentmove.HasEquipmentWith(with...))
+	}
+	if v, ok := in["hasFromLocation"].(bool); ok {
+		if v {
+			ps = append(ps, equipmentmove.HasFromLocation())
+		} else {
+			ps = append(ps, equipmentmove.Not(equipmentmove.HasFromLocation()))
+		}
+	}
+	if v, ok := in["hasFromLocationWith"]; ok && v != nil {
+		var with []predicate.Location
+		for _, v := range wheres(v) {
+			sub, err := s.whereLocation(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipmentmove.HasFromLocationWith(with...))
+	}
+	if v, ok := in["hasToLocation"].(bool); ok {
+		if v {
+			ps = append(ps, equipmentmove.HasToLocation())
+		} else {
+			ps = append(ps, equipmentmove.Not(equipmentmove.HasToLocation()))
+		}
+	}
+	if v, ok := in["hasToLocationWith"]; ok && v != nil {
+		var with []predicate.Location
+		for _, v := range wheres(v) {
+			sub, err := s.whereLocation(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipmentmove.HasToLocationWith(with...))
+	}
+	if v, ok := in["hasMovedBy"].(bool); ok {
+		if v {
+			ps = append(ps, equipmentmove.HasMovedBy())
+		} else {
+			ps = append(ps, equipmentmove.Not(equipmentmove.HasMovedBy()))
+		}
+	}
+	if v, ok := in["hasMovedByWith"]; ok && v != nil {
+		var with []predicate.User
+		for _, v := range wheres(v) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, equipmentmove.HasMovedByWith(with...))
+	}
+	return ps, nil
+}
+
+// convertEquipmentMoveCreateTime returns the create_time of EquipmentMove of an argument.
+func convertEquipmentMoveCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertEquipmentMoveNote returns the note of EquipmentMove of an argument.
+func convertEquipmentMoveNote(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// defineHoliday defines the types of Holiday and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineHoliday(query, mutation graphql.Fields, mutable bool) {
+	leaves := map[string]leaf{
+		"date": graphql.DateTime,
+		"name": graphql.String,
+	}
+
+	s.objects["Holiday"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Holiday",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Holiday).ID, nil
+					},
+				},
+				"date": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["date"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Holiday).Date
+						return v, nil
+					},
+				},
+				"name": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["name"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Holiday).Name
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Holiday.organization", p.Source.(*ent.Holiday).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Holiday.Query().
+								Where(holiday.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Holiday"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "HolidayWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Holiday"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                 &graphql.InputObjectFieldConfig{Type: where},
+				"and":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"date":                &graphql.InputObjectFieldConfig{Type: leaves["date"]},
+				"dateNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["date"]},
+				"dateIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["date"]))},
+				"dateNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["date"]))},
+				"dateGT":              &graphql.InputObjectFieldConfig{Type: leaves["date"]},
+				"dateGTE":             &graphql.InputObjectFieldConfig{Type: leaves["date"]},
+				"dateLT":              &graphql.InputObjectFieldConfig{Type: leaves["date"]},
+				"dateLTE":             &graphql.InputObjectFieldConfig{Type: leaves["date"]},
+				"name":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameGT":              &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameGTE":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLT":              &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLTE":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContains":        &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"hasOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+			}
+		}),
+	})
+
+	query["holiday"] = &graphql.Field{
+		Type: s.objects["Holiday"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Holiday.Query().
+				Where(holiday.ID(id)).
+				Where(s.scopeHoliday(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["holidays"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Holiday")),
+		Args: connectionArgs(s.wheres["Holiday"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Holiday.Query().Where(s.scopeHoliday(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereHoliday(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(holiday.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(holiday.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(holiday.FieldID))
+			} else {
+				q.Order(ent.Asc(holiday.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateHolidayInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"date":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["date"])},
+			"name":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["name"])},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateHolidayInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"date":              &graphql.InputObjectFieldConfig{Type: leaves["date"]},
+			"name":              &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createHoliday"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Holiday"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createHoliday"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Holiday.Create()
+			if v, ok := in["date"]; ok && v != nil {
+				c, err := convertHolidayDate(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDate(c)
+			}
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertHolidayName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateHoliday"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Holiday"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateHoliday"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Holiday.UpdateOneID(id)
+			if v, ok := in["date"]; ok && v != nil {
+				c, err := convertHolidayDate(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDate(c)
+			}
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertHolidayName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteHoliday"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteHoliday"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Holiday.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeHoliday returns the predicates narrowing the Holiday entities
+// that can be read.
+func (s *Schema) scopeHoliday(ctx context.Context) []predicate.Holiday {
+	if s.opts.Scopes.Holiday == nil {
+		return nil
+	}
+	return s.opts.Scopes.Holiday(ctx)
+}
+
+// whereHoliday returns the predicates of a HolidayWhereInput.
+func (s *Schema) whereHoliday(in map[string]interface{}) ([]predicate.Holiday, error) {
+	var ps []predicate.Holiday
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereHoliday(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, holiday.Not(holiday.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Holiday
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereHoliday(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, holiday.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, holiday.And(group...))
+		default:
+			ps = append(ps, holiday.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.IDLTE(ids))
+	}
+	if v, ok := in["date"]; ok && v != nil {
+		c, err := convertHolidayDate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.DateEQ(c))
+	}
+	if v, ok := in["dateNEQ"]; ok && v != nil {
+		c, err := convertHolidayDate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.DateNEQ(c))
+	}
+	if v, ok := in["dateIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertHolidayDate(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, holiday.DateIn(vs...))
+	}
+	if v, ok := in["dateNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertHolidayDate(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, holiday.DateNotIn(vs...))
+	}
+	if v, ok := in["dateGT"]; ok && v != nil {
+		c, err := convertHolidayDate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.DateGT(c))
+	}
+	if v, ok := in["dateGTE"]; ok && v != nil {
+		c, err := convertHolidayDate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.DateGTE(c))
+	}
+	if v, ok := in["dateLT"]; ok && v != nil {
+		c, err := convertHolidayDate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.DateLT(c))
+	}
+	if v, ok := in["dateLTE"]; ok && v != nil {
+		c, err := convertHolidayDate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.DateLTE(c))
+	}
+	if v, ok := in["name"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameEQ(c))
+	}
+	if v, ok := in["nameNEQ"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameNEQ(c))
+	}
+	if v, ok := in["nameIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertHolidayName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, holiday.NameIn(vs...))
+	}
+	if v, ok := in["nameNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertHolidayName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, holiday.NameNotIn(vs...))
+	}
+	if v, ok := in["nameGT"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameGT(c))
+	}
+	if v, ok := in["nameGTE"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameGTE(c))
+	}
+	if v, ok := in["nameLT"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameLT(c))
+	}
+	if v, ok := in["nameLTE"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameLTE(c))
+	}
+	if v, ok := in["nameContains"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameContains(c))
+	}
+	if v, ok := in["nameHasPrefix"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameHasPrefix(c))
+	}
+	if v, ok := in["nameHasSuffix"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameHasSuffix(c))
+	}
+	if v, ok := in["nameEqualFold"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameEqualFold(c))
+	}
+	if v, ok := in["nameContainsFold"]; ok && v != nil {
+		c, err := convertHolidayName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, holiday.NameContainsFold(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, holiday.HasOrganization())
+		} else {
+			ps = append(ps, holiday.Not(holiday.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, holiday.HasOrganizationWith(with...))
+	}
+	return ps, nil
+}
+
+// convertHolidayDate returns the date of Holiday of an argument.
+func convertHolidayDate(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: date: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertHolidayName returns the name of Holiday of an argument.
+func convertHolidayName(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// defineInvoice defines the types of Invoice and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineInvoice(query, mutation graphql.Fields, mutable bool) {
+	s.enums["InvoiceKind"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "InvoiceKind",
+		Values: graphql.EnumValueConfigMap{
+			"invoice":     &graphql.EnumValueConfig{Value: "invoice"},
+			"credit_note": &graphql.EnumValueConfig{Value: "credit_note"},
+		},
+	})
+	s.enums["InvoicePaymentStatus"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "InvoicePaymentStatus",
+		Values: graphql.EnumValueConfigMap{
+			"unpaid":     &graphql.EnumValueConfig{Value: "unpaid"},
+			"partial":    &graphql.EnumValueConfig{Value: "partial"},
+			"paid":       &graphql.EnumValueConfig{Value: "paid"},
+			"refund_due": &graphql.EnumValueConfig{Value: "refund_due"},
+		},
+	})
+	leaves := map[string]leaf{
+		"create_time":     graphql.DateTime,
+		"number":          graphql.String,
+		"kind":            s.enums["InvoiceKind"],
+		"currency":        graphql.String,
+		"subtotal":        graphql.String,
+		"discount":        graphql.String,
+		"vat_rate":        graphql.String,
+		"vat":             graphql.String,
+		"total":           graphql.String,
+		"reason":          graphql.String,
+		"payment_status":  s.enums["InvoicePaymentStatus"],
+		"amount_paid":     graphql.String,
+		"amount_credited": graphql.String,
+	}
+
+	s.objects["Invoice"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Invoice",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Invoice).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).CreateTime
+						return v, nil
+					},
+				},
+				"number": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["number"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).Number
+						return v, nil
+					},
+				},
+				"kind": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["kind"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).Kind
+						return string(v), nil
+					},
+				},
+				"currency": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["currency"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).Currency
+						return v, nil
+					},
+				},
+				"subtotal": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["subtotal"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).Subtotal
+						return v.String(), nil
+					},
+				},
+				"discount": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["discount"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).Discount
+						return v.String(), nil
+					},
+				},
+				"vatRate": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["vat_rate"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).VatRate
+						return v.String(), nil
+					},
+				},
+				"vat": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["vat"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).Vat
+						return v.String(), nil
+					},
+				},
+				"total": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["total"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).Total
+						return v.String(), nil
+					},
+				},
+				"reason": &graphql.Field{
+					Type: leaves["reason"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).Reason
+						return v, nil
+					},
+				},
+				"paymentStatus": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["payment_status"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).PaymentStatus
+						return string(v), nil
+					},
+				},
+				"amountPaid": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["amount_paid"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).AmountPaid
+						return v.String(), nil
+					},
+				},
+				"amountCredited": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["amount_credited"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Invoice).AmountCredited
+						return v.String(), nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Invoice.organization", p.Source.(*ent.Invoice).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Invoice.Query().
+								Where(invoice.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"repairSlip": &graphql.Field{
+					Type: s.objects["RepairSlip"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Invoice.repair_slip", p.Source.(*ent.Invoice).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Invoice.Query().
+								Where(invoice.IDIn(ids...)).
+								WithRepairSlip(func(q *ent.RepairSlipQuery) {
+									q.Where(s.scopeRepairSlip(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.RepairSlip != nil {
+									values[n.ID] = n.Edges.RepairSlip
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"customer": &graphql.Field{
+					Type: s.objects["User"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Invoice.customer", p.Source.(*ent.Invoice).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Invoice.Query().
+								Where(invoice.IDIn(ids...)).
+								WithCustomer(func(q *ent.UserQuery) {
+									q.Where(s.scopeUser(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Customer != nil {
+									values[n.ID] = n.Edges.Customer
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"lines": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["InvoiceLine"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Invoice.lines", p.Source.(*ent.Invoice).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Invoice.Query().
+								Where(invoice.IDIn(ids...)).
+								WithLines(func(q *ent.InvoiceLineQuery) {
+									q.Where(s.scopeInvoiceLine(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Lines
+								if edges == nil {
+									edges = []*ent.InvoiceLine{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"original": &graphql.Field{
+					Type: s.objects["Invoice"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Invoice.original", p.Source.(*ent.Invoice).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Invoice.Query().
+								Where(invoice.IDIn(ids...)).
+								WithOriginal(func(q *ent.InvoiceQuery) {
+									q.Where(s.scopeInvoice(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Original != nil {
+									values[n.ID] = n.Edges.Original
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"creditNotes": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Invoice"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Invoice.credit_notes", p.Source.(*ent.Invoice).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Invoice.Query().
+								Where(invoice.IDIn(ids...)).
+								WithCreditNotes(func(q *ent.InvoiceQuery) {
+									q.Where(s.scopeInvoice(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.CreditNotes
+								if edges == nil {
+									edges = []*ent.Invoice{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"payments": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Payment"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Invoice.payments", p.Source.(*ent.Invoice).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Invoice.Query().
+								Where(invoice.IDIn(ids...)).
+								WithPayments(func(q *ent.PaymentQuery) {
+									q.Where(s.scopePayment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Payments
+								if edges == nil {
+									edges = []*ent.Payment{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Invoice"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "InvoiceWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Invoice"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                  &graphql.InputObjectFieldConfig{Type: where},
+				"and":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":           &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"number":               &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["number"]))},
+				"numberNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["number"]))},
+				"numberGT":             &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberGTE":            &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberLT":             &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberLTE":            &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberContains":       &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberHasPrefix":      &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberHasSuffix":      &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberEqualFold":      &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberContainsFold":   &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"kind":                 &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"kindNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"currency":             &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["currency"]))},
+				"currencyNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["currency"]))},
+				"currencyGT":           &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyGTE":          &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyLT":           &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyLTE":          &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyContains":     &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"currencyContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+				"subtotal":             &graphql.InputObjectFieldConfig{Type: leaves["subtotal"]},
+				"subtotalNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["subtotal"]},
+				"subtotalIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["subtotal"]))},
+				"subtotalNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["subtotal"]))},
+				"subtotalGT":           &graphql.InputObjectFieldConfig{Type: leaves["subtotal"]},
+				"subtotalGTE":          &graphql.InputObjectFieldConfig{Type: leaves["subtotal"]},
+				"subtotalLT":           &graphql.InputObjectFieldConfig{Type: leaves["subtotal"]},
+				"subtotalLTE":          &graphql.InputObjectFieldConfig{Type: leaves["subtotal"]},
+				"discount":             &graphql.InputObjectFieldConfig{Type: leaves["discount"]},
+				"discountNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["discount"]},
+				"discountIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["discount"]))},
+				"discountNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["discount"]))},
+				"discountGT":           &graphql.InputObjectFieldConfig{Type: leaves["discount"]},
+				"discountGTE":          &graphql.InputObjectFieldConfig{Type: leaves["discount"]},
+				"discountLT":           &graphql.InputObjectFieldConfig{Type: leaves["discount"]},
+				"discountLTE":          &graphql.InputObjectFieldConfig{Type: leaves["discount"]},
+				"vatRate":              &graphql.InputObjectFieldConfig{Type: leaves["vat_rate"]},
+				"vatRateNEQ":           &graphql.InputObjectFieldConfig{Type: leaves["vat_rate"]},
+				"vatRateIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["vat_rate"]))},
+				"vatRateNotIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["vat_rate"]))},
+				"vatRateGT":            &graphql.InputObjectFieldConfig{Type: leaves["vat_rate"]},
+				"vatRateGTE":           &graphql.InputObjectFieldConfig{Type: leaves["vat_rate"]},
+				"vatRateLT":            &graphql.InputObjectFieldConfig{Type: leaves["vat_rate"]},
+				"vatRateLTE":           &graphql.InputObjectFieldConfig{Type: leaves["vat_rate"]},
+				"vat":                  &graphql.InputObjectFieldConfig{Type: leaves["vat"]},
+				"vatNEQ":               &graphql.InputObjectFieldConfig{Type: leaves["vat"]},
+				"vatIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["vat"]))},
+				"vatNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["vat"]))},
+				"vatGT":                &graphql.InputObjectFieldConfig{Type: leaves["vat"]},
+				"vatGTE":               &graphql.InputObjectFieldConfig{Type: leaves["vat"]},
+				"vatLT":                &graphql.InputObjectFieldConfig{Type: leaves["vat"]},
+				"vatLTE":               &graphql.InputObjectFieldConfig{Type: leaves["vat"]},
+				"total":                &graphql.InputObjectFieldConfig{Type: leaves["total"]},
+				"totalNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["total"]},
+				"totalIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["total"]))},
+				"totalNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["total"]))},
+				"totalGT":              &graphql.InputObjectFieldConfig{Type: leaves["total"]},
+				"totalGTE":             &graphql.InputObjectFieldConfig{Type: leaves["total"]},
+				"totalLT":              &graphql.InputObjectFieldConfig{Type: leaves["total"]},
+				"totalLTE":             &graphql.InputObjectFieldConfig{Type: leaves["total"]},
+				"reason":               &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["reason"]))},
+				"reasonNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["reason"]))},
+				"reasonGT":             &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonGTE":            &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonLT":             &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonLTE":            &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonContains":       &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonHasPrefix":      &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonHasSuffix":      &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonIsNil":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"reasonNotNil":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"reasonEqualFold":      &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"reasonContainsFold":   &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+				"paymentStatus":        &graphql.InputObjectFieldConfig{Type: leaves["payment_status"]},
+				"paymentStatusNEQ":     &graphql.InputObjectFieldConfig{Type: leaves["payment_status"]},
+				"paymentStatusIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["payment_status"]))},
+				"paymentStatusNotIn":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["payment_status"]))},
+				"amountPaid":           &graphql.InputObjectFieldConfig{Type: leaves["amount_paid"]},
+				"amountPaidNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["amount_paid"]},
+				"amountPaidIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["amount_paid"]))},
+				"amountPaidNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["amount_paid"]))},
+				"amountPaidGT":         &graphql.InputObjectFieldConfig{Type: leaves["amount_paid"]},
+				"amountPaidGTE":        &graphql.InputObjectFieldConfig{Type: leaves["amount_paid"]},
+				"amountPaidLT":         &graphql.InputObjectFieldConfig{Type: leaves["amount_paid"]},
+				"amountPaidLTE":        &graphql.InputObjectFieldConfig{Type: leaves["amount_paid"]},
+				"amountCredited":       &graphql.InputObjectFieldConfig{Type: leaves["amount_credited"]},
+				"amountCreditedNEQ":    &graphql.InputObjectFieldConfig{Type: leaves["amount_credited"]},
+				"amountCreditedIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["amount_credited"]))},
+				"amountCreditedNotIn":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["amount_credited"]))},
+				"amountCreditedGT":     &graphql.InputObjectFieldConfig{Type: leaves["amount_credited"]},
+				"amountCreditedGTE":    &graphql.InputObjectFieldConfig{Type: leaves["amount_credited"]},
+				"amountCreditedLT":     &graphql.InputObjectFieldConfig{Type: leaves["amount_credited"]},
+				"amountCreditedLTE":    &graphql.InputObjectFieldConfig{Type: leaves["amount_credited"]},
+				"hasOrganization":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasRepairSlip":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasRepairSlipWith":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["RepairSlip"]))},
+				"hasCustomer":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasCustomerWith":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["User"]))},
+				"hasLines":             &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasLinesWith":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["InvoiceLine"]))},
+				"hasOriginal":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOriginalWith":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Invoice"]))},
+				"hasCreditNotes":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasCreditNotesWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Invoice"]))},
+				"hasPayments":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasPaymentsWith":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Payment"]))},
+			}
+		}),
+	})
+
+	query["invoice"] = &graphql.Field{
+		Type: s.objects["Invoice"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Invoice.Query().
+				Where(invoice.ID(id)).
+				Where(s.scopeInvoice(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["invoices"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Invoice")),
+		Args: connectionArgs(s.wheres["Invoice"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Invoice.Query().Where(s.scopeInvoice(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereInvoice(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(invoice.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(invoice.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(invoice.FieldID))
+			} else {
+				q.Order(ent.Asc(invoice.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateInvoiceInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"number":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["number"])},
+			"kind":           &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+			"currency":       &graphql.InputObjectFieldConfig{Type: leaves["currency"]},
+			"subtotal":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["subtotal"])},
+			"discount":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["discount"])},
+			"vatRate":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["vat_rate"])},
+			"vat":            &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["vat"])},
+			"total":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["total"])},
+			"reason":         &graphql.InputObjectFieldConfig{Type: leaves["reason"]},
+			"paymentStatus":  &graphql.InputObjectFieldConfig{Type: leaves["payment_status"]},
+			"amountPaid":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["amount_paid"])},
+			"amountCredited": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["amount_credited"])},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"repairSlipID":   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"customerID":     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"lineIDs":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"originalID":     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"creditNoteIDs":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"paymentIDs":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateInvoiceInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"paymentStatus":       &graphql.InputObjectFieldConfig{Type: leaves["payment_status"]},
+			"amountPaid":          &graphql.InputObjectFieldConfig{Type: leaves["amount_paid"]},
+			"amountCredited":      &graphql.InputObjectFieldConfig{Type: leaves["amount_credited"]},
+			"organizationID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"repairSlipID":        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearRepairSlip":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"customerID":          &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearCustomer":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addLineIDs":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeLineIDs":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"originalID":          &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOriginal":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addCreditNoteIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeCreditNoteIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addPaymentIDs":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removePaymentIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	mutation["createInvoice"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Invoice"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createInvoice"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Invoice.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertInvoiceCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["number"]; ok && v != nil {
+				c, err := convertInvoiceNumber(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetNumber(c)
+			}
+			if v, ok := in["kind"]; ok && v != nil {
+				c, err := convertInvoiceKind(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetKind(c)
+			}
+			if v, ok := in["currency"]; ok && v != nil {
+				c, err := convertInvoiceCurrency(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCurrency(c)
+			}
+			if v, ok := in["subtotal"]; ok && v != nil {
+				c, err := convertInvoiceSubtotal(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSubtotal(c)
+			}
+			if v, ok := in["discount"]; ok && v != nil {
+				c, err := convertInvoiceDiscount(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDiscount(c)
+			}
+			if v, ok := in["vatRate"]; ok && v != nil {
+				c, err := convertInvoiceVatRate(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetVatRate(c)
+			}
+			if v, ok := in["vat"]; ok && v != nil {
+				c, err := convertInvoiceVat(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetVat(c)
+			}
+			if v, ok := in["total"]; ok && v != nil {
+				c, err := convertInvoiceTotal(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetTotal(c)
+			}
+			if v, ok := in["reason"]; ok && v != nil {
+				c, err := convertInvoiceReason(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetReason(c)
+			}
+			if v, ok := in["paymentStatus"]; ok && v != nil {
+				c, err := convertInvoicePaymentStatus(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPaymentStatus(c)
+			}
+			if v, ok := in["amountPaid"]; ok && v != nil {
+				c, err := convertInvoiceAmountPaid(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAmountPaid(c)
+			}
+			if v, ok := in["amountCredited"]; ok && v != nil {
+				c, err := convertInvoiceAmountCredited(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAmountCredited(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			if v, ok := in["customerID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCustomerID(id)
+			}
+			if v, ok := in["lineIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddLineIDs(ids...)
+			}
+			if v, ok := in["originalID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOriginalID(id)
+			}
+			if v, ok := in["creditNoteIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddCreditNoteIDs(ids...)
+			}
+			if v, ok := in["paymentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddPaymentIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateInvoice"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Invoice"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateInvoice"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Invoice.UpdateOneID(id)
+			if v, ok := in["paymentStatus"]; ok && v != nil {
+				c, err := convertInvoicePaymentStatus(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPaymentStatus(c)
+			}
+			if v, ok := in["amountPaid"]; ok && v != nil {
+				c, err := convertInvoiceAmountPaid(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAmountPaid(c)
+			}
+			if v, ok := in["amountCredited"]; ok && v != nil {
+				c, err := convertInvoiceAmountCredited(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAmountCredited(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			if v, ok := in["clearRepairSlip"]; ok && v == true {
+				b.ClearRepairSlip()
+			}
+			if v, ok := in["customerID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCustomerID(id)
+			}
+			if v, ok := in["clearCustomer"]; ok && v == true {
+				b.ClearCustomer()
+			}
+			if v, ok := in["addLineIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddLineIDs(ids...)
+			}
+			if v, ok := in["removeLineIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveLineIDs(ids...)
+			}
+			if v, ok := in["originalID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOriginalID(id)
+			}
+			if v, ok := in["clearOriginal"]; ok && v == true {
+				b.ClearOriginal()
+			}
+			if v, ok := in["addCreditNoteIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddCreditNoteIDs(ids...)
+			}
+			if v, ok := in["removeCreditNoteIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveCreditNoteIDs(ids...)
+			}
+			if v, ok := in["addPaymentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddPaymentIDs(ids...)
+			}
+			if v, ok := in["removePaymentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemovePaymentIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteInvoice"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteInvoice"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Invoice.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeInvoice returns the predicates narrowing the Invoice entities
+// that can be read.
+func (s *Schema) scopeInvoice(ctx context.Context) []predicate.Invoice {
+	if s.opts.Scopes.Invoice == nil {
+		return nil
+	}
+	return s.opts.Scopes.Invoice(ctx)
+}
+
+// whereInvoice returns the predicates of a InvoiceWhereInput.
+func (s *Schema) whereInvoice(in map[string]interface{}) ([]predicate.Invoice, error) {
+	var ps []predicate.Invoice
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereInvoice(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, invoice.Not(invoice.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Invoice
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereInvoice(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, invoice.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, invoice.And(group...))
+		default:
+			ps = append(ps, invoice.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertInvoiceCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertInvoiceCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertInvoiceCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertInvoiceCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertInvoiceCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertInvoiceCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertInvoiceCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertInvoiceCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CreateTimeLTE(c))
+	}
+	if v, ok := in["number"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberEQ(c))
+	}
+	if v, ok := in["numberNEQ"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberNEQ(c))
+	}
+	if v, ok := in["numberIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertInvoiceNumber(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.NumberIn(vs...))
+	}
+	if v, ok := in["numberNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertInvoiceNumber(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.NumberNotIn(vs...))
+	}
+	if v, ok := in["numberGT"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberGT(c))
+	}
+	if v, ok := in["numberGTE"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberGTE(c))
+	}
+	if v, ok := in["numberLT"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberLT(c))
+	}
+	if v, ok := in["numberLTE"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberLTE(c))
+	}
+	if v, ok := in["numberContains"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberContains(c))
+	}
+	if v, ok := in["numberHasPrefix"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberHasPrefix(c))
+	}
+	if v, ok := in["numberHasSuffix"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberHasSuffix(c))
+	}
+	if v, ok := in["numberEqualFold"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberEqualFold(c))
+	}
+	if v, ok := in["numberContainsFold"]; ok && v != nil {
+		c, err := convertInvoiceNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.NumberContainsFold(c))
+	}
+	if v, ok := in["kind"]; ok && v != nil {
+		c, err := convertInvoiceKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.KindEQ(c))
+	}
+	if v, ok := in["kindNEQ"]; ok && v != nil {
+		c, err := convertInvoiceKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.KindNEQ(c))
+	}
+	if v, ok := in["kindIn"].([]interface{}); ok {
+		vs := make([]invoice.Kind, len(v))
+		for i := range v {
+			c, err := convertInvoiceKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.KindIn(vs...))
+	}
+	if v, ok := in["kindNotIn"].([]interface{}); ok {
+		vs := make([]invoice.Kind, len(v))
+		for i := range v {
+			c, err := convertInvoiceKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.KindNotIn(vs...))
+	}
+	if v, ok := in["currency"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyEQ(c))
+	}
+	if v, ok := in["currencyNEQ"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyNEQ(c))
+	}
+	if v, ok := in["currencyIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertInvoiceCurrency(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.CurrencyIn(vs...))
+	}
+	if v, ok := in["currencyNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertInvoiceCurrency(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.CurrencyNotIn(vs...))
+	}
+	if v, ok := in["currencyGT"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyGT(c))
+	}
+	if v, ok := in["currencyGTE"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyGTE(c))
+	}
+	if v, ok := in["currencyLT"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyLT(c))
+	}
+	if v, ok := in["currencyLTE"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyLTE(c))
+	}
+	if v, ok := in["currencyContains"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyContains(c))
+	}
+	if v, ok := in["currencyHasPrefix"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyHasPrefix(c))
+	}
+	if v, ok := in["currencyHasSuffix"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyHasSuffix(c))
+	}
+	if v, ok := in["currencyEqualFold"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyEqualFold(c))
+	}
+	if v, ok := in["currencyContainsFold"]; ok && v != nil {
+		c, err := convertInvoiceCurrency(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.CurrencyContainsFold(c))
+	}
+	if v, ok := in["subtotal"]; ok && v != nil {
+		c, err := convertInvoiceSubtotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.SubtotalEQ(c))
+	}
+	if v, ok := in["subtotalNEQ"]; ok && v != nil {
+		c, err := convertInvoiceSubtotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.SubtotalNEQ(c))
+	}
+	if v, ok := in["subtotalIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceSubtotal(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.SubtotalIn(vs...))
+	}
+	if v, ok := in["subtotalNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceSubtotal(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.SubtotalNotIn(vs...))
+	}
+	if v, ok := in["subtotalGT"]; ok && v != nil {
+		c, err := convertInvoiceSubtotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.SubtotalGT(c))
+	}
+	if v, ok := in["subtotalGTE"]; ok && v != nil {
+		c, err := convertInvoiceSubtotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.SubtotalGTE(c))
+	}
+	if v, ok := in["subtotalLT"]; ok && v != nil {
+		c, err := convertInvoiceSubtotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.SubtotalLT(c))
+	}
+	if v, ok := in["subtotalLTE"]; ok && v != nil {
+		c, err := convertInvoiceSubtotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.SubtotalLTE(c))
+	}
+	if v, ok := in["discount"]; ok && v != nil {
+		c, err := convertInvoiceDiscount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.DiscountEQ(c))
+	}
+	if v, ok := in["discountNEQ"]; ok && v != nil {
+		c, err := convertInvoiceDiscount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.DiscountNEQ(c))
+	}
+	if v, ok := in["discountIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceDiscount(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.DiscountIn(vs...))
+	}
+	if v, ok := in["discountNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceDiscount(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.DiscountNotIn(vs...))
+	}
+	if v, ok := in["discountGT"]; ok && v != nil {
+		c, err := convertInvoiceDiscount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.DiscountGT(c))
+	}
+	if v, ok := in["discountGTE"]; ok && v != nil {
+		c, err := convertInvoiceDiscount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.DiscountGTE(c))
+	}
+	if v, ok := in["discountLT"]; ok && v != nil {
+		c, err := convertInvoiceDiscount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.DiscountLT(c))
+	}
+	if v, ok := in["discountLTE"]; ok && v != nil {
+		c, err := convertInvoiceDiscount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.DiscountLTE(c))
+	}
+	if v, ok := in["vatRate"]; ok && v != nil {
+		c, err := convertInvoiceVatRate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatRateEQ(c))
+	}
+	if v, ok := in["vatRateNEQ"]; ok && v != nil {
+		c, err := convertInvoiceVatRate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatRateNEQ(c))
+	}
+	if v, ok := in["vatRateIn"].([]interface{}); ok {
+		vs := make([]money.Rate, len(v))
+		for i := range v {
+			c, err := convertInvoiceVatRate(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.VatRateIn(vs...))
+	}
+	if v, ok := in["vatRateNotIn"].([]interface{}); ok {
+		vs := make([]money.Rate, len(v))
+		for i := range v {
+			c, err := convertInvoiceVatRate(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.VatRateNotIn(vs...))
+	}
+	if v, ok := in["vatRateGT"]; ok && v != nil {
+		c, err := convertInvoiceVatRate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatRateGT(c))
+	}
+	if v, ok := in["vatRateGTE"]; ok && v != nil {
+		c, err := convertInvoiceVatRate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatRateGTE(c))
+	}
+	if v, ok := in["vatRateLT"]; ok && v != nil {
+		c, err := convertInvoiceVatRate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatRateLT(c))
+	}
+	if v, ok := in["vatRateLTE"]; ok && v != nil {
+		c, err := convertInvoiceVatRate(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatRateLTE(c))
+	}
+	if v, ok := in["vat"]; ok && v != nil {
+		c, err := convertInvoiceVat(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatEQ(c))
+	}
+	if v, ok := in["vatNEQ"]; ok && v != nil {
+		c, err := convertInvoiceVat(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatNEQ(c))
+	}
+	if v, ok := in["vatIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceVat(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.VatIn(vs...))
+	}
+	if v, ok := in["vatNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceVat(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.VatNotIn(vs...))
+	}
+	if v, ok := in["vatGT"]; ok && v != nil {
+		c, err := convertInvoiceVat(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatGT(c))
+	}
+	if v, ok := in["vatGTE"]; ok && v != nil {
+		c, err := convertInvoiceVat(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatGTE(c))
+	}
+	if v, ok := in["vatLT"]; ok && v != nil {
+		c, err := convertInvoiceVat(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatLT(c))
+	}
+	if v, ok := in["vatLTE"]; ok && v != nil {
+		c, err := convertInvoiceVat(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.VatLTE(c))
+	}
+	if v, ok := in["total"]; ok && v != nil {
+		c, err := convertInvoiceTotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.TotalEQ(c))
+	}
+	if v, ok := in["totalNEQ"]; ok && v != nil {
+		c, err := convertInvoiceTotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.TotalNEQ(c))
+	}
+	if v, ok := in["totalIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceTotal(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.TotalIn(vs...))
+	}
+	if v, ok := in["totalNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceTotal(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.TotalNotIn(vs...))
+	}
+	if v, ok := in["totalGT"]; ok && v != nil {
+		c, err := convertInvoiceTotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.TotalGT(c))
+	}
+	if v, ok := in["totalGTE"]; ok && v != nil {
+		c, err := convertInvoiceTotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.TotalGTE(c))
+	}
+	if v, ok := in["totalLT"]; ok && v != nil {
+		c, err := convertInvoiceTotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.TotalLT(c))
+	}
+	if v, ok := in["totalLTE"]; ok && v != nil {
+		c, err := convertInvoiceTotal(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.TotalLTE(c))
+	}
+	if v, ok := in["reason"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonEQ(c))
+	}
+	if v, ok := in["reasonNEQ"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonNEQ(c))
+	}
+	if v, ok := in["reasonIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertInvoiceReason(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.ReasonIn(vs...))
+	}
+	if v, ok := in["reasonNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertInvoiceReason(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.ReasonNotIn(vs...))
+	}
+	if v, ok := in["reasonGT"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonGT(c))
+	}
+	if v, ok := in["reasonGTE"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonGTE(c))
+	}
+	if v, ok := in["reasonLT"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonLT(c))
+	}
+	if v, ok := in["reasonLTE"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonLTE(c))
+	}
+	if v, ok := in["reasonContains"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonContains(c))
+	}
+	if v, ok := in["reasonHasPrefix"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonHasPrefix(c))
+	}
+	if v, ok := in["reasonHasSuffix"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonHasSuffix(c))
+	}
+	if v, ok := in["reasonIsNil"]; ok && v == true {
+		ps = append(ps, invoice.ReasonIsNil())
+	}
+	if v, ok := in["reasonNotNil"]; ok && v == true {
+		ps = append(ps, invoice.ReasonNotNil())
+	}
+	if v, ok := in["reasonEqualFold"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonEqualFold(c))
+	}
+	if v, ok := in["reasonContainsFold"]; ok && v != nil {
+		c, err := convertInvoiceReason(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.ReasonContainsFold(c))
+	}
+	if v, ok := in["paymentStatus"]; ok && v != nil {
+		c, err := convertInvoicePaymentStatus(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.PaymentStatusEQ(c))
+	}
+	if v, ok := in["paymentStatusNEQ"]; ok && v != nil {
+		c, err := convertInvoicePaymentStatus(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.PaymentStatusNEQ(c))
+	}
+	if v, ok := in["paymentStatusIn"].([]interface{}); ok {
+		vs := make([]invoice.PaymentStatus, len(v))
+		for i := range v {
+			c, err := convertInvoicePaymentStatus(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.PaymentStatusIn(vs...))
+	}
+	if v, ok := in["paymentStatusNotIn"].([]interface{}); ok {
+		vs := make([]invoice.PaymentStatus, len(v))
+		for i := range v {
+			c, err := convertInvoicePaymentStatus(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.PaymentStatusNotIn(vs...))
+	}
+	if v, ok := in["amountPaid"]; ok && v != nil {
+		c, err := convertInvoiceAmountPaid(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountPaidEQ(c))
+	}
+	if v, ok := in["amountPaidNEQ"]; ok && v != nil {
+		c, err := convertInvoiceAmountPaid(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountPaidNEQ(c))
+	}
+	if v, ok := in["amountPaidIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceAmountPaid(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.AmountPaidIn(vs...))
+	}
+	if v, ok := in["amountPaidNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceAmountPaid(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.AmountPaidNotIn(vs...))
+	}
+	if v, ok := in["amountPaidGT"]; ok && v != nil {
+		c, err := convertInvoiceAmountPaid(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountPaidGT(c))
+	}
+	if v, ok := in["amountPaidGTE"]; ok && v != nil {
+		c, err := convertInvoiceAmountPaid(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountPaidGTE(c))
+	}
+	if v, ok := in["amountPaidLT"]; ok && v != nil {
+		c, err := convertInvoiceAmountPaid(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountPaidLT(c))
+	}
+	if v, ok := in["amountPaidLTE"]; ok && v != nil {
+		c, err := convertInvoiceAmountPaid(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountPaidLTE(c))
+	}
+	if v, ok := in["amountCredited"]; ok && v != nil {
+		c, err := convertInvoiceAmountCredited(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountCreditedEQ(c))
+	}
+	if v, ok := in["amountCreditedNEQ"]; ok && v != nil {
+		c, err := convertInvoiceAmountCredited(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountCreditedNEQ(c))
+	}
+	if v, ok := in["amountCreditedIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceAmountCredited(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.AmountCreditedIn(vs...))
+	}
+	if v, ok := in["amountCreditedNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceAmountCredited(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoice.AmountCreditedNotIn(vs...))
+	}
+	if v, ok := in["amountCreditedGT"]; ok && v != nil {
+		c, err := convertInvoiceAmountCredited(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountCreditedGT(c))
+	}
+	if v, ok := in["amountCreditedGTE"]; ok && v != nil {
+		c, err := convertInvoiceAmountCredited(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountCreditedGTE(c))
+	}
+	if v, ok := in["amountCreditedLT"]; ok && v != nil {
+		c, err := convertInvoiceAmountCredited(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountCreditedLT(c))
+	}
+	if v, ok := in["amountCreditedLTE"]; ok && v != nil {
+		c, err := convertInvoiceAmountCredited(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoice.AmountCreditedLTE(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, invoice.HasOrganization())
+		} else {
+			ps = append(ps, invoice.Not(invoice.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, invoice.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasRepairSlip"].(bool); ok {
+		if v {
+			ps = append(ps, invoice.HasRepairSlip())
+		} else {
+			ps = append(ps, invoice.Not(invoice.HasRepairSlip()))
+		}
+	}
+	if v, ok := in["hasRepairSlipWith"]; ok && v != nil {
+		var with []predicate.RepairSlip
+		for _, v := range wheres(v) {
+			sub, err := s.whereRepairSlip(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, invoice.HasRepairSlipWith(with...))
+	}
+	if v, ok := in["hasCustomer"].(bool); ok {
+		if v {
+			ps = append(ps, invoice.HasCustomer())
+		} else {
+			ps = append(ps, invoice.Not(invoice.HasCustomer()))
+		}
+	}
+	if v, ok := in["hasCustomerWith"]; ok && v != nil {
+		var with []predicate.User
+		for _, v := range wheres(v) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, invoice.HasCustomerWith(with...))
+	}
+	if v, ok := in["hasLines"].(bool); ok {
+		if v {
+			ps = append(ps, invoice.HasLines())
+		} else {
+			ps = append(ps, invoice.Not(invoice.HasLines()))
+		}
+	}
+	if v, ok := in["hasLinesWith"]; ok && v != nil {
+		var with []predicate.InvoiceLine
+		for _, v := range wheres(v) {
+			sub, err := s.whereInvoiceLine(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, invoice.HasLinesWith(with...))
+	}
+	if v, ok := in["hasOriginal"].(bool); ok {
+		if v {
+			ps = append(ps, invoice.HasOriginal())
+		} else {
+			ps = append(ps, invoice.Not(invoice.HasOriginal()))
+		}
+	}
+	if v, ok := in["hasOriginalWith"]; ok && v != nil {
+		var with []predicate.Invoice
+		for _, v := range wheres(v) {
+			sub, err := s.whereInvoice(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, invoice.HasOriginalWith(with...))
+	}
+	if v, ok := in["hasCreditNotes"].(bool); ok {
+		if v {
+			ps = append(ps, invoice.HasCreditNotes())
+		} else {
+			ps = append(ps, invoice.Not(invoice.HasCreditNotes()))
+		}
+	}
+	if v, ok := in["hasCreditNotesWith"]; ok && v != nil {
+		var with []predicate.Invoice
+		for _, v := range wheres(v) {
+			sub, err := s.whereInvoice(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, invoice.HasCreditNotesWith(with...))
+	}
+	if v, ok := in["hasPayments"].(bool); ok {
+		if v {
+			ps = append(ps, invoice.HasPayments())
+		} else {
+			ps = append(ps, invoice.Not(invoice.HasPayments()))
+		}
+	}
+	if v, ok := in["hasPaymentsWith"]; ok && v != nil {
+		var with []predicate.Payment
+		for _, v := range wheres(v) {
+			sub, err := s.wherePayment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, invoice.HasPaymentsWith(with...))
+	}
+	return ps, nil
+}
+
+// convertInvoiceCreateTime returns the create_time of Invoice of an argument.
+func convertInvoiceCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertInvoiceNumber returns the number of Invoice of an argument.
+func convertInvoiceNumber(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertInvoiceKind returns the kind of Invoice of an argument.
+func convertInvoiceKind(v interface{}) (invoice.Kind, error) {
+	s, _ := v.(string)
+	return invoice.Kind(s), nil
+}
+
+// convertInvoiceCurrency returns the currency of Invoice of an argument.
+func convertInvoiceCurrency(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertInvoiceSubtotal returns the subtotal of Invoice of an argument.
+func convertInvoiceSubtotal(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// convertInvoiceDiscount returns the discount of Invoice of an argument.
+func convertInvoiceDiscount(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// convertInvoiceVatRate returns the vat_rate of Invoice of an argument.
+func convertInvoiceVatRate(v interface{}) (money.Rate, error) {
+	s, _ := v.(string)
+	return money.ParseRate(s)
+}
+
+// convertInvoiceVat returns the vat of Invoice of an argument.
+func convertInvoiceVat(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// convertInvoiceTotal returns the total of Invoice of an argument.
+func convertInvoiceTotal(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// convertInvoiceReason returns the reason of Invoice of an argument.
+func convertInvoiceReason(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertInvoicePaymentStatus returns the payment_status of Invoice of an argument.
+func convertInvoicePaymentStatus(v interface{}) (invoice.PaymentStatus, error) {
+	s, _ := v.(string)
+	return invoice.PaymentStatus(s), nil
+}
+
+// convertInvoiceAmountPaid returns the amount_paid of Invoice of an argument.
+func convertInvoiceAmountPaid(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// convertInvoiceAmountCredited returns the amount_credited of Invoice of an argument.
+func convertInvoiceAmountCredited(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// defineInvoiceLine defines the types of InvoiceLine and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineInvoiceLine(query, mutation graphql.Fields, mutable bool) {
+	s.enums["InvoiceLineKind"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "InvoiceLineKind",
+		Values: graphql.EnumValueConfigMap{
+			"labour": &graphql.EnumValueConfig{Value: "labour"},
+			"part":   &graphql.EnumValueConfig{Value: "part"},
+			"fee":    &graphql.EnumValueConfig{Value: "fee"},
+		},
+	})
+	leaves := map[string]leaf{
+		"kind":        s.enums["InvoiceLineKind"],
+		"description": graphql.String,
+		"quantity":    graphql.String,
+		"unit_price":  graphql.String,
+		"amount":      graphql.String,
+	}
+
+	s.objects["InvoiceLine"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "InvoiceLine",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.InvoiceLine).ID, nil
+					},
+				},
+				"kind": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["kind"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.InvoiceLine).Kind
+						return string(v), nil
+					},
+				},
+				"description": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["description"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.InvoiceLine).Description
+						return v, nil
+					},
+				},
+				"quantity": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["quantity"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.InvoiceLine).Quantity
+						return v.String(), nil
+					},
+				},
+				"unitPrice": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["unit_price"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.InvoiceLine).UnitPrice
+						return v.String(), nil
+					},
+				},
+				"amount": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["amount"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.InvoiceLine).Amount
+						return v.String(), nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "InvoiceLine.organization", p.Source.(*ent.InvoiceLine).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.InvoiceLine.Query().
+								Where(invoiceline.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"invoice": &graphql.Field{
+					Type: s.objects["Invoice"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "InvoiceLine.invoice", p.Source.(*ent.InvoiceLine).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.InvoiceLine.Query().
+								Where(invoiceline.IDIn(ids...)).
+								WithInvoice(func(q *ent.InvoiceQuery) {
+									q.Where(s.scopeInvoice(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Invoice != nil {
+									values[n.ID] = n.Edges.Invoice
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["InvoiceLine"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "InvoiceLineWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["InvoiceLine"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                     &graphql.InputObjectFieldConfig{Type: where},
+				"and":                     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"kind":                    &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindNEQ":                 &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"kindNotIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"description":             &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["description"]))},
+				"descriptionNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["description"]))},
+				"descriptionGT":           &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionGTE":          &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionLT":           &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionLTE":          &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionContains":     &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"descriptionContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["description"]},
+				"quantity":                &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["quantity"]))},
+				"quantityNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["quantity"]))},
+				"quantityGT":              &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityGTE":             &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityLT":              &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityLTE":             &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"unitPrice":               &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["unit_price"]))},
+				"unitPriceNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["unit_price"]))},
+				"unitPriceGT":             &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceGTE":            &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceLT":             &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceLTE":            &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"amount":                  &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountNEQ":               &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["amount"]))},
+				"amountNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["amount"]))},
+				"amountGT":                &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountGTE":               &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountLT":                &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountLTE":               &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"hasOrganization":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasInvoice":              &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasInvoiceWith":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Invoice"]))},
+			}
+		}),
+	})
+
+	query["invoiceLine"] = &graphql.Field{
+		Type: s.objects["InvoiceLine"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.InvoiceLine.Query().
+				Where(invoiceline.ID(id)).
+				Where(s.scopeInvoiceLine(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["invoiceLines"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("InvoiceLine")),
+		Args: connectionArgs(s.wheres["InvoiceLine"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.InvoiceLine.Query().Where(s.scopeInvoiceLine(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereInvoiceLine(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(invoiceline.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(invoiceline.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(invoiceline.FieldID))
+			} else {
+				q.Order(ent.Asc(invoiceline.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateInvoiceLineInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"kind":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["kind"])},
+			"description":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["description"])},
+			"quantity":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["quantity"])},
+			"unitPrice":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["unit_price"])},
+			"amount":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["amount"])},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"invoiceID":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateInvoiceLineInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"invoiceID":         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearInvoice":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createInvoiceLine"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["InvoiceLine"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createInvoiceLine"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.InvoiceLine.Create()
+			if v, ok := in["kind"]; ok && v != nil {
+				c, err := convertInvoiceLineKind(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetKind(c)
+			}
+			if v, ok := in["description"]; ok && v != nil {
+				c, err := convertInvoiceLineDescription(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDescription(c)
+			}
+			if v, ok := in["quantity"]; ok && v != nil {
+				c, err := convertInvoiceLineQuantity(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetQuantity(c)
+			}
+			if v, ok := in["unitPrice"]; ok && v != nil {
+				c, err := convertInvoiceLineUnitPrice(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUnitPrice(c)
+			}
+			if v, ok := in["amount"]; ok && v != nil {
+				c, err := convertInvoiceLineAmount(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAmount(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["invoiceID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetInvoiceID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateInvoiceLine"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["InvoiceLine"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateInvoiceLine"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.InvoiceLine.UpdateOneID(id)
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["invoiceID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetInvoiceID(id)
+			}
+			if v, ok := in["clearInvoice"]; ok && v == true {
+				b.ClearInvoice()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteInvoiceLine"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteInvoiceLine"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.InvoiceLine.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeInvoiceLine returns the predicates narrowing the InvoiceLine entities
+// that can be read.
+func (s *Schema) scopeInvoiceLine(ctx context.Context) []predicate.InvoiceLine {
+	if s.opts.Scopes.InvoiceLine == nil {
+		return nil
+	}
+	return s.opts.Scopes.InvoiceLine(ctx)
+}
+
+// whereInvoiceLine returns the predicates of a InvoiceLineWhereInput.
+func (s *Schema) whereInvoiceLine(in map[string]interface{}) ([]predicate.InvoiceLine, error) {
+	var ps []predicate.InvoiceLine
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereInvoiceLine(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, invoiceline.Not(invoiceline.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.InvoiceLine
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereInvoiceLine(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, invoiceline.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, invoiceline.And(group...))
+		default:
+			ps = append(ps, invoiceline.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.IDLTE(ids))
+	}
+	if v, ok := in["kind"]; ok && v != nil {
+		c, err := convertInvoiceLineKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.KindEQ(c))
+	}
+	if v, ok := in["kindNEQ"]; ok && v != nil {
+		c, err := convertInvoiceLineKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.KindNEQ(c))
+	}
+	if v, ok := in["kindIn"].([]interface{}); ok {
+		vs := make([]invoiceline.Kind, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.KindIn(vs...))
+	}
+	if v, ok := in["kindNotIn"].([]interface{}); ok {
+		vs := make([]invoiceline.Kind, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.KindNotIn(vs...))
+	}
+	if v, ok := in["description"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionEQ(c))
+	}
+	if v, ok := in["descriptionNEQ"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionNEQ(c))
+	}
+	if v, ok := in["descriptionIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineDescription(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.DescriptionIn(vs...))
+	}
+	if v, ok := in["descriptionNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineDescription(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.DescriptionNotIn(vs...))
+	}
+	if v, ok := in["descriptionGT"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionGT(c))
+	}
+	if v, ok := in["descriptionGTE"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionGTE(c))
+	}
+	if v, ok := in["descriptionLT"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionLT(c))
+	}
+	if v, ok := in["descriptionLTE"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionLTE(c))
+	}
+	if v, ok := in["descriptionContains"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionContains(c))
+	}
+	if v, ok := in["descriptionHasPrefix"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionHasPrefix(c))
+	}
+	if v, ok := in["descriptionHasSuffix"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionHasSuffix(c))
+	}
+	if v, ok := in["descriptionEqualFold"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionEqualFold(c))
+	}
+	if v, ok := in["descriptionContainsFold"]; ok && v != nil {
+		c, err := convertInvoiceLineDescription(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.DescriptionContainsFold(c))
+	}
+	if v, ok := in["quantity"]; ok && v != nil {
+		c, err := convertInvoiceLineQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.QuantityEQ(c))
+	}
+	if v, ok := in["quantityNEQ"]; ok && v != nil {
+		c, err := convertInvoiceLineQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.QuantityNEQ(c))
+	}
+	if v, ok := in["quantityIn"].([]interface{}); ok {
+		vs := make([]money.Quantity, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineQuantity(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.QuantityIn(vs...))
+	}
+	if v, ok := in["quantityNotIn"].([]interface{}); ok {
+		vs := make([]money.Quantity, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineQuantity(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.QuantityNotIn(vs...))
+	}
+	if v, ok := in["quantityGT"]; ok && v != nil {
+		c, err := convertInvoiceLineQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.QuantityGT(c))
+	}
+	if v, ok := in["quantityGTE"]; ok && v != nil {
+		c, err := convertInvoiceLineQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.QuantityGTE(c))
+	}
+	if v, ok := in["quantityLT"]; ok && v != nil {
+		c, err := convertInvoiceLineQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.QuantityLT(c))
+	}
+	if v, ok := in["quantityLTE"]; ok && v != nil {
+		c, err := convertInvoiceLineQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.QuantityLTE(c))
+	}
+	if v, ok := in["unitPrice"]; ok && v != nil {
+		c, err := convertInvoiceLineUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.UnitPriceEQ(c))
+	}
+	if v, ok := in["unitPriceNEQ"]; ok && v != nil {
+		c, err := convertInvoiceLineUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.UnitPriceNEQ(c))
+	}
+	if v, ok := in["unitPriceIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineUnitPrice(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.UnitPriceIn(vs...))
+	}
+	if v, ok := in["unitPriceNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineUnitPrice(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.UnitPriceNotIn(vs...))
+	}
+	if v, ok := in["unitPriceGT"]; ok && v != nil {
+		c, err := convertInvoiceLineUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.UnitPriceGT(c))
+	}
+	if v, ok := in["unitPriceGTE"]; ok && v != nil {
+		c, err := convertInvoiceLineUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.UnitPriceGTE(c))
+	}
+	if v, ok := in["unitPriceLT"]; ok && v != nil {
+		c, err := convertInvoiceLineUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.UnitPriceLT(c))
+	}
+	if v, ok := in["unitPriceLTE"]; ok && v != nil {
+		c, err := convertInvoiceLineUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.UnitPriceLTE(c))
+	}
+	if v, ok := in["amount"]; ok && v != nil {
+		c, err := convertInvoiceLineAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.AmountEQ(c))
+	}
+	if v, ok := in["amountNEQ"]; ok && v != nil {
+		c, err := convertInvoiceLineAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.AmountNEQ(c))
+	}
+	if v, ok := in["amountIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineAmount(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.AmountIn(vs...))
+	}
+	if v, ok := in["amountNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertInvoiceLineAmount(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, invoiceline.AmountNotIn(vs...))
+	}
+	if v, ok := in["amountGT"]; ok && v != nil {
+		c, err := convertInvoiceLineAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.AmountGT(c))
+	}
+	if v, ok := in["amountGTE"]; ok && v != nil {
+		c, err := convertInvoiceLineAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.AmountGTE(c))
+	}
+	if v, ok := in["amountLT"]; ok && v != nil {
+		c, err := convertInvoiceLineAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.AmountLT(c))
+	}
+	if v, ok := in["amountLTE"]; ok && v != nil {
+		c, err := convertInvoiceLineAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, invoiceline.AmountLTE(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, invoiceline.HasOrganization())
+		} else {
+			ps = append(ps, invoiceline.Not(invoiceline.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, invoiceline.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasInvoice"].(bool); ok {
+		if v {
+			ps = append(ps, invoiceline.HasInvoice())
+		} else {
+			ps = append(ps, invoiceline.Not(invoiceline.HasInvoice()))
+		}
+	}
+	if v, ok := in["hasInvoiceWith"]; ok && v != nil {
+		var with []predicate.Invoice
+		for _, v := range wheres(v) {
+			sub, err := s.whereInvoice(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, invoiceline.HasInvoiceWith(with...))
+	}
+	return ps, nil
+}
+
+// convertInvoiceLineKind returns the kind of InvoiceLine of an argument.
+func convertInvoiceLineKind(v interface{}) (invoiceline.Kind, error) {
+	s, _ := v.(string)
+	return invoiceline.Kind(s), nil
+}
+
+// convertInvoiceLineDescription returns the description of InvoiceLine of an argument.
+func convertInvoiceLineDescription(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertInvoiceLineQuantity returns the quantity of InvoiceLine of an argument.
+func convertInvoiceLineQuantity(v interface{}) (money.Quantity, error) {
+	s, _ := v.(string)
+	return money.ParseQuantity(s)
+}
+
+// convertInvoiceLineUnitPrice returns the unit_price of InvoiceLine of an argument.
+func convertInvoiceLineUnitPrice(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// convertInvoiceLineAmount returns the amount of InvoiceLine of an argument.
+func convertInvoiceLineAmount(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// defineJob defines the types of Job and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineJob(query, mutation graphql.Fields, mutable bool) {
+	s.enums["JobStatus"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "JobStatus",
+		Values: graphql.EnumValueConfigMap{
+			"pending":   &graphql.EnumValueConfig{Value: "pending"},
+			"running":   &graphql.EnumValueConfig{Value: "running"},
+			"succeeded": &graphql.EnumValueConfig{Value: "succeeded"},
+			"dead":      &graphql.EnumValueConfig{Value: "dead"},
+			"cancelled": &graphql.EnumValueConfig{Value: "cancelled"},
+		},
+	})
+	leaves := map[string]leaf{
+		"create_time":  graphql.DateTime,
+		"update_time":  graphql.DateTime,
+		"kind":         graphql.String,
+		"payload":      graphql.String,
+		"status":       s.enums["JobStatus"],
+		"attempts":     graphql.Int,
+		"max_attempts": graphql.Int,
+		"run_at":       graphql.DateTime,
+		"locked_by":    graphql.String,
+		"locked_until": graphql.DateTime,
+		"last_error":   graphql.String,
+		"finished_at":  graphql.DateTime,
+	}
+
+	s.objects["Job"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Job",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Job).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).CreateTime
+						return v, nil
+					},
+				},
+				"updateTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["update_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).UpdateTime
+						return v, nil
+					},
+				},
+				"kind": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["kind"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).Kind
+						return v, nil
+					},
+				},
+				"payload": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["payload"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).Payload
+						return v, nil
+					},
+				},
+				"status": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["status"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).Status
+						return string(v), nil
+					},
+				},
+				"attempts": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["attempts"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).Attempts
+						return v, nil
+					},
+				},
+				"maxAttempts": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["max_attempts"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).MaxAttempts
+						return v, nil
+					},
+				},
+				"runAt": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["run_at"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).RunAt
+						return v, nil
+					},
+				},
+				"lockedBy": &graphql.Field{
+					Type: leaves["locked_by"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).LockedBy
+						return v, nil
+					},
+				},
+				"lockedUntil": &graphql.Field{
+					Type: leaves["locked_until"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).LockedUntil
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"lastError": &graphql.Field{
+					Type: leaves["last_error"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).LastError
+						return v, nil
+					},
+				},
+				"finishedAt": &graphql.Field{
+					Type: leaves["finished_at"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Job).FinishedAt
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Job.organization", p.Source.(*ent.Job).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Job.Query().
+								Where(job.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Job"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "JobWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Job"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                   &graphql.InputObjectFieldConfig{Type: where},
+				"and":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":            &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"updateTime":            &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeNEQ":         &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeNotIn":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeGT":          &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeGTE":         &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLT":          &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLTE":         &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"kind":                  &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindNEQ":               &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"kindNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"kindGT":                &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindGTE":               &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindLT":                &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindLTE":               &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindContains":          &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindHasPrefix":         &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindHasSuffix":         &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindEqualFold":         &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindContainsFold":      &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"payload":               &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["payload"]))},
+				"payloadNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["payload"]))},
+				"payloadGT":             &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadGTE":            &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadLT":             &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadLTE":            &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadContains":       &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadHasPrefix":      &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadHasSuffix":      &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadEqualFold":      &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadContainsFold":   &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"status":                &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+				"statusNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+				"statusIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["status"]))},
+				"statusNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["status"]))},
+				"attempts":              &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsNEQ":           &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["attempts"]))},
+				"attemptsNotIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["attempts"]))},
+				"attemptsGT":            &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsGTE":           &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsLT":            &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsLTE":           &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"maxAttempts":           &graphql.InputObjectFieldConfig{Type: leaves["max_attempts"]},
+				"maxAttemptsNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["max_attempts"]},
+				"maxAttemptsIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["max_attempts"]))},
+				"maxAttemptsNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["max_attempts"]))},
+				"maxAttemptsGT":         &graphql.InputObjectFieldConfig{Type: leaves["max_attempts"]},
+				"maxAttemptsGTE":        &graphql.InputObjectFieldConfig{Type: leaves["max_attempts"]},
+				"maxAttemptsLT":         &graphql.InputObjectFieldConfig{Type: leaves["max_attempts"]},
+				"maxAttemptsLTE":        &graphql.InputObjectFieldConfig{Type: leaves["max_attempts"]},
+				"runAt":                 &graphql.InputObjectFieldConfig{Type: leaves["run_at"]},
+				"runAtNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["run_at"]},
+				"runAtIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["run_at"]))},
+				"runAtNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["run_at"]))},
+				"runAtGT":               &graphql.InputObjectFieldConfig{Type: leaves["run_at"]},
+				"runAtGTE":              &graphql.InputObjectFieldConfig{Type: leaves["run_at"]},
+				"runAtLT":               &graphql.InputObjectFieldConfig{Type: leaves["run_at"]},
+				"runAtLTE":              &graphql.InputObjectFieldConfig{Type: leaves["run_at"]},
+				"lockedBy":              &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByNEQ":           &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["locked_by"]))},
+				"lockedByNotIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["locked_by"]))},
+				"lockedByGT":            &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByGTE":           &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByLT":            &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByLTE":           &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByContains":      &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByHasPrefix":     &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByHasSuffix":     &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByIsNil":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"lockedByNotNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"lockedByEqualFold":     &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedByContainsFold":  &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+				"lockedUntil":           &graphql.InputObjectFieldConfig{Type: leaves["locked_until"]},
+				"lockedUntilNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["locked_until"]},
+				"lockedUntilIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["locked_until"]))},
+				"lockedUntilNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["locked_until"]))},
+				"lockedUntilGT":         &graphql.InputObjectFieldConfig{Type: leaves["locked_until"]},
+				"lockedUntilGTE":        &graphql.InputObjectFieldConfig{Type: leaves["locked_until"]},
+				"lockedUntilLT":         &graphql.InputObjectFieldConfig{Type: leaves["locked_until"]},
+				"lockedUntilLTE":        &graphql.InputObjectFieldConfig{Type: leaves["locked_until"]},
+				"lockedUntilIsNil":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"lockedUntilNotNil":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"lastError":             &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["last_error"]))},
+				"lastErrorNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["last_error"]))},
+				"lastErrorGT":           &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorGTE":          &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorLT":           &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorLTE":          &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorContains":     &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorIsNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"lastErrorNotNil":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"lastErrorEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"lastErrorContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+				"finishedAt":            &graphql.InputObjectFieldConfig{Type: leaves["finished_at"]},
+				"finishedAtNEQ":         &graphql.InputObjectFieldConfig{Type: leaves["finished_at"]},
+				"finishedAtIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["finished_at"]))},
+				"finishedAtNotIn":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["finished_at"]))},
+				"finishedAtGT":          &graphql.InputObjectFieldConfig{Type: leaves["finished_at"]},
+				"finishedAtGTE":         &graphql.InputObjectFieldConfig{Type: leaves["finished_at"]},
+				"finishedAtLT":          &graphql.InputObjectFieldConfig{Type: leaves["finished_at"]},
+				"finishedAtLTE":         &graphql.InputObjectFieldConfig{Type: leaves["finished_at"]},
+				"finishedAtIsNil":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"finishedAtNotNil":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganization":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+			}
+		}),
+	})
+
+	query["job"] = &graphql.Field{
+		Type: s.objects["Job"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Job.Query().
+				Where(job.ID(id)).
+				Where(s.scopeJob(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["jobs"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Job")),
+		Args: connectionArgs(s.wheres["Job"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Job.Query().Where(s.scopeJob(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereJob(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(job.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(job.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(job.FieldID))
+			} else {
+				q.Order(ent.Asc(job.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateJobInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"updateTime":     &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+			"kind":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["kind"])},
+			"payload":        &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+			"status":         &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+			"attempts":       &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+			"maxAttempts":    &graphql.InputObjectFieldConfig{Type: leaves["max_attempts"]},
+			"runAt":          &graphql.InputObjectFieldConfig{Type: leaves["run_at"]},
+			"lockedBy":       &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+			"lockedUntil":    &graphql.InputObjectFieldConfig{Type: leaves["locked_until"]},
+			"lastError":      &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+			"finishedAt":     &graphql.InputObjectFieldConfig{Type: leaves["finished_at"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateJobInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"status":            &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+			"attempts":          &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+			"maxAttempts":       &graphql.InputObjectFieldConfig{Type: leaves["max_attempts"]},
+			"runAt":             &graphql.InputObjectFieldConfig{Type: leaves["run_at"]},
+			"lockedBy":          &graphql.InputObjectFieldConfig{Type: leaves["locked_by"]},
+			"clearLockedBy":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"lockedUntil":       &graphql.InputObjectFieldConfig{Type: leaves["locked_until"]},
+			"clearLockedUntil":  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"lastError":         &graphql.InputObjectFieldConfig{Type: leaves["last_error"]},
+			"clearLastError":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"finishedAt":        &graphql.InputObjectFieldConfig{Type: leaves["finished_at"]},
+			"clearFinishedAt":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createJob"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Job"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createJob"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Job.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertJobCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["updateTime"]; ok && v != nil {
+				c, err := convertJobUpdateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUpdateTime(c)
+			}
+			if v, ok := in["kind"]; ok && v != nil {
+				c, err := convertJobKind(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetKind(c)
+			}
+			if v, ok := in["payload"]; ok && v != nil {
+				c, err := convertJobPayload(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPayload(c)
+			}
+			if v, ok := in["status"]; ok && v != nil {
+				c, err := convertJobStatus(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetStatus(c)
+			}
+			if v, ok := in["attempts"]; ok && v != nil {
+				c, err := convertJobAttempts(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAttempts(c)
+			}
+			if v, ok := in["maxAttempts"]; ok && v != nil {
+				c, err := convertJobMaxAttempts(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetMaxAttempts(c)
+			}
+			if v, ok := in["runAt"]; ok && v != nil {
+				c, err := convertJobRunAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRunAt(c)
+			}
+			if v, ok := in["lockedBy"]; ok && v != nil {
+				c, err := convertJobLockedBy(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLockedBy(c)
+			}
+			if v, ok := in["lockedUntil"]; ok && v != nil {
+				c, err := convertJobLockedUntil(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLockedUntil(c)
+			}
+			if v, ok := in["lastError"]; ok && v != nil {
+				c, err := convertJobLastError(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLastError(c)
+			}
+			if v, ok := in["finishedAt"]; ok && v != nil {
+				c, err := convertJobFinishedAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetFinishedAt(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateJob"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Job"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateJob"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Job.UpdateOneID(id)
+			if v, ok := in["status"]; ok && v != nil {
+				c, err := convertJobStatus(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetStatus(c)
+			}
+			if v, ok := in["attempts"]; ok && v != nil {
+				c, err := convertJobAttempts(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAttempts(c)
+			}
+			if v, ok := in["maxAttempts"]; ok && v != nil {
+				c, err := convertJobMaxAttempts(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetMaxAttempts(c)
+			}
+			if v, ok := in["runAt"]; ok && v != nil {
+				c, err := convertJobRunAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRunAt(c)
+			}
+			if v, ok := in["lockedBy"]; ok && v != nil {
+				c, err := convertJobLockedBy(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLockedBy(c)
+			}
+			if v, ok := in["clearLockedBy"]; ok && v == true {
+				b.ClearLockedBy()
+			}
+			if v, ok := in["lockedUntil"]; ok && v != nil {
+				c, err := convertJobLockedUntil(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLockedUntil(c)
+			}
+			if v, ok := in["clearLockedUntil"]; ok && v == true {
+				b.ClearLockedUntil()
+			}
+			if v, ok := in["lastError"]; ok && v != nil {
+				c, err := convertJobLastError(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLastError(c)
+			}
+			if v, ok := in["clearLastError"]; ok && v == true {
+				b.ClearLastError()
+			}
+			if v, ok := in["finishedAt"]; ok && v != nil {
+				c, err := convertJobFinishedAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetFinishedAt(c)
+			}
+			if v, ok := in["clearFinishedAt"]; ok && v == true {
+				b.ClearFinishedAt()
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteJob"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteJob"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Job.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeJob returns the predicates narrowing the Job entities
+// that can be read.
+func (s *Schema) scopeJob(ctx context.Context) []predicate.Job {
+	if s.opts.Scopes.Job == nil {
+		return nil
+	}
+	return s.opts.Scopes.Job(ctx)
+}
+
+// whereJob returns the predicates of a JobWhereInput.
+func (s *Schema) whereJob(in map[string]interface{}) ([]predicate.Job, error) {
+	var ps []predicate.Job
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereJob(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, job.Not(job.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Job
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereJob(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, job.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, job.And(group...))
+		default:
+			ps = append(ps, job.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertJobCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertJobCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertJobCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertJobCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertJobCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertJobCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.CreateTimeLTE(c))
+	}
+	if v, ok := in["updateTime"]; ok && v != nil {
+		c, err := convertJobUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.UpdateTimeEQ(c))
+	}
+	if v, ok := in["updateTimeNEQ"]; ok && v != nil {
+		c, err := convertJobUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.UpdateTimeNEQ(c))
+	}
+	if v, ok := in["updateTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.UpdateTimeIn(vs...))
+	}
+	if v, ok := in["updateTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.UpdateTimeNotIn(vs...))
+	}
+	if v, ok := in["updateTimeGT"]; ok && v != nil {
+		c, err := convertJobUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.UpdateTimeGT(c))
+	}
+	if v, ok := in["updateTimeGTE"]; ok && v != nil {
+		c, err := convertJobUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.UpdateTimeGTE(c))
+	}
+	if v, ok := in["updateTimeLT"]; ok && v != nil {
+		c, err := convertJobUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.UpdateTimeLT(c))
+	}
+	if v, ok := in["updateTimeLTE"]; ok && v != nil {
+		c, err := convertJobUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.UpdateTimeLTE(c))
+	}
+	if v, ok := in["kind"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindEQ(c))
+	}
+	if v, ok := in["kindNEQ"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindNEQ(c))
+	}
+	if v, ok := in["kindIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertJobKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.KindIn(vs...))
+	}
+	if v, ok := in["kindNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertJobKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.KindNotIn(vs...))
+	}
+	if v, ok := in["kindGT"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindGT(c))
+	}
+	if v, ok := in["kindGTE"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindGTE(c))
+	}
+	if v, ok := in["kindLT"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindLT(c))
+	}
+	if v, ok := in["kindLTE"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindLTE(c))
+	}
+	if v, ok := in["kindContains"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindContains(c))
+	}
+	if v, ok := in["kindHasPrefix"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindHasPrefix(c))
+	}
+	if v, ok := in["kindHasSuffix"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindHasSuffix(c))
+	}
+	if v, ok := in["kindEqualFold"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindEqualFold(c))
+	}
+	if v, ok := in["kindContainsFold"]; ok && v != nil {
+		c, err := convertJobKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.KindContainsFold(c))
+	}
+	if v, ok := in["payload"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadEQ(c))
+	}
+	if v, ok := in["payloadNEQ"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadNEQ(c))
+	}
+	if v, ok := in["payloadIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertJobPayload(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.PayloadIn(vs...))
+	}
+	if v, ok := in["payloadNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertJobPayload(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.PayloadNotIn(vs...))
+	}
+	if v, ok := in["payloadGT"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadGT(c))
+	}
+	if v, ok := in["payloadGTE"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadGTE(c))
+	}
+	if v, ok := in["payloadLT"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadLT(c))
+	}
+	if v, ok := in["payloadLTE"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadLTE(c))
+	}
+	if v, ok := in["payloadContains"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadContains(c))
+	}
+	if v, ok := in["payloadHasPrefix"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadHasPrefix(c))
+	}
+	if v, ok := in["payloadHasSuffix"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadHasSuffix(c))
+	}
+	if v, ok := in["payloadEqualFold"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadEqualFold(c))
+	}
+	if v, ok := in["payloadContainsFold"]; ok && v != nil {
+		c, err := convertJobPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.PayloadContainsFold(c))
+	}
+	if v, ok := in["status"]; ok && v != nil {
+		c, err := convertJobStatus(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.StatusEQ(c))
+	}
+	if v, ok := in["statusNEQ"]; ok && v != nil {
+		c, err := convertJobStatus(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.StatusNEQ(c))
+	}
+	if v, ok := in["statusIn"].([]interface{}); ok {
+		vs := make([]job.Status, len(v))
+		for i := range v {
+			c, err := convertJobStatus(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.StatusIn(vs...))
+	}
+	if v, ok := in["statusNotIn"].([]interface{}); ok {
+		vs := make([]job.Status, len(v))
+		for i := range v {
+			c, err := convertJobStatus(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.StatusNotIn(vs...))
+	}
+	if v, ok := in["attempts"]; ok && v != nil {
+		c, err := convertJobAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.AttemptsEQ(c))
+	}
+	if v, ok := in["attemptsNEQ"]; ok && v != nil {
+		c, err := convertJobAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.AttemptsNEQ(c))
+	}
+	if v, ok := in["attemptsIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertJobAttempts(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.AttemptsIn(vs...))
+	}
+	if v, ok := in["attemptsNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertJobAttempts(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.AttemptsNotIn(vs...))
+	}
+	if v, ok := in["attemptsGT"]; ok && v != nil {
+		c, err := convertJobAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.AttemptsGT(c))
+	}
+	if v, ok := in["attemptsGTE"]; ok && v != nil {
+		c, err := convertJobAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.AttemptsGTE(c))
+	}
+	if v, ok := in["attemptsLT"]; ok && v != nil {
+		c, err := convertJobAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.AttemptsLT(c))
+	}
+	if v, ok := in["attemptsLTE"]; ok && v != nil {
+		c, err := convertJobAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.AttemptsLTE(c))
+	}
+	if v, ok := in["maxAttempts"]; ok && v != nil {
+		c, err := convertJobMaxAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.MaxAttemptsEQ(c))
+	}
+	if v, ok := in["maxAttemptsNEQ"]; ok && v != nil {
+		c, err := convertJobMaxAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.MaxAttemptsNEQ(c))
+	}
+	if v, ok := in["maxAttemptsIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertJobMaxAttempts(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.MaxAttemptsIn(vs...))
+	}
+	if v, ok := in["maxAttemptsNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertJobMaxAttempts(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.MaxAttemptsNotIn(vs...))
+	}
+	if v, ok := in["maxAttemptsGT"]; ok && v != nil {
+		c, err := convertJobMaxAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.MaxAttemptsGT(c))
+	}
+	if v, ok := in["maxAttemptsGTE"]; ok && v != nil {
+		c, err := convertJobMaxAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.MaxAttemptsGTE(c))
+	}
+	if v, ok := in["maxAttemptsLT"]; ok && v != nil {
+		c, err := convertJobMaxAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.MaxAttemptsLT(c))
+	}
+	if v, ok := in["maxAttemptsLTE"]; ok && v != nil {
+		c, err := convertJobMaxAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.MaxAttemptsLTE(c))
+	}
+	if v, ok := in["runAt"]; ok && v != nil {
+		c, err := convertJobRunAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.RunAtEQ(c))
+	}
+	if v, ok := in["runAtNEQ"]; ok && v != nil {
+		c, err := convertJobRunAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.RunAtNEQ(c))
+	}
+	if v, ok := in["runAtIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobRunAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.RunAtIn(vs...))
+	}
+	if v, ok := in["runAtNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobRunAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.RunAtNotIn(vs...))
+	}
+	if v, ok := in["runAtGT"]; ok && v != nil {
+		c, err := convertJobRunAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.RunAtGT(c))
+	}
+	if v, ok := in["runAtGTE"]; ok && v != nil {
+		c, err := convertJobRunAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.RunAtGTE(c))
+	}
+	if v, ok := in["runAtLT"]; ok && v != nil {
+		c, err := convertJobRunAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.RunAtLT(c))
+	}
+	if v, ok := in["runAtLTE"]; ok && v != nil {
+		c, err := convertJobRunAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.RunAtLTE(c))
+	}
+	if v, ok := in["lockedBy"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByEQ(c))
+	}
+	if v, ok := in["lockedByNEQ"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByNEQ(c))
+	}
+	if v, ok := in["lockedByIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertJobLockedBy(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.LockedByIn(vs...))
+	}
+	if v, ok := in["lockedByNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertJobLockedBy(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.LockedByNotIn(vs...))
+	}
+	if v, ok := in["lockedByGT"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByGT(c))
+	}
+	if v, ok := in["lockedByGTE"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByGTE(c))
+	}
+	if v, ok := in["lockedByLT"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByLT(c))
+	}
+	if v, ok := in["lockedByLTE"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByLTE(c))
+	}
+	if v, ok := in["lockedByContains"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByContains(c))
+	}
+	if v, ok := in["lockedByHasPrefix"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByHasPrefix(c))
+	}
+	if v, ok := in["lockedByHasSuffix"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByHasSuffix(c))
+	}
+	if v, ok := in["lockedByIsNil"]; ok && v == true {
+		ps = append(ps, job.LockedByIsNil())
+	}
+	if v, ok := in["lockedByNotNil"]; ok && v == true {
+		ps = append(ps, job.LockedByNotNil())
+	}
+	if v, ok := in["lockedByEqualFold"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByEqualFold(c))
+	}
+	if v, ok := in["lockedByContainsFold"]; ok && v != nil {
+		c, err := convertJobLockedBy(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedByContainsFold(c))
+	}
+	if v, ok := in["lockedUntil"]; ok && v != nil {
+		c, err := convertJobLockedUntil(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedUntilEQ(c))
+	}
+	if v, ok := in["lockedUntilNEQ"]; ok && v != nil {
+		c, err := convertJobLockedUntil(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedUntilNEQ(c))
+	}
+	if v, ok := in["lockedUntilIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobLockedUntil(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.LockedUntilIn(vs...))
+	}
+	if v, ok := in["lockedUntilNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobLockedUntil(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.LockedUntilNotIn(vs...))
+	}
+	if v, ok := in["lockedUntilGT"]; ok && v != nil {
+		c, err := convertJobLockedUntil(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedUntilGT(c))
+	}
+	if v, ok := in["lockedUntilGTE"]; ok && v != nil {
+		c, err := convertJobLockedUntil(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedUntilGTE(c))
+	}
+	if v, ok := in["lockedUntilLT"]; ok && v != nil {
+		c, err := convertJobLockedUntil(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedUntilLT(c))
+	}
+	if v, ok := in["lockedUntilLTE"]; ok && v != nil {
+		c, err := convertJobLockedUntil(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LockedUntilLTE(c))
+	}
+	if v, ok := in["lockedUntilIsNil"]; ok && v == true {
+		ps = append(ps, job.LockedUntilIsNil())
+	}
+	if v, ok := in["lockedUntilNotNil"]; ok && v == true {
+		ps = append(ps, job.LockedUntilNotNil())
+	}
+	if v, ok := in["lastError"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorEQ(c))
+	}
+	if v, ok := in["lastErrorNEQ"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorNEQ(c))
+	}
+	if v, ok := in["lastErrorIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertJobLastError(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.LastErrorIn(vs...))
+	}
+	if v, ok := in["lastErrorNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertJobLastError(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.LastErrorNotIn(vs...))
+	}
+	if v, ok := in["lastErrorGT"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorGT(c))
+	}
+	if v, ok := in["lastErrorGTE"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorGTE(c))
+	}
+	if v, ok := in["lastErrorLT"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorLT(c))
+	}
+	if v, ok := in["lastErrorLTE"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorLTE(c))
+	}
+	if v, ok := in["lastErrorContains"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorContains(c))
+	}
+	if v, ok := in["lastErrorHasPrefix"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorHasPrefix(c))
+	}
+	if v, ok := in["lastErrorHasSuffix"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorHasSuffix(c))
+	}
+	if v, ok := in["lastErrorIsNil"]; ok && v == true {
+		ps = append(ps, job.LastErrorIsNil())
+	}
+	if v, ok := in["lastErrorNotNil"]; ok && v == true {
+		ps = append(ps, job.LastErrorNotNil())
+	}
+	if v, ok := in["lastErrorEqualFold"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorEqualFold(c))
+	}
+	if v, ok := in["lastErrorContainsFold"]; ok && v != nil {
+		c, err := convertJobLastError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.LastErrorContainsFold(c))
+	}
+	if v, ok := in["finishedAt"]; ok && v != nil {
+		c, err := convertJobFinishedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.FinishedAtEQ(c))
+	}
+	if v, ok := in["finishedAtNEQ"]; ok && v != nil {
+		c, err := convertJobFinishedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.FinishedAtNEQ(c))
+	}
+	if v, ok := in["finishedAtIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobFinishedAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.FinishedAtIn(vs...))
+	}
+	if v, ok := in["finishedAtNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertJobFinishedAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, job.FinishedAtNotIn(vs...))
+	}
+	if v, ok := in["finishedAtGT"]; ok && v != nil {
+		c, err := convertJobFinishedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.FinishedAtGT(c))
+	}
+	if v, ok := in["finishedAtGTE"]; ok && v != nil {
+		c, err := convertJobFinishedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.FinishedAtGTE(c))
+	}
+	if v, ok := in["finishedAtLT"]; ok && v != nil {
+		c, err := convertJobFinishedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.FinishedAtLT(c))
+	}
+	if v, ok := in["finishedAtLTE"]; ok && v != nil {
+		c, err := convertJobFinishedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, job.FinishedAtLTE(c))
+	}
+	if v, ok := in["finishedAtIsNil"]; ok && v == true {
+		ps = append(ps, job.FinishedAtIsNil())
+	}
+	if v, ok := in["finishedAtNotNil"]; ok && v == true {
+		ps = append(ps, job.FinishedAtNotNil())
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, job.HasOrganization())
+		} else {
+			ps = append(ps, job.Not(job.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, job.HasOrganizationWith(with...))
+	}
+	return ps, nil
+}
+
+// convertJobCreateTime returns the create_time of Job of an argument.
+func convertJobCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertJobUpdateTime returns the update_time of Job of an argument.
+func convertJobUpdateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: updateTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertJobKind returns the kind of Job of an argument.
+func convertJobKind(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertJobPayload returns the payload of Job of an argument.
+func convertJobPayload(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertJobStatus returns the status of Job of an argument.
+func convertJobStatus(v interface{}) (job.Status, error) {
+	s, _ := v.(string)
+	return job.Status(s), nil
+}
+
+// convertJobAttempts returns the attempts of Job of an argument.
+func convertJobAttempts(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// convertJobMaxAttempts returns the max_attempts of Job of an argument.
+func convertJobMaxAttempts(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// convertJobRunAt returns the run_at of Job of an argument.
+func convertJobRunAt(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: runAt: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertJobLockedBy returns the locked_by of Job of an argument.
+func convertJobLockedBy(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertJobLockedUntil returns the locked_until of Job of an argument.
+func convertJobLockedUntil(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: lockedUntil: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertJobLastError returns the last_error of Job of an argument.
+func convertJobLastError(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertJobFinishedAt returns the finished_at of Job of an argument.
+func convertJobFinishedAt(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: finishedAt: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// defineLocation defines the types of Location and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineLocation(query, mutation graphql.Fields, mutable bool) {
+	s.enums["LocationKind"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "LocationKind",
+		Values: graphql.EnumValueConfigMap{
+			"campus":   &graphql.EnumValueConfig{Value: "campus"},
+			"building": &graphql.EnumValueConfig{Value: "building"},
+			"floor":    &graphql.EnumValueConfig{Value: "floor"},
+			"room":     &graphql.EnumValueConfig{Value: "room"},
+		},
+	})
+	leaves := map[string]leaf{
+		"create_time": graphql.DateTime,
+		"update_time": graphql.DateTime,
+		"name":        graphql.String,
+		"kind":        s.enums["LocationKind"],
+	}
+
+	s.objects["Location"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Location",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Location).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Location).CreateTime
+						return v, nil
+					},
+				},
+				"updateTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["update_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Location).UpdateTime
+						return v, nil
+					},
+				},
+				"name": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["name"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Location).Name
+						return v, nil
+					},
+				},
+				"kind": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["kind"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Location).Kind
+						return string(v), nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Location.organization", p.Source.(*ent.Location).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Location.Query().
+								Where(location.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"parent": &graphql.Field{
+					Type: s.objects["Location"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Location.parent", p.Source.(*ent.Location).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Location.Query().
+								Where(location.IDIn(ids...)).
+								WithParent(func(q *ent.LocationQuery) {
+									q.Where(s.scopeLocation(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Parent != nil {
+									values[n.ID] = n.Edges.Parent
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"children": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Location"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Location.children", p.Source.(*ent.Location).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Location.Query().
+								Where(location.IDIn(ids...)).
+								WithChildren(func(q *ent.LocationQuery) {
+									q.Where(s.scopeLocation(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Children
+								if edges == nil {
+									edges = []*ent.Location{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"equipment": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Equipment"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Location.equipment", p.Source.(*ent.Location).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Location.Query().
+								Where(location.IDIn(ids...)).
+								WithEquipment(func(q *ent.EquipmentQuery) {
+									q.Where(s.scopeEquipment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Equipment
+								if edges == nil {
+									edges = []*ent.Equipment{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Location"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "LocationWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Location"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                 &graphql.InputObjectFieldConfig{Type: where},
+				"and":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"updateTime":          &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeGT":        &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeGTE":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLT":        &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLTE":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"name":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameGT":              &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameGTE":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLT":              &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLTE":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContains":        &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"kind":                &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"kindNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"hasOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasParent":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasParentWith":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Location"]))},
+				"hasChildren":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasChildrenWith":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Location"]))},
+				"hasEquipment":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasEquipmentWith":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Equipment"]))},
+			}
+		}),
+	})
+
+	query["location"] = &graphql.Field{
+		Type: s.objects["Location"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Location.Query().
+				Where(location.ID(id)).
+				Where(s.scopeLocation(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["locations"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Location")),
+		Args: connectionArgs(s.wheres["Location"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Location.Query().Where(s.scopeLocation(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereLocation(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(location.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(location.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(location.FieldID))
+			} else {
+				q.Order(ent.Asc(location.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateLocationInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"updateTime":     &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+			"name":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["name"])},
+			"kind":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["kind"])},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"parentID":       &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"childIDs":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"equipmentIDs":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateLocationInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":               &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+			"kind":               &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+			"organizationID":     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization":  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"parentID":           &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearParent":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addChildIDs":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeChildIDs":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addEquipmentIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeEquipmentIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	mutation["createLocation"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Location"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createLocation"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Location.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertLocationCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["updateTime"]; ok && v != nil {
+				c, err := convertLocationUpdateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUpdateTime(c)
+			}
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertLocationName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["kind"]; ok && v != nil {
+				c, err := convertLocationKind(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetKind(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["parentID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetParentID(id)
+			}
+			if v, ok := in["childIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddChildIDs(ids...)
+			}
+			if v, ok := in["equipmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddEquipmentIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateLocation"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Location"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateLocation"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Location.UpdateOneID(id)
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertLocationName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["kind"]; ok && v != nil {
+				c, err := convertLocationKind(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetKind(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["parentID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetParentID(id)
+			}
+			if v, ok := in["clearParent"]; ok && v == true {
+				b.ClearParent()
+			}
+			if v, ok := in["addChildIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddChildIDs(ids...)
+			}
+			if v, ok := in["removeChildIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveChildIDs(ids...)
+			}
+			if v, ok := in["addEquipmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddEquipmentIDs(ids...)
+			}
+			if v, ok := in["removeEquipmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveEquipmentIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteLocation"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteLocation"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Location.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeLocation returns the predicates narrowing the Location entities
+// that can be read.
+func (s *Schema) scopeLocation(ctx context.Context) []predicate.Location {
+	if s.opts.Scopes.Location == nil {
+		return nil
+	}
+	return s.opts.Scopes.Location(ctx)
+}
+
+// whereLocation returns the predicates of a LocationWhereInput.
+func (s *Schema) whereLocation(in map[string]interface{}) ([]predicate.Location, error) {
+	var ps []predicate.Location
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, location.Not(location.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Location
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereLocation(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, location.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, location.And(group...))
+		default:
+			ps = append(ps, location.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertLocationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertLocationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertLocationCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, location.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertLocationCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, location.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertLocationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertLocationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertLocationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertLocationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.CreateTimeLTE(c))
+	}
+	if v, ok := in["updateTime"]; ok && v != nil {
+		c, err := convertLocationUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.UpdateTimeEQ(c))
+	}
+	if v, ok := in["updateTimeNEQ"]; ok && v != nil {
+		c, err := convertLocationUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.UpdateTimeNEQ(c))
+	}
+	if v, ok := in["updateTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertLocationUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, location.UpdateTimeIn(vs...))
+	}
+	if v, ok := in["updateTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertLocationUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, location.UpdateTimeNotIn(vs...))
+	}
+	if v, ok := in["updateTimeGT"]; ok && v != nil {
+		c, err := convertLocationUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.UpdateTimeGT(c))
+	}
+	if v, ok := in["updateTimeGTE"]; ok && v != nil {
+		c, err := convertLocationUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.UpdateTimeGTE(c))
+	}
+	if v, ok := in["updateTimeLT"]; ok && v != nil {
+		c, err := convertLocationUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.UpdateTimeLT(c))
+	}
+	if v, ok := in["updateTimeLTE"]; ok && v != nil {
+		c, err := convertLocationUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.UpdateTimeLTE(c))
+	}
+	if v, ok := in["name"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameEQ(c))
+	}
+	if v, ok := in["nameNEQ"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameNEQ(c))
+	}
+	if v, ok := in["nameIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertLocationName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, location.NameIn(vs...))
+	}
+	if v, ok := in["nameNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertLocationName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, location.NameNotIn(vs...))
+	}
+	if v, ok := in["nameGT"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameGT(c))
+	}
+	if v, ok := in["nameGTE"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameGTE(c))
+	}
+	if v, ok := in["nameLT"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameLT(c))
+	}
+	if v, ok := in["nameLTE"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameLTE(c))
+	}
+	if v, ok := in["nameContains"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameContains(c))
+	}
+	if v, ok := in["nameHasPrefix"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameHasPrefix(c))
+	}
+	if v, ok := in["nameHasSuffix"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameHasSuffix(c))
+	}
+	if v, ok := in["nameEqualFold"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameEqualFold(c))
+	}
+	if v, ok := in["nameContainsFold"]; ok && v != nil {
+		c, err := convertLocationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.NameContainsFold(c))
+	}
+	if v, ok := in["kind"]; ok && v != nil {
+		c, err := convertLocationKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.KindEQ(c))
+	}
+	if v, ok := in["kindNEQ"]; ok && v != nil {
+		c, err := convertLocationKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, location.KindNEQ(c))
+	}
+	if v, ok := in["kindIn"].([]interface{}); ok {
+		vs := make([]location.Kind, len(v))
+		for i := range v {
+			c, err := convertLocationKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, location.KindIn(vs...))
+	}
+	if v, ok := in["kindNotIn"].([]interface{}); ok {
+		vs := make([]location.Kind, len(v))
+		for i := range v {
+			c, err := convertLocationKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, location.KindNotIn(vs...))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, location.HasOrganization())
+		} else {
+			ps = append(ps, location.Not(location.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, location.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasParent"].(bool); ok {
+		if v {
+			ps = append(ps, location.HasParent())
+		} else {
+			ps = append(ps, location.Not(location.HasParent()))
+		}
+	}
+	if v, ok := in["hasParentWith"]; ok && v != nil {
+		var with []predicate.Location
+		for _, v := range wheres(v) {
+			sub, err := s.whereLocation(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, location.HasParentWith(with...))
+	}
+	if v, ok := in["hasChildren"].(bool); ok {
+		if v {
+			ps = append(ps, location.HasChildren())
+		} else {
+			ps = append(ps, location.Not(location.HasChildren()))
+		}
+	}
+	if v, ok := in["hasChildrenWith"]; ok && v != nil {
+		var with []predicate.Location
+		for _, v := range wheres(v) {
+			sub, err := s.whereLocation(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, location.HasChildrenWith(with...))
+	}
+	if v, ok := in["hasEquipment"].(bool); ok {
+		if v {
+			ps = append(ps, location.HasEquipment())
+		} else {
+			ps = append(ps, location.Not(location.HasEquipment()))
+		}
+	}
+	if v, ok := in["hasEquipmentWith"]; ok && v != nil {
+		var with []predicate.Equipment
+		for _, v := range wheres(v) {
+			sub, err := s.whereEquipment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, location.HasEquipmentWith(with...))
+	}
+	return ps, nil
+}
+
+// convertLocationCreateTime returns the create_time of Location of an argument.
+func convertLocationCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertLocationUpdateTime returns the update_time of Location of an argument.
+func convertLocationUpdateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: updateTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertLocationName returns the name of Location of an argument.
+func convertLocationName(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertLocationKind returns the kind of Location of an argument.
+func convertLocationKind(v interface{}) (location.Kind, error) {
+	s, _ := v.(string)
+	return location.Kind(s), nil
+}
+
+// defineOrganization defines the types of Organization and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineOrganization(query, mutation graphql.Fields, mutable bool) {
+	leaves := map[string]leaf{
+		"create_time": graphql.DateTime,
+		"name":        graphql.String,
+	}
+
+	s.objects["Organization"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Organization",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Organization).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Organization).CreateTime
+						return v, nil
+					},
+				},
+				"name": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["name"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Organization).Name
+						return v, nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Organization"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "OrganizationWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Organization"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":              &graphql.InputObjectFieldConfig{Type: where},
+				"and":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":            &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":             &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":            &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":             &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":            &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":    &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":    &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":    &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"name":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameGT":           &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameGTE":          &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLT":           &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLTE":          &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContains":     &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+			}
+		}),
+	})
+
+	query["organization"] = &graphql.Field{
+		Type: s.objects["Organization"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Organization.Query().
+				Where(organization.ID(id)).
+				Where(s.scopeOrganization(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["organizations"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Organization")),
+		Args: connectionArgs(s.wheres["Organization"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Organization.Query().Where(s.scopeOrganization(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereOrganization(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(organization.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(organization.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(organization.FieldID))
+			} else {
+				q.Order(ent.Asc(organization.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateOrganizationInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime": &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"name":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["name"])},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateOrganizationInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name": &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+		},
+	})
+	mutation["createOrganization"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Organization"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createOrganization"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Organization.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertOrganizationCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertOrganizationName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateOrganization"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Organization"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateOrganization"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Organization.UpdateOneID(id)
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertOrganizationName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteOrganization"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteOrganization"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Organization.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeOrganization returns the predicates narrowing the Organization entities
+// that can be read.
+func (s *Schema) scopeOrganization(ctx context.Context) []predicate.Organization {
+	if s.opts.Scopes.Organization == nil {
+		return nil
+	}
+	return s.opts.Scopes.Organization(ctx)
+}
+
+// whereOrganization returns the predicates of a OrganizationWhereInput.
+func (s *Schema) whereOrganization(in map[string]interface{}) ([]predicate.Organization, error) {
+	var ps []predicate.Organization
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereOrganization(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, organization.Not(organization.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Organization
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, organization.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, organization.And(group...))
+		default:
+			ps = append(ps, organization.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertOrganizationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertOrganizationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertOrganizationCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, organization.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertOrganizationCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, organization.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertOrganizationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertOrganizationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertOrganizationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertOrganizationCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.CreateTimeLTE(c))
+	}
+	if v, ok := in["name"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameEQ(c))
+	}
+	if v, ok := in["nameNEQ"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameNEQ(c))
+	}
+	if v, ok := in["nameIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertOrganizationName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, organization.NameIn(vs...))
+	}
+	if v, ok := in["nameNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertOrganizationName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, organization.NameNotIn(vs...))
+	}
+	if v, ok := in["nameGT"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameGT(c))
+	}
+	if v, ok := in["nameGTE"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameGTE(c))
+	}
+	if v, ok := in["nameLT"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameLT(c))
+	}
+	if v, ok := in["nameLTE"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameLTE(c))
+	}
+	if v, ok := in["nameContains"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameContains(c))
+	}
+	if v, ok := in["nameHasPrefix"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameHasPrefix(c))
+	}
+	if v, ok := in["nameHasSuffix"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameHasSuffix(c))
+	}
+	if v, ok := in["nameEqualFold"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameEqualFold(c))
+	}
+	if v, ok := in["nameContainsFold"]; ok && v != nil {
+		c, err := convertOrganizationName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, organization.NameContainsFold(c))
+	}
+	return ps, nil
+}
+
+// convertOrganizationCreateTime returns the create_time of Organization of an argument.
+func convertOrganizationCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertOrganizationName returns the name of Organization of an argument.
+func convertOrganizationName(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// definePart defines the types of Part and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) definePart(query, mutation graphql.Fields, mutable bool) {
+	leaves := map[string]leaf{
+		"sku":        graphql.String,
+		"name":       graphql.String,
+		"unit":       graphql.String,
+		"unit_price": graphql.String,
+	}
+
+	s.objects["Part"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Part",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Part).ID, nil
+					},
+				},
+				"sku": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["sku"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Part).Sku
+						return v, nil
+					},
+				},
+				"name": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["name"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Part).Name
+						return v, nil
+					},
+				},
+				"unit": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["unit"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Part).Unit
+						return v, nil
+					},
+				},
+				"unitPrice": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["unit_price"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Part).UnitPrice
+						return v.String(), nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Part.organization", p.Source.(*ent.Part).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Part.Query().
+								Where(part.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"stockLevels": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["StockLevel"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Part.stock_levels", p.Source.(*ent.Part).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Part.Query().
+								Where(part.IDIn(ids...)).
+								WithStockLevels(func(q *ent.StockLevelQuery) {
+									q.Where(s.scopeStockLevel(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.StockLevels
+								if edges == nil {
+									edges = []*ent.StockLevel{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"movements": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["StockMovement"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Part.movements", p.Source.(*ent.Part).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Part.Query().
+								Where(part.IDIn(ids...)).
+								WithMovements(func(q *ent.StockMovementQuery) {
+									q.Where(s.scopeStockMovement(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Movements
+								if edges == nil {
+									edges = []*ent.StockMovement{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Part"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "PartWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Part"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                 &graphql.InputObjectFieldConfig{Type: where},
+				"and":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"sku":                 &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["sku"]))},
+				"skuNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["sku"]))},
+				"skuGT":               &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuGTE":              &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuLT":               &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuLTE":              &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuContains":         &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuHasPrefix":        &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuHasSuffix":        &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuEqualFold":        &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"skuContainsFold":     &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+				"name":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameGT":              &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameGTE":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLT":              &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLTE":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContains":        &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"unit":                &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["unit"]))},
+				"unitNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["unit"]))},
+				"unitGT":              &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitGTE":             &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitLT":              &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitLTE":             &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitContains":        &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+				"unitPrice":           &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["unit_price"]))},
+				"unitPriceNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["unit_price"]))},
+				"unitPriceGT":         &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceGTE":        &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceLT":         &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"unitPriceLTE":        &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+				"hasOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasStockLevels":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasStockLevelsWith":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["StockLevel"]))},
+				"hasMovements":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasMovementsWith":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["StockMovement"]))},
+			}
+		}),
+	})
+
+	query["part"] = &graphql.Field{
+		Type: s.objects["Part"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Part.Query().
+				Where(part.ID(id)).
+				Where(s.scopePart(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["parts"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Part")),
+		Args: connectionArgs(s.wheres["Part"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Part.Query().Where(s.scopePart(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.wherePart(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(part.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(part.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(part.FieldID))
+			} else {
+				q.Order(ent.Asc(part.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreatePartInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"sku":            &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["sku"])},
+			"name":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["name"])},
+			"unit":           &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+			"unitPrice":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["unit_price"])},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"stockLevelIDs":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"movementIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdatePartInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"sku":                 &graphql.InputObjectFieldConfig{Type: leaves["sku"]},
+			"name":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+			"unit":                &graphql.InputObjectFieldConfig{Type: leaves["unit"]},
+			"unitPrice":           &graphql.InputObjectFieldConfig{Type: leaves["unit_price"]},
+			"organizationID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addStockLevelIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeStockLevelIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addMovementIDs":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeMovementIDs":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	mutation["createPart"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Part"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createPart"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Part.Create()
+			if v, ok := in["sku"]; ok && v != nil {
+				c, err := convertPartSku(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSku(c)
+			}
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertPartName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["unit"]; ok && v != nil {
+				c, err := convertPartUnit(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUnit(c)
+			}
+			if v, ok := in["unitPrice"]; ok && v != nil {
+				c, err := convertPartUnitPrice(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUnitPrice(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["stockLevelIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddStockLevelIDs(ids...)
+			}
+			if v, ok := in["movementIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddMovementIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updatePart"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Part"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updatePart"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Part.UpdateOneID(id)
+			if v, ok := in["sku"]; ok && v != nil {
+				c, err := convertPartSku(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSku(c)
+			}
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertPartName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["unit"]; ok && v != nil {
+				c, err := convertPartUnit(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUnit(c)
+			}
+			if v, ok := in["unitPrice"]; ok && v != nil {
+				c, err := convertPartUnitPrice(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUnitPrice(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["addStockLevelIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddStockLevelIDs(ids...)
+			}
+			if v, ok := in["removeStockLevelIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveStockLevelIDs(ids...)
+			}
+			if v, ok := in["addMovementIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddMovementIDs(ids...)
+			}
+			if v, ok := in["removeMovementIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveMovementIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deletePart"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deletePart"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Part.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopePart returns the predicates narrowing the Part entities
+// that can be read.
+func (s *Schema) scopePart(ctx context.Context) []predicate.Part {
+	if s.opts.Scopes.Part == nil {
+		return nil
+	}
+	return s.opts.Scopes.Part(ctx)
+}
+
+// wherePart returns the predicates of a PartWhereInput.
+func (s *Schema) wherePart(in map[string]interface{}) ([]predicate.Part, error) {
+	var ps []predicate.Part
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.wherePart(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, part.Not(part.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Part
+		for _, v := range wheres(in[op]) {
+			sub, err := s.wherePart(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, part.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, part.And(group...))
+		default:
+			ps = append(ps, part.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.IDLTE(ids))
+	}
+	if v, ok := in["sku"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuEQ(c))
+	}
+	if v, ok := in["skuNEQ"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuNEQ(c))
+	}
+	if v, ok := in["skuIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPartSku(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, part.SkuIn(vs...))
+	}
+	if v, ok := in["skuNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPartSku(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, part.SkuNotIn(vs...))
+	}
+	if v, ok := in["skuGT"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuGT(c))
+	}
+	if v, ok := in["skuGTE"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuGTE(c))
+	}
+	if v, ok := in["skuLT"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuLT(c))
+	}
+	if v, ok := in["skuLTE"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuLTE(c))
+	}
+	if v, ok := in["skuContains"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuContains(c))
+	}
+	if v, ok := in["skuHasPrefix"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuHasPrefix(c))
+	}
+	if v, ok := in["skuHasSuffix"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuHasSuffix(c))
+	}
+	if v, ok := in["skuEqualFold"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuEqualFold(c))
+	}
+	if v, ok := in["skuContainsFold"]; ok && v != nil {
+		c, err := convertPartSku(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.SkuContainsFold(c))
+	}
+	if v, ok := in["name"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameEQ(c))
+	}
+	if v, ok := in["nameNEQ"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameNEQ(c))
+	}
+	if v, ok := in["nameIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPartName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, part.NameIn(vs...))
+	}
+	if v, ok := in["nameNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPartName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, part.NameNotIn(vs...))
+	}
+	if v, ok := in["nameGT"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameGT(c))
+	}
+	if v, ok := in["nameGTE"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameGTE(c))
+	}
+	if v, ok := in["nameLT"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameLT(c))
+	}
+	if v, ok := in["nameLTE"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameLTE(c))
+	}
+	if v, ok := in["nameContains"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameContains(c))
+	}
+	if v, ok := in["nameHasPrefix"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameHasPrefix(c))
+	}
+	if v, ok := in["nameHasSuffix"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameHasSuffix(c))
+	}
+	if v, ok := in["nameEqualFold"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameEqualFold(c))
+	}
+	if v, ok := in["nameContainsFold"]; ok && v != nil {
+		c, err := convertPartName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.NameContainsFold(c))
+	}
+	if v, ok := in["unit"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitEQ(c))
+	}
+	if v, ok := in["unitNEQ"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitNEQ(c))
+	}
+	if v, ok := in["unitIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPartUnit(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, part.UnitIn(vs...))
+	}
+	if v, ok := in["unitNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPartUnit(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, part.UnitNotIn(vs...))
+	}
+	if v, ok := in["unitGT"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitGT(c))
+	}
+	if v, ok := in["unitGTE"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitGTE(c))
+	}
+	if v, ok := in["unitLT"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitLT(c))
+	}
+	if v, ok := in["unitLTE"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitLTE(c))
+	}
+	if v, ok := in["unitContains"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitContains(c))
+	}
+	if v, ok := in["unitHasPrefix"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitHasPrefix(c))
+	}
+	if v, ok := in["unitHasSuffix"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitHasSuffix(c))
+	}
+	if v, ok := in["unitEqualFold"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitEqualFold(c))
+	}
+	if v, ok := in["unitContainsFold"]; ok && v != nil {
+		c, err := convertPartUnit(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitContainsFold(c))
+	}
+	if v, ok := in["unitPrice"]; ok && v != nil {
+		c, err := convertPartUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitPriceEQ(c))
+	}
+	if v, ok := in["unitPriceNEQ"]; ok && v != nil {
+		c, err := convertPartUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitPriceNEQ(c))
+	}
+	if v, ok := in["unitPriceIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertPartUnitPrice(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, part.UnitPriceIn(vs...))
+	}
+	if v, ok := in["unitPriceNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertPartUnitPrice(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, part.UnitPriceNotIn(vs...))
+	}
+	if v, ok := in["unitPriceGT"]; ok && v != nil {
+		c, err := convertPartUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitPriceGT(c))
+	}
+	if v, ok := in["unitPriceGTE"]; ok && v != nil {
+		c, err := convertPartUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitPriceGTE(c))
+	}
+	if v, ok := in["unitPriceLT"]; ok && v != nil {
+		c, err := convertPartUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitPriceLT(c))
+	}
+	if v, ok := in["unitPriceLTE"]; ok && v != nil {
+		c, err := convertPartUnitPrice(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, part.UnitPriceLTE(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, part.HasOrganization())
+		} else {
+			ps = append(ps, part.Not(part.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, part.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasStockLevels"].(bool); ok {
+		if v {
+			ps = append(ps, part.HasStockLevels())
+		} else {
+			ps = append(ps, part.Not(part.HasStockLevels()))
+		}
+	}
+	if v, ok := in["hasStockLevelsWith"]; ok && v != nil {
+		var with []predicate.StockLevel
+		for _, v := range wheres(v) {
+			sub, err := s.whereStockLevel(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, part.HasStockLevelsWith(with...))
+	}
+	if v, ok := in["hasMovements"].(bool); ok {
+		if v {
+			ps = append(ps, part.HasMovements())
+		} else {
+			ps = append(ps, part.Not(part.HasMovements()))
+		}
+	}
+	if v, ok := in["hasMovementsWith"]; ok && v != nil {
+		var with []predicate.StockMovement
+		for _, v := range wheres(v) {
+			sub, err := s.whereStockMovement(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, part.HasMovementsWith(with...))
+	}
+	return ps, nil
+}
+
+// convertPartSku returns the sku of Part of an argument.
+func convertPartSku(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertPartName returns the name of Part of an argument.
+func convertPartName(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertPartUnit returns the unit of Part of an argument.
+func convertPartUnit(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertPartUnitPrice returns the unit_price of Part of an argument.
+func convertPartUnitPrice(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// definePayment defines the types of Payment and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) definePayment(query, mutation graphql.Fields, mutable bool) {
+	s.enums["PaymentKind"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "PaymentKind",
+		Values: graphql.EnumValueConfigMap{
+			"payment": &graphql.EnumValueConfig{Value: "payment"},
+			"refund":  &graphql.EnumValueConfig{Value: "refund"},
+		},
+	})
+	s.enums["PaymentMethod"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "PaymentMethod",
+		Values: graphql.EnumValueConfigMap{
+			"cash":          &graphql.EnumValueConfig{Value: "cash"},
+			"bank_transfer": &graphql.EnumValueConfig{Value: "bank_transfer"},
+			"promptpay":     &graphql.EnumValueConfig{Value: "promptpay"},
+		},
+	})
+	leaves := map[string]leaf{
+		"create_time": graphql.DateTime,
+		"number":      graphql.String,
+		"kind":        s.enums["PaymentKind"],
+		"method":      s.enums["PaymentMethod"],
+		"amount":      graphql.String,
+		"reference":   graphql.String,
+		"paid_at":     graphql.DateTime,
+	}
+
+	s.objects["Payment"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Payment",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Payment).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Payment).CreateTime
+						return v, nil
+					},
+				},
+				"number": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["number"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Payment).Number
+						return v, nil
+					},
+				},
+				"kind": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["kind"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Payment).Kind
+						return string(v), nil
+					},
+				},
+				"method": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["method"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Payment).Method
+						return string(v), nil
+					},
+				},
+				"amount": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["amount"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Payment).Amount
+						return v.String(), nil
+					},
+				},
+				"reference": &graphql.Field{
+					Type: leaves["reference"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Payment).Reference
+						return v, nil
+					},
+				},
+				"paidAt": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["paid_at"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Payment).PaidAt
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Payment.organization", p.Source.(*ent.Payment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Payment.Query().
+								Where(payment.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"invoice": &graphql.Field{
+					Type: s.objects["Invoice"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Payment.invoice", p.Source.(*ent.Payment).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Payment.Query().
+								Where(payment.IDIn(ids...)).
+								WithInvoice(func(q *ent.InvoiceQuery) {
+									q.Where(s.scopeInvoice(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Invoice != nil {
+									values[n.ID] = n.Edges.Invoice
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Payment"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "PaymentWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Payment"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                   &graphql.InputObjectFieldConfig{Type: where},
+				"and":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":            &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"number":                &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["number"]))},
+				"numberNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["number"]))},
+				"numberGT":              &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberGTE":             &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberLT":              &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberLTE":             &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberContains":        &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberHasPrefix":       &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberHasSuffix":       &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberEqualFold":       &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"numberContainsFold":    &graphql.InputObjectFieldConfig{Type: leaves["number"]},
+				"kind":                  &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindNEQ":               &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"kindNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"method":                &graphql.InputObjectFieldConfig{Type: leaves["method"]},
+				"methodNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["method"]},
+				"methodIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["method"]))},
+				"methodNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["method"]))},
+				"amount":                &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["amount"]))},
+				"amountNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["amount"]))},
+				"amountGT":              &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountGTE":             &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountLT":              &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"amountLTE":             &graphql.InputObjectFieldConfig{Type: leaves["amount"]},
+				"reference":             &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["reference"]))},
+				"referenceNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["reference"]))},
+				"referenceGT":           &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceGTE":          &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceLT":           &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceLTE":          &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceContains":     &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceIsNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"referenceNotNil":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"referenceEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"referenceContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+				"paidAt":                &graphql.InputObjectFieldConfig{Type: leaves["paid_at"]},
+				"paidAtNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["paid_at"]},
+				"paidAtIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["paid_at"]))},
+				"paidAtNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["paid_at"]))},
+				"paidAtGT":              &graphql.InputObjectFieldConfig{Type: leaves["paid_at"]},
+				"paidAtGTE":             &graphql.InputObjectFieldConfig{Type: leaves["paid_at"]},
+				"paidAtLT":              &graphql.InputObjectFieldConfig{Type: leaves["paid_at"]},
+				"paidAtLTE":             &graphql.InputObjectFieldConfig{Type: leaves["paid_at"]},
+				"hasOrganization":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasInvoice":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasInvoiceWith":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Invoice"]))},
+			}
+		}),
+	})
+
+	query["payment"] = &graphql.Field{
+		Type: s.objects["Payment"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Payment.Query().
+				Where(payment.ID(id)).
+				Where(s.scopePayment(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["payments"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Payment")),
+		Args: connectionArgs(s.wheres["Payment"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Payment.Query().Where(s.scopePayment(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.wherePayment(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(payment.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(payment.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(payment.FieldID))
+			} else {
+				q.Order(ent.Asc(payment.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreatePaymentInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"number":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["number"])},
+			"kind":           &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+			"method":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["method"])},
+			"amount":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["amount"])},
+			"reference":      &graphql.InputObjectFieldConfig{Type: leaves["reference"]},
+			"paidAt":         &graphql.InputObjectFieldConfig{Type: leaves["paid_at"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"invoiceID":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdatePaymentInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"invoiceID":         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearInvoice":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createPayment"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Payment"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createPayment"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Payment.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertPaymentCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["number"]; ok && v != nil {
+				c, err := convertPaymentNumber(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetNumber(c)
+			}
+			if v, ok := in["kind"]; ok && v != nil {
+				c, err := convertPaymentKind(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetKind(c)
+			}
+			if v, ok := in["method"]; ok && v != nil {
+				c, err := convertPaymentMethod(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetMethod(c)
+			}
+			if v, ok := in["amount"]; ok && v != nil {
+				c, err := convertPaymentAmount(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAmount(c)
+			}
+			if v, ok := in["reference"]; ok && v != nil {
+				c, err := convertPaymentReference(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetReference(c)
+			}
+			if v, ok := in["paidAt"]; ok && v != nil {
+				c, err := convertPaymentPaidAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPaidAt(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["invoiceID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetInvoiceID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updatePayment"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Payment"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updatePayment"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Payment.UpdateOneID(id)
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["invoiceID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetInvoiceID(id)
+			}
+			if v, ok := in["clearInvoice"]; ok && v == true {
+				b.ClearInvoice()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deletePayment"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deletePayment"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Payment.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopePayment returns the predicates narrowing the Payment entities
+// that can be read.
+func (s *Schema) scopePayment(ctx context.Context) []predicate.Payment {
+	if s.opts.Scopes.Payment == nil {
+		return nil
+	}
+	return s.opts.Scopes.Payment(ctx)
+}
+
+// wherePayment returns the predicates of a PaymentWhereInput.
+func (s *Schema) wherePayment(in map[string]interface{}) ([]predicate.Payment, error) {
+	var ps []predicate.Payment
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.wherePayment(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, payment.Not(payment.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Payment
+		for _, v := range wheres(in[op]) {
+			sub, err := s.wherePayment(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, payment.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, payment.And(group...))
+		default:
+			ps = append(ps, payment.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertPaymentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertPaymentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertPaymentCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertPaymentCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertPaymentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertPaymentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertPaymentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertPaymentCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.CreateTimeLTE(c))
+	}
+	if v, ok := in["number"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberEQ(c))
+	}
+	if v, ok := in["numberNEQ"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberNEQ(c))
+	}
+	if v, ok := in["numberIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPaymentNumber(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.NumberIn(vs...))
+	}
+	if v, ok := in["numberNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPaymentNumber(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.NumberNotIn(vs...))
+	}
+	if v, ok := in["numberGT"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberGT(c))
+	}
+	if v, ok := in["numberGTE"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberGTE(c))
+	}
+	if v, ok := in["numberLT"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberLT(c))
+	}
+	if v, ok := in["numberLTE"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberLTE(c))
+	}
+	if v, ok := in["numberContains"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberContains(c))
+	}
+	if v, ok := in["numberHasPrefix"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberHasPrefix(c))
+	}
+	if v, ok := in["numberHasSuffix"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberHasSuffix(c))
+	}
+	if v, ok := in["numberEqualFold"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberEqualFold(c))
+	}
+	if v, ok := in["numberContainsFold"]; ok && v != nil {
+		c, err := convertPaymentNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.NumberContainsFold(c))
+	}
+	if v, ok := in["kind"]; ok && v != nil {
+		c, err := convertPaymentKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.KindEQ(c))
+	}
+	if v, ok := in["kindNEQ"]; ok && v != nil {
+		c, err := convertPaymentKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.KindNEQ(c))
+	}
+	if v, ok := in["kindIn"].([]interface{}); ok {
+		vs := make([]payment.Kind, len(v))
+		for i := range v {
+			c, err := convertPaymentKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.KindIn(vs...))
+	}
+	if v, ok := in["kindNotIn"].([]interface{}); ok {
+		vs := make([]payment.Kind, len(v))
+		for i := range v {
+			c, err := convertPaymentKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.KindNotIn(vs...))
+	}
+	if v, ok := in["method"]; ok && v != nil {
+		c, err := convertPaymentMethod(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.MethodEQ(c))
+	}
+	if v, ok := in["methodNEQ"]; ok && v != nil {
+		c, err := convertPaymentMethod(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.MethodNEQ(c))
+	}
+	if v, ok := in["methodIn"].([]interface{}); ok {
+		vs := make([]payment.Method, len(v))
+		for i := range v {
+			c, err := convertPaymentMethod(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.MethodIn(vs...))
+	}
+	if v, ok := in["methodNotIn"].([]interface{}); ok {
+		vs := make([]payment.Method, len(v))
+		for i := range v {
+			c, err := convertPaymentMethod(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.MethodNotIn(vs...))
+	}
+	if v, ok := in["amount"]; ok && v != nil {
+		c, err := convertPaymentAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.AmountEQ(c))
+	}
+	if v, ok := in["amountNEQ"]; ok && v != nil {
+		c, err := convertPaymentAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.AmountNEQ(c))
+	}
+	if v, ok := in["amountIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertPaymentAmount(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.AmountIn(vs...))
+	}
+	if v, ok := in["amountNotIn"].([]interface{}); ok {
+		vs := make([]money.Amount, len(v))
+		for i := range v {
+			c, err := convertPaymentAmount(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.AmountNotIn(vs...))
+	}
+	if v, ok := in["amountGT"]; ok && v != nil {
+		c, err := convertPaymentAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.AmountGT(c))
+	}
+	if v, ok := in["amountGTE"]; ok && v != nil {
+		c, err := convertPaymentAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.AmountGTE(c))
+	}
+	if v, ok := in["amountLT"]; ok && v != nil {
+		c, err := convertPaymentAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.AmountLT(c))
+	}
+	if v, ok := in["amountLTE"]; ok && v != nil {
+		c, err := convertPaymentAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.AmountLTE(c))
+	}
+	if v, ok := in["reference"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceEQ(c))
+	}
+	if v, ok := in["referenceNEQ"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceNEQ(c))
+	}
+	if v, ok := in["referenceIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPaymentReference(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.ReferenceIn(vs...))
+	}
+	if v, ok := in["referenceNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertPaymentReference(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.ReferenceNotIn(vs...))
+	}
+	if v, ok := in["referenceGT"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceGT(c))
+	}
+	if v, ok := in["referenceGTE"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceGTE(c))
+	}
+	if v, ok := in["referenceLT"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceLT(c))
+	}
+	if v, ok := in["referenceLTE"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceLTE(c))
+	}
+	if v, ok := in["referenceContains"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceContains(c))
+	}
+	if v, ok := in["referenceHasPrefix"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceHasPrefix(c))
+	}
+	if v, ok := in["referenceHasSuffix"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceHasSuffix(c))
+	}
+	if v, ok := in["referenceIsNil"]; ok && v == true {
+		ps = append(ps, payment.ReferenceIsNil())
+	}
+	if v, ok := in["referenceNotNil"]; ok && v == true {
+		ps = append(ps, payment.ReferenceNotNil())
+	}
+	if v, ok := in["referenceEqualFold"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceEqualFold(c))
+	}
+	if v, ok := in["referenceContainsFold"]; ok && v != nil {
+		c, err := convertPaymentReference(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.ReferenceContainsFold(c))
+	}
+	if v, ok := in["paidAt"]; ok && v != nil {
+		c, err := convertPaymentPaidAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.PaidAtEQ(c))
+	}
+	if v, ok := in["paidAtNEQ"]; ok && v != nil {
+		c, err := convertPaymentPaidAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.PaidAtNEQ(c))
+	}
+	if v, ok := in["paidAtIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertPaymentPaidAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.PaidAtIn(vs...))
+	}
+	if v, ok := in["paidAtNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertPaymentPaidAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, payment.PaidAtNotIn(vs...))
+	}
+	if v, ok := in["paidAtGT"]; ok && v != nil {
+		c, err := convertPaymentPaidAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.PaidAtGT(c))
+	}
+	if v, ok := in["paidAtGTE"]; ok && v != nil {
+		c, err := convertPaymentPaidAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.PaidAtGTE(c))
+	}
+	if v, ok := in["paidAtLT"]; ok && v != nil {
+		c, err := convertPaymentPaidAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.PaidAtLT(c))
+	}
+	if v, ok := in["paidAtLTE"]; ok && v != nil {
+		c, err := convertPaymentPaidAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, payment.PaidAtLTE(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, payment.HasOrganization())
+		} else {
+			ps = append(ps, payment.Not(payment.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, payment.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasInvoice"].(bool); ok {
+		if v {
+			ps = append(ps, payment.HasInvoice())
+		} else {
+			ps = append(ps, payment.Not(payment.HasInvoice()))
+		}
+	}
+	if v, ok := in["hasInvoiceWith"]; ok && v != nil {
+		var with []predicate.Invoice
+		for _, v := range wheres(v) {
+			sub, err := s.whereInvoice(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, payment.HasInvoiceWith(with...))
+	}
+	return ps, nil
+}
+
+// convertPaymentCreateTime returns the create_time of Payment of an argument.
+func convertPaymentCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertPaymentNumber returns the number of Payment of an argument.
+func convertPaymentNumber(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertPaymentKind returns the kind of Payment of an argument.
+func convertPaymentKind(v interface{}) (payment.Kind, error) {
+	s, _ := v.(string)
+	return payment.Kind(s), nil
+}
+
+// convertPaymentMethod returns the method of Payment of an argument.
+func convertPaymentMethod(v interface{}) (payment.Method, error) {
+	s, _ := v.(string)
+	return payment.Method(s), nil
+}
+
+// convertPaymentAmount returns the amount of Payment of an argument.
+func convertPaymentAmount(v interface{}) (money.Amount, error) {
+	s, _ := v.(string)
+	return money.ParseAmount(s)
+}
+
+// convertPaymentReference returns the reference of Payment of an argument.
+func convertPaymentReference(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertPaymentPaidAt returns the paid_at of Payment of an argument.
+func convertPaymentPaidAt(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: paidAt: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// defineRepairSlip defines the types of RepairSlip and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineRepairSlip(query, mutation graphql.Fields, mutable bool) {
+	s.enums["RepairSlipStatus"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "RepairSlipStatus",
+		Values: graphql.EnumValueConfigMap{
+			"received":      &graphql.EnumValueConfig{Value: "received"},
+			"in_progress":   &graphql.EnumValueConfig{Value: "in_progress"},
+			"waiting_parts": &graphql.EnumValueConfig{Value: "waiting_parts"},
+			"ready":         &graphql.EnumValueConfig{Value: "ready"},
+			"closed":        &graphql.EnumValueConfig{Value: "closed"},
+		},
+	})
+	s.enums["RepairSlipRoute"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "RepairSlipRoute",
+		Values: graphql.EnumValueConfigMap{
+			"in_house":       &graphql.EnumValueConfig{Value: "in_house"},
+			"warranty_claim": &graphql.EnumValueConfig{Value: "warranty_claim"},
+		},
+	})
+	s.enums["RepairSlipPriority"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "RepairSlipPriority",
+		Values: graphql.EnumValueConfigMap{
+			"low":    &graphql.EnumValueConfig{Value: "low"},
+			"normal": &graphql.EnumValueConfig{Value: "normal"},
+			"high":   &graphql.EnumValueConfig{Value: "high"},
+			"urgent": &graphql.EnumValueConfig{Value: "urgent"},
+		},
+	})
+	leaves := map[string]leaf{
+		"create_time":         graphql.DateTime,
+		"update_time":         graphql.DateTime,
+		"symptom":             graphql.String,
+		"category":            graphql.String,
+		"status":              s.enums["RepairSlipStatus"],
+		"under_warranty":      graphql.Boolean,
+		"route":               s.enums["RepairSlipRoute"],
+		"priority":            s.enums["RepairSlipPriority"],
+		"response_due":        graphql.DateTime,
+		"resolution_due":      graphql.DateTime,
+		"responded_at":        graphql.DateTime,
+		"resolved_at":         graphql.DateTime,
+		"response_breached":   graphql.Boolean,
+		"resolution_breached": graphql.Boolean,
+		"escalated_at":        graphql.DateTime,
+	}
+
+	s.objects["RepairSlip"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "RepairSlip",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.RepairSlip).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).CreateTime
+						return v, nil
+					},
+				},
+				"updateTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["update_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).UpdateTime
+						return v, nil
+					},
+				},
+				"symptom": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["symptom"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).Symptom
+						return v, nil
+					},
+				},
+				"category": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["category"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).Category
+						return v, nil
+					},
+				},
+				"status": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["status"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).Status
+						return string(v), nil
+					},
+				},
+				"underWarranty": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["under_warranty"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).UnderWarranty
+						return v, nil
+					},
+				},
+				"route": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["route"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).Route
+						return string(v), nil
+					},
+				},
+				"priority": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["priority"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).Priority
+						return string(v), nil
+					},
+				},
+				"responseDue": &graphql.Field{
+					Type: leaves["response_due"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).ResponseDue
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"resolutionDue": &graphql.Field{
+					Type: leaves["resolution_due"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).ResolutionDue
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"respondedAt": &graphql.Field{
+					Type: leaves["responded_at"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).RespondedAt
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"resolvedAt": &graphql.Field{
+					Type: leaves["resolved_at"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).ResolvedAt
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"responseBreached": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["response_breached"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).ResponseBreached
+						return v, nil
+					},
+				},
+				"resolutionBreached": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["resolution_breached"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).ResolutionBreached
+						return v, nil
+					},
+				},
+				"escalatedAt": &graphql.Field{
+					Type: leaves["escalated_at"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.RepairSlip).EscalatedAt
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "RepairSlip.organization", p.Source.(*ent.RepairSlip).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.RepairSlip.Query().
+								Where(repairslip.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"reporter": &graphql.Field{
+					Type: s.objects["User"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "RepairSlip.reporter", p.Source.(*ent.RepairSlip).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.RepairSlip.Query().
+								Where(repairslip.IDIn(ids...)).
+								WithReporter(func(q *ent.UserQuery) {
+									q.Where(s.scopeUser(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Reporter != nil {
+									values[n.ID] = n.Edges.Reporter
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"assignee": &graphql.Field{
+					Type: s.objects["User"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "RepairSlip.assignee", p.Source.(*ent.RepairSlip).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.RepairSlip.Query().
+								Where(repairslip.IDIn(ids...)).
+								WithAssignee(func(q *ent.UserQuery) {
+									q.Where(s.scopeUser(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Assignee != nil {
+									values[n.ID] = n.Edges.Assignee
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"partsUsed": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["StockMovement"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "RepairSlip.parts_used", p.Source.(*ent.RepairSlip).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.RepairSlip.Query().
+								Where(repairslip.IDIn(ids...)).
+								WithPartsUsed(func(q *ent.StockMovementQuery) {
+									q.Where(s.scopeStockMovement(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.PartsUsed
+								if edges == nil {
+									edges = []*ent.StockMovement{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"invoice": &graphql.Field{
+					Type: s.objects["Invoice"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "RepairSlip.invoice", p.Source.(*ent.RepairSlip).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.RepairSlip.Query().
+								Where(repairslip.IDIn(ids...)).
+								WithInvoice(func(q *ent.InvoiceQuery) {
+									q.Where(s.scopeInvoice(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Invoice != nil {
+									values[n.ID] = n.Edges.Invoice
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"equipment": &graphql.Field{
+					Type: s.objects["Equipment"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "RepairSlip.equipment", p.Source.(*ent.RepairSlip).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.RepairSlip.Query().
+								Where(repairslip.IDIn(ids...)).
+								WithEquipment(func(q *ent.EquipmentQuery) {
+									q.Where(s.scopeEquipment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Equipment != nil {
+									values[n.ID] = n.Edges.Equipment
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"attachments": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Attachment"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "RepairSlip.attachments", p.Source.(*ent.RepairSlip).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.RepairSlip.Query().
+								Where(repairslip.IDIn(ids...)).
+								WithAttachments(func(q *ent.AttachmentQuery) {
+									q.Where(s.scopeAttachment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Attachments
+								if edges == nil {
+									edges = []*ent.Attachment{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"comments": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Comment"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "RepairSlip.comments", p.Source.(*ent.RepairSlip).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.RepairSlip.Query().
+								Where(repairslip.IDIn(ids...)).
+								WithComments(func(q *ent.CommentQuery) {
+									q.Where(s.scopeComment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Comments
+								if edges == nil {
+									edges = []*ent.Comment{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"activities": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Activity"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "RepairSlip.activities", p.Source.(*ent.RepairSlip).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.RepairSlip.Query().
+								Where(repairslip.IDIn(ids...)).
+								WithActivities(func(q *ent.ActivityQuery) {
+									q.Where(s.scopeActivity(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Activities
+								if edges == nil {
+									edges = []*ent.Activity{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["RepairSlip"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "RepairSlipWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["RepairSlip"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                   &graphql.InputObjectFieldConfig{Type: where},
+				"and":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":            &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"updateTime":            &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeNEQ":         &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeNotIn":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeGT":          &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeGTE":         &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLT":          &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLTE":         &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"symptom":               &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["symptom"]))},
+				"symptomNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["symptom"]))},
+				"symptomGT":             &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomGTE":            &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomLT":             &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomLTE":            &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomContains":       &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomHasPrefix":      &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomHasSuffix":      &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomEqualFold":      &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"symptomContainsFold":   &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+				"category":              &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryNEQ":           &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["category"]))},
+				"categoryNotIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["category"]))},
+				"categoryGT":            &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryGTE":           &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryLT":            &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryLTE":           &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryContains":      &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryHasPrefix":     &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryHasSuffix":     &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryEqualFold":     &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryContainsFold":  &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"status":                &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+				"statusNEQ":             &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+				"statusIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["status"]))},
+				"statusNotIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["status"]))},
+				"underWarranty":         &graphql.InputObjectFieldConfig{Type: leaves["under_warranty"]},
+				"underWarrantyNEQ":      &graphql.InputObjectFieldConfig{Type: leaves["under_warranty"]},
+				"route":                 &graphql.InputObjectFieldConfig{Type: leaves["route"]},
+				"routeNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["route"]},
+				"routeIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["route"]))},
+				"routeNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["route"]))},
+				"priority":              &graphql.InputObjectFieldConfig{Type: leaves["priority"]},
+				"priorityNEQ":           &graphql.InputObjectFieldConfig{Type: leaves["priority"]},
+				"priorityIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["priority"]))},
+				"priorityNotIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["priority"]))},
+				"responseDue":           &graphql.InputObjectFieldConfig{Type: leaves["response_due"]},
+				"responseDueNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["response_due"]},
+				"responseDueIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["response_due"]))},
+				"responseDueNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["response_due"]))},
+				"responseDueGT":         &graphql.InputObjectFieldConfig{Type: leaves["response_due"]},
+				"responseDueGTE":        &graphql.InputObjectFieldConfig{Type: leaves["response_due"]},
+				"responseDueLT":         &graphql.InputObjectFieldConfig{Type: leaves["response_due"]},
+				"responseDueLTE":        &graphql.InputObjectFieldConfig{Type: leaves["response_due"]},
+				"responseDueIsNil":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"responseDueNotNil":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"resolutionDue":         &graphql.InputObjectFieldConfig{Type: leaves["resolution_due"]},
+				"resolutionDueNEQ":      &graphql.InputObjectFieldConfig{Type: leaves["resolution_due"]},
+				"resolutionDueIn":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["resolution_due"]))},
+				"resolutionDueNotIn":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["resolution_due"]))},
+				"resolutionDueGT":       &graphql.InputObjectFieldConfig{Type: leaves["resolution_due"]},
+				"resolutionDueGTE":      &graphql.InputObjectFieldConfig{Type: leaves["resolution_due"]},
+				"resolutionDueLT":       &graphql.InputObjectFieldConfig{Type: leaves["resolution_due"]},
+				"resolutionDueLTE":      &graphql.InputObjectFieldConfig{Type: leaves["resolution_due"]},
+				"resolutionDueIsNil":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"resolutionDueNotNil":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"respondedAt":           &graphql.InputObjectFieldConfig{Type: leaves["responded_at"]},
+				"respondedAtNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["responded_at"]},
+				"respondedAtIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["responded_at"]))},
+				"respondedAtNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["responded_at"]))},
+				"respondedAtGT":         &graphql.InputObjectFieldConfig{Type: leaves["responded_at"]},
+				"respondedAtGTE":        &graphql.InputObjectFieldConfig{Type: leaves["responded_at"]},
+				"respondedAtLT":         &graphql.InputObjectFieldConfig{Type: leaves["responded_at"]},
+				"respondedAtLTE":        &graphql.InputObjectFieldConfig{Type: leaves["responded_at"]},
+				"respondedAtIsNil":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"respondedAtNotNil":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"resolvedAt":            &graphql.InputObjectFieldConfig{Type: leaves["resolved_at"]},
+				"resolvedAtNEQ":         &graphql.InputObjectFieldConfig{Type: leaves["resolved_at"]},
+				"resolvedAtIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["resolved_at"]))},
+				"resolvedAtNotIn":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["resolved_at"]))},
+				"resolvedAtGT":          &graphql.InputObjectFieldConfig{Type: leaves["resolved_at"]},
+				"resolvedAtGTE":         &graphql.InputObjectFieldConfig{Type: leaves["resolved_at"]},
+				"resolvedAtLT":          &graphql.InputObjectFieldConfig{Type: leaves["resolved_at"]},
+				"resolvedAtLTE":         &graphql.InputObjectFieldConfig{Type: leaves["resolved_at"]},
+				"resolvedAtIsNil":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"resolvedAtNotNil":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"responseBreached":      &graphql.InputObjectFieldConfig{Type: leaves["response_breached"]},
+				"responseBreachedNEQ":   &graphql.InputObjectFieldConfig{Type: leaves["response_breached"]},
+				"resolutionBreached":    &graphql.InputObjectFieldConfig{Type: leaves["resolution_breached"]},
+				"resolutionBreachedNEQ": &graphql.InputObjectFieldConfig{Type: leaves["resolution_breached"]},
+				"escalatedAt":           &graphql.InputObjectFieldConfig{Type: leaves["escalated_at"]},
+				"escalatedAtNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["escalated_at"]},
+				"escalatedAtIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["escalated_at"]))},
+				"escalatedAtNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["escalated_at"]))},
+				"escalatedAtGT":         &graphql.InputObjectFieldConfig{Type: leaves["escalated_at"]},
+				"escalatedAtGTE":        &graphql.InputObjectFieldConfig{Type: leaves["escalated_at"]},
+				"escalatedAtLT":         &graphql.InputObjectFieldConfig{Type: leaves["escalated_at"]},
+				"escalatedAtLTE":        &graphql.InputObjectFieldConfig{Type: leaves["escalated_at"]},
+				"escalatedAtIsNil":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"escalatedAtNotNil":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganization":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasReporter":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasReporterWith":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["User"]))},
+				"hasAssignee":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasAssigneeWith":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["User"]))},
+				"hasPartsUsed":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasPartsUsedWith":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["StockMovement"]))},
+				"hasInvoice":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasInvoiceWith":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Invoice"]))},
+				"hasEquipment":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasEquipmentWith":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Equipment"]))},
+				"hasAttachments":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasAttachmentsWith":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Attachment"]))},
+				"hasComments":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasCommentsWith":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Comment"]))},
+				"hasActivities":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasActivitiesWith":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Activity"]))},
+			}
+		}),
+	})
+
+	query["repairSlip"] = &graphql.Field{
+		Type: s.objects["RepairSlip"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.RepairSlip.Query().
+				Where(repairslip.ID(id)).
+				Where(s.scopeRepairSlip(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["repairSlips"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("RepairSlip")),
+		Args: connectionArgs(s.wheres["RepairSlip"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.RepairSlip.Query().Where(s.scopeRepairSlip(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereRepairSlip(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(repairslip.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(repairslip.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(repairslip.FieldID))
+			} else {
+				q.Order(ent.Asc(repairslip.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateRepairSlipInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"updateTime":         &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+			"symptom":            &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["symptom"])},
+			"category":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["category"])},
+			"status":             &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+			"underWarranty":      &graphql.InputObjectFieldConfig{Type: leaves["under_warranty"]},
+			"route":              &graphql.InputObjectFieldConfig{Type: leaves["route"]},
+			"priority":           &graphql.InputObjectFieldConfig{Type: leaves["priority"]},
+			"responseDue":        &graphql.InputObjectFieldConfig{Type: leaves["response_due"]},
+			"resolutionDue":      &graphql.InputObjectFieldConfig{Type: leaves["resolution_due"]},
+			"respondedAt":        &graphql.InputObjectFieldConfig{Type: leaves["responded_at"]},
+			"resolvedAt":         &graphql.InputObjectFieldConfig{Type: leaves["resolved_at"]},
+			"responseBreached":   &graphql.InputObjectFieldConfig{Type: leaves["response_breached"]},
+			"resolutionBreached": &graphql.InputObjectFieldConfig{Type: leaves["resolution_breached"]},
+			"escalatedAt":        &graphql.InputObjectFieldConfig{Type: leaves["escalated_at"]},
+			"organizationID":     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"reporterID":         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"assigneeID":         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"partsUsedIDs":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"invoiceID":          &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"equipmentID":        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"attachmentIDs":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"commentIDs":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"activityIDs":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateRepairSlipInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"symptom":             &graphql.InputObjectFieldConfig{Type: leaves["symptom"]},
+			"category":            &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+			"status":              &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+			"underWarranty":       &graphql.InputObjectFieldConfig{Type: leaves["under_warranty"]},
+			"route":               &graphql.InputObjectFieldConfig{Type: leaves["route"]},
+			"priority":            &graphql.InputObjectFieldConfig{Type: leaves["priority"]},
+			"responseDue":         &graphql.InputObjectFieldConfig{Type: leaves["response_due"]},
+			"clearResponseDue":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"resolutionDue":       &graphql.InputObjectFieldConfig{Type: leaves["resolution_due"]},
+			"clearResolutionDue":  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"respondedAt":         &graphql.InputObjectFieldConfig{Type: leaves["responded_at"]},
+			"clearRespondedAt":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"resolvedAt":          &graphql.InputObjectFieldConfig{Type: leaves["resolved_at"]},
+			"clearResolvedAt":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"responseBreached":    &graphql.InputObjectFieldConfig{Type: leaves["response_breached"]},
+			"resolutionBreached":  &graphql.InputObjectFieldConfig{Type: leaves["resolution_breached"]},
+			"escalatedAt":         &graphql.InputObjectFieldConfig{Type: leaves["escalated_at"]},
+			"clearEscalatedAt":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"organizationID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"reporterID":          &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearReporter":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"assigneeID":          &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearAssignee":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addPartsUsedIDs":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removePartsUsedIDs":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"invoiceID":           &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearInvoice":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"equipmentID":         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearEquipment":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addAttachmentIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeAttachmentIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addCommentIDs":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeCommentIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addActivityIDs":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeActivityIDs":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	mutation["createRepairSlip"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["RepairSlip"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createRepairSlip"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.RepairSlip.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertRepairSlipCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["updateTime"]; ok && v != nil {
+				c, err := convertRepairSlipUpdateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUpdateTime(c)
+			}
+			if v, ok := in["symptom"]; ok && v != nil {
+				c, err := convertRepairSlipSymptom(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSymptom(c)
+			}
+			if v, ok := in["category"]; ok && v != nil {
+				c, err := convertRepairSlipCategory(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCategory(c)
+			}
+			if v, ok := in["status"]; ok && v != nil {
+				c, err := convertRepairSlipStatus(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetStatus(c)
+			}
+			if v, ok := in["underWarranty"]; ok && v != nil {
+				c, err := convertRepairSlipUnderWarranty(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUnderWarranty(c)
+			}
+			if v, ok := in["route"]; ok && v != nil {
+				c, err := convertRepairSlipRoute(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRoute(c)
+			}
+			if v, ok := in["priority"]; ok && v != nil {
+				c, err := convertRepairSlipPriority(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPriority(c)
+			}
+			if v, ok := in["responseDue"]; ok && v != nil {
+				c, err := convertRepairSlipResponseDue(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseDue(c)
+			}
+			if v, ok := in["resolutionDue"]; ok && v != nil {
+				c, err := convertRepairSlipResolutionDue(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResolutionDue(c)
+			}
+			if v, ok := in["respondedAt"]; ok && v != nil {
+				c, err := convertRepairSlipRespondedAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRespondedAt(c)
+			}
+			if v, ok := in["resolvedAt"]; ok && v != nil {
+				c, err := convertRepairSlipResolvedAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResolvedAt(c)
+			}
+			if v, ok := in["responseBreached"]; ok && v != nil {
+				c, err := convertRepairSlipResponseBreached(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseBreached(c)
+			}
+			if v, ok := in["resolutionBreached"]; ok && v != nil {
+				c, err := convertRepairSlipResolutionBreached(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResolutionBreached(c)
+			}
+			if v, ok := in["escalatedAt"]; ok && v != nil {
+				c, err := convertRepairSlipEscalatedAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEscalatedAt(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["reporterID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetReporterID(id)
+			}
+			if v, ok := in["assigneeID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAssigneeID(id)
+			}
+			if v, ok := in["partsUsedIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddPartsUsedIDs(ids...)
+			}
+			if v, ok := in["invoiceID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetInvoiceID(id)
+			}
+			if v, ok := in["equipmentID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEquipmentID(id)
+			}
+			if v, ok := in["attachmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddAttachmentIDs(ids...)
+			}
+			if v, ok := in["commentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddCommentIDs(ids...)
+			}
+			if v, ok := in["activityIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddActivityIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateRepairSlip"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["RepairSlip"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateRepairSlip"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.RepairSlip.UpdateOneID(id)
+			if v, ok := in["symptom"]; ok && v != nil {
+				c, err := convertRepairSlipSymptom(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSymptom(c)
+			}
+			if v, ok := in["category"]; ok && v != nil {
+				c, err := convertRepairSlipCategory(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCategory(c)
+			}
+			if v, ok := in["status"]; ok && v != nil {
+				c, err := convertRepairSlipStatus(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetStatus(c)
+			}
+			if v, ok := in["underWarranty"]; ok && v != nil {
+				c, err := convertRepairSlipUnderWarranty(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUnderWarranty(c)
+			}
+			if v, ok := in["route"]; ok && v != nil {
+				c, err := convertRepairSlipRoute(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRoute(c)
+			}
+			if v, ok := in["priority"]; ok && v != nil {
+				c, err := convertRepairSlipPriority(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPriority(c)
+			}
+			if v, ok := in["responseDue"]; ok && v != nil {
+				c, err := convertRepairSlipResponseDue(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseDue(c)
+			}
+			if v, ok := in["clearResponseDue"]; ok && v == true {
+				b.ClearResponseDue()
+			}
+			if v, ok := in["resolutionDue"]; ok && v != nil {
+				c, err := convertRepairSlipResolutionDue(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResolutionDue(c)
+			}
+			if v, ok := in["clearResolutionDue"]; ok && v == true {
+				b.ClearResolutionDue()
+			}
+			if v, ok := in["respondedAt"]; ok && v != nil {
+				c, err := convertRepairSlipRespondedAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRespondedAt(c)
+			}
+			if v, ok := in["clearRespondedAt"]; ok && v == true {
+				b.ClearRespondedAt()
+			}
+			if v, ok := in["resolvedAt"]; ok && v != nil {
+				c, err := convertRepairSlipResolvedAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResolvedAt(c)
+			}
+			if v, ok := in["clearResolvedAt"]; ok && v == true {
+				b.ClearResolvedAt()
+			}
+			if v, ok := in["responseBreached"]; ok && v != nil {
+				c, err := convertRepairSlipResponseBreached(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseBreached(c)
+			}
+			if v, ok := in["resolutionBreached"]; ok && v != nil {
+				c, err := convertRepairSlipResolutionBreached(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResolutionBreached(c)
+			}
+			if v, ok := in["escalatedAt"]; ok && v != nil {
+				c, err := convertRepairSlipEscalatedAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEscalatedAt(c)
+			}
+			if v, ok := in["clearEscalatedAt"]; ok && v == true {
+				b.ClearEscalatedAt()
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["reporterID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetReporterID(id)
+			}
+			if v, ok := in["clearReporter"]; ok && v == true {
+				b.ClearReporter()
+			}
+			if v, ok := in["assigneeID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAssigneeID(id)
+			}
+			if v, ok := in["clearAssignee"]; ok && v == true {
+				b.ClearAssignee()
+			}
+			if v, ok := in["addPartsUsedIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddPartsUsedIDs(ids...)
+			}
+			if v, ok := in["removePartsUsedIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemovePartsUsedIDs(ids...)
+			}
+			if v, ok := in["invoiceID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetInvoiceID(id)
+			}
+			if v, ok := in["clearInvoice"]; ok && v == true {
+				b.ClearInvoice()
+			}
+			if v, ok := in["equipmentID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEquipmentID(id)
+			}
+			if v, ok := in["clearEquipment"]; ok && v == true {
+				b.ClearEquipment()
+			}
+			if v, ok := in["addAttachmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddAttachmentIDs(ids...)
+			}
+			if v, ok := in["removeAttachmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveAttachmentIDs(ids...)
+			}
+			if v, ok := in["addCommentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddCommentIDs(ids...)
+			}
+			if v, ok := in["removeCommentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveCommentIDs(ids...)
+			}
+			if v, ok := in["addActivityIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddActivityIDs(ids...)
+			}
+			if v, ok := in["removeActivityIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveActivityIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteRepairSlip"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteRepairSlip"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.RepairSlip.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeRepairSlip returns the predicates narrowing the RepairSlip entities
+// that can be read.
+func (s *Schema) scopeRepairSlip(ctx context.Context) []predicate.RepairSlip {
+	if s.opts.Scopes.RepairSlip == nil {
+		return nil
+	}
+	return s.opts.Scopes.RepairSlip(ctx)
+}
+
+// whereRepairSlip returns the predicates of a RepairSlipWhereInput.
+func (s *Schema) whereRepairSlip(in map[string]interface{}) ([]predicate.RepairSlip, error) {
+	var ps []predicate.RepairSlip
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereRepairSlip(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, repairslip.Not(repairslip.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.RepairSlip
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereRepairSlip(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, repairslip.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, repairslip.And(group...))
+		default:
+			ps = append(ps, repairslip.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertRepairSlipCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertRepairSlipCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertRepairSlipCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertRepairSlipCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertRepairSlipCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CreateTimeLTE(c))
+	}
+	if v, ok := in["updateTime"]; ok && v != nil {
+		c, err := convertRepairSlipUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.UpdateTimeEQ(c))
+	}
+	if v, ok := in["updateTimeNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.UpdateTimeNEQ(c))
+	}
+	if v, ok := in["updateTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.UpdateTimeIn(vs...))
+	}
+	if v, ok := in["updateTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.UpdateTimeNotIn(vs...))
+	}
+	if v, ok := in["updateTimeGT"]; ok && v != nil {
+		c, err := convertRepairSlipUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.UpdateTimeGT(c))
+	}
+	if v, ok := in["updateTimeGTE"]; ok && v != nil {
+		c, err := convertRepairSlipUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.UpdateTimeGTE(c))
+	}
+	if v, ok := in["updateTimeLT"]; ok && v != nil {
+		c, err := convertRepairSlipUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.UpdateTimeLT(c))
+	}
+	if v, ok := in["updateTimeLTE"]; ok && v != nil {
+		c, err := convertRepairSlipUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.UpdateTimeLTE(c))
+	}
+	if v, ok := in["symptom"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomEQ(c))
+	}
+	if v, ok := in["symptomNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomNEQ(c))
+	}
+	if v, ok := in["symptomIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertRepairSlipSymptom(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.SymptomIn(vs...))
+	}
+	if v, ok := in["symptomNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertRepairSlipSymptom(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.SymptomNotIn(vs...))
+	}
+	if v, ok := in["symptomGT"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomGT(c))
+	}
+	if v, ok := in["symptomGTE"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomGTE(c))
+	}
+	if v, ok := in["symptomLT"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomLT(c))
+	}
+	if v, ok := in["symptomLTE"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomLTE(c))
+	}
+	if v, ok := in["symptomContains"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomContains(c))
+	}
+	if v, ok := in["symptomHasPrefix"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomHasPrefix(c))
+	}
+	if v, ok := in["symptomHasSuffix"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomHasSuffix(c))
+	}
+	if v, ok := in["symptomEqualFold"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomEqualFold(c))
+	}
+	if v, ok := in["symptomContainsFold"]; ok && v != nil {
+		c, err := convertRepairSlipSymptom(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.SymptomContainsFold(c))
+	}
+	if v, ok := in["category"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryEQ(c))
+	}
+	if v, ok := in["categoryNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryNEQ(c))
+	}
+	if v, ok := in["categoryIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertRepairSlipCategory(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.CategoryIn(vs...))
+	}
+	if v, ok := in["categoryNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertRepairSlipCategory(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.CategoryNotIn(vs...))
+	}
+	if v, ok := in["categoryGT"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryGT(c))
+	}
+	if v, ok := in["categoryGTE"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryGTE(c))
+	}
+	if v, ok := in["categoryLT"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryLT(c))
+	}
+	if v, ok := in["categoryLTE"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryLTE(c))
+	}
+	if v, ok := in["categoryContains"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryContains(c))
+	}
+	if v, ok := in["categoryHasPrefix"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryHasPrefix(c))
+	}
+	if v, ok := in["categoryHasSuffix"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryHasSuffix(c))
+	}
+	if v, ok := in["categoryEqualFold"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryEqualFold(c))
+	}
+	if v, ok := in["categoryContainsFold"]; ok && v != nil {
+		c, err := convertRepairSlipCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.CategoryContainsFold(c))
+	}
+	if v, ok := in["status"]; ok && v != nil {
+		c, err := convertRepairSlipStatus(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.StatusEQ(c))
+	}
+	if v, ok := in["statusNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipStatus(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.StatusNEQ(c))
+	}
+	if v, ok := in["statusIn"].([]interface{}); ok {
+		vs := make([]repairslip.Status, len(v))
+		for i := range v {
+			c, err := convertRepairSlipStatus(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.StatusIn(vs...))
+	}
+	if v, ok := in["statusNotIn"].([]interface{}); ok {
+		vs := make([]repairslip.Status, len(v))
+		for i := range v {
+			c, err := convertRepairSlipStatus(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.StatusNotIn(vs...))
+	}
+	if v, ok := in["underWarranty"]; ok && v != nil {
+		c, err := convertRepairSlipUnderWarranty(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.UnderWarrantyEQ(c))
+	}
+	if v, ok := in["underWarrantyNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipUnderWarranty(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.UnderWarrantyNEQ(c))
+	}
+	if v, ok := in["route"]; ok && v != nil {
+		c, err := convertRepairSlipRoute(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.RouteEQ(c))
+	}
+	if v, ok := in["routeNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipRoute(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.RouteNEQ(c))
+	}
+	if v, ok := in["routeIn"].([]interface{}); ok {
+		vs := make([]repairslip.Route, len(v))
+		for i := range v {
+			c, err := convertRepairSlipRoute(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.RouteIn(vs...))
+	}
+	if v, ok := in["routeNotIn"].([]interface{}); ok {
+		vs := make([]repairslip.Route, len(v))
+		for i := range v {
+			c, err := convertRepairSlipRoute(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.RouteNotIn(vs...))
+	}
+	if v, ok := in["priority"]; ok && v != nil {
+		c, err := convertRepairSlipPriority(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.PriorityEQ(c))
+	}
+	if v, ok := in["priorityNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipPriority(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.PriorityNEQ(c))
+	}
+	if v, ok := in["priorityIn"].([]interface{}); ok {
+		vs := make([]repairslip.Priority, len(v))
+		for i := range v {
+			c, err := convertRepairSlipPriority(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.PriorityIn(vs...))
+	}
+	if v, ok := in["priorityNotIn"].([]interface{}); ok {
+		vs := make([]repairslip.Priority, len(v))
+		for i := range v {
+			c, err := convertRepairSlipPriority(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.PriorityNotIn(vs...))
+	}
+	if v, ok := in["responseDue"]; ok && v != nil {
+		c, err := convertRepairSlipResponseDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResponseDueEQ(c))
+	}
+	if v, ok := in["responseDueNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipResponseDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResponseDueNEQ(c))
+	}
+	if v, ok := in["responseDueIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipResponseDue(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.ResponseDueIn(vs...))
+	}
+	if v, ok := in["responseDueNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipResponseDue(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.ResponseDueNotIn(vs...))
+	}
+	if v, ok := in["responseDueGT"]; ok && v != nil {
+		c, err := convertRepairSlipResponseDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResponseDueGT(c))
+	}
+	if v, ok := in["responseDueGTE"]; ok && v != nil {
+		c, err := convertRepairSlipResponseDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResponseDueGTE(c))
+	}
+	if v, ok := in["responseDueLT"]; ok && v != nil {
+		c, err := convertRepairSlipResponseDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResponseDueLT(c))
+	}
+	if v, ok := in["responseDueLTE"]; ok && v != nil {
+		c, err := convertRepairSlipResponseDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResponseDueLTE(c))
+	}
+	if v, ok := in["responseDueIsNil"]; ok && v == true {
+		ps = append(ps, repairslip.ResponseDueIsNil())
+	}
+	if v, ok := in["responseDueNotNil"]; ok && v == true {
+		ps = append(ps, repairslip.ResponseDueNotNil())
+	}
+	if v, ok := in["resolutionDue"]; ok && v != nil {
+		c, err := convertRepairSlipResolutionDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolutionDueEQ(c))
+	}
+	if v, ok := in["resolutionDueNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipResolutionDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolutionDueNEQ(c))
+	}
+	if v, ok := in["resolutionDueIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipResolutionDue(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.ResolutionDueIn(vs...))
+	}
+	if v, ok := in["resolutionDueNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipResolutionDue(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.ResolutionDueNotIn(vs...))
+	}
+	if v, ok := in["resolutionDueGT"]; ok && v != nil {
+		c, err := convertRepairSlipResolutionDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolutionDueGT(c))
+	}
+	if v, ok := in["resolutionDueGTE"]; ok && v != nil {
+		c, err := convertRepairSlipResolutionDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolutionDueGTE(c))
+	}
+	if v, ok := in["resolutionDueLT"]; ok && v != nil {
+		c, err := convertRepairSlipResolutionDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolutionDueLT(c))
+	}
+	if v, ok := in["resolutionDueLTE"]; ok && v != nil {
+		c, err := convertRepairSlipResolutionDue(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolutionDueLTE(c))
+	}
+	if v, ok := in["resolutionDueIsNil"]; ok && v == true {
+		ps = append(ps, repairslip.ResolutionDueIsNil())
+	}
+	if v, ok := in["resolutionDueNotNil"]; ok && v == true {
+		ps = append(ps, repairslip.ResolutionDueNotNil())
+	}
+	if v, ok := in["respondedAt"]; ok && v != nil {
+		c, err := convertRepairSlipRespondedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.RespondedAtEQ(c))
+	}
+	if v, ok := in["respondedAtNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipRespondedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.RespondedAtNEQ(c))
+	}
+	if v, ok := in["respondedAtIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipRespondedAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.RespondedAtIn(vs...))
+	}
+	if v, ok := in["respondedAtNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipRespondedAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.RespondedAtNotIn(vs...))
+	}
+	if v, ok := in["respondedAtGT"]; ok && v != nil {
+		c, err := convertRepairSlipRespondedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.RespondedAtGT(c))
+	}
+	if v, ok := in["respondedAtGTE"]; ok && v != nil {
+		c, err := convertRepairSlipRespondedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.RespondedAtGTE(c))
+	}
+	if v, ok := in["respondedAtLT"]; ok && v != nil {
+		c, err := convertRepairSlipRespondedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.RespondedAtLT(c))
+	}
+	if v, ok := in["respondedAtLTE"]; ok && v != nil {
+		c, err := convertRepairSlipRespondedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.RespondedAtLTE(c))
+	}
+	if v, ok := in["respondedAtIsNil"]; ok && v == true {
+		ps = append(ps, repairslip.RespondedAtIsNil())
+	}
+	if v, ok := in["respondedAtNotNil"]; ok && v == true {
+		ps = append(ps, repairslip.RespondedAtNotNil())
+	}
+	if v, ok := in["resolvedAt"]; ok && v != nil {
+		c, err := convertRepairSlipResolvedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolvedAtEQ(c))
+	}
+	if v, ok := in["resolvedAtNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipResolvedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolvedAtNEQ(c))
+	}
+	if v, ok := in["resolvedAtIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipResolvedAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.ResolvedAtIn(vs...))
+	}
+	if v, ok := in["resolvedAtNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipResolvedAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.ResolvedAtNotIn(vs...))
+	}
+	if v, ok := in["resolvedAtGT"]; ok && v != nil {
+		c, err := convertRepairSlipResolvedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolvedAtGT(c))
+	}
+	if v, ok := in["resolvedAtGTE"]; ok && v != nil {
+		c, err := convertRepairSlipResolvedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolvedAtGTE(c))
+	}
+	if v, ok := in["resolvedAtLT"]; ok && v != nil {
+		c, err := convertRepairSlipResolvedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolvedAtLT(c))
+	}
+	if v, ok := in["resolvedAtLTE"]; ok && v != nil {
+		c, err := convertRepairSlipResolvedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolvedAtLTE(c))
+	}
+	if v, ok := in["resolvedAtIsNil"]; ok && v == true {
+		ps = append(ps, repairslip.ResolvedAtIsNil())
+	}
+	if v, ok := in["resolvedAtNotNil"]; ok && v == true {
+		ps = append(ps, repairslip.ResolvedAtNotNil())
+	}
+	if v, ok := in["responseBreached"]; ok && v != nil {
+		c, err := convertRepairSlipResponseBreached(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResponseBreachedEQ(c))
+	}
+	if v, ok := in["responseBreachedNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipResponseBreached(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResponseBreachedNEQ(c))
+	}
+	if v, ok := in["resolutionBreached"]; ok && v != nil {
+		c, err := convertRepairSlipResolutionBreached(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolutionBreachedEQ(c))
+	}
+	if v, ok := in["resolutionBreachedNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipResolutionBreached(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.ResolutionBreachedNEQ(c))
+	}
+	if v, ok := in["escalatedAt"]; ok && v != nil {
+		c, err := convertRepairSlipEscalatedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.EscalatedAtEQ(c))
+	}
+	if v, ok := in["escalatedAtNEQ"]; ok && v != nil {
+		c, err := convertRepairSlipEscalatedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.EscalatedAtNEQ(c))
+	}
+	if v, ok := in["escalatedAtIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipEscalatedAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.EscalatedAtIn(vs...))
+	}
+	if v, ok := in["escalatedAtNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertRepairSlipEscalatedAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, repairslip.EscalatedAtNotIn(vs...))
+	}
+	if v, ok := in["escalatedAtGT"]; ok && v != nil {
+		c, err := convertRepairSlipEscalatedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.EscalatedAtGT(c))
+	}
+	if v, ok := in["escalatedAtGTE"]; ok && v != nil {
+		c, err := convertRepairSlipEscalatedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.EscalatedAtGTE(c))
+	}
+	if v, ok := in["escalatedAtLT"]; ok && v != nil {
+		c, err := convertRepairSlipEscalatedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.EscalatedAtLT(c))
+	}
+	if v, ok := in["escalatedAtLTE"]; ok && v != nil {
+		c, err := convertRepairSlipEscalatedAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, repairslip.EscalatedAtLTE(c))
+	}
+	if v, ok := in["escalatedAtIsNil"]; ok && v == true {
+		ps = append(ps, repairslip.EscalatedAtIsNil())
+	}
+	if v, ok := in["escalatedAtNotNil"]; ok && v == true {
+		ps = append(ps, repairslip.EscalatedAtNotNil())
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, repairslip.HasOrganization())
+		} else {
+			ps = append(ps, repairslip.Not(repairslip.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, repairslip.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasReporter"].(bool); ok {
+		if v {
+			ps = append(ps, repairslip.HasReporter())
+		} else {
+			ps = append(ps, repairslip.Not(repairslip.HasReporter()))
+		}
+	}
+	if v, ok := in["hasReporterWith"]; ok && v != nil {
+		var with []predicate.User
+		for _, v := range wheres(v) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, repairslip.HasReporterWith(with...))
+	}
+	if v, ok := in["hasAssignee"].(bool); ok {
+		if v {
+			ps = append(ps, repairslip.HasAssignee())
+		} else {
+			ps = append(ps, repairslip.Not(repairslip.HasAssignee()))
+		}
+	}
+	if v, ok := in["hasAssigneeWith"]; ok && v != nil {
+		var with []predicate.User
+		for _, v := range wheres(v) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, repairslip.HasAssigneeWith(with...))
+	}
+	if v, ok := in["hasPartsUsed"].(bool); ok {
+		if v {
+			ps = append(ps, repairslip.HasPartsUsed())
+		} else {
+			ps = append(ps, repairslip.Not(repairslip.HasPartsUsed()))
+		}
+	}
+	if v, ok := in["hasPartsUsedWith"]; ok && v != nil {
+		var with []predicate.StockMovement
+		for _, v := range wheres(v) {
+			sub, err := s.whereStockMovement(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, repairslip.HasPartsUsedWith(with...))
+	}
+	if v, ok := in["hasInvoice"].(bool); ok {
+		if v {
+			ps = append(ps, repairslip.HasInvoice())
+		} else {
+			ps = append(ps, repairslip.Not(repairslip.HasInvoice()))
+		}
+	}
+	if v, ok := in["hasInvoiceWith"]; ok && v != nil {
+		var with []predicate.Invoice
+		for _, v := range wheres(v) {
+			sub, err := s.whereInvoice(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, repairslip.HasInvoiceWith(with...))
+	}
+	if v, ok := in["hasEquipment"].(bool); ok {
+		if v {
+			ps = append(ps, repairslip.HasEquipment())
+		} else {
+			ps = append(ps, repairslip.Not(repairslip.HasEquipment()))
+		}
+	}
+	if v, ok := in["hasEquipmentWith"]; ok && v != nil {
+		var with []predicate.Equipment
+		for _, v := range wheres(v) {
+			sub, err := s.whereEquipment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, repairslip.HasEquipmentWith(with...))
+	}
+	if v, ok := in["hasAttachments"].(bool); ok {
+		if v {
+			ps = append(ps, repairslip.HasAttachments())
+		} else {
+			ps = append(ps, repairslip.Not(repairslip.HasAttachments()))
+		}
+	}
+	if v, ok := in["hasAttachmentsWith"]; ok && v != nil {
+		var with []predicate.Attachment
+		for _, v := range wheres(v) {
+			sub, err := s.whereAttachment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, repairslip.HasAttachmentsWith(with...))
+	}
+	if v, ok := in["hasComments"].(bool); ok {
+		if v {
+			ps = append(ps, repairslip.HasComments())
+		} else {
+			ps = append(ps, repairslip.Not(repairslip.HasComments()))
+		}
+	}
+	if v, ok := in["hasCommentsWith"]; ok && v != nil {
+		var with []predicate.Comment
+		for _, v := range wheres(v) {
+			sub, err := s.whereComment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, repairslip.HasCommentsWith(with...))
+	}
+	if v, ok := in["hasActivities"].(bool); ok {
+		if v {
+			ps = append(ps, repairslip.HasActivities())
+		} else {
+			ps = append(ps, repairslip.Not(repairslip.HasActivities()))
+		}
+	}
+	if v, ok := in["hasActivitiesWith"]; ok && v != nil {
+		var with []predicate.Activity
+		for _, v := range wheres(v) {
+			sub, err := s.whereActivity(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, repairslip.HasActivitiesWith(with...))
+	}
+	return ps, nil
+}
+
+// convertRepairSlipCreateTime returns the create_time of RepairSlip of an argument.
+func convertRepairSlipCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertRepairSlipUpdateTime returns the update_time of RepairSlip of an argument.
+func convertRepairSlipUpdateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: updateTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertRepairSlipSymptom returns the symptom of RepairSlip of an argument.
+func convertRepairSlipSymptom(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertRepairSlipCategory returns the category of RepairSlip of an argument.
+func convertRepairSlipCategory(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertRepairSlipStatus returns the status of RepairSlip of an argument.
+func convertRepairSlipStatus(v interface{}) (repairslip.Status, error) {
+	s, _ := v.(string)
+	return repairslip.Status(s), nil
+}
+
+// convertRepairSlipUnderWarranty returns the under_warranty of RepairSlip of an argument.
+func convertRepairSlipUnderWarranty(v interface{}) (bool, error) {
+	b, _ := v.(bool)
+	return b, nil
+}
+
+// convertRepairSlipRoute returns the route of RepairSlip of an argument.
+func convertRepairSlipRoute(v interface{}) (repairslip.Route, error) {
+	s, _ := v.(string)
+	return repairslip.Route(s), nil
+}
+
+// convertRepairSlipPriority returns the priority of RepairSlip of an argument.
+func convertRepairSlipPriority(v interface{}) (repairslip.Priority, error) {
+	s, _ := v.(string)
+	return repairslip.Priority(s), nil
+}
+
+// convertRepairSlipResponseDue returns the response_due of RepairSlip of an argument.
+func convertRepairSlipResponseDue(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: responseDue: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertRepairSlipResolutionDue returns the resolution_due of RepairSlip of an argument.
+func convertRepairSlipResolutionDue(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: resolutionDue: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertRepairSlipRespondedAt returns the responded_at of RepairSlip of an argument.
+func convertRepairSlipRespondedAt(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: respondedAt: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertRepairSlipResolvedAt returns the resolved_at of RepairSlip of an argument.
+func convertRepairSlipResolvedAt(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: resolvedAt: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertRepairSlipResponseBreached returns the response_breached of RepairSlip of an argument.
+func convertRepairSlipResponseBreached(v interface{}) (bool, error) {
+	b, _ := v.(bool)
+	return b, nil
+}
+
+// convertRepairSlipResolutionBreached returns the resolution_breached of RepairSlip of an argument.
+func convertRepairSlipResolutionBreached(v interface{}) (bool, error) {
+	b, _ := v.(bool)
+	return b, nil
+}
+
+// convertRepairSlipEscalatedAt returns the escalated_at of RepairSlip of an argument.
+func convertRepairSlipEscalatedAt(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: escalatedAt: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// defineSLAPolicy defines the types of SLAPolicy and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineSLAPolicy(query, mutation graphql.Fields, mutable bool) {
+	s.enums["SLAPolicyPriority"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "SLAPolicyPriority",
+		Values: graphql.EnumValueConfigMap{
+			"low":    &graphql.EnumValueConfig{Value: "low"},
+			"normal": &graphql.EnumValueConfig{Value: "normal"},
+			"high":   &graphql.EnumValueConfig{Value: "high"},
+			"urgent": &graphql.EnumValueConfig{Value: "urgent"},
+		},
+	})
+	leaves := map[string]leaf{
+		"priority":           s.enums["SLAPolicyPriority"],
+		"category":           graphql.String,
+		"response_minutes":   graphql.Int,
+		"resolution_minutes": graphql.Int,
+	}
+
+	s.objects["SLAPolicy"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "SLAPolicy",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.SLAPolicy).ID, nil
+					},
+				},
+				"priority": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["priority"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.SLAPolicy).Priority
+						return string(v), nil
+					},
+				},
+				"category": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["category"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.SLAPolicy).Category
+						return v, nil
+					},
+				},
+				"responseMinutes": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["response_minutes"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.SLAPolicy).ResponseMinutes
+						return v, nil
+					},
+				},
+				"resolutionMinutes": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["resolution_minutes"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.SLAPolicy).ResolutionMinutes
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "SLAPolicy.organization", p.Source.(*ent.SLAPolicy).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.SLAPolicy.Query().
+								Where(slapolicy.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["SLAPolicy"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "SLAPolicyWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["SLAPolicy"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                    &graphql.InputObjectFieldConfig{Type: where},
+				"and":                    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"priority":               &graphql.InputObjectFieldConfig{Type: leaves["priority"]},
+				"priorityNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["priority"]},
+				"priorityIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["priority"]))},
+				"priorityNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["priority"]))},
+				"category":               &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["category"]))},
+				"categoryNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["category"]))},
+				"categoryGT":             &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryGTE":            &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryLT":             &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryLTE":            &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryContains":       &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryHasPrefix":      &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryHasSuffix":      &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryEqualFold":      &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"categoryContainsFold":   &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+				"responseMinutes":        &graphql.InputObjectFieldConfig{Type: leaves["response_minutes"]},
+				"responseMinutesNEQ":     &graphql.InputObjectFieldConfig{Type: leaves["response_minutes"]},
+				"responseMinutesIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["response_minutes"]))},
+				"responseMinutesNotIn":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["response_minutes"]))},
+				"responseMinutesGT":      &graphql.InputObjectFieldConfig{Type: leaves["response_minutes"]},
+				"responseMinutesGTE":     &graphql.InputObjectFieldConfig{Type: leaves["response_minutes"]},
+				"responseMinutesLT":      &graphql.InputObjectFieldConfig{Type: leaves["response_minutes"]},
+				"responseMinutesLTE":     &graphql.InputObjectFieldConfig{Type: leaves["response_minutes"]},
+				"resolutionMinutes":      &graphql.InputObjectFieldConfig{Type: leaves["resolution_minutes"]},
+				"resolutionMinutesNEQ":   &graphql.InputObjectFieldConfig{Type: leaves["resolution_minutes"]},
+				"resolutionMinutesIn":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["resolution_minutes"]))},
+				"resolutionMinutesNotIn": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["resolution_minutes"]))},
+				"resolutionMinutesGT":    &graphql.InputObjectFieldConfig{Type: leaves["resolution_minutes"]},
+				"resolutionMinutesGTE":   &graphql.InputObjectFieldConfig{Type: leaves["resolution_minutes"]},
+				"resolutionMinutesLT":    &graphql.InputObjectFieldConfig{Type: leaves["resolution_minutes"]},
+				"resolutionMinutesLTE":   &graphql.InputObjectFieldConfig{Type: leaves["resolution_minutes"]},
+				"hasOrganization":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+			}
+		}),
+	})
+
+	query["slaPolicy"] = &graphql.Field{
+		Type: s.objects["SLAPolicy"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.SLAPolicy.Query().
+				Where(slapolicy.ID(id)).
+				Where(s.scopeSLAPolicy(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["slaPolicies"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("SLAPolicy")),
+		Args: connectionArgs(s.wheres["SLAPolicy"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.SLAPolicy.Query().Where(s.scopeSLAPolicy(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereSLAPolicy(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(slapolicy.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(slapolicy.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(slapolicy.FieldID))
+			} else {
+				q.Order(ent.Asc(slapolicy.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateSLAPolicyInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"priority":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["priority"])},
+			"category":          &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+			"responseMinutes":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["response_minutes"])},
+			"resolutionMinutes": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["resolution_minutes"])},
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateSLAPolicyInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"priority":          &graphql.InputObjectFieldConfig{Type: leaves["priority"]},
+			"category":          &graphql.InputObjectFieldConfig{Type: leaves["category"]},
+			"responseMinutes":   &graphql.InputObjectFieldConfig{Type: leaves["response_minutes"]},
+			"resolutionMinutes": &graphql.InputObjectFieldConfig{Type: leaves["resolution_minutes"]},
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createSLAPolicy"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["SLAPolicy"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createSLAPolicy"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.SLAPolicy.Create()
+			if v, ok := in["priority"]; ok && v != nil {
+				c, err := convertSLAPolicyPriority(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPriority(c)
+			}
+			if v, ok := in["category"]; ok && v != nil {
+				c, err := convertSLAPolicyCategory(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCategory(c)
+			}
+			if v, ok := in["responseMinutes"]; ok && v != nil {
+				c, err := convertSLAPolicyResponseMinutes(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseMinutes(c)
+			}
+			if v, ok := in["resolutionMinutes"]; ok && v != nil {
+				c, err := convertSLAPolicyResolutionMinutes(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResolutionMinutes(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateSLAPolicy"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["SLAPolicy"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateSLAPolicy"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.SLAPolicy.UpdateOneID(id)
+			if v, ok := in["priority"]; ok && v != nil {
+				c, err := convertSLAPolicyPriority(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPriority(c)
+			}
+			if v, ok := in["category"]; ok && v != nil {
+				c, err := convertSLAPolicyCategory(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCategory(c)
+			}
+			if v, ok := in["responseMinutes"]; ok && v != nil {
+				c, err := convertSLAPolicyResponseMinutes(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseMinutes(c)
+			}
+			if v, ok := in["resolutionMinutes"]; ok && v != nil {
+				c, err := convertSLAPolicyResolutionMinutes(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResolutionMinutes(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteSLAPolicy"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteSLAPolicy"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.SLAPolicy.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeSLAPolicy returns the predicates narrowing the SLAPolicy entities
+// that can be read.
+func (s *Schema) scopeSLAPolicy(ctx context.Context) []predicate.SLAPolicy {
+	if s.opts.Scopes.SLAPolicy == nil {
+		return nil
+	}
+	return s.opts.Scopes.SLAPolicy(ctx)
+}
+
+// whereSLAPolicy returns the predicates of a SLAPolicyWhereInput.
+func (s *Schema) whereSLAPolicy(in map[string]interface{}) ([]predicate.SLAPolicy, error) {
+	var ps []predicate.SLAPolicy
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereSLAPolicy(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, slapolicy.Not(slapolicy.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.SLAPolicy
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereSLAPolicy(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, slapolicy.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, slapolicy.And(group...))
+		default:
+			ps = append(ps, slapolicy.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.IDLTE(ids))
+	}
+	if v, ok := in["priority"]; ok && v != nil {
+		c, err := convertSLAPolicyPriority(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.PriorityEQ(c))
+	}
+	if v, ok := in["priorityNEQ"]; ok && v != nil {
+		c, err := convertSLAPolicyPriority(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.PriorityNEQ(c))
+	}
+	if v, ok := in["priorityIn"].([]interface{}); ok {
+		vs := make([]slapolicy.Priority, len(v))
+		for i := range v {
+			c, err := convertSLAPolicyPriority(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, slapolicy.PriorityIn(vs...))
+	}
+	if v, ok := in["priorityNotIn"].([]interface{}); ok {
+		vs := make([]slapolicy.Priority, len(v))
+		for i := range v {
+			c, err := convertSLAPolicyPriority(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, slapolicy.PriorityNotIn(vs...))
+	}
+	if v, ok := in["category"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryEQ(c))
+	}
+	if v, ok := in["categoryNEQ"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryNEQ(c))
+	}
+	if v, ok := in["categoryIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertSLAPolicyCategory(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, slapolicy.CategoryIn(vs...))
+	}
+	if v, ok := in["categoryNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertSLAPolicyCategory(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, slapolicy.CategoryNotIn(vs...))
+	}
+	if v, ok := in["categoryGT"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryGT(c))
+	}
+	if v, ok := in["categoryGTE"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryGTE(c))
+	}
+	if v, ok := in["categoryLT"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryLT(c))
+	}
+	if v, ok := in["categoryLTE"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryLTE(c))
+	}
+	if v, ok := in["categoryContains"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryContains(c))
+	}
+	if v, ok := in["categoryHasPrefix"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryHasPrefix(c))
+	}
+	if v, ok := in["categoryHasSuffix"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryHasSuffix(c))
+	}
+	if v, ok := in["categoryEqualFold"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryEqualFold(c))
+	}
+	if v, ok := in["categoryContainsFold"]; ok && v != nil {
+		c, err := convertSLAPolicyCategory(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.CategoryContainsFold(c))
+	}
+	if v, ok := in["responseMinutes"]; ok && v != nil {
+		c, err := convertSLAPolicyResponseMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResponseMinutesEQ(c))
+	}
+	if v, ok := in["responseMinutesNEQ"]; ok && v != nil {
+		c, err := convertSLAPolicyResponseMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResponseMinutesNEQ(c))
+	}
+	if v, ok := in["responseMinutesIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertSLAPolicyResponseMinutes(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, slapolicy.ResponseMinutesIn(vs...))
+	}
+	if v, ok := in["responseMinutesNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertSLAPolicyResponseMinutes(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, slapolicy.ResponseMinutesNotIn(vs...))
+	}
+	if v, ok := in["responseMinutesGT"]; ok && v != nil {
+		c, err := convertSLAPolicyResponseMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResponseMinutesGT(c))
+	}
+	if v, ok := in["responseMinutesGTE"]; ok && v != nil {
+		c, err := convertSLAPolicyResponseMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResponseMinutesGTE(c))
+	}
+	if v, ok := in["responseMinutesLT"]; ok && v != nil {
+		c, err := convertSLAPolicyResponseMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResponseMinutesLT(c))
+	}
+	if v, ok := in["responseMinutesLTE"]; ok && v != nil {
+		c, err := convertSLAPolicyResponseMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResponseMinutesLTE(c))
+	}
+	if v, ok := in["resolutionMinutes"]; ok && v != nil {
+		c, err := convertSLAPolicyResolutionMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResolutionMinutesEQ(c))
+	}
+	if v, ok := in["resolutionMinutesNEQ"]; ok && v != nil {
+		c, err := convertSLAPolicyResolutionMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResolutionMinutesNEQ(c))
+	}
+	if v, ok := in["resolutionMinutesIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertSLAPolicyResolutionMinutes(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, slapolicy.ResolutionMinutesIn(vs...))
+	}
+	if v, ok := in["resolutionMinutesNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertSLAPolicyResolutionMinutes(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, slapolicy.ResolutionMinutesNotIn(vs...))
+	}
+	if v, ok := in["resolutionMinutesGT"]; ok && v != nil {
+		c, err := convertSLAPolicyResolutionMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResolutionMinutesGT(c))
+	}
+	if v, ok := in["resolutionMinutesGTE"]; ok && v != nil {
+		c, err := convertSLAPolicyResolutionMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResolutionMinutesGTE(c))
+	}
+	if v, ok := in["resolutionMinutesLT"]; ok && v != nil {
+		c, err := convertSLAPolicyResolutionMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResolutionMinutesLT(c))
+	}
+	if v, ok := in["resolutionMinutesLTE"]; ok && v != nil {
+		c, err := convertSLAPolicyResolutionMinutes(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, slapolicy.ResolutionMinutesLTE(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, slapolicy.HasOrganization())
+		} else {
+			ps = append(ps, slapolicy.Not(slapolicy.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, slapolicy.HasOrganizationWith(with...))
+	}
+	return ps, nil
+}
+
+// convertSLAPolicyPriority returns the priority of SLAPolicy of an argument.
+func convertSLAPolicyPriority(v interface{}) (slapolicy.Priority, error) {
+	s, _ := v.(string)
+	return slapolicy.Priority(s), nil
+}
+
+// convertSLAPolicyCategory returns the category of SLAPolicy of an argument.
+func convertSLAPolicyCategory(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertSLAPolicyResponseMinutes returns the response_minutes of SLAPolicy of an argument.
+func convertSLAPolicyResponseMinutes(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// convertSLAPolicyResolutionMinutes returns the resolution_minutes of SLAPolicy of an argument.
+func convertSLAPolicyResolutionMinutes(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// defineStockLevel defines the types of StockLevel and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineStockLevel(query, mutation graphql.Fields, mutable bool) {
+	leaves := map[string]leaf{
+		"location": graphql.String,
+		"on_hand":  graphql.Int,
+	}
+
+	s.objects["StockLevel"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "StockLevel",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.StockLevel).ID, nil
+					},
+				},
+				"location": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["location"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.StockLevel).Location
+						return v, nil
+					},
+				},
+				"onHand": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["on_hand"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.StockLevel).OnHand
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "StockLevel.organization", p.Source.(*ent.StockLevel).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.StockLevel.Query().
+								Where(stocklevel.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"part": &graphql.Field{
+					Type: s.objects["Part"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "StockLevel.part", p.Source.(*ent.StockLevel).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.StockLevel.Query().
+								Where(stocklevel.IDIn(ids...)).
+								WithPart(func(q *ent.PartQuery) {
+									q.Where(s.scopePart(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Part != nil {
+									values[n.ID] = n.Edges.Part
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["StockLevel"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "StockLevelWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["StockLevel"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                  &graphql.InputObjectFieldConfig{Type: where},
+				"and":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"location":             &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["location"]))},
+				"locationNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["location"]))},
+				"locationGT":           &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationGTE":          &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationLT":           &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationLTE":          &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationContains":     &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"onHand":               &graphql.InputObjectFieldConfig{Type: leaves["on_hand"]},
+				"onHandNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["on_hand"]},
+				"onHandIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["on_hand"]))},
+				"onHandNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["on_hand"]))},
+				"onHandGT":             &graphql.InputObjectFieldConfig{Type: leaves["on_hand"]},
+				"onHandGTE":            &graphql.InputObjectFieldConfig{Type: leaves["on_hand"]},
+				"onHandLT":             &graphql.InputObjectFieldConfig{Type: leaves["on_hand"]},
+				"onHandLTE":            &graphql.InputObjectFieldConfig{Type: leaves["on_hand"]},
+				"hasOrganization":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasPart":              &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasPartWith":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Part"]))},
+			}
+		}),
+	})
+
+	query["stockLevel"] = &graphql.Field{
+		Type: s.objects["StockLevel"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.StockLevel.Query().
+				Where(stocklevel.ID(id)).
+				Where(s.scopeStockLevel(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["stockLevels"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("StockLevel")),
+		Args: connectionArgs(s.wheres["StockLevel"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.StockLevel.Query().Where(s.scopeStockLevel(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereStockLevel(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(stocklevel.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(stocklevel.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(stocklevel.FieldID))
+			} else {
+				q.Order(ent.Asc(stocklevel.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateStockLevelInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"location":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["location"])},
+			"onHand":         &graphql.InputObjectFieldConfig{Type: leaves["on_hand"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"partID":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateStockLevelInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"location":          &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+			"onHand":            &graphql.InputObjectFieldConfig{Type: leaves["on_hand"]},
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"partID":            &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearPart":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createStockLevel"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["StockLevel"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createStockLevel"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.StockLevel.Create()
+			if v, ok := in["location"]; ok && v != nil {
+				c, err := convertStockLevelLocation(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLocation(c)
+			}
+			if v, ok := in["onHand"]; ok && v != nil {
+				c, err := convertStockLevelOnHand(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOnHand(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["partID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPartID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateStockLevel"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["StockLevel"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateStockLevel"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.StockLevel.UpdateOneID(id)
+			if v, ok := in["location"]; ok && v != nil {
+				c, err := convertStockLevelLocation(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLocation(c)
+			}
+			if v, ok := in["onHand"]; ok && v != nil {
+				c, err := convertStockLevelOnHand(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOnHand(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["partID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPartID(id)
+			}
+			if v, ok := in["clearPart"]; ok && v == true {
+				b.ClearPart()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteStockLevel"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteStockLevel"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.StockLevel.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeStockLevel returns the predicates narrowing the StockLevel entities
+// that can be read.
+func (s *Schema) scopeStockLevel(ctx context.Context) []predicate.StockLevel {
+	if s.opts.Scopes.StockLevel == nil {
+		return nil
+	}
+	return s.opts.Scopes.StockLevel(ctx)
+}
+
+// whereStockLevel returns the predicates of a StockLevelWhereInput.
+func (s *Schema) whereStockLevel(in map[string]interface{}) ([]predicate.StockLevel, error) {
+	var ps []predicate.StockLevel
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereStockLevel(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, stocklevel.Not(stocklevel.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.StockLevel
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereStockLevel(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, stocklevel.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, stocklevel.And(group...))
+		default:
+			ps = append(ps, stocklevel.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.IDLTE(ids))
+	}
+	if v, ok := in["location"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationEQ(c))
+	}
+	if v, ok := in["locationNEQ"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationNEQ(c))
+	}
+	if v, ok := in["locationIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertStockLevelLocation(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stocklevel.LocationIn(vs...))
+	}
+	if v, ok := in["locationNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertStockLevelLocation(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stocklevel.LocationNotIn(vs...))
+	}
+	if v, ok := in["locationGT"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationGT(c))
+	}
+	if v, ok := in["locationGTE"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationGTE(c))
+	}
+	if v, ok := in["locationLT"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationLT(c))
+	}
+	if v, ok := in["locationLTE"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationLTE(c))
+	}
+	if v, ok := in["locationContains"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationContains(c))
+	}
+	if v, ok := in["locationHasPrefix"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationHasPrefix(c))
+	}
+	if v, ok := in["locationHasSuffix"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationHasSuffix(c))
+	}
+	if v, ok := in["locationEqualFold"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationEqualFold(c))
+	}
+	if v, ok := in["locationContainsFold"]; ok && v != nil {
+		c, err := convertStockLevelLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.LocationContainsFold(c))
+	}
+	if v, ok := in["onHand"]; ok && v != nil {
+		c, err := convertStockLevelOnHand(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.OnHandEQ(c))
+	}
+	if v, ok := in["onHandNEQ"]; ok && v != nil {
+		c, err := convertStockLevelOnHand(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.OnHandNEQ(c))
+	}
+	if v, ok := in["onHandIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertStockLevelOnHand(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stocklevel.OnHandIn(vs...))
+	}
+	if v, ok := in["onHandNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertStockLevelOnHand(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stocklevel.OnHandNotIn(vs...))
+	}
+	if v, ok := in["onHandGT"]; ok && v != nil {
+		c, err := convertStockLevelOnHand(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.OnHandGT(c))
+	}
+	if v, ok := in["onHandGTE"]; ok && v != nil {
+		c, err := convertStockLevelOnHand(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.OnHandGTE(c))
+	}
+	if v, ok := in["onHandLT"]; ok && v != nil {
+		c, err := convertStockLevelOnHand(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.OnHandLT(c))
+	}
+	if v, ok := in["onHandLTE"]; ok && v != nil {
+		c, err := convertStockLevelOnHand(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stocklevel.OnHandLTE(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, stocklevel.HasOrganization())
+		} else {
+			ps = append(ps, stocklevel.Not(stocklevel.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, stocklevel.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasPart"].(bool); ok {
+		if v {
+			ps = append(ps, stocklevel.HasPart())
+		} else {
+			ps = append(ps, stocklevel.Not(stocklevel.HasPart()))
+		}
+	}
+	if v, ok := in["hasPartWith"]; ok && v != nil {
+		var with []predicate.Part
+		for _, v := range wheres(v) {
+			sub, err := s.wherePart(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, stocklevel.HasPartWith(with...))
+	}
+	return ps, nil
+}
+
+// convertStockLevelLocation returns the location of StockLevel of an argument.
+func convertStockLevelLocation(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertStockLevelOnHand returns the on_hand of StockLevel of an argument.
+func convertStockLevelOnHand(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// defineStockMovement defines the types of StockMovement and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineStockMovement(query, mutation graphql.Fields, mutable bool) {
+	s.enums["StockMovementKind"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "StockMovementKind",
+		Values: graphql.EnumValueConfigMap{
+			"receipt":     &graphql.EnumValueConfig{Value: "receipt"},
+			"consumption": &graphql.EnumValueConfig{Value: "consumption"},
+			"adjustment":  &graphql.EnumValueConfig{Value: "adjustment"},
+		},
+	})
+	s.enums["StockMovementReasonCode"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "StockMovementReasonCode",
+		Values: graphql.EnumValueConfigMap{
+			"count_correction":   &graphql.EnumValueConfig{Value: "count_correction"},
+			"damaged":            &graphql.EnumValueConfig{Value: "damaged"},
+			"lost":               &graphql.EnumValueConfig{Value: "lost"},
+			"found":              &graphql.EnumValueConfig{Value: "found"},
+			"returned_to_vendor": &graphql.EnumValueConfig{Value: "returned_to_vendor"},
+			"other":              &graphql.EnumValueConfig{Value: "other"},
+		},
+	})
+	leaves := map[string]leaf{
+		"create_time": graphql.DateTime,
+		"kind":        s.enums["StockMovementKind"],
+		"quantity":    graphql.Int,
+		"location":    graphql.String,
+		"reason_code": s.enums["StockMovementReasonCode"],
+		"note":        graphql.String,
+	}
+
+	s.objects["StockMovement"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "StockMovement",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.StockMovement).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.StockMovement).CreateTime
+						return v, nil
+					},
+				},
+				"kind": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["kind"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.StockMovement).Kind
+						return string(v), nil
+					},
+				},
+				"quantity": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["quantity"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.StockMovement).Quantity
+						return v, nil
+					},
+				},
+				"location": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["location"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.StockMovement).Location
+						return v, nil
+					},
+				},
+				"reasonCode": &graphql.Field{
+					Type: leaves["reason_code"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.StockMovement).ReasonCode
+						return string(v), nil
+					},
+				},
+				"note": &graphql.Field{
+					Type: leaves["note"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.StockMovement).Note
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "StockMovement.organization", p.Source.(*ent.StockMovement).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.StockMovement.Query().
+								Where(stockmovement.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"part": &graphql.Field{
+					Type: s.objects["Part"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "StockMovement.part", p.Source.(*ent.StockMovement).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.StockMovement.Query().
+								Where(stockmovement.IDIn(ids...)).
+								WithPart(func(q *ent.PartQuery) {
+									q.Where(s.scopePart(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Part != nil {
+									values[n.ID] = n.Edges.Part
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"repairSlip": &graphql.Field{
+					Type: s.objects["RepairSlip"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "StockMovement.repair_slip", p.Source.(*ent.StockMovement).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.StockMovement.Query().
+								Where(stockmovement.IDIn(ids...)).
+								WithRepairSlip(func(q *ent.RepairSlipQuery) {
+									q.Where(s.scopeRepairSlip(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.RepairSlip != nil {
+									values[n.ID] = n.Edges.RepairSlip
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["StockMovement"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "StockMovementWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["StockMovement"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                  &graphql.InputObjectFieldConfig{Type: where},
+				"and":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                 &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":           &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":         &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"kind":                 &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["kind"]},
+				"kindIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"kindNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["kind"]))},
+				"quantity":             &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["quantity"]))},
+				"quantityNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["quantity"]))},
+				"quantityGT":           &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityGTE":          &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityLT":           &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"quantityLTE":          &graphql.InputObjectFieldConfig{Type: leaves["quantity"]},
+				"location":             &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["location"]))},
+				"locationNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["location"]))},
+				"locationGT":           &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationGTE":          &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationLT":           &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationLTE":          &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationContains":     &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"locationContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["location"]},
+				"reasonCode":           &graphql.InputObjectFieldConfig{Type: leaves["reason_code"]},
+				"reasonCodeNEQ":        &graphql.InputObjectFieldConfig{Type: leaves["reason_code"]},
+				"reasonCodeIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["reason_code"]))},
+				"reasonCodeNotIn":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["reason_code"]))},
+				"reasonCodeIsNil":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"reasonCodeNotNil":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"note":                 &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["note"]))},
+				"noteNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["note"]))},
+				"noteGT":               &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteGTE":              &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteLT":               &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteLTE":              &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteContains":         &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteHasPrefix":        &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteHasSuffix":        &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteIsNil":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"noteNotNil":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"noteEqualFold":        &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"noteContainsFold":     &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+				"hasOrganization":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasPart":              &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasPartWith":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Part"]))},
+				"hasRepairSlip":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasRepairSlipWith":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["RepairSlip"]))},
+			}
+		}),
+	})
+
+	query["stockMovement"] = &graphql.Field{
+		Type: s.objects["StockMovement"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.StockMovement.Query().
+				Where(stockmovement.ID(id)).
+				Where(s.scopeStockMovement(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["stockMovements"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("StockMovement")),
+		Args: connectionArgs(s.wheres["StockMovement"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.StockMovement.Query().Where(s.scopeStockMovement(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereStockMovement(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(stockmovement.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(stockmovement.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(stockmovement.FieldID))
+			} else {
+				q.Order(ent.Asc(stockmovement.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateStockMovementInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"kind":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["kind"])},
+			"quantity":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["quantity"])},
+			"location":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["location"])},
+			"reasonCode":     &graphql.InputObjectFieldConfig{Type: leaves["reason_code"]},
+			"note":           &graphql.InputObjectFieldConfig{Type: leaves["note"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"partID":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"repairSlipID":   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateStockMovementInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"partID":            &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearPart":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"repairSlipID":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearRepairSlip":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createStockMovement"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["StockMovement"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createStockMovement"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.StockMovement.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertStockMovementCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["kind"]; ok && v != nil {
+				c, err := convertStockMovementKind(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetKind(c)
+			}
+			if v, ok := in["quantity"]; ok && v != nil {
+				c, err := convertStockMovementQuantity(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetQuantity(c)
+			}
+			if v, ok := in["location"]; ok && v != nil {
+				c, err := convertStockMovementLocation(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLocation(c)
+			}
+			if v, ok := in["reasonCode"]; ok && v != nil {
+				c, err := convertStockMovementReasonCode(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetReasonCode(c)
+			}
+			if v, ok := in["note"]; ok && v != nil {
+				c, err := convertStockMovementNote(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetNote(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["partID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPartID(id)
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateStockMovement"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["StockMovement"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateStockMovement"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.StockMovement.UpdateOneID(id)
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["partID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPartID(id)
+			}
+			if v, ok := in["clearPart"]; ok && v == true {
+				b.ClearPart()
+			}
+			if v, ok := in["repairSlipID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRepairSlipID(id)
+			}
+			if v, ok := in["clearRepairSlip"]; ok && v == true {
+				b.ClearRepairSlip()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteStockMovement"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteStockMovement"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.StockMovement.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeStockMovement returns the predicates narrowing the StockMovement entities
+// that can be read.
+func (s *Schema) scopeStockMovement(ctx context.Context) []predicate.StockMovement {
+	if s.opts.Scopes.StockMovement == nil {
+		return nil
+	}
+	return s.opts.Scopes.StockMovement(ctx)
+}
+
+// whereStockMovement returns the predicates of a StockMovementWhereInput.
+func (s *Schema) whereStockMovement(in map[string]interface{}) ([]predicate.StockMovement, error) {
+	var ps []predicate.StockMovement
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereStockMovement(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, stockmovement.Not(stockmovement.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.StockMovement
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereStockMovement(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, stockmovement.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, stockmovement.And(group...))
+		default:
+			ps = append(ps, stockmovement.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertStockMovementCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertStockMovementCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertStockMovementCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertStockMovementCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertStockMovementCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertStockMovementCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertStockMovementCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertStockMovementCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.CreateTimeLTE(c))
+	}
+	if v, ok := in["kind"]; ok && v != nil {
+		c, err := convertStockMovementKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.KindEQ(c))
+	}
+	if v, ok := in["kindNEQ"]; ok && v != nil {
+		c, err := convertStockMovementKind(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.KindNEQ(c))
+	}
+	if v, ok := in["kindIn"].([]interface{}); ok {
+		vs := make([]stockmovement.Kind, len(v))
+		for i := range v {
+			c, err := convertStockMovementKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.KindIn(vs...))
+	}
+	if v, ok := in["kindNotIn"].([]interface{}); ok {
+		vs := make([]stockmovement.Kind, len(v))
+		for i := range v {
+			c, err := convertStockMovementKind(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.KindNotIn(vs...))
+	}
+	if v, ok := in["quantity"]; ok && v != nil {
+		c, err := convertStockMovementQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.QuantityEQ(c))
+	}
+	if v, ok := in["quantityNEQ"]; ok && v != nil {
+		c, err := convertStockMovementQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.QuantityNEQ(c))
+	}
+	if v, ok := in["quantityIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertStockMovementQuantity(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.QuantityIn(vs...))
+	}
+	if v, ok := in["quantityNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertStockMovementQuantity(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.QuantityNotIn(vs...))
+	}
+	if v, ok := in["quantityGT"]; ok && v != nil {
+		c, err := convertStockMovementQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.QuantityGT(c))
+	}
+	if v, ok := in["quantityGTE"]; ok && v != nil {
+		c, err := convertStockMovementQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.QuantityGTE(c))
+	}
+	if v, ok := in["quantityLT"]; ok && v != nil {
+		c, err := convertStockMovementQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.QuantityLT(c))
+	}
+	if v, ok := in["quantityLTE"]; ok && v != nil {
+		c, err := convertStockMovementQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.QuantityLTE(c))
+	}
+	if v, ok := in["location"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationEQ(c))
+	}
+	if v, ok := in["locationNEQ"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationNEQ(c))
+	}
+	if v, ok := in["locationIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertStockMovementLocation(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.LocationIn(vs...))
+	}
+	if v, ok := in["locationNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertStockMovementLocation(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.LocationNotIn(vs...))
+	}
+	if v, ok := in["locationGT"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationGT(c))
+	}
+	if v, ok := in["locationGTE"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationGTE(c))
+	}
+	if v, ok := in["locationLT"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationLT(c))
+	}
+	if v, ok := in["locationLTE"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationLTE(c))
+	}
+	if v, ok := in["locationContains"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationContains(c))
+	}
+	if v, ok := in["locationHasPrefix"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationHasPrefix(c))
+	}
+	if v, ok := in["locationHasSuffix"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationHasSuffix(c))
+	}
+	if v, ok := in["locationEqualFold"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationEqualFold(c))
+	}
+	if v, ok := in["locationContainsFold"]; ok && v != nil {
+		c, err := convertStockMovementLocation(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.LocationContainsFold(c))
+	}
+	if v, ok := in["reasonCode"]; ok && v != nil {
+		c, err := convertStockMovementReasonCode(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.ReasonCodeEQ(c))
+	}
+	if v, ok := in["reasonCodeNEQ"]; ok && v != nil {
+		c, err := convertStockMovementReasonCode(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.ReasonCodeNEQ(c))
+	}
+	if v, ok := in["reasonCodeIn"].([]interface{}); ok {
+		vs := make([]stockmovement.ReasonCode, len(v))
+		for i := range v {
+			c, err := convertStockMovementReasonCode(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.ReasonCodeIn(vs...))
+	}
+	if v, ok := in["reasonCodeNotIn"].([]interface{}); ok {
+		vs := make([]stockmovement.ReasonCode, len(v))
+		for i := range v {
+			c, err := convertStockMovementReasonCode(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.ReasonCodeNotIn(vs...))
+	}
+	if v, ok := in["reasonCodeIsNil"]; ok && v == true {
+		ps = append(ps, stockmovement.ReasonCodeIsNil())
+	}
+	if v, ok := in["reasonCodeNotNil"]; ok && v == true {
+		ps = append(ps, stockmovement.ReasonCodeNotNil())
+	}
+	if v, ok := in["note"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteEQ(c))
+	}
+	if v, ok := in["noteNEQ"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteNEQ(c))
+	}
+	if v, ok := in["noteIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertStockMovementNote(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.NoteIn(vs...))
+	}
+	if v, ok := in["noteNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertStockMovementNote(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, stockmovement.NoteNotIn(vs...))
+	}
+	if v, ok := in["noteGT"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteGT(c))
+	}
+	if v, ok := in["noteGTE"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteGTE(c))
+	}
+	if v, ok := in["noteLT"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteLT(c))
+	}
+	if v, ok := in["noteLTE"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteLTE(c))
+	}
+	if v, ok := in["noteContains"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteContains(c))
+	}
+	if v, ok := in["noteHasPrefix"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteHasPrefix(c))
+	}
+	if v, ok := in["noteHasSuffix"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteHasSuffix(c))
+	}
+	if v, ok := in["noteIsNil"]; ok && v == true {
+		ps = append(ps, stockmovement.NoteIsNil())
+	}
+	if v, ok := in["noteNotNil"]; ok && v == true {
+		ps = append(ps, stockmovement.NoteNotNil())
+	}
+	if v, ok := in["noteEqualFold"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteEqualFold(c))
+	}
+	if v, ok := in["noteContainsFold"]; ok && v != nil {
+		c, err := convertStockMovementNote(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, stockmovement.NoteContainsFold(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, stockmovement.HasOrganization())
+		} else {
+			ps = append(ps, stockmovement.Not(stockmovement.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, stockmovement.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasPart"].(bool); ok {
+		if v {
+			ps = append(ps, stockmovement.HasPart())
+		} else {
+			ps = append(ps, stockmovement.Not(stockmovement.HasPart()))
+		}
+	}
+	if v, ok := in["hasPartWith"]; ok && v != nil {
+		var with []predicate.Part
+		for _, v := range wheres(v) {
+			sub, err := s.wherePart(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, stockmovement.HasPartWith(with...))
+	}
+	if v, ok := in["hasRepairSlip"].(bool); ok {
+		if v {
+			ps = append(ps, stockmovement.HasRepairSlip())
+		} else {
+			ps = append(ps, stockmovement.Not(stockmovement.HasRepairSlip()))
+		}
+	}
+	if v, ok := in["hasRepairSlipWith"]; ok && v != nil {
+		var with []predicate.RepairSlip
+		for _, v := range wheres(v) {
+			sub, err := s.whereRepairSlip(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, stockmovement.HasRepairSlipWith(with...))
+	}
+	return ps, nil
+}
+
+// convertStockMovementCreateTime returns the create_time of StockMovement of an argument.
+func convertStockMovementCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertStockMovementKind returns the kind of StockMovement of an argument.
+func convertStockMovementKind(v interface{}) (stockmovement.Kind, error) {
+	s, _ := v.(string)
+	return stockmovement.Kind(s), nil
+}
+
+// convertStockMovementQuantity returns the quantity of StockMovement of an argument.
+func convertStockMovementQuantity(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// convertStockMovementLocation returns the location of StockMovement of an argument.
+func convertStockMovementLocation(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertStockMovementReasonCode returns the reason_code of StockMovement of an argument.
+func convertStockMovementReasonCode(v interface{}) (stockmovement.ReasonCode, error) {
+	s, _ := v.(string)
+	return stockmovement.ReasonCode(s), nil
+}
+
+// convertStockMovementNote returns the note of StockMovement of an argument.
+func convertStockMovementNote(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// defineUser defines the types of User and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineUser(query, mutation graphql.Fields, mutable bool) {
+	s.enums["UserRole"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "UserRole",
+		Values: graphql.EnumValueConfigMap{
+			"staff":      &graphql.EnumValueConfig{Value: "staff"},
+			"technician": &graphql.EnumValueConfig{Value: "technician"},
+			"supervisor": &graphql.EnumValueConfig{Value: "supervisor"},
+			"admin":      &graphql.EnumValueConfig{Value: "admin"},
+		},
+	})
+	s.enums["UserLocale"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "UserLocale",
+		Values: graphql.EnumValueConfigMap{
+			"th": &graphql.EnumValueConfig{Value: "th"},
+			"en": &graphql.EnumValueConfig{Value: "en"},
+		},
+	})
+	leaves := map[string]leaf{
+		"age":        graphql.Int,
+		"name":       graphql.String,
+		"role":       s.enums["UserRole"],
+		"skill":      graphql.String,
+		"department": graphql.String,
+		"email":      graphql.String,
+		"locale":     s.enums["UserLocale"],
+		"disabled":   graphql.Boolean,
+	}
+
+	s.objects["User"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.User).ID, nil
+					},
+				},
+				"age": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["age"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.User).Age
+						return v, nil
+					},
+				},
+				"name": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["name"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.User).Name
+						return v, nil
+					},
+				},
+				"role": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["role"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.User).Role
+						return string(v), nil
+					},
+				},
+				"skill": &graphql.Field{
+					Type: leaves["skill"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.User).Skill
+						return v, nil
+					},
+				},
+				"department": &graphql.Field{
+					Type: leaves["department"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.User).Department
+						return v, nil
+					},
+				},
+				"email": &graphql.Field{
+					Type: leaves["email"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.User).Email
+						return v, nil
+					},
+				},
+				"locale": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["locale"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.User).Locale
+						return string(v), nil
+					},
+				},
+				"disabled": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["disabled"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.User).Disabled
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "User.organization", p.Source.(*ent.User).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.User.Query().
+								Where(user.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"reportedSlips": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["RepairSlip"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "User.reported_slips", p.Source.(*ent.User).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.User.Query().
+								Where(user.IDIn(ids...)).
+								WithReportedSlips(func(q *ent.RepairSlipQuery) {
+									q.Where(s.scopeRepairSlip(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.ReportedSlips
+								if edges == nil {
+									edges = []*ent.RepairSlip{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"assignedSlips": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["RepairSlip"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "User.assigned_slips", p.Source.(*ent.User).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.User.Query().
+								Where(user.IDIn(ids...)).
+								WithAssignedSlips(func(q *ent.RepairSlipQuery) {
+									q.Where(s.scopeRepairSlip(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.AssignedSlips
+								if edges == nil {
+									edges = []*ent.RepairSlip{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"invoices": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Invoice"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "User.invoices", p.Source.(*ent.User).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.User.Query().
+								Where(user.IDIn(ids...)).
+								WithInvoices(func(q *ent.InvoiceQuery) {
+									q.Where(s.scopeInvoice(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Invoices
+								if edges == nil {
+									edges = []*ent.Invoice{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"attachments": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Attachment"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "User.attachments", p.Source.(*ent.User).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.User.Query().
+								Where(user.IDIn(ids...)).
+								WithAttachments(func(q *ent.AttachmentQuery) {
+									q.Where(s.scopeAttachment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Attachments
+								if edges == nil {
+									edges = []*ent.Attachment{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"mentionedIn": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Comment"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "User.mentioned_in", p.Source.(*ent.User).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.User.Query().
+								Where(user.IDIn(ids...)).
+								WithMentionedIn(func(q *ent.CommentQuery) {
+									q.Where(s.scopeComment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.MentionedIn
+								if edges == nil {
+									edges = []*ent.Comment{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["User"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UserWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["User"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                    &graphql.InputObjectFieldConfig{Type: where},
+				"and":                    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                   &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"age":                    &graphql.InputObjectFieldConfig{Type: leaves["age"]},
+				"ageNEQ":                 &graphql.InputObjectFieldConfig{Type: leaves["age"]},
+				"ageIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["age"]))},
+				"ageNotIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["age"]))},
+				"ageGT":                  &graphql.InputObjectFieldConfig{Type: leaves["age"]},
+				"ageGTE":                 &graphql.InputObjectFieldConfig{Type: leaves["age"]},
+				"ageLT":                  &graphql.InputObjectFieldConfig{Type: leaves["age"]},
+				"ageLTE":                 &graphql.InputObjectFieldConfig{Type: leaves["age"]},
+				"name":                   &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameNEQ":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameNotIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameGT":                 &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameGTE":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLT":                 &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLTE":                &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContains":           &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasPrefix":          &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasSuffix":          &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameEqualFold":          &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContainsFold":       &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"role":                   &graphql.InputObjectFieldConfig{Type: leaves["role"]},
+				"roleNEQ":                &graphql.InputObjectFieldConfig{Type: leaves["role"]},
+				"roleIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["role"]))},
+				"roleNotIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["role"]))},
+				"skill":                  &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillNEQ":               &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["skill"]))},
+				"skillNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["skill"]))},
+				"skillGT":                &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillGTE":               &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillLT":                &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillLTE":               &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillContains":          &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillHasPrefix":         &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillHasSuffix":         &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillIsNil":             &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"skillNotNil":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"skillEqualFold":         &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"skillContainsFold":      &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+				"department":             &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["department"]))},
+				"departmentNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["department"]))},
+				"departmentGT":           &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentGTE":          &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentLT":           &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentLTE":          &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentContains":     &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentIsNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"departmentNotNil":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"departmentEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"departmentContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+				"email":                  &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailNEQ":               &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["email"]))},
+				"emailNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["email"]))},
+				"emailGT":                &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailGTE":               &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailLT":                &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailLTE":               &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailContains":          &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailHasPrefix":         &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailHasSuffix":         &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailIsNil":             &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"emailNotNil":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"emailEqualFold":         &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"emailContainsFold":      &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+				"locale":                 &graphql.InputObjectFieldConfig{Type: leaves["locale"]},
+				"localeNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["locale"]},
+				"localeIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["locale"]))},
+				"localeNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["locale"]))},
+				"disabled":               &graphql.InputObjectFieldConfig{Type: leaves["disabled"]},
+				"disabledNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["disabled"]},
+				"hasOrganization":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasReportedSlips":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasReportedSlipsWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["RepairSlip"]))},
+				"hasAssignedSlips":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasAssignedSlipsWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["RepairSlip"]))},
+				"hasInvoices":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasInvoicesWith":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Invoice"]))},
+				"hasAttachments":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasAttachmentsWith":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Attachment"]))},
+				"hasMentionedIn":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasMentionedInWith":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Comment"]))},
+			}
+		}),
+	})
+
+	query["user"] = &graphql.Field{
+		Type: s.objects["User"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.User.Query().
+				Where(user.ID(id)).
+				Where(s.scopeUser(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["users"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("User")),
+		Args: connectionArgs(s.wheres["User"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.User.Query().Where(s.scopeUser(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereUser(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(user.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(user.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(user.FieldID))
+			} else {
+				q.Order(ent.Asc(user.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateUserInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"age":             &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["age"])},
+			"name":            &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["name"])},
+			"role":            &graphql.InputObjectFieldConfig{Type: leaves["role"]},
+			"skill":           &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+			"department":      &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+			"email":           &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+			"locale":          &graphql.InputObjectFieldConfig{Type: leaves["locale"]},
+			"disabled":        &graphql.InputObjectFieldConfig{Type: leaves["disabled"]},
+			"organizationID":  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"reportedSlipIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"assignedSlipIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"invoiceIDs":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"attachmentIDs":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"mentionedInIDs":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateUserInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"age":                   &graphql.InputObjectFieldConfig{Type: leaves["age"]},
+			"name":                  &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+			"role":                  &graphql.InputObjectFieldConfig{Type: leaves["role"]},
+			"skill":                 &graphql.InputObjectFieldConfig{Type: leaves["skill"]},
+			"clearSkill":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"department":            &graphql.InputObjectFieldConfig{Type: leaves["department"]},
+			"clearDepartment":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"email":                 &graphql.InputObjectFieldConfig{Type: leaves["email"]},
+			"clearEmail":            &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"locale":                &graphql.InputObjectFieldConfig{Type: leaves["locale"]},
+			"disabled":              &graphql.InputObjectFieldConfig{Type: leaves["disabled"]},
+			"organizationID":        &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addReportedSlipIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeReportedSlipIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addAssignedSlipIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeAssignedSlipIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addInvoiceIDs":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeInvoiceIDs":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addAttachmentIDs":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeAttachmentIDs":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"addMentionedInIDs":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeMentionedInIDs":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	mutation["createUser"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["User"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createUser"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.User.Create()
+			if v, ok := in["age"]; ok && v != nil {
+				c, err := convertUserAge(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAge(c)
+			}
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertUserName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["role"]; ok && v != nil {
+				c, err := convertUserRole(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRole(c)
+			}
+			if v, ok := in["skill"]; ok && v != nil {
+				c, err := convertUserSkill(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSkill(c)
+			}
+			if v, ok := in["department"]; ok && v != nil {
+				c, err := convertUserDepartment(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDepartment(c)
+			}
+			if v, ok := in["email"]; ok && v != nil {
+				c, err := convertUserEmail(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEmail(c)
+			}
+			if v, ok := in["locale"]; ok && v != nil {
+				c, err := convertUserLocale(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLocale(c)
+			}
+			if v, ok := in["disabled"]; ok && v != nil {
+				c, err := convertUserDisabled(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDisabled(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["reportedSlipIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddReportedSlipIDs(ids...)
+			}
+			if v, ok := in["assignedSlipIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddAssignedSlipIDs(ids...)
+			}
+			if v, ok := in["invoiceIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddInvoiceIDs(ids...)
+			}
+			if v, ok := in["attachmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddAttachmentIDs(ids...)
+			}
+			if v, ok := in["mentionedInIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddMentionedInIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateUser"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["User"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateUser"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.User.UpdateOneID(id)
+			if v, ok := in["age"]; ok && v != nil {
+				c, err := convertUserAge(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAge(c)
+			}
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertUserName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["role"]; ok && v != nil {
+				c, err := convertUserRole(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetRole(c)
+			}
+			if v, ok := in["skill"]; ok && v != nil {
+				c, err := convertUserSkill(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetSkill(c)
+			}
+			if v, ok := in["clearSkill"]; ok && v == true {
+				b.ClearSkill()
+			}
+			if v, ok := in["department"]; ok && v != nil {
+				c, err := convertUserDepartment(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDepartment(c)
+			}
+			if v, ok := in["clearDepartment"]; ok && v == true {
+				b.ClearDepartment()
+			}
+			if v, ok := in["email"]; ok && v != nil {
+				c, err := convertUserEmail(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEmail(c)
+			}
+			if v, ok := in["clearEmail"]; ok && v == true {
+				b.ClearEmail()
+			}
+			if v, ok := in["locale"]; ok && v != nil {
+				c, err := convertUserLocale(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetLocale(c)
+			}
+			if v, ok := in["disabled"]; ok && v != nil {
+				c, err := convertUserDisabled(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDisabled(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["addReportedSlipIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddReportedSlipIDs(ids...)
+			}
+			if v, ok := in["removeReportedSlipIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveReportedSlipIDs(ids...)
+			}
+			if v, ok := in["addAssignedSlipIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddAssignedSlipIDs(ids...)
+			}
+			if v, ok := in["removeAssignedSlipIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveAssignedSlipIDs(ids...)
+			}
+			if v, ok := in["addInvoiceIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddInvoiceIDs(ids...)
+			}
+			if v, ok := in["removeInvoiceIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveInvoiceIDs(ids...)
+			}
+			if v, ok := in["addAttachmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddAttachmentIDs(ids...)
+			}
+			if v, ok := in["removeAttachmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveAttachmentIDs(ids...)
+			}
+			if v, ok := in["addMentionedInIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddMentionedInIDs(ids...)
+			}
+			if v, ok := in["removeMentionedInIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveMentionedInIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteUser"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteUser"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.User.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeUser returns the predicates narrowing the User entities
+// that can be read.
+func (s *Schema) scopeUser(ctx context.Context) []predicate.User {
+	if s.opts.Scopes.User == nil {
+		return nil
+	}
+	return s.opts.Scopes.User(ctx)
+}
+
+// whereUser returns the predicates of a UserWhereInput.
+func (s *Schema) whereUser(in map[string]interface{}) ([]predicate.User, error) {
+	var ps []predicate.User
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereUser(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, user.Not(user.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.User
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereUser(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, user.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, user.And(group...))
+		default:
+			ps = append(ps, user.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.IDLTE(ids))
+	}
+	if v, ok := in["age"]; ok && v != nil {
+		c, err := convertUserAge(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.AgeEQ(c))
+	}
+	if v, ok := in["ageNEQ"]; ok && v != nil {
+		c, err := convertUserAge(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.AgeNEQ(c))
+	}
+	if v, ok := in["ageIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertUserAge(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.AgeIn(vs...))
+	}
+	if v, ok := in["ageNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertUserAge(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.AgeNotIn(vs...))
+	}
+	if v, ok := in["ageGT"]; ok && v != nil {
+		c, err := convertUserAge(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.AgeGT(c))
+	}
+	if v, ok := in["ageGTE"]; ok && v != nil {
+		c, err := convertUserAge(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.AgeGTE(c))
+	}
+	if v, ok := in["ageLT"]; ok && v != nil {
+		c, err := convertUserAge(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.AgeLT(c))
+	}
+	if v, ok := in["ageLTE"]; ok && v != nil {
+		c, err := convertUserAge(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.AgeLTE(c))
+	}
+	if v, ok := in["name"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameEQ(c))
+	}
+	if v, ok := in["nameNEQ"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameNEQ(c))
+	}
+	if v, ok := in["nameIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertUserName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.NameIn(vs...))
+	}
+	if v, ok := in["nameNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertUserName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.NameNotIn(vs...))
+	}
+	if v, ok := in["nameGT"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameGT(c))
+	}
+	if v, ok := in["nameGTE"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameGTE(c))
+	}
+	if v, ok := in["nameLT"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameLT(c))
+	}
+	if v, ok := in["nameLTE"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameLTE(c))
+	}
+	if v, ok := in["nameContains"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameContains(c))
+	}
+	if v, ok := in["nameHasPrefix"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameHasPrefix(c))
+	}
+	if v, ok := in["nameHasSuffix"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameHasSuffix(c))
+	}
+	if v, ok := in["nameEqualFold"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameEqualFold(c))
+	}
+	if v, ok := in["nameContainsFold"]; ok && v != nil {
+		c, err := convertUserName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.NameContainsFold(c))
+	}
+	if v, ok := in["role"]; ok && v != nil {
+		c, err := convertUserRole(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.RoleEQ(c))
+	}
+	if v, ok := in["roleNEQ"]; ok && v != nil {
+		c, err := convertUserRole(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.RoleNEQ(c))
+	}
+	if v, ok := in["roleIn"].([]interface{}); ok {
+		vs := make([]user.Role, len(v))
+		for i := range v {
+			c, err := convertUserRole(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.RoleIn(vs...))
+	}
+	if v, ok := in["roleNotIn"].([]interface{}); ok {
+		vs := make([]user.Role, len(v))
+		for i := range v {
+			c, err := convertUserRole(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.RoleNotIn(vs...))
+	}
+	if v, ok := in["skill"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillEQ(c))
+	}
+	if v, ok := in["skillNEQ"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillNEQ(c))
+	}
+	if v, ok := in["skillIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertUserSkill(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.SkillIn(vs...))
+	}
+	if v, ok := in["skillNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertUserSkill(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.SkillNotIn(vs...))
+	}
+	if v, ok := in["skillGT"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillGT(c))
+	}
+	if v, ok := in["skillGTE"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillGTE(c))
+	}
+	if v, ok := in["skillLT"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillLT(c))
+	}
+	if v, ok := in["skillLTE"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillLTE(c))
+	}
+	if v, ok := in["skillContains"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillContains(c))
+	}
+	if v, ok := in["skillHasPrefix"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillHasPrefix(c))
+	}
+	if v, ok := in["skillHasSuffix"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillHasSuffix(c))
+	}
+	if v, ok := in["skillIsNil"]; ok && v == true {
+		ps = append(ps, user.SkillIsNil())
+	}
+	if v, ok := in["skillNotNil"]; ok && v == true {
+		ps = append(ps, user.SkillNotNil())
+	}
+	if v, ok := in["skillEqualFold"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillEqualFold(c))
+	}
+	if v, ok := in["skillContainsFold"]; ok && v != nil {
+		c, err := convertUserSkill(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.SkillContainsFold(c))
+	}
+	if v, ok := in["department"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentEQ(c))
+	}
+	if v, ok := in["departmentNEQ"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentNEQ(c))
+	}
+	if v, ok := in["departmentIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertUserDepartment(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.DepartmentIn(vs...))
+	}
+	if v, ok := in["departmentNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertUserDepartment(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.DepartmentNotIn(vs...))
+	}
+	if v, ok := in["departmentGT"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentGT(c))
+	}
+	if v, ok := in["departmentGTE"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentGTE(c))
+	}
+	if v, ok := in["departmentLT"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentLT(c))
+	}
+	if v, ok := in["departmentLTE"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentLTE(c))
+	}
+	if v, ok := in["departmentContains"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentContains(c))
+	}
+	if v, ok := in["departmentHasPrefix"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentHasPrefix(c))
+	}
+	if v, ok := in["departmentHasSuffix"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentHasSuffix(c))
+	}
+	if v, ok := in["departmentIsNil"]; ok && v == true {
+		ps = append(ps, user.DepartmentIsNil())
+	}
+	if v, ok := in["departmentNotNil"]; ok && v == true {
+		ps = append(ps, user.DepartmentNotNil())
+	}
+	if v, ok := in["departmentEqualFold"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentEqualFold(c))
+	}
+	if v, ok := in["departmentContainsFold"]; ok && v != nil {
+		c, err := convertUserDepartment(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DepartmentContainsFold(c))
+	}
+	if v, ok := in["email"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailEQ(c))
+	}
+	if v, ok := in["emailNEQ"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailNEQ(c))
+	}
+	if v, ok := in["emailIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertUserEmail(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.EmailIn(vs...))
+	}
+	if v, ok := in["emailNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertUserEmail(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.EmailNotIn(vs...))
+	}
+	if v, ok := in["emailGT"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailGT(c))
+	}
+	if v, ok := in["emailGTE"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailGTE(c))
+	}
+	if v, ok := in["emailLT"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailLT(c))
+	}
+	if v, ok := in["emailLTE"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailLTE(c))
+	}
+	if v, ok := in["emailContains"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailContains(c))
+	}
+	if v, ok := in["emailHasPrefix"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailHasPrefix(c))
+	}
+	if v, ok := in["emailHasSuffix"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailHasSuffix(c))
+	}
+	if v, ok := in["emailIsNil"]; ok && v == true {
+		ps = append(ps, user.EmailIsNil())
+	}
+	if v, ok := in["emailNotNil"]; ok && v == true {
+		ps = append(ps, user.EmailNotNil())
+	}
+	if v, ok := in["emailEqualFold"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailEqualFold(c))
+	}
+	if v, ok := in["emailContainsFold"]; ok && v != nil {
+		c, err := convertUserEmail(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.EmailContainsFold(c))
+	}
+	if v, ok := in["locale"]; ok && v != nil {
+		c, err := convertUserLocale(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.LocaleEQ(c))
+	}
+	if v, ok := in["localeNEQ"]; ok && v != nil {
+		c, err := convertUserLocale(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.LocaleNEQ(c))
+	}
+	if v, ok := in["localeIn"].([]interface{}); ok {
+		vs := make([]user.Locale, len(v))
+		for i := range v {
+			c, err := convertUserLocale(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.LocaleIn(vs...))
+	}
+	if v, ok := in["localeNotIn"].([]interface{}); ok {
+		vs := make([]user.Locale, len(v))
+		for i := range v {
+			c, err := convertUserLocale(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, user.LocaleNotIn(vs...))
+	}
+	if v, ok := in["disabled"]; ok && v != nil {
+		c, err := convertUserDisabled(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DisabledEQ(c))
+	}
+	if v, ok := in["disabledNEQ"]; ok && v != nil {
+		c, err := convertUserDisabled(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, user.DisabledNEQ(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, user.HasOrganization())
+		} else {
+			ps = append(ps, user.Not(user.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, user.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasReportedSlips"].(bool); ok {
+		if v {
+			ps = append(ps, user.HasReportedSlips())
+		} else {
+			ps = append(ps, user.Not(user.HasReportedSlips()))
+		}
+	}
+	if v, ok := in["hasReportedSlipsWith"]; ok && v != nil {
+		var with []predicate.RepairSlip
+		for _, v := range wheres(v) {
+			sub, err := s.whereRepairSlip(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, user.HasReportedSlipsWith(with...))
+	}
+	if v, ok := in["hasAssignedSlips"].(bool); ok {
+		if v {
+			ps = append(ps, user.HasAssignedSlips())
+		} else {
+			ps = append(ps, user.Not(user.HasAssignedSlips()))
+		}
+	}
+	if v, ok := in["hasAssignedSlipsWith"]; ok && v != nil {
+		var with []predicate.RepairSlip
+		for _, v := range wheres(v) {
+			sub, err := s.whereRepairSlip(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, user.HasAssignedSlipsWith(with...))
+	}
+	if v, ok := in["hasInvoices"].(bool); ok {
+		if v {
+			ps = append(ps, user.HasInvoices())
+		} else {
+			ps = append(ps, user.Not(user.HasInvoices()))
+		}
+	}
+	if v, ok := in["hasInvoicesWith"]; ok && v != nil {
+		var with []predicate.Invoice
+		for _, v := range wheres(v) {
+			sub, err := s.whereInvoice(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, user.HasInvoicesWith(with...))
+	}
+	if v, ok := in["hasAttachments"].(bool); ok {
+		if v {
+			ps = append(ps, user.HasAttachments())
+		} else {
+			ps = append(ps, user.Not(user.HasAttachments()))
+		}
+	}
+	if v, ok := in["hasAttachmentsWith"]; ok && v != nil {
+		var with []predicate.Attachment
+		for _, v := range wheres(v) {
+			sub, err := s.whereAttachment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, user.HasAttachmentsWith(with...))
+	}
+	if v, ok := in["hasMentionedIn"].(bool); ok {
+		if v {
+			ps = append(ps, user.HasMentionedIn())
+		} else {
+			ps = append(ps, user.Not(user.HasMentionedIn()))
+		}
+	}
+	if v, ok := in["hasMentionedInWith"]; ok && v != nil {
+		var with []predicate.Comment
+		for _, v := range wheres(v) {
+			sub, err := s.whereComment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, user.HasMentionedInWith(with...))
+	}
+	return ps, nil
+}
+
+// convertUserAge returns the age of User of an argument.
+func convertUserAge(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// convertUserName returns the name of User of an argument.
+func convertUserName(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertUserRole returns the role of User of an argument.
+func convertUserRole(v interface{}) (user.Role, error) {
+	s, _ := v.(string)
+	return user.Role(s), nil
+}
+
+// convertUserSkill returns the skill of User of an argument.
+func convertUserSkill(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertUserDepartment returns the department of User of an argument.
+func convertUserDepartment(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertUserEmail returns the email of User of an argument.
+func convertUserEmail(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertUserLocale returns the locale of User of an argument.
+func convertUserLocale(v interface{}) (user.Locale, error) {
+	s, _ := v.(string)
+	return user.Locale(s), nil
+}
+
+// convertUserDisabled returns the disabled of User of an argument.
+func convertUserDisabled(v interface{}) (bool, error) {
+	b, _ := v.(bool)
+	return b, nil
+}
+
+// defineWarrantyTerm defines the types of WarrantyTerm and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineWarrantyTerm(query, mutation graphql.Fields, mutable bool) {
+	s.enums["WarrantyTermCoverage"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "WarrantyTermCoverage",
+		Values: graphql.EnumValueConfigMap{
+			"parts":            &graphql.EnumValueConfig{Value: "parts"},
+			"labour":           &graphql.EnumValueConfig{Value: "labour"},
+			"parts_and_labour": &graphql.EnumValueConfig{Value: "parts_and_labour"},
+		},
+	})
+	leaves := map[string]leaf{
+		"name":          graphql.String,
+		"provider":      graphql.String,
+		"months":        graphql.Int,
+		"coverage":      s.enums["WarrantyTermCoverage"],
+		"claim_contact": graphql.String,
+		"conditions":    graphql.String,
+	}
+
+	s.objects["WarrantyTerm"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "WarrantyTerm",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.WarrantyTerm).ID, nil
+					},
+				},
+				"name": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["name"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WarrantyTerm).Name
+						return v, nil
+					},
+				},
+				"provider": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["provider"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WarrantyTerm).Provider
+						return v, nil
+					},
+				},
+				"months": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["months"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WarrantyTerm).Months
+						return v, nil
+					},
+				},
+				"coverage": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["coverage"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WarrantyTerm).Coverage
+						return string(v), nil
+					},
+				},
+				"claimContact": &graphql.Field{
+					Type: leaves["claim_contact"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WarrantyTerm).ClaimContact
+						return v, nil
+					},
+				},
+				"conditions": &graphql.Field{
+					Type: leaves["conditions"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WarrantyTerm).Conditions
+						return v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "WarrantyTerm.organization", p.Source.(*ent.WarrantyTerm).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.WarrantyTerm.Query().
+								Where(warrantyterm.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"equipment": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["Equipment"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "WarrantyTerm.equipment", p.Source.(*ent.WarrantyTerm).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.WarrantyTerm.Query().
+								Where(warrantyterm.IDIn(ids...)).
+								WithEquipment(func(q *ent.EquipmentQuery) {
+									q.Where(s.scopeEquipment(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Equipment
+								if edges == nil {
+									edges = []*ent.Equipment{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["WarrantyTerm"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "WarrantyTermWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["WarrantyTerm"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                      &graphql.InputObjectFieldConfig{Type: where},
+				"and":                      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                       &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"name":                     &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameNEQ":                  &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameIn":                   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameNotIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["name"]))},
+				"nameGT":                   &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameGTE":                  &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLT":                   &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameLTE":                  &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContains":             &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasPrefix":            &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameHasSuffix":            &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameEqualFold":            &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"nameContainsFold":         &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+				"provider":                 &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["provider"]))},
+				"providerNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["provider"]))},
+				"providerGT":               &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerGTE":              &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerLT":               &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerLTE":              &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerContains":         &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerHasPrefix":        &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerHasSuffix":        &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerEqualFold":        &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"providerContainsFold":     &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+				"months":                   &graphql.InputObjectFieldConfig{Type: leaves["months"]},
+				"monthsNEQ":                &graphql.InputObjectFieldConfig{Type: leaves["months"]},
+				"monthsIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["months"]))},
+				"monthsNotIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["months"]))},
+				"monthsGT":                 &graphql.InputObjectFieldConfig{Type: leaves["months"]},
+				"monthsGTE":                &graphql.InputObjectFieldConfig{Type: leaves["months"]},
+				"monthsLT":                 &graphql.InputObjectFieldConfig{Type: leaves["months"]},
+				"monthsLTE":                &graphql.InputObjectFieldConfig{Type: leaves["months"]},
+				"coverage":                 &graphql.InputObjectFieldConfig{Type: leaves["coverage"]},
+				"coverageNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["coverage"]},
+				"coverageIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["coverage"]))},
+				"coverageNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["coverage"]))},
+				"claimContact":             &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["claim_contact"]))},
+				"claimContactNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["claim_contact"]))},
+				"claimContactGT":           &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactGTE":          &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactLT":           &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactLTE":          &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactContains":     &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactIsNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"claimContactNotNil":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"claimContactEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"claimContactContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+				"conditions":               &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["conditions"]))},
+				"conditionsNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["conditions"]))},
+				"conditionsGT":             &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsGTE":            &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsLT":             &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsLTE":            &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsContains":       &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsHasPrefix":      &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsHasSuffix":      &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsIsNil":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"conditionsNotNil":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"conditionsEqualFold":      &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"conditionsContainsFold":   &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+				"hasOrganization":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasEquipment":             &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasEquipmentWith":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Equipment"]))},
+			}
+		}),
+	})
+
+	query["warrantyTerm"] = &graphql.Field{
+		Type: s.objects["WarrantyTerm"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.WarrantyTerm.Query().
+				Where(warrantyterm.ID(id)).
+				Where(s.scopeWarrantyTerm(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["warrantyTerms"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("WarrantyTerm")),
+		Args: connectionArgs(s.wheres["WarrantyTerm"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.WarrantyTerm.Query().Where(s.scopeWarrantyTerm(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereWarrantyTerm(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(warrantyterm.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(warrantyterm.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(warrantyterm.FieldID))
+			} else {
+				q.Order(ent.Asc(warrantyterm.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateWarrantyTermInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":           &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["name"])},
+			"provider":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["provider"])},
+			"months":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["months"])},
+			"coverage":       &graphql.InputObjectFieldConfig{Type: leaves["coverage"]},
+			"claimContact":   &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+			"conditions":     &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"equipmentIDs":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateWarrantyTermInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":               &graphql.InputObjectFieldConfig{Type: leaves["name"]},
+			"provider":           &graphql.InputObjectFieldConfig{Type: leaves["provider"]},
+			"months":             &graphql.InputObjectFieldConfig{Type: leaves["months"]},
+			"coverage":           &graphql.InputObjectFieldConfig{Type: leaves["coverage"]},
+			"claimContact":       &graphql.InputObjectFieldConfig{Type: leaves["claim_contact"]},
+			"clearClaimContact":  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"conditions":         &graphql.InputObjectFieldConfig{Type: leaves["conditions"]},
+			"clearConditions":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"organizationID":     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization":  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addEquipmentIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeEquipmentIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	mutation["createWarrantyTerm"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["WarrantyTerm"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createWarrantyTerm"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.WarrantyTerm.Create()
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertWarrantyTermName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["provider"]; ok && v != nil {
+				c, err := convertWarrantyTermProvider(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetProvider(c)
+			}
+			if v, ok := in["months"]; ok && v != nil {
+				c, err := convertWarrantyTermMonths(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetMonths(c)
+			}
+			if v, ok := in["coverage"]; ok && v != nil {
+				c, err := convertWarrantyTermCoverage(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCoverage(c)
+			}
+			if v, ok := in["claimContact"]; ok && v != nil {
+				c, err := convertWarrantyTermClaimContact(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetClaimContact(c)
+			}
+			if v, ok := in["conditions"]; ok && v != nil {
+				c, err := convertWarrantyTermConditions(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetConditions(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["equipmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddEquipmentIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateWarrantyTerm"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["WarrantyTerm"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateWarrantyTerm"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.WarrantyTerm.UpdateOneID(id)
+			if v, ok := in["name"]; ok && v != nil {
+				c, err := convertWarrantyTermName(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetName(c)
+			}
+			if v, ok := in["provider"]; ok && v != nil {
+				c, err := convertWarrantyTermProvider(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetProvider(c)
+			}
+			if v, ok := in["months"]; ok && v != nil {
+				c, err := convertWarrantyTermMonths(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetMonths(c)
+			}
+			if v, ok := in["coverage"]; ok && v != nil {
+				c, err := convertWarrantyTermCoverage(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCoverage(c)
+			}
+			if v, ok := in["claimContact"]; ok && v != nil {
+				c, err := convertWarrantyTermClaimContact(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetClaimContact(c)
+			}
+			if v, ok := in["clearClaimContact"]; ok && v == true {
+				b.ClearClaimContact()
+			}
+			if v, ok := in["conditions"]; ok && v != nil {
+				c, err := convertWarrantyTermConditions(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetConditions(c)
+			}
+			if v, ok := in["clearConditions"]; ok && v == true {
+				b.ClearConditions()
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["addEquipmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddEquipmentIDs(ids...)
+			}
+			if v, ok := in["removeEquipmentIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveEquipmentIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteWarrantyTerm"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteWarrantyTerm"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.WarrantyTerm.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeWarrantyTerm returns the predicates narrowing the WarrantyTerm entities
+// that can be read.
+func (s *Schema) scopeWarrantyTerm(ctx context.Context) []predicate.WarrantyTerm {
+	if s.opts.Scopes.WarrantyTerm == nil {
+		return nil
+	}
+	return s.opts.Scopes.WarrantyTerm(ctx)
+}
+
+// whereWarrantyTerm returns the predicates of a WarrantyTermWhereInput.
+func (s *Schema) whereWarrantyTerm(in map[string]interface{}) ([]predicate.WarrantyTerm, error) {
+	var ps []predicate.WarrantyTerm
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereWarrantyTerm(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, warrantyterm.Not(warrantyterm.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.WarrantyTerm
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereWarrantyTerm(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, warrantyterm.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, warrantyterm.And(group...))
+		default:
+			ps = append(ps, warrantyterm.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.IDLTE(ids))
+	}
+	if v, ok := in["name"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameEQ(c))
+	}
+	if v, ok := in["nameNEQ"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameNEQ(c))
+	}
+	if v, ok := in["nameIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.NameIn(vs...))
+	}
+	if v, ok := in["nameNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermName(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.NameNotIn(vs...))
+	}
+	if v, ok := in["nameGT"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameGT(c))
+	}
+	if v, ok := in["nameGTE"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameGTE(c))
+	}
+	if v, ok := in["nameLT"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameLT(c))
+	}
+	if v, ok := in["nameLTE"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameLTE(c))
+	}
+	if v, ok := in["nameContains"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameContains(c))
+	}
+	if v, ok := in["nameHasPrefix"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameHasPrefix(c))
+	}
+	if v, ok := in["nameHasSuffix"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameHasSuffix(c))
+	}
+	if v, ok := in["nameEqualFold"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameEqualFold(c))
+	}
+	if v, ok := in["nameContainsFold"]; ok && v != nil {
+		c, err := convertWarrantyTermName(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.NameContainsFold(c))
+	}
+	if v, ok := in["provider"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderEQ(c))
+	}
+	if v, ok := in["providerNEQ"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderNEQ(c))
+	}
+	if v, ok := in["providerIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermProvider(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.ProviderIn(vs...))
+	}
+	if v, ok := in["providerNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermProvider(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.ProviderNotIn(vs...))
+	}
+	if v, ok := in["providerGT"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderGT(c))
+	}
+	if v, ok := in["providerGTE"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderGTE(c))
+	}
+	if v, ok := in["providerLT"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderLT(c))
+	}
+	if v, ok := in["providerLTE"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderLTE(c))
+	}
+	if v, ok := in["providerContains"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderContains(c))
+	}
+	if v, ok := in["providerHasPrefix"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderHasPrefix(c))
+	}
+	if v, ok := in["providerHasSuffix"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderHasSuffix(c))
+	}
+	if v, ok := in["providerEqualFold"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderEqualFold(c))
+	}
+	if v, ok := in["providerContainsFold"]; ok && v != nil {
+		c, err := convertWarrantyTermProvider(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ProviderContainsFold(c))
+	}
+	if v, ok := in["months"]; ok && v != nil {
+		c, err := convertWarrantyTermMonths(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.MonthsEQ(c))
+	}
+	if v, ok := in["monthsNEQ"]; ok && v != nil {
+		c, err := convertWarrantyTermMonths(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.MonthsNEQ(c))
+	}
+	if v, ok := in["monthsIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermMonths(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.MonthsIn(vs...))
+	}
+	if v, ok := in["monthsNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermMonths(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.MonthsNotIn(vs...))
+	}
+	if v, ok := in["monthsGT"]; ok && v != nil {
+		c, err := convertWarrantyTermMonths(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.MonthsGT(c))
+	}
+	if v, ok := in["monthsGTE"]; ok && v != nil {
+		c, err := convertWarrantyTermMonths(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.MonthsGTE(c))
+	}
+	if v, ok := in["monthsLT"]; ok && v != nil {
+		c, err := convertWarrantyTermMonths(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.MonthsLT(c))
+	}
+	if v, ok := in["monthsLTE"]; ok && v != nil {
+		c, err := convertWarrantyTermMonths(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.MonthsLTE(c))
+	}
+	if v, ok := in["coverage"]; ok && v != nil {
+		c, err := convertWarrantyTermCoverage(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.CoverageEQ(c))
+	}
+	if v, ok := in["coverageNEQ"]; ok && v != nil {
+		c, err := convertWarrantyTermCoverage(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.CoverageNEQ(c))
+	}
+	if v, ok := in["coverageIn"].([]interface{}); ok {
+		vs := make([]warrantyterm.Coverage, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermCoverage(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.CoverageIn(vs...))
+	}
+	if v, ok := in["coverageNotIn"].([]interface{}); ok {
+		vs := make([]warrantyterm.Coverage, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermCoverage(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.CoverageNotIn(vs...))
+	}
+	if v, ok := in["claimContact"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactEQ(c))
+	}
+	if v, ok := in["claimContactNEQ"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactNEQ(c))
+	}
+	if v, ok := in["claimContactIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermClaimContact(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.ClaimContactIn(vs...))
+	}
+	if v, ok := in["claimContactNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermClaimContact(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.ClaimContactNotIn(vs...))
+	}
+	if v, ok := in["claimContactGT"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactGT(c))
+	}
+	if v, ok := in["claimContactGTE"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactGTE(c))
+	}
+	if v, ok := in["claimContactLT"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactLT(c))
+	}
+	if v, ok := in["claimContactLTE"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactLTE(c))
+	}
+	if v, ok := in["claimContactContains"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactContains(c))
+	}
+	if v, ok := in["claimContactHasPrefix"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactHasPrefix(c))
+	}
+	if v, ok := in["claimContactHasSuffix"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactHasSuffix(c))
+	}
+	if v, ok := in["claimContactIsNil"]; ok && v == true {
+		ps = append(ps, warrantyterm.ClaimContactIsNil())
+	}
+	if v, ok := in["claimContactNotNil"]; ok && v == true {
+		ps = append(ps, warrantyterm.ClaimContactNotNil())
+	}
+	if v, ok := in["claimContactEqualFold"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactEqualFold(c))
+	}
+	if v, ok := in["claimContactContainsFold"]; ok && v != nil {
+		c, err := convertWarrantyTermClaimContact(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ClaimContactContainsFold(c))
+	}
+	if v, ok := in["conditions"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsEQ(c))
+	}
+	if v, ok := in["conditionsNEQ"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsNEQ(c))
+	}
+	if v, ok := in["conditionsIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermConditions(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.ConditionsIn(vs...))
+	}
+	if v, ok := in["conditionsNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWarrantyTermConditions(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, warrantyterm.ConditionsNotIn(vs...))
+	}
+	if v, ok := in["conditionsGT"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsGT(c))
+	}
+	if v, ok := in["conditionsGTE"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsGTE(c))
+	}
+	if v, ok := in["conditionsLT"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsLT(c))
+	}
+	if v, ok := in["conditionsLTE"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsLTE(c))
+	}
+	if v, ok := in["conditionsContains"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsContains(c))
+	}
+	if v, ok := in["conditionsHasPrefix"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsHasPrefix(c))
+	}
+	if v, ok := in["conditionsHasSuffix"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsHasSuffix(c))
+	}
+	if v, ok := in["conditionsIsNil"]; ok && v == true {
+		ps = append(ps, warrantyterm.ConditionsIsNil())
+	}
+	if v, ok := in["conditionsNotNil"]; ok && v == true {
+		ps = append(ps, warrantyterm.ConditionsNotNil())
+	}
+	if v, ok := in["conditionsEqualFold"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsEqualFold(c))
+	}
+	if v, ok := in["conditionsContainsFold"]; ok && v != nil {
+		c, err := convertWarrantyTermConditions(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, warrantyterm.ConditionsContainsFold(c))
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, warrantyterm.HasOrganization())
+		} else {
+			ps = append(ps, warrantyterm.Not(warrantyterm.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, warrantyterm.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasEquipment"].(bool); ok {
+		if v {
+			ps = append(ps, warrantyterm.HasEquipment())
+		} else {
+			ps = append(ps, warrantyterm.Not(warrantyterm.HasEquipment()))
+		}
+	}
+	if v, ok := in["hasEquipmentWith"]; ok && v != nil {
+		var with []predicate.Equipment
+		for _, v := range wheres(v) {
+			sub, err := s.whereEquipment(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, warrantyterm.HasEquipmentWith(with...))
+	}
+	return ps, nil
+}
+
+// convertWarrantyTermName returns the name of WarrantyTerm of an argument.
+func convertWarrantyTermName(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertWarrantyTermProvider returns the provider of WarrantyTerm of an argument.
+func convertWarrantyTermProvider(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertWarrantyTermMonths returns the months of WarrantyTerm of an argument.
+func convertWarrantyTermMonths(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// convertWarrantyTermCoverage returns the coverage of WarrantyTerm of an argument.
+func convertWarrantyTermCoverage(v interface{}) (warrantyterm.Coverage, error) {
+	s, _ := v.(string)
+	return warrantyterm.Coverage(s), nil
+}
+
+// convertWarrantyTermClaimContact returns the claim_contact of WarrantyTerm of an argument.
+func convertWarrantyTermClaimContact(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertWarrantyTermConditions returns the conditions of WarrantyTerm of an argument.
+func convertWarrantyTermConditions(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// defineWebhook defines the types of Webhook and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineWebhook(query, mutation graphql.Fields, mutable bool) {
+	leaves := map[string]leaf{
+		"create_time": graphql.DateTime,
+		"update_time": graphql.DateTime,
+		"url":         graphql.String,
+		"events":      graphql.NewList(graphql.NewNonNull(graphql.String)),
+		"active":      graphql.Boolean,
+		"failures":    graphql.Int,
+		"disabled_at": graphql.DateTime,
+	}
+
+	s.objects["Webhook"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Webhook",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.Webhook).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Webhook).CreateTime
+						return v, nil
+					},
+				},
+				"updateTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["update_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Webhook).UpdateTime
+						return v, nil
+					},
+				},
+				"url": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["url"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Webhook).URL
+						return v, nil
+					},
+				},
+				"events": &graphql.Field{
+					Type: leaves["events"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Webhook).Events
+						return v, nil
+					},
+				},
+				"active": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["active"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Webhook).Active
+						return v, nil
+					},
+				},
+				"failures": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["failures"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Webhook).Failures
+						return v, nil
+					},
+				},
+				"disabledAt": &graphql.Field{
+					Type: leaves["disabled_at"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.Webhook).DisabledAt
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Webhook.organization", p.Source.(*ent.Webhook).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Webhook.Query().
+								Where(webhook.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"deliveries": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(s.objects["WebhookDelivery"]))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "Webhook.deliveries", p.Source.(*ent.Webhook).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.Webhook.Query().
+								Where(webhook.IDIn(ids...)).
+								WithDeliveries(func(q *ent.WebhookDeliveryQuery) {
+									q.Where(s.scopeWebhookDelivery(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								edges := n.Edges.Deliveries
+								if edges == nil {
+									edges = []*ent.WebhookDelivery{}
+								}
+								values[n.ID] = edges
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["Webhook"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "WebhookWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["Webhook"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                 &graphql.InputObjectFieldConfig{Type: where},
+				"and":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":               &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":          &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":        &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":       &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"updateTime":          &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["update_time"]))},
+				"updateTimeGT":        &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeGTE":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLT":        &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"updateTimeLTE":       &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+				"url":                 &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["url"]))},
+				"urlNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["url"]))},
+				"urlGT":               &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlGTE":              &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlLT":               &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlLTE":              &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlContains":         &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlHasPrefix":        &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlHasSuffix":        &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlEqualFold":        &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"urlContainsFold":     &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+				"eventsIsNil":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"eventsNotNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"active":              &graphql.InputObjectFieldConfig{Type: leaves["active"]},
+				"activeNEQ":           &graphql.InputObjectFieldConfig{Type: leaves["active"]},
+				"failures":            &graphql.InputObjectFieldConfig{Type: leaves["failures"]},
+				"failuresNEQ":         &graphql.InputObjectFieldConfig{Type: leaves["failures"]},
+				"failuresIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["failures"]))},
+				"failuresNotIn":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["failures"]))},
+				"failuresGT":          &graphql.InputObjectFieldConfig{Type: leaves["failures"]},
+				"failuresGTE":         &graphql.InputObjectFieldConfig{Type: leaves["failures"]},
+				"failuresLT":          &graphql.InputObjectFieldConfig{Type: leaves["failures"]},
+				"failuresLTE":         &graphql.InputObjectFieldConfig{Type: leaves["failures"]},
+				"disabledAt":          &graphql.InputObjectFieldConfig{Type: leaves["disabled_at"]},
+				"disabledAtNEQ":       &graphql.InputObjectFieldConfig{Type: leaves["disabled_at"]},
+				"disabledAtIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["disabled_at"]))},
+				"disabledAtNotIn":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["disabled_at"]))},
+				"disabledAtGT":        &graphql.InputObjectFieldConfig{Type: leaves["disabled_at"]},
+				"disabledAtGTE":       &graphql.InputObjectFieldConfig{Type: leaves["disabled_at"]},
+				"disabledAtLT":        &graphql.InputObjectFieldConfig{Type: leaves["disabled_at"]},
+				"disabledAtLTE":       &graphql.InputObjectFieldConfig{Type: leaves["disabled_at"]},
+				"disabledAtIsNil":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"disabledAtNotNil":    &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganization":     &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasDeliveries":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasDeliveriesWith":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["WebhookDelivery"]))},
+			}
+		}),
+	})
+
+	query["webhook"] = &graphql.Field{
+		Type: s.objects["Webhook"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.Webhook.Query().
+				Where(webhook.ID(id)).
+				Where(s.scopeWebhook(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["webhooks"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("Webhook")),
+		Args: connectionArgs(s.wheres["Webhook"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.Webhook.Query().Where(s.scopeWebhook(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereWebhook(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(webhook.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(webhook.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(webhook.FieldID))
+			} else {
+				q.Order(ent.Asc(webhook.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateWebhookInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"updateTime":     &graphql.InputObjectFieldConfig{Type: leaves["update_time"]},
+			"url":            &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["url"])},
+			"events":         &graphql.InputObjectFieldConfig{Type: leaves["events"]},
+			"active":         &graphql.InputObjectFieldConfig{Type: leaves["active"]},
+			"failures":       &graphql.InputObjectFieldConfig{Type: leaves["failures"]},
+			"disabledAt":     &graphql.InputObjectFieldConfig{Type: leaves["disabled_at"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"deliveryIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateWebhookInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"url":               &graphql.InputObjectFieldConfig{Type: leaves["url"]},
+			"events":            &graphql.InputObjectFieldConfig{Type: leaves["events"]},
+			"clearEvents":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"active":            &graphql.InputObjectFieldConfig{Type: leaves["active"]},
+			"failures":          &graphql.InputObjectFieldConfig{Type: leaves["failures"]},
+			"disabledAt":        &graphql.InputObjectFieldConfig{Type: leaves["disabled_at"]},
+			"clearDisabledAt":   &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"addDeliveryIDs":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+			"removeDeliveryIDs": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+		},
+	})
+	mutation["createWebhook"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Webhook"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createWebhook"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Webhook.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertWebhookCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["updateTime"]; ok && v != nil {
+				c, err := convertWebhookUpdateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetUpdateTime(c)
+			}
+			if v, ok := in["url"]; ok && v != nil {
+				c, err := convertWebhookURL(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetURL(c)
+			}
+			if v, ok := in["events"]; ok && v != nil {
+				c, err := convertWebhookEvents(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEvents(c)
+			}
+			if v, ok := in["active"]; ok && v != nil {
+				c, err := convertWebhookActive(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetActive(c)
+			}
+			if v, ok := in["failures"]; ok && v != nil {
+				c, err := convertWebhookFailures(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetFailures(c)
+			}
+			if v, ok := in["disabledAt"]; ok && v != nil {
+				c, err := convertWebhookDisabledAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDisabledAt(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["deliveryIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddDeliveryIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateWebhook"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["Webhook"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateWebhook"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.Webhook.UpdateOneID(id)
+			if v, ok := in["url"]; ok && v != nil {
+				c, err := convertWebhookURL(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetURL(c)
+			}
+			if v, ok := in["events"]; ok && v != nil {
+				c, err := convertWebhookEvents(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEvents(c)
+			}
+			if v, ok := in["clearEvents"]; ok && v == true {
+				b.ClearEvents()
+			}
+			if v, ok := in["active"]; ok && v != nil {
+				c, err := convertWebhookActive(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetActive(c)
+			}
+			if v, ok := in["failures"]; ok && v != nil {
+				c, err := convertWebhookFailures(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetFailures(c)
+			}
+			if v, ok := in["disabledAt"]; ok && v != nil {
+				c, err := convertWebhookDisabledAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDisabledAt(c)
+			}
+			if v, ok := in["clearDisabledAt"]; ok && v == true {
+				b.ClearDisabledAt()
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["addDeliveryIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.AddDeliveryIDs(ids...)
+			}
+			if v, ok := in["removeDeliveryIDs"]; ok && v != nil {
+				ids, err := toIDs(v)
+				if err != nil {
+					return nil, err
+				}
+				b.RemoveDeliveryIDs(ids...)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteWebhook"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteWebhook"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.Webhook.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeWebhook returns the predicates narrowing the Webhook entities
+// that can be read.
+func (s *Schema) scopeWebhook(ctx context.Context) []predicate.Webhook {
+	if s.opts.Scopes.Webhook == nil {
+		return nil
+	}
+	return s.opts.Scopes.Webhook(ctx)
+}
+
+// whereWebhook returns the predicates of a WebhookWhereInput.
+func (s *Schema) whereWebhook(in map[string]interface{}) ([]predicate.Webhook, error) {
+	var ps []predicate.Webhook
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereWebhook(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, webhook.Not(webhook.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.Webhook
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereWebhook(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, webhook.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, webhook.And(group...))
+		default:
+			ps = append(ps, webhook.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertWebhookCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertWebhookCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertWebhookCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertWebhookCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertWebhookCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertWebhookCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.CreateTimeLTE(c))
+	}
+	if v, ok := in["updateTime"]; ok && v != nil {
+		c, err := convertWebhookUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.UpdateTimeEQ(c))
+	}
+	if v, ok := in["updateTimeNEQ"]; ok && v != nil {
+		c, err := convertWebhookUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.UpdateTimeNEQ(c))
+	}
+	if v, ok := in["updateTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.UpdateTimeIn(vs...))
+	}
+	if v, ok := in["updateTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookUpdateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.UpdateTimeNotIn(vs...))
+	}
+	if v, ok := in["updateTimeGT"]; ok && v != nil {
+		c, err := convertWebhookUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.UpdateTimeGT(c))
+	}
+	if v, ok := in["updateTimeGTE"]; ok && v != nil {
+		c, err := convertWebhookUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.UpdateTimeGTE(c))
+	}
+	if v, ok := in["updateTimeLT"]; ok && v != nil {
+		c, err := convertWebhookUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.UpdateTimeLT(c))
+	}
+	if v, ok := in["updateTimeLTE"]; ok && v != nil {
+		c, err := convertWebhookUpdateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.UpdateTimeLTE(c))
+	}
+	if v, ok := in["url"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLEQ(c))
+	}
+	if v, ok := in["urlNEQ"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLNEQ(c))
+	}
+	if v, ok := in["urlIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookURL(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.URLIn(vs...))
+	}
+	if v, ok := in["urlNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookURL(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.URLNotIn(vs...))
+	}
+	if v, ok := in["urlGT"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLGT(c))
+	}
+	if v, ok := in["urlGTE"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLGTE(c))
+	}
+	if v, ok := in["urlLT"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLLT(c))
+	}
+	if v, ok := in["urlLTE"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLLTE(c))
+	}
+	if v, ok := in["urlContains"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLContains(c))
+	}
+	if v, ok := in["urlHasPrefix"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLHasPrefix(c))
+	}
+	if v, ok := in["urlHasSuffix"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLHasSuffix(c))
+	}
+	if v, ok := in["urlEqualFold"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLEqualFold(c))
+	}
+	if v, ok := in["urlContainsFold"]; ok && v != nil {
+		c, err := convertWebhookURL(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.URLContainsFold(c))
+	}
+	if v, ok := in["eventsIsNil"]; ok && v == true {
+		ps = append(ps, webhook.EventsIsNil())
+	}
+	if v, ok := in["eventsNotNil"]; ok && v == true {
+		ps = append(ps, webhook.EventsNotNil())
+	}
+	if v, ok := in["active"]; ok && v != nil {
+		c, err := convertWebhookActive(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.ActiveEQ(c))
+	}
+	if v, ok := in["activeNEQ"]; ok && v != nil {
+		c, err := convertWebhookActive(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.ActiveNEQ(c))
+	}
+	if v, ok := in["failures"]; ok && v != nil {
+		c, err := convertWebhookFailures(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.FailuresEQ(c))
+	}
+	if v, ok := in["failuresNEQ"]; ok && v != nil {
+		c, err := convertWebhookFailures(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.FailuresNEQ(c))
+	}
+	if v, ok := in["failuresIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertWebhookFailures(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.FailuresIn(vs...))
+	}
+	if v, ok := in["failuresNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertWebhookFailures(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.FailuresNotIn(vs...))
+	}
+	if v, ok := in["failuresGT"]; ok && v != nil {
+		c, err := convertWebhookFailures(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.FailuresGT(c))
+	}
+	if v, ok := in["failuresGTE"]; ok && v != nil {
+		c, err := convertWebhookFailures(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.FailuresGTE(c))
+	}
+	if v, ok := in["failuresLT"]; ok && v != nil {
+		c, err := convertWebhookFailures(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.FailuresLT(c))
+	}
+	if v, ok := in["failuresLTE"]; ok && v != nil {
+		c, err := convertWebhookFailures(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.FailuresLTE(c))
+	}
+	if v, ok := in["disabledAt"]; ok && v != nil {
+		c, err := convertWebhookDisabledAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.DisabledAtEQ(c))
+	}
+	if v, ok := in["disabledAtNEQ"]; ok && v != nil {
+		c, err := convertWebhookDisabledAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.DisabledAtNEQ(c))
+	}
+	if v, ok := in["disabledAtIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookDisabledAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.DisabledAtIn(vs...))
+	}
+	if v, ok := in["disabledAtNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookDisabledAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhook.DisabledAtNotIn(vs...))
+	}
+	if v, ok := in["disabledAtGT"]; ok && v != nil {
+		c, err := convertWebhookDisabledAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.DisabledAtGT(c))
+	}
+	if v, ok := in["disabledAtGTE"]; ok && v != nil {
+		c, err := convertWebhookDisabledAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.DisabledAtGTE(c))
+	}
+	if v, ok := in["disabledAtLT"]; ok && v != nil {
+		c, err := convertWebhookDisabledAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.DisabledAtLT(c))
+	}
+	if v, ok := in["disabledAtLTE"]; ok && v != nil {
+		c, err := convertWebhookDisabledAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhook.DisabledAtLTE(c))
+	}
+	if v, ok := in["disabledAtIsNil"]; ok && v == true {
+		ps = append(ps, webhook.DisabledAtIsNil())
+	}
+	if v, ok := in["disabledAtNotNil"]; ok && v == true {
+		ps = append(ps, webhook.DisabledAtNotNil())
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, webhook.HasOrganization())
+		} else {
+			ps = append(ps, webhook.Not(webhook.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, webhook.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasDeliveries"].(bool); ok {
+		if v {
+			ps = append(ps, webhook.HasDeliveries())
+		} else {
+			ps = append(ps, webhook.Not(webhook.HasDeliveries()))
+		}
+	}
+	if v, ok := in["hasDeliveriesWith"]; ok && v != nil {
+		var with []predicate.WebhookDelivery
+		for _, v := range wheres(v) {
+			sub, err := s.whereWebhookDelivery(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, webhook.HasDeliveriesWith(with...))
+	}
+	return ps, nil
+}
+
+// convertWebhookCreateTime returns the create_time of Webhook of an argument.
+func convertWebhookCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertWebhookUpdateTime returns the update_time of Webhook of an argument.
+func convertWebhookUpdateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: updateTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertWebhookURL returns the url of Webhook of an argument.
+func convertWebhookURL(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertWebhookEvents returns the events of Webhook of an argument.
+func convertWebhookEvents(v interface{}) ([]string, error) {
+	return toStrings(v)
+}
+
+// convertWebhookActive returns the active of Webhook of an argument.
+func convertWebhookActive(v interface{}) (bool, error) {
+	b, _ := v.(bool)
+	return b, nil
+}
+
+// convertWebhookFailures returns the failures of Webhook of an argument.
+func convertWebhookFailures(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// convertWebhookDisabledAt returns the disabled_at of Webhook of an argument.
+func convertWebhookDisabledAt(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: disabledAt: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// defineWebhookDelivery defines the types of WebhookDelivery and adds its queries and,
+// when it is mutable, its mutations.
+func (s *Schema) defineWebhookDelivery(query, mutation graphql.Fields, mutable bool) {
+	s.enums["WebhookDeliveryStatus"] = graphql.NewEnum(graphql.EnumConfig{
+		Name: "WebhookDeliveryStatus",
+		Values: graphql.EnumValueConfigMap{
+			"pending":   &graphql.EnumValueConfig{Value: "pending"},
+			"succeeded": &graphql.EnumValueConfig{Value: "succeeded"},
+			"failed":    &graphql.EnumValueConfig{Value: "failed"},
+		},
+	})
+	leaves := map[string]leaf{
+		"create_time":   graphql.DateTime,
+		"event":         graphql.String,
+		"payload":       graphql.String,
+		"status":        s.enums["WebhookDeliveryStatus"],
+		"attempts":      graphql.Int,
+		"response_code": graphql.Int,
+		"response_body": graphql.String,
+		"error":         graphql.String,
+		"delivered_at":  graphql.DateTime,
+	}
+
+	s.objects["WebhookDelivery"] = graphql.NewObject(graphql.ObjectConfig{
+		Name: "WebhookDelivery",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*ent.WebhookDelivery).ID, nil
+					},
+				},
+				"createTime": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["create_time"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WebhookDelivery).CreateTime
+						return v, nil
+					},
+				},
+				"event": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["event"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WebhookDelivery).Event
+						return v, nil
+					},
+				},
+				"payload": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["payload"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WebhookDelivery).Payload
+						return v, nil
+					},
+				},
+				"status": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["status"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WebhookDelivery).Status
+						return string(v), nil
+					},
+				},
+				"attempts": &graphql.Field{
+					Type: graphql.NewNonNull(leaves["attempts"]),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WebhookDelivery).Attempts
+						return v, nil
+					},
+				},
+				"responseCode": &graphql.Field{
+					Type: leaves["response_code"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WebhookDelivery).ResponseCode
+						return v, nil
+					},
+				},
+				"responseBody": &graphql.Field{
+					Type: leaves["response_body"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WebhookDelivery).ResponseBody
+						return v, nil
+					},
+				},
+				"error": &graphql.Field{
+					Type: leaves["error"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WebhookDelivery).Error
+						return v, nil
+					},
+				},
+				"deliveredAt": &graphql.Field{
+					Type: leaves["delivered_at"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						v := p.Source.(*ent.WebhookDelivery).DeliveredAt
+						if v == nil {
+							return nil, nil
+						}
+						return *v, nil
+					},
+				},
+				"organization": &graphql.Field{
+					Type: s.objects["Organization"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "WebhookDelivery.organization", p.Source.(*ent.WebhookDelivery).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.WebhookDelivery.Query().
+								Where(webhookdelivery.IDIn(ids...)).
+								WithOrganization(func(q *ent.OrganizationQuery) {
+									q.Where(s.scopeOrganization(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Organization != nil {
+									values[n.ID] = n.Edges.Organization
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+				"webhook": &graphql.Field{
+					Type: s.objects["Webhook"],
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return s.load(p.Context, "WebhookDelivery.webhook", p.Source.(*ent.WebhookDelivery).ID, func(ctx context.Context, ids []int) (map[int]interface{}, error) {
+							nodes, err := s.client.WebhookDelivery.Query().
+								Where(webhookdelivery.IDIn(ids...)).
+								WithWebhook(func(q *ent.WebhookQuery) {
+									q.Where(s.scopeWebhook(ctx)...)
+								}).
+								All(ctx)
+							if err != nil {
+								return nil, err
+							}
+							values := make(map[int]interface{}, len(nodes))
+							for _, n := range nodes {
+								if n.Edges.Webhook != nil {
+									values[n.ID] = n.Edges.Webhook
+								}
+							}
+							return values, nil
+						}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	s.wheres["WebhookDelivery"] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "WebhookDeliveryWhereInput",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			where := s.wheres["WebhookDelivery"]
+			return graphql.InputObjectConfigFieldMap{
+				"not":                      &graphql.InputObjectFieldConfig{Type: where},
+				"and":                      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"or":                       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(where))},
+				"id":                       &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idNEQ":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idIn":                     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idNotIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"idGT":                     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idGTE":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLT":                     &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"idLTE":                    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+				"createTime":               &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeNEQ":            &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeNotIn":          &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["create_time"]))},
+				"createTimeGT":             &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeGTE":            &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLT":             &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"createTimeLTE":            &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+				"event":                    &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventNEQ":                 &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["event"]))},
+				"eventNotIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["event"]))},
+				"eventGT":                  &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventGTE":                 &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventLT":                  &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventLTE":                 &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventContains":            &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventHasPrefix":           &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventHasSuffix":           &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventEqualFold":           &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"eventContainsFold":        &graphql.InputObjectFieldConfig{Type: leaves["event"]},
+				"payload":                  &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadNEQ":               &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadIn":                &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["payload"]))},
+				"payloadNotIn":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["payload"]))},
+				"payloadGT":                &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadGTE":               &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadLT":                &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadLTE":               &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadContains":          &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadHasPrefix":         &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadHasSuffix":         &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadEqualFold":         &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"payloadContainsFold":      &graphql.InputObjectFieldConfig{Type: leaves["payload"]},
+				"status":                   &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+				"statusNEQ":                &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+				"statusIn":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["status"]))},
+				"statusNotIn":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["status"]))},
+				"attempts":                 &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsNEQ":              &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["attempts"]))},
+				"attemptsNotIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["attempts"]))},
+				"attemptsGT":               &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsGTE":              &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsLT":               &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"attemptsLTE":              &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+				"responseCode":             &graphql.InputObjectFieldConfig{Type: leaves["response_code"]},
+				"responseCodeNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["response_code"]},
+				"responseCodeIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["response_code"]))},
+				"responseCodeNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["response_code"]))},
+				"responseCodeGT":           &graphql.InputObjectFieldConfig{Type: leaves["response_code"]},
+				"responseCodeGTE":          &graphql.InputObjectFieldConfig{Type: leaves["response_code"]},
+				"responseCodeLT":           &graphql.InputObjectFieldConfig{Type: leaves["response_code"]},
+				"responseCodeLTE":          &graphql.InputObjectFieldConfig{Type: leaves["response_code"]},
+				"responseCodeIsNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"responseCodeNotNil":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"responseBody":             &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyNEQ":          &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyIn":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["response_body"]))},
+				"responseBodyNotIn":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["response_body"]))},
+				"responseBodyGT":           &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyGTE":          &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyLT":           &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyLTE":          &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyContains":     &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyHasPrefix":    &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyHasSuffix":    &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyIsNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"responseBodyNotNil":       &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"responseBodyEqualFold":    &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"responseBodyContainsFold": &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+				"error":                    &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorNEQ":                 &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorIn":                  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["error"]))},
+				"errorNotIn":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["error"]))},
+				"errorGT":                  &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorGTE":                 &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorLT":                  &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorLTE":                 &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorContains":            &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorHasPrefix":           &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorHasSuffix":           &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorIsNil":               &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"errorNotNil":              &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"errorEqualFold":           &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"errorContainsFold":        &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+				"deliveredAt":              &graphql.InputObjectFieldConfig{Type: leaves["delivered_at"]},
+				"deliveredAtNEQ":           &graphql.InputObjectFieldConfig{Type: leaves["delivered_at"]},
+				"deliveredAtIn":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["delivered_at"]))},
+				"deliveredAtNotIn":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(leaves["delivered_at"]))},
+				"deliveredAtGT":            &graphql.InputObjectFieldConfig{Type: leaves["delivered_at"]},
+				"deliveredAtGTE":           &graphql.InputObjectFieldConfig{Type: leaves["delivered_at"]},
+				"deliveredAtLT":            &graphql.InputObjectFieldConfig{Type: leaves["delivered_at"]},
+				"deliveredAtLTE":           &graphql.InputObjectFieldConfig{Type: leaves["delivered_at"]},
+				"deliveredAtIsNil":         &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"deliveredAtNotNil":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganization":          &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasOrganizationWith":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Organization"]))},
+				"hasWebhook":               &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+				"hasWebhookWith":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(s.wheres["Webhook"]))},
+			}
+		}),
+	})
+
+	query["webhookDelivery"] = &graphql.Field{
+		Type: s.objects["WebhookDelivery"],
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			n, err := s.client.WebhookDelivery.Query().
+				Where(webhookdelivery.ID(id)).
+				Where(s.scopeWebhookDelivery(p.Context)...).
+				Only(p.Context)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return n, err
+		},
+	}
+	query["webhookDeliveries"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.connection("WebhookDelivery")),
+		Args: connectionArgs(s.wheres["WebhookDelivery"]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			q := s.client.WebhookDelivery.Query().Where(s.scopeWebhookDelivery(p.Context)...)
+			if in, ok := p.Args["where"].(map[string]interface{}); ok {
+				ps, err := s.whereWebhookDelivery(in)
+				if err != nil {
+					return nil, err
+				}
+				q.Where(ps...)
+			}
+			pg, err := paginate(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			count := q.Clone()
+			if pg.after != nil {
+				q.Where(webhookdelivery.IDGT(*pg.after))
+			}
+			if pg.before != nil {
+				q.Where(webhookdelivery.IDLT(*pg.before))
+			}
+			if pg.last {
+				q.Order(ent.Desc(webhookdelivery.FieldID))
+			} else {
+				q.Order(ent.Asc(webhookdelivery.FieldID))
+			}
+			ns, err := q.Limit(pg.size + 1).All(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			nodes, ids := make([]interface{}, len(ns)), make([]int, len(ns))
+			for i, n := range ns {
+				nodes[i], ids[i] = n, n.ID
+			}
+			return pg.connection(nodes, ids, func() (int, error) {
+				return count.Count(p.Context)
+			}), nil
+		},
+	}
+
+	if !mutable {
+		return
+	}
+	create := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CreateWebhookDeliveryInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"createTime":     &graphql.InputObjectFieldConfig{Type: leaves["create_time"]},
+			"event":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["event"])},
+			"payload":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(leaves["payload"])},
+			"status":         &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+			"attempts":       &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+			"responseCode":   &graphql.InputObjectFieldConfig{Type: leaves["response_code"]},
+			"responseBody":   &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+			"error":          &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+			"deliveredAt":    &graphql.InputObjectFieldConfig{Type: leaves["delivered_at"]},
+			"organizationID": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"webhookID":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+	})
+	update := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "UpdateWebhookDeliveryInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"status":            &graphql.InputObjectFieldConfig{Type: leaves["status"]},
+			"attempts":          &graphql.InputObjectFieldConfig{Type: leaves["attempts"]},
+			"responseCode":      &graphql.InputObjectFieldConfig{Type: leaves["response_code"]},
+			"clearResponseCode": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"responseBody":      &graphql.InputObjectFieldConfig{Type: leaves["response_body"]},
+			"clearResponseBody": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"error":             &graphql.InputObjectFieldConfig{Type: leaves["error"]},
+			"clearError":        &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"deliveredAt":       &graphql.InputObjectFieldConfig{Type: leaves["delivered_at"]},
+			"clearDeliveredAt":  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"organizationID":    &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearOrganization": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"webhookID":         &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"clearWebhook":      &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	mutation["createWebhookDelivery"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["WebhookDelivery"]),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(create)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "createWebhookDelivery"); err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.WebhookDelivery.Create()
+			if v, ok := in["createTime"]; ok && v != nil {
+				c, err := convertWebhookDeliveryCreateTime(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetCreateTime(c)
+			}
+			if v, ok := in["event"]; ok && v != nil {
+				c, err := convertWebhookDeliveryEvent(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetEvent(c)
+			}
+			if v, ok := in["payload"]; ok && v != nil {
+				c, err := convertWebhookDeliveryPayload(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetPayload(c)
+			}
+			if v, ok := in["status"]; ok && v != nil {
+				c, err := convertWebhookDeliveryStatus(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetStatus(c)
+			}
+			if v, ok := in["attempts"]; ok && v != nil {
+				c, err := convertWebhookDeliveryAttempts(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAttempts(c)
+			}
+			if v, ok := in["responseCode"]; ok && v != nil {
+				c, err := convertWebhookDeliveryResponseCode(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseCode(c)
+			}
+			if v, ok := in["responseBody"]; ok && v != nil {
+				c, err := convertWebhookDeliveryResponseBody(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseBody(c)
+			}
+			if v, ok := in["error"]; ok && v != nil {
+				c, err := convertWebhookDeliveryError(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetError(c)
+			}
+			if v, ok := in["deliveredAt"]; ok && v != nil {
+				c, err := convertWebhookDeliveryDeliveredAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDeliveredAt(c)
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["webhookID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWebhookID(id)
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["updateWebhookDelivery"] = &graphql.Field{
+		Type: graphql.NewNonNull(s.objects["WebhookDelivery"]),
+		Args: graphql.FieldConfigArgument{
+			"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(update)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "updateWebhookDelivery"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			in := p.Args["input"].(map[string]interface{})
+			b := s.client.WebhookDelivery.UpdateOneID(id)
+			if v, ok := in["status"]; ok && v != nil {
+				c, err := convertWebhookDeliveryStatus(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetStatus(c)
+			}
+			if v, ok := in["attempts"]; ok && v != nil {
+				c, err := convertWebhookDeliveryAttempts(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetAttempts(c)
+			}
+			if v, ok := in["responseCode"]; ok && v != nil {
+				c, err := convertWebhookDeliveryResponseCode(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseCode(c)
+			}
+			if v, ok := in["clearResponseCode"]; ok && v == true {
+				b.ClearResponseCode()
+			}
+			if v, ok := in["responseBody"]; ok && v != nil {
+				c, err := convertWebhookDeliveryResponseBody(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetResponseBody(c)
+			}
+			if v, ok := in["clearResponseBody"]; ok && v == true {
+				b.ClearResponseBody()
+			}
+			if v, ok := in["error"]; ok && v != nil {
+				c, err := convertWebhookDeliveryError(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetError(c)
+			}
+			if v, ok := in["clearError"]; ok && v == true {
+				b.ClearError()
+			}
+			if v, ok := in["deliveredAt"]; ok && v != nil {
+				c, err := convertWebhookDeliveryDeliveredAt(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetDeliveredAt(c)
+			}
+			if v, ok := in["clearDeliveredAt"]; ok && v == true {
+				b.ClearDeliveredAt()
+			}
+			if v, ok := in["organizationID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetOrganizationID(id)
+			}
+			if v, ok := in["clearOrganization"]; ok && v == true {
+				b.ClearOrganization()
+			}
+			if v, ok := in["webhookID"]; ok && v != nil {
+				id, err := toID(v)
+				if err != nil {
+					return nil, err
+				}
+				b.SetWebhookID(id)
+			}
+			if v, ok := in["clearWebhook"]; ok && v == true {
+				b.ClearWebhook()
+			}
+			return b.Save(p.Context)
+		},
+	}
+	mutation["deleteWebhookDelivery"] = &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if err := s.authorize(p.Context, "deleteWebhookDelivery"); err != nil {
+				return nil, err
+			}
+			id, err := toID(p.Args["id"])
+			if err != nil {
+				return nil, err
+			}
+			if err := s.client.WebhookDelivery.DeleteOneID(id).Exec(p.Context); err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	}
+}
+
+// scopeWebhookDelivery returns the predicates narrowing the WebhookDelivery entities
+// that can be read.
+func (s *Schema) scopeWebhookDelivery(ctx context.Context) []predicate.WebhookDelivery {
+	if s.opts.Scopes.WebhookDelivery == nil {
+		return nil
+	}
+	return s.opts.Scopes.WebhookDelivery(ctx)
+}
+
+// whereWebhookDelivery returns the predicates of a WebhookDeliveryWhereInput.
+func (s *Schema) whereWebhookDelivery(in map[string]interface{}) ([]predicate.WebhookDelivery, error) {
+	var ps []predicate.WebhookDelivery
+	if v, ok := in["not"].(map[string]interface{}); ok {
+		not, err := s.whereWebhookDelivery(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(not) > 0 {
+			ps = append(ps, webhookdelivery.Not(webhookdelivery.And(not...)))
+		}
+	}
+	for _, op := range []string{"and", "or"} {
+		var group []predicate.WebhookDelivery
+		for _, v := range wheres(in[op]) {
+			sub, err := s.whereWebhookDelivery(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				group = append(group, webhookdelivery.And(sub...))
+			}
+		}
+		switch {
+		case len(group) == 0:
+		case op == "and":
+			ps = append(ps, webhookdelivery.And(group...))
+		default:
+			ps = append(ps, webhookdelivery.Or(group...))
+		}
+	}
+	if v, ok := in["id"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.IDEQ(ids))
+	}
+	if v, ok := in["idNEQ"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.IDNEQ(ids))
+	}
+	if v, ok := in["idIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.IDIn(ids...))
+	}
+	if v, ok := in["idNotIn"]; ok && v != nil {
+		ids, err := toIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.IDNotIn(ids...))
+	}
+	if v, ok := in["idGT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.IDGT(ids))
+	}
+	if v, ok := in["idGTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.IDGTE(ids))
+	}
+	if v, ok := in["idLT"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.IDLT(ids))
+	}
+	if v, ok := in["idLTE"]; ok && v != nil {
+		ids, err := toID(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.IDLTE(ids))
+	}
+	if v, ok := in["createTime"]; ok && v != nil {
+		c, err := convertWebhookDeliveryCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.CreateTimeEQ(c))
+	}
+	if v, ok := in["createTimeNEQ"]; ok && v != nil {
+		c, err := convertWebhookDeliveryCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.CreateTimeNEQ(c))
+	}
+	if v, ok := in["createTimeIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.CreateTimeIn(vs...))
+	}
+	if v, ok := in["createTimeNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryCreateTime(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.CreateTimeNotIn(vs...))
+	}
+	if v, ok := in["createTimeGT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.CreateTimeGT(c))
+	}
+	if v, ok := in["createTimeGTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.CreateTimeGTE(c))
+	}
+	if v, ok := in["createTimeLT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.CreateTimeLT(c))
+	}
+	if v, ok := in["createTimeLTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryCreateTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.CreateTimeLTE(c))
+	}
+	if v, ok := in["event"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventEQ(c))
+	}
+	if v, ok := in["eventNEQ"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventNEQ(c))
+	}
+	if v, ok := in["eventIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryEvent(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.EventIn(vs...))
+	}
+	if v, ok := in["eventNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryEvent(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.EventNotIn(vs...))
+	}
+	if v, ok := in["eventGT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventGT(c))
+	}
+	if v, ok := in["eventGTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventGTE(c))
+	}
+	if v, ok := in["eventLT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventLT(c))
+	}
+	if v, ok := in["eventLTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventLTE(c))
+	}
+	if v, ok := in["eventContains"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventContains(c))
+	}
+	if v, ok := in["eventHasPrefix"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventHasPrefix(c))
+	}
+	if v, ok := in["eventHasSuffix"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventHasSuffix(c))
+	}
+	if v, ok := in["eventEqualFold"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventEqualFold(c))
+	}
+	if v, ok := in["eventContainsFold"]; ok && v != nil {
+		c, err := convertWebhookDeliveryEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.EventContainsFold(c))
+	}
+	if v, ok := in["payload"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadEQ(c))
+	}
+	if v, ok := in["payloadNEQ"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadNEQ(c))
+	}
+	if v, ok := in["payloadIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryPayload(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.PayloadIn(vs...))
+	}
+	if v, ok := in["payloadNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryPayload(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.PayloadNotIn(vs...))
+	}
+	if v, ok := in["payloadGT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadGT(c))
+	}
+	if v, ok := in["payloadGTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadGTE(c))
+	}
+	if v, ok := in["payloadLT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadLT(c))
+	}
+	if v, ok := in["payloadLTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadLTE(c))
+	}
+	if v, ok := in["payloadContains"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadContains(c))
+	}
+	if v, ok := in["payloadHasPrefix"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadHasPrefix(c))
+	}
+	if v, ok := in["payloadHasSuffix"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadHasSuffix(c))
+	}
+	if v, ok := in["payloadEqualFold"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadEqualFold(c))
+	}
+	if v, ok := in["payloadContainsFold"]; ok && v != nil {
+		c, err := convertWebhookDeliveryPayload(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.PayloadContainsFold(c))
+	}
+	if v, ok := in["status"]; ok && v != nil {
+		c, err := convertWebhookDeliveryStatus(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.StatusEQ(c))
+	}
+	if v, ok := in["statusNEQ"]; ok && v != nil {
+		c, err := convertWebhookDeliveryStatus(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.StatusNEQ(c))
+	}
+	if v, ok := in["statusIn"].([]interface{}); ok {
+		vs := make([]webhookdelivery.Status, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryStatus(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.StatusIn(vs...))
+	}
+	if v, ok := in["statusNotIn"].([]interface{}); ok {
+		vs := make([]webhookdelivery.Status, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryStatus(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.StatusNotIn(vs...))
+	}
+	if v, ok := in["attempts"]; ok && v != nil {
+		c, err := convertWebhookDeliveryAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.AttemptsEQ(c))
+	}
+	if v, ok := in["attemptsNEQ"]; ok && v != nil {
+		c, err := convertWebhookDeliveryAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.AttemptsNEQ(c))
+	}
+	if v, ok := in["attemptsIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryAttempts(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.AttemptsIn(vs...))
+	}
+	if v, ok := in["attemptsNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryAttempts(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.AttemptsNotIn(vs...))
+	}
+	if v, ok := in["attemptsGT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.AttemptsGT(c))
+	}
+	if v, ok := in["attemptsGTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.AttemptsGTE(c))
+	}
+	if v, ok := in["attemptsLT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.AttemptsLT(c))
+	}
+	if v, ok := in["attemptsLTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryAttempts(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.AttemptsLTE(c))
+	}
+	if v, ok := in["responseCode"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseCode(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseCodeEQ(c))
+	}
+	if v, ok := in["responseCodeNEQ"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseCode(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseCodeNEQ(c))
+	}
+	if v, ok := in["responseCodeIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryResponseCode(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.ResponseCodeIn(vs...))
+	}
+	if v, ok := in["responseCodeNotIn"].([]interface{}); ok {
+		vs := make([]int, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryResponseCode(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.ResponseCodeNotIn(vs...))
+	}
+	if v, ok := in["responseCodeGT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseCode(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseCodeGT(c))
+	}
+	if v, ok := in["responseCodeGTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseCode(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseCodeGTE(c))
+	}
+	if v, ok := in["responseCodeLT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseCode(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseCodeLT(c))
+	}
+	if v, ok := in["responseCodeLTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseCode(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseCodeLTE(c))
+	}
+	if v, ok := in["responseCodeIsNil"]; ok && v == true {
+		ps = append(ps, webhookdelivery.ResponseCodeIsNil())
+	}
+	if v, ok := in["responseCodeNotNil"]; ok && v == true {
+		ps = append(ps, webhookdelivery.ResponseCodeNotNil())
+	}
+	if v, ok := in["responseBody"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyEQ(c))
+	}
+	if v, ok := in["responseBodyNEQ"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyNEQ(c))
+	}
+	if v, ok := in["responseBodyIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryResponseBody(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyIn(vs...))
+	}
+	if v, ok := in["responseBodyNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryResponseBody(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyNotIn(vs...))
+	}
+	if v, ok := in["responseBodyGT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyGT(c))
+	}
+	if v, ok := in["responseBodyGTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyGTE(c))
+	}
+	if v, ok := in["responseBodyLT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyLT(c))
+	}
+	if v, ok := in["responseBodyLTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyLTE(c))
+	}
+	if v, ok := in["responseBodyContains"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyContains(c))
+	}
+	if v, ok := in["responseBodyHasPrefix"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyHasPrefix(c))
+	}
+	if v, ok := in["responseBodyHasSuffix"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyHasSuffix(c))
+	}
+	if v, ok := in["responseBodyIsNil"]; ok && v == true {
+		ps = append(ps, webhookdelivery.ResponseBodyIsNil())
+	}
+	if v, ok := in["responseBodyNotNil"]; ok && v == true {
+		ps = append(ps, webhookdelivery.ResponseBodyNotNil())
+	}
+	if v, ok := in["responseBodyEqualFold"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyEqualFold(c))
+	}
+	if v, ok := in["responseBodyContainsFold"]; ok && v != nil {
+		c, err := convertWebhookDeliveryResponseBody(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ResponseBodyContainsFold(c))
+	}
+	if v, ok := in["error"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorEQ(c))
+	}
+	if v, ok := in["errorNEQ"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorNEQ(c))
+	}
+	if v, ok := in["errorIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryError(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.ErrorIn(vs...))
+	}
+	if v, ok := in["errorNotIn"].([]interface{}); ok {
+		vs := make([]string, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryError(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.ErrorNotIn(vs...))
+	}
+	if v, ok := in["errorGT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorGT(c))
+	}
+	if v, ok := in["errorGTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorGTE(c))
+	}
+	if v, ok := in["errorLT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorLT(c))
+	}
+	if v, ok := in["errorLTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorLTE(c))
+	}
+	if v, ok := in["errorContains"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorContains(c))
+	}
+	if v, ok := in["errorHasPrefix"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorHasPrefix(c))
+	}
+	if v, ok := in["errorHasSuffix"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorHasSuffix(c))
+	}
+	if v, ok := in["errorIsNil"]; ok && v == true {
+		ps = append(ps, webhookdelivery.ErrorIsNil())
+	}
+	if v, ok := in["errorNotNil"]; ok && v == true {
+		ps = append(ps, webhookdelivery.ErrorNotNil())
+	}
+	if v, ok := in["errorEqualFold"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorEqualFold(c))
+	}
+	if v, ok := in["errorContainsFold"]; ok && v != nil {
+		c, err := convertWebhookDeliveryError(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.ErrorContainsFold(c))
+	}
+	if v, ok := in["deliveredAt"]; ok && v != nil {
+		c, err := convertWebhookDeliveryDeliveredAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.DeliveredAtEQ(c))
+	}
+	if v, ok := in["deliveredAtNEQ"]; ok && v != nil {
+		c, err := convertWebhookDeliveryDeliveredAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.DeliveredAtNEQ(c))
+	}
+	if v, ok := in["deliveredAtIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryDeliveredAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.DeliveredAtIn(vs...))
+	}
+	if v, ok := in["deliveredAtNotIn"].([]interface{}); ok {
+		vs := make([]time.Time, len(v))
+		for i := range v {
+			c, err := convertWebhookDeliveryDeliveredAt(v[i])
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = c
+		}
+		ps = append(ps, webhookdelivery.DeliveredAtNotIn(vs...))
+	}
+	if v, ok := in["deliveredAtGT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryDeliveredAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.DeliveredAtGT(c))
+	}
+	if v, ok := in["deliveredAtGTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryDeliveredAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.DeliveredAtGTE(c))
+	}
+	if v, ok := in["deliveredAtLT"]; ok && v != nil {
+		c, err := convertWebhookDeliveryDeliveredAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.DeliveredAtLT(c))
+	}
+	if v, ok := in["deliveredAtLTE"]; ok && v != nil {
+		c, err := convertWebhookDeliveryDeliveredAt(v)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, webhookdelivery.DeliveredAtLTE(c))
+	}
+	if v, ok := in["deliveredAtIsNil"]; ok && v == true {
+		ps = append(ps, webhookdelivery.DeliveredAtIsNil())
+	}
+	if v, ok := in["deliveredAtNotNil"]; ok && v == true {
+		ps = append(ps, webhookdelivery.DeliveredAtNotNil())
+	}
+	if v, ok := in["hasOrganization"].(bool); ok {
+		if v {
+			ps = append(ps, webhookdelivery.HasOrganization())
+		} else {
+			ps = append(ps, webhookdelivery.Not(webhookdelivery.HasOrganization()))
+		}
+	}
+	if v, ok := in["hasOrganizationWith"]; ok && v != nil {
+		var with []predicate.Organization
+		for _, v := range wheres(v) {
+			sub, err := s.whereOrganization(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, webhookdelivery.HasOrganizationWith(with...))
+	}
+	if v, ok := in["hasWebhook"].(bool); ok {
+		if v {
+			ps = append(ps, webhookdelivery.HasWebhook())
+		} else {
+			ps = append(ps, webhookdelivery.Not(webhookdelivery.HasWebhook()))
+		}
+	}
+	if v, ok := in["hasWebhookWith"]; ok && v != nil {
+		var with []predicate.Webhook
+		for _, v := range wheres(v) {
+			sub, err := s.whereWebhook(v)
+			if err != nil {
+				return nil, err
+			}
+			with = append(with, sub...)
+		}
+		ps = append(ps, webhookdelivery.HasWebhookWith(with...))
+	}
+	return ps, nil
+}
+
+// convertWebhookDeliveryCreateTime returns the create_time of WebhookDelivery of an argument.
+func convertWebhookDeliveryCreateTime(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: createTime: %v is not a time", v)
+	}
+	return t, nil
+}
+
+// convertWebhookDeliveryEvent returns the event of WebhookDelivery of an argument.
+func convertWebhookDeliveryEvent(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertWebhookDeliveryPayload returns the payload of WebhookDelivery of an argument.
+func convertWebhookDeliveryPayload(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertWebhookDeliveryStatus returns the status of WebhookDelivery of an argument.
+func convertWebhookDeliveryStatus(v interface{}) (webhookdelivery.Status, error) {
+	s, _ := v.(string)
+	return webhookdelivery.Status(s), nil
+}
+
+// convertWebhookDeliveryAttempts returns the attempts of WebhookDelivery of an argument.
+func convertWebhookDeliveryAttempts(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// convertWebhookDeliveryResponseCode returns the response_code of WebhookDelivery of an argument.
+func convertWebhookDeliveryResponseCode(v interface{}) (int, error) {
+	n, _ := v.(int)
+	return int(n), nil
+}
+
+// convertWebhookDeliveryResponseBody returns the response_body of WebhookDelivery of an argument.
+func convertWebhookDeliveryResponseBody(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertWebhookDeliveryError returns the error of WebhookDelivery of an argument.
+func convertWebhookDeliveryError(v interface{}) (string, error) {
+	s, _ := v.(string)
+	return s, nil
+}
+
+// convertWebhookDeliveryDeliveredAt returns the delivered_at of WebhookDelivery of an argument.
+func convertWebhookDeliveryDeliveredAt(v interface{}) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gql: deliveredAt: %v is not a time", v)
+	}
+	return t, nil
+}
@@ -0,0 +1,146 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/holiday"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Holiday is the model entity for the Holiday schema.
+type Holiday struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HolidayQuery when eager-loading is set.
+	Edges           HolidayEdges `json:"edges"`
+	organization_id *int
+}
+
+// HolidayEdges holds the relations/edges for other nodes in the graph.
+type HolidayEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HolidayEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Holiday) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // date
+		&sql.NullString{}, // name
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Holiday) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Holiday fields.
+func (h *Holiday) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(holiday.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	h.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field date", values[0])
+	} else if value.Valid {
+		h.Date = value.Time
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[1])
+	} else if value.Valid {
+		h.Name = value.String
+	}
+	values = values[2:]
+	if len(values) == len(holiday.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			h.organization_id = new(int)
+			*h.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Holiday.
+func (h *Holiday) QueryOrganization() *OrganizationQuery {
+	return (&HolidayClient{config: h.config}).QueryOrganization(h)
+}
+
+// Update returns a builder for updating this Holiday.
+// Note that, you need to call Holiday.Unwrap() before calling this method, if this Holiday
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (h *Holiday) Update() *HolidayUpdateOne {
+	return (&HolidayClient{config: h.config}).UpdateOne(h)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (h *Holiday) Unwrap() *Holiday {
+	tx, ok := h.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Holiday is not a transactional entity")
+	}
+	h.config.driver = tx.drv
+	return h
+}
+
+// String implements the fmt.Stringer.
+func (h *Holiday) String() string {
+	var builder strings.Builder
+	builder.WriteString("Holiday(")
+	builder.WriteString(fmt.Sprintf("id=%v", h.ID))
+	builder.WriteString(", date=")
+	builder.WriteString(h.Date.Format(time.ANSIC))
+	builder.WriteString(", name=")
+	builder.WriteString(h.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Holidays is a parsable slice of Holiday.
+type Holidays []*Holiday
+
+func (h Holidays) config(cfg config) {
+	for _i := range h {
+		h[_i].config = cfg
+	}
+}
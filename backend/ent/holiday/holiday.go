@@ -0,0 +1,55 @@
+// Code generated by entc, DO NOT EDIT.
+
+package holiday
+
+import (
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the holiday type in the database.
+	Label = "holiday"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+
+	// Table holds the table name of the holiday in the database.
+	Table = "holidays"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "holidays"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+)
+
+// Columns holds all SQL columns for holiday fields.
+var Columns = []string{
+	FieldID,
+	FieldDate,
+	FieldName,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Holiday type.
+var ForeignKeys = []string{
+	"organization_id",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
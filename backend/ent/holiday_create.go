@@ -0,0 +1,169 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/holiday"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// HolidayCreate is the builder for creating a Holiday entity.
+type HolidayCreate struct {
+	config
+	mutation *HolidayMutation
+	hooks    []Hook
+}
+
+// SetDate sets the date field.
+func (hc *HolidayCreate) SetDate(t time.Time) *HolidayCreate {
+	hc.mutation.SetDate(t)
+	return hc
+}
+
+// SetName sets the name field.
+func (hc *HolidayCreate) SetName(s string) *HolidayCreate {
+	hc.mutation.SetName(s)
+	return hc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (hc *HolidayCreate) SetOrganizationID(id int) *HolidayCreate {
+	hc.mutation.SetOrganizationID(id)
+	return hc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (hc *HolidayCreate) SetNillableOrganizationID(id *int) *HolidayCreate {
+	if id != nil {
+		hc = hc.SetOrganizationID(*id)
+	}
+	return hc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (hc *HolidayCreate) SetOrganization(o *Organization) *HolidayCreate {
+	return hc.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (hc *HolidayCreate) Mutation() *HolidayMutation {
+	return hc.mutation
+}
+
+// Save creates the Holiday in the database.
+func (hc *HolidayCreate) Save(ctx context.Context) (*Holiday, error) {
+	if _, ok := hc.mutation.Date(); !ok {
+		return nil, &ValidationError{Name: "date", err: errors.New("ent: missing required field \"date\"")}
+	}
+	if _, ok := hc.mutation.Name(); !ok {
+		return nil, &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := hc.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	var (
+		err  error
+		node *Holiday
+	)
+	if len(hc.hooks) == 0 {
+		node, err = hc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*HolidayMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			hc.mutation = mutation
+			node, err = hc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(hc.hooks) - 1; i >= 0; i-- {
+			mut = hc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, hc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hc *HolidayCreate) SaveX(ctx context.Context) *Holiday {
+	v, err := hc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (hc *HolidayCreate) sqlSave(ctx context.Context) (*Holiday, error) {
+	h, _spec := hc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	h.ID = int(id)
+	return h, nil
+}
+
+func (hc *HolidayCreate) createSpec() (*Holiday, *sqlgraph.CreateSpec) {
+	var (
+		h     = &Holiday{config: hc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: holiday.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: holiday.FieldID,
+			},
+		}
+	)
+	if value, ok := hc.mutation.Date(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: holiday.FieldDate,
+		})
+		h.Date = value
+	}
+	if value, ok := hc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: holiday.FieldName,
+		})
+		h.Name = value
+	}
+	if nodes := hc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return h, _spec
+}
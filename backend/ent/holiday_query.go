@@ -0,0 +1,943 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/holiday"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// HolidayQuery is the builder for querying Holiday entities.
+type HolidayQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.Holiday
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (hq *HolidayQuery) Where(ps ...predicate.Holiday) *HolidayQuery {
+	hq.predicates = append(hq.predicates, ps...)
+	return hq
+}
+
+// Limit adds a limit step to the query.
+func (hq *HolidayQuery) Limit(limit int) *HolidayQuery {
+	hq.limit = &limit
+	return hq
+}
+
+// Offset adds an offset step to the query.
+func (hq *HolidayQuery) Offset(offset int) *HolidayQuery {
+	hq.offset = &offset
+	return hq
+}
+
+// Order adds an order step to the query.
+func (hq *HolidayQuery) Order(o ...OrderFunc) *HolidayQuery {
+	hq.order = append(hq.order, o...)
+	return hq
+}
+
+// QueryOrganization chains the current query on the organization edge.
+func (hq *HolidayQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: hq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(holiday.Table, holiday.FieldID, hq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, holiday.OrganizationTable, holiday.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Holiday entity in the query. Returns *NotFoundError when no holiday was found.
+func (hq *HolidayQuery) First(ctx context.Context) (*Holiday, error) {
+	hs, err := hq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(hs) == 0 {
+		return nil, &NotFoundError{holiday.Label}
+	}
+	return hs[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hq *HolidayQuery) FirstX(ctx context.Context) *Holiday {
+	h, err := hq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return h
+}
+
+// FirstID returns the first Holiday id in the query. Returns *NotFoundError when no id was found.
+func (hq *HolidayQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{holiday.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (hq *HolidayQuery) FirstXID(ctx context.Context) int {
+	id, err := hq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Holiday entity in the query, returns an error if not exactly one entity was returned.
+func (hq *HolidayQuery) Only(ctx context.Context) (*Holiday, error) {
+	hs, err := hq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(hs) {
+	case 1:
+		return hs[0], nil
+	case 0:
+		return nil, &NotFoundError{holiday.Label}
+	default:
+		return nil, &NotSingularError{holiday.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hq *HolidayQuery) OnlyX(ctx context.Context) *Holiday {
+	h, err := hq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// OnlyID returns the only Holiday id in the query, returns an error if not exactly one id was returned.
+func (hq *HolidayQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{holiday.Label}
+	default:
+		err = &NotSingularError{holiday.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hq *HolidayQuery) OnlyIDX(ctx context.Context) int {
+	id, err := hq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Holidays.
+func (hq *HolidayQuery) All(ctx context.Context) ([]*Holiday, error) {
+	if err := hq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return hq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hq *HolidayQuery) AllX(ctx context.Context) []*Holiday {
+	hs, err := hq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return hs
+}
+
+// IDs executes the query and returns a list of Holiday ids.
+func (hq *HolidayQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := hq.Select(holiday.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hq *HolidayQuery) IDsX(ctx context.Context) []int {
+	ids, err := hq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hq *HolidayQuery) Count(ctx context.Context) (int, error) {
+	if err := hq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return hq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hq *HolidayQuery) CountX(ctx context.Context) int {
+	count, err := hq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hq *HolidayQuery) Exist(ctx context.Context) (bool, error) {
+	if err := hq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return hq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hq *HolidayQuery) ExistX(ctx context.Context) bool {
+	exist, err := hq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hq *HolidayQuery) Clone() *HolidayQuery {
+	return &HolidayQuery{
+		config:     hq.config,
+		limit:      hq.limit,
+		offset:     hq.offset,
+		order:      append([]OrderFunc{}, hq.order...),
+		unique:     append([]string{}, hq.unique...),
+		predicates: append([]predicate.Holiday{}, hq.predicates...),
+		// clone intermediate query.
+		sql:  hq.sql.Clone(),
+		path: hq.path,
+	}
+}
+
+//	WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (hq *HolidayQuery) WithOrganization(opts ...func(*OrganizationQuery)) *HolidayQuery {
+	query := &OrganizationQuery{config: hq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	hq.withOrganization = query
+	return hq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Date time.Time `json:"date,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Holiday.Query().
+//		GroupBy(holiday.FieldDate).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (hq *HolidayQuery) GroupBy(field string, fields ...string) *HolidayGroupBy {
+	group := &HolidayGroupBy{config: hq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return hq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Date time.Time `json:"date,omitempty"`
+//	}
+//
+//	client.Holiday.Query().
+//		Select(holiday.FieldDate).
+//		Scan(ctx, &v)
+//
+func (hq *HolidayQuery) Select(field string, fields ...string) *HolidaySelect {
+	selector := &HolidaySelect{config: hq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return hq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (hq *HolidayQuery) prepareQuery(ctx context.Context) error {
+	if hq.path != nil {
+		prev, err := hq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hq.sql = prev
+	}
+	if err := holiday.Policy.EvalQuery(ctx, hq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (hq *HolidayQuery) sqlAll(ctx context.Context) ([]*Holiday, error) {
+	var (
+		nodes       = []*Holiday{}
+		withFKs     = hq.withFKs
+		_spec       = hq.querySpec()
+		loadedTypes = [1]bool{
+			hq.withOrganization != nil,
+		}
+	)
+	if hq.withOrganization != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, holiday.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &Holiday{config: hq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := hq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Holiday)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (hq *HolidayQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hq.querySpec()
+	return sqlgraph.CountNodes(ctx, hq.driver, _spec)
+}
+
+func (hq *HolidayQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := hq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (hq *HolidayQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   holiday.Table,
+			Columns: holiday.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: holiday.FieldID,
+			},
+		},
+		From:   hq.sql,
+		Unique: true,
+	}
+	if ps := hq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hq *HolidayQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(hq.driver.Dialect())
+	t1 := builder.Table(holiday.Table)
+	selector := builder.Select(t1.Columns(holiday.Columns...)...).From(t1)
+	if hq.sql != nil {
+		selector = hq.sql
+		selector.Select(selector.Columns(holiday.Columns...)...)
+	}
+	for _, p := range hq.predicates {
+		p(selector)
+	}
+	for _, p := range hq.order {
+		p(selector)
+	}
+	if offset := hq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// HolidayGroupBy is the builder for group-by Holiday entities.
+type HolidayGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (hgb *HolidayGroupBy) Aggregate(fns ...AggregateFunc) *HolidayGroupBy {
+	hgb.fns = append(hgb.fns, fns...)
+	return hgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (hgb *HolidayGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := hgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	hgb.sql = query
+	return hgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (hgb *HolidayGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := hgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (hgb *HolidayGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(hgb.fields) > 1 {
+		return nil, errors.New("ent: HolidayGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := hgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (hgb *HolidayGroupBy) StringsX(ctx context.Context) []string {
+	v, err := hgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (hgb *HolidayGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = hgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{holiday.Label}
+	default:
+		err = fmt.Errorf("ent: HolidayGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (hgb *HolidayGroupBy) StringX(ctx context.Context) string {
+	v, err := hgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (hgb *HolidayGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(hgb.fields) > 1 {
+		return nil, errors.New("ent: HolidayGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := hgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (hgb *HolidayGroupBy) IntsX(ctx context.Context) []int {
+	v, err := hgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (hgb *HolidayGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = hgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{holiday.Label}
+	default:
+		err = fmt.Errorf("ent: HolidayGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (hgb *HolidayGroupBy) IntX(ctx context.Context) int {
+	v, err := hgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (hgb *HolidayGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(hgb.fields) > 1 {
+		return nil, errors.New("ent: HolidayGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := hgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (hgb *HolidayGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := hgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (hgb *HolidayGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = hgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{holiday.Label}
+	default:
+		err = fmt.Errorf("ent: HolidayGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (hgb *HolidayGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := hgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (hgb *HolidayGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(hgb.fields) > 1 {
+		return nil, errors.New("ent: HolidayGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := hgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (hgb *HolidayGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := hgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (hgb *HolidayGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = hgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{holiday.Label}
+	default:
+		err = fmt.Errorf("ent: HolidayGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (hgb *HolidayGroupBy) BoolX(ctx context.Context) bool {
+	v, err := hgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (hgb *HolidayGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := hgb.sqlQuery().Query()
+	if err := hgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (hgb *HolidayGroupBy) sqlQuery() *sql.Selector {
+	selector := hgb.sql
+	columns := make([]string, 0, len(hgb.fields)+len(hgb.fns))
+	columns = append(columns, hgb.fields...)
+	for _, fn := range hgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(hgb.fields...)
+}
+
+// HolidaySelect is the builder for select fields of Holiday entities.
+type HolidaySelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (hs *HolidaySelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := hs.path(ctx)
+	if err != nil {
+		return err
+	}
+	hs.sql = query
+	return hs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (hs *HolidaySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := hs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (hs *HolidaySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(hs.fields) > 1 {
+		return nil, errors.New("ent: HolidaySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := hs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (hs *HolidaySelect) StringsX(ctx context.Context) []string {
+	v, err := hs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (hs *HolidaySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = hs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{holiday.Label}
+	default:
+		err = fmt.Errorf("ent: HolidaySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (hs *HolidaySelect) StringX(ctx context.Context) string {
+	v, err := hs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (hs *HolidaySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(hs.fields) > 1 {
+		return nil, errors.New("ent: HolidaySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := hs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (hs *HolidaySelect) IntsX(ctx context.Context) []int {
+	v, err := hs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (hs *HolidaySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = hs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{holiday.Label}
+	default:
+		err = fmt.Errorf("ent: HolidaySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (hs *HolidaySelect) IntX(ctx context.Context) int {
+	v, err := hs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (hs *HolidaySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(hs.fields) > 1 {
+		return nil, errors.New("ent: HolidaySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := hs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (hs *HolidaySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := hs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (hs *HolidaySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = hs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{holiday.Label}
+	default:
+		err = fmt.Errorf("ent: HolidaySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (hs *HolidaySelect) Float64X(ctx context.Context) float64 {
+	v, err := hs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (hs *HolidaySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(hs.fields) > 1 {
+		return nil, errors.New("ent: HolidaySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := hs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (hs *HolidaySelect) BoolsX(ctx context.Context) []bool {
+	v, err := hs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (hs *HolidaySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = hs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{holiday.Label}
+	default:
+		err = fmt.Errorf("ent: HolidaySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (hs *HolidaySelect) BoolX(ctx context.Context) bool {
+	v, err := hs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (hs *HolidaySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := hs.sqlQuery().Query()
+	if err := hs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (hs *HolidaySelect) sqlQuery() sql.Querier {
+	selector := hs.sql
+	selector.Select(selector.Columns(hs.fields...)...)
+	return selector
+}
@@ -0,0 +1,392 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/holiday"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// HolidayUpdate is the builder for updating Holiday entities.
+type HolidayUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *HolidayMutation
+	predicates []predicate.Holiday
+}
+
+// Where adds a new predicate for the builder.
+func (hu *HolidayUpdate) Where(ps ...predicate.Holiday) *HolidayUpdate {
+	hu.predicates = append(hu.predicates, ps...)
+	return hu
+}
+
+// SetDate sets the date field.
+func (hu *HolidayUpdate) SetDate(t time.Time) *HolidayUpdate {
+	hu.mutation.SetDate(t)
+	return hu
+}
+
+// SetName sets the name field.
+func (hu *HolidayUpdate) SetName(s string) *HolidayUpdate {
+	hu.mutation.SetName(s)
+	return hu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (hu *HolidayUpdate) SetOrganizationID(id int) *HolidayUpdate {
+	hu.mutation.SetOrganizationID(id)
+	return hu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (hu *HolidayUpdate) SetNillableOrganizationID(id *int) *HolidayUpdate {
+	if id != nil {
+		hu = hu.SetOrganizationID(*id)
+	}
+	return hu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (hu *HolidayUpdate) SetOrganization(o *Organization) *HolidayUpdate {
+	return hu.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (hu *HolidayUpdate) Mutation() *HolidayMutation {
+	return hu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (hu *HolidayUpdate) ClearOrganization() *HolidayUpdate {
+	hu.mutation.ClearOrganization()
+	return hu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (hu *HolidayUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := hu.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return 0, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(hu.hooks) == 0 {
+		affected, err = hu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*HolidayMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			hu.mutation = mutation
+			affected, err = hu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(hu.hooks) - 1; i >= 0; i-- {
+			mut = hu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, hu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hu *HolidayUpdate) SaveX(ctx context.Context) int {
+	affected, err := hu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hu *HolidayUpdate) Exec(ctx context.Context) error {
+	_, err := hu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hu *HolidayUpdate) ExecX(ctx context.Context) {
+	if err := hu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (hu *HolidayUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   holiday.Table,
+			Columns: holiday.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: holiday.FieldID,
+			},
+		},
+	}
+	if ps := hu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hu.mutation.Date(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: holiday.FieldDate,
+		})
+	}
+	if value, ok := hu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: holiday.FieldName,
+		})
+	}
+	if hu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// HolidayUpdateOne is the builder for updating a single Holiday entity.
+type HolidayUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *HolidayMutation
+}
+
+// SetDate sets the date field.
+func (huo *HolidayUpdateOne) SetDate(t time.Time) *HolidayUpdateOne {
+	huo.mutation.SetDate(t)
+	return huo
+}
+
+// SetName sets the name field.
+func (huo *HolidayUpdateOne) SetName(s string) *HolidayUpdateOne {
+	huo.mutation.SetName(s)
+	return huo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (huo *HolidayUpdateOne) SetOrganizationID(id int) *HolidayUpdateOne {
+	huo.mutation.SetOrganizationID(id)
+	return huo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (huo *HolidayUpdateOne) SetNillableOrganizationID(id *int) *HolidayUpdateOne {
+	if id != nil {
+		huo = huo.SetOrganizationID(*id)
+	}
+	return huo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (huo *HolidayUpdateOne) SetOrganization(o *Organization) *HolidayUpdateOne {
+	return huo.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (huo *HolidayUpdateOne) Mutation() *HolidayMutation {
+	return huo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (huo *HolidayUpdateOne) ClearOrganization() *HolidayUpdateOne {
+	huo.mutation.ClearOrganization()
+	return huo
+}
+
+// Save executes the query and returns the updated entity.
+func (huo *HolidayUpdateOne) Save(ctx context.Context) (*Holiday, error) {
+	if v, ok := huo.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+
+	var (
+		err  error
+		node *Holiday
+	)
+	if len(huo.hooks) == 0 {
+		node, err = huo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*HolidayMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			huo.mutation = mutation
+			node, err = huo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(huo.hooks) - 1; i >= 0; i-- {
+			mut = huo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, huo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (huo *HolidayUpdateOne) SaveX(ctx context.Context) *Holiday {
+	h, err := huo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// Exec executes the query on the entity.
+func (huo *HolidayUpdateOne) Exec(ctx context.Context) error {
+	_, err := huo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (huo *HolidayUpdateOne) ExecX(ctx context.Context) {
+	if err := huo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (huo *HolidayUpdateOne) sqlSave(ctx context.Context) (h *Holiday, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   holiday.Table,
+			Columns: holiday.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: holiday.FieldID,
+			},
+		},
+	}
+	id, ok := huo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Holiday.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := huo.mutation.Date(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: holiday.FieldDate,
+		})
+	}
+	if value, ok := huo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: holiday.FieldName,
+		})
+	}
+	if huo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	h = &Holiday{config: huo.config}
+	_spec.Assign = h.assignValues
+	_spec.ScanValues = h.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, huo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return h, nil
+}
@@ -0,0 +1,394 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind invoice.Kind `json:"kind,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Subtotal holds the value of the "subtotal" field.
+	Subtotal money.Amount `json:"subtotal"`
+	// Discount holds the value of the "discount" field.
+	Discount money.Amount `json:"discount"`
+	// VatRate holds the value of the "vat_rate" field.
+	VatRate money.Rate `json:"vat_rate"`
+	// Vat holds the value of the "vat" field.
+	Vat money.Amount `json:"vat"`
+	// Total holds the value of the "total" field.
+	Total money.Amount `json:"total"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// PaymentStatus holds the value of the "payment_status" field.
+	PaymentStatus invoice.PaymentStatus `json:"payment_status,omitempty"`
+	// AmountPaid holds the value of the "amount_paid" field.
+	AmountPaid money.Amount `json:"amount_paid"`
+	// AmountCredited holds the value of the "amount_credited" field.
+	AmountCredited money.Amount `json:"amount_credited"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceQuery when eager-loading is set.
+	Edges                InvoiceEdges `json:"edges"`
+	organization_id      *int
+	invoice_credit_notes *int
+	repair_slip_invoice  *int
+	user_invoices        *int
+}
+
+// InvoiceEdges holds the relations/edges for other nodes in the graph.
+type InvoiceEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// RepairSlip holds the value of the repair_slip edge.
+	RepairSlip *RepairSlip
+	// Customer holds the value of the customer edge.
+	Customer *User
+	// Lines holds the value of the lines edge.
+	Lines []*InvoiceLine
+	// Original holds the value of the original edge.
+	Original *Invoice
+	// CreditNotes holds the value of the credit_notes edge.
+	CreditNotes []*Invoice
+	// Payments holds the value of the payments edge.
+	Payments []*Payment
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// RepairSlipOrErr returns the RepairSlip value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) RepairSlipOrErr() (*RepairSlip, error) {
+	if e.loadedTypes[1] {
+		if e.RepairSlip == nil {
+			// The edge repair_slip was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: repairslip.Label}
+		}
+		return e.RepairSlip, nil
+	}
+	return nil, &NotLoadedError{edge: "repair_slip"}
+}
+
+// CustomerOrErr returns the Customer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) CustomerOrErr() (*User, error) {
+	if e.loadedTypes[2] {
+		if e.Customer == nil {
+			// The edge customer was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Customer, nil
+	}
+	return nil, &NotLoadedError{edge: "customer"}
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) LinesOrErr() ([]*InvoiceLine, error) {
+	if e.loadedTypes[3] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// OriginalOrErr returns the Original value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) OriginalOrErr() (*Invoice, error) {
+	if e.loadedTypes[4] {
+		if e.Original == nil {
+			// The edge original was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: invoice.Label}
+		}
+		return e.Original, nil
+	}
+	return nil, &NotLoadedError{edge: "original"}
+}
+
+// CreditNotesOrErr returns the CreditNotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) CreditNotesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[5] {
+		return e.CreditNotes, nil
+	}
+	return nil, &NotLoadedError{edge: "credit_notes"}
+}
+
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) PaymentsOrErr() ([]*Payment, error) {
+	if e.loadedTypes[6] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invoice) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullString{}, // number
+		&sql.NullString{}, // kind
+		&sql.NullString{}, // currency
+		&sql.NullInt64{},  // subtotal
+		&sql.NullInt64{},  // discount
+		&sql.NullInt64{},  // vat_rate
+		&sql.NullInt64{},  // vat
+		&sql.NullInt64{},  // total
+		&sql.NullString{}, // reason
+		&sql.NullString{}, // payment_status
+		&sql.NullInt64{},  // amount_paid
+		&sql.NullInt64{},  // amount_credited
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Invoice) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+		&sql.NullInt64{}, // invoice_credit_notes
+		&sql.NullInt64{}, // repair_slip_invoice
+		&sql.NullInt64{}, // user_invoices
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields.
+func (i *Invoice) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(invoice.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	i.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		i.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field number", values[1])
+	} else if value.Valid {
+		i.Number = value.String
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field kind", values[2])
+	} else if value.Valid {
+		i.Kind = invoice.Kind(value.String)
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field currency", values[3])
+	} else if value.Valid {
+		i.Currency = value.String
+	}
+	if value, ok := values[4].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field subtotal", values[4])
+	} else if value.Valid {
+		i.Subtotal = money.Amount(value.Int64)
+	}
+	if value, ok := values[5].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field discount", values[5])
+	} else if value.Valid {
+		i.Discount = money.Amount(value.Int64)
+	}
+	if value, ok := values[6].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field vat_rate", values[6])
+	} else if value.Valid {
+		i.VatRate = money.Rate(value.Int64)
+	}
+	if value, ok := values[7].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field vat", values[7])
+	} else if value.Valid {
+		i.Vat = money.Amount(value.Int64)
+	}
+	if value, ok := values[8].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field total", values[8])
+	} else if value.Valid {
+		i.Total = money.Amount(value.Int64)
+	}
+	if value, ok := values[9].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field reason", values[9])
+	} else if value.Valid {
+		i.Reason = value.String
+	}
+	if value, ok := values[10].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field payment_status", values[10])
+	} else if value.Valid {
+		i.PaymentStatus = invoice.PaymentStatus(value.String)
+	}
+	if value, ok := values[11].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field amount_paid", values[11])
+	} else if value.Valid {
+		i.AmountPaid = money.Amount(value.Int64)
+	}
+	if value, ok := values[12].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field amount_credited", values[12])
+	} else if value.Valid {
+		i.AmountCredited = money.Amount(value.Int64)
+	}
+	values = values[13:]
+	if len(values) == len(invoice.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			i.organization_id = new(int)
+			*i.organization_id = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field invoice_credit_notes", value)
+		} else if value.Valid {
+			i.invoice_credit_notes = new(int)
+			*i.invoice_credit_notes = int(value.Int64)
+		}
+		if value, ok := values[2].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field repair_slip_invoice", value)
+		} else if value.Valid {
+			i.repair_slip_invoice = new(int)
+			*i.repair_slip_invoice = int(value.Int64)
+		}
+		if value, ok := values[3].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_invoices", value)
+		} else if value.Valid {
+			i.user_invoices = new(int)
+			*i.user_invoices = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Invoice.
+func (i *Invoice) QueryOrganization() *OrganizationQuery {
+	return (&InvoiceClient{config: i.config}).QueryOrganization(i)
+}
+
+// QueryRepairSlip queries the repair_slip edge of the Invoice.
+func (i *Invoice) QueryRepairSlip() *RepairSlipQuery {
+	return (&InvoiceClient{config: i.config}).QueryRepairSlip(i)
+}
+
+// QueryCustomer queries the customer edge of the Invoice.
+func (i *Invoice) QueryCustomer() *UserQuery {
+	return (&InvoiceClient{config: i.config}).QueryCustomer(i)
+}
+
+// QueryLines queries the lines edge of the Invoice.
+func (i *Invoice) QueryLines() *InvoiceLineQuery {
+	return (&InvoiceClient{config: i.config}).QueryLines(i)
+}
+
+// QueryOriginal queries the original edge of the Invoice.
+func (i *Invoice) QueryOriginal() *InvoiceQuery {
+	return (&InvoiceClient{config: i.config}).QueryOriginal(i)
+}
+
+// QueryCreditNotes queries the credit_notes edge of the Invoice.
+func (i *Invoice) QueryCreditNotes() *InvoiceQuery {
+	return (&InvoiceClient{config: i.config}).QueryCreditNotes(i)
+}
+
+// QueryPayments queries the payments edge of the Invoice.
+func (i *Invoice) QueryPayments() *PaymentQuery {
+	return (&InvoiceClient{config: i.config}).QueryPayments(i)
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that, you need to call Invoice.Unwrap() before calling this method, if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invoice) Update() *InvoiceUpdateOne {
+	return (&InvoiceClient{config: i.config}).UpdateOne(i)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (i *Invoice) Unwrap() *Invoice {
+	tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invoice is not a transactional entity")
+	}
+	i.config.driver = tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString(fmt.Sprintf("id=%v", i.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(i.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", number=")
+	builder.WriteString(i.Number)
+	builder.WriteString(", kind=")
+	builder.WriteString(fmt.Sprintf("%v", i.Kind))
+	builder.WriteString(", currency=")
+	builder.WriteString(i.Currency)
+	builder.WriteString(", subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", i.Subtotal))
+	builder.WriteString(", discount=")
+	builder.WriteString(fmt.Sprintf("%v", i.Discount))
+	builder.WriteString(", vat_rate=")
+	builder.WriteString(fmt.Sprintf("%v", i.VatRate))
+	builder.WriteString(", vat=")
+	builder.WriteString(fmt.Sprintf("%v", i.Vat))
+	builder.WriteString(", total=")
+	builder.WriteString(fmt.Sprintf("%v", i.Total))
+	builder.WriteString(", reason=")
+	builder.WriteString(i.Reason)
+	builder.WriteString(", payment_status=")
+	builder.WriteString(fmt.Sprintf("%v", i.PaymentStatus))
+	builder.WriteString(", amount_paid=")
+	builder.WriteString(fmt.Sprintf("%v", i.AmountPaid))
+	builder.WriteString(", amount_credited=")
+	builder.WriteString(fmt.Sprintf("%v", i.AmountCredited))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
+
+func (i Invoices) config(cfg config) {
+	for _i := range i {
+		i[_i].config = cfg
+	}
+}
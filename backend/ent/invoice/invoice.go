@@ -0,0 +1,200 @@
+// Code generated by entc, DO NOT EDIT.
+
+package invoice
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the invoice type in the database.
+	Label = "invoice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldDiscount holds the string denoting the discount field in the database.
+	FieldDiscount = "discount"
+	// FieldVatRate holds the string denoting the vat_rate field in the database.
+	FieldVatRate = "vat_rate"
+	// FieldVat holds the string denoting the vat field in the database.
+	FieldVat = "vat"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldPaymentStatus holds the string denoting the payment_status field in the database.
+	FieldPaymentStatus = "payment_status"
+	// FieldAmountPaid holds the string denoting the amount_paid field in the database.
+	FieldAmountPaid = "amount_paid"
+	// FieldAmountCredited holds the string denoting the amount_credited field in the database.
+	FieldAmountCredited = "amount_credited"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeRepairSlip holds the string denoting the repair_slip edge name in mutations.
+	EdgeRepairSlip = "repair_slip"
+	// EdgeCustomer holds the string denoting the customer edge name in mutations.
+	EdgeCustomer = "customer"
+	// EdgeLines holds the string denoting the lines edge name in mutations.
+	EdgeLines = "lines"
+	// EdgeOriginal holds the string denoting the original edge name in mutations.
+	EdgeOriginal = "original"
+	// EdgeCreditNotes holds the string denoting the credit_notes edge name in mutations.
+	EdgeCreditNotes = "credit_notes"
+	// EdgePayments holds the string denoting the payments edge name in mutations.
+	EdgePayments = "payments"
+
+	// Table holds the table name of the invoice in the database.
+	Table = "invoices"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "invoices"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// RepairSlipTable is the table the holds the repair_slip relation/edge.
+	RepairSlipTable = "invoices"
+	// RepairSlipInverseTable is the table name for the RepairSlip entity.
+	// It exists in this package in order to avoid circular dependency with the "repairslip" package.
+	RepairSlipInverseTable = "repair_slips"
+	// RepairSlipColumn is the table column denoting the repair_slip relation/edge.
+	RepairSlipColumn = "repair_slip_invoice"
+	// CustomerTable is the table the holds the customer relation/edge.
+	CustomerTable = "invoices"
+	// CustomerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	CustomerInverseTable = "users"
+	// CustomerColumn is the table column denoting the customer relation/edge.
+	CustomerColumn = "user_invoices"
+	// LinesTable is the table the holds the lines relation/edge.
+	LinesTable = "invoice_lines"
+	// LinesInverseTable is the table name for the InvoiceLine entity.
+	// It exists in this package in order to avoid circular dependency with the "invoiceline" package.
+	LinesInverseTable = "invoice_lines"
+	// LinesColumn is the table column denoting the lines relation/edge.
+	LinesColumn = "invoice_lines"
+	// OriginalTable is the table the holds the original relation/edge.
+	OriginalTable = "invoices"
+	// OriginalColumn is the table column denoting the original relation/edge.
+	OriginalColumn = "invoice_credit_notes"
+	// CreditNotesTable is the table the holds the credit_notes relation/edge.
+	CreditNotesTable = "invoices"
+	// CreditNotesColumn is the table column denoting the credit_notes relation/edge.
+	CreditNotesColumn = "invoice_credit_notes"
+	// PaymentsTable is the table the holds the payments relation/edge.
+	PaymentsTable = "payments"
+	// PaymentsInverseTable is the table name for the Payment entity.
+	// It exists in this package in order to avoid circular dependency with the "payment" package.
+	PaymentsInverseTable = "payments"
+	// PaymentsColumn is the table column denoting the payments relation/edge.
+	PaymentsColumn = "invoice_payments"
+)
+
+// Columns holds all SQL columns for invoice fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldNumber,
+	FieldKind,
+	FieldCurrency,
+	FieldSubtotal,
+	FieldDiscount,
+	FieldVatRate,
+	FieldVat,
+	FieldTotal,
+	FieldReason,
+	FieldPaymentStatus,
+	FieldAmountPaid,
+	FieldAmountCredited,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Invoice type.
+var ForeignKeys = []string{
+	"organization_id",
+	"invoice_credit_notes",
+	"repair_slip_invoice",
+	"user_invoices",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [3]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+	// NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	NumberValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the currency field.
+	DefaultCurrency string
+)
+
+// Kind defines the type for the kind enum field.
+type Kind string
+
+// KindInvoice is the default Kind.
+const DefaultKind = KindInvoice
+
+// Kind values.
+const (
+	KindInvoice    Kind = "invoice"
+	KindCreditNote Kind = "credit_note"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "k" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindInvoice, KindCreditNote:
+		return nil
+	default:
+		return fmt.Errorf("invoice: invalid enum value for kind field: %q", k)
+	}
+}
+
+// PaymentStatus defines the type for the payment_status enum field.
+type PaymentStatus string
+
+// PaymentStatusUnpaid is the default PaymentStatus.
+const DefaultPaymentStatus = PaymentStatusUnpaid
+
+// PaymentStatus values.
+const (
+	PaymentStatusUnpaid    PaymentStatus = "unpaid"
+	PaymentStatusPartial   PaymentStatus = "partial"
+	PaymentStatusPaid      PaymentStatus = "paid"
+	PaymentStatusRefundDue PaymentStatus = "refund_due"
+)
+
+func (ps PaymentStatus) String() string {
+	return string(ps)
+}
+
+// PaymentStatusValidator is a validator for the "ps" field enum values. It is called by the builders before save.
+func PaymentStatusValidator(ps PaymentStatus) error {
+	switch ps {
+	case PaymentStatusUnpaid, PaymentStatusPartial, PaymentStatusPaid, PaymentStatusRefundDue:
+		return nil
+	default:
+		return fmt.Errorf("invoice: invalid enum value for payment_status field: %q", ps)
+	}
+}
@@ -0,0 +1,628 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (ic *InvoiceCreate) SetCreateTime(t time.Time) *InvoiceCreate {
+	ic.mutation.SetCreateTime(t)
+	return ic
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCreateTime(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetCreateTime(*t)
+	}
+	return ic
+}
+
+// SetNumber sets the number field.
+func (ic *InvoiceCreate) SetNumber(s string) *InvoiceCreate {
+	ic.mutation.SetNumber(s)
+	return ic
+}
+
+// SetKind sets the kind field.
+func (ic *InvoiceCreate) SetKind(i invoice.Kind) *InvoiceCreate {
+	ic.mutation.SetKind(i)
+	return ic
+}
+
+// SetNillableKind sets the kind field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableKind(i *invoice.Kind) *InvoiceCreate {
+	if i != nil {
+		ic.SetKind(*i)
+	}
+	return ic
+}
+
+// SetCurrency sets the currency field.
+func (ic *InvoiceCreate) SetCurrency(s string) *InvoiceCreate {
+	ic.mutation.SetCurrency(s)
+	return ic
+}
+
+// SetNillableCurrency sets the currency field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCurrency(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetCurrency(*s)
+	}
+	return ic
+}
+
+// SetSubtotal sets the subtotal field.
+func (ic *InvoiceCreate) SetSubtotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetSubtotal(m)
+	return ic
+}
+
+// SetDiscount sets the discount field.
+func (ic *InvoiceCreate) SetDiscount(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetDiscount(m)
+	return ic
+}
+
+// SetVatRate sets the vat_rate field.
+func (ic *InvoiceCreate) SetVatRate(m money.Rate) *InvoiceCreate {
+	ic.mutation.SetVatRate(m)
+	return ic
+}
+
+// SetVat sets the vat field.
+func (ic *InvoiceCreate) SetVat(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetVat(m)
+	return ic
+}
+
+// SetTotal sets the total field.
+func (ic *InvoiceCreate) SetTotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetTotal(m)
+	return ic
+}
+
+// SetReason sets the reason field.
+func (ic *InvoiceCreate) SetReason(s string) *InvoiceCreate {
+	ic.mutation.SetReason(s)
+	return ic
+}
+
+// SetNillableReason sets the reason field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableReason(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetReason(*s)
+	}
+	return ic
+}
+
+// SetPaymentStatus sets the payment_status field.
+func (ic *InvoiceCreate) SetPaymentStatus(is invoice.PaymentStatus) *InvoiceCreate {
+	ic.mutation.SetPaymentStatus(is)
+	return ic
+}
+
+// SetNillablePaymentStatus sets the payment_status field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePaymentStatus(is *invoice.PaymentStatus) *InvoiceCreate {
+	if is != nil {
+		ic.SetPaymentStatus(*is)
+	}
+	return ic
+}
+
+// SetAmountPaid sets the amount_paid field.
+func (ic *InvoiceCreate) SetAmountPaid(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetAmountPaid(m)
+	return ic
+}
+
+// SetAmountCredited sets the amount_credited field.
+func (ic *InvoiceCreate) SetAmountCredited(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetAmountCredited(m)
+	return ic
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (ic *InvoiceCreate) SetOrganizationID(id int) *InvoiceCreate {
+	ic.mutation.SetOrganizationID(id)
+	return ic
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableOrganizationID(id *int) *InvoiceCreate {
+	if id != nil {
+		ic = ic.SetOrganizationID(*id)
+	}
+	return ic
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (ic *InvoiceCreate) SetOrganization(o *Organization) *InvoiceCreate {
+	return ic.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (ic *InvoiceCreate) SetRepairSlipID(id int) *InvoiceCreate {
+	ic.mutation.SetRepairSlipID(id)
+	return ic
+}
+
+// SetNillableRepairSlipID sets the repair_slip edge to RepairSlip by id if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableRepairSlipID(id *int) *InvoiceCreate {
+	if id != nil {
+		ic = ic.SetRepairSlipID(*id)
+	}
+	return ic
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (ic *InvoiceCreate) SetRepairSlip(r *RepairSlip) *InvoiceCreate {
+	return ic.SetRepairSlipID(r.ID)
+}
+
+// SetCustomerID sets the customer edge to User by id.
+func (ic *InvoiceCreate) SetCustomerID(id int) *InvoiceCreate {
+	ic.mutation.SetCustomerID(id)
+	return ic
+}
+
+// SetNillableCustomerID sets the customer edge to User by id if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCustomerID(id *int) *InvoiceCreate {
+	if id != nil {
+		ic = ic.SetCustomerID(*id)
+	}
+	return ic
+}
+
+// SetCustomer sets the customer edge to User.
+func (ic *InvoiceCreate) SetCustomer(u *User) *InvoiceCreate {
+	return ic.SetCustomerID(u.ID)
+}
+
+// AddLineIDs adds the lines edge to InvoiceLine by ids.
+func (ic *InvoiceCreate) AddLineIDs(ids ...int) *InvoiceCreate {
+	ic.mutation.AddLineIDs(ids...)
+	return ic
+}
+
+// AddLines adds the lines edges to InvoiceLine.
+func (ic *InvoiceCreate) AddLines(i ...*InvoiceLine) *InvoiceCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddLineIDs(ids...)
+}
+
+// SetOriginalID sets the original edge to Invoice by id.
+func (ic *InvoiceCreate) SetOriginalID(id int) *InvoiceCreate {
+	ic.mutation.SetOriginalID(id)
+	return ic
+}
+
+// SetNillableOriginalID sets the original edge to Invoice by id if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableOriginalID(id *int) *InvoiceCreate {
+	if id != nil {
+		ic = ic.SetOriginalID(*id)
+	}
+	return ic
+}
+
+// SetOriginal sets the original edge to Invoice.
+func (ic *InvoiceCreate) SetOriginal(i *Invoice) *InvoiceCreate {
+	return ic.SetOriginalID(i.ID)
+}
+
+// AddCreditNoteIDs adds the credit_notes edge to Invoice by ids.
+func (ic *InvoiceCreate) AddCreditNoteIDs(ids ...int) *InvoiceCreate {
+	ic.mutation.AddCreditNoteIDs(ids...)
+	return ic
+}
+
+// AddCreditNotes adds the credit_notes edges to Invoice.
+func (ic *InvoiceCreate) AddCreditNotes(i ...*Invoice) *InvoiceCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddCreditNoteIDs(ids...)
+}
+
+// AddPaymentIDs adds the payments edge to Payment by ids.
+func (ic *InvoiceCreate) AddPaymentIDs(ids ...int) *InvoiceCreate {
+	ic.mutation.AddPaymentIDs(ids...)
+	return ic
+}
+
+// AddPayments adds the payments edges to Payment.
+func (ic *InvoiceCreate) AddPayments(p ...*Payment) *InvoiceCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ic.AddPaymentIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (ic *InvoiceCreate) Mutation() *InvoiceMutation {
+	return ic.mutation
+}
+
+// Save creates the Invoice in the database.
+func (ic *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	if _, ok := ic.mutation.CreateTime(); !ok {
+		v := invoice.DefaultCreateTime()
+		ic.mutation.SetCreateTime(v)
+	}
+	if _, ok := ic.mutation.Number(); !ok {
+		return nil, &ValidationError{Name: "number", err: errors.New("ent: missing required field \"number\"")}
+	}
+	if v, ok := ic.mutation.Number(); ok {
+		if err := invoice.NumberValidator(v); err != nil {
+			return nil, &ValidationError{Name: "number", err: fmt.Errorf("ent: validator failed for field \"number\": %w", err)}
+		}
+	}
+	if _, ok := ic.mutation.Kind(); !ok {
+		v := invoice.DefaultKind
+		ic.mutation.SetKind(v)
+	}
+	if v, ok := ic.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return nil, &ValidationError{Name: "kind", err: fmt.Errorf("ent: validator failed for field \"kind\": %w", err)}
+		}
+	}
+	if _, ok := ic.mutation.Currency(); !ok {
+		v := invoice.DefaultCurrency
+		ic.mutation.SetCurrency(v)
+	}
+	if _, ok := ic.mutation.Subtotal(); !ok {
+		return nil, &ValidationError{Name: "subtotal", err: errors.New("ent: missing required field \"subtotal\"")}
+	}
+	if _, ok := ic.mutation.Discount(); !ok {
+		return nil, &ValidationError{Name: "discount", err: errors.New("ent: missing required field \"discount\"")}
+	}
+	if _, ok := ic.mutation.VatRate(); !ok {
+		return nil, &ValidationError{Name: "vat_rate", err: errors.New("ent: missing required field \"vat_rate\"")}
+	}
+	if _, ok := ic.mutation.Vat(); !ok {
+		return nil, &ValidationError{Name: "vat", err: errors.New("ent: missing required field \"vat\"")}
+	}
+	if _, ok := ic.mutation.Total(); !ok {
+		return nil, &ValidationError{Name: "total", err: errors.New("ent: missing required field \"total\"")}
+	}
+	if _, ok := ic.mutation.PaymentStatus(); !ok {
+		v := invoice.DefaultPaymentStatus
+		ic.mutation.SetPaymentStatus(v)
+	}
+	if v, ok := ic.mutation.PaymentStatus(); ok {
+		if err := invoice.PaymentStatusValidator(v); err != nil {
+			return nil, &ValidationError{Name: "payment_status", err: fmt.Errorf("ent: validator failed for field \"payment_status\": %w", err)}
+		}
+	}
+	if _, ok := ic.mutation.AmountPaid(); !ok {
+		return nil, &ValidationError{Name: "amount_paid", err: errors.New("ent: missing required field \"amount_paid\"")}
+	}
+	if _, ok := ic.mutation.AmountCredited(); !ok {
+		return nil, &ValidationError{Name: "amount_credited", err: errors.New("ent: missing required field \"amount_credited\"")}
+	}
+	var (
+		err  error
+		node *Invoice
+	)
+	if len(ic.hooks) == 0 {
+		node, err = ic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ic.mutation = mutation
+			node, err = ic.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ic.hooks) - 1; i >= 0; i-- {
+			mut = ic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ic *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	i, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	i.ID = int(id)
+	return i, nil
+}
+
+func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		i     = &Invoice{config: ic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invoice.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		}
+	)
+	if value, ok := ic.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invoice.FieldCreateTime,
+		})
+		i.CreateTime = value
+	}
+	if value, ok := ic.mutation.Number(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+		i.Number = value
+	}
+	if value, ok := ic.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invoice.FieldKind,
+		})
+		i.Kind = value
+	}
+	if value, ok := ic.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCurrency,
+		})
+		i.Currency = value
+	}
+	if value, ok := ic.mutation.Subtotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+		i.Subtotal = value
+	}
+	if value, ok := ic.mutation.Discount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldDiscount,
+		})
+		i.Discount = value
+	}
+	if value, ok := ic.mutation.VatRate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldVatRate,
+		})
+		i.VatRate = value
+	}
+	if value, ok := ic.mutation.Vat(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldVat,
+		})
+		i.Vat = value
+	}
+	if value, ok := ic.mutation.Total(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+		i.Total = value
+	}
+	if value, ok := ic.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldReason,
+		})
+		i.Reason = value
+	}
+	if value, ok := ic.mutation.PaymentStatus(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invoice.FieldPaymentStatus,
+		})
+		i.PaymentStatus = value
+	}
+	if value, ok := ic.mutation.AmountPaid(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+		i.AmountPaid = value
+	}
+	if value, ok := ic.mutation.AmountCredited(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountCredited,
+		})
+		i.AmountCredited = value
+	}
+	if nodes := ic.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invoice.RepairSlipTable,
+			Columns: []string{invoice.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoiceline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.OriginalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalTable,
+			Columns: []string{invoice.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return i, _spec
+}
@@ -0,0 +1,1274 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *InvoiceMutation
+	predicates []predicate.Invoice
+}
+
+// Where adds a new predicate for the builder.
+func (iu *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	iu.predicates = append(iu.predicates, ps...)
+	return iu
+}
+
+// SetPaymentStatus sets the payment_status field.
+func (iu *InvoiceUpdate) SetPaymentStatus(is invoice.PaymentStatus) *InvoiceUpdate {
+	iu.mutation.SetPaymentStatus(is)
+	return iu
+}
+
+// SetNillablePaymentStatus sets the payment_status field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePaymentStatus(is *invoice.PaymentStatus) *InvoiceUpdate {
+	if is != nil {
+		iu.SetPaymentStatus(*is)
+	}
+	return iu
+}
+
+// SetAmountPaid sets the amount_paid field.
+func (iu *InvoiceUpdate) SetAmountPaid(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetAmountPaid()
+	iu.mutation.SetAmountPaid(m)
+	return iu
+}
+
+// AddAmountPaid adds m to amount_paid.
+func (iu *InvoiceUpdate) AddAmountPaid(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddAmountPaid(m)
+	return iu
+}
+
+// SetAmountCredited sets the amount_credited field.
+func (iu *InvoiceUpdate) SetAmountCredited(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetAmountCredited()
+	iu.mutation.SetAmountCredited(m)
+	return iu
+}
+
+// AddAmountCredited adds m to amount_credited.
+func (iu *InvoiceUpdate) AddAmountCredited(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddAmountCredited(m)
+	return iu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (iu *InvoiceUpdate) SetOrganizationID(id int) *InvoiceUpdate {
+	iu.mutation.SetOrganizationID(id)
+	return iu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableOrganizationID(id *int) *InvoiceUpdate {
+	if id != nil {
+		iu = iu.SetOrganizationID(*id)
+	}
+	return iu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (iu *InvoiceUpdate) SetOrganization(o *Organization) *InvoiceUpdate {
+	return iu.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (iu *InvoiceUpdate) SetRepairSlipID(id int) *InvoiceUpdate {
+	iu.mutation.SetRepairSlipID(id)
+	return iu
+}
+
+// SetNillableRepairSlipID sets the repair_slip edge to RepairSlip by id if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableRepairSlipID(id *int) *InvoiceUpdate {
+	if id != nil {
+		iu = iu.SetRepairSlipID(*id)
+	}
+	return iu
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (iu *InvoiceUpdate) SetRepairSlip(r *RepairSlip) *InvoiceUpdate {
+	return iu.SetRepairSlipID(r.ID)
+}
+
+// SetCustomerID sets the customer edge to User by id.
+func (iu *InvoiceUpdate) SetCustomerID(id int) *InvoiceUpdate {
+	iu.mutation.SetCustomerID(id)
+	return iu
+}
+
+// SetNillableCustomerID sets the customer edge to User by id if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableCustomerID(id *int) *InvoiceUpdate {
+	if id != nil {
+		iu = iu.SetCustomerID(*id)
+	}
+	return iu
+}
+
+// SetCustomer sets the customer edge to User.
+func (iu *InvoiceUpdate) SetCustomer(u *User) *InvoiceUpdate {
+	return iu.SetCustomerID(u.ID)
+}
+
+// AddLineIDs adds the lines edge to InvoiceLine by ids.
+func (iu *InvoiceUpdate) AddLineIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.AddLineIDs(ids...)
+	return iu
+}
+
+// AddLines adds the lines edges to InvoiceLine.
+func (iu *InvoiceUpdate) AddLines(i ...*InvoiceLine) *InvoiceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddLineIDs(ids...)
+}
+
+// SetOriginalID sets the original edge to Invoice by id.
+func (iu *InvoiceUpdate) SetOriginalID(id int) *InvoiceUpdate {
+	iu.mutation.SetOriginalID(id)
+	return iu
+}
+
+// SetNillableOriginalID sets the original edge to Invoice by id if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableOriginalID(id *int) *InvoiceUpdate {
+	if id != nil {
+		iu = iu.SetOriginalID(*id)
+	}
+	return iu
+}
+
+// SetOriginal sets the original edge to Invoice.
+func (iu *InvoiceUpdate) SetOriginal(i *Invoice) *InvoiceUpdate {
+	return iu.SetOriginalID(i.ID)
+}
+
+// AddCreditNoteIDs adds the credit_notes edge to Invoice by ids.
+func (iu *InvoiceUpdate) AddCreditNoteIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.AddCreditNoteIDs(ids...)
+	return iu
+}
+
+// AddCreditNotes adds the credit_notes edges to Invoice.
+func (iu *InvoiceUpdate) AddCreditNotes(i ...*Invoice) *InvoiceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddCreditNoteIDs(ids...)
+}
+
+// AddPaymentIDs adds the payments edge to Payment by ids.
+func (iu *InvoiceUpdate) AddPaymentIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.AddPaymentIDs(ids...)
+	return iu
+}
+
+// AddPayments adds the payments edges to Payment.
+func (iu *InvoiceUpdate) AddPayments(p ...*Payment) *InvoiceUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iu.AddPaymentIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iu *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return iu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (iu *InvoiceUpdate) ClearOrganization() *InvoiceUpdate {
+	iu.mutation.ClearOrganization()
+	return iu
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (iu *InvoiceUpdate) ClearRepairSlip() *InvoiceUpdate {
+	iu.mutation.ClearRepairSlip()
+	return iu
+}
+
+// ClearCustomer clears the customer edge to User.
+func (iu *InvoiceUpdate) ClearCustomer() *InvoiceUpdate {
+	iu.mutation.ClearCustomer()
+	return iu
+}
+
+// RemoveLineIDs removes the lines edge to InvoiceLine by ids.
+func (iu *InvoiceUpdate) RemoveLineIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.RemoveLineIDs(ids...)
+	return iu
+}
+
+// RemoveLines removes lines edges to InvoiceLine.
+func (iu *InvoiceUpdate) RemoveLines(i ...*InvoiceLine) *InvoiceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveLineIDs(ids...)
+}
+
+// ClearOriginal clears the original edge to Invoice.
+func (iu *InvoiceUpdate) ClearOriginal() *InvoiceUpdate {
+	iu.mutation.ClearOriginal()
+	return iu
+}
+
+// RemoveCreditNoteIDs removes the credit_notes edge to Invoice by ids.
+func (iu *InvoiceUpdate) RemoveCreditNoteIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.RemoveCreditNoteIDs(ids...)
+	return iu
+}
+
+// RemoveCreditNotes removes credit_notes edges to Invoice.
+func (iu *InvoiceUpdate) RemoveCreditNotes(i ...*Invoice) *InvoiceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveCreditNoteIDs(ids...)
+}
+
+// RemovePaymentIDs removes the payments edge to Payment by ids.
+func (iu *InvoiceUpdate) RemovePaymentIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.RemovePaymentIDs(ids...)
+	return iu
+}
+
+// RemovePayments removes payments edges to Payment.
+func (iu *InvoiceUpdate) RemovePayments(p ...*Payment) *InvoiceUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iu.RemovePaymentIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (iu *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := iu.mutation.PaymentStatus(); ok {
+		if err := invoice.PaymentStatusValidator(v); err != nil {
+			return 0, &ValidationError{Name: "payment_status", err: fmt.Errorf("ent: validator failed for field \"payment_status\": %w", err)}
+		}
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(iu.hooks) == 0 {
+		affected, err = iu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iu.mutation = mutation
+			affected, err = iu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iu.hooks) - 1; i >= 0; i-- {
+			mut = iu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+	}
+	if ps := iu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if iu.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoice.FieldReason,
+		})
+	}
+	if value, ok := iu.mutation.PaymentStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invoice.FieldPaymentStatus,
+		})
+	}
+	if value, ok := iu.mutation.AmountPaid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+	}
+	if value, ok := iu.mutation.AddedAmountPaid(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+	}
+	if value, ok := iu.mutation.AmountCredited(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountCredited,
+		})
+	}
+	if value, ok := iu.mutation.AddedAmountCredited(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountCredited,
+		})
+	}
+	if iu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invoice.RepairSlipTable,
+			Columns: []string{invoice.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invoice.RepairSlipTable,
+			Columns: []string{invoice.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := iu.mutation.RemovedLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoiceline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoiceline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.OriginalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalTable,
+			Columns: []string{invoice.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.OriginalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalTable,
+			Columns: []string{invoice.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := iu.mutation.RemovedCreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := iu.mutation.RemovedPaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// SetPaymentStatus sets the payment_status field.
+func (iuo *InvoiceUpdateOne) SetPaymentStatus(is invoice.PaymentStatus) *InvoiceUpdateOne {
+	iuo.mutation.SetPaymentStatus(is)
+	return iuo
+}
+
+// SetNillablePaymentStatus sets the payment_status field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePaymentStatus(is *invoice.PaymentStatus) *InvoiceUpdateOne {
+	if is != nil {
+		iuo.SetPaymentStatus(*is)
+	}
+	return iuo
+}
+
+// SetAmountPaid sets the amount_paid field.
+func (iuo *InvoiceUpdateOne) SetAmountPaid(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetAmountPaid()
+	iuo.mutation.SetAmountPaid(m)
+	return iuo
+}
+
+// AddAmountPaid adds m to amount_paid.
+func (iuo *InvoiceUpdateOne) AddAmountPaid(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddAmountPaid(m)
+	return iuo
+}
+
+// SetAmountCredited sets the amount_credited field.
+func (iuo *InvoiceUpdateOne) SetAmountCredited(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetAmountCredited()
+	iuo.mutation.SetAmountCredited(m)
+	return iuo
+}
+
+// AddAmountCredited adds m to amount_credited.
+func (iuo *InvoiceUpdateOne) AddAmountCredited(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddAmountCredited(m)
+	return iuo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (iuo *InvoiceUpdateOne) SetOrganizationID(id int) *InvoiceUpdateOne {
+	iuo.mutation.SetOrganizationID(id)
+	return iuo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableOrganizationID(id *int) *InvoiceUpdateOne {
+	if id != nil {
+		iuo = iuo.SetOrganizationID(*id)
+	}
+	return iuo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (iuo *InvoiceUpdateOne) SetOrganization(o *Organization) *InvoiceUpdateOne {
+	return iuo.SetOrganizationID(o.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (iuo *InvoiceUpdateOne) SetRepairSlipID(id int) *InvoiceUpdateOne {
+	iuo.mutation.SetRepairSlipID(id)
+	return iuo
+}
+
+// SetNillableRepairSlipID sets the repair_slip edge to RepairSlip by id if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableRepairSlipID(id *int) *InvoiceUpdateOne {
+	if id != nil {
+		iuo = iuo.SetRepairSlipID(*id)
+	}
+	return iuo
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (iuo *InvoiceUpdateOne) SetRepairSlip(r *RepairSlip) *InvoiceUpdateOne {
+	return iuo.SetRepairSlipID(r.ID)
+}
+
+// SetCustomerID sets the customer edge to User by id.
+func (iuo *InvoiceUpdateOne) SetCustomerID(id int) *InvoiceUpdateOne {
+	iuo.mutation.SetCustomerID(id)
+	return iuo
+}
+
+// SetNillableCustomerID sets the customer edge to User by id if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableCustomerID(id *int) *InvoiceUpdateOne {
+	if id != nil {
+		iuo = iuo.SetCustomerID(*id)
+	}
+	return iuo
+}
+
+// SetCustomer sets the customer edge to User.
+func (iuo *InvoiceUpdateOne) SetCustomer(u *User) *InvoiceUpdateOne {
+	return iuo.SetCustomerID(u.ID)
+}
+
+// AddLineIDs adds the lines edge to InvoiceLine by ids.
+func (iuo *InvoiceUpdateOne) AddLineIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.AddLineIDs(ids...)
+	return iuo
+}
+
+// AddLines adds the lines edges to InvoiceLine.
+func (iuo *InvoiceUpdateOne) AddLines(i ...*InvoiceLine) *InvoiceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddLineIDs(ids...)
+}
+
+// SetOriginalID sets the original edge to Invoice by id.
+func (iuo *InvoiceUpdateOne) SetOriginalID(id int) *InvoiceUpdateOne {
+	iuo.mutation.SetOriginalID(id)
+	return iuo
+}
+
+// SetNillableOriginalID sets the original edge to Invoice by id if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableOriginalID(id *int) *InvoiceUpdateOne {
+	if id != nil {
+		iuo = iuo.SetOriginalID(*id)
+	}
+	return iuo
+}
+
+// SetOriginal sets the original edge to Invoice.
+func (iuo *InvoiceUpdateOne) SetOriginal(i *Invoice) *InvoiceUpdateOne {
+	return iuo.SetOriginalID(i.ID)
+}
+
+// AddCreditNoteIDs adds the credit_notes edge to Invoice by ids.
+func (iuo *InvoiceUpdateOne) AddCreditNoteIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.AddCreditNoteIDs(ids...)
+	return iuo
+}
+
+// AddCreditNotes adds the credit_notes edges to Invoice.
+func (iuo *InvoiceUpdateOne) AddCreditNotes(i ...*Invoice) *InvoiceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddCreditNoteIDs(ids...)
+}
+
+// AddPaymentIDs adds the payments edge to Payment by ids.
+func (iuo *InvoiceUpdateOne) AddPaymentIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.AddPaymentIDs(ids...)
+	return iuo
+}
+
+// AddPayments adds the payments edges to Payment.
+func (iuo *InvoiceUpdateOne) AddPayments(p ...*Payment) *InvoiceUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iuo.AddPaymentIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iuo *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return iuo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (iuo *InvoiceUpdateOne) ClearOrganization() *InvoiceUpdateOne {
+	iuo.mutation.ClearOrganization()
+	return iuo
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (iuo *InvoiceUpdateOne) ClearRepairSlip() *InvoiceUpdateOne {
+	iuo.mutation.ClearRepairSlip()
+	return iuo
+}
+
+// ClearCustomer clears the customer edge to User.
+func (iuo *InvoiceUpdateOne) ClearCustomer() *InvoiceUpdateOne {
+	iuo.mutation.ClearCustomer()
+	return iuo
+}
+
+// RemoveLineIDs removes the lines edge to InvoiceLine by ids.
+func (iuo *InvoiceUpdateOne) RemoveLineIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.RemoveLineIDs(ids...)
+	return iuo
+}
+
+// RemoveLines removes lines edges to InvoiceLine.
+func (iuo *InvoiceUpdateOne) RemoveLines(i ...*InvoiceLine) *InvoiceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveLineIDs(ids...)
+}
+
+// ClearOriginal clears the original edge to Invoice.
+func (iuo *InvoiceUpdateOne) ClearOriginal() *InvoiceUpdateOne {
+	iuo.mutation.ClearOriginal()
+	return iuo
+}
+
+// RemoveCreditNoteIDs removes the credit_notes edge to Invoice by ids.
+func (iuo *InvoiceUpdateOne) RemoveCreditNoteIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.RemoveCreditNoteIDs(ids...)
+	return iuo
+}
+
+// RemoveCreditNotes removes credit_notes edges to Invoice.
+func (iuo *InvoiceUpdateOne) RemoveCreditNotes(i ...*Invoice) *InvoiceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveCreditNoteIDs(ids...)
+}
+
+// RemovePaymentIDs removes the payments edge to Payment by ids.
+func (iuo *InvoiceUpdateOne) RemovePaymentIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.RemovePaymentIDs(ids...)
+	return iuo
+}
+
+// RemovePayments removes payments edges to Payment.
+func (iuo *InvoiceUpdateOne) RemovePayments(p ...*Payment) *InvoiceUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iuo.RemovePaymentIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (iuo *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	if v, ok := iuo.mutation.PaymentStatus(); ok {
+		if err := invoice.PaymentStatusValidator(v); err != nil {
+			return nil, &ValidationError{Name: "payment_status", err: fmt.Errorf("ent: validator failed for field \"payment_status\": %w", err)}
+		}
+	}
+
+	var (
+		err  error
+		node *Invoice
+	)
+	if len(iuo.hooks) == 0 {
+		node, err = iuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iuo.mutation = mutation
+			node, err = iuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iuo.hooks) - 1; i >= 0; i-- {
+			mut = iuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	i, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (i *Invoice, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+	}
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Invoice.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if iuo.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoice.FieldReason,
+		})
+	}
+	if value, ok := iuo.mutation.PaymentStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invoice.FieldPaymentStatus,
+		})
+	}
+	if value, ok := iuo.mutation.AmountPaid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+	}
+	if value, ok := iuo.mutation.AddedAmountPaid(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+	}
+	if value, ok := iuo.mutation.AmountCredited(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountCredited,
+		})
+	}
+	if value, ok := iuo.mutation.AddedAmountCredited(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldAmountCredited,
+		})
+	}
+	if iuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invoice.RepairSlipTable,
+			Columns: []string{invoice.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invoice.RepairSlipTable,
+			Columns: []string{invoice.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CustomerTable,
+			Columns: []string{invoice.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := iuo.mutation.RemovedLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoiceline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoiceline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.OriginalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalTable,
+			Columns: []string{invoice.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.OriginalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OriginalTable,
+			Columns: []string{invoice.OriginalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := iuo.mutation.RemovedCreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := iuo.mutation.RemovedPaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	i = &Invoice{config: iuo.config}
+	_spec.Assign = i.assignValues
+	_spec.ScanValues = i.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return i, nil
+}
@@ -0,0 +1,206 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// InvoiceLine is the model entity for the InvoiceLine schema.
+type InvoiceLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind invoiceline.Kind `json:"kind,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity money.Quantity `json:"quantity"`
+	// UnitPrice holds the value of the "unit_price" field.
+	UnitPrice money.Amount `json:"unit_price"`
+	// Amount holds the value of the "amount" field.
+	Amount money.Amount `json:"amount"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceLineQuery when eager-loading is set.
+	Edges           InvoiceLineEdges `json:"edges"`
+	invoice_lines   *int
+	organization_id *int
+}
+
+// InvoiceLineEdges holds the relations/edges for other nodes in the graph.
+type InvoiceLineEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceLineEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceLineEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.loadedTypes[1] {
+		if e.Invoice == nil {
+			// The edge invoice was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: invoice.Label}
+		}
+		return e.Invoice, nil
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceLine) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // kind
+		&sql.NullString{}, // description
+		&sql.NullInt64{},  // quantity
+		&sql.NullInt64{},  // unit_price
+		&sql.NullInt64{},  // amount
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*InvoiceLine) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // invoice_lines
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceLine fields.
+func (il *InvoiceLine) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(invoiceline.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	il.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field kind", values[0])
+	} else if value.Valid {
+		il.Kind = invoiceline.Kind(value.String)
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field description", values[1])
+	} else if value.Valid {
+		il.Description = value.String
+	}
+	if value, ok := values[2].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field quantity", values[2])
+	} else if value.Valid {
+		il.Quantity = money.Quantity(value.Int64)
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field unit_price", values[3])
+	} else if value.Valid {
+		il.UnitPrice = money.Amount(value.Int64)
+	}
+	if value, ok := values[4].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field amount", values[4])
+	} else if value.Valid {
+		il.Amount = money.Amount(value.Int64)
+	}
+	values = values[5:]
+	if len(values) == len(invoiceline.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field invoice_lines", value)
+		} else if value.Valid {
+			il.invoice_lines = new(int)
+			*il.invoice_lines = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			il.organization_id = new(int)
+			*il.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the InvoiceLine.
+func (il *InvoiceLine) QueryOrganization() *OrganizationQuery {
+	return (&InvoiceLineClient{config: il.config}).QueryOrganization(il)
+}
+
+// QueryInvoice queries the invoice edge of the InvoiceLine.
+func (il *InvoiceLine) QueryInvoice() *InvoiceQuery {
+	return (&InvoiceLineClient{config: il.config}).QueryInvoice(il)
+}
+
+// Update returns a builder for updating this InvoiceLine.
+// Note that, you need to call InvoiceLine.Unwrap() before calling this method, if this InvoiceLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (il *InvoiceLine) Update() *InvoiceLineUpdateOne {
+	return (&InvoiceLineClient{config: il.config}).UpdateOne(il)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (il *InvoiceLine) Unwrap() *InvoiceLine {
+	tx, ok := il.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceLine is not a transactional entity")
+	}
+	il.config.driver = tx.drv
+	return il
+}
+
+// String implements the fmt.Stringer.
+func (il *InvoiceLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceLine(")
+	builder.WriteString(fmt.Sprintf("id=%v", il.ID))
+	builder.WriteString(", kind=")
+	builder.WriteString(fmt.Sprintf("%v", il.Kind))
+	builder.WriteString(", description=")
+	builder.WriteString(il.Description)
+	builder.WriteString(", quantity=")
+	builder.WriteString(fmt.Sprintf("%v", il.Quantity))
+	builder.WriteString(", unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", il.UnitPrice))
+	builder.WriteString(", amount=")
+	builder.WriteString(fmt.Sprintf("%v", il.Amount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceLines is a parsable slice of InvoiceLine.
+type InvoiceLines []*InvoiceLine
+
+func (il InvoiceLines) config(cfg config) {
+	for _i := range il {
+		il[_i].config = cfg
+	}
+}
@@ -0,0 +1,100 @@
+// Code generated by entc, DO NOT EDIT.
+
+package invoiceline
+
+import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the invoiceline type in the database.
+	Label = "invoice_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldUnitPrice holds the string denoting the unit_price field in the database.
+	FieldUnitPrice = "unit_price"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+
+	// Table holds the table name of the invoiceline in the database.
+	Table = "invoice_lines"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "invoice_lines"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// InvoiceTable is the table the holds the invoice relation/edge.
+	InvoiceTable = "invoice_lines"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "invoice_lines"
+)
+
+// Columns holds all SQL columns for invoiceline fields.
+var Columns = []string{
+	FieldID,
+	FieldKind,
+	FieldDescription,
+	FieldQuantity,
+	FieldUnitPrice,
+	FieldAmount,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the InvoiceLine type.
+var ForeignKeys = []string{
+	"invoice_lines",
+	"organization_id",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+)
+
+// Kind defines the type for the kind enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindLabour Kind = "labour"
+	KindPart   Kind = "part"
+	KindFee    Kind = "fee"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "k" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindLabour, KindPart, KindFee:
+		return nil
+	default:
+		return fmt.Errorf("invoiceline: invalid enum value for kind field: %q", k)
+	}
+}
@@ -0,0 +1,259 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceLineCreate is the builder for creating a InvoiceLine entity.
+type InvoiceLineCreate struct {
+	config
+	mutation *InvoiceLineMutation
+	hooks    []Hook
+}
+
+// SetKind sets the kind field.
+func (ilc *InvoiceLineCreate) SetKind(i invoiceline.Kind) *InvoiceLineCreate {
+	ilc.mutation.SetKind(i)
+	return ilc
+}
+
+// SetDescription sets the description field.
+func (ilc *InvoiceLineCreate) SetDescription(s string) *InvoiceLineCreate {
+	ilc.mutation.SetDescription(s)
+	return ilc
+}
+
+// SetQuantity sets the quantity field.
+func (ilc *InvoiceLineCreate) SetQuantity(m money.Quantity) *InvoiceLineCreate {
+	ilc.mutation.SetQuantity(m)
+	return ilc
+}
+
+// SetUnitPrice sets the unit_price field.
+func (ilc *InvoiceLineCreate) SetUnitPrice(m money.Amount) *InvoiceLineCreate {
+	ilc.mutation.SetUnitPrice(m)
+	return ilc
+}
+
+// SetAmount sets the amount field.
+func (ilc *InvoiceLineCreate) SetAmount(m money.Amount) *InvoiceLineCreate {
+	ilc.mutation.SetAmount(m)
+	return ilc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (ilc *InvoiceLineCreate) SetOrganizationID(id int) *InvoiceLineCreate {
+	ilc.mutation.SetOrganizationID(id)
+	return ilc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (ilc *InvoiceLineCreate) SetNillableOrganizationID(id *int) *InvoiceLineCreate {
+	if id != nil {
+		ilc = ilc.SetOrganizationID(*id)
+	}
+	return ilc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (ilc *InvoiceLineCreate) SetOrganization(o *Organization) *InvoiceLineCreate {
+	return ilc.SetOrganizationID(o.ID)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (ilc *InvoiceLineCreate) SetInvoiceID(id int) *InvoiceLineCreate {
+	ilc.mutation.SetInvoiceID(id)
+	return ilc
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (ilc *InvoiceLineCreate) SetInvoice(i *Invoice) *InvoiceLineCreate {
+	return ilc.SetInvoiceID(i.ID)
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (ilc *InvoiceLineCreate) Mutation() *InvoiceLineMutation {
+	return ilc.mutation
+}
+
+// Save creates the InvoiceLine in the database.
+func (ilc *InvoiceLineCreate) Save(ctx context.Context) (*InvoiceLine, error) {
+	if _, ok := ilc.mutation.Kind(); !ok {
+		return nil, &ValidationError{Name: "kind", err: errors.New("ent: missing required field \"kind\"")}
+	}
+	if v, ok := ilc.mutation.Kind(); ok {
+		if err := invoiceline.KindValidator(v); err != nil {
+			return nil, &ValidationError{Name: "kind", err: fmt.Errorf("ent: validator failed for field \"kind\": %w", err)}
+		}
+	}
+	if _, ok := ilc.mutation.Description(); !ok {
+		return nil, &ValidationError{Name: "description", err: errors.New("ent: missing required field \"description\"")}
+	}
+	if v, ok := ilc.mutation.Description(); ok {
+		if err := invoiceline.DescriptionValidator(v); err != nil {
+			return nil, &ValidationError{Name: "description", err: fmt.Errorf("ent: validator failed for field \"description\": %w", err)}
+		}
+	}
+	if _, ok := ilc.mutation.Quantity(); !ok {
+		return nil, &ValidationError{Name: "quantity", err: errors.New("ent: missing required field \"quantity\"")}
+	}
+	if _, ok := ilc.mutation.UnitPrice(); !ok {
+		return nil, &ValidationError{Name: "unit_price", err: errors.New("ent: missing required field \"unit_price\"")}
+	}
+	if _, ok := ilc.mutation.Amount(); !ok {
+		return nil, &ValidationError{Name: "amount", err: errors.New("ent: missing required field \"amount\"")}
+	}
+	if _, ok := ilc.mutation.InvoiceID(); !ok {
+		return nil, &ValidationError{Name: "invoice", err: errors.New("ent: missing required edge \"invoice\"")}
+	}
+	var (
+		err  error
+		node *InvoiceLine
+	)
+	if len(ilc.hooks) == 0 {
+		node, err = ilc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ilc.mutation = mutation
+			node, err = ilc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ilc.hooks) - 1; i >= 0; i-- {
+			mut = ilc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ilc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ilc *InvoiceLineCreate) SaveX(ctx context.Context) *InvoiceLine {
+	v, err := ilc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ilc *InvoiceLineCreate) sqlSave(ctx context.Context) (*InvoiceLine, error) {
+	il, _spec := ilc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ilc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	il.ID = int(id)
+	return il, nil
+}
+
+func (ilc *InvoiceLineCreate) createSpec() (*InvoiceLine, *sqlgraph.CreateSpec) {
+	var (
+		il    = &InvoiceLine{config: ilc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invoiceline.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoiceline.FieldID,
+			},
+		}
+	)
+	if value, ok := ilc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: invoiceline.FieldKind,
+		})
+		il.Kind = value
+	}
+	if value, ok := ilc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoiceline.FieldDescription,
+		})
+		il.Description = value
+	}
+	if value, ok := ilc.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceline.FieldQuantity,
+		})
+		il.Quantity = value
+	}
+	if value, ok := ilc.mutation.UnitPrice(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceline.FieldUnitPrice,
+		})
+		il.UnitPrice = value
+	}
+	if value, ok := ilc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceline.FieldAmount,
+		})
+		il.Amount = value
+	}
+	if nodes := ilc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.OrganizationTable,
+			Columns: []string{invoiceline.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ilc.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return il, _spec
+}
@@ -0,0 +1,999 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceLineQuery is the builder for querying InvoiceLine entities.
+type InvoiceLineQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.InvoiceLine
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withInvoice      *InvoiceQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (ilq *InvoiceLineQuery) Where(ps ...predicate.InvoiceLine) *InvoiceLineQuery {
+	ilq.predicates = append(ilq.predicates, ps...)
+	return ilq
+}
+
+// Limit adds a limit step to the query.
+func (ilq *InvoiceLineQuery) Limit(limit int) *InvoiceLineQuery {
+	ilq.limit = &limit
+	return ilq
+}
+
+// Offset adds an offset step to the query.
+func (ilq *InvoiceLineQuery) Offset(offset int) *InvoiceLineQuery {
+	ilq.offset = &offset
+	return ilq
+}
+
+// Order adds an order step to the query.
+func (ilq *InvoiceLineQuery) Order(o ...OrderFunc) *InvoiceLineQuery {
+	ilq.order = append(ilq.order, o...)
+	return ilq
+}
+
+// QueryOrganization chains the current query on the organization edge.
+func (ilq *InvoiceLineQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: ilq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ilq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, ilq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invoiceline.OrganizationTable, invoiceline.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ilq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoice chains the current query on the invoice edge.
+func (ilq *InvoiceLineQuery) QueryInvoice() *InvoiceQuery {
+	query := &InvoiceQuery{config: ilq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ilq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, ilq.sqlQuery()),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.InvoiceTable, invoiceline.InvoiceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ilq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first InvoiceLine entity in the query. Returns *NotFoundError when no invoiceline was found.
+func (ilq *InvoiceLineQuery) First(ctx context.Context) (*InvoiceLine, error) {
+	ils, err := ilq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ils) == 0 {
+		return nil, &NotFoundError{invoiceline.Label}
+	}
+	return ils[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) FirstX(ctx context.Context) *InvoiceLine {
+	il, err := ilq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return il
+}
+
+// FirstID returns the first InvoiceLine id in the query. Returns *NotFoundError when no id was found.
+func (ilq *InvoiceLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ilq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invoiceline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) FirstXID(ctx context.Context) int {
+	id, err := ilq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only InvoiceLine entity in the query, returns an error if not exactly one entity was returned.
+func (ilq *InvoiceLineQuery) Only(ctx context.Context) (*InvoiceLine, error) {
+	ils, err := ilq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(ils) {
+	case 1:
+		return ils[0], nil
+	case 0:
+		return nil, &NotFoundError{invoiceline.Label}
+	default:
+		return nil, &NotSingularError{invoiceline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) OnlyX(ctx context.Context) *InvoiceLine {
+	il, err := ilq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return il
+}
+
+// OnlyID returns the only InvoiceLine id in the query, returns an error if not exactly one id was returned.
+func (ilq *InvoiceLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ilq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = &NotSingularError{invoiceline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ilq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvoiceLines.
+func (ilq *InvoiceLineQuery) All(ctx context.Context) ([]*InvoiceLine, error) {
+	if err := ilq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ilq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) AllX(ctx context.Context) []*InvoiceLine {
+	ils, err := ilq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ils
+}
+
+// IDs executes the query and returns a list of InvoiceLine ids.
+func (ilq *InvoiceLineQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ilq.Select(invoiceline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := ilq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ilq *InvoiceLineQuery) Count(ctx context.Context) (int, error) {
+	if err := ilq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ilq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) CountX(ctx context.Context) int {
+	count, err := ilq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ilq *InvoiceLineQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ilq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ilq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := ilq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ilq *InvoiceLineQuery) Clone() *InvoiceLineQuery {
+	return &InvoiceLineQuery{
+		config:     ilq.config,
+		limit:      ilq.limit,
+		offset:     ilq.offset,
+		order:      append([]OrderFunc{}, ilq.order...),
+		unique:     append([]string{}, ilq.unique...),
+		predicates: append([]predicate.InvoiceLine{}, ilq.predicates...),
+		// clone intermediate query.
+		sql:  ilq.sql.Clone(),
+		path: ilq.path,
+	}
+}
+
+//  WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (ilq *InvoiceLineQuery) WithOrganization(opts ...func(*OrganizationQuery)) *InvoiceLineQuery {
+	query := &OrganizationQuery{config: ilq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ilq.withOrganization = query
+	return ilq
+}
+
+//  WithInvoice tells the query-builder to eager-loads the nodes that are connected to
+// the "invoice" edge. The optional arguments used to configure the query builder of the edge.
+func (ilq *InvoiceLineQuery) WithInvoice(opts ...func(*InvoiceQuery)) *InvoiceLineQuery {
+	query := &InvoiceQuery{config: ilq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ilq.withInvoice = query
+	return ilq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind invoiceline.Kind `json:"kind,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvoiceLine.Query().
+//		GroupBy(invoiceline.FieldKind).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (ilq *InvoiceLineQuery) GroupBy(field string, fields ...string) *InvoiceLineGroupBy {
+	group := &InvoiceLineGroupBy{config: ilq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ilq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ilq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind invoiceline.Kind `json:"kind,omitempty"`
+//	}
+//
+//	client.InvoiceLine.Query().
+//		Select(invoiceline.FieldKind).
+//		Scan(ctx, &v)
+//
+func (ilq *InvoiceLineQuery) Select(field string, fields ...string) *InvoiceLineSelect {
+	selector := &InvoiceLineSelect{config: ilq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ilq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ilq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (ilq *InvoiceLineQuery) prepareQuery(ctx context.Context) error {
+	if ilq.path != nil {
+		prev, err := ilq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ilq.sql = prev
+	}
+	if err := invoiceline.Policy.EvalQuery(ctx, ilq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ilq *InvoiceLineQuery) sqlAll(ctx context.Context) ([]*InvoiceLine, error) {
+	var (
+		nodes       = []*InvoiceLine{}
+		withFKs     = ilq.withFKs
+		_spec       = ilq.querySpec()
+		loadedTypes = [2]bool{
+			ilq.withOrganization != nil,
+			ilq.withInvoice != nil,
+		}
+	)
+	if ilq.withOrganization != nil || ilq.withInvoice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, invoiceline.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &InvoiceLine{config: ilq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ilq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ilq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*InvoiceLine)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	if query := ilq.withInvoice; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*InvoiceLine)
+		for i := range nodes {
+			if fk := nodes[i].invoice_lines; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(invoice.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "invoice_lines" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Invoice = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ilq *InvoiceLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ilq.querySpec()
+	return sqlgraph.CountNodes(ctx, ilq.driver, _spec)
+}
+
+func (ilq *InvoiceLineQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ilq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (ilq *InvoiceLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoiceline.Table,
+			Columns: invoiceline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoiceline.FieldID,
+			},
+		},
+		From:   ilq.sql,
+		Unique: true,
+	}
+	if ps := ilq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ilq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ilq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ilq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ilq *InvoiceLineQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(ilq.driver.Dialect())
+	t1 := builder.Table(invoiceline.Table)
+	selector := builder.Select(t1.Columns(invoiceline.Columns...)...).From(t1)
+	if ilq.sql != nil {
+		selector = ilq.sql
+		selector.Select(selector.Columns(invoiceline.Columns...)...)
+	}
+	for _, p := range ilq.predicates {
+		p(selector)
+	}
+	for _, p := range ilq.order {
+		p(selector)
+	}
+	if offset := ilq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ilq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InvoiceLineGroupBy is the builder for group-by InvoiceLine entities.
+type InvoiceLineGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ilgb *InvoiceLineGroupBy) Aggregate(fns ...AggregateFunc) *InvoiceLineGroupBy {
+	ilgb.fns = append(ilgb.fns, fns...)
+	return ilgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (ilgb *InvoiceLineGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ilgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ilgb.sql = query
+	return ilgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ilgb *InvoiceLineGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ilgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (ilgb *InvoiceLineGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ilgb.fields) > 1 {
+		return nil, errors.New("ent: InvoiceLineGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ilgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ilgb *InvoiceLineGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ilgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (ilgb *InvoiceLineGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ilgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = fmt.Errorf("ent: InvoiceLineGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ilgb *InvoiceLineGroupBy) StringX(ctx context.Context) string {
+	v, err := ilgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (ilgb *InvoiceLineGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ilgb.fields) > 1 {
+		return nil, errors.New("ent: InvoiceLineGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ilgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ilgb *InvoiceLineGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ilgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (ilgb *InvoiceLineGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ilgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = fmt.Errorf("ent: InvoiceLineGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ilgb *InvoiceLineGroupBy) IntX(ctx context.Context) int {
+	v, err := ilgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (ilgb *InvoiceLineGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ilgb.fields) > 1 {
+		return nil, errors.New("ent: InvoiceLineGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ilgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ilgb *InvoiceLineGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ilgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (ilgb *InvoiceLineGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ilgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = fmt.Errorf("ent: InvoiceLineGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ilgb *InvoiceLineGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ilgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (ilgb *InvoiceLineGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ilgb.fields) > 1 {
+		return nil, errors.New("ent: InvoiceLineGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ilgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ilgb *InvoiceLineGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ilgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (ilgb *InvoiceLineGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ilgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = fmt.Errorf("ent: InvoiceLineGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ilgb *InvoiceLineGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ilgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ilgb *InvoiceLineGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ilgb.sqlQuery().Query()
+	if err := ilgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ilgb *InvoiceLineGroupBy) sqlQuery() *sql.Selector {
+	selector := ilgb.sql
+	columns := make([]string, 0, len(ilgb.fields)+len(ilgb.fns))
+	columns = append(columns, ilgb.fields...)
+	for _, fn := range ilgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(ilgb.fields...)
+}
+
+// InvoiceLineSelect is the builder for select fields of InvoiceLine entities.
+type InvoiceLineSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (ils *InvoiceLineSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := ils.path(ctx)
+	if err != nil {
+		return err
+	}
+	ils.sql = query
+	return ils.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ils *InvoiceLineSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ils.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (ils *InvoiceLineSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ils.fields) > 1 {
+		return nil, errors.New("ent: InvoiceLineSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ils.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ils *InvoiceLineSelect) StringsX(ctx context.Context) []string {
+	v, err := ils.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (ils *InvoiceLineSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ils.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = fmt.Errorf("ent: InvoiceLineSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ils *InvoiceLineSelect) StringX(ctx context.Context) string {
+	v, err := ils.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (ils *InvoiceLineSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ils.fields) > 1 {
+		return nil, errors.New("ent: InvoiceLineSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ils.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ils *InvoiceLineSelect) IntsX(ctx context.Context) []int {
+	v, err := ils.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (ils *InvoiceLineSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ils.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = fmt.Errorf("ent: InvoiceLineSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ils *InvoiceLineSelect) IntX(ctx context.Context) int {
+	v, err := ils.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (ils *InvoiceLineSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ils.fields) > 1 {
+		return nil, errors.New("ent: InvoiceLineSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ils.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ils *InvoiceLineSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ils.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (ils *InvoiceLineSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ils.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = fmt.Errorf("ent: InvoiceLineSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ils *InvoiceLineSelect) Float64X(ctx context.Context) float64 {
+	v, err := ils.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (ils *InvoiceLineSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ils.fields) > 1 {
+		return nil, errors.New("ent: InvoiceLineSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ils.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ils *InvoiceLineSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ils.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (ils *InvoiceLineSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ils.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = fmt.Errorf("ent: InvoiceLineSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ils *InvoiceLineSelect) BoolX(ctx context.Context) bool {
+	v, err := ils.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ils *InvoiceLineSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ils.sqlQuery().Query()
+	if err := ils.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ils *InvoiceLineSelect) sqlQuery() sql.Querier {
+	selector := ils.sql
+	selector.Select(selector.Columns(ils.fields...)...)
+	return selector
+}
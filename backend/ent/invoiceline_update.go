@@ -0,0 +1,441 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceLineUpdate is the builder for updating InvoiceLine entities.
+type InvoiceLineUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *InvoiceLineMutation
+	predicates []predicate.InvoiceLine
+}
+
+// Where adds a new predicate for the builder.
+func (ilu *InvoiceLineUpdate) Where(ps ...predicate.InvoiceLine) *InvoiceLineUpdate {
+	ilu.predicates = append(ilu.predicates, ps...)
+	return ilu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (ilu *InvoiceLineUpdate) SetOrganizationID(id int) *InvoiceLineUpdate {
+	ilu.mutation.SetOrganizationID(id)
+	return ilu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (ilu *InvoiceLineUpdate) SetNillableOrganizationID(id *int) *InvoiceLineUpdate {
+	if id != nil {
+		ilu = ilu.SetOrganizationID(*id)
+	}
+	return ilu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (ilu *InvoiceLineUpdate) SetOrganization(o *Organization) *InvoiceLineUpdate {
+	return ilu.SetOrganizationID(o.ID)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (ilu *InvoiceLineUpdate) SetInvoiceID(id int) *InvoiceLineUpdate {
+	ilu.mutation.SetInvoiceID(id)
+	return ilu
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (ilu *InvoiceLineUpdate) SetInvoice(i *Invoice) *InvoiceLineUpdate {
+	return ilu.SetInvoiceID(i.ID)
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (ilu *InvoiceLineUpdate) Mutation() *InvoiceLineMutation {
+	return ilu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (ilu *InvoiceLineUpdate) ClearOrganization() *InvoiceLineUpdate {
+	ilu.mutation.ClearOrganization()
+	return ilu
+}
+
+// ClearInvoice clears the invoice edge to Invoice.
+func (ilu *InvoiceLineUpdate) ClearInvoice() *InvoiceLineUpdate {
+	ilu.mutation.ClearInvoice()
+	return ilu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (ilu *InvoiceLineUpdate) Save(ctx context.Context) (int, error) {
+
+	if _, ok := ilu.mutation.InvoiceID(); ilu.mutation.InvoiceCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"invoice\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(ilu.hooks) == 0 {
+		affected, err = ilu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ilu.mutation = mutation
+			affected, err = ilu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ilu.hooks) - 1; i >= 0; i-- {
+			mut = ilu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ilu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ilu *InvoiceLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := ilu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ilu *InvoiceLineUpdate) Exec(ctx context.Context) error {
+	_, err := ilu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilu *InvoiceLineUpdate) ExecX(ctx context.Context) {
+	if err := ilu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ilu *InvoiceLineUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoiceline.Table,
+			Columns: invoiceline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoiceline.FieldID,
+			},
+		},
+	}
+	if ps := ilu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ilu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.OrganizationTable,
+			Columns: []string{invoiceline.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ilu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.OrganizationTable,
+			Columns: []string{invoiceline.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ilu.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ilu.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ilu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceline.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceLineUpdateOne is the builder for updating a single InvoiceLine entity.
+type InvoiceLineUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceLineMutation
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (iluo *InvoiceLineUpdateOne) SetOrganizationID(id int) *InvoiceLineUpdateOne {
+	iluo.mutation.SetOrganizationID(id)
+	return iluo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (iluo *InvoiceLineUpdateOne) SetNillableOrganizationID(id *int) *InvoiceLineUpdateOne {
+	if id != nil {
+		iluo = iluo.SetOrganizationID(*id)
+	}
+	return iluo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (iluo *InvoiceLineUpdateOne) SetOrganization(o *Organization) *InvoiceLineUpdateOne {
+	return iluo.SetOrganizationID(o.ID)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (iluo *InvoiceLineUpdateOne) SetInvoiceID(id int) *InvoiceLineUpdateOne {
+	iluo.mutation.SetInvoiceID(id)
+	return iluo
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (iluo *InvoiceLineUpdateOne) SetInvoice(i *Invoice) *InvoiceLineUpdateOne {
+	return iluo.SetInvoiceID(i.ID)
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (iluo *InvoiceLineUpdateOne) Mutation() *InvoiceLineMutation {
+	return iluo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (iluo *InvoiceLineUpdateOne) ClearOrganization() *InvoiceLineUpdateOne {
+	iluo.mutation.ClearOrganization()
+	return iluo
+}
+
+// ClearInvoice clears the invoice edge to Invoice.
+func (iluo *InvoiceLineUpdateOne) ClearInvoice() *InvoiceLineUpdateOne {
+	iluo.mutation.ClearInvoice()
+	return iluo
+}
+
+// Save executes the query and returns the updated entity.
+func (iluo *InvoiceLineUpdateOne) Save(ctx context.Context) (*InvoiceLine, error) {
+
+	if _, ok := iluo.mutation.InvoiceID(); iluo.mutation.InvoiceCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"invoice\"")
+	}
+	var (
+		err  error
+		node *InvoiceLine
+	)
+	if len(iluo.hooks) == 0 {
+		node, err = iluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iluo.mutation = mutation
+			node, err = iluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iluo.hooks) - 1; i >= 0; i-- {
+			mut = iluo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iluo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iluo *InvoiceLineUpdateOne) SaveX(ctx context.Context) *InvoiceLine {
+	il, err := iluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return il
+}
+
+// Exec executes the query on the entity.
+func (iluo *InvoiceLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := iluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iluo *InvoiceLineUpdateOne) ExecX(ctx context.Context) {
+	if err := iluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iluo *InvoiceLineUpdateOne) sqlSave(ctx context.Context) (il *InvoiceLine, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoiceline.Table,
+			Columns: invoiceline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoiceline.FieldID,
+			},
+		},
+	}
+	id, ok := iluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing InvoiceLine.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if iluo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.OrganizationTable,
+			Columns: []string{invoiceline.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iluo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoiceline.OrganizationTable,
+			Columns: []string{invoiceline.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iluo.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iluo.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	il = &InvoiceLine{config: iluo.config}
+	_spec.Assign = il.assignValues
+	_spec.ScanValues = il.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, iluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceline.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return il, nil
+}
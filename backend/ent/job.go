@@ -0,0 +1,252 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/job"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Job is the model entity for the Job schema.
+type Job struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload string `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status job.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts"`
+	// MaxAttempts holds the value of the "max_attempts" field.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// RunAt holds the value of the "run_at" field.
+	RunAt time.Time `json:"run_at,omitempty"`
+	// LockedBy holds the value of the "locked_by" field.
+	LockedBy string `json:"locked_by,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JobQuery when eager-loading is set.
+	Edges           JobEdges `json:"edges"`
+	organization_id *int
+}
+
+// JobEdges holds the relations/edges for other nodes in the graph.
+type JobEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JobEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Job) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullTime{},   // update_time
+		&sql.NullString{}, // kind
+		&sql.NullString{}, // payload
+		&sql.NullString{}, // status
+		&sql.NullInt64{},  // attempts
+		&sql.NullInt64{},  // max_attempts
+		&sql.NullTime{},   // run_at
+		&sql.NullString{}, // locked_by
+		&sql.NullTime{},   // locked_until
+		&sql.NullString{}, // last_error
+		&sql.NullTime{},   // finished_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Job) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Job fields.
+func (j *Job) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(job.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	j.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		j.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field update_time", values[1])
+	} else if value.Valid {
+		j.UpdateTime = value.Time
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field kind", values[2])
+	} else if value.Valid {
+		j.Kind = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field payload", values[3])
+	} else if value.Valid {
+		j.Payload = value.String
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field status", values[4])
+	} else if value.Valid {
+		j.Status = job.Status(value.String)
+	}
+	if value, ok := values[5].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field attempts", values[5])
+	} else if value.Valid {
+		j.Attempts = int(value.Int64)
+	}
+	if value, ok := values[6].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field max_attempts", values[6])
+	} else if value.Valid {
+		j.MaxAttempts = int(value.Int64)
+	}
+	if value, ok := values[7].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field run_at", values[7])
+	} else if value.Valid {
+		j.RunAt = value.Time
+	}
+	if value, ok := values[8].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field locked_by", values[8])
+	} else if value.Valid {
+		j.LockedBy = value.String
+	}
+	if value, ok := values[9].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field locked_until", values[9])
+	} else if value.Valid {
+		j.LockedUntil = new(time.Time)
+		*j.LockedUntil = value.Time
+	}
+	if value, ok := values[10].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field last_error", values[10])
+	} else if value.Valid {
+		j.LastError = value.String
+	}
+	if value, ok := values[11].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field finished_at", values[11])
+	} else if value.Valid {
+		j.FinishedAt = new(time.Time)
+		*j.FinishedAt = value.Time
+	}
+	values = values[12:]
+	if len(values) == len(job.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			j.organization_id = new(int)
+			*j.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Job.
+func (j *Job) QueryOrganization() *OrganizationQuery {
+	return (&JobClient{config: j.config}).QueryOrganization(j)
+}
+
+// Update returns a builder for updating this Job.
+// Note that, you need to call Job.Unwrap() before calling this method, if this Job
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (j *Job) Update() *JobUpdateOne {
+	return (&JobClient{config: j.config}).UpdateOne(j)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (j *Job) Unwrap() *Job {
+	tx, ok := j.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Job is not a transactional entity")
+	}
+	j.config.driver = tx.drv
+	return j
+}
+
+// String implements the fmt.Stringer.
+func (j *Job) String() string {
+	var builder strings.Builder
+	builder.WriteString("Job(")
+	builder.WriteString(fmt.Sprintf("id=%v", j.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(j.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", update_time=")
+	builder.WriteString(j.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", kind=")
+	builder.WriteString(j.Kind)
+	builder.WriteString(", payload=")
+	builder.WriteString(j.Payload)
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", j.Status))
+	builder.WriteString(", attempts=")
+	builder.WriteString(fmt.Sprintf("%v", j.Attempts))
+	builder.WriteString(", max_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", j.MaxAttempts))
+	builder.WriteString(", run_at=")
+	builder.WriteString(j.RunAt.Format(time.ANSIC))
+	builder.WriteString(", locked_by=")
+	builder.WriteString(j.LockedBy)
+	if v := j.LockedUntil; v != nil {
+		builder.WriteString(", locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", last_error=")
+	builder.WriteString(j.LastError)
+	if v := j.FinishedAt; v != nil {
+		builder.WriteString(", finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Jobs is a parsable slice of Job.
+type Jobs []*Job
+
+func (j Jobs) config(cfg config) {
+	for _i := range j {
+		j[_i].config = cfg
+	}
+}
@@ -0,0 +1,432 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/job"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// JobCreate is the builder for creating a Job entity.
+type JobCreate struct {
+	config
+	mutation *JobMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (jc *JobCreate) SetCreateTime(t time.Time) *JobCreate {
+	jc.mutation.SetCreateTime(t)
+	return jc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (jc *JobCreate) SetNillableCreateTime(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetCreateTime(*t)
+	}
+	return jc
+}
+
+// SetUpdateTime sets the update_time field.
+func (jc *JobCreate) SetUpdateTime(t time.Time) *JobCreate {
+	jc.mutation.SetUpdateTime(t)
+	return jc
+}
+
+// SetNillableUpdateTime sets the update_time field if the given value is not nil.
+func (jc *JobCreate) SetNillableUpdateTime(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetUpdateTime(*t)
+	}
+	return jc
+}
+
+// SetKind sets the kind field.
+func (jc *JobCreate) SetKind(s string) *JobCreate {
+	jc.mutation.SetKind(s)
+	return jc
+}
+
+// SetPayload sets the payload field.
+func (jc *JobCreate) SetPayload(s string) *JobCreate {
+	jc.mutation.SetPayload(s)
+	return jc
+}
+
+// SetNillablePayload sets the payload field if the given value is not nil.
+func (jc *JobCreate) SetNillablePayload(s *string) *JobCreate {
+	if s != nil {
+		jc.SetPayload(*s)
+	}
+	return jc
+}
+
+// SetStatus sets the status field.
+func (jc *JobCreate) SetStatus(j job.Status) *JobCreate {
+	jc.mutation.SetStatus(j)
+	return jc
+}
+
+// SetNillableStatus sets the status field if the given value is not nil.
+func (jc *JobCreate) SetNillableStatus(j *job.Status) *JobCreate {
+	if j != nil {
+		jc.SetStatus(*j)
+	}
+	return jc
+}
+
+// SetAttempts sets the attempts field.
+func (jc *JobCreate) SetAttempts(i int) *JobCreate {
+	jc.mutation.SetAttempts(i)
+	return jc
+}
+
+// SetNillableAttempts sets the attempts field if the given value is not nil.
+func (jc *JobCreate) SetNillableAttempts(i *int) *JobCreate {
+	if i != nil {
+		jc.SetAttempts(*i)
+	}
+	return jc
+}
+
+// SetMaxAttempts sets the max_attempts field.
+func (jc *JobCreate) SetMaxAttempts(i int) *JobCreate {
+	jc.mutation.SetMaxAttempts(i)
+	return jc
+}
+
+// SetNillableMaxAttempts sets the max_attempts field if the given value is not nil.
+func (jc *JobCreate) SetNillableMaxAttempts(i *int) *JobCreate {
+	if i != nil {
+		jc.SetMaxAttempts(*i)
+	}
+	return jc
+}
+
+// SetRunAt sets the run_at field.
+func (jc *JobCreate) SetRunAt(t time.Time) *JobCreate {
+	jc.mutation.SetRunAt(t)
+	return jc
+}
+
+// SetNillableRunAt sets the run_at field if the given value is not nil.
+func (jc *JobCreate) SetNillableRunAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetRunAt(*t)
+	}
+	return jc
+}
+
+// SetLockedBy sets the locked_by field.
+func (jc *JobCreate) SetLockedBy(s string) *JobCreate {
+	jc.mutation.SetLockedBy(s)
+	return jc
+}
+
+// SetNillableLockedBy sets the locked_by field if the given value is not nil.
+func (jc *JobCreate) SetNillableLockedBy(s *string) *JobCreate {
+	if s != nil {
+		jc.SetLockedBy(*s)
+	}
+	return jc
+}
+
+// SetLockedUntil sets the locked_until field.
+func (jc *JobCreate) SetLockedUntil(t time.Time) *JobCreate {
+	jc.mutation.SetLockedUntil(t)
+	return jc
+}
+
+// SetNillableLockedUntil sets the locked_until field if the given value is not nil.
+func (jc *JobCreate) SetNillableLockedUntil(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetLockedUntil(*t)
+	}
+	return jc
+}
+
+// SetLastError sets the last_error field.
+func (jc *JobCreate) SetLastError(s string) *JobCreate {
+	jc.mutation.SetLastError(s)
+	return jc
+}
+
+// SetNillableLastError sets the last_error field if the given value is not nil.
+func (jc *JobCreate) SetNillableLastError(s *string) *JobCreate {
+	if s != nil {
+		jc.SetLastError(*s)
+	}
+	return jc
+}
+
+// SetFinishedAt sets the finished_at field.
+func (jc *JobCreate) SetFinishedAt(t time.Time) *JobCreate {
+	jc.mutation.SetFinishedAt(t)
+	return jc
+}
+
+// SetNillableFinishedAt sets the finished_at field if the given value is not nil.
+func (jc *JobCreate) SetNillableFinishedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetFinishedAt(*t)
+	}
+	return jc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (jc *JobCreate) SetOrganizationID(id int) *JobCreate {
+	jc.mutation.SetOrganizationID(id)
+	return jc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (jc *JobCreate) SetNillableOrganizationID(id *int) *JobCreate {
+	if id != nil {
+		jc = jc.SetOrganizationID(*id)
+	}
+	return jc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (jc *JobCreate) SetOrganization(o *Organization) *JobCreate {
+	return jc.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (jc *JobCreate) Mutation() *JobMutation {
+	return jc.mutation
+}
+
+// Save creates the Job in the database.
+func (jc *JobCreate) Save(ctx context.Context) (*Job, error) {
+	if _, ok := jc.mutation.CreateTime(); !ok {
+		v := job.DefaultCreateTime()
+		jc.mutation.SetCreateTime(v)
+	}
+	if _, ok := jc.mutation.UpdateTime(); !ok {
+		v := job.DefaultUpdateTime()
+		jc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := jc.mutation.Kind(); !ok {
+		return nil, &ValidationError{Name: "kind", err: errors.New("ent: missing required field \"kind\"")}
+	}
+	if v, ok := jc.mutation.Kind(); ok {
+		if err := job.KindValidator(v); err != nil {
+			return nil, &ValidationError{Name: "kind", err: fmt.Errorf("ent: validator failed for field \"kind\": %w", err)}
+		}
+	}
+	if _, ok := jc.mutation.Payload(); !ok {
+		v := job.DefaultPayload
+		jc.mutation.SetPayload(v)
+	}
+	if _, ok := jc.mutation.Status(); !ok {
+		v := job.DefaultStatus
+		jc.mutation.SetStatus(v)
+	}
+	if v, ok := jc.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return nil, &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if _, ok := jc.mutation.Attempts(); !ok {
+		v := job.DefaultAttempts
+		jc.mutation.SetAttempts(v)
+	}
+	if _, ok := jc.mutation.MaxAttempts(); !ok {
+		v := job.DefaultMaxAttempts
+		jc.mutation.SetMaxAttempts(v)
+	}
+	if v, ok := jc.mutation.MaxAttempts(); ok {
+		if err := job.MaxAttemptsValidator(v); err != nil {
+			return nil, &ValidationError{Name: "max_attempts", err: fmt.Errorf("ent: validator failed for field \"max_attempts\": %w", err)}
+		}
+	}
+	if _, ok := jc.mutation.RunAt(); !ok {
+		v := job.DefaultRunAt()
+		jc.mutation.SetRunAt(v)
+	}
+	var (
+		err  error
+		node *Job
+	)
+	if len(jc.hooks) == 0 {
+		node, err = jc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			jc.mutation = mutation
+			node, err = jc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(jc.hooks) - 1; i >= 0; i-- {
+			mut = jc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, jc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jc *JobCreate) SaveX(ctx context.Context) *Job {
+	v, err := jc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (jc *JobCreate) sqlSave(ctx context.Context) (*Job, error) {
+	j, _spec := jc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	j.ID = int(id)
+	return j, nil
+}
+
+func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
+	var (
+		j     = &Job{config: jc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: job.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: job.FieldID,
+			},
+		}
+	)
+	if value, ok := jc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldCreateTime,
+		})
+		j.CreateTime = value
+	}
+	if value, ok := jc.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldUpdateTime,
+		})
+		j.UpdateTime = value
+	}
+	if value, ok := jc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldKind,
+		})
+		j.Kind = value
+	}
+	if value, ok := jc.mutation.Payload(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldPayload,
+		})
+		j.Payload = value
+	}
+	if value, ok := jc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: job.FieldStatus,
+		})
+		j.Status = value
+	}
+	if value, ok := jc.mutation.Attempts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldAttempts,
+		})
+		j.Attempts = value
+	}
+	if value, ok := jc.mutation.MaxAttempts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldMaxAttempts,
+		})
+		j.MaxAttempts = value
+	}
+	if value, ok := jc.mutation.RunAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldRunAt,
+		})
+		j.RunAt = value
+	}
+	if value, ok := jc.mutation.LockedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldLockedBy,
+		})
+		j.LockedBy = value
+	}
+	if value, ok := jc.mutation.LockedUntil(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldLockedUntil,
+		})
+		j.LockedUntil = &value
+	}
+	if value, ok := jc.mutation.LastError(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldLastError,
+		})
+		j.LastError = value
+	}
+	if value, ok := jc.mutation.FinishedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldFinishedAt,
+		})
+		j.FinishedAt = &value
+	}
+	if nodes := jc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   job.OrganizationTable,
+			Columns: []string{job.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return j, _spec
+}
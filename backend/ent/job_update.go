@@ -0,0 +1,860 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/job"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// JobUpdate is the builder for updating Job entities.
+type JobUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *JobMutation
+	predicates []predicate.Job
+}
+
+// Where adds a new predicate for the builder.
+func (ju *JobUpdate) Where(ps ...predicate.Job) *JobUpdate {
+	ju.predicates = append(ju.predicates, ps...)
+	return ju
+}
+
+// SetStatus sets the status field.
+func (ju *JobUpdate) SetStatus(j job.Status) *JobUpdate {
+	ju.mutation.SetStatus(j)
+	return ju
+}
+
+// SetNillableStatus sets the status field if the given value is not nil.
+func (ju *JobUpdate) SetNillableStatus(j *job.Status) *JobUpdate {
+	if j != nil {
+		ju.SetStatus(*j)
+	}
+	return ju
+}
+
+// SetAttempts sets the attempts field.
+func (ju *JobUpdate) SetAttempts(i int) *JobUpdate {
+	ju.mutation.ResetAttempts()
+	ju.mutation.SetAttempts(i)
+	return ju
+}
+
+// SetNillableAttempts sets the attempts field if the given value is not nil.
+func (ju *JobUpdate) SetNillableAttempts(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetAttempts(*i)
+	}
+	return ju
+}
+
+// AddAttempts adds i to attempts.
+func (ju *JobUpdate) AddAttempts(i int) *JobUpdate {
+	ju.mutation.AddAttempts(i)
+	return ju
+}
+
+// SetMaxAttempts sets the max_attempts field.
+func (ju *JobUpdate) SetMaxAttempts(i int) *JobUpdate {
+	ju.mutation.ResetMaxAttempts()
+	ju.mutation.SetMaxAttempts(i)
+	return ju
+}
+
+// SetNillableMaxAttempts sets the max_attempts field if the given value is not nil.
+func (ju *JobUpdate) SetNillableMaxAttempts(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetMaxAttempts(*i)
+	}
+	return ju
+}
+
+// AddMaxAttempts adds i to max_attempts.
+func (ju *JobUpdate) AddMaxAttempts(i int) *JobUpdate {
+	ju.mutation.AddMaxAttempts(i)
+	return ju
+}
+
+// SetRunAt sets the run_at field.
+func (ju *JobUpdate) SetRunAt(t time.Time) *JobUpdate {
+	ju.mutation.SetRunAt(t)
+	return ju
+}
+
+// SetNillableRunAt sets the run_at field if the given value is not nil.
+func (ju *JobUpdate) SetNillableRunAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetRunAt(*t)
+	}
+	return ju
+}
+
+// SetLockedBy sets the locked_by field.
+func (ju *JobUpdate) SetLockedBy(s string) *JobUpdate {
+	ju.mutation.SetLockedBy(s)
+	return ju
+}
+
+// SetNillableLockedBy sets the locked_by field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLockedBy(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetLockedBy(*s)
+	}
+	return ju
+}
+
+// ClearLockedBy clears the value of locked_by.
+func (ju *JobUpdate) ClearLockedBy() *JobUpdate {
+	ju.mutation.ClearLockedBy()
+	return ju
+}
+
+// SetLockedUntil sets the locked_until field.
+func (ju *JobUpdate) SetLockedUntil(t time.Time) *JobUpdate {
+	ju.mutation.SetLockedUntil(t)
+	return ju
+}
+
+// SetNillableLockedUntil sets the locked_until field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLockedUntil(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetLockedUntil(*t)
+	}
+	return ju
+}
+
+// ClearLockedUntil clears the value of locked_until.
+func (ju *JobUpdate) ClearLockedUntil() *JobUpdate {
+	ju.mutation.ClearLockedUntil()
+	return ju
+}
+
+// SetLastError sets the last_error field.
+func (ju *JobUpdate) SetLastError(s string) *JobUpdate {
+	ju.mutation.SetLastError(s)
+	return ju
+}
+
+// SetNillableLastError sets the last_error field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLastError(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetLastError(*s)
+	}
+	return ju
+}
+
+// ClearLastError clears the value of last_error.
+func (ju *JobUpdate) ClearLastError() *JobUpdate {
+	ju.mutation.ClearLastError()
+	return ju
+}
+
+// SetFinishedAt sets the finished_at field.
+func (ju *JobUpdate) SetFinishedAt(t time.Time) *JobUpdate {
+	ju.mutation.SetFinishedAt(t)
+	return ju
+}
+
+// SetNillableFinishedAt sets the finished_at field if the given value is not nil.
+func (ju *JobUpdate) SetNillableFinishedAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetFinishedAt(*t)
+	}
+	return ju
+}
+
+// ClearFinishedAt clears the value of finished_at.
+func (ju *JobUpdate) ClearFinishedAt() *JobUpdate {
+	ju.mutation.ClearFinishedAt()
+	return ju
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (ju *JobUpdate) SetOrganizationID(id int) *JobUpdate {
+	ju.mutation.SetOrganizationID(id)
+	return ju
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (ju *JobUpdate) SetNillableOrganizationID(id *int) *JobUpdate {
+	if id != nil {
+		ju = ju.SetOrganizationID(*id)
+	}
+	return ju
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (ju *JobUpdate) SetOrganization(o *Organization) *JobUpdate {
+	return ju.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (ju *JobUpdate) Mutation() *JobMutation {
+	return ju.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (ju *JobUpdate) ClearOrganization() *JobUpdate {
+	ju.mutation.ClearOrganization()
+	return ju
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (ju *JobUpdate) Save(ctx context.Context) (int, error) {
+	if _, ok := ju.mutation.UpdateTime(); !ok {
+		v := job.UpdateDefaultUpdateTime()
+		ju.mutation.SetUpdateTime(v)
+	}
+	if v, ok := ju.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return 0, &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if v, ok := ju.mutation.MaxAttempts(); ok {
+		if err := job.MaxAttemptsValidator(v); err != nil {
+			return 0, &ValidationError{Name: "max_attempts", err: fmt.Errorf("ent: validator failed for field \"max_attempts\": %w", err)}
+		}
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(ju.hooks) == 0 {
+		affected, err = ju.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ju.mutation = mutation
+			affected, err = ju.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ju.hooks) - 1; i >= 0; i-- {
+			mut = ju.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ju.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ju *JobUpdate) SaveX(ctx context.Context) int {
+	affected, err := ju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ju *JobUpdate) Exec(ctx context.Context) error {
+	_, err := ju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ju *JobUpdate) ExecX(ctx context.Context) {
+	if err := ju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ju *JobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   job.Table,
+			Columns: job.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: job.FieldID,
+			},
+		},
+	}
+	if ps := ju.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ju.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldUpdateTime,
+		})
+	}
+	if value, ok := ju.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: job.FieldStatus,
+		})
+	}
+	if value, ok := ju.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldAttempts,
+		})
+	}
+	if value, ok := ju.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldAttempts,
+		})
+	}
+	if value, ok := ju.mutation.MaxAttempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldMaxAttempts,
+		})
+	}
+	if value, ok := ju.mutation.AddedMaxAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldMaxAttempts,
+		})
+	}
+	if value, ok := ju.mutation.RunAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldRunAt,
+		})
+	}
+	if value, ok := ju.mutation.LockedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldLockedBy,
+		})
+	}
+	if ju.mutation.LockedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: job.FieldLockedBy,
+		})
+	}
+	if value, ok := ju.mutation.LockedUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldLockedUntil,
+		})
+	}
+	if ju.mutation.LockedUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: job.FieldLockedUntil,
+		})
+	}
+	if value, ok := ju.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldLastError,
+		})
+	}
+	if ju.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: job.FieldLastError,
+		})
+	}
+	if value, ok := ju.mutation.FinishedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldFinishedAt,
+		})
+	}
+	if ju.mutation.FinishedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: job.FieldFinishedAt,
+		})
+	}
+	if ju.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   job.OrganizationTable,
+			Columns: []string{job.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ju.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   job.OrganizationTable,
+			Columns: []string{job.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// JobUpdateOne is the builder for updating a single Job entity.
+type JobUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// SetStatus sets the status field.
+func (juo *JobUpdateOne) SetStatus(j job.Status) *JobUpdateOne {
+	juo.mutation.SetStatus(j)
+	return juo
+}
+
+// SetNillableStatus sets the status field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableStatus(j *job.Status) *JobUpdateOne {
+	if j != nil {
+		juo.SetStatus(*j)
+	}
+	return juo
+}
+
+// SetAttempts sets the attempts field.
+func (juo *JobUpdateOne) SetAttempts(i int) *JobUpdateOne {
+	juo.mutation.ResetAttempts()
+	juo.mutation.SetAttempts(i)
+	return juo
+}
+
+// SetNillableAttempts sets the attempts field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableAttempts(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetAttempts(*i)
+	}
+	return juo
+}
+
+// AddAttempts adds i to attempts.
+func (juo *JobUpdateOne) AddAttempts(i int) *JobUpdateOne {
+	juo.mutation.AddAttempts(i)
+	return juo
+}
+
+// SetMaxAttempts sets the max_attempts field.
+func (juo *JobUpdateOne) SetMaxAttempts(i int) *JobUpdateOne {
+	juo.mutation.ResetMaxAttempts()
+	juo.mutation.SetMaxAttempts(i)
+	return juo
+}
+
+// SetNillableMaxAttempts sets the max_attempts field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableMaxAttempts(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetMaxAttempts(*i)
+	}
+	return juo
+}
+
+// AddMaxAttempts adds i to max_attempts.
+func (juo *JobUpdateOne) AddMaxAttempts(i int) *JobUpdateOne {
+	juo.mutation.AddMaxAttempts(i)
+	return juo
+}
+
+// SetRunAt sets the run_at field.
+func (juo *JobUpdateOne) SetRunAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetRunAt(t)
+	return juo
+}
+
+// SetNillableRunAt sets the run_at field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableRunAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetRunAt(*t)
+	}
+	return juo
+}
+
+// SetLockedBy sets the locked_by field.
+func (juo *JobUpdateOne) SetLockedBy(s string) *JobUpdateOne {
+	juo.mutation.SetLockedBy(s)
+	return juo
+}
+
+// SetNillableLockedBy sets the locked_by field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLockedBy(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetLockedBy(*s)
+	}
+	return juo
+}
+
+// ClearLockedBy clears the value of locked_by.
+func (juo *JobUpdateOne) ClearLockedBy() *JobUpdateOne {
+	juo.mutation.ClearLockedBy()
+	return juo
+}
+
+// SetLockedUntil sets the locked_until field.
+func (juo *JobUpdateOne) SetLockedUntil(t time.Time) *JobUpdateOne {
+	juo.mutation.SetLockedUntil(t)
+	return juo
+}
+
+// SetNillableLockedUntil sets the locked_until field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLockedUntil(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetLockedUntil(*t)
+	}
+	return juo
+}
+
+// ClearLockedUntil clears the value of locked_until.
+func (juo *JobUpdateOne) ClearLockedUntil() *JobUpdateOne {
+	juo.mutation.ClearLockedUntil()
+	return juo
+}
+
+// SetLastError sets the last_error field.
+func (juo *JobUpdateOne) SetLastError(s string) *JobUpdateOne {
+	juo.mutation.SetLastError(s)
+	return juo
+}
+
+// SetNillableLastError sets the last_error field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLastError(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetLastError(*s)
+	}
+	return juo
+}
+
+// ClearLastError clears the value of last_error.
+func (juo *JobUpdateOne) ClearLastError() *JobUpdateOne {
+	juo.mutation.ClearLastError()
+	return juo
+}
+
+// SetFinishedAt sets the finished_at field.
+func (juo *JobUpdateOne) SetFinishedAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetFinishedAt(t)
+	return juo
+}
+
+// SetNillableFinishedAt sets the finished_at field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableFinishedAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetFinishedAt(*t)
+	}
+	return juo
+}
+
+// ClearFinishedAt clears the value of finished_at.
+func (juo *JobUpdateOne) ClearFinishedAt() *JobUpdateOne {
+	juo.mutation.ClearFinishedAt()
+	return juo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (juo *JobUpdateOne) SetOrganizationID(id int) *JobUpdateOne {
+	juo.mutation.SetOrganizationID(id)
+	return juo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableOrganizationID(id *int) *JobUpdateOne {
+	if id != nil {
+		juo = juo.SetOrganizationID(*id)
+	}
+	return juo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (juo *JobUpdateOne) SetOrganization(o *Organization) *JobUpdateOne {
+	return juo.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (juo *JobUpdateOne) Mutation() *JobMutation {
+	return juo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (juo *JobUpdateOne) ClearOrganization() *JobUpdateOne {
+	juo.mutation.ClearOrganization()
+	return juo
+}
+
+// Save executes the query and returns the updated entity.
+func (juo *JobUpdateOne) Save(ctx context.Context) (*Job, error) {
+	if _, ok := juo.mutation.UpdateTime(); !ok {
+		v := job.UpdateDefaultUpdateTime()
+		juo.mutation.SetUpdateTime(v)
+	}
+	if v, ok := juo.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return nil, &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if v, ok := juo.mutation.MaxAttempts(); ok {
+		if err := job.MaxAttemptsValidator(v); err != nil {
+			return nil, &ValidationError{Name: "max_attempts", err: fmt.Errorf("ent: validator failed for field \"max_attempts\": %w", err)}
+		}
+	}
+
+	var (
+		err  error
+		node *Job
+	)
+	if len(juo.hooks) == 0 {
+		node, err = juo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			juo.mutation = mutation
+			node, err = juo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(juo.hooks) - 1; i >= 0; i-- {
+			mut = juo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, juo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (juo *JobUpdateOne) SaveX(ctx context.Context) *Job {
+	j, err := juo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// Exec executes the query on the entity.
+func (juo *JobUpdateOne) Exec(ctx context.Context) error {
+	_, err := juo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (juo *JobUpdateOne) ExecX(ctx context.Context) {
+	if err := juo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (juo *JobUpdateOne) sqlSave(ctx context.Context) (j *Job, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   job.Table,
+			Columns: job.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: job.FieldID,
+			},
+		},
+	}
+	id, ok := juo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Job.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := juo.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldUpdateTime,
+		})
+	}
+	if value, ok := juo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: job.FieldStatus,
+		})
+	}
+	if value, ok := juo.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldAttempts,
+		})
+	}
+	if value, ok := juo.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldAttempts,
+		})
+	}
+	if value, ok := juo.mutation.MaxAttempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldMaxAttempts,
+		})
+	}
+	if value, ok := juo.mutation.AddedMaxAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldMaxAttempts,
+		})
+	}
+	if value, ok := juo.mutation.RunAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldRunAt,
+		})
+	}
+	if value, ok := juo.mutation.LockedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldLockedBy,
+		})
+	}
+	if juo.mutation.LockedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: job.FieldLockedBy,
+		})
+	}
+	if value, ok := juo.mutation.LockedUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldLockedUntil,
+		})
+	}
+	if juo.mutation.LockedUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: job.FieldLockedUntil,
+		})
+	}
+	if value, ok := juo.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldLastError,
+		})
+	}
+	if juo.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: job.FieldLastError,
+		})
+	}
+	if value, ok := juo.mutation.FinishedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldFinishedAt,
+		})
+	}
+	if juo.mutation.FinishedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: job.FieldFinishedAt,
+		})
+	}
+	if juo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   job.OrganizationTable,
+			Columns: []string{job.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := juo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   job.OrganizationTable,
+			Columns: []string{job.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	j = &Job{config: juo.config}
+	_spec.Assign = j.assignValues
+	_spec.ScanValues = j.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, juo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return j, nil
+}
@@ -0,0 +1,227 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Location is the model entity for the Location schema.
+type Location struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind location.Kind `json:"kind,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LocationQuery when eager-loading is set.
+	Edges             LocationEdges `json:"edges"`
+	organization_id   *int
+	location_children *int
+}
+
+// LocationEdges holds the relations/edges for other nodes in the graph.
+type LocationEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Parent holds the value of the parent edge.
+	Parent *Location
+	// Children holds the value of the children edge.
+	Children []*Location
+	// Equipment holds the value of the equipment edge.
+	Equipment []*Equipment
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LocationEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LocationEdges) ParentOrErr() (*Location, error) {
+	if e.loadedTypes[1] {
+		if e.Parent == nil {
+			// The edge parent was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: location.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) ChildrenOrErr() ([]*Location, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// EquipmentOrErr returns the Equipment value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) EquipmentOrErr() ([]*Equipment, error) {
+	if e.loadedTypes[3] {
+		return e.Equipment, nil
+	}
+	return nil, &NotLoadedError{edge: "equipment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Location) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullTime{},   // update_time
+		&sql.NullString{}, // name
+		&sql.NullString{}, // kind
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Location) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+		&sql.NullInt64{}, // location_children
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Location fields.
+func (l *Location) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(location.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	l.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		l.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field update_time", values[1])
+	} else if value.Valid {
+		l.UpdateTime = value.Time
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[2])
+	} else if value.Valid {
+		l.Name = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field kind", values[3])
+	} else if value.Valid {
+		l.Kind = location.Kind(value.String)
+	}
+	values = values[4:]
+	if len(values) == len(location.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			l.organization_id = new(int)
+			*l.organization_id = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field location_children", value)
+		} else if value.Valid {
+			l.location_children = new(int)
+			*l.location_children = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Location.
+func (l *Location) QueryOrganization() *OrganizationQuery {
+	return (&LocationClient{config: l.config}).QueryOrganization(l)
+}
+
+// QueryParent queries the parent edge of the Location.
+func (l *Location) QueryParent() *LocationQuery {
+	return (&LocationClient{config: l.config}).QueryParent(l)
+}
+
+// QueryChildren queries the children edge of the Location.
+func (l *Location) QueryChildren() *LocationQuery {
+	return (&LocationClient{config: l.config}).QueryChildren(l)
+}
+
+// QueryEquipment queries the equipment edge of the Location.
+func (l *Location) QueryEquipment() *EquipmentQuery {
+	return (&LocationClient{config: l.config}).QueryEquipment(l)
+}
+
+// Update returns a builder for updating this Location.
+// Note that, you need to call Location.Unwrap() before calling this method, if this Location
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Location) Update() *LocationUpdateOne {
+	return (&LocationClient{config: l.config}).UpdateOne(l)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (l *Location) Unwrap() *Location {
+	tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Location is not a transactional entity")
+	}
+	l.config.driver = tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Location) String() string {
+	var builder strings.Builder
+	builder.WriteString("Location(")
+	builder.WriteString(fmt.Sprintf("id=%v", l.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(l.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", update_time=")
+	builder.WriteString(l.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", name=")
+	builder.WriteString(l.Name)
+	builder.WriteString(", kind=")
+	builder.WriteString(fmt.Sprintf("%v", l.Kind))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Locations is a parsable slice of Location.
+type Locations []*Location
+
+func (l Locations) config(cfg config) {
+	for _i := range l {
+		l[_i].config = cfg
+	}
+}
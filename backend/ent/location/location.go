@@ -0,0 +1,117 @@
+// Code generated by entc, DO NOT EDIT.
+
+package location
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the location type in the database.
+	Label = "location"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeEquipment holds the string denoting the equipment edge name in mutations.
+	EdgeEquipment = "equipment"
+
+	// Table holds the table name of the location in the database.
+	Table = "locations"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "locations"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// ParentTable is the table the holds the parent relation/edge.
+	ParentTable = "locations"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "location_children"
+	// ChildrenTable is the table the holds the children relation/edge.
+	ChildrenTable = "locations"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "location_children"
+	// EquipmentTable is the table the holds the equipment relation/edge.
+	EquipmentTable = "equipment"
+	// EquipmentInverseTable is the table name for the Equipment entity.
+	// It exists in this package in order to avoid circular dependency with the "equipment" package.
+	EquipmentInverseTable = "equipment"
+	// EquipmentColumn is the table column denoting the equipment relation/edge.
+	EquipmentColumn = "location_equipment"
+)
+
+// Columns holds all SQL columns for location fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldName,
+	FieldKind,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Location type.
+var ForeignKeys = []string{
+	"organization_id",
+	"location_children",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the update_time field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	UpdateDefaultUpdateTime func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// Kind defines the type for the kind enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindCampus   Kind = "campus"
+	KindBuilding Kind = "building"
+	KindFloor    Kind = "floor"
+	KindRoom     Kind = "room"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "k" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindCampus, KindBuilding, KindFloor, KindRoom:
+		return nil
+	default:
+		return fmt.Errorf("location: invalid enum value for kind field: %q", k)
+	}
+}
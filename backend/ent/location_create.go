@@ -0,0 +1,333 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// LocationCreate is the builder for creating a Location entity.
+type LocationCreate struct {
+	config
+	mutation *LocationMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (lc *LocationCreate) SetCreateTime(t time.Time) *LocationCreate {
+	lc.mutation.SetCreateTime(t)
+	return lc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (lc *LocationCreate) SetNillableCreateTime(t *time.Time) *LocationCreate {
+	if t != nil {
+		lc.SetCreateTime(*t)
+	}
+	return lc
+}
+
+// SetUpdateTime sets the update_time field.
+func (lc *LocationCreate) SetUpdateTime(t time.Time) *LocationCreate {
+	lc.mutation.SetUpdateTime(t)
+	return lc
+}
+
+// SetNillableUpdateTime sets the update_time field if the given value is not nil.
+func (lc *LocationCreate) SetNillableUpdateTime(t *time.Time) *LocationCreate {
+	if t != nil {
+		lc.SetUpdateTime(*t)
+	}
+	return lc
+}
+
+// SetName sets the name field.
+func (lc *LocationCreate) SetName(s string) *LocationCreate {
+	lc.mutation.SetName(s)
+	return lc
+}
+
+// SetKind sets the kind field.
+func (lc *LocationCreate) SetKind(l location.Kind) *LocationCreate {
+	lc.mutation.SetKind(l)
+	return lc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (lc *LocationCreate) SetOrganizationID(id int) *LocationCreate {
+	lc.mutation.SetOrganizationID(id)
+	return lc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (lc *LocationCreate) SetNillableOrganizationID(id *int) *LocationCreate {
+	if id != nil {
+		lc = lc.SetOrganizationID(*id)
+	}
+	return lc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (lc *LocationCreate) SetOrganization(o *Organization) *LocationCreate {
+	return lc.SetOrganizationID(o.ID)
+}
+
+// SetParentID sets the parent edge to Location by id.
+func (lc *LocationCreate) SetParentID(id int) *LocationCreate {
+	lc.mutation.SetParentID(id)
+	return lc
+}
+
+// SetNillableParentID sets the parent edge to Location by id if the given value is not nil.
+func (lc *LocationCreate) SetNillableParentID(id *int) *LocationCreate {
+	if id != nil {
+		lc = lc.SetParentID(*id)
+	}
+	return lc
+}
+
+// SetParent sets the parent edge to Location.
+func (lc *LocationCreate) SetParent(l *Location) *LocationCreate {
+	return lc.SetParentID(l.ID)
+}
+
+// AddChildIDs adds the children edge to Location by ids.
+func (lc *LocationCreate) AddChildIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddChildIDs(ids...)
+	return lc
+}
+
+// AddChildren adds the children edges to Location.
+func (lc *LocationCreate) AddChildren(l ...*Location) *LocationCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lc.AddChildIDs(ids...)
+}
+
+// AddEquipmentIDs adds the equipment edge to Equipment by ids.
+func (lc *LocationCreate) AddEquipmentIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddEquipmentIDs(ids...)
+	return lc
+}
+
+// AddEquipment adds the equipment edges to Equipment.
+func (lc *LocationCreate) AddEquipment(e ...*Equipment) *LocationCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return lc.AddEquipmentIDs(ids...)
+}
+
+// Mutation returns the LocationMutation object of the builder.
+func (lc *LocationCreate) Mutation() *LocationMutation {
+	return lc.mutation
+}
+
+// Save creates the Location in the database.
+func (lc *LocationCreate) Save(ctx context.Context) (*Location, error) {
+	if _, ok := lc.mutation.CreateTime(); !ok {
+		v := location.DefaultCreateTime()
+		lc.mutation.SetCreateTime(v)
+	}
+	if _, ok := lc.mutation.UpdateTime(); !ok {
+		v := location.DefaultUpdateTime()
+		lc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := lc.mutation.Name(); !ok {
+		return nil, &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := lc.mutation.Name(); ok {
+		if err := location.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := lc.mutation.Kind(); !ok {
+		return nil, &ValidationError{Name: "kind", err: errors.New("ent: missing required field \"kind\"")}
+	}
+	if v, ok := lc.mutation.Kind(); ok {
+		if err := location.KindValidator(v); err != nil {
+			return nil, &ValidationError{Name: "kind", err: fmt.Errorf("ent: validator failed for field \"kind\": %w", err)}
+		}
+	}
+	var (
+		err  error
+		node *Location
+	)
+	if len(lc.hooks) == 0 {
+		node, err = lc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lc.mutation = mutation
+			node, err = lc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lc.hooks) - 1; i >= 0; i-- {
+			mut = lc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *LocationCreate) SaveX(ctx context.Context) *Location {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (lc *LocationCreate) sqlSave(ctx context.Context) (*Location, error) {
+	l, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	l.ID = int(id)
+	return l, nil
+}
+
+func (lc *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
+	var (
+		l     = &Location{config: lc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: location.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: location.FieldID,
+			},
+		}
+	)
+	if value, ok := lc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: location.FieldCreateTime,
+		})
+		l.CreateTime = value
+	}
+	if value, ok := lc.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: location.FieldUpdateTime,
+		})
+		l.UpdateTime = value
+	}
+	if value, ok := lc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: location.FieldName,
+		})
+		l.Name = value
+	}
+	if value, ok := lc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: location.FieldKind,
+		})
+		l.Kind = value
+	}
+	if nodes := lc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   location.OrganizationTable,
+			Columns: []string{location.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   location.ParentTable,
+			Columns: []string{location.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ChildrenTable,
+			Columns: []string{location.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.EquipmentTable,
+			Columns: []string{location.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return l, _spec
+}
@@ -0,0 +1,816 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// LocationUpdate is the builder for updating Location entities.
+type LocationUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *LocationMutation
+	predicates []predicate.Location
+}
+
+// Where adds a new predicate for the builder.
+func (lu *LocationUpdate) Where(ps ...predicate.Location) *LocationUpdate {
+	lu.predicates = append(lu.predicates, ps...)
+	return lu
+}
+
+// SetName sets the name field.
+func (lu *LocationUpdate) SetName(s string) *LocationUpdate {
+	lu.mutation.SetName(s)
+	return lu
+}
+
+// SetKind sets the kind field.
+func (lu *LocationUpdate) SetKind(l location.Kind) *LocationUpdate {
+	lu.mutation.SetKind(l)
+	return lu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (lu *LocationUpdate) SetOrganizationID(id int) *LocationUpdate {
+	lu.mutation.SetOrganizationID(id)
+	return lu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (lu *LocationUpdate) SetNillableOrganizationID(id *int) *LocationUpdate {
+	if id != nil {
+		lu = lu.SetOrganizationID(*id)
+	}
+	return lu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (lu *LocationUpdate) SetOrganization(o *Organization) *LocationUpdate {
+	return lu.SetOrganizationID(o.ID)
+}
+
+// SetParentID sets the parent edge to Location by id.
+func (lu *LocationUpdate) SetParentID(id int) *LocationUpdate {
+	lu.mutation.SetParentID(id)
+	return lu
+}
+
+// SetNillableParentID sets the parent edge to Location by id if the given value is not nil.
+func (lu *LocationUpdate) SetNillableParentID(id *int) *LocationUpdate {
+	if id != nil {
+		lu = lu.SetParentID(*id)
+	}
+	return lu
+}
+
+// SetParent sets the parent edge to Location.
+func (lu *LocationUpdate) SetParent(l *Location) *LocationUpdate {
+	return lu.SetParentID(l.ID)
+}
+
+// AddChildIDs adds the children edge to Location by ids.
+func (lu *LocationUpdate) AddChildIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddChildIDs(ids...)
+	return lu
+}
+
+// AddChildren adds the children edges to Location.
+func (lu *LocationUpdate) AddChildren(l ...*Location) *LocationUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lu.AddChildIDs(ids...)
+}
+
+// AddEquipmentIDs adds the equipment edge to Equipment by ids.
+func (lu *LocationUpdate) AddEquipmentIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddEquipmentIDs(ids...)
+	return lu
+}
+
+// AddEquipment adds the equipment edges to Equipment.
+func (lu *LocationUpdate) AddEquipment(e ...*Equipment) *LocationUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return lu.AddEquipmentIDs(ids...)
+}
+
+// Mutation returns the LocationMutation object of the builder.
+func (lu *LocationUpdate) Mutation() *LocationMutation {
+	return lu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (lu *LocationUpdate) ClearOrganization() *LocationUpdate {
+	lu.mutation.ClearOrganization()
+	return lu
+}
+
+// ClearParent clears the parent edge to Location.
+func (lu *LocationUpdate) ClearParent() *LocationUpdate {
+	lu.mutation.ClearParent()
+	return lu
+}
+
+// RemoveChildIDs removes the children edge to Location by ids.
+func (lu *LocationUpdate) RemoveChildIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveChildIDs(ids...)
+	return lu
+}
+
+// RemoveChildren removes children edges to Location.
+func (lu *LocationUpdate) RemoveChildren(l ...*Location) *LocationUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lu.RemoveChildIDs(ids...)
+}
+
+// RemoveEquipmentIDs removes the equipment edge to Equipment by ids.
+func (lu *LocationUpdate) RemoveEquipmentIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveEquipmentIDs(ids...)
+	return lu
+}
+
+// RemoveEquipment removes equipment edges to Equipment.
+func (lu *LocationUpdate) RemoveEquipment(e ...*Equipment) *LocationUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return lu.RemoveEquipmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (lu *LocationUpdate) Save(ctx context.Context) (int, error) {
+	if _, ok := lu.mutation.UpdateTime(); !ok {
+		v := location.UpdateDefaultUpdateTime()
+		lu.mutation.SetUpdateTime(v)
+	}
+	if v, ok := lu.mutation.Name(); ok {
+		if err := location.NameValidator(v); err != nil {
+			return 0, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := lu.mutation.Kind(); ok {
+		if err := location.KindValidator(v); err != nil {
+			return 0, &ValidationError{Name: "kind", err: fmt.Errorf("ent: validator failed for field \"kind\": %w", err)}
+		}
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(lu.hooks) == 0 {
+		affected, err = lu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lu.mutation = mutation
+			affected, err = lu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lu.hooks) - 1; i >= 0; i-- {
+			mut = lu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LocationUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LocationUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lu *LocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   location.Table,
+			Columns: location.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: location.FieldID,
+			},
+		},
+	}
+	if ps := lu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: location.FieldUpdateTime,
+		})
+	}
+	if value, ok := lu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: location.FieldName,
+		})
+	}
+	if value, ok := lu.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: location.FieldKind,
+		})
+	}
+	if lu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   location.OrganizationTable,
+			Columns: []string{location.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   location.OrganizationTable,
+			Columns: []string{location.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   location.ParentTable,
+			Columns: []string{location.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   location.ParentTable,
+			Columns: []string{location.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := lu.mutation.RemovedChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ChildrenTable,
+			Columns: []string{location.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ChildrenTable,
+			Columns: []string{location.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := lu.mutation.RemovedEquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.EquipmentTable,
+			Columns: []string{location.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.EquipmentTable,
+			Columns: []string{location.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{location.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// LocationUpdateOne is the builder for updating a single Location entity.
+type LocationUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *LocationMutation
+}
+
+// SetName sets the name field.
+func (luo *LocationUpdateOne) SetName(s string) *LocationUpdateOne {
+	luo.mutation.SetName(s)
+	return luo
+}
+
+// SetKind sets the kind field.
+func (luo *LocationUpdateOne) SetKind(l location.Kind) *LocationUpdateOne {
+	luo.mutation.SetKind(l)
+	return luo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (luo *LocationUpdateOne) SetOrganizationID(id int) *LocationUpdateOne {
+	luo.mutation.SetOrganizationID(id)
+	return luo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (luo *LocationUpdateOne) SetNillableOrganizationID(id *int) *LocationUpdateOne {
+	if id != nil {
+		luo = luo.SetOrganizationID(*id)
+	}
+	return luo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (luo *LocationUpdateOne) SetOrganization(o *Organization) *LocationUpdateOne {
+	return luo.SetOrganizationID(o.ID)
+}
+
+// SetParentID sets the parent edge to Location by id.
+func (luo *LocationUpdateOne) SetParentID(id int) *LocationUpdateOne {
+	luo.mutation.SetParentID(id)
+	return luo
+}
+
+// SetNillableParentID sets the parent edge to Location by id if the given value is not nil.
+func (luo *LocationUpdateOne) SetNillableParentID(id *int) *LocationUpdateOne {
+	if id != nil {
+		luo = luo.SetParentID(*id)
+	}
+	return luo
+}
+
+// SetParent sets the parent edge to Location.
+func (luo *LocationUpdateOne) SetParent(l *Location) *LocationUpdateOne {
+	return luo.SetParentID(l.ID)
+}
+
+// AddChildIDs adds the children edge to Location by ids.
+func (luo *LocationUpdateOne) AddChildIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddChildIDs(ids...)
+	return luo
+}
+
+// AddChildren adds the children edges to Location.
+func (luo *LocationUpdateOne) AddChildren(l ...*Location) *LocationUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.AddChildIDs(ids...)
+}
+
+// AddEquipmentIDs adds the equipment edge to Equipment by ids.
+func (luo *LocationUpdateOne) AddEquipmentIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddEquipmentIDs(ids...)
+	return luo
+}
+
+// AddEquipment adds the equipment edges to Equipment.
+func (luo *LocationUpdateOne) AddEquipment(e ...*Equipment) *LocationUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return luo.AddEquipmentIDs(ids...)
+}
+
+// Mutation returns the LocationMutation object of the builder.
+func (luo *LocationUpdateOne) Mutation() *LocationMutation {
+	return luo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (luo *LocationUpdateOne) ClearOrganization() *LocationUpdateOne {
+	luo.mutation.ClearOrganization()
+	return luo
+}
+
+// ClearParent clears the parent edge to Location.
+func (luo *LocationUpdateOne) ClearParent() *LocationUpdateOne {
+	luo.mutation.ClearParent()
+	return luo
+}
+
+// RemoveChildIDs removes the children edge to Location by ids.
+func (luo *LocationUpdateOne) RemoveChildIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveChildIDs(ids...)
+	return luo
+}
+
+// RemoveChildren removes children edges to Location.
+func (luo *LocationUpdateOne) RemoveChildren(l ...*Location) *LocationUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.RemoveChildIDs(ids...)
+}
+
+// RemoveEquipmentIDs removes the equipment edge to Equipment by ids.
+func (luo *LocationUpdateOne) RemoveEquipmentIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveEquipmentIDs(ids...)
+	return luo
+}
+
+// RemoveEquipment removes equipment edges to Equipment.
+func (luo *LocationUpdateOne) RemoveEquipment(e ...*Equipment) *LocationUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return luo.RemoveEquipmentIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (luo *LocationUpdateOne) Save(ctx context.Context) (*Location, error) {
+	if _, ok := luo.mutation.UpdateTime(); !ok {
+		v := location.UpdateDefaultUpdateTime()
+		luo.mutation.SetUpdateTime(v)
+	}
+	if v, ok := luo.mutation.Name(); ok {
+		if err := location.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := luo.mutation.Kind(); ok {
+		if err := location.KindValidator(v); err != nil {
+			return nil, &ValidationError{Name: "kind", err: fmt.Errorf("ent: validator failed for field \"kind\": %w", err)}
+		}
+	}
+
+	var (
+		err  error
+		node *Location
+	)
+	if len(luo.hooks) == 0 {
+		node, err = luo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			luo.mutation = mutation
+			node, err = luo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(luo.hooks) - 1; i >= 0; i-- {
+			mut = luo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, luo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LocationUpdateOne) SaveX(ctx context.Context) *Location {
+	l, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// Exec executes the query on the entity.
+func (luo *LocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LocationUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (luo *LocationUpdateOne) sqlSave(ctx context.Context) (l *Location, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   location.Table,
+			Columns: location.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: location.FieldID,
+			},
+		},
+	}
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Location.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := luo.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: location.FieldUpdateTime,
+		})
+	}
+	if value, ok := luo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: location.FieldName,
+		})
+	}
+	if value, ok := luo.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: location.FieldKind,
+		})
+	}
+	if luo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   location.OrganizationTable,
+			Columns: []string{location.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   location.OrganizationTable,
+			Columns: []string{location.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   location.ParentTable,
+			Columns: []string{location.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   location.ParentTable,
+			Columns: []string{location.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := luo.mutation.RemovedChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ChildrenTable,
+			Columns: []string{location.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ChildrenTable,
+			Columns: []string{location.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: location.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := luo.mutation.RemovedEquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.EquipmentTable,
+			Columns: []string{location.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.EquipmentTable,
+			Columns: []string{location.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	l = &Location{config: luo.config}
+	_spec.Assign = l.assignValues
+	_spec.ScanValues = l.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{location.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return l, nil
+}
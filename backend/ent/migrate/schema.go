@@ -8,24 +8,941 @@ import (
 )
 
 var (
+	// ActivitiesColumns holds the columns for the "activities" table.
+	ActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"status_changed", "priority_changed", "assigned", "unassigned", "escalated"}},
+		{Name: "from", Type: field.TypeString, Nullable: true},
+		{Name: "to", Type: field.TypeString, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "activity_actor", Type: field.TypeInt, Nullable: true},
+		{Name: "activity_technician", Type: field.TypeInt, Nullable: true},
+		{Name: "repair_slip_activities", Type: field.TypeInt, Nullable: true},
+	}
+	// ActivitiesTable holds the schema information for the "activities" table.
+	ActivitiesTable = &schema.Table{
+		Name:       "activities",
+		Columns:    ActivitiesColumns,
+		PrimaryKey: []*schema.Column{ActivitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "activities_organizations_organization",
+				Columns: []*schema.Column{ActivitiesColumns[5]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "activities_users_actor",
+				Columns: []*schema.Column{ActivitiesColumns[6]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "activities_users_technician",
+				Columns: []*schema.Column{ActivitiesColumns[7]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "activities_repair_slips_activities",
+				Columns: []*schema.Column{ActivitiesColumns[8]},
+
+				RefColumns: []*schema.Column{RepairSlipsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "filename", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "thumbnail", Type: field.TypeBool},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "repair_slip_attachments", Type: field.TypeInt, Nullable: true},
+		{Name: "user_attachments", Type: field.TypeInt, Nullable: true},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "attachments_organizations_organization",
+				Columns: []*schema.Column{AttachmentsColumns[7]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "attachments_repair_slips_attachments",
+				Columns: []*schema.Column{AttachmentsColumns[8]},
+
+				RefColumns: []*schema.Column{RepairSlipsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "attachments_users_attachments",
+				Columns: []*schema.Column{AttachmentsColumns[9]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "internal"}, Default: "public"},
+		{Name: "edited", Type: field.TypeBool},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "comment_author", Type: field.TypeInt, Nullable: true},
+		{Name: "repair_slip_comments", Type: field.TypeInt, Nullable: true},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "comments_organizations_organization",
+				Columns: []*schema.Column{CommentsColumns[6]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "comments_users_author",
+				Columns: []*schema.Column{CommentsColumns[7]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "comments_repair_slips_comments",
+				Columns: []*schema.Column{CommentsColumns[8]},
+
+				RefColumns: []*schema.Column{RepairSlipsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// CommentRevisionsColumns holds the columns for the "comment_revisions" table.
+	CommentRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "comment_revisions", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CommentRevisionsTable holds the schema information for the "comment_revisions" table.
+	CommentRevisionsTable = &schema.Table{
+		Name:       "comment_revisions",
+		Columns:    CommentRevisionsColumns,
+		PrimaryKey: []*schema.Column{CommentRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "comment_revisions_comments_revisions",
+				Columns: []*schema.Column{CommentRevisionsColumns[3]},
+
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "comment_revisions_organizations_organization",
+				Columns: []*schema.Column{CommentRevisionsColumns[4]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// EquipmentColumns holds the columns for the "equipment" table.
+	EquipmentColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "serial_number", Type: field.TypeString},
+		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "asset_tag", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "warranty_start", Type: field.TypeTime, Nullable: true},
+		{Name: "warranty_end", Type: field.TypeTime, Nullable: true},
+		{Name: "warranty_provider", Type: field.TypeString, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "location_equipment", Type: field.TypeInt, Nullable: true},
+		{Name: "warranty_term_equipment", Type: field.TypeInt, Nullable: true},
+	}
+	// EquipmentTable holds the schema information for the "equipment" table.
+	EquipmentTable = &schema.Table{
+		Name:       "equipment",
+		Columns:    EquipmentColumns,
+		PrimaryKey: []*schema.Column{EquipmentColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "equipment_organizations_organization",
+				Columns: []*schema.Column{EquipmentColumns[10]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "equipment_locations_equipment",
+				Columns: []*schema.Column{EquipmentColumns[11]},
+
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "equipment_warranty_terms_equipment",
+				Columns: []*schema.Column{EquipmentColumns[12]},
+
+				RefColumns: []*schema.Column{WarrantyTermsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "equipment_serial_number_organization_id",
+				Unique:  true,
+				Columns: []*schema.Column{EquipmentColumns[4], EquipmentColumns[10]},
+			},
+			{
+				Name:    "equipment_asset_tag_organization_id",
+				Unique:  true,
+				Columns: []*schema.Column{EquipmentColumns[6], EquipmentColumns[10]},
+			},
+		},
+	}
+	// EquipmentMovesColumns holds the columns for the "equipment_moves" table.
+	EquipmentMovesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "equipment_moves", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "equipment_move_from_location", Type: field.TypeInt, Nullable: true},
+		{Name: "equipment_move_to_location", Type: field.TypeInt, Nullable: true},
+		{Name: "equipment_move_moved_by", Type: field.TypeInt, Nullable: true},
+	}
+	// EquipmentMovesTable holds the schema information for the "equipment_moves" table.
+	EquipmentMovesTable = &schema.Table{
+		Name:       "equipment_moves",
+		Columns:    EquipmentMovesColumns,
+		PrimaryKey: []*schema.Column{EquipmentMovesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "equipment_moves_equipment_moves",
+				Columns: []*schema.Column{EquipmentMovesColumns[3]},
+
+				RefColumns: []*schema.Column{EquipmentColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "equipment_moves_organizations_organization",
+				Columns: []*schema.Column{EquipmentMovesColumns[4]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "equipment_moves_locations_from_location",
+				Columns: []*schema.Column{EquipmentMovesColumns[5]},
+
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "equipment_moves_locations_to_location",
+				Columns: []*schema.Column{EquipmentMovesColumns[6]},
+
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "equipment_moves_users_moved_by",
+				Columns: []*schema.Column{EquipmentMovesColumns[7]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// HolidaysColumns holds the columns for the "holidays" table.
+	HolidaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// HolidaysTable holds the schema information for the "holidays" table.
+	HolidaysTable = &schema.Table{
+		Name:       "holidays",
+		Columns:    HolidaysColumns,
+		PrimaryKey: []*schema.Column{HolidaysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "holidays_organizations_organization",
+				Columns: []*schema.Column{HolidaysColumns[3]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "holiday_date_organization_id",
+				Unique:  true,
+				Columns: []*schema.Column{HolidaysColumns[1], HolidaysColumns[3]},
+			},
+		},
+	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "number", Type: field.TypeString, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"invoice", "credit_note"}, Default: "invoice"},
+		{Name: "currency", Type: field.TypeString, Default: "THB"},
+		{Name: "subtotal", Type: field.TypeInt64},
+		{Name: "discount", Type: field.TypeInt64},
+		{Name: "vat_rate", Type: field.TypeInt64},
+		{Name: "vat", Type: field.TypeInt64},
+		{Name: "total", Type: field.TypeInt64},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "payment_status", Type: field.TypeEnum, Enums: []string{"unpaid", "partial", "paid", "refund_due"}, Default: "unpaid"},
+		{Name: "amount_paid", Type: field.TypeInt64},
+		{Name: "amount_credited", Type: field.TypeInt64},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "invoice_credit_notes", Type: field.TypeInt, Nullable: true},
+		{Name: "repair_slip_invoice", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "user_invoices", Type: field.TypeInt, Nullable: true},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "invoices_organizations_organization",
+				Columns: []*schema.Column{InvoicesColumns[14]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "invoices_invoices_credit_notes",
+				Columns: []*schema.Column{InvoicesColumns[15]},
+
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "invoices_repair_slips_invoice",
+				Columns: []*schema.Column{InvoicesColumns[16]},
+
+				RefColumns: []*schema.Column{RepairSlipsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "invoices_users_invoices",
+				Columns: []*schema.Column{InvoicesColumns[17]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// InvoiceLinesColumns holds the columns for the "invoice_lines" table.
+	InvoiceLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"labour", "part", "fee"}},
+		{Name: "description", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt64},
+		{Name: "unit_price", Type: field.TypeInt64},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "invoice_lines", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// InvoiceLinesTable holds the schema information for the "invoice_lines" table.
+	InvoiceLinesTable = &schema.Table{
+		Name:       "invoice_lines",
+		Columns:    InvoiceLinesColumns,
+		PrimaryKey: []*schema.Column{InvoiceLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "invoice_lines_invoices_lines",
+				Columns: []*schema.Column{InvoiceLinesColumns[6]},
+
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "invoice_lines_organizations_organization",
+				Columns: []*schema.Column{InvoiceLinesColumns[7]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647, Default: "{}"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "succeeded", "dead", "cancelled"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 5},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "locked_by", Type: field.TypeString, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "jobs_organizations_organization",
+				Columns: []*schema.Column{JobsColumns[13]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[5], JobsColumns[8]},
+			},
+		},
+	}
+	// LocationsColumns holds the columns for the "locations" table.
+	LocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"campus", "building", "floor", "room"}},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "location_children", Type: field.TypeInt, Nullable: true},
+	}
+	// LocationsTable holds the schema information for the "locations" table.
+	LocationsTable = &schema.Table{
+		Name:       "locations",
+		Columns:    LocationsColumns,
+		PrimaryKey: []*schema.Column{LocationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "locations_organizations_organization",
+				Columns: []*schema.Column{LocationsColumns[5]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "locations_locations_children",
+				Columns: []*schema.Column{LocationsColumns[6]},
+
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "location_name_location_children_organization_id",
+				Unique:  true,
+				Columns: []*schema.Column{LocationsColumns[3], LocationsColumns[6], LocationsColumns[5]},
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:        "organizations",
+		Columns:     OrganizationsColumns,
+		PrimaryKey:  []*schema.Column{OrganizationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// PartsColumns holds the columns for the "parts" table.
+	PartsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sku", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "unit", Type: field.TypeString, Default: "pcs"},
+		{Name: "unit_price", Type: field.TypeInt64},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// PartsTable holds the schema information for the "parts" table.
+	PartsTable = &schema.Table{
+		Name:       "parts",
+		Columns:    PartsColumns,
+		PrimaryKey: []*schema.Column{PartsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "parts_organizations_organization",
+				Columns: []*schema.Column{PartsColumns[5]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PaymentsColumns holds the columns for the "payments" table.
+	PaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "number", Type: field.TypeString, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"payment", "refund"}, Default: "payment"},
+		{Name: "method", Type: field.TypeEnum, Enums: []string{"cash", "bank_transfer", "promptpay"}},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "paid_at", Type: field.TypeTime},
+		{Name: "invoice_payments", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// PaymentsTable holds the schema information for the "payments" table.
+	PaymentsTable = &schema.Table{
+		Name:       "payments",
+		Columns:    PaymentsColumns,
+		PrimaryKey: []*schema.Column{PaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "payments_invoices_payments",
+				Columns: []*schema.Column{PaymentsColumns[8]},
+
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "payments_organizations_organization",
+				Columns: []*schema.Column{PaymentsColumns[9]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// RepairSlipsColumns holds the columns for the "repair_slips" table.
+	RepairSlipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "symptom", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"received", "in_progress", "waiting_parts", "ready", "closed"}, Default: "received"},
+		{Name: "under_warranty", Type: field.TypeBool},
+		{Name: "route", Type: field.TypeEnum, Enums: []string{"in_house", "warranty_claim"}, Default: "in_house"},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"low", "normal", "high", "urgent"}, Default: "normal"},
+		{Name: "response_due", Type: field.TypeTime, Nullable: true},
+		{Name: "resolution_due", Type: field.TypeTime, Nullable: true},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "response_breached", Type: field.TypeBool},
+		{Name: "resolution_breached", Type: field.TypeBool},
+		{Name: "escalated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "equipment_repair_slips", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_reported_slips", Type: field.TypeInt, Nullable: true},
+		{Name: "user_assigned_slips", Type: field.TypeInt, Nullable: true},
+	}
+	// RepairSlipsTable holds the schema information for the "repair_slips" table.
+	RepairSlipsTable = &schema.Table{
+		Name:       "repair_slips",
+		Columns:    RepairSlipsColumns,
+		PrimaryKey: []*schema.Column{RepairSlipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "repair_slips_equipment_repair_slips",
+				Columns: []*schema.Column{RepairSlipsColumns[16]},
+
+				RefColumns: []*schema.Column{EquipmentColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "repair_slips_organizations_organization",
+				Columns: []*schema.Column{RepairSlipsColumns[17]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "repair_slips_users_reported_slips",
+				Columns: []*schema.Column{RepairSlipsColumns[18]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "repair_slips_users_assigned_slips",
+				Columns: []*schema.Column{RepairSlipsColumns[19]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// SLAPoliciesColumns holds the columns for the "sla_policies" table.
+	SLAPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"low", "normal", "high", "urgent"}},
+		{Name: "category", Type: field.TypeString, Default: ""},
+		{Name: "response_minutes", Type: field.TypeInt},
+		{Name: "resolution_minutes", Type: field.TypeInt},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// SLAPoliciesTable holds the schema information for the "sla_policies" table.
+	SLAPoliciesTable = &schema.Table{
+		Name:       "sla_policies",
+		Columns:    SLAPoliciesColumns,
+		PrimaryKey: []*schema.Column{SLAPoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "sla_policies_organizations_organization",
+				Columns: []*schema.Column{SLAPoliciesColumns[5]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "slapolicy_priority_category_organization_id",
+				Unique:  true,
+				Columns: []*schema.Column{SLAPoliciesColumns[1], SLAPoliciesColumns[2], SLAPoliciesColumns[5]},
+			},
+		},
+	}
+	// StockLevelsColumns holds the columns for the "stock_levels" table.
+	StockLevelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "location", Type: field.TypeString},
+		{Name: "on_hand", Type: field.TypeInt},
+		{Name: "part_stock_levels", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// StockLevelsTable holds the schema information for the "stock_levels" table.
+	StockLevelsTable = &schema.Table{
+		Name:       "stock_levels",
+		Columns:    StockLevelsColumns,
+		PrimaryKey: []*schema.Column{StockLevelsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "stock_levels_parts_stock_levels",
+				Columns: []*schema.Column{StockLevelsColumns[3]},
+
+				RefColumns: []*schema.Column{PartsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "stock_levels_organizations_organization",
+				Columns: []*schema.Column{StockLevelsColumns[4]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stocklevel_location_part_stock_levels",
+				Unique:  true,
+				Columns: []*schema.Column{StockLevelsColumns[1], StockLevelsColumns[3]},
+			},
+		},
+	}
+	// StockMovementsColumns holds the columns for the "stock_movements" table.
+	StockMovementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"receipt", "consumption", "adjustment"}},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "location", Type: field.TypeString},
+		{Name: "reason_code", Type: field.TypeEnum, Nullable: true, Enums: []string{"count_correction", "damaged", "lost", "found", "returned_to_vendor", "other"}},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "part_movements", Type: field.TypeInt, Nullable: true},
+		{Name: "repair_slip_parts_used", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// StockMovementsTable holds the schema information for the "stock_movements" table.
+	StockMovementsTable = &schema.Table{
+		Name:       "stock_movements",
+		Columns:    StockMovementsColumns,
+		PrimaryKey: []*schema.Column{StockMovementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "stock_movements_parts_movements",
+				Columns: []*schema.Column{StockMovementsColumns[7]},
+
+				RefColumns: []*schema.Column{PartsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "stock_movements_repair_slips_parts_used",
+				Columns: []*schema.Column{StockMovementsColumns[8]},
+
+				RefColumns: []*schema.Column{RepairSlipsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "stock_movements_organizations_organization",
+				Columns: []*schema.Column{StockMovementsColumns[9]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "age", Type: field.TypeInt},
 		{Name: "name", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"staff", "technician", "supervisor", "admin"}, Default: "staff"},
+		{Name: "skill", Type: field.TypeString, Nullable: true},
+		{Name: "department", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "locale", Type: field.TypeEnum, Enums: []string{"th", "en"}, Default: "th"},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true},
+		{Name: "disabled", Type: field.TypeBool},
+		{Name: "assignment_version", Type: field.TypeInt},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
-		Name:        "users",
-		Columns:     UsersColumns,
-		PrimaryKey:  []*schema.Column{UsersColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{},
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "users_organizations_organization",
+				Columns: []*schema.Column{UsersColumns[11]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// WarrantyTermsColumns holds the columns for the "warranty_terms" table.
+	WarrantyTermsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "months", Type: field.TypeInt},
+		{Name: "coverage", Type: field.TypeEnum, Enums: []string{"parts", "labour", "parts_and_labour"}, Default: "parts_and_labour"},
+		{Name: "claim_contact", Type: field.TypeString, Nullable: true},
+		{Name: "conditions", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WarrantyTermsTable holds the schema information for the "warranty_terms" table.
+	WarrantyTermsTable = &schema.Table{
+		Name:       "warranty_terms",
+		Columns:    WarrantyTermsColumns,
+		PrimaryKey: []*schema.Column{WarrantyTermsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "warranty_terms_organizations_organization",
+				Columns: []*schema.Column{WarrantyTermsColumns[7]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "failures", Type: field.TypeInt},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "webhooks_organizations_organization",
+				Columns: []*schema.Column{WebhooksColumns[9]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "response_code", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_deliveries", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "webhook_deliveries_webhooks_deliveries",
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10]},
+
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "webhook_deliveries_organizations_organization",
+				Columns: []*schema.Column{WebhookDeliveriesColumns[11]},
+
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// CommentMentionsColumns holds the columns for the "comment_mentions" table.
+	CommentMentionsColumns = []*schema.Column{
+		{Name: "comment_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// CommentMentionsTable holds the schema information for the "comment_mentions" table.
+	CommentMentionsTable = &schema.Table{
+		Name:       "comment_mentions",
+		Columns:    CommentMentionsColumns,
+		PrimaryKey: []*schema.Column{CommentMentionsColumns[0], CommentMentionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "comment_mentions_comment_id",
+				Columns: []*schema.Column{CommentMentionsColumns[0]},
+
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:  "comment_mentions_user_id",
+				Columns: []*schema.Column{CommentMentionsColumns[1]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ActivitiesTable,
+		AttachmentsTable,
+		CommentsTable,
+		CommentRevisionsTable,
+		EquipmentTable,
+		EquipmentMovesTable,
+		HolidaysTable,
+		InvoicesTable,
+		InvoiceLinesTable,
+		JobsTable,
+		LocationsTable,
+		OrganizationsTable,
+		PartsTable,
+		PaymentsTable,
+		RepairSlipsTable,
+		SLAPoliciesTable,
+		StockLevelsTable,
+		StockMovementsTable,
 		UsersTable,
+		WarrantyTermsTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
+		CommentMentionsTable,
 	}
 )
 
 func init() {
+	ActivitiesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	ActivitiesTable.ForeignKeys[1].RefTable = UsersTable
+	ActivitiesTable.ForeignKeys[2].RefTable = UsersTable
+	ActivitiesTable.ForeignKeys[3].RefTable = RepairSlipsTable
+	AttachmentsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	AttachmentsTable.ForeignKeys[1].RefTable = RepairSlipsTable
+	AttachmentsTable.ForeignKeys[2].RefTable = UsersTable
+	CommentsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	CommentsTable.ForeignKeys[1].RefTable = UsersTable
+	CommentsTable.ForeignKeys[2].RefTable = RepairSlipsTable
+	CommentRevisionsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentRevisionsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	EquipmentTable.ForeignKeys[0].RefTable = OrganizationsTable
+	EquipmentTable.ForeignKeys[1].RefTable = LocationsTable
+	EquipmentTable.ForeignKeys[2].RefTable = WarrantyTermsTable
+	EquipmentMovesTable.ForeignKeys[0].RefTable = EquipmentTable
+	EquipmentMovesTable.ForeignKeys[1].RefTable = OrganizationsTable
+	EquipmentMovesTable.ForeignKeys[2].RefTable = LocationsTable
+	EquipmentMovesTable.ForeignKeys[3].RefTable = LocationsTable
+	EquipmentMovesTable.ForeignKeys[4].RefTable = UsersTable
+	HolidaysTable.ForeignKeys[0].RefTable = OrganizationsTable
+	InvoicesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	InvoicesTable.ForeignKeys[1].RefTable = InvoicesTable
+	InvoicesTable.ForeignKeys[2].RefTable = RepairSlipsTable
+	InvoicesTable.ForeignKeys[3].RefTable = UsersTable
+	InvoiceLinesTable.ForeignKeys[0].RefTable = InvoicesTable
+	InvoiceLinesTable.ForeignKeys[1].RefTable = OrganizationsTable
+	JobsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	LocationsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	LocationsTable.ForeignKeys[1].RefTable = LocationsTable
+	PartsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	PaymentsTable.ForeignKeys[0].RefTable = InvoicesTable
+	PaymentsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	RepairSlipsTable.ForeignKeys[0].RefTable = EquipmentTable
+	RepairSlipsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	RepairSlipsTable.ForeignKeys[2].RefTable = UsersTable
+	RepairSlipsTable.ForeignKeys[3].RefTable = UsersTable
+	SLAPoliciesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	StockLevelsTable.ForeignKeys[0].RefTable = PartsTable
+	StockLevelsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	StockMovementsTable.ForeignKeys[0].RefTable = PartsTable
+	StockMovementsTable.ForeignKeys[1].RefTable = RepairSlipsTable
+	StockMovementsTable.ForeignKeys[2].RefTable = OrganizationsTable
+	UsersTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WarrantyTermsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WebhooksTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	WebhookDeliveriesTable.ForeignKeys[1].RefTable = OrganizationsTable
+	CommentMentionsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentMentionsTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -0,0 +1,139 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// OrganizationCreate is the builder for creating a Organization entity.
+type OrganizationCreate struct {
+	config
+	mutation *OrganizationMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (oc *OrganizationCreate) SetCreateTime(t time.Time) *OrganizationCreate {
+	oc.mutation.SetCreateTime(t)
+	return oc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableCreateTime(t *time.Time) *OrganizationCreate {
+	if t != nil {
+		oc.SetCreateTime(*t)
+	}
+	return oc
+}
+
+// SetName sets the name field.
+func (oc *OrganizationCreate) SetName(s string) *OrganizationCreate {
+	oc.mutation.SetName(s)
+	return oc
+}
+
+// Mutation returns the OrganizationMutation object of the builder.
+func (oc *OrganizationCreate) Mutation() *OrganizationMutation {
+	return oc.mutation
+}
+
+// Save creates the Organization in the database.
+func (oc *OrganizationCreate) Save(ctx context.Context) (*Organization, error) {
+	if _, ok := oc.mutation.CreateTime(); !ok {
+		v := organization.DefaultCreateTime()
+		oc.mutation.SetCreateTime(v)
+	}
+	if _, ok := oc.mutation.Name(); !ok {
+		return nil, &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := oc.mutation.Name(); ok {
+		if err := organization.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	var (
+		err  error
+		node *Organization
+	)
+	if len(oc.hooks) == 0 {
+		node, err = oc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OrganizationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			oc.mutation = mutation
+			node, err = oc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(oc.hooks) - 1; i >= 0; i-- {
+			mut = oc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, oc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oc *OrganizationCreate) SaveX(ctx context.Context) *Organization {
+	v, err := oc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (oc *OrganizationCreate) sqlSave(ctx context.Context) (*Organization, error) {
+	o, _spec := oc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	o.ID = int(id)
+	return o, nil
+}
+
+func (oc *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec) {
+	var (
+		o     = &Organization{config: oc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: organization.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: organization.FieldID,
+			},
+		}
+	)
+	if value, ok := oc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: organization.FieldCreateTime,
+		})
+		o.CreateTime = value
+	}
+	if value, ok := oc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: organization.FieldName,
+		})
+		o.Name = value
+	}
+	return o, _spec
+}
@@ -0,0 +1,864 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// OrganizationQuery is the builder for querying Organization entities.
+type OrganizationQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.Organization
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (oq *OrganizationQuery) Where(ps ...predicate.Organization) *OrganizationQuery {
+	oq.predicates = append(oq.predicates, ps...)
+	return oq
+}
+
+// Limit adds a limit step to the query.
+func (oq *OrganizationQuery) Limit(limit int) *OrganizationQuery {
+	oq.limit = &limit
+	return oq
+}
+
+// Offset adds an offset step to the query.
+func (oq *OrganizationQuery) Offset(offset int) *OrganizationQuery {
+	oq.offset = &offset
+	return oq
+}
+
+// Order adds an order step to the query.
+func (oq *OrganizationQuery) Order(o ...OrderFunc) *OrganizationQuery {
+	oq.order = append(oq.order, o...)
+	return oq
+}
+
+// First returns the first Organization entity in the query. Returns *NotFoundError when no organization was found.
+func (oq *OrganizationQuery) First(ctx context.Context) (*Organization, error) {
+	os, err := oq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(os) == 0 {
+		return nil, &NotFoundError{organization.Label}
+	}
+	return os[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oq *OrganizationQuery) FirstX(ctx context.Context) *Organization {
+	o, err := oq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return o
+}
+
+// FirstID returns the first Organization id in the query. Returns *NotFoundError when no id was found.
+func (oq *OrganizationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = oq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{organization.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (oq *OrganizationQuery) FirstXID(ctx context.Context) int {
+	id, err := oq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Organization entity in the query, returns an error if not exactly one entity was returned.
+func (oq *OrganizationQuery) Only(ctx context.Context) (*Organization, error) {
+	os, err := oq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(os) {
+	case 1:
+		return os[0], nil
+	case 0:
+		return nil, &NotFoundError{organization.Label}
+	default:
+		return nil, &NotSingularError{organization.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oq *OrganizationQuery) OnlyX(ctx context.Context) *Organization {
+	o, err := oq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return o
+}
+
+// OnlyID returns the only Organization id in the query, returns an error if not exactly one id was returned.
+func (oq *OrganizationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = oq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{organization.Label}
+	default:
+		err = &NotSingularError{organization.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oq *OrganizationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := oq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Organizations.
+func (oq *OrganizationQuery) All(ctx context.Context) ([]*Organization, error) {
+	if err := oq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return oq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oq *OrganizationQuery) AllX(ctx context.Context) []*Organization {
+	os, err := oq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return os
+}
+
+// IDs executes the query and returns a list of Organization ids.
+func (oq *OrganizationQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := oq.Select(organization.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oq *OrganizationQuery) IDsX(ctx context.Context) []int {
+	ids, err := oq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oq *OrganizationQuery) Count(ctx context.Context) (int, error) {
+	if err := oq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return oq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oq *OrganizationQuery) CountX(ctx context.Context) int {
+	count, err := oq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oq *OrganizationQuery) Exist(ctx context.Context) (bool, error) {
+	if err := oq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return oq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oq *OrganizationQuery) ExistX(ctx context.Context) bool {
+	exist, err := oq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oq *OrganizationQuery) Clone() *OrganizationQuery {
+	return &OrganizationQuery{
+		config:     oq.config,
+		limit:      oq.limit,
+		offset:     oq.offset,
+		order:      append([]OrderFunc{}, oq.order...),
+		unique:     append([]string{}, oq.unique...),
+		predicates: append([]predicate.Organization{}, oq.predicates...),
+		// clone intermediate query.
+		sql:  oq.sql.Clone(),
+		path: oq.path,
+	}
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Organization.Query().
+//		GroupBy(organization.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (oq *OrganizationQuery) GroupBy(field string, fields ...string) *OrganizationGroupBy {
+	group := &OrganizationGroupBy{config: oq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return oq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.Organization.Query().
+//		Select(organization.FieldCreateTime).
+//		Scan(ctx, &v)
+func (oq *OrganizationQuery) Select(field string, fields ...string) *OrganizationSelect {
+	selector := &OrganizationSelect{config: oq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return oq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (oq *OrganizationQuery) prepareQuery(ctx context.Context) error {
+	if oq.path != nil {
+		prev, err := oq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oq.sql = prev
+	}
+	return nil
+}
+
+func (oq *OrganizationQuery) sqlAll(ctx context.Context) ([]*Organization, error) {
+	var (
+		nodes = []*Organization{}
+		_spec = oq.querySpec()
+	)
+	_spec.ScanValues = func() []interface{} {
+		node := &Organization{config: oq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (oq *OrganizationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oq.querySpec()
+	return sqlgraph.CountNodes(ctx, oq.driver, _spec)
+}
+
+func (oq *OrganizationQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := oq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (oq *OrganizationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   organization.Table,
+			Columns: organization.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: organization.FieldID,
+			},
+		},
+		From:   oq.sql,
+		Unique: true,
+	}
+	if ps := oq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oq *OrganizationQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(oq.driver.Dialect())
+	t1 := builder.Table(organization.Table)
+	selector := builder.Select(t1.Columns(organization.Columns...)...).From(t1)
+	if oq.sql != nil {
+		selector = oq.sql
+		selector.Select(selector.Columns(organization.Columns...)...)
+	}
+	for _, p := range oq.predicates {
+		p(selector)
+	}
+	for _, p := range oq.order {
+		p(selector)
+	}
+	if offset := oq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OrganizationGroupBy is the builder for group-by Organization entities.
+type OrganizationGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ogb *OrganizationGroupBy) Aggregate(fns ...AggregateFunc) *OrganizationGroupBy {
+	ogb.fns = append(ogb.fns, fns...)
+	return ogb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (ogb *OrganizationGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ogb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ogb.sql = query
+	return ogb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ogb *OrganizationGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ogb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (ogb *OrganizationGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ogb.fields) > 1 {
+		return nil, errors.New("ent: OrganizationGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ogb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ogb *OrganizationGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ogb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (ogb *OrganizationGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ogb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{organization.Label}
+	default:
+		err = fmt.Errorf("ent: OrganizationGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ogb *OrganizationGroupBy) StringX(ctx context.Context) string {
+	v, err := ogb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (ogb *OrganizationGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ogb.fields) > 1 {
+		return nil, errors.New("ent: OrganizationGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ogb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ogb *OrganizationGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ogb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (ogb *OrganizationGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ogb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{organization.Label}
+	default:
+		err = fmt.Errorf("ent: OrganizationGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ogb *OrganizationGroupBy) IntX(ctx context.Context) int {
+	v, err := ogb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (ogb *OrganizationGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ogb.fields) > 1 {
+		return nil, errors.New("ent: OrganizationGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ogb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ogb *OrganizationGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ogb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (ogb *OrganizationGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ogb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{organization.Label}
+	default:
+		err = fmt.Errorf("ent: OrganizationGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ogb *OrganizationGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ogb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (ogb *OrganizationGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ogb.fields) > 1 {
+		return nil, errors.New("ent: OrganizationGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ogb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ogb *OrganizationGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ogb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (ogb *OrganizationGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ogb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{organization.Label}
+	default:
+		err = fmt.Errorf("ent: OrganizationGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ogb *OrganizationGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ogb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ogb *OrganizationGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ogb.sqlQuery().Query()
+	if err := ogb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ogb *OrganizationGroupBy) sqlQuery() *sql.Selector {
+	selector := ogb.sql
+	columns := make([]string, 0, len(ogb.fields)+len(ogb.fns))
+	columns = append(columns, ogb.fields...)
+	for _, fn := range ogb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(ogb.fields...)
+}
+
+// OrganizationSelect is the builder for select fields of Organization entities.
+type OrganizationSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (os *OrganizationSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := os.path(ctx)
+	if err != nil {
+		return err
+	}
+	os.sql = query
+	return os.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (os *OrganizationSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := os.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (os *OrganizationSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(os.fields) > 1 {
+		return nil, errors.New("ent: OrganizationSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := os.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (os *OrganizationSelect) StringsX(ctx context.Context) []string {
+	v, err := os.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (os *OrganizationSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = os.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{organization.Label}
+	default:
+		err = fmt.Errorf("ent: OrganizationSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (os *OrganizationSelect) StringX(ctx context.Context) string {
+	v, err := os.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (os *OrganizationSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(os.fields) > 1 {
+		return nil, errors.New("ent: OrganizationSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := os.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (os *OrganizationSelect) IntsX(ctx context.Context) []int {
+	v, err := os.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (os *OrganizationSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = os.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{organization.Label}
+	default:
+		err = fmt.Errorf("ent: OrganizationSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (os *OrganizationSelect) IntX(ctx context.Context) int {
+	v, err := os.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (os *OrganizationSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(os.fields) > 1 {
+		return nil, errors.New("ent: OrganizationSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := os.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (os *OrganizationSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := os.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (os *OrganizationSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = os.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{organization.Label}
+	default:
+		err = fmt.Errorf("ent: OrganizationSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (os *OrganizationSelect) Float64X(ctx context.Context) float64 {
+	v, err := os.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (os *OrganizationSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(os.fields) > 1 {
+		return nil, errors.New("ent: OrganizationSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := os.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (os *OrganizationSelect) BoolsX(ctx context.Context) []bool {
+	v, err := os.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (os *OrganizationSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = os.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{organization.Label}
+	default:
+		err = fmt.Errorf("ent: OrganizationSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (os *OrganizationSelect) BoolX(ctx context.Context) bool {
+	v, err := os.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (os *OrganizationSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := os.sqlQuery().Query()
+	if err := os.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (os *OrganizationSelect) sqlQuery() sql.Querier {
+	selector := os.sql
+	selector.Select(selector.Columns(os.fields...)...)
+	return selector
+}
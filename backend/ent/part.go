@@ -0,0 +1,198 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Part is the model entity for the Part schema.
+type Part struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Sku holds the value of the "sku" field.
+	Sku string `json:"sku,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Unit holds the value of the "unit" field.
+	Unit string `json:"unit,omitempty"`
+	// UnitPrice holds the value of the "unit_price" field.
+	UnitPrice money.Amount `json:"unit_price"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PartQuery when eager-loading is set.
+	Edges           PartEdges `json:"edges"`
+	organization_id *int
+}
+
+// PartEdges holds the relations/edges for other nodes in the graph.
+type PartEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// StockLevels holds the value of the stock_levels edge.
+	StockLevels []*StockLevel
+	// Movements holds the value of the movements edge.
+	Movements []*StockMovement
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PartEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// StockLevelsOrErr returns the StockLevels value or an error if the edge
+// was not loaded in eager-loading.
+func (e PartEdges) StockLevelsOrErr() ([]*StockLevel, error) {
+	if e.loadedTypes[1] {
+		return e.StockLevels, nil
+	}
+	return nil, &NotLoadedError{edge: "stock_levels"}
+}
+
+// MovementsOrErr returns the Movements value or an error if the edge
+// was not loaded in eager-loading.
+func (e PartEdges) MovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[2] {
+		return e.Movements, nil
+	}
+	return nil, &NotLoadedError{edge: "movements"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Part) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // sku
+		&sql.NullString{}, // name
+		&sql.NullString{}, // unit
+		&sql.NullInt64{},  // unit_price
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Part) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Part fields.
+func (pa *Part) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(part.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	pa.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field sku", values[0])
+	} else if value.Valid {
+		pa.Sku = value.String
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[1])
+	} else if value.Valid {
+		pa.Name = value.String
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field unit", values[2])
+	} else if value.Valid {
+		pa.Unit = value.String
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field unit_price", values[3])
+	} else if value.Valid {
+		pa.UnitPrice = money.Amount(value.Int64)
+	}
+	values = values[4:]
+	if len(values) == len(part.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			pa.organization_id = new(int)
+			*pa.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Part.
+func (pa *Part) QueryOrganization() *OrganizationQuery {
+	return (&PartClient{config: pa.config}).QueryOrganization(pa)
+}
+
+// QueryStockLevels queries the stock_levels edge of the Part.
+func (pa *Part) QueryStockLevels() *StockLevelQuery {
+	return (&PartClient{config: pa.config}).QueryStockLevels(pa)
+}
+
+// QueryMovements queries the movements edge of the Part.
+func (pa *Part) QueryMovements() *StockMovementQuery {
+	return (&PartClient{config: pa.config}).QueryMovements(pa)
+}
+
+// Update returns a builder for updating this Part.
+// Note that, you need to call Part.Unwrap() before calling this method, if this Part
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *Part) Update() *PartUpdateOne {
+	return (&PartClient{config: pa.config}).UpdateOne(pa)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (pa *Part) Unwrap() *Part {
+	tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Part is not a transactional entity")
+	}
+	pa.config.driver = tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *Part) String() string {
+	var builder strings.Builder
+	builder.WriteString("Part(")
+	builder.WriteString(fmt.Sprintf("id=%v", pa.ID))
+	builder.WriteString(", sku=")
+	builder.WriteString(pa.Sku)
+	builder.WriteString(", name=")
+	builder.WriteString(pa.Name)
+	builder.WriteString(", unit=")
+	builder.WriteString(pa.Unit)
+	builder.WriteString(", unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", pa.UnitPrice))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Parts is a parsable slice of Part.
+type Parts []*Part
+
+func (pa Parts) config(cfg config) {
+	for _i := range pa {
+		pa[_i].config = cfg
+	}
+}
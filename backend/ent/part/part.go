@@ -0,0 +1,83 @@
+// Code generated by entc, DO NOT EDIT.
+
+package part
+
+import (
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the part type in the database.
+	Label = "part"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSku holds the string denoting the sku field in the database.
+	FieldSku = "sku"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldUnit holds the string denoting the unit field in the database.
+	FieldUnit = "unit"
+	// FieldUnitPrice holds the string denoting the unit_price field in the database.
+	FieldUnitPrice = "unit_price"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeStockLevels holds the string denoting the stock_levels edge name in mutations.
+	EdgeStockLevels = "stock_levels"
+	// EdgeMovements holds the string denoting the movements edge name in mutations.
+	EdgeMovements = "movements"
+
+	// Table holds the table name of the part in the database.
+	Table = "parts"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "parts"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// StockLevelsTable is the table the holds the stock_levels relation/edge.
+	StockLevelsTable = "stock_levels"
+	// StockLevelsInverseTable is the table name for the StockLevel entity.
+	// It exists in this package in order to avoid circular dependency with the "stocklevel" package.
+	StockLevelsInverseTable = "stock_levels"
+	// StockLevelsColumn is the table column denoting the stock_levels relation/edge.
+	StockLevelsColumn = "part_stock_levels"
+	// MovementsTable is the table the holds the movements relation/edge.
+	MovementsTable = "stock_movements"
+	// MovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	MovementsInverseTable = "stock_movements"
+	// MovementsColumn is the table column denoting the movements relation/edge.
+	MovementsColumn = "part_movements"
+)
+
+// Columns holds all SQL columns for part fields.
+var Columns = []string{
+	FieldID,
+	FieldSku,
+	FieldName,
+	FieldUnit,
+	FieldUnitPrice,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Part type.
+var ForeignKeys = []string{
+	"organization_id",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// SkuValidator is a validator for the "sku" field. It is called by the builders before save.
+	SkuValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultUnit holds the default value on creation for the unit field.
+	DefaultUnit string
+)
@@ -0,0 +1,287 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// PartCreate is the builder for creating a Part entity.
+type PartCreate struct {
+	config
+	mutation *PartMutation
+	hooks    []Hook
+}
+
+// SetSku sets the sku field.
+func (pc *PartCreate) SetSku(s string) *PartCreate {
+	pc.mutation.SetSku(s)
+	return pc
+}
+
+// SetName sets the name field.
+func (pc *PartCreate) SetName(s string) *PartCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetUnit sets the unit field.
+func (pc *PartCreate) SetUnit(s string) *PartCreate {
+	pc.mutation.SetUnit(s)
+	return pc
+}
+
+// SetNillableUnit sets the unit field if the given value is not nil.
+func (pc *PartCreate) SetNillableUnit(s *string) *PartCreate {
+	if s != nil {
+		pc.SetUnit(*s)
+	}
+	return pc
+}
+
+// SetUnitPrice sets the unit_price field.
+func (pc *PartCreate) SetUnitPrice(m money.Amount) *PartCreate {
+	pc.mutation.SetUnitPrice(m)
+	return pc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (pc *PartCreate) SetOrganizationID(id int) *PartCreate {
+	pc.mutation.SetOrganizationID(id)
+	return pc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (pc *PartCreate) SetNillableOrganizationID(id *int) *PartCreate {
+	if id != nil {
+		pc = pc.SetOrganizationID(*id)
+	}
+	return pc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (pc *PartCreate) SetOrganization(o *Organization) *PartCreate {
+	return pc.SetOrganizationID(o.ID)
+}
+
+// AddStockLevelIDs adds the stock_levels edge to StockLevel by ids.
+func (pc *PartCreate) AddStockLevelIDs(ids ...int) *PartCreate {
+	pc.mutation.AddStockLevelIDs(ids...)
+	return pc
+}
+
+// AddStockLevels adds the stock_levels edges to StockLevel.
+func (pc *PartCreate) AddStockLevels(s ...*StockLevel) *PartCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddStockLevelIDs(ids...)
+}
+
+// AddMovementIDs adds the movements edge to StockMovement by ids.
+func (pc *PartCreate) AddMovementIDs(ids ...int) *PartCreate {
+	pc.mutation.AddMovementIDs(ids...)
+	return pc
+}
+
+// AddMovements adds the movements edges to StockMovement.
+func (pc *PartCreate) AddMovements(s ...*StockMovement) *PartCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddMovementIDs(ids...)
+}
+
+// Mutation returns the PartMutation object of the builder.
+func (pc *PartCreate) Mutation() *PartMutation {
+	return pc.mutation
+}
+
+// Save creates the Part in the database.
+func (pc *PartCreate) Save(ctx context.Context) (*Part, error) {
+	if _, ok := pc.mutation.Sku(); !ok {
+		return nil, &ValidationError{Name: "sku", err: errors.New("ent: missing required field \"sku\"")}
+	}
+	if v, ok := pc.mutation.Sku(); ok {
+		if err := part.SkuValidator(v); err != nil {
+			return nil, &ValidationError{Name: "sku", err: fmt.Errorf("ent: validator failed for field \"sku\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.Name(); !ok {
+		return nil, &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := part.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.Unit(); !ok {
+		v := part.DefaultUnit
+		pc.mutation.SetUnit(v)
+	}
+	if _, ok := pc.mutation.UnitPrice(); !ok {
+		return nil, &ValidationError{Name: "unit_price", err: errors.New("ent: missing required field \"unit_price\"")}
+	}
+	var (
+		err  error
+		node *Part
+	)
+	if len(pc.hooks) == 0 {
+		node, err = pc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PartMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pc.mutation = mutation
+			node, err = pc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pc.hooks) - 1; i >= 0; i-- {
+			mut = pc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PartCreate) SaveX(ctx context.Context) *Part {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (pc *PartCreate) sqlSave(ctx context.Context) (*Part, error) {
+	pa, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	pa.ID = int(id)
+	return pa, nil
+}
+
+func (pc *PartCreate) createSpec() (*Part, *sqlgraph.CreateSpec) {
+	var (
+		pa    = &Part{config: pc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: part.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: part.FieldID,
+			},
+		}
+	)
+	if value, ok := pc.mutation.Sku(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: part.FieldSku,
+		})
+		pa.Sku = value
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: part.FieldName,
+		})
+		pa.Name = value
+	}
+	if value, ok := pc.mutation.Unit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: part.FieldUnit,
+		})
+		pa.Unit = value
+	}
+	if value, ok := pc.mutation.UnitPrice(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: part.FieldUnitPrice,
+		})
+		pa.UnitPrice = value
+	}
+	if nodes := pc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   part.OrganizationTable,
+			Columns: []string{part.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.StockLevelsTable,
+			Columns: []string{part.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stocklevel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.MovementsTable,
+			Columns: []string{part.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return pa, _spec
+}
@@ -0,0 +1,772 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// PartUpdate is the builder for updating Part entities.
+type PartUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *PartMutation
+	predicates []predicate.Part
+}
+
+// Where adds a new predicate for the builder.
+func (pu *PartUpdate) Where(ps ...predicate.Part) *PartUpdate {
+	pu.predicates = append(pu.predicates, ps...)
+	return pu
+}
+
+// SetSku sets the sku field.
+func (pu *PartUpdate) SetSku(s string) *PartUpdate {
+	pu.mutation.SetSku(s)
+	return pu
+}
+
+// SetName sets the name field.
+func (pu *PartUpdate) SetName(s string) *PartUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetUnit sets the unit field.
+func (pu *PartUpdate) SetUnit(s string) *PartUpdate {
+	pu.mutation.SetUnit(s)
+	return pu
+}
+
+// SetNillableUnit sets the unit field if the given value is not nil.
+func (pu *PartUpdate) SetNillableUnit(s *string) *PartUpdate {
+	if s != nil {
+		pu.SetUnit(*s)
+	}
+	return pu
+}
+
+// SetUnitPrice sets the unit_price field.
+func (pu *PartUpdate) SetUnitPrice(m money.Amount) *PartUpdate {
+	pu.mutation.ResetUnitPrice()
+	pu.mutation.SetUnitPrice(m)
+	return pu
+}
+
+// AddUnitPrice adds m to unit_price.
+func (pu *PartUpdate) AddUnitPrice(m money.Amount) *PartUpdate {
+	pu.mutation.AddUnitPrice(m)
+	return pu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (pu *PartUpdate) SetOrganizationID(id int) *PartUpdate {
+	pu.mutation.SetOrganizationID(id)
+	return pu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (pu *PartUpdate) SetNillableOrganizationID(id *int) *PartUpdate {
+	if id != nil {
+		pu = pu.SetOrganizationID(*id)
+	}
+	return pu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (pu *PartUpdate) SetOrganization(o *Organization) *PartUpdate {
+	return pu.SetOrganizationID(o.ID)
+}
+
+// AddStockLevelIDs adds the stock_levels edge to StockLevel by ids.
+func (pu *PartUpdate) AddStockLevelIDs(ids ...int) *PartUpdate {
+	pu.mutation.AddStockLevelIDs(ids...)
+	return pu
+}
+
+// AddStockLevels adds the stock_levels edges to StockLevel.
+func (pu *PartUpdate) AddStockLevels(s ...*StockLevel) *PartUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddStockLevelIDs(ids...)
+}
+
+// AddMovementIDs adds the movements edge to StockMovement by ids.
+func (pu *PartUpdate) AddMovementIDs(ids ...int) *PartUpdate {
+	pu.mutation.AddMovementIDs(ids...)
+	return pu
+}
+
+// AddMovements adds the movements edges to StockMovement.
+func (pu *PartUpdate) AddMovements(s ...*StockMovement) *PartUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddMovementIDs(ids...)
+}
+
+// Mutation returns the PartMutation object of the builder.
+func (pu *PartUpdate) Mutation() *PartMutation {
+	return pu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (pu *PartUpdate) ClearOrganization() *PartUpdate {
+	pu.mutation.ClearOrganization()
+	return pu
+}
+
+// RemoveStockLevelIDs removes the stock_levels edge to StockLevel by ids.
+func (pu *PartUpdate) RemoveStockLevelIDs(ids ...int) *PartUpdate {
+	pu.mutation.RemoveStockLevelIDs(ids...)
+	return pu
+}
+
+// RemoveStockLevels removes stock_levels edges to StockLevel.
+func (pu *PartUpdate) RemoveStockLevels(s ...*StockLevel) *PartUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveStockLevelIDs(ids...)
+}
+
+// RemoveMovementIDs removes the movements edge to StockMovement by ids.
+func (pu *PartUpdate) RemoveMovementIDs(ids ...int) *PartUpdate {
+	pu.mutation.RemoveMovementIDs(ids...)
+	return pu
+}
+
+// RemoveMovements removes movements edges to StockMovement.
+func (pu *PartUpdate) RemoveMovements(s ...*StockMovement) *PartUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveMovementIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (pu *PartUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := pu.mutation.Sku(); ok {
+		if err := part.SkuValidator(v); err != nil {
+			return 0, &ValidationError{Name: "sku", err: fmt.Errorf("ent: validator failed for field \"sku\": %w", err)}
+		}
+	}
+	if v, ok := pu.mutation.Name(); ok {
+		if err := part.NameValidator(v); err != nil {
+			return 0, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(pu.hooks) == 0 {
+		affected, err = pu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PartMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pu.mutation = mutation
+			affected, err = pu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pu.hooks) - 1; i >= 0; i-- {
+			mut = pu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PartUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PartUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PartUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pu *PartUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   part.Table,
+			Columns: part.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: part.FieldID,
+			},
+		},
+	}
+	if ps := pu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Sku(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: part.FieldSku,
+		})
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: part.FieldName,
+		})
+	}
+	if value, ok := pu.mutation.Unit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: part.FieldUnit,
+		})
+	}
+	if value, ok := pu.mutation.UnitPrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: part.FieldUnitPrice,
+		})
+	}
+	if value, ok := pu.mutation.AddedUnitPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: part.FieldUnitPrice,
+		})
+	}
+	if pu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   part.OrganizationTable,
+			Columns: []string{part.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   part.OrganizationTable,
+			Columns: []string{part.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := pu.mutation.RemovedStockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.StockLevelsTable,
+			Columns: []string{part.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stocklevel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.StockLevelsTable,
+			Columns: []string{part.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stocklevel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := pu.mutation.RemovedMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.MovementsTable,
+			Columns: []string{part.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.MovementsTable,
+			Columns: []string{part.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{part.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PartUpdateOne is the builder for updating a single Part entity.
+type PartUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *PartMutation
+}
+
+// SetSku sets the sku field.
+func (puo *PartUpdateOne) SetSku(s string) *PartUpdateOne {
+	puo.mutation.SetSku(s)
+	return puo
+}
+
+// SetName sets the name field.
+func (puo *PartUpdateOne) SetName(s string) *PartUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetUnit sets the unit field.
+func (puo *PartUpdateOne) SetUnit(s string) *PartUpdateOne {
+	puo.mutation.SetUnit(s)
+	return puo
+}
+
+// SetNillableUnit sets the unit field if the given value is not nil.
+func (puo *PartUpdateOne) SetNillableUnit(s *string) *PartUpdateOne {
+	if s != nil {
+		puo.SetUnit(*s)
+	}
+	return puo
+}
+
+// SetUnitPrice sets the unit_price field.
+func (puo *PartUpdateOne) SetUnitPrice(m money.Amount) *PartUpdateOne {
+	puo.mutation.ResetUnitPrice()
+	puo.mutation.SetUnitPrice(m)
+	return puo
+}
+
+// AddUnitPrice adds m to unit_price.
+func (puo *PartUpdateOne) AddUnitPrice(m money.Amount) *PartUpdateOne {
+	puo.mutation.AddUnitPrice(m)
+	return puo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (puo *PartUpdateOne) SetOrganizationID(id int) *PartUpdateOne {
+	puo.mutation.SetOrganizationID(id)
+	return puo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (puo *PartUpdateOne) SetNillableOrganizationID(id *int) *PartUpdateOne {
+	if id != nil {
+		puo = puo.SetOrganizationID(*id)
+	}
+	return puo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (puo *PartUpdateOne) SetOrganization(o *Organization) *PartUpdateOne {
+	return puo.SetOrganizationID(o.ID)
+}
+
+// AddStockLevelIDs adds the stock_levels edge to StockLevel by ids.
+func (puo *PartUpdateOne) AddStockLevelIDs(ids ...int) *PartUpdateOne {
+	puo.mutation.AddStockLevelIDs(ids...)
+	return puo
+}
+
+// AddStockLevels adds the stock_levels edges to StockLevel.
+func (puo *PartUpdateOne) AddStockLevels(s ...*StockLevel) *PartUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddStockLevelIDs(ids...)
+}
+
+// AddMovementIDs adds the movements edge to StockMovement by ids.
+func (puo *PartUpdateOne) AddMovementIDs(ids ...int) *PartUpdateOne {
+	puo.mutation.AddMovementIDs(ids...)
+	return puo
+}
+
+// AddMovements adds the movements edges to StockMovement.
+func (puo *PartUpdateOne) AddMovements(s ...*StockMovement) *PartUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddMovementIDs(ids...)
+}
+
+// Mutation returns the PartMutation object of the builder.
+func (puo *PartUpdateOne) Mutation() *PartMutation {
+	return puo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (puo *PartUpdateOne) ClearOrganization() *PartUpdateOne {
+	puo.mutation.ClearOrganization()
+	return puo
+}
+
+// RemoveStockLevelIDs removes the stock_levels edge to StockLevel by ids.
+func (puo *PartUpdateOne) RemoveStockLevelIDs(ids ...int) *PartUpdateOne {
+	puo.mutation.RemoveStockLevelIDs(ids...)
+	return puo
+}
+
+// RemoveStockLevels removes stock_levels edges to StockLevel.
+func (puo *PartUpdateOne) RemoveStockLevels(s ...*StockLevel) *PartUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveStockLevelIDs(ids...)
+}
+
+// RemoveMovementIDs removes the movements edge to StockMovement by ids.
+func (puo *PartUpdateOne) RemoveMovementIDs(ids ...int) *PartUpdateOne {
+	puo.mutation.RemoveMovementIDs(ids...)
+	return puo
+}
+
+// RemoveMovements removes movements edges to StockMovement.
+func (puo *PartUpdateOne) RemoveMovements(s ...*StockMovement) *PartUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveMovementIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (puo *PartUpdateOne) Save(ctx context.Context) (*Part, error) {
+	if v, ok := puo.mutation.Sku(); ok {
+		if err := part.SkuValidator(v); err != nil {
+			return nil, &ValidationError{Name: "sku", err: fmt.Errorf("ent: validator failed for field \"sku\": %w", err)}
+		}
+	}
+	if v, ok := puo.mutation.Name(); ok {
+		if err := part.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+
+	var (
+		err  error
+		node *Part
+	)
+	if len(puo.hooks) == 0 {
+		node, err = puo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PartMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			puo.mutation = mutation
+			node, err = puo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puo.hooks) - 1; i >= 0; i-- {
+			mut = puo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, puo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PartUpdateOne) SaveX(ctx context.Context) *Part {
+	pa, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return pa
+}
+
+// Exec executes the query on the entity.
+func (puo *PartUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PartUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (puo *PartUpdateOne) sqlSave(ctx context.Context) (pa *Part, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   part.Table,
+			Columns: part.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: part.FieldID,
+			},
+		},
+	}
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Part.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := puo.mutation.Sku(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: part.FieldSku,
+		})
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: part.FieldName,
+		})
+	}
+	if value, ok := puo.mutation.Unit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: part.FieldUnit,
+		})
+	}
+	if value, ok := puo.mutation.UnitPrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: part.FieldUnitPrice,
+		})
+	}
+	if value, ok := puo.mutation.AddedUnitPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: part.FieldUnitPrice,
+		})
+	}
+	if puo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   part.OrganizationTable,
+			Columns: []string{part.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   part.OrganizationTable,
+			Columns: []string{part.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := puo.mutation.RemovedStockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.StockLevelsTable,
+			Columns: []string{part.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stocklevel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.StockLevelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.StockLevelsTable,
+			Columns: []string{part.StockLevelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stocklevel.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := puo.mutation.RemovedMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.MovementsTable,
+			Columns: []string{part.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   part.MovementsTable,
+			Columns: []string{part.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	pa = &Part{config: puo.config}
+	_spec.Assign = pa.assignValues
+	_spec.ScanValues = pa.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{part.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return pa, nil
+}
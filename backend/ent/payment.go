@@ -0,0 +1,227 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Payment is the model entity for the Payment schema.
+type Payment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind payment.Kind `json:"kind,omitempty"`
+	// Method holds the value of the "method" field.
+	Method payment.Method `json:"method,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount money.Amount `json:"amount"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// PaidAt holds the value of the "paid_at" field.
+	PaidAt time.Time `json:"paid_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentQuery when eager-loading is set.
+	Edges            PaymentEdges `json:"edges"`
+	invoice_payments *int
+	organization_id  *int
+}
+
+// PaymentEdges holds the relations/edges for other nodes in the graph.
+type PaymentEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.loadedTypes[1] {
+		if e.Invoice == nil {
+			// The edge invoice was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: invoice.Label}
+		}
+		return e.Invoice, nil
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Payment) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullString{}, // number
+		&sql.NullString{}, // kind
+		&sql.NullString{}, // method
+		&sql.NullInt64{},  // amount
+		&sql.NullString{}, // reference
+		&sql.NullTime{},   // paid_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Payment) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // invoice_payments
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Payment fields.
+func (pa *Payment) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(payment.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	pa.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		pa.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field number", values[1])
+	} else if value.Valid {
+		pa.Number = value.String
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field kind", values[2])
+	} else if value.Valid {
+		pa.Kind = payment.Kind(value.String)
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field method", values[3])
+	} else if value.Valid {
+		pa.Method = payment.Method(value.String)
+	}
+	if value, ok := values[4].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field amount", values[4])
+	} else if value.Valid {
+		pa.Amount = money.Amount(value.Int64)
+	}
+	if value, ok := values[5].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field reference", values[5])
+	} else if value.Valid {
+		pa.Reference = value.String
+	}
+	if value, ok := values[6].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field paid_at", values[6])
+	} else if value.Valid {
+		pa.PaidAt = value.Time
+	}
+	values = values[7:]
+	if len(values) == len(payment.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field invoice_payments", value)
+		} else if value.Valid {
+			pa.invoice_payments = new(int)
+			*pa.invoice_payments = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			pa.organization_id = new(int)
+			*pa.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Payment.
+func (pa *Payment) QueryOrganization() *OrganizationQuery {
+	return (&PaymentClient{config: pa.config}).QueryOrganization(pa)
+}
+
+// QueryInvoice queries the invoice edge of the Payment.
+func (pa *Payment) QueryInvoice() *InvoiceQuery {
+	return (&PaymentClient{config: pa.config}).QueryInvoice(pa)
+}
+
+// Update returns a builder for updating this Payment.
+// Note that, you need to call Payment.Unwrap() before calling this method, if this Payment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *Payment) Update() *PaymentUpdateOne {
+	return (&PaymentClient{config: pa.config}).UpdateOne(pa)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (pa *Payment) Unwrap() *Payment {
+	tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Payment is not a transactional entity")
+	}
+	pa.config.driver = tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *Payment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Payment(")
+	builder.WriteString(fmt.Sprintf("id=%v", pa.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(pa.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", number=")
+	builder.WriteString(pa.Number)
+	builder.WriteString(", kind=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Kind))
+	builder.WriteString(", method=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Method))
+	builder.WriteString(", amount=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Amount))
+	builder.WriteString(", reference=")
+	builder.WriteString(pa.Reference)
+	builder.WriteString(", paid_at=")
+	builder.WriteString(pa.PaidAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Payments is a parsable slice of Payment.
+type Payments []*Payment
+
+func (pa Payments) config(cfg config) {
+	for _i := range pa {
+		pa[_i].config = cfg
+	}
+}
@@ -0,0 +1,137 @@
+// Code generated by entc, DO NOT EDIT.
+
+package payment
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the payment type in the database.
+	Label = "payment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldPaidAt holds the string denoting the paid_at field in the database.
+	FieldPaidAt = "paid_at"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+
+	// Table holds the table name of the payment in the database.
+	Table = "payments"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "payments"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// InvoiceTable is the table the holds the invoice relation/edge.
+	InvoiceTable = "payments"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "invoice_payments"
+)
+
+// Columns holds all SQL columns for payment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldNumber,
+	FieldKind,
+	FieldMethod,
+	FieldAmount,
+	FieldReference,
+	FieldPaidAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Payment type.
+var ForeignKeys = []string{
+	"invoice_payments",
+	"organization_id",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [3]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+	// NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	NumberValidator func(string) error
+	// DefaultPaidAt holds the default value on creation for the paid_at field.
+	DefaultPaidAt func() time.Time
+)
+
+// Kind defines the type for the kind enum field.
+type Kind string
+
+// KindPayment is the default Kind.
+const DefaultKind = KindPayment
+
+// Kind values.
+const (
+	KindPayment Kind = "payment"
+	KindRefund  Kind = "refund"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "k" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindPayment, KindRefund:
+		return nil
+	default:
+		return fmt.Errorf("payment: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Method defines the type for the method enum field.
+type Method string
+
+// Method values.
+const (
+	MethodCash         Method = "cash"
+	MethodBankTransfer Method = "bank_transfer"
+	MethodPromptpay    Method = "promptpay"
+)
+
+func (m Method) String() string {
+	return string(m)
+}
+
+// MethodValidator is a validator for the "m" field enum values. It is called by the builders before save.
+func MethodValidator(m Method) error {
+	switch m {
+	case MethodCash, MethodBankTransfer, MethodPromptpay:
+		return nil
+	default:
+		return fmt.Errorf("payment: invalid enum value for method field: %q", m)
+	}
+}
@@ -0,0 +1,331 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// PaymentCreate is the builder for creating a Payment entity.
+type PaymentCreate struct {
+	config
+	mutation *PaymentMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (pc *PaymentCreate) SetCreateTime(t time.Time) *PaymentCreate {
+	pc.mutation.SetCreateTime(t)
+	return pc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableCreateTime(t *time.Time) *PaymentCreate {
+	if t != nil {
+		pc.SetCreateTime(*t)
+	}
+	return pc
+}
+
+// SetNumber sets the number field.
+func (pc *PaymentCreate) SetNumber(s string) *PaymentCreate {
+	pc.mutation.SetNumber(s)
+	return pc
+}
+
+// SetKind sets the kind field.
+func (pc *PaymentCreate) SetKind(pa payment.Kind) *PaymentCreate {
+	pc.mutation.SetKind(pa)
+	return pc
+}
+
+// SetNillableKind sets the kind field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableKind(pa *payment.Kind) *PaymentCreate {
+	if pa != nil {
+		pc.SetKind(*pa)
+	}
+	return pc
+}
+
+// SetMethod sets the method field.
+func (pc *PaymentCreate) SetMethod(pa payment.Method) *PaymentCreate {
+	pc.mutation.SetMethod(pa)
+	return pc
+}
+
+// SetAmount sets the amount field.
+func (pc *PaymentCreate) SetAmount(m money.Amount) *PaymentCreate {
+	pc.mutation.SetAmount(m)
+	return pc
+}
+
+// SetReference sets the reference field.
+func (pc *PaymentCreate) SetReference(s string) *PaymentCreate {
+	pc.mutation.SetReference(s)
+	return pc
+}
+
+// SetNillableReference sets the reference field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableReference(s *string) *PaymentCreate {
+	if s != nil {
+		pc.SetReference(*s)
+	}
+	return pc
+}
+
+// SetPaidAt sets the paid_at field.
+func (pc *PaymentCreate) SetPaidAt(t time.Time) *PaymentCreate {
+	pc.mutation.SetPaidAt(t)
+	return pc
+}
+
+// SetNillablePaidAt sets the paid_at field if the given value is not nil.
+func (pc *PaymentCreate) SetNillablePaidAt(t *time.Time) *PaymentCreate {
+	if t != nil {
+		pc.SetPaidAt(*t)
+	}
+	return pc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (pc *PaymentCreate) SetOrganizationID(id int) *PaymentCreate {
+	pc.mutation.SetOrganizationID(id)
+	return pc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (pc *PaymentCreate) SetNillableOrganizationID(id *int) *PaymentCreate {
+	if id != nil {
+		pc = pc.SetOrganizationID(*id)
+	}
+	return pc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (pc *PaymentCreate) SetOrganization(o *Organization) *PaymentCreate {
+	return pc.SetOrganizationID(o.ID)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (pc *PaymentCreate) SetInvoiceID(id int) *PaymentCreate {
+	pc.mutation.SetInvoiceID(id)
+	return pc
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (pc *PaymentCreate) SetInvoice(i *Invoice) *PaymentCreate {
+	return pc.SetInvoiceID(i.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (pc *PaymentCreate) Mutation() *PaymentMutation {
+	return pc.mutation
+}
+
+// Save creates the Payment in the database.
+func (pc *PaymentCreate) Save(ctx context.Context) (*Payment, error) {
+	if _, ok := pc.mutation.CreateTime(); !ok {
+		v := payment.DefaultCreateTime()
+		pc.mutation.SetCreateTime(v)
+	}
+	if _, ok := pc.mutation.Number(); !ok {
+		return nil, &ValidationError{Name: "number", err: errors.New("ent: missing required field \"number\"")}
+	}
+	if v, ok := pc.mutation.Number(); ok {
+		if err := payment.NumberValidator(v); err != nil {
+			return nil, &ValidationError{Name: "number", err: fmt.Errorf("ent: validator failed for field \"number\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.Kind(); !ok {
+		v := payment.DefaultKind
+		pc.mutation.SetKind(v)
+	}
+	if v, ok := pc.mutation.Kind(); ok {
+		if err := payment.KindValidator(v); err != nil {
+			return nil, &ValidationError{Name: "kind", err: fmt.Errorf("ent: validator failed for field \"kind\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.Method(); !ok {
+		return nil, &ValidationError{Name: "method", err: errors.New("ent: missing required field \"method\"")}
+	}
+	if v, ok := pc.mutation.Method(); ok {
+		if err := payment.MethodValidator(v); err != nil {
+			return nil, &ValidationError{Name: "method", err: fmt.Errorf("ent: validator failed for field \"method\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.Amount(); !ok {
+		return nil, &ValidationError{Name: "amount", err: errors.New("ent: missing required field \"amount\"")}
+	}
+	if _, ok := pc.mutation.PaidAt(); !ok {
+		v := payment.DefaultPaidAt()
+		pc.mutation.SetPaidAt(v)
+	}
+	if _, ok := pc.mutation.InvoiceID(); !ok {
+		return nil, &ValidationError{Name: "invoice", err: errors.New("ent: missing required edge \"invoice\"")}
+	}
+	var (
+		err  error
+		node *Payment
+	)
+	if len(pc.hooks) == 0 {
+		node, err = pc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PaymentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pc.mutation = mutation
+			node, err = pc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pc.hooks) - 1; i >= 0; i-- {
+			mut = pc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PaymentCreate) SaveX(ctx context.Context) *Payment {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (pc *PaymentCreate) sqlSave(ctx context.Context) (*Payment, error) {
+	pa, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	pa.ID = int(id)
+	return pa, nil
+}
+
+func (pc *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
+	var (
+		pa    = &Payment{config: pc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: payment.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: payment.FieldID,
+			},
+		}
+	)
+	if value, ok := pc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: payment.FieldCreateTime,
+		})
+		pa.CreateTime = value
+	}
+	if value, ok := pc.mutation.Number(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldNumber,
+		})
+		pa.Number = value
+	}
+	if value, ok := pc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: payment.FieldKind,
+		})
+		pa.Kind = value
+	}
+	if value, ok := pc.mutation.Method(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: payment.FieldMethod,
+		})
+		pa.Method = value
+	}
+	if value, ok := pc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: payment.FieldAmount,
+		})
+		pa.Amount = value
+	}
+	if value, ok := pc.mutation.Reference(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldReference,
+		})
+		pa.Reference = value
+	}
+	if value, ok := pc.mutation.PaidAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: payment.FieldPaidAt,
+		})
+		pa.PaidAt = value
+	}
+	if nodes := pc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payment.OrganizationTable,
+			Columns: []string{payment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.InvoiceTable,
+			Columns: []string{payment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return pa, _spec
+}
@@ -0,0 +1,453 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// PaymentUpdate is the builder for updating Payment entities.
+type PaymentUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *PaymentMutation
+	predicates []predicate.Payment
+}
+
+// Where adds a new predicate for the builder.
+func (pu *PaymentUpdate) Where(ps ...predicate.Payment) *PaymentUpdate {
+	pu.predicates = append(pu.predicates, ps...)
+	return pu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (pu *PaymentUpdate) SetOrganizationID(id int) *PaymentUpdate {
+	pu.mutation.SetOrganizationID(id)
+	return pu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableOrganizationID(id *int) *PaymentUpdate {
+	if id != nil {
+		pu = pu.SetOrganizationID(*id)
+	}
+	return pu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (pu *PaymentUpdate) SetOrganization(o *Organization) *PaymentUpdate {
+	return pu.SetOrganizationID(o.ID)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (pu *PaymentUpdate) SetInvoiceID(id int) *PaymentUpdate {
+	pu.mutation.SetInvoiceID(id)
+	return pu
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (pu *PaymentUpdate) SetInvoice(i *Invoice) *PaymentUpdate {
+	return pu.SetInvoiceID(i.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (pu *PaymentUpdate) Mutation() *PaymentMutation {
+	return pu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (pu *PaymentUpdate) ClearOrganization() *PaymentUpdate {
+	pu.mutation.ClearOrganization()
+	return pu
+}
+
+// ClearInvoice clears the invoice edge to Invoice.
+func (pu *PaymentUpdate) ClearInvoice() *PaymentUpdate {
+	pu.mutation.ClearInvoice()
+	return pu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (pu *PaymentUpdate) Save(ctx context.Context) (int, error) {
+
+	if _, ok := pu.mutation.InvoiceID(); pu.mutation.InvoiceCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"invoice\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(pu.hooks) == 0 {
+		affected, err = pu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PaymentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pu.mutation = mutation
+			affected, err = pu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pu.hooks) - 1; i >= 0; i-- {
+			mut = pu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PaymentUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PaymentUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pu *PaymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   payment.Table,
+			Columns: payment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: payment.FieldID,
+			},
+		},
+	}
+	if ps := pu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pu.mutation.ReferenceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: payment.FieldReference,
+		})
+	}
+	if pu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payment.OrganizationTable,
+			Columns: []string{payment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payment.OrganizationTable,
+			Columns: []string{payment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.InvoiceTable,
+			Columns: []string{payment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.InvoiceTable,
+			Columns: []string{payment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payment.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PaymentUpdateOne is the builder for updating a single Payment entity.
+type PaymentUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *PaymentMutation
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (puo *PaymentUpdateOne) SetOrganizationID(id int) *PaymentUpdateOne {
+	puo.mutation.SetOrganizationID(id)
+	return puo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableOrganizationID(id *int) *PaymentUpdateOne {
+	if id != nil {
+		puo = puo.SetOrganizationID(*id)
+	}
+	return puo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (puo *PaymentUpdateOne) SetOrganization(o *Organization) *PaymentUpdateOne {
+	return puo.SetOrganizationID(o.ID)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (puo *PaymentUpdateOne) SetInvoiceID(id int) *PaymentUpdateOne {
+	puo.mutation.SetInvoiceID(id)
+	return puo
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (puo *PaymentUpdateOne) SetInvoice(i *Invoice) *PaymentUpdateOne {
+	return puo.SetInvoiceID(i.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (puo *PaymentUpdateOne) Mutation() *PaymentMutation {
+	return puo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (puo *PaymentUpdateOne) ClearOrganization() *PaymentUpdateOne {
+	puo.mutation.ClearOrganization()
+	return puo
+}
+
+// ClearInvoice clears the invoice edge to Invoice.
+func (puo *PaymentUpdateOne) ClearInvoice() *PaymentUpdateOne {
+	puo.mutation.ClearInvoice()
+	return puo
+}
+
+// Save executes the query and returns the updated entity.
+func (puo *PaymentUpdateOne) Save(ctx context.Context) (*Payment, error) {
+
+	if _, ok := puo.mutation.InvoiceID(); puo.mutation.InvoiceCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"invoice\"")
+	}
+	var (
+		err  error
+		node *Payment
+	)
+	if len(puo.hooks) == 0 {
+		node, err = puo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PaymentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			puo.mutation = mutation
+			node, err = puo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puo.hooks) - 1; i >= 0; i-- {
+			mut = puo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, puo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PaymentUpdateOne) SaveX(ctx context.Context) *Payment {
+	pa, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return pa
+}
+
+// Exec executes the query on the entity.
+func (puo *PaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (puo *PaymentUpdateOne) sqlSave(ctx context.Context) (pa *Payment, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   payment.Table,
+			Columns: payment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: payment.FieldID,
+			},
+		},
+	}
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Payment.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if puo.mutation.ReferenceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: payment.FieldReference,
+		})
+	}
+	if puo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payment.OrganizationTable,
+			Columns: []string{payment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payment.OrganizationTable,
+			Columns: []string{payment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.InvoiceTable,
+			Columns: []string{payment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.InvoiceTable,
+			Columns: []string{payment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	pa = &Payment{config: puo.config}
+	_spec.Assign = pa.assignValues
+	_spec.ScanValues = pa.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payment.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return pa, nil
+}
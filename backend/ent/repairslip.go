@@ -0,0 +1,466 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// RepairSlip is the model entity for the RepairSlip schema.
+type RepairSlip struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Symptom holds the value of the "symptom" field.
+	Symptom string `json:"symptom,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
+	// Status holds the value of the "status" field.
+	Status repairslip.Status `json:"status,omitempty"`
+	// UnderWarranty holds the value of the "under_warranty" field.
+	UnderWarranty bool `json:"under_warranty"`
+	// Route holds the value of the "route" field.
+	Route repairslip.Route `json:"route,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority repairslip.Priority `json:"priority,omitempty"`
+	// ResponseDue holds the value of the "response_due" field.
+	ResponseDue *time.Time `json:"response_due,omitempty"`
+	// ResolutionDue holds the value of the "resolution_due" field.
+	ResolutionDue *time.Time `json:"resolution_due,omitempty"`
+	// RespondedAt holds the value of the "responded_at" field.
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// ResponseBreached holds the value of the "response_breached" field.
+	ResponseBreached bool `json:"response_breached"`
+	// ResolutionBreached holds the value of the "resolution_breached" field.
+	ResolutionBreached bool `json:"resolution_breached"`
+	// EscalatedAt holds the value of the "escalated_at" field.
+	EscalatedAt *time.Time `json:"escalated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RepairSlipQuery when eager-loading is set.
+	Edges                  RepairSlipEdges `json:"edges"`
+	equipment_repair_slips *int
+	organization_id        *int
+	user_reported_slips    *int
+	user_assigned_slips    *int
+}
+
+// RepairSlipEdges holds the relations/edges for other nodes in the graph.
+type RepairSlipEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Reporter holds the value of the reporter edge.
+	Reporter *User
+	// Assignee holds the value of the assignee edge.
+	Assignee *User
+	// PartsUsed holds the value of the parts_used edge.
+	PartsUsed []*StockMovement
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice
+	// Equipment holds the value of the equipment edge.
+	Equipment *Equipment
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment
+	// Comments holds the value of the comments edge.
+	Comments []*Comment
+	// Activities holds the value of the activities edge.
+	Activities []*Activity
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [9]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RepairSlipEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// ReporterOrErr returns the Reporter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RepairSlipEdges) ReporterOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.Reporter == nil {
+			// The edge reporter was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Reporter, nil
+	}
+	return nil, &NotLoadedError{edge: "reporter"}
+}
+
+// AssigneeOrErr returns the Assignee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RepairSlipEdges) AssigneeOrErr() (*User, error) {
+	if e.loadedTypes[2] {
+		if e.Assignee == nil {
+			// The edge assignee was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Assignee, nil
+	}
+	return nil, &NotLoadedError{edge: "assignee"}
+}
+
+// PartsUsedOrErr returns the PartsUsed value or an error if the edge
+// was not loaded in eager-loading.
+func (e RepairSlipEdges) PartsUsedOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[3] {
+		return e.PartsUsed, nil
+	}
+	return nil, &NotLoadedError{edge: "parts_used"}
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RepairSlipEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.loadedTypes[4] {
+		if e.Invoice == nil {
+			// The edge invoice was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: invoice.Label}
+		}
+		return e.Invoice, nil
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// EquipmentOrErr returns the Equipment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RepairSlipEdges) EquipmentOrErr() (*Equipment, error) {
+	if e.loadedTypes[5] {
+		if e.Equipment == nil {
+			// The edge equipment was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: equipment.Label}
+		}
+		return e.Equipment, nil
+	}
+	return nil, &NotLoadedError{edge: "equipment"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e RepairSlipEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[6] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e RepairSlipEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[7] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// ActivitiesOrErr returns the Activities value or an error if the edge
+// was not loaded in eager-loading.
+func (e RepairSlipEdges) ActivitiesOrErr() ([]*Activity, error) {
+	if e.loadedTypes[8] {
+		return e.Activities, nil
+	}
+	return nil, &NotLoadedError{edge: "activities"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RepairSlip) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullTime{},   // update_time
+		&sql.NullString{}, // symptom
+		&sql.NullString{}, // category
+		&sql.NullString{}, // status
+		&sql.NullBool{},   // under_warranty
+		&sql.NullString{}, // route
+		&sql.NullString{}, // priority
+		&sql.NullTime{},   // response_due
+		&sql.NullTime{},   // resolution_due
+		&sql.NullTime{},   // responded_at
+		&sql.NullTime{},   // resolved_at
+		&sql.NullBool{},   // response_breached
+		&sql.NullBool{},   // resolution_breached
+		&sql.NullTime{},   // escalated_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*RepairSlip) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // equipment_repair_slips
+		&sql.NullInt64{}, // organization_id
+		&sql.NullInt64{}, // user_reported_slips
+		&sql.NullInt64{}, // user_assigned_slips
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RepairSlip fields.
+func (rs *RepairSlip) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(repairslip.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	rs.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		rs.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field update_time", values[1])
+	} else if value.Valid {
+		rs.UpdateTime = value.Time
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field symptom", values[2])
+	} else if value.Valid {
+		rs.Symptom = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field category", values[3])
+	} else if value.Valid {
+		rs.Category = value.String
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field status", values[4])
+	} else if value.Valid {
+		rs.Status = repairslip.Status(value.String)
+	}
+	if value, ok := values[5].(*sql.NullBool); !ok {
+		return fmt.Errorf("unexpected type %T for field under_warranty", values[5])
+	} else if value.Valid {
+		rs.UnderWarranty = value.Bool
+	}
+	if value, ok := values[6].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field route", values[6])
+	} else if value.Valid {
+		rs.Route = repairslip.Route(value.String)
+	}
+	if value, ok := values[7].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field priority", values[7])
+	} else if value.Valid {
+		rs.Priority = repairslip.Priority(value.String)
+	}
+	if value, ok := values[8].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field response_due", values[8])
+	} else if value.Valid {
+		rs.ResponseDue = new(time.Time)
+		*rs.ResponseDue = value.Time
+	}
+	if value, ok := values[9].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field resolution_due", values[9])
+	} else if value.Valid {
+		rs.ResolutionDue = new(time.Time)
+		*rs.ResolutionDue = value.Time
+	}
+	if value, ok := values[10].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field responded_at", values[10])
+	} else if value.Valid {
+		rs.RespondedAt = new(time.Time)
+		*rs.RespondedAt = value.Time
+	}
+	if value, ok := values[11].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field resolved_at", values[11])
+	} else if value.Valid {
+		rs.ResolvedAt = new(time.Time)
+		*rs.ResolvedAt = value.Time
+	}
+	if value, ok := values[12].(*sql.NullBool); !ok {
+		return fmt.Errorf("unexpected type %T for field response_breached", values[12])
+	} else if value.Valid {
+		rs.ResponseBreached = value.Bool
+	}
+	if value, ok := values[13].(*sql.NullBool); !ok {
+		return fmt.Errorf("unexpected type %T for field resolution_breached", values[13])
+	} else if value.Valid {
+		rs.ResolutionBreached = value.Bool
+	}
+	if value, ok := values[14].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field escalated_at", values[14])
+	} else if value.Valid {
+		rs.EscalatedAt = new(time.Time)
+		*rs.EscalatedAt = value.Time
+	}
+	values = values[15:]
+	if len(values) == len(repairslip.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field equipment_repair_slips", value)
+		} else if value.Valid {
+			rs.equipment_repair_slips = new(int)
+			*rs.equipment_repair_slips = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			rs.organization_id = new(int)
+			*rs.organization_id = int(value.Int64)
+		}
+		if value, ok := values[2].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_reported_slips", value)
+		} else if value.Valid {
+			rs.user_reported_slips = new(int)
+			*rs.user_reported_slips = int(value.Int64)
+		}
+		if value, ok := values[3].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_assigned_slips", value)
+		} else if value.Valid {
+			rs.user_assigned_slips = new(int)
+			*rs.user_assigned_slips = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the RepairSlip.
+func (rs *RepairSlip) QueryOrganization() *OrganizationQuery {
+	return (&RepairSlipClient{config: rs.config}).QueryOrganization(rs)
+}
+
+// QueryReporter queries the reporter edge of the RepairSlip.
+func (rs *RepairSlip) QueryReporter() *UserQuery {
+	return (&RepairSlipClient{config: rs.config}).QueryReporter(rs)
+}
+
+// QueryAssignee queries the assignee edge of the RepairSlip.
+func (rs *RepairSlip) QueryAssignee() *UserQuery {
+	return (&RepairSlipClient{config: rs.config}).QueryAssignee(rs)
+}
+
+// QueryPartsUsed queries the parts_used edge of the RepairSlip.
+func (rs *RepairSlip) QueryPartsUsed() *StockMovementQuery {
+	return (&RepairSlipClient{config: rs.config}).QueryPartsUsed(rs)
+}
+
+// QueryInvoice queries the invoice edge of the RepairSlip.
+func (rs *RepairSlip) QueryInvoice() *InvoiceQuery {
+	return (&RepairSlipClient{config: rs.config}).QueryInvoice(rs)
+}
+
+// QueryEquipment queries the equipment edge of the RepairSlip.
+func (rs *RepairSlip) QueryEquipment() *EquipmentQuery {
+	return (&RepairSlipClient{config: rs.config}).QueryEquipment(rs)
+}
+
+// QueryAttachments queries the attachments edge of the RepairSlip.
+func (rs *RepairSlip) QueryAttachments() *AttachmentQuery {
+	return (&RepairSlipClient{config: rs.config}).QueryAttachments(rs)
+}
+
+// QueryComments queries the comments edge of the RepairSlip.
+func (rs *RepairSlip) QueryComments() *CommentQuery {
+	return (&RepairSlipClient{config: rs.config}).QueryComments(rs)
+}
+
+// QueryActivities queries the activities edge of the RepairSlip.
+func (rs *RepairSlip) QueryActivities() *ActivityQuery {
+	return (&RepairSlipClient{config: rs.config}).QueryActivities(rs)
+}
+
+// Update returns a builder for updating this RepairSlip.
+// Note that, you need to call RepairSlip.Unwrap() before calling this method, if this RepairSlip
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rs *RepairSlip) Update() *RepairSlipUpdateOne {
+	return (&RepairSlipClient{config: rs.config}).UpdateOne(rs)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (rs *RepairSlip) Unwrap() *RepairSlip {
+	tx, ok := rs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RepairSlip is not a transactional entity")
+	}
+	rs.config.driver = tx.drv
+	return rs
+}
+
+// String implements the fmt.Stringer.
+func (rs *RepairSlip) String() string {
+	var builder strings.Builder
+	builder.WriteString("RepairSlip(")
+	builder.WriteString(fmt.Sprintf("id=%v", rs.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(rs.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", update_time=")
+	builder.WriteString(rs.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", symptom=")
+	builder.WriteString(rs.Symptom)
+	builder.WriteString(", category=")
+	builder.WriteString(rs.Category)
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Status))
+	builder.WriteString(", under_warranty=")
+	builder.WriteString(fmt.Sprintf("%v", rs.UnderWarranty))
+	builder.WriteString(", route=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Route))
+	builder.WriteString(", priority=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Priority))
+	if v := rs.ResponseDue; v != nil {
+		builder.WriteString(", response_due=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := rs.ResolutionDue; v != nil {
+		builder.WriteString(", resolution_due=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := rs.RespondedAt; v != nil {
+		builder.WriteString(", responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := rs.ResolvedAt; v != nil {
+		builder.WriteString(", resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", response_breached=")
+	builder.WriteString(fmt.Sprintf("%v", rs.ResponseBreached))
+	builder.WriteString(", resolution_breached=")
+	builder.WriteString(fmt.Sprintf("%v", rs.ResolutionBreached))
+	if v := rs.EscalatedAt; v != nil {
+		builder.WriteString(", escalated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RepairSlips is a parsable slice of RepairSlip.
+type RepairSlips []*RepairSlip
+
+func (rs RepairSlips) config(cfg config) {
+	for _i := range rs {
+		rs[_i].config = cfg
+	}
+}
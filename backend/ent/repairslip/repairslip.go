@@ -0,0 +1,269 @@
+// Code generated by entc, DO NOT EDIT.
+
+package repairslip
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the repairslip type in the database.
+	Label = "repair_slip"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldSymptom holds the string denoting the symptom field in the database.
+	FieldSymptom = "symptom"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldUnderWarranty holds the string denoting the under_warranty field in the database.
+	FieldUnderWarranty = "under_warranty"
+	// FieldRoute holds the string denoting the route field in the database.
+	FieldRoute = "route"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldResponseDue holds the string denoting the response_due field in the database.
+	FieldResponseDue = "response_due"
+	// FieldResolutionDue holds the string denoting the resolution_due field in the database.
+	FieldResolutionDue = "resolution_due"
+	// FieldRespondedAt holds the string denoting the responded_at field in the database.
+	FieldRespondedAt = "responded_at"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldResponseBreached holds the string denoting the response_breached field in the database.
+	FieldResponseBreached = "response_breached"
+	// FieldResolutionBreached holds the string denoting the resolution_breached field in the database.
+	FieldResolutionBreached = "resolution_breached"
+	// FieldEscalatedAt holds the string denoting the escalated_at field in the database.
+	FieldEscalatedAt = "escalated_at"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeReporter holds the string denoting the reporter edge name in mutations.
+	EdgeReporter = "reporter"
+	// EdgeAssignee holds the string denoting the assignee edge name in mutations.
+	EdgeAssignee = "assignee"
+	// EdgePartsUsed holds the string denoting the parts_used edge name in mutations.
+	EdgePartsUsed = "parts_used"
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+	// EdgeEquipment holds the string denoting the equipment edge name in mutations.
+	EdgeEquipment = "equipment"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeActivities holds the string denoting the activities edge name in mutations.
+	EdgeActivities = "activities"
+
+	// Table holds the table name of the repairslip in the database.
+	Table = "repair_slips"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "repair_slips"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// ReporterTable is the table the holds the reporter relation/edge.
+	ReporterTable = "repair_slips"
+	// ReporterInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ReporterInverseTable = "users"
+	// ReporterColumn is the table column denoting the reporter relation/edge.
+	ReporterColumn = "user_reported_slips"
+	// AssigneeTable is the table the holds the assignee relation/edge.
+	AssigneeTable = "repair_slips"
+	// AssigneeInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	AssigneeInverseTable = "users"
+	// AssigneeColumn is the table column denoting the assignee relation/edge.
+	AssigneeColumn = "user_assigned_slips"
+	// PartsUsedTable is the table the holds the parts_used relation/edge.
+	PartsUsedTable = "stock_movements"
+	// PartsUsedInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	PartsUsedInverseTable = "stock_movements"
+	// PartsUsedColumn is the table column denoting the parts_used relation/edge.
+	PartsUsedColumn = "repair_slip_parts_used"
+	// InvoiceTable is the table the holds the invoice relation/edge.
+	InvoiceTable = "invoices"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "repair_slip_invoice"
+	// EquipmentTable is the table the holds the equipment relation/edge.
+	EquipmentTable = "repair_slips"
+	// EquipmentInverseTable is the table name for the Equipment entity.
+	// It exists in this package in order to avoid circular dependency with the "equipment" package.
+	EquipmentInverseTable = "equipment"
+	// EquipmentColumn is the table column denoting the equipment relation/edge.
+	EquipmentColumn = "equipment_repair_slips"
+	// AttachmentsTable is the table the holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "repair_slip_attachments"
+	// CommentsTable is the table the holds the comments relation/edge.
+	CommentsTable = "comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "repair_slip_comments"
+	// ActivitiesTable is the table the holds the activities relation/edge.
+	ActivitiesTable = "activities"
+	// ActivitiesInverseTable is the table name for the Activity entity.
+	// It exists in this package in order to avoid circular dependency with the "activity" package.
+	ActivitiesInverseTable = "activities"
+	// ActivitiesColumn is the table column denoting the activities relation/edge.
+	ActivitiesColumn = "repair_slip_activities"
+)
+
+// Columns holds all SQL columns for repairslip fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldSymptom,
+	FieldCategory,
+	FieldStatus,
+	FieldUnderWarranty,
+	FieldRoute,
+	FieldPriority,
+	FieldResponseDue,
+	FieldResolutionDue,
+	FieldRespondedAt,
+	FieldResolvedAt,
+	FieldResponseBreached,
+	FieldResolutionBreached,
+	FieldEscalatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the RepairSlip type.
+var ForeignKeys = []string{
+	"equipment_repair_slips",
+	"organization_id",
+	"user_reported_slips",
+	"user_assigned_slips",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the update_time field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	UpdateDefaultUpdateTime func() time.Time
+	// SymptomValidator is a validator for the "symptom" field. It is called by the builders before save.
+	SymptomValidator func(string) error
+	// CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	CategoryValidator func(string) error
+	// DefaultUnderWarranty holds the default value on creation for the under_warranty field.
+	DefaultUnderWarranty bool
+	// DefaultResponseBreached holds the default value on creation for the response_breached field.
+	DefaultResponseBreached bool
+	// DefaultResolutionBreached holds the default value on creation for the resolution_breached field.
+	DefaultResolutionBreached bool
+)
+
+// Status defines the type for the status enum field.
+type Status string
+
+// StatusReceived is the default Status.
+const DefaultStatus = StatusReceived
+
+// Status values.
+const (
+	StatusReceived     Status = "received"
+	StatusInProgress   Status = "in_progress"
+	StatusWaitingParts Status = "waiting_parts"
+	StatusReady        Status = "ready"
+	StatusClosed       Status = "closed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "s" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusReceived, StatusInProgress, StatusWaitingParts, StatusReady, StatusClosed:
+		return nil
+	default:
+		return fmt.Errorf("repairslip: invalid enum value for status field: %q", s)
+	}
+}
+
+// Route defines the type for the route enum field.
+type Route string
+
+// RouteInHouse is the default Route.
+const DefaultRoute = RouteInHouse
+
+// Route values.
+const (
+	RouteInHouse       Route = "in_house"
+	RouteWarrantyClaim Route = "warranty_claim"
+)
+
+func (r Route) String() string {
+	return string(r)
+}
+
+// RouteValidator is a validator for the "r" field enum values. It is called by the builders before save.
+func RouteValidator(r Route) error {
+	switch r {
+	case RouteInHouse, RouteWarrantyClaim:
+		return nil
+	default:
+		return fmt.Errorf("repairslip: invalid enum value for route field: %q", r)
+	}
+}
+
+// Priority defines the type for the priority enum field.
+type Priority string
+
+// PriorityNormal is the default Priority.
+const DefaultPriority = PriorityNormal
+
+// Priority values.
+const (
+	PriorityLow    Priority = "low"
+	PriorityNormal Priority = "normal"
+	PriorityHigh   Priority = "high"
+	PriorityUrgent Priority = "urgent"
+)
+
+func (pr Priority) String() string {
+	return string(pr)
+}
+
+// PriorityValidator is a validator for the "pr" field enum values. It is called by the builders before save.
+func PriorityValidator(pr Priority) error {
+	switch pr {
+	case PriorityLow, PriorityNormal, PriorityHigh, PriorityUrgent:
+		return nil
+	default:
+		return fmt.Errorf("repairslip: invalid enum value for priority field: %q", pr)
+	}
+}
@@ -0,0 +1,1472 @@
+// Code generated by entc, DO NOT EDIT.
+
+package repairslip
+
+import (
+	"time"
+
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreateTime), v))
+	})
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdateTime), v))
+	})
+}
+
+// Symptom applies equality check predicate on the "symptom" field. It's identical to SymptomEQ.
+func Symptom(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSymptom), v))
+	})
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// UnderWarranty applies equality check predicate on the "under_warranty" field. It's identical to UnderWarrantyEQ.
+func UnderWarranty(v bool) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnderWarranty), v))
+	})
+}
+
+// ResponseDue applies equality check predicate on the "response_due" field. It's identical to ResponseDueEQ.
+func ResponseDue(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponseDue), v))
+	})
+}
+
+// ResolutionDue applies equality check predicate on the "resolution_due" field. It's identical to ResolutionDueEQ.
+func ResolutionDue(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolutionDue), v))
+	})
+}
+
+// RespondedAt applies equality check predicate on the "responded_at" field. It's identical to RespondedAtEQ.
+func RespondedAt(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRespondedAt), v))
+	})
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolvedAt), v))
+	})
+}
+
+// ResponseBreached applies equality check predicate on the "response_breached" field. It's identical to ResponseBreachedEQ.
+func ResponseBreached(v bool) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponseBreached), v))
+	})
+}
+
+// ResolutionBreached applies equality check predicate on the "resolution_breached" field. It's identical to ResolutionBreachedEQ.
+func ResolutionBreached(v bool) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolutionBreached), v))
+	})
+}
+
+// EscalatedAt applies equality check predicate on the "escalated_at" field. It's identical to EscalatedAtEQ.
+func EscalatedAt(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEscalatedAt), v))
+	})
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreateTime), v...))
+	})
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreateTime), v...))
+	})
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreateTime), v))
+	})
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdateTime), v...))
+	})
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdateTime), v...))
+	})
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdateTime), v))
+	})
+}
+
+// SymptomEQ applies the EQ predicate on the "symptom" field.
+func SymptomEQ(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomNEQ applies the NEQ predicate on the "symptom" field.
+func SymptomNEQ(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomIn applies the In predicate on the "symptom" field.
+func SymptomIn(vs ...string) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSymptom), v...))
+	})
+}
+
+// SymptomNotIn applies the NotIn predicate on the "symptom" field.
+func SymptomNotIn(vs ...string) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSymptom), v...))
+	})
+}
+
+// SymptomGT applies the GT predicate on the "symptom" field.
+func SymptomGT(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomGTE applies the GTE predicate on the "symptom" field.
+func SymptomGTE(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomLT applies the LT predicate on the "symptom" field.
+func SymptomLT(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomLTE applies the LTE predicate on the "symptom" field.
+func SymptomLTE(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomContains applies the Contains predicate on the "symptom" field.
+func SymptomContains(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomHasPrefix applies the HasPrefix predicate on the "symptom" field.
+func SymptomHasPrefix(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomHasSuffix applies the HasSuffix predicate on the "symptom" field.
+func SymptomHasSuffix(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomEqualFold applies the EqualFold predicate on the "symptom" field.
+func SymptomEqualFold(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSymptom), v))
+	})
+}
+
+// SymptomContainsFold applies the ContainsFold predicate on the "symptom" field.
+func SymptomContainsFold(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSymptom), v))
+	})
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCategory), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// UnderWarrantyEQ applies the EQ predicate on the "under_warranty" field.
+func UnderWarrantyEQ(v bool) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnderWarranty), v))
+	})
+}
+
+// UnderWarrantyNEQ applies the NEQ predicate on the "under_warranty" field.
+func UnderWarrantyNEQ(v bool) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnderWarranty), v))
+	})
+}
+
+// RouteEQ applies the EQ predicate on the "route" field.
+func RouteEQ(v Route) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRoute), v))
+	})
+}
+
+// RouteNEQ applies the NEQ predicate on the "route" field.
+func RouteNEQ(v Route) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRoute), v))
+	})
+}
+
+// RouteIn applies the In predicate on the "route" field.
+func RouteIn(vs ...Route) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRoute), v...))
+	})
+}
+
+// RouteNotIn applies the NotIn predicate on the "route" field.
+func RouteNotIn(vs ...Route) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRoute), v...))
+	})
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPriority), v...))
+	})
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPriority), v...))
+	})
+}
+
+// ResponseDueEQ applies the EQ predicate on the "response_due" field.
+func ResponseDueEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponseDue), v))
+	})
+}
+
+// ResponseDueNEQ applies the NEQ predicate on the "response_due" field.
+func ResponseDueNEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResponseDue), v))
+	})
+}
+
+// ResponseDueIn applies the In predicate on the "response_due" field.
+func ResponseDueIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResponseDue), v...))
+	})
+}
+
+// ResponseDueNotIn applies the NotIn predicate on the "response_due" field.
+func ResponseDueNotIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResponseDue), v...))
+	})
+}
+
+// ResponseDueGT applies the GT predicate on the "response_due" field.
+func ResponseDueGT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldResponseDue), v))
+	})
+}
+
+// ResponseDueGTE applies the GTE predicate on the "response_due" field.
+func ResponseDueGTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldResponseDue), v))
+	})
+}
+
+// ResponseDueLT applies the LT predicate on the "response_due" field.
+func ResponseDueLT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldResponseDue), v))
+	})
+}
+
+// ResponseDueLTE applies the LTE predicate on the "response_due" field.
+func ResponseDueLTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldResponseDue), v))
+	})
+}
+
+// ResponseDueIsNil applies the IsNil predicate on the "response_due" field.
+func ResponseDueIsNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldResponseDue)))
+	})
+}
+
+// ResponseDueNotNil applies the NotNil predicate on the "response_due" field.
+func ResponseDueNotNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldResponseDue)))
+	})
+}
+
+// ResolutionDueEQ applies the EQ predicate on the "resolution_due" field.
+func ResolutionDueEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolutionDue), v))
+	})
+}
+
+// ResolutionDueNEQ applies the NEQ predicate on the "resolution_due" field.
+func ResolutionDueNEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResolutionDue), v))
+	})
+}
+
+// ResolutionDueIn applies the In predicate on the "resolution_due" field.
+func ResolutionDueIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResolutionDue), v...))
+	})
+}
+
+// ResolutionDueNotIn applies the NotIn predicate on the "resolution_due" field.
+func ResolutionDueNotIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResolutionDue), v...))
+	})
+}
+
+// ResolutionDueGT applies the GT predicate on the "resolution_due" field.
+func ResolutionDueGT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldResolutionDue), v))
+	})
+}
+
+// ResolutionDueGTE applies the GTE predicate on the "resolution_due" field.
+func ResolutionDueGTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldResolutionDue), v))
+	})
+}
+
+// ResolutionDueLT applies the LT predicate on the "resolution_due" field.
+func ResolutionDueLT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldResolutionDue), v))
+	})
+}
+
+// ResolutionDueLTE applies the LTE predicate on the "resolution_due" field.
+func ResolutionDueLTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldResolutionDue), v))
+	})
+}
+
+// ResolutionDueIsNil applies the IsNil predicate on the "resolution_due" field.
+func ResolutionDueIsNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldResolutionDue)))
+	})
+}
+
+// ResolutionDueNotNil applies the NotNil predicate on the "resolution_due" field.
+func ResolutionDueNotNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldResolutionDue)))
+	})
+}
+
+// RespondedAtEQ applies the EQ predicate on the "responded_at" field.
+func RespondedAtEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRespondedAt), v))
+	})
+}
+
+// RespondedAtNEQ applies the NEQ predicate on the "responded_at" field.
+func RespondedAtNEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRespondedAt), v))
+	})
+}
+
+// RespondedAtIn applies the In predicate on the "responded_at" field.
+func RespondedAtIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRespondedAt), v...))
+	})
+}
+
+// RespondedAtNotIn applies the NotIn predicate on the "responded_at" field.
+func RespondedAtNotIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRespondedAt), v...))
+	})
+}
+
+// RespondedAtGT applies the GT predicate on the "responded_at" field.
+func RespondedAtGT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRespondedAt), v))
+	})
+}
+
+// RespondedAtGTE applies the GTE predicate on the "responded_at" field.
+func RespondedAtGTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRespondedAt), v))
+	})
+}
+
+// RespondedAtLT applies the LT predicate on the "responded_at" field.
+func RespondedAtLT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRespondedAt), v))
+	})
+}
+
+// RespondedAtLTE applies the LTE predicate on the "responded_at" field.
+func RespondedAtLTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRespondedAt), v))
+	})
+}
+
+// RespondedAtIsNil applies the IsNil predicate on the "responded_at" field.
+func RespondedAtIsNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRespondedAt)))
+	})
+}
+
+// RespondedAtNotNil applies the NotNil predicate on the "responded_at" field.
+func RespondedAtNotNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRespondedAt)))
+	})
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolvedAt), v))
+	})
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResolvedAt), v))
+	})
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResolvedAt), v...))
+	})
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResolvedAt), v...))
+	})
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldResolvedAt), v))
+	})
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldResolvedAt), v))
+	})
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldResolvedAt), v))
+	})
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldResolvedAt), v))
+	})
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldResolvedAt)))
+	})
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldResolvedAt)))
+	})
+}
+
+// ResponseBreachedEQ applies the EQ predicate on the "response_breached" field.
+func ResponseBreachedEQ(v bool) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponseBreached), v))
+	})
+}
+
+// ResponseBreachedNEQ applies the NEQ predicate on the "response_breached" field.
+func ResponseBreachedNEQ(v bool) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResponseBreached), v))
+	})
+}
+
+// ResolutionBreachedEQ applies the EQ predicate on the "resolution_breached" field.
+func ResolutionBreachedEQ(v bool) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolutionBreached), v))
+	})
+}
+
+// ResolutionBreachedNEQ applies the NEQ predicate on the "resolution_breached" field.
+func ResolutionBreachedNEQ(v bool) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResolutionBreached), v))
+	})
+}
+
+// EscalatedAtEQ applies the EQ predicate on the "escalated_at" field.
+func EscalatedAtEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEscalatedAt), v))
+	})
+}
+
+// EscalatedAtNEQ applies the NEQ predicate on the "escalated_at" field.
+func EscalatedAtNEQ(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEscalatedAt), v))
+	})
+}
+
+// EscalatedAtIn applies the In predicate on the "escalated_at" field.
+func EscalatedAtIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEscalatedAt), v...))
+	})
+}
+
+// EscalatedAtNotIn applies the NotIn predicate on the "escalated_at" field.
+func EscalatedAtNotIn(vs ...time.Time) predicate.RepairSlip {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEscalatedAt), v...))
+	})
+}
+
+// EscalatedAtGT applies the GT predicate on the "escalated_at" field.
+func EscalatedAtGT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEscalatedAt), v))
+	})
+}
+
+// EscalatedAtGTE applies the GTE predicate on the "escalated_at" field.
+func EscalatedAtGTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEscalatedAt), v))
+	})
+}
+
+// EscalatedAtLT applies the LT predicate on the "escalated_at" field.
+func EscalatedAtLT(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEscalatedAt), v))
+	})
+}
+
+// EscalatedAtLTE applies the LTE predicate on the "escalated_at" field.
+func EscalatedAtLTE(v time.Time) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEscalatedAt), v))
+	})
+}
+
+// EscalatedAtIsNil applies the IsNil predicate on the "escalated_at" field.
+func EscalatedAtIsNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEscalatedAt)))
+	})
+}
+
+// EscalatedAtNotNil applies the NotNil predicate on the "escalated_at" field.
+func EscalatedAtNotNil() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEscalatedAt)))
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReporter applies the HasEdge predicate on the "reporter" edge.
+func HasReporter() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReporterTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReporterTable, ReporterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReporterWith applies the HasEdge predicate on the "reporter" edge with a given conditions (other predicates).
+func HasReporterWith(preds ...predicate.User) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReporterInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReporterTable, ReporterColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssignee applies the HasEdge predicate on the "assignee" edge.
+func HasAssignee() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AssigneeTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AssigneeTable, AssigneeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssigneeWith applies the HasEdge predicate on the "assignee" edge with a given conditions (other predicates).
+func HasAssigneeWith(preds ...predicate.User) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AssigneeInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AssigneeTable, AssigneeColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPartsUsed applies the HasEdge predicate on the "parts_used" edge.
+func HasPartsUsed() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PartsUsedTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PartsUsedTable, PartsUsedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPartsUsedWith applies the HasEdge predicate on the "parts_used" edge with a given conditions (other predicates).
+func HasPartsUsedWith(preds ...predicate.StockMovement) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PartsUsedInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PartsUsedTable, PartsUsedColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvoiceTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvoiceInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEquipment applies the HasEdge predicate on the "equipment" edge.
+func HasEquipment() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EquipmentTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EquipmentTable, EquipmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEquipmentWith applies the HasEdge predicate on the "equipment" edge with a given conditions (other predicates).
+func HasEquipmentWith(preds ...predicate.Equipment) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EquipmentInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EquipmentTable, EquipmentColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AttachmentsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AttachmentsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CommentsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CommentsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActivities applies the HasEdge predicate on the "activities" edge.
+func HasActivities() predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ActivitiesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActivitiesWith applies the HasEdge predicate on the "activities" edge with a given conditions (other predicates).
+func HasActivitiesWith(preds ...predicate.Activity) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ActivitiesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.RepairSlip) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.RepairSlip) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RepairSlip) predicate.RepairSlip {
+	return predicate.RepairSlip(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
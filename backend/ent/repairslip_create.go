@@ -0,0 +1,802 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// RepairSlipCreate is the builder for creating a RepairSlip entity.
+type RepairSlipCreate struct {
+	config
+	mutation *RepairSlipMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (rsc *RepairSlipCreate) SetCreateTime(t time.Time) *RepairSlipCreate {
+	rsc.mutation.SetCreateTime(t)
+	return rsc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableCreateTime(t *time.Time) *RepairSlipCreate {
+	if t != nil {
+		rsc.SetCreateTime(*t)
+	}
+	return rsc
+}
+
+// SetUpdateTime sets the update_time field.
+func (rsc *RepairSlipCreate) SetUpdateTime(t time.Time) *RepairSlipCreate {
+	rsc.mutation.SetUpdateTime(t)
+	return rsc
+}
+
+// SetNillableUpdateTime sets the update_time field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableUpdateTime(t *time.Time) *RepairSlipCreate {
+	if t != nil {
+		rsc.SetUpdateTime(*t)
+	}
+	return rsc
+}
+
+// SetSymptom sets the symptom field.
+func (rsc *RepairSlipCreate) SetSymptom(s string) *RepairSlipCreate {
+	rsc.mutation.SetSymptom(s)
+	return rsc
+}
+
+// SetCategory sets the category field.
+func (rsc *RepairSlipCreate) SetCategory(s string) *RepairSlipCreate {
+	rsc.mutation.SetCategory(s)
+	return rsc
+}
+
+// SetStatus sets the status field.
+func (rsc *RepairSlipCreate) SetStatus(r repairslip.Status) *RepairSlipCreate {
+	rsc.mutation.SetStatus(r)
+	return rsc
+}
+
+// SetNillableStatus sets the status field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableStatus(r *repairslip.Status) *RepairSlipCreate {
+	if r != nil {
+		rsc.SetStatus(*r)
+	}
+	return rsc
+}
+
+// SetUnderWarranty sets the under_warranty field.
+func (rsc *RepairSlipCreate) SetUnderWarranty(b bool) *RepairSlipCreate {
+	rsc.mutation.SetUnderWarranty(b)
+	return rsc
+}
+
+// SetNillableUnderWarranty sets the under_warranty field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableUnderWarranty(b *bool) *RepairSlipCreate {
+	if b != nil {
+		rsc.SetUnderWarranty(*b)
+	}
+	return rsc
+}
+
+// SetRoute sets the route field.
+func (rsc *RepairSlipCreate) SetRoute(r repairslip.Route) *RepairSlipCreate {
+	rsc.mutation.SetRoute(r)
+	return rsc
+}
+
+// SetNillableRoute sets the route field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableRoute(r *repairslip.Route) *RepairSlipCreate {
+	if r != nil {
+		rsc.SetRoute(*r)
+	}
+	return rsc
+}
+
+// SetPriority sets the priority field.
+func (rsc *RepairSlipCreate) SetPriority(r repairslip.Priority) *RepairSlipCreate {
+	rsc.mutation.SetPriority(r)
+	return rsc
+}
+
+// SetNillablePriority sets the priority field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillablePriority(r *repairslip.Priority) *RepairSlipCreate {
+	if r != nil {
+		rsc.SetPriority(*r)
+	}
+	return rsc
+}
+
+// SetResponseDue sets the response_due field.
+func (rsc *RepairSlipCreate) SetResponseDue(t time.Time) *RepairSlipCreate {
+	rsc.mutation.SetResponseDue(t)
+	return rsc
+}
+
+// SetNillableResponseDue sets the response_due field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableResponseDue(t *time.Time) *RepairSlipCreate {
+	if t != nil {
+		rsc.SetResponseDue(*t)
+	}
+	return rsc
+}
+
+// SetResolutionDue sets the resolution_due field.
+func (rsc *RepairSlipCreate) SetResolutionDue(t time.Time) *RepairSlipCreate {
+	rsc.mutation.SetResolutionDue(t)
+	return rsc
+}
+
+// SetNillableResolutionDue sets the resolution_due field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableResolutionDue(t *time.Time) *RepairSlipCreate {
+	if t != nil {
+		rsc.SetResolutionDue(*t)
+	}
+	return rsc
+}
+
+// SetRespondedAt sets the responded_at field.
+func (rsc *RepairSlipCreate) SetRespondedAt(t time.Time) *RepairSlipCreate {
+	rsc.mutation.SetRespondedAt(t)
+	return rsc
+}
+
+// SetNillableRespondedAt sets the responded_at field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableRespondedAt(t *time.Time) *RepairSlipCreate {
+	if t != nil {
+		rsc.SetRespondedAt(*t)
+	}
+	return rsc
+}
+
+// SetResolvedAt sets the resolved_at field.
+func (rsc *RepairSlipCreate) SetResolvedAt(t time.Time) *RepairSlipCreate {
+	rsc.mutation.SetResolvedAt(t)
+	return rsc
+}
+
+// SetNillableResolvedAt sets the resolved_at field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableResolvedAt(t *time.Time) *RepairSlipCreate {
+	if t != nil {
+		rsc.SetResolvedAt(*t)
+	}
+	return rsc
+}
+
+// SetResponseBreached sets the response_breached field.
+func (rsc *RepairSlipCreate) SetResponseBreached(b bool) *RepairSlipCreate {
+	rsc.mutation.SetResponseBreached(b)
+	return rsc
+}
+
+// SetNillableResponseBreached sets the response_breached field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableResponseBreached(b *bool) *RepairSlipCreate {
+	if b != nil {
+		rsc.SetResponseBreached(*b)
+	}
+	return rsc
+}
+
+// SetResolutionBreached sets the resolution_breached field.
+func (rsc *RepairSlipCreate) SetResolutionBreached(b bool) *RepairSlipCreate {
+	rsc.mutation.SetResolutionBreached(b)
+	return rsc
+}
+
+// SetNillableResolutionBreached sets the resolution_breached field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableResolutionBreached(b *bool) *RepairSlipCreate {
+	if b != nil {
+		rsc.SetResolutionBreached(*b)
+	}
+	return rsc
+}
+
+// SetEscalatedAt sets the escalated_at field.
+func (rsc *RepairSlipCreate) SetEscalatedAt(t time.Time) *RepairSlipCreate {
+	rsc.mutation.SetEscalatedAt(t)
+	return rsc
+}
+
+// SetNillableEscalatedAt sets the escalated_at field if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableEscalatedAt(t *time.Time) *RepairSlipCreate {
+	if t != nil {
+		rsc.SetEscalatedAt(*t)
+	}
+	return rsc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (rsc *RepairSlipCreate) SetOrganizationID(id int) *RepairSlipCreate {
+	rsc.mutation.SetOrganizationID(id)
+	return rsc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableOrganizationID(id *int) *RepairSlipCreate {
+	if id != nil {
+		rsc = rsc.SetOrganizationID(*id)
+	}
+	return rsc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (rsc *RepairSlipCreate) SetOrganization(o *Organization) *RepairSlipCreate {
+	return rsc.SetOrganizationID(o.ID)
+}
+
+// SetReporterID sets the reporter edge to User by id.
+func (rsc *RepairSlipCreate) SetReporterID(id int) *RepairSlipCreate {
+	rsc.mutation.SetReporterID(id)
+	return rsc
+}
+
+// SetNillableReporterID sets the reporter edge to User by id if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableReporterID(id *int) *RepairSlipCreate {
+	if id != nil {
+		rsc = rsc.SetReporterID(*id)
+	}
+	return rsc
+}
+
+// SetReporter sets the reporter edge to User.
+func (rsc *RepairSlipCreate) SetReporter(u *User) *RepairSlipCreate {
+	return rsc.SetReporterID(u.ID)
+}
+
+// SetAssigneeID sets the assignee edge to User by id.
+func (rsc *RepairSlipCreate) SetAssigneeID(id int) *RepairSlipCreate {
+	rsc.mutation.SetAssigneeID(id)
+	return rsc
+}
+
+// SetNillableAssigneeID sets the assignee edge to User by id if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableAssigneeID(id *int) *RepairSlipCreate {
+	if id != nil {
+		rsc = rsc.SetAssigneeID(*id)
+	}
+	return rsc
+}
+
+// SetAssignee sets the assignee edge to User.
+func (rsc *RepairSlipCreate) SetAssignee(u *User) *RepairSlipCreate {
+	return rsc.SetAssigneeID(u.ID)
+}
+
+// AddPartsUsedIDs adds the parts_used edge to StockMovement by ids.
+func (rsc *RepairSlipCreate) AddPartsUsedIDs(ids ...int) *RepairSlipCreate {
+	rsc.mutation.AddPartsUsedIDs(ids...)
+	return rsc
+}
+
+// AddPartsUsed adds the parts_used edges to StockMovement.
+func (rsc *RepairSlipCreate) AddPartsUsed(s ...*StockMovement) *RepairSlipCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rsc.AddPartsUsedIDs(ids...)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (rsc *RepairSlipCreate) SetInvoiceID(id int) *RepairSlipCreate {
+	rsc.mutation.SetInvoiceID(id)
+	return rsc
+}
+
+// SetNillableInvoiceID sets the invoice edge to Invoice by id if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableInvoiceID(id *int) *RepairSlipCreate {
+	if id != nil {
+		rsc = rsc.SetInvoiceID(*id)
+	}
+	return rsc
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (rsc *RepairSlipCreate) SetInvoice(i *Invoice) *RepairSlipCreate {
+	return rsc.SetInvoiceID(i.ID)
+}
+
+// SetEquipmentID sets the equipment edge to Equipment by id.
+func (rsc *RepairSlipCreate) SetEquipmentID(id int) *RepairSlipCreate {
+	rsc.mutation.SetEquipmentID(id)
+	return rsc
+}
+
+// SetNillableEquipmentID sets the equipment edge to Equipment by id if the given value is not nil.
+func (rsc *RepairSlipCreate) SetNillableEquipmentID(id *int) *RepairSlipCreate {
+	if id != nil {
+		rsc = rsc.SetEquipmentID(*id)
+	}
+	return rsc
+}
+
+// SetEquipment sets the equipment edge to Equipment.
+func (rsc *RepairSlipCreate) SetEquipment(e *Equipment) *RepairSlipCreate {
+	return rsc.SetEquipmentID(e.ID)
+}
+
+// AddAttachmentIDs adds the attachments edge to Attachment by ids.
+func (rsc *RepairSlipCreate) AddAttachmentIDs(ids ...int) *RepairSlipCreate {
+	rsc.mutation.AddAttachmentIDs(ids...)
+	return rsc
+}
+
+// AddAttachments adds the attachments edges to Attachment.
+func (rsc *RepairSlipCreate) AddAttachments(a ...*Attachment) *RepairSlipCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsc.AddAttachmentIDs(ids...)
+}
+
+// AddCommentIDs adds the comments edge to Comment by ids.
+func (rsc *RepairSlipCreate) AddCommentIDs(ids ...int) *RepairSlipCreate {
+	rsc.mutation.AddCommentIDs(ids...)
+	return rsc
+}
+
+// AddComments adds the comments edges to Comment.
+func (rsc *RepairSlipCreate) AddComments(c ...*Comment) *RepairSlipCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rsc.AddCommentIDs(ids...)
+}
+
+// AddActivityIDs adds the activities edge to Activity by ids.
+func (rsc *RepairSlipCreate) AddActivityIDs(ids ...int) *RepairSlipCreate {
+	rsc.mutation.AddActivityIDs(ids...)
+	return rsc
+}
+
+// AddActivities adds the activities edges to Activity.
+func (rsc *RepairSlipCreate) AddActivities(a ...*Activity) *RepairSlipCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsc.AddActivityIDs(ids...)
+}
+
+// Mutation returns the RepairSlipMutation object of the builder.
+func (rsc *RepairSlipCreate) Mutation() *RepairSlipMutation {
+	return rsc.mutation
+}
+
+// Save creates the RepairSlip in the database.
+func (rsc *RepairSlipCreate) Save(ctx context.Context) (*RepairSlip, error) {
+	if _, ok := rsc.mutation.CreateTime(); !ok {
+		v := repairslip.DefaultCreateTime()
+		rsc.mutation.SetCreateTime(v)
+	}
+	if _, ok := rsc.mutation.UpdateTime(); !ok {
+		v := repairslip.DefaultUpdateTime()
+		rsc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := rsc.mutation.Symptom(); !ok {
+		return nil, &ValidationError{Name: "symptom", err: errors.New("ent: missing required field \"symptom\"")}
+	}
+	if v, ok := rsc.mutation.Symptom(); ok {
+		if err := repairslip.SymptomValidator(v); err != nil {
+			return nil, &ValidationError{Name: "symptom", err: fmt.Errorf("ent: validator failed for field \"symptom\": %w", err)}
+		}
+	}
+	if _, ok := rsc.mutation.Category(); !ok {
+		return nil, &ValidationError{Name: "category", err: errors.New("ent: missing required field \"category\"")}
+	}
+	if v, ok := rsc.mutation.Category(); ok {
+		if err := repairslip.CategoryValidator(v); err != nil {
+			return nil, &ValidationError{Name: "category", err: fmt.Errorf("ent: validator failed for field \"category\": %w", err)}
+		}
+	}
+	if _, ok := rsc.mutation.Status(); !ok {
+		v := repairslip.DefaultStatus
+		rsc.mutation.SetStatus(v)
+	}
+	if v, ok := rsc.mutation.Status(); ok {
+		if err := repairslip.StatusValidator(v); err != nil {
+			return nil, &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if _, ok := rsc.mutation.UnderWarranty(); !ok {
+		v := repairslip.DefaultUnderWarranty
+		rsc.mutation.SetUnderWarranty(v)
+	}
+	if _, ok := rsc.mutation.Route(); !ok {
+		v := repairslip.DefaultRoute
+		rsc.mutation.SetRoute(v)
+	}
+	if v, ok := rsc.mutation.Route(); ok {
+		if err := repairslip.RouteValidator(v); err != nil {
+			return nil, &ValidationError{Name: "route", err: fmt.Errorf("ent: validator failed for field \"route\": %w", err)}
+		}
+	}
+	if _, ok := rsc.mutation.Priority(); !ok {
+		v := repairslip.DefaultPriority
+		rsc.mutation.SetPriority(v)
+	}
+	if v, ok := rsc.mutation.Priority(); ok {
+		if err := repairslip.PriorityValidator(v); err != nil {
+			return nil, &ValidationError{Name: "priority", err: fmt.Errorf("ent: validator failed for field \"priority\": %w", err)}
+		}
+	}
+	if _, ok := rsc.mutation.ResponseBreached(); !ok {
+		v := repairslip.DefaultResponseBreached
+		rsc.mutation.SetResponseBreached(v)
+	}
+	if _, ok := rsc.mutation.ResolutionBreached(); !ok {
+		v := repairslip.DefaultResolutionBreached
+		rsc.mutation.SetResolutionBreached(v)
+	}
+	var (
+		err  error
+		node *RepairSlip
+	)
+	if len(rsc.hooks) == 0 {
+		node, err = rsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RepairSlipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rsc.mutation = mutation
+			node, err = rsc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rsc.hooks) - 1; i >= 0; i-- {
+			mut = rsc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rsc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rsc *RepairSlipCreate) SaveX(ctx context.Context) *RepairSlip {
+	v, err := rsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (rsc *RepairSlipCreate) sqlSave(ctx context.Context) (*RepairSlip, error) {
+	rs, _spec := rsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rsc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	rs.ID = int(id)
+	return rs, nil
+}
+
+func (rsc *RepairSlipCreate) createSpec() (*RepairSlip, *sqlgraph.CreateSpec) {
+	var (
+		rs    = &RepairSlip{config: rsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: repairslip.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: repairslip.FieldID,
+			},
+		}
+	)
+	if value, ok := rsc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldCreateTime,
+		})
+		rs.CreateTime = value
+	}
+	if value, ok := rsc.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldUpdateTime,
+		})
+		rs.UpdateTime = value
+	}
+	if value, ok := rsc.mutation.Symptom(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: repairslip.FieldSymptom,
+		})
+		rs.Symptom = value
+	}
+	if value, ok := rsc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: repairslip.FieldCategory,
+		})
+		rs.Category = value
+	}
+	if value, ok := rsc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: repairslip.FieldStatus,
+		})
+		rs.Status = value
+	}
+	if value, ok := rsc.mutation.UnderWarranty(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: repairslip.FieldUnderWarranty,
+		})
+		rs.UnderWarranty = value
+	}
+	if value, ok := rsc.mutation.Route(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: repairslip.FieldRoute,
+		})
+		rs.Route = value
+	}
+	if value, ok := rsc.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: repairslip.FieldPriority,
+		})
+		rs.Priority = value
+	}
+	if value, ok := rsc.mutation.ResponseDue(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldResponseDue,
+		})
+		rs.ResponseDue = &value
+	}
+	if value, ok := rsc.mutation.ResolutionDue(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldResolutionDue,
+		})
+		rs.ResolutionDue = &value
+	}
+	if value, ok := rsc.mutation.RespondedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldRespondedAt,
+		})
+		rs.RespondedAt = &value
+	}
+	if value, ok := rsc.mutation.ResolvedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldResolvedAt,
+		})
+		rs.ResolvedAt = &value
+	}
+	if value, ok := rsc.mutation.ResponseBreached(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: repairslip.FieldResponseBreached,
+		})
+		rs.ResponseBreached = value
+	}
+	if value, ok := rsc.mutation.ResolutionBreached(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: repairslip.FieldResolutionBreached,
+		})
+		rs.ResolutionBreached = value
+	}
+	if value, ok := rsc.mutation.EscalatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldEscalatedAt,
+		})
+		rs.EscalatedAt = &value
+	}
+	if nodes := rsc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   repairslip.OrganizationTable,
+			Columns: []string{repairslip.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.ReporterTable,
+			Columns: []string{repairslip.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.AssigneeTable,
+			Columns: []string{repairslip.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.PartsUsedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.PartsUsedTable,
+			Columns: []string{repairslip.PartsUsedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   repairslip.InvoiceTable,
+			Columns: []string{repairslip.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.EquipmentTable,
+			Columns: []string{repairslip.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.AttachmentsTable,
+			Columns: []string{repairslip.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.CommentsTable,
+			Columns: []string{repairslip.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.ActivitiesTable,
+			Columns: []string{repairslip.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: activity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return rs, _spec
+}
@@ -0,0 +1,1413 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// RepairSlipQuery is the builder for querying RepairSlip entities.
+type RepairSlipQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.RepairSlip
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withReporter     *UserQuery
+	withAssignee     *UserQuery
+	withPartsUsed    *StockMovementQuery
+	withInvoice      *InvoiceQuery
+	withEquipment    *EquipmentQuery
+	withAttachments  *AttachmentQuery
+	withComments     *CommentQuery
+	withActivities   *ActivityQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (rsq *RepairSlipQuery) Where(ps ...predicate.RepairSlip) *RepairSlipQuery {
+	rsq.predicates = append(rsq.predicates, ps...)
+	return rsq
+}
+
+// Limit adds a limit step to the query.
+func (rsq *RepairSlipQuery) Limit(limit int) *RepairSlipQuery {
+	rsq.limit = &limit
+	return rsq
+}
+
+// Offset adds an offset step to the query.
+func (rsq *RepairSlipQuery) Offset(offset int) *RepairSlipQuery {
+	rsq.offset = &offset
+	return rsq
+}
+
+// Order adds an order step to the query.
+func (rsq *RepairSlipQuery) Order(o ...OrderFunc) *RepairSlipQuery {
+	rsq.order = append(rsq.order, o...)
+	return rsq
+}
+
+// QueryOrganization chains the current query on the organization edge.
+func (rsq *RepairSlipQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: rsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repairslip.Table, repairslip.FieldID, rsq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, repairslip.OrganizationTable, repairslip.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReporter chains the current query on the reporter edge.
+func (rsq *RepairSlipQuery) QueryReporter() *UserQuery {
+	query := &UserQuery{config: rsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repairslip.Table, repairslip.FieldID, rsq.sqlQuery()),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, repairslip.ReporterTable, repairslip.ReporterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignee chains the current query on the assignee edge.
+func (rsq *RepairSlipQuery) QueryAssignee() *UserQuery {
+	query := &UserQuery{config: rsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repairslip.Table, repairslip.FieldID, rsq.sqlQuery()),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, repairslip.AssigneeTable, repairslip.AssigneeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPartsUsed chains the current query on the parts_used edge.
+func (rsq *RepairSlipQuery) QueryPartsUsed() *StockMovementQuery {
+	query := &StockMovementQuery{config: rsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repairslip.Table, repairslip.FieldID, rsq.sqlQuery()),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, repairslip.PartsUsedTable, repairslip.PartsUsedColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoice chains the current query on the invoice edge.
+func (rsq *RepairSlipQuery) QueryInvoice() *InvoiceQuery {
+	query := &InvoiceQuery{config: rsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repairslip.Table, repairslip.FieldID, rsq.sqlQuery()),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, repairslip.InvoiceTable, repairslip.InvoiceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEquipment chains the current query on the equipment edge.
+func (rsq *RepairSlipQuery) QueryEquipment() *EquipmentQuery {
+	query := &EquipmentQuery{config: rsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repairslip.Table, repairslip.FieldID, rsq.sqlQuery()),
+			sqlgraph.To(equipment.Table, equipment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, repairslip.EquipmentTable, repairslip.EquipmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the attachments edge.
+func (rsq *RepairSlipQuery) QueryAttachments() *AttachmentQuery {
+	query := &AttachmentQuery{config: rsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repairslip.Table, repairslip.FieldID, rsq.sqlQuery()),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, repairslip.AttachmentsTable, repairslip.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComments chains the current query on the comments edge.
+func (rsq *RepairSlipQuery) QueryComments() *CommentQuery {
+	query := &CommentQuery{config: rsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repairslip.Table, repairslip.FieldID, rsq.sqlQuery()),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, repairslip.CommentsTable, repairslip.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryActivities chains the current query on the activities edge.
+func (rsq *RepairSlipQuery) QueryActivities() *ActivityQuery {
+	query := &ActivityQuery{config: rsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repairslip.Table, repairslip.FieldID, rsq.sqlQuery()),
+			sqlgraph.To(activity.Table, activity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, repairslip.ActivitiesTable, repairslip.ActivitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RepairSlip entity in the query. Returns *NotFoundError when no repairslip was found.
+func (rsq *RepairSlipQuery) First(ctx context.Context) (*RepairSlip, error) {
+	rsSlice, err := rsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsSlice) == 0 {
+		return nil, &NotFoundError{repairslip.Label}
+	}
+	return rsSlice[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rsq *RepairSlipQuery) FirstX(ctx context.Context) *RepairSlip {
+	rs, err := rsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return rs
+}
+
+// FirstID returns the first RepairSlip id in the query. Returns *NotFoundError when no id was found.
+func (rsq *RepairSlipQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{repairslip.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (rsq *RepairSlipQuery) FirstXID(ctx context.Context) int {
+	id, err := rsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only RepairSlip entity in the query, returns an error if not exactly one entity was returned.
+func (rsq *RepairSlipQuery) Only(ctx context.Context) (*RepairSlip, error) {
+	rsSlice, err := rsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(rsSlice) {
+	case 1:
+		return rsSlice[0], nil
+	case 0:
+		return nil, &NotFoundError{repairslip.Label}
+	default:
+		return nil, &NotSingularError{repairslip.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rsq *RepairSlipQuery) OnlyX(ctx context.Context) *RepairSlip {
+	rs, err := rsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// OnlyID returns the only RepairSlip id in the query, returns an error if not exactly one id was returned.
+func (rsq *RepairSlipQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{repairslip.Label}
+	default:
+		err = &NotSingularError{repairslip.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rsq *RepairSlipQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RepairSlips.
+func (rsq *RepairSlipQuery) All(ctx context.Context) ([]*RepairSlip, error) {
+	if err := rsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rsq *RepairSlipQuery) AllX(ctx context.Context) []*RepairSlip {
+	rsSlice, err := rsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rsSlice
+}
+
+// IDs executes the query and returns a list of RepairSlip ids.
+func (rsq *RepairSlipQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := rsq.Select(repairslip.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rsq *RepairSlipQuery) IDsX(ctx context.Context) []int {
+	ids, err := rsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rsq *RepairSlipQuery) Count(ctx context.Context) (int, error) {
+	if err := rsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rsq *RepairSlipQuery) CountX(ctx context.Context) int {
+	count, err := rsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rsq *RepairSlipQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rsq *RepairSlipQuery) ExistX(ctx context.Context) bool {
+	exist, err := rsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rsq *RepairSlipQuery) Clone() *RepairSlipQuery {
+	return &RepairSlipQuery{
+		config:     rsq.config,
+		limit:      rsq.limit,
+		offset:     rsq.offset,
+		order:      append([]OrderFunc{}, rsq.order...),
+		unique:     append([]string{}, rsq.unique...),
+		predicates: append([]predicate.RepairSlip{}, rsq.predicates...),
+		// clone intermediate query.
+		sql:  rsq.sql.Clone(),
+		path: rsq.path,
+	}
+}
+
+//  WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (rsq *RepairSlipQuery) WithOrganization(opts ...func(*OrganizationQuery)) *RepairSlipQuery {
+	query := &OrganizationQuery{config: rsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withOrganization = query
+	return rsq
+}
+
+//  WithReporter tells the query-builder to eager-loads the nodes that are connected to
+// the "reporter" edge. The optional arguments used to configure the query builder of the edge.
+func (rsq *RepairSlipQuery) WithReporter(opts ...func(*UserQuery)) *RepairSlipQuery {
+	query := &UserQuery{config: rsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withReporter = query
+	return rsq
+}
+
+//  WithAssignee tells the query-builder to eager-loads the nodes that are connected to
+// the "assignee" edge. The optional arguments used to configure the query builder of the edge.
+func (rsq *RepairSlipQuery) WithAssignee(opts ...func(*UserQuery)) *RepairSlipQuery {
+	query := &UserQuery{config: rsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withAssignee = query
+	return rsq
+}
+
+//  WithPartsUsed tells the query-builder to eager-loads the nodes that are connected to
+// the "parts_used" edge. The optional arguments used to configure the query builder of the edge.
+func (rsq *RepairSlipQuery) WithPartsUsed(opts ...func(*StockMovementQuery)) *RepairSlipQuery {
+	query := &StockMovementQuery{config: rsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withPartsUsed = query
+	return rsq
+}
+
+//  WithInvoice tells the query-builder to eager-loads the nodes that are connected to
+// the "invoice" edge. The optional arguments used to configure the query builder of the edge.
+func (rsq *RepairSlipQuery) WithInvoice(opts ...func(*InvoiceQuery)) *RepairSlipQuery {
+	query := &InvoiceQuery{config: rsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withInvoice = query
+	return rsq
+}
+
+//  WithEquipment tells the query-builder to eager-loads the nodes that are connected to
+// the "equipment" edge. The optional arguments used to configure the query builder of the edge.
+func (rsq *RepairSlipQuery) WithEquipment(opts ...func(*EquipmentQuery)) *RepairSlipQuery {
+	query := &EquipmentQuery{config: rsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withEquipment = query
+	return rsq
+}
+
+//  WithAttachments tells the query-builder to eager-loads the nodes that are connected to
+// the "attachments" edge. The optional arguments used to configure the query builder of the edge.
+func (rsq *RepairSlipQuery) WithAttachments(opts ...func(*AttachmentQuery)) *RepairSlipQuery {
+	query := &AttachmentQuery{config: rsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withAttachments = query
+	return rsq
+}
+
+//  WithComments tells the query-builder to eager-loads the nodes that are connected to
+// the "comments" edge. The optional arguments used to configure the query builder of the edge.
+func (rsq *RepairSlipQuery) WithComments(opts ...func(*CommentQuery)) *RepairSlipQuery {
+	query := &CommentQuery{config: rsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withComments = query
+	return rsq
+}
+
+//  WithActivities tells the query-builder to eager-loads the nodes that are connected to
+// the "activities" edge. The optional arguments used to configure the query builder of the edge.
+func (rsq *RepairSlipQuery) WithActivities(opts ...func(*ActivityQuery)) *RepairSlipQuery {
+	query := &ActivityQuery{config: rsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withActivities = query
+	return rsq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RepairSlip.Query().
+//		GroupBy(repairslip.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (rsq *RepairSlipQuery) GroupBy(field string, fields ...string) *RepairSlipGroupBy {
+	group := &RepairSlipGroupBy{config: rsq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rsq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.RepairSlip.Query().
+//		Select(repairslip.FieldCreateTime).
+//		Scan(ctx, &v)
+//
+func (rsq *RepairSlipQuery) Select(field string, fields ...string) *RepairSlipSelect {
+	selector := &RepairSlipSelect{config: rsq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rsq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (rsq *RepairSlipQuery) prepareQuery(ctx context.Context) error {
+	if rsq.path != nil {
+		prev, err := rsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rsq.sql = prev
+	}
+	if err := repairslip.Policy.EvalQuery(ctx, rsq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (rsq *RepairSlipQuery) sqlAll(ctx context.Context) ([]*RepairSlip, error) {
+	var (
+		nodes       = []*RepairSlip{}
+		withFKs     = rsq.withFKs
+		_spec       = rsq.querySpec()
+		loadedTypes = [9]bool{
+			rsq.withOrganization != nil,
+			rsq.withReporter != nil,
+			rsq.withAssignee != nil,
+			rsq.withPartsUsed != nil,
+			rsq.withInvoice != nil,
+			rsq.withEquipment != nil,
+			rsq.withAttachments != nil,
+			rsq.withComments != nil,
+			rsq.withActivities != nil,
+		}
+	)
+	if rsq.withOrganization != nil || rsq.withReporter != nil || rsq.withAssignee != nil || rsq.withEquipment != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, repairslip.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &RepairSlip{config: rsq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := rsq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*RepairSlip)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	if query := rsq.withReporter; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*RepairSlip)
+		for i := range nodes {
+			if fk := nodes[i].user_reported_slips; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_reported_slips" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Reporter = n
+			}
+		}
+	}
+
+	if query := rsq.withAssignee; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*RepairSlip)
+		for i := range nodes {
+			if fk := nodes[i].user_assigned_slips; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_assigned_slips" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Assignee = n
+			}
+		}
+	}
+
+	if query := rsq.withPartsUsed; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*RepairSlip)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.StockMovement(func(s *sql.Selector) {
+			s.Where(sql.InValues(repairslip.PartsUsedColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.repair_slip_parts_used
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "repair_slip_parts_used" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "repair_slip_parts_used" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.PartsUsed = append(node.Edges.PartsUsed, n)
+		}
+	}
+
+	if query := rsq.withInvoice; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*RepairSlip)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Invoice(func(s *sql.Selector) {
+			s.Where(sql.InValues(repairslip.InvoiceColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.repair_slip_invoice
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "repair_slip_invoice" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "repair_slip_invoice" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Invoice = n
+		}
+	}
+
+	if query := rsq.withEquipment; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*RepairSlip)
+		for i := range nodes {
+			if fk := nodes[i].equipment_repair_slips; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(equipment.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "equipment_repair_slips" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Equipment = n
+			}
+		}
+	}
+
+	if query := rsq.withAttachments; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*RepairSlip)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Attachment(func(s *sql.Selector) {
+			s.Where(sql.InValues(repairslip.AttachmentsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.repair_slip_attachments
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "repair_slip_attachments" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "repair_slip_attachments" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Attachments = append(node.Edges.Attachments, n)
+		}
+	}
+
+	if query := rsq.withComments; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*RepairSlip)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Comment(func(s *sql.Selector) {
+			s.Where(sql.InValues(repairslip.CommentsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.repair_slip_comments
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "repair_slip_comments" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "repair_slip_comments" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Comments = append(node.Edges.Comments, n)
+		}
+	}
+
+	if query := rsq.withActivities; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*RepairSlip)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Activity(func(s *sql.Selector) {
+			s.Where(sql.InValues(repairslip.ActivitiesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.repair_slip_activities
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "repair_slip_activities" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "repair_slip_activities" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Activities = append(node.Edges.Activities, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (rsq *RepairSlipQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rsq.querySpec()
+	return sqlgraph.CountNodes(ctx, rsq.driver, _spec)
+}
+
+func (rsq *RepairSlipQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (rsq *RepairSlipQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   repairslip.Table,
+			Columns: repairslip.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: repairslip.FieldID,
+			},
+		},
+		From:   rsq.sql,
+		Unique: true,
+	}
+	if ps := rsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rsq *RepairSlipQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(rsq.driver.Dialect())
+	t1 := builder.Table(repairslip.Table)
+	selector := builder.Select(t1.Columns(repairslip.Columns...)...).From(t1)
+	if rsq.sql != nil {
+		selector = rsq.sql
+		selector.Select(selector.Columns(repairslip.Columns...)...)
+	}
+	for _, p := range rsq.predicates {
+		p(selector)
+	}
+	for _, p := range rsq.order {
+		p(selector)
+	}
+	if offset := rsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RepairSlipGroupBy is the builder for group-by RepairSlip entities.
+type RepairSlipGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rsgb *RepairSlipGroupBy) Aggregate(fns ...AggregateFunc) *RepairSlipGroupBy {
+	rsgb.fns = append(rsgb.fns, fns...)
+	return rsgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (rsgb *RepairSlipGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rsgb.sql = query
+	return rsgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (rsgb *RepairSlipGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := rsgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (rsgb *RepairSlipGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(rsgb.fields) > 1 {
+		return nil, errors.New("ent: RepairSlipGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := rsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (rsgb *RepairSlipGroupBy) StringsX(ctx context.Context) []string {
+	v, err := rsgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (rsgb *RepairSlipGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = rsgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{repairslip.Label}
+	default:
+		err = fmt.Errorf("ent: RepairSlipGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (rsgb *RepairSlipGroupBy) StringX(ctx context.Context) string {
+	v, err := rsgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (rsgb *RepairSlipGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(rsgb.fields) > 1 {
+		return nil, errors.New("ent: RepairSlipGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := rsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (rsgb *RepairSlipGroupBy) IntsX(ctx context.Context) []int {
+	v, err := rsgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (rsgb *RepairSlipGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = rsgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{repairslip.Label}
+	default:
+		err = fmt.Errorf("ent: RepairSlipGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (rsgb *RepairSlipGroupBy) IntX(ctx context.Context) int {
+	v, err := rsgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (rsgb *RepairSlipGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(rsgb.fields) > 1 {
+		return nil, errors.New("ent: RepairSlipGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := rsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (rsgb *RepairSlipGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := rsgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (rsgb *RepairSlipGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = rsgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{repairslip.Label}
+	default:
+		err = fmt.Errorf("ent: RepairSlipGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (rsgb *RepairSlipGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := rsgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (rsgb *RepairSlipGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(rsgb.fields) > 1 {
+		return nil, errors.New("ent: RepairSlipGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := rsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (rsgb *RepairSlipGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := rsgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (rsgb *RepairSlipGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = rsgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{repairslip.Label}
+	default:
+		err = fmt.Errorf("ent: RepairSlipGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (rsgb *RepairSlipGroupBy) BoolX(ctx context.Context) bool {
+	v, err := rsgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (rsgb *RepairSlipGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rsgb.sqlQuery().Query()
+	if err := rsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rsgb *RepairSlipGroupBy) sqlQuery() *sql.Selector {
+	selector := rsgb.sql
+	columns := make([]string, 0, len(rsgb.fields)+len(rsgb.fns))
+	columns = append(columns, rsgb.fields...)
+	for _, fn := range rsgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(rsgb.fields...)
+}
+
+// RepairSlipSelect is the builder for select fields of RepairSlip entities.
+type RepairSlipSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (rss *RepairSlipSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := rss.path(ctx)
+	if err != nil {
+		return err
+	}
+	rss.sql = query
+	return rss.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (rss *RepairSlipSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := rss.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (rss *RepairSlipSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(rss.fields) > 1 {
+		return nil, errors.New("ent: RepairSlipSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := rss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (rss *RepairSlipSelect) StringsX(ctx context.Context) []string {
+	v, err := rss.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (rss *RepairSlipSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = rss.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{repairslip.Label}
+	default:
+		err = fmt.Errorf("ent: RepairSlipSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (rss *RepairSlipSelect) StringX(ctx context.Context) string {
+	v, err := rss.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (rss *RepairSlipSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(rss.fields) > 1 {
+		return nil, errors.New("ent: RepairSlipSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := rss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (rss *RepairSlipSelect) IntsX(ctx context.Context) []int {
+	v, err := rss.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (rss *RepairSlipSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = rss.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{repairslip.Label}
+	default:
+		err = fmt.Errorf("ent: RepairSlipSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (rss *RepairSlipSelect) IntX(ctx context.Context) int {
+	v, err := rss.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (rss *RepairSlipSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(rss.fields) > 1 {
+		return nil, errors.New("ent: RepairSlipSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := rss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (rss *RepairSlipSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := rss.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (rss *RepairSlipSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = rss.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{repairslip.Label}
+	default:
+		err = fmt.Errorf("ent: RepairSlipSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (rss *RepairSlipSelect) Float64X(ctx context.Context) float64 {
+	v, err := rss.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (rss *RepairSlipSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(rss.fields) > 1 {
+		return nil, errors.New("ent: RepairSlipSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := rss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (rss *RepairSlipSelect) BoolsX(ctx context.Context) []bool {
+	v, err := rss.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (rss *RepairSlipSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = rss.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{repairslip.Label}
+	default:
+		err = fmt.Errorf("ent: RepairSlipSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (rss *RepairSlipSelect) BoolX(ctx context.Context) bool {
+	v, err := rss.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (rss *RepairSlipSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rss.sqlQuery().Query()
+	if err := rss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rss *RepairSlipSelect) sqlQuery() sql.Querier {
+	selector := rss.sql
+	selector.Select(selector.Columns(rss.fields...)...)
+	return selector
+}
@@ -0,0 +1,2067 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// RepairSlipUpdate is the builder for updating RepairSlip entities.
+type RepairSlipUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *RepairSlipMutation
+	predicates []predicate.RepairSlip
+}
+
+// Where adds a new predicate for the builder.
+func (rsu *RepairSlipUpdate) Where(ps ...predicate.RepairSlip) *RepairSlipUpdate {
+	rsu.predicates = append(rsu.predicates, ps...)
+	return rsu
+}
+
+// SetSymptom sets the symptom field.
+func (rsu *RepairSlipUpdate) SetSymptom(s string) *RepairSlipUpdate {
+	rsu.mutation.SetSymptom(s)
+	return rsu
+}
+
+// SetCategory sets the category field.
+func (rsu *RepairSlipUpdate) SetCategory(s string) *RepairSlipUpdate {
+	rsu.mutation.SetCategory(s)
+	return rsu
+}
+
+// SetStatus sets the status field.
+func (rsu *RepairSlipUpdate) SetStatus(r repairslip.Status) *RepairSlipUpdate {
+	rsu.mutation.SetStatus(r)
+	return rsu
+}
+
+// SetNillableStatus sets the status field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableStatus(r *repairslip.Status) *RepairSlipUpdate {
+	if r != nil {
+		rsu.SetStatus(*r)
+	}
+	return rsu
+}
+
+// SetUnderWarranty sets the under_warranty field.
+func (rsu *RepairSlipUpdate) SetUnderWarranty(b bool) *RepairSlipUpdate {
+	rsu.mutation.SetUnderWarranty(b)
+	return rsu
+}
+
+// SetNillableUnderWarranty sets the under_warranty field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableUnderWarranty(b *bool) *RepairSlipUpdate {
+	if b != nil {
+		rsu.SetUnderWarranty(*b)
+	}
+	return rsu
+}
+
+// SetRoute sets the route field.
+func (rsu *RepairSlipUpdate) SetRoute(r repairslip.Route) *RepairSlipUpdate {
+	rsu.mutation.SetRoute(r)
+	return rsu
+}
+
+// SetNillableRoute sets the route field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableRoute(r *repairslip.Route) *RepairSlipUpdate {
+	if r != nil {
+		rsu.SetRoute(*r)
+	}
+	return rsu
+}
+
+// SetPriority sets the priority field.
+func (rsu *RepairSlipUpdate) SetPriority(r repairslip.Priority) *RepairSlipUpdate {
+	rsu.mutation.SetPriority(r)
+	return rsu
+}
+
+// SetNillablePriority sets the priority field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillablePriority(r *repairslip.Priority) *RepairSlipUpdate {
+	if r != nil {
+		rsu.SetPriority(*r)
+	}
+	return rsu
+}
+
+// SetResponseDue sets the response_due field.
+func (rsu *RepairSlipUpdate) SetResponseDue(t time.Time) *RepairSlipUpdate {
+	rsu.mutation.SetResponseDue(t)
+	return rsu
+}
+
+// SetNillableResponseDue sets the response_due field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableResponseDue(t *time.Time) *RepairSlipUpdate {
+	if t != nil {
+		rsu.SetResponseDue(*t)
+	}
+	return rsu
+}
+
+// ClearResponseDue clears the value of response_due.
+func (rsu *RepairSlipUpdate) ClearResponseDue() *RepairSlipUpdate {
+	rsu.mutation.ClearResponseDue()
+	return rsu
+}
+
+// SetResolutionDue sets the resolution_due field.
+func (rsu *RepairSlipUpdate) SetResolutionDue(t time.Time) *RepairSlipUpdate {
+	rsu.mutation.SetResolutionDue(t)
+	return rsu
+}
+
+// SetNillableResolutionDue sets the resolution_due field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableResolutionDue(t *time.Time) *RepairSlipUpdate {
+	if t != nil {
+		rsu.SetResolutionDue(*t)
+	}
+	return rsu
+}
+
+// ClearResolutionDue clears the value of resolution_due.
+func (rsu *RepairSlipUpdate) ClearResolutionDue() *RepairSlipUpdate {
+	rsu.mutation.ClearResolutionDue()
+	return rsu
+}
+
+// SetRespondedAt sets the responded_at field.
+func (rsu *RepairSlipUpdate) SetRespondedAt(t time.Time) *RepairSlipUpdate {
+	rsu.mutation.SetRespondedAt(t)
+	return rsu
+}
+
+// SetNillableRespondedAt sets the responded_at field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableRespondedAt(t *time.Time) *RepairSlipUpdate {
+	if t != nil {
+		rsu.SetRespondedAt(*t)
+	}
+	return rsu
+}
+
+// ClearRespondedAt clears the value of responded_at.
+func (rsu *RepairSlipUpdate) ClearRespondedAt() *RepairSlipUpdate {
+	rsu.mutation.ClearRespondedAt()
+	return rsu
+}
+
+// SetResolvedAt sets the resolved_at field.
+func (rsu *RepairSlipUpdate) SetResolvedAt(t time.Time) *RepairSlipUpdate {
+	rsu.mutation.SetResolvedAt(t)
+	return rsu
+}
+
+// SetNillableResolvedAt sets the resolved_at field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableResolvedAt(t *time.Time) *RepairSlipUpdate {
+	if t != nil {
+		rsu.SetResolvedAt(*t)
+	}
+	return rsu
+}
+
+// ClearResolvedAt clears the value of resolved_at.
+func (rsu *RepairSlipUpdate) ClearResolvedAt() *RepairSlipUpdate {
+	rsu.mutation.ClearResolvedAt()
+	return rsu
+}
+
+// SetResponseBreached sets the response_breached field.
+func (rsu *RepairSlipUpdate) SetResponseBreached(b bool) *RepairSlipUpdate {
+	rsu.mutation.SetResponseBreached(b)
+	return rsu
+}
+
+// SetNillableResponseBreached sets the response_breached field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableResponseBreached(b *bool) *RepairSlipUpdate {
+	if b != nil {
+		rsu.SetResponseBreached(*b)
+	}
+	return rsu
+}
+
+// SetResolutionBreached sets the resolution_breached field.
+func (rsu *RepairSlipUpdate) SetResolutionBreached(b bool) *RepairSlipUpdate {
+	rsu.mutation.SetResolutionBreached(b)
+	return rsu
+}
+
+// SetNillableResolutionBreached sets the resolution_breached field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableResolutionBreached(b *bool) *RepairSlipUpdate {
+	if b != nil {
+		rsu.SetResolutionBreached(*b)
+	}
+	return rsu
+}
+
+// SetEscalatedAt sets the escalated_at field.
+func (rsu *RepairSlipUpdate) SetEscalatedAt(t time.Time) *RepairSlipUpdate {
+	rsu.mutation.SetEscalatedAt(t)
+	return rsu
+}
+
+// SetNillableEscalatedAt sets the escalated_at field if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableEscalatedAt(t *time.Time) *RepairSlipUpdate {
+	if t != nil {
+		rsu.SetEscalatedAt(*t)
+	}
+	return rsu
+}
+
+// ClearEscalatedAt clears the value of escalated_at.
+func (rsu *RepairSlipUpdate) ClearEscalatedAt() *RepairSlipUpdate {
+	rsu.mutation.ClearEscalatedAt()
+	return rsu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (rsu *RepairSlipUpdate) SetOrganizationID(id int) *RepairSlipUpdate {
+	rsu.mutation.SetOrganizationID(id)
+	return rsu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableOrganizationID(id *int) *RepairSlipUpdate {
+	if id != nil {
+		rsu = rsu.SetOrganizationID(*id)
+	}
+	return rsu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (rsu *RepairSlipUpdate) SetOrganization(o *Organization) *RepairSlipUpdate {
+	return rsu.SetOrganizationID(o.ID)
+}
+
+// SetReporterID sets the reporter edge to User by id.
+func (rsu *RepairSlipUpdate) SetReporterID(id int) *RepairSlipUpdate {
+	rsu.mutation.SetReporterID(id)
+	return rsu
+}
+
+// SetNillableReporterID sets the reporter edge to User by id if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableReporterID(id *int) *RepairSlipUpdate {
+	if id != nil {
+		rsu = rsu.SetReporterID(*id)
+	}
+	return rsu
+}
+
+// SetReporter sets the reporter edge to User.
+func (rsu *RepairSlipUpdate) SetReporter(u *User) *RepairSlipUpdate {
+	return rsu.SetReporterID(u.ID)
+}
+
+// SetAssigneeID sets the assignee edge to User by id.
+func (rsu *RepairSlipUpdate) SetAssigneeID(id int) *RepairSlipUpdate {
+	rsu.mutation.SetAssigneeID(id)
+	return rsu
+}
+
+// SetNillableAssigneeID sets the assignee edge to User by id if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableAssigneeID(id *int) *RepairSlipUpdate {
+	if id != nil {
+		rsu = rsu.SetAssigneeID(*id)
+	}
+	return rsu
+}
+
+// SetAssignee sets the assignee edge to User.
+func (rsu *RepairSlipUpdate) SetAssignee(u *User) *RepairSlipUpdate {
+	return rsu.SetAssigneeID(u.ID)
+}
+
+// AddPartsUsedIDs adds the parts_used edge to StockMovement by ids.
+func (rsu *RepairSlipUpdate) AddPartsUsedIDs(ids ...int) *RepairSlipUpdate {
+	rsu.mutation.AddPartsUsedIDs(ids...)
+	return rsu
+}
+
+// AddPartsUsed adds the parts_used edges to StockMovement.
+func (rsu *RepairSlipUpdate) AddPartsUsed(s ...*StockMovement) *RepairSlipUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rsu.AddPartsUsedIDs(ids...)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (rsu *RepairSlipUpdate) SetInvoiceID(id int) *RepairSlipUpdate {
+	rsu.mutation.SetInvoiceID(id)
+	return rsu
+}
+
+// SetNillableInvoiceID sets the invoice edge to Invoice by id if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableInvoiceID(id *int) *RepairSlipUpdate {
+	if id != nil {
+		rsu = rsu.SetInvoiceID(*id)
+	}
+	return rsu
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (rsu *RepairSlipUpdate) SetInvoice(i *Invoice) *RepairSlipUpdate {
+	return rsu.SetInvoiceID(i.ID)
+}
+
+// SetEquipmentID sets the equipment edge to Equipment by id.
+func (rsu *RepairSlipUpdate) SetEquipmentID(id int) *RepairSlipUpdate {
+	rsu.mutation.SetEquipmentID(id)
+	return rsu
+}
+
+// SetNillableEquipmentID sets the equipment edge to Equipment by id if the given value is not nil.
+func (rsu *RepairSlipUpdate) SetNillableEquipmentID(id *int) *RepairSlipUpdate {
+	if id != nil {
+		rsu = rsu.SetEquipmentID(*id)
+	}
+	return rsu
+}
+
+// SetEquipment sets the equipment edge to Equipment.
+func (rsu *RepairSlipUpdate) SetEquipment(e *Equipment) *RepairSlipUpdate {
+	return rsu.SetEquipmentID(e.ID)
+}
+
+// AddAttachmentIDs adds the attachments edge to Attachment by ids.
+func (rsu *RepairSlipUpdate) AddAttachmentIDs(ids ...int) *RepairSlipUpdate {
+	rsu.mutation.AddAttachmentIDs(ids...)
+	return rsu
+}
+
+// AddAttachments adds the attachments edges to Attachment.
+func (rsu *RepairSlipUpdate) AddAttachments(a ...*Attachment) *RepairSlipUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsu.AddAttachmentIDs(ids...)
+}
+
+// AddCommentIDs adds the comments edge to Comment by ids.
+func (rsu *RepairSlipUpdate) AddCommentIDs(ids ...int) *RepairSlipUpdate {
+	rsu.mutation.AddCommentIDs(ids...)
+	return rsu
+}
+
+// AddComments adds the comments edges to Comment.
+func (rsu *RepairSlipUpdate) AddComments(c ...*Comment) *RepairSlipUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rsu.AddCommentIDs(ids...)
+}
+
+// AddActivityIDs adds the activities edge to Activity by ids.
+func (rsu *RepairSlipUpdate) AddActivityIDs(ids ...int) *RepairSlipUpdate {
+	rsu.mutation.AddActivityIDs(ids...)
+	return rsu
+}
+
+// AddActivities adds the activities edges to Activity.
+func (rsu *RepairSlipUpdate) AddActivities(a ...*Activity) *RepairSlipUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsu.AddActivityIDs(ids...)
+}
+
+// Mutation returns the RepairSlipMutation object of the builder.
+func (rsu *RepairSlipUpdate) Mutation() *RepairSlipMutation {
+	return rsu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (rsu *RepairSlipUpdate) ClearOrganization() *RepairSlipUpdate {
+	rsu.mutation.ClearOrganization()
+	return rsu
+}
+
+// ClearReporter clears the reporter edge to User.
+func (rsu *RepairSlipUpdate) ClearReporter() *RepairSlipUpdate {
+	rsu.mutation.ClearReporter()
+	return rsu
+}
+
+// ClearAssignee clears the assignee edge to User.
+func (rsu *RepairSlipUpdate) ClearAssignee() *RepairSlipUpdate {
+	rsu.mutation.ClearAssignee()
+	return rsu
+}
+
+// RemovePartsUsedIDs removes the parts_used edge to StockMovement by ids.
+func (rsu *RepairSlipUpdate) RemovePartsUsedIDs(ids ...int) *RepairSlipUpdate {
+	rsu.mutation.RemovePartsUsedIDs(ids...)
+	return rsu
+}
+
+// RemovePartsUsed removes parts_used edges to StockMovement.
+func (rsu *RepairSlipUpdate) RemovePartsUsed(s ...*StockMovement) *RepairSlipUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rsu.RemovePartsUsedIDs(ids...)
+}
+
+// ClearInvoice clears the invoice edge to Invoice.
+func (rsu *RepairSlipUpdate) ClearInvoice() *RepairSlipUpdate {
+	rsu.mutation.ClearInvoice()
+	return rsu
+}
+
+// ClearEquipment clears the equipment edge to Equipment.
+func (rsu *RepairSlipUpdate) ClearEquipment() *RepairSlipUpdate {
+	rsu.mutation.ClearEquipment()
+	return rsu
+}
+
+// RemoveAttachmentIDs removes the attachments edge to Attachment by ids.
+func (rsu *RepairSlipUpdate) RemoveAttachmentIDs(ids ...int) *RepairSlipUpdate {
+	rsu.mutation.RemoveAttachmentIDs(ids...)
+	return rsu
+}
+
+// RemoveAttachments removes attachments edges to Attachment.
+func (rsu *RepairSlipUpdate) RemoveAttachments(a ...*Attachment) *RepairSlipUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsu.RemoveAttachmentIDs(ids...)
+}
+
+// RemoveCommentIDs removes the comments edge to Comment by ids.
+func (rsu *RepairSlipUpdate) RemoveCommentIDs(ids ...int) *RepairSlipUpdate {
+	rsu.mutation.RemoveCommentIDs(ids...)
+	return rsu
+}
+
+// RemoveComments removes comments edges to Comment.
+func (rsu *RepairSlipUpdate) RemoveComments(c ...*Comment) *RepairSlipUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rsu.RemoveCommentIDs(ids...)
+}
+
+// RemoveActivityIDs removes the activities edge to Activity by ids.
+func (rsu *RepairSlipUpdate) RemoveActivityIDs(ids ...int) *RepairSlipUpdate {
+	rsu.mutation.RemoveActivityIDs(ids...)
+	return rsu
+}
+
+// RemoveActivities removes activities edges to Activity.
+func (rsu *RepairSlipUpdate) RemoveActivities(a ...*Activity) *RepairSlipUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsu.RemoveActivityIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (rsu *RepairSlipUpdate) Save(ctx context.Context) (int, error) {
+	if _, ok := rsu.mutation.UpdateTime(); !ok {
+		v := repairslip.UpdateDefaultUpdateTime()
+		rsu.mutation.SetUpdateTime(v)
+	}
+	if v, ok := rsu.mutation.Symptom(); ok {
+		if err := repairslip.SymptomValidator(v); err != nil {
+			return 0, &ValidationError{Name: "symptom", err: fmt.Errorf("ent: validator failed for field \"symptom\": %w", err)}
+		}
+	}
+	if v, ok := rsu.mutation.Category(); ok {
+		if err := repairslip.CategoryValidator(v); err != nil {
+			return 0, &ValidationError{Name: "category", err: fmt.Errorf("ent: validator failed for field \"category\": %w", err)}
+		}
+	}
+	if v, ok := rsu.mutation.Status(); ok {
+		if err := repairslip.StatusValidator(v); err != nil {
+			return 0, &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if v, ok := rsu.mutation.Route(); ok {
+		if err := repairslip.RouteValidator(v); err != nil {
+			return 0, &ValidationError{Name: "route", err: fmt.Errorf("ent: validator failed for field \"route\": %w", err)}
+		}
+	}
+	if v, ok := rsu.mutation.Priority(); ok {
+		if err := repairslip.PriorityValidator(v); err != nil {
+			return 0, &ValidationError{Name: "priority", err: fmt.Errorf("ent: validator failed for field \"priority\": %w", err)}
+		}
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(rsu.hooks) == 0 {
+		affected, err = rsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RepairSlipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rsu.mutation = mutation
+			affected, err = rsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rsu.hooks) - 1; i >= 0; i-- {
+			mut = rsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsu *RepairSlipUpdate) SaveX(ctx context.Context) int {
+	affected, err := rsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rsu *RepairSlipUpdate) Exec(ctx context.Context) error {
+	_, err := rsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsu *RepairSlipUpdate) ExecX(ctx context.Context) {
+	if err := rsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rsu *RepairSlipUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   repairslip.Table,
+			Columns: repairslip.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: repairslip.FieldID,
+			},
+		},
+	}
+	if ps := rsu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsu.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldUpdateTime,
+		})
+	}
+	if value, ok := rsu.mutation.Symptom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: repairslip.FieldSymptom,
+		})
+	}
+	if value, ok := rsu.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: repairslip.FieldCategory,
+		})
+	}
+	if value, ok := rsu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: repairslip.FieldStatus,
+		})
+	}
+	if value, ok := rsu.mutation.UnderWarranty(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: repairslip.FieldUnderWarranty,
+		})
+	}
+	if value, ok := rsu.mutation.Route(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: repairslip.FieldRoute,
+		})
+	}
+	if value, ok := rsu.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: repairslip.FieldPriority,
+		})
+	}
+	if value, ok := rsu.mutation.ResponseDue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldResponseDue,
+		})
+	}
+	if rsu.mutation.ResponseDueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldResponseDue,
+		})
+	}
+	if value, ok := rsu.mutation.ResolutionDue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldResolutionDue,
+		})
+	}
+	if rsu.mutation.ResolutionDueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldResolutionDue,
+		})
+	}
+	if value, ok := rsu.mutation.RespondedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldRespondedAt,
+		})
+	}
+	if rsu.mutation.RespondedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldRespondedAt,
+		})
+	}
+	if value, ok := rsu.mutation.ResolvedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldResolvedAt,
+		})
+	}
+	if rsu.mutation.ResolvedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldResolvedAt,
+		})
+	}
+	if value, ok := rsu.mutation.ResponseBreached(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: repairslip.FieldResponseBreached,
+		})
+	}
+	if value, ok := rsu.mutation.ResolutionBreached(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: repairslip.FieldResolutionBreached,
+		})
+	}
+	if value, ok := rsu.mutation.EscalatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldEscalatedAt,
+		})
+	}
+	if rsu.mutation.EscalatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldEscalatedAt,
+		})
+	}
+	if rsu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   repairslip.OrganizationTable,
+			Columns: []string{repairslip.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   repairslip.OrganizationTable,
+			Columns: []string{repairslip.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsu.mutation.ReporterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.ReporterTable,
+			Columns: []string{repairslip.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.ReporterTable,
+			Columns: []string{repairslip.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsu.mutation.AssigneeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.AssigneeTable,
+			Columns: []string{repairslip.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.AssigneeTable,
+			Columns: []string{repairslip.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := rsu.mutation.RemovedPartsUsedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.PartsUsedTable,
+			Columns: []string{repairslip.PartsUsedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.PartsUsedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.PartsUsedTable,
+			Columns: []string{repairslip.PartsUsedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsu.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   repairslip.InvoiceTable,
+			Columns: []string{repairslip.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   repairslip.InvoiceTable,
+			Columns: []string{repairslip.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsu.mutation.EquipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.EquipmentTable,
+			Columns: []string{repairslip.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.EquipmentTable,
+			Columns: []string{repairslip.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := rsu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.AttachmentsTable,
+			Columns: []string{repairslip.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.AttachmentsTable,
+			Columns: []string{repairslip.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := rsu.mutation.RemovedCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.CommentsTable,
+			Columns: []string{repairslip.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.CommentsTable,
+			Columns: []string{repairslip.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := rsu.mutation.RemovedActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.ActivitiesTable,
+			Columns: []string{repairslip.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: activity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.ActivitiesTable,
+			Columns: []string{repairslip.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: activity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{repairslip.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RepairSlipUpdateOne is the builder for updating a single RepairSlip entity.
+type RepairSlipUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *RepairSlipMutation
+}
+
+// SetSymptom sets the symptom field.
+func (rsuo *RepairSlipUpdateOne) SetSymptom(s string) *RepairSlipUpdateOne {
+	rsuo.mutation.SetSymptom(s)
+	return rsuo
+}
+
+// SetCategory sets the category field.
+func (rsuo *RepairSlipUpdateOne) SetCategory(s string) *RepairSlipUpdateOne {
+	rsuo.mutation.SetCategory(s)
+	return rsuo
+}
+
+// SetStatus sets the status field.
+func (rsuo *RepairSlipUpdateOne) SetStatus(r repairslip.Status) *RepairSlipUpdateOne {
+	rsuo.mutation.SetStatus(r)
+	return rsuo
+}
+
+// SetNillableStatus sets the status field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableStatus(r *repairslip.Status) *RepairSlipUpdateOne {
+	if r != nil {
+		rsuo.SetStatus(*r)
+	}
+	return rsuo
+}
+
+// SetUnderWarranty sets the under_warranty field.
+func (rsuo *RepairSlipUpdateOne) SetUnderWarranty(b bool) *RepairSlipUpdateOne {
+	rsuo.mutation.SetUnderWarranty(b)
+	return rsuo
+}
+
+// SetNillableUnderWarranty sets the under_warranty field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableUnderWarranty(b *bool) *RepairSlipUpdateOne {
+	if b != nil {
+		rsuo.SetUnderWarranty(*b)
+	}
+	return rsuo
+}
+
+// SetRoute sets the route field.
+func (rsuo *RepairSlipUpdateOne) SetRoute(r repairslip.Route) *RepairSlipUpdateOne {
+	rsuo.mutation.SetRoute(r)
+	return rsuo
+}
+
+// SetNillableRoute sets the route field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableRoute(r *repairslip.Route) *RepairSlipUpdateOne {
+	if r != nil {
+		rsuo.SetRoute(*r)
+	}
+	return rsuo
+}
+
+// SetPriority sets the priority field.
+func (rsuo *RepairSlipUpdateOne) SetPriority(r repairslip.Priority) *RepairSlipUpdateOne {
+	rsuo.mutation.SetPriority(r)
+	return rsuo
+}
+
+// SetNillablePriority sets the priority field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillablePriority(r *repairslip.Priority) *RepairSlipUpdateOne {
+	if r != nil {
+		rsuo.SetPriority(*r)
+	}
+	return rsuo
+}
+
+// SetResponseDue sets the response_due field.
+func (rsuo *RepairSlipUpdateOne) SetResponseDue(t time.Time) *RepairSlipUpdateOne {
+	rsuo.mutation.SetResponseDue(t)
+	return rsuo
+}
+
+// SetNillableResponseDue sets the response_due field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableResponseDue(t *time.Time) *RepairSlipUpdateOne {
+	if t != nil {
+		rsuo.SetResponseDue(*t)
+	}
+	return rsuo
+}
+
+// ClearResponseDue clears the value of response_due.
+func (rsuo *RepairSlipUpdateOne) ClearResponseDue() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearResponseDue()
+	return rsuo
+}
+
+// SetResolutionDue sets the resolution_due field.
+func (rsuo *RepairSlipUpdateOne) SetResolutionDue(t time.Time) *RepairSlipUpdateOne {
+	rsuo.mutation.SetResolutionDue(t)
+	return rsuo
+}
+
+// SetNillableResolutionDue sets the resolution_due field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableResolutionDue(t *time.Time) *RepairSlipUpdateOne {
+	if t != nil {
+		rsuo.SetResolutionDue(*t)
+	}
+	return rsuo
+}
+
+// ClearResolutionDue clears the value of resolution_due.
+func (rsuo *RepairSlipUpdateOne) ClearResolutionDue() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearResolutionDue()
+	return rsuo
+}
+
+// SetRespondedAt sets the responded_at field.
+func (rsuo *RepairSlipUpdateOne) SetRespondedAt(t time.Time) *RepairSlipUpdateOne {
+	rsuo.mutation.SetRespondedAt(t)
+	return rsuo
+}
+
+// SetNillableRespondedAt sets the responded_at field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableRespondedAt(t *time.Time) *RepairSlipUpdateOne {
+	if t != nil {
+		rsuo.SetRespondedAt(*t)
+	}
+	return rsuo
+}
+
+// ClearRespondedAt clears the value of responded_at.
+func (rsuo *RepairSlipUpdateOne) ClearRespondedAt() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearRespondedAt()
+	return rsuo
+}
+
+// SetResolvedAt sets the resolved_at field.
+func (rsuo *RepairSlipUpdateOne) SetResolvedAt(t time.Time) *RepairSlipUpdateOne {
+	rsuo.mutation.SetResolvedAt(t)
+	return rsuo
+}
+
+// SetNillableResolvedAt sets the resolved_at field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableResolvedAt(t *time.Time) *RepairSlipUpdateOne {
+	if t != nil {
+		rsuo.SetResolvedAt(*t)
+	}
+	return rsuo
+}
+
+// ClearResolvedAt clears the value of resolved_at.
+func (rsuo *RepairSlipUpdateOne) ClearResolvedAt() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearResolvedAt()
+	return rsuo
+}
+
+// SetResponseBreached sets the response_breached field.
+func (rsuo *RepairSlipUpdateOne) SetResponseBreached(b bool) *RepairSlipUpdateOne {
+	rsuo.mutation.SetResponseBreached(b)
+	return rsuo
+}
+
+// SetNillableResponseBreached sets the response_breached field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableResponseBreached(b *bool) *RepairSlipUpdateOne {
+	if b != nil {
+		rsuo.SetResponseBreached(*b)
+	}
+	return rsuo
+}
+
+// SetResolutionBreached sets the resolution_breached field.
+func (rsuo *RepairSlipUpdateOne) SetResolutionBreached(b bool) *RepairSlipUpdateOne {
+	rsuo.mutation.SetResolutionBreached(b)
+	return rsuo
+}
+
+// SetNillableResolutionBreached sets the resolution_breached field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableResolutionBreached(b *bool) *RepairSlipUpdateOne {
+	if b != nil {
+		rsuo.SetResolutionBreached(*b)
+	}
+	return rsuo
+}
+
+// SetEscalatedAt sets the escalated_at field.
+func (rsuo *RepairSlipUpdateOne) SetEscalatedAt(t time.Time) *RepairSlipUpdateOne {
+	rsuo.mutation.SetEscalatedAt(t)
+	return rsuo
+}
+
+// SetNillableEscalatedAt sets the escalated_at field if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableEscalatedAt(t *time.Time) *RepairSlipUpdateOne {
+	if t != nil {
+		rsuo.SetEscalatedAt(*t)
+	}
+	return rsuo
+}
+
+// ClearEscalatedAt clears the value of escalated_at.
+func (rsuo *RepairSlipUpdateOne) ClearEscalatedAt() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearEscalatedAt()
+	return rsuo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (rsuo *RepairSlipUpdateOne) SetOrganizationID(id int) *RepairSlipUpdateOne {
+	rsuo.mutation.SetOrganizationID(id)
+	return rsuo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableOrganizationID(id *int) *RepairSlipUpdateOne {
+	if id != nil {
+		rsuo = rsuo.SetOrganizationID(*id)
+	}
+	return rsuo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (rsuo *RepairSlipUpdateOne) SetOrganization(o *Organization) *RepairSlipUpdateOne {
+	return rsuo.SetOrganizationID(o.ID)
+}
+
+// SetReporterID sets the reporter edge to User by id.
+func (rsuo *RepairSlipUpdateOne) SetReporterID(id int) *RepairSlipUpdateOne {
+	rsuo.mutation.SetReporterID(id)
+	return rsuo
+}
+
+// SetNillableReporterID sets the reporter edge to User by id if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableReporterID(id *int) *RepairSlipUpdateOne {
+	if id != nil {
+		rsuo = rsuo.SetReporterID(*id)
+	}
+	return rsuo
+}
+
+// SetReporter sets the reporter edge to User.
+func (rsuo *RepairSlipUpdateOne) SetReporter(u *User) *RepairSlipUpdateOne {
+	return rsuo.SetReporterID(u.ID)
+}
+
+// SetAssigneeID sets the assignee edge to User by id.
+func (rsuo *RepairSlipUpdateOne) SetAssigneeID(id int) *RepairSlipUpdateOne {
+	rsuo.mutation.SetAssigneeID(id)
+	return rsuo
+}
+
+// SetNillableAssigneeID sets the assignee edge to User by id if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableAssigneeID(id *int) *RepairSlipUpdateOne {
+	if id != nil {
+		rsuo = rsuo.SetAssigneeID(*id)
+	}
+	return rsuo
+}
+
+// SetAssignee sets the assignee edge to User.
+func (rsuo *RepairSlipUpdateOne) SetAssignee(u *User) *RepairSlipUpdateOne {
+	return rsuo.SetAssigneeID(u.ID)
+}
+
+// AddPartsUsedIDs adds the parts_used edge to StockMovement by ids.
+func (rsuo *RepairSlipUpdateOne) AddPartsUsedIDs(ids ...int) *RepairSlipUpdateOne {
+	rsuo.mutation.AddPartsUsedIDs(ids...)
+	return rsuo
+}
+
+// AddPartsUsed adds the parts_used edges to StockMovement.
+func (rsuo *RepairSlipUpdateOne) AddPartsUsed(s ...*StockMovement) *RepairSlipUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rsuo.AddPartsUsedIDs(ids...)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (rsuo *RepairSlipUpdateOne) SetInvoiceID(id int) *RepairSlipUpdateOne {
+	rsuo.mutation.SetInvoiceID(id)
+	return rsuo
+}
+
+// SetNillableInvoiceID sets the invoice edge to Invoice by id if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableInvoiceID(id *int) *RepairSlipUpdateOne {
+	if id != nil {
+		rsuo = rsuo.SetInvoiceID(*id)
+	}
+	return rsuo
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (rsuo *RepairSlipUpdateOne) SetInvoice(i *Invoice) *RepairSlipUpdateOne {
+	return rsuo.SetInvoiceID(i.ID)
+}
+
+// SetEquipmentID sets the equipment edge to Equipment by id.
+func (rsuo *RepairSlipUpdateOne) SetEquipmentID(id int) *RepairSlipUpdateOne {
+	rsuo.mutation.SetEquipmentID(id)
+	return rsuo
+}
+
+// SetNillableEquipmentID sets the equipment edge to Equipment by id if the given value is not nil.
+func (rsuo *RepairSlipUpdateOne) SetNillableEquipmentID(id *int) *RepairSlipUpdateOne {
+	if id != nil {
+		rsuo = rsuo.SetEquipmentID(*id)
+	}
+	return rsuo
+}
+
+// SetEquipment sets the equipment edge to Equipment.
+func (rsuo *RepairSlipUpdateOne) SetEquipment(e *Equipment) *RepairSlipUpdateOne {
+	return rsuo.SetEquipmentID(e.ID)
+}
+
+// AddAttachmentIDs adds the attachments edge to Attachment by ids.
+func (rsuo *RepairSlipUpdateOne) AddAttachmentIDs(ids ...int) *RepairSlipUpdateOne {
+	rsuo.mutation.AddAttachmentIDs(ids...)
+	return rsuo
+}
+
+// AddAttachments adds the attachments edges to Attachment.
+func (rsuo *RepairSlipUpdateOne) AddAttachments(a ...*Attachment) *RepairSlipUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsuo.AddAttachmentIDs(ids...)
+}
+
+// AddCommentIDs adds the comments edge to Comment by ids.
+func (rsuo *RepairSlipUpdateOne) AddCommentIDs(ids ...int) *RepairSlipUpdateOne {
+	rsuo.mutation.AddCommentIDs(ids...)
+	return rsuo
+}
+
+// AddComments adds the comments edges to Comment.
+func (rsuo *RepairSlipUpdateOne) AddComments(c ...*Comment) *RepairSlipUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rsuo.AddCommentIDs(ids...)
+}
+
+// AddActivityIDs adds the activities edge to Activity by ids.
+func (rsuo *RepairSlipUpdateOne) AddActivityIDs(ids ...int) *RepairSlipUpdateOne {
+	rsuo.mutation.AddActivityIDs(ids...)
+	return rsuo
+}
+
+// AddActivities adds the activities edges to Activity.
+func (rsuo *RepairSlipUpdateOne) AddActivities(a ...*Activity) *RepairSlipUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsuo.AddActivityIDs(ids...)
+}
+
+// Mutation returns the RepairSlipMutation object of the builder.
+func (rsuo *RepairSlipUpdateOne) Mutation() *RepairSlipMutation {
+	return rsuo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (rsuo *RepairSlipUpdateOne) ClearOrganization() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearOrganization()
+	return rsuo
+}
+
+// ClearReporter clears the reporter edge to User.
+func (rsuo *RepairSlipUpdateOne) ClearReporter() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearReporter()
+	return rsuo
+}
+
+// ClearAssignee clears the assignee edge to User.
+func (rsuo *RepairSlipUpdateOne) ClearAssignee() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearAssignee()
+	return rsuo
+}
+
+// RemovePartsUsedIDs removes the parts_used edge to StockMovement by ids.
+func (rsuo *RepairSlipUpdateOne) RemovePartsUsedIDs(ids ...int) *RepairSlipUpdateOne {
+	rsuo.mutation.RemovePartsUsedIDs(ids...)
+	return rsuo
+}
+
+// RemovePartsUsed removes parts_used edges to StockMovement.
+func (rsuo *RepairSlipUpdateOne) RemovePartsUsed(s ...*StockMovement) *RepairSlipUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rsuo.RemovePartsUsedIDs(ids...)
+}
+
+// ClearInvoice clears the invoice edge to Invoice.
+func (rsuo *RepairSlipUpdateOne) ClearInvoice() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearInvoice()
+	return rsuo
+}
+
+// ClearEquipment clears the equipment edge to Equipment.
+func (rsuo *RepairSlipUpdateOne) ClearEquipment() *RepairSlipUpdateOne {
+	rsuo.mutation.ClearEquipment()
+	return rsuo
+}
+
+// RemoveAttachmentIDs removes the attachments edge to Attachment by ids.
+func (rsuo *RepairSlipUpdateOne) RemoveAttachmentIDs(ids ...int) *RepairSlipUpdateOne {
+	rsuo.mutation.RemoveAttachmentIDs(ids...)
+	return rsuo
+}
+
+// RemoveAttachments removes attachments edges to Attachment.
+func (rsuo *RepairSlipUpdateOne) RemoveAttachments(a ...*Attachment) *RepairSlipUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsuo.RemoveAttachmentIDs(ids...)
+}
+
+// RemoveCommentIDs removes the comments edge to Comment by ids.
+func (rsuo *RepairSlipUpdateOne) RemoveCommentIDs(ids ...int) *RepairSlipUpdateOne {
+	rsuo.mutation.RemoveCommentIDs(ids...)
+	return rsuo
+}
+
+// RemoveComments removes comments edges to Comment.
+func (rsuo *RepairSlipUpdateOne) RemoveComments(c ...*Comment) *RepairSlipUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rsuo.RemoveCommentIDs(ids...)
+}
+
+// RemoveActivityIDs removes the activities edge to Activity by ids.
+func (rsuo *RepairSlipUpdateOne) RemoveActivityIDs(ids ...int) *RepairSlipUpdateOne {
+	rsuo.mutation.RemoveActivityIDs(ids...)
+	return rsuo
+}
+
+// RemoveActivities removes activities edges to Activity.
+func (rsuo *RepairSlipUpdateOne) RemoveActivities(a ...*Activity) *RepairSlipUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rsuo.RemoveActivityIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (rsuo *RepairSlipUpdateOne) Save(ctx context.Context) (*RepairSlip, error) {
+	if _, ok := rsuo.mutation.UpdateTime(); !ok {
+		v := repairslip.UpdateDefaultUpdateTime()
+		rsuo.mutation.SetUpdateTime(v)
+	}
+	if v, ok := rsuo.mutation.Symptom(); ok {
+		if err := repairslip.SymptomValidator(v); err != nil {
+			return nil, &ValidationError{Name: "symptom", err: fmt.Errorf("ent: validator failed for field \"symptom\": %w", err)}
+		}
+	}
+	if v, ok := rsuo.mutation.Category(); ok {
+		if err := repairslip.CategoryValidator(v); err != nil {
+			return nil, &ValidationError{Name: "category", err: fmt.Errorf("ent: validator failed for field \"category\": %w", err)}
+		}
+	}
+	if v, ok := rsuo.mutation.Status(); ok {
+		if err := repairslip.StatusValidator(v); err != nil {
+			return nil, &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if v, ok := rsuo.mutation.Route(); ok {
+		if err := repairslip.RouteValidator(v); err != nil {
+			return nil, &ValidationError{Name: "route", err: fmt.Errorf("ent: validator failed for field \"route\": %w", err)}
+		}
+	}
+	if v, ok := rsuo.mutation.Priority(); ok {
+		if err := repairslip.PriorityValidator(v); err != nil {
+			return nil, &ValidationError{Name: "priority", err: fmt.Errorf("ent: validator failed for field \"priority\": %w", err)}
+		}
+	}
+
+	var (
+		err  error
+		node *RepairSlip
+	)
+	if len(rsuo.hooks) == 0 {
+		node, err = rsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RepairSlipMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rsuo.mutation = mutation
+			node, err = rsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rsuo.hooks) - 1; i >= 0; i-- {
+			mut = rsuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rsuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsuo *RepairSlipUpdateOne) SaveX(ctx context.Context) *RepairSlip {
+	rs, err := rsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// Exec executes the query on the entity.
+func (rsuo *RepairSlipUpdateOne) Exec(ctx context.Context) error {
+	_, err := rsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsuo *RepairSlipUpdateOne) ExecX(ctx context.Context) {
+	if err := rsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rsuo *RepairSlipUpdateOne) sqlSave(ctx context.Context) (rs *RepairSlip, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   repairslip.Table,
+			Columns: repairslip.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: repairslip.FieldID,
+			},
+		},
+	}
+	id, ok := rsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing RepairSlip.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := rsuo.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldUpdateTime,
+		})
+	}
+	if value, ok := rsuo.mutation.Symptom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: repairslip.FieldSymptom,
+		})
+	}
+	if value, ok := rsuo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: repairslip.FieldCategory,
+		})
+	}
+	if value, ok := rsuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: repairslip.FieldStatus,
+		})
+	}
+	if value, ok := rsuo.mutation.UnderWarranty(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: repairslip.FieldUnderWarranty,
+		})
+	}
+	if value, ok := rsuo.mutation.Route(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: repairslip.FieldRoute,
+		})
+	}
+	if value, ok := rsuo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: repairslip.FieldPriority,
+		})
+	}
+	if value, ok := rsuo.mutation.ResponseDue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldResponseDue,
+		})
+	}
+	if rsuo.mutation.ResponseDueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldResponseDue,
+		})
+	}
+	if value, ok := rsuo.mutation.ResolutionDue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldResolutionDue,
+		})
+	}
+	if rsuo.mutation.ResolutionDueCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldResolutionDue,
+		})
+	}
+	if value, ok := rsuo.mutation.RespondedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldRespondedAt,
+		})
+	}
+	if rsuo.mutation.RespondedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldRespondedAt,
+		})
+	}
+	if value, ok := rsuo.mutation.ResolvedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldResolvedAt,
+		})
+	}
+	if rsuo.mutation.ResolvedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldResolvedAt,
+		})
+	}
+	if value, ok := rsuo.mutation.ResponseBreached(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: repairslip.FieldResponseBreached,
+		})
+	}
+	if value, ok := rsuo.mutation.ResolutionBreached(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: repairslip.FieldResolutionBreached,
+		})
+	}
+	if value, ok := rsuo.mutation.EscalatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: repairslip.FieldEscalatedAt,
+		})
+	}
+	if rsuo.mutation.EscalatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: repairslip.FieldEscalatedAt,
+		})
+	}
+	if rsuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   repairslip.OrganizationTable,
+			Columns: []string{repairslip.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   repairslip.OrganizationTable,
+			Columns: []string{repairslip.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsuo.mutation.ReporterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.ReporterTable,
+			Columns: []string{repairslip.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.ReporterTable,
+			Columns: []string{repairslip.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsuo.mutation.AssigneeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.AssigneeTable,
+			Columns: []string{repairslip.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.AssigneeTable,
+			Columns: []string{repairslip.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := rsuo.mutation.RemovedPartsUsedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.PartsUsedTable,
+			Columns: []string{repairslip.PartsUsedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.PartsUsedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.PartsUsedTable,
+			Columns: []string{repairslip.PartsUsedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: stockmovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsuo.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   repairslip.InvoiceTable,
+			Columns: []string{repairslip.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   repairslip.InvoiceTable,
+			Columns: []string{repairslip.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rsuo.mutation.EquipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.EquipmentTable,
+			Columns: []string{repairslip.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repairslip.EquipmentTable,
+			Columns: []string{repairslip.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := rsuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.AttachmentsTable,
+			Columns: []string{repairslip.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.AttachmentsTable,
+			Columns: []string{repairslip.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := rsuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.CommentsTable,
+			Columns: []string{repairslip.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.CommentsTable,
+			Columns: []string{repairslip.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := rsuo.mutation.RemovedActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.ActivitiesTable,
+			Columns: []string{repairslip.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: activity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repairslip.ActivitiesTable,
+			Columns: []string{repairslip.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: activity.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	rs = &RepairSlip{config: rsuo.config}
+	_spec.Assign = rs.assignValues
+	_spec.ScanValues = rs.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, rsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{repairslip.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return rs, nil
+}
@@ -2,23 +2,4 @@
 
 package ent
 
-import (
-	"github.com/darksford123x/app/ent/schema"
-	"github.com/darksford123x/app/ent/user"
-)
-
-// The init function reads all schema descriptors with runtime
-// code (default values, validators or hooks) and stitches it
-// to their package variables.
-func init() {
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescAge is the schema descriptor for age field.
-	userDescAge := userFields[0].Descriptor()
-	// user.AgeValidator is a validator for the "age" field. It is called by the builders before save.
-	user.AgeValidator = userDescAge.Validators[0].(func(int) error)
-	// userDescName is the schema descriptor for name field.
-	userDescName := userFields[1].Descriptor()
-	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	user.NameValidator = userDescName.Validators[0].(func(string) error)
-}
+// The schema-stitching logic is generated in github.com/darksford123x/app/ent/runtime/runtime.go
@@ -2,9 +2,639 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/darksford123x/app/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/commentrevision"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/holiday"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/invoiceline"
+	"github.com/darksford123x/app/ent/job"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/schema"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/darksford123x/app/ent/webhook"
+	"github.com/darksford123x/app/ent/webhookdelivery"
+
+	"github.com/facebookincubator/ent"
+)
+
+// The init function reads all schema descriptors with runtime
+// code (default values, validators or hooks) and stitches it
+// to their package variables.
+func init() {
+	activityMixin := schema.Activity{}.Mixin()
+	activity.Policy = schema.Activity{}.Policy()
+	activity.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := activity.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	activityMixinFields0 := activityMixin[0].Fields()
+	activityFields := schema.Activity{}.Fields()
+	_ = activityFields
+	// activityDescCreateTime is the schema descriptor for create_time field.
+	activityDescCreateTime := activityMixinFields0[0].Descriptor()
+	// activity.DefaultCreateTime holds the default value on creation for the create_time field.
+	activity.DefaultCreateTime = activityDescCreateTime.Default.(func() time.Time)
+	attachmentMixin := schema.Attachment{}.Mixin()
+	attachment.Policy = schema.Attachment{}.Policy()
+	attachment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := attachment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	attachmentMixinFields0 := attachmentMixin[0].Fields()
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescCreateTime is the schema descriptor for create_time field.
+	attachmentDescCreateTime := attachmentMixinFields0[0].Descriptor()
+	// attachment.DefaultCreateTime holds the default value on creation for the create_time field.
+	attachment.DefaultCreateTime = attachmentDescCreateTime.Default.(func() time.Time)
+	// attachmentDescFilename is the schema descriptor for filename field.
+	attachmentDescFilename := attachmentFields[0].Descriptor()
+	// attachment.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	attachment.FilenameValidator = attachmentDescFilename.Validators[0].(func(string) error)
+	// attachmentDescContentType is the schema descriptor for content_type field.
+	attachmentDescContentType := attachmentFields[1].Descriptor()
+	// attachment.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	attachment.ContentTypeValidator = attachmentDescContentType.Validators[0].(func(string) error)
+	// attachmentDescKey is the schema descriptor for key field.
+	attachmentDescKey := attachmentFields[3].Descriptor()
+	// attachment.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	attachment.KeyValidator = attachmentDescKey.Validators[0].(func(string) error)
+	// attachmentDescThumbnail is the schema descriptor for thumbnail field.
+	attachmentDescThumbnail := attachmentFields[4].Descriptor()
+	// attachment.DefaultThumbnail holds the default value on creation for the thumbnail field.
+	attachment.DefaultThumbnail = attachmentDescThumbnail.Default.(bool)
+	commentMixin := schema.Comment{}.Mixin()
+	comment.Policy = schema.Comment{}.Policy()
+	comment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := comment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	commentMixinFields0 := commentMixin[0].Fields()
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescCreateTime is the schema descriptor for create_time field.
+	commentDescCreateTime := commentMixinFields0[0].Descriptor()
+	// comment.DefaultCreateTime holds the default value on creation for the create_time field.
+	comment.DefaultCreateTime = commentDescCreateTime.Default.(func() time.Time)
+	// commentDescUpdateTime is the schema descriptor for update_time field.
+	commentDescUpdateTime := commentMixinFields0[1].Descriptor()
+	// comment.DefaultUpdateTime holds the default value on creation for the update_time field.
+	comment.DefaultUpdateTime = commentDescUpdateTime.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	comment.UpdateDefaultUpdateTime = commentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// commentDescBody is the schema descriptor for body field.
+	commentDescBody := commentFields[0].Descriptor()
+	// comment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	comment.BodyValidator = commentDescBody.Validators[0].(func(string) error)
+	// commentDescEdited is the schema descriptor for edited field.
+	commentDescEdited := commentFields[2].Descriptor()
+	// comment.DefaultEdited holds the default value on creation for the edited field.
+	comment.DefaultEdited = commentDescEdited.Default.(bool)
+	commentrevisionMixin := schema.CommentRevision{}.Mixin()
+	commentrevision.Policy = schema.CommentRevision{}.Policy()
+	commentrevision.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := commentrevision.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	commentrevisionMixinFields0 := commentrevisionMixin[0].Fields()
+	commentrevisionFields := schema.CommentRevision{}.Fields()
+	_ = commentrevisionFields
+	// commentrevisionDescCreateTime is the schema descriptor for create_time field.
+	commentrevisionDescCreateTime := commentrevisionMixinFields0[0].Descriptor()
+	// commentrevision.DefaultCreateTime holds the default value on creation for the create_time field.
+	commentrevision.DefaultCreateTime = commentrevisionDescCreateTime.Default.(func() time.Time)
+	equipmentMixin := schema.Equipment{}.Mixin()
+	equipment.Policy = schema.Equipment{}.Policy()
+	equipment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := equipment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	equipmentHooks := schema.Equipment{}.Hooks()
+
+	equipment.Hooks[1] = equipmentHooks[0]
+
+	equipment.Hooks[2] = equipmentHooks[1]
+	equipmentMixinFields0 := equipmentMixin[0].Fields()
+	equipmentFields := schema.Equipment{}.Fields()
+	_ = equipmentFields
+	// equipmentDescCreateTime is the schema descriptor for create_time field.
+	equipmentDescCreateTime := equipmentMixinFields0[0].Descriptor()
+	// equipment.DefaultCreateTime holds the default value on creation for the create_time field.
+	equipment.DefaultCreateTime = equipmentDescCreateTime.Default.(func() time.Time)
+	// equipmentDescUpdateTime is the schema descriptor for update_time field.
+	equipmentDescUpdateTime := equipmentMixinFields0[1].Descriptor()
+	// equipment.DefaultUpdateTime holds the default value on creation for the update_time field.
+	equipment.DefaultUpdateTime = equipmentDescUpdateTime.Default.(func() time.Time)
+	// equipment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	equipment.UpdateDefaultUpdateTime = equipmentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// equipmentDescName is the schema descriptor for name field.
+	equipmentDescName := equipmentFields[0].Descriptor()
+	// equipment.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	equipment.NameValidator = equipmentDescName.Validators[0].(func(string) error)
+	// equipmentDescSerialNumber is the schema descriptor for serial_number field.
+	equipmentDescSerialNumber := equipmentFields[1].Descriptor()
+	// equipment.SerialNumberValidator is a validator for the "serial_number" field. It is called by the builders before save.
+	equipment.SerialNumberValidator = equipmentDescSerialNumber.Validators[0].(func(string) error)
+	// equipmentDescAssetTag is the schema descriptor for asset_tag field.
+	equipmentDescAssetTag := equipmentFields[3].Descriptor()
+	// equipment.AssetTagValidator is a validator for the "asset_tag" field. It is called by the builders before save.
+	equipment.AssetTagValidator = func() func(string) error {
+		validators := equipmentDescAssetTag.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(asset_tag string) error {
+			for _, fn := range fns {
+				if err := fn(asset_tag); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	equipmentmoveMixin := schema.EquipmentMove{}.Mixin()
+	equipmentmove.Policy = schema.EquipmentMove{}.Policy()
+	equipmentmove.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := equipmentmove.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	equipmentmoveMixinFields0 := equipmentmoveMixin[0].Fields()
+	equipmentmoveFields := schema.EquipmentMove{}.Fields()
+	_ = equipmentmoveFields
+	// equipmentmoveDescCreateTime is the schema descriptor for create_time field.
+	equipmentmoveDescCreateTime := equipmentmoveMixinFields0[0].Descriptor()
+	// equipmentmove.DefaultCreateTime holds the default value on creation for the create_time field.
+	equipmentmove.DefaultCreateTime = equipmentmoveDescCreateTime.Default.(func() time.Time)
+	holiday.Policy = schema.Holiday{}.Policy()
+	holiday.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := holiday.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	holidayFields := schema.Holiday{}.Fields()
+	_ = holidayFields
+	// holidayDescName is the schema descriptor for name field.
+	holidayDescName := holidayFields[1].Descriptor()
+	// holiday.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	holiday.NameValidator = holidayDescName.Validators[0].(func(string) error)
+	invoiceMixin := schema.Invoice{}.Mixin()
+	invoice.Policy = schema.Invoice{}.Policy()
+	invoice.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := invoice.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	invoiceHooks := schema.Invoice{}.Hooks()
+
+	invoice.Hooks[1] = invoiceHooks[0]
+
+	invoice.Hooks[2] = invoiceHooks[1]
+	invoiceMixinFields0 := invoiceMixin[0].Fields()
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescCreateTime is the schema descriptor for create_time field.
+	invoiceDescCreateTime := invoiceMixinFields0[0].Descriptor()
+	// invoice.DefaultCreateTime holds the default value on creation for the create_time field.
+	invoice.DefaultCreateTime = invoiceDescCreateTime.Default.(func() time.Time)
+	// invoiceDescNumber is the schema descriptor for number field.
+	invoiceDescNumber := invoiceFields[0].Descriptor()
+	// invoice.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	invoice.NumberValidator = invoiceDescNumber.Validators[0].(func(string) error)
+	// invoiceDescCurrency is the schema descriptor for currency field.
+	invoiceDescCurrency := invoiceFields[2].Descriptor()
+	// invoice.DefaultCurrency holds the default value on creation for the currency field.
+	invoice.DefaultCurrency = invoiceDescCurrency.Default.(string)
+	invoiceline.Policy = schema.InvoiceLine{}.Policy()
+	invoiceline.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := invoiceline.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	invoicelineHooks := schema.InvoiceLine{}.Hooks()
+
+	invoiceline.Hooks[1] = invoicelineHooks[0]
+	invoicelineFields := schema.InvoiceLine{}.Fields()
+	_ = invoicelineFields
+	// invoicelineDescDescription is the schema descriptor for description field.
+	invoicelineDescDescription := invoicelineFields[1].Descriptor()
+	// invoiceline.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	invoiceline.DescriptionValidator = invoicelineDescDescription.Validators[0].(func(string) error)
+	jobMixin := schema.Job{}.Mixin()
+	job.Policy = schema.Job{}.Policy()
+	job.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := job.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	jobMixinFields0 := jobMixin[0].Fields()
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescCreateTime is the schema descriptor for create_time field.
+	jobDescCreateTime := jobMixinFields0[0].Descriptor()
+	// job.DefaultCreateTime holds the default value on creation for the create_time field.
+	job.DefaultCreateTime = jobDescCreateTime.Default.(func() time.Time)
+	// jobDescUpdateTime is the schema descriptor for update_time field.
+	jobDescUpdateTime := jobMixinFields0[1].Descriptor()
+	// job.DefaultUpdateTime holds the default value on creation for the update_time field.
+	job.DefaultUpdateTime = jobDescUpdateTime.Default.(func() time.Time)
+	// job.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	job.UpdateDefaultUpdateTime = jobDescUpdateTime.UpdateDefault.(func() time.Time)
+	// jobDescKind is the schema descriptor for kind field.
+	jobDescKind := jobFields[0].Descriptor()
+	// job.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	job.KindValidator = jobDescKind.Validators[0].(func(string) error)
+	// jobDescPayload is the schema descriptor for payload field.
+	jobDescPayload := jobFields[1].Descriptor()
+	// job.DefaultPayload holds the default value on creation for the payload field.
+	job.DefaultPayload = jobDescPayload.Default.(string)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[3].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescMaxAttempts is the schema descriptor for max_attempts field.
+	jobDescMaxAttempts := jobFields[4].Descriptor()
+	// job.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	job.DefaultMaxAttempts = jobDescMaxAttempts.Default.(int)
+	// job.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	job.MaxAttemptsValidator = jobDescMaxAttempts.Validators[0].(func(int) error)
+	// jobDescRunAt is the schema descriptor for run_at field.
+	jobDescRunAt := jobFields[5].Descriptor()
+	// job.DefaultRunAt holds the default value on creation for the run_at field.
+	job.DefaultRunAt = jobDescRunAt.Default.(func() time.Time)
+	locationMixin := schema.Location{}.Mixin()
+	location.Policy = schema.Location{}.Policy()
+	location.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := location.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	locationHooks := schema.Location{}.Hooks()
+
+	location.Hooks[1] = locationHooks[0]
+	locationMixinFields0 := locationMixin[0].Fields()
+	locationFields := schema.Location{}.Fields()
+	_ = locationFields
+	// locationDescCreateTime is the schema descriptor for create_time field.
+	locationDescCreateTime := locationMixinFields0[0].Descriptor()
+	// location.DefaultCreateTime holds the default value on creation for the create_time field.
+	location.DefaultCreateTime = locationDescCreateTime.Default.(func() time.Time)
+	// locationDescUpdateTime is the schema descriptor for update_time field.
+	locationDescUpdateTime := locationMixinFields0[1].Descriptor()
+	// location.DefaultUpdateTime holds the default value on creation for the update_time field.
+	location.DefaultUpdateTime = locationDescUpdateTime.Default.(func() time.Time)
+	// location.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	location.UpdateDefaultUpdateTime = locationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// locationDescName is the schema descriptor for name field.
+	locationDescName := locationFields[0].Descriptor()
+	// location.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	location.NameValidator = locationDescName.Validators[0].(func(string) error)
+	organizationMixin := schema.Organization{}.Mixin()
+	organizationMixinFields0 := organizationMixin[0].Fields()
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescCreateTime is the schema descriptor for create_time field.
+	organizationDescCreateTime := organizationMixinFields0[0].Descriptor()
+	// organization.DefaultCreateTime holds the default value on creation for the create_time field.
+	organization.DefaultCreateTime = organizationDescCreateTime.Default.(func() time.Time)
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[0].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	part.Policy = schema.Part{}.Policy()
+	part.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := part.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	partFields := schema.Part{}.Fields()
+	_ = partFields
+	// partDescSku is the schema descriptor for sku field.
+	partDescSku := partFields[0].Descriptor()
+	// part.SkuValidator is a validator for the "sku" field. It is called by the builders before save.
+	part.SkuValidator = partDescSku.Validators[0].(func(string) error)
+	// partDescName is the schema descriptor for name field.
+	partDescName := partFields[1].Descriptor()
+	// part.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	part.NameValidator = partDescName.Validators[0].(func(string) error)
+	// partDescUnit is the schema descriptor for unit field.
+	partDescUnit := partFields[2].Descriptor()
+	// part.DefaultUnit holds the default value on creation for the unit field.
+	part.DefaultUnit = partDescUnit.Default.(string)
+	paymentMixin := schema.Payment{}.Mixin()
+	payment.Policy = schema.Payment{}.Policy()
+	payment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := payment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	paymentHooks := schema.Payment{}.Hooks()
+
+	payment.Hooks[1] = paymentHooks[0]
+
+	payment.Hooks[2] = paymentHooks[1]
+	paymentMixinFields0 := paymentMixin[0].Fields()
+	paymentFields := schema.Payment{}.Fields()
+	_ = paymentFields
+	// paymentDescCreateTime is the schema descriptor for create_time field.
+	paymentDescCreateTime := paymentMixinFields0[0].Descriptor()
+	// payment.DefaultCreateTime holds the default value on creation for the create_time field.
+	payment.DefaultCreateTime = paymentDescCreateTime.Default.(func() time.Time)
+	// paymentDescNumber is the schema descriptor for number field.
+	paymentDescNumber := paymentFields[0].Descriptor()
+	// payment.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	payment.NumberValidator = paymentDescNumber.Validators[0].(func(string) error)
+	// paymentDescPaidAt is the schema descriptor for paid_at field.
+	paymentDescPaidAt := paymentFields[5].Descriptor()
+	// payment.DefaultPaidAt holds the default value on creation for the paid_at field.
+	payment.DefaultPaidAt = paymentDescPaidAt.Default.(func() time.Time)
+	repairslipMixin := schema.RepairSlip{}.Mixin()
+	repairslip.Policy = schema.RepairSlip{}.Policy()
+	repairslip.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := repairslip.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	repairslipMixinFields0 := repairslipMixin[0].Fields()
+	repairslipFields := schema.RepairSlip{}.Fields()
+	_ = repairslipFields
+	// repairslipDescCreateTime is the schema descriptor for create_time field.
+	repairslipDescCreateTime := repairslipMixinFields0[0].Descriptor()
+	// repairslip.DefaultCreateTime holds the default value on creation for the create_time field.
+	repairslip.DefaultCreateTime = repairslipDescCreateTime.Default.(func() time.Time)
+	// repairslipDescUpdateTime is the schema descriptor for update_time field.
+	repairslipDescUpdateTime := repairslipMixinFields0[1].Descriptor()
+	// repairslip.DefaultUpdateTime holds the default value on creation for the update_time field.
+	repairslip.DefaultUpdateTime = repairslipDescUpdateTime.Default.(func() time.Time)
+	// repairslip.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	repairslip.UpdateDefaultUpdateTime = repairslipDescUpdateTime.UpdateDefault.(func() time.Time)
+	// repairslipDescSymptom is the schema descriptor for symptom field.
+	repairslipDescSymptom := repairslipFields[0].Descriptor()
+	// repairslip.SymptomValidator is a validator for the "symptom" field. It is called by the builders before save.
+	repairslip.SymptomValidator = repairslipDescSymptom.Validators[0].(func(string) error)
+	// repairslipDescCategory is the schema descriptor for category field.
+	repairslipDescCategory := repairslipFields[1].Descriptor()
+	// repairslip.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	repairslip.CategoryValidator = repairslipDescCategory.Validators[0].(func(string) error)
+	// repairslipDescUnderWarranty is the schema descriptor for under_warranty field.
+	repairslipDescUnderWarranty := repairslipFields[3].Descriptor()
+	// repairslip.DefaultUnderWarranty holds the default value on creation for the under_warranty field.
+	repairslip.DefaultUnderWarranty = repairslipDescUnderWarranty.Default.(bool)
+	// repairslipDescResponseBreached is the schema descriptor for response_breached field.
+	repairslipDescResponseBreached := repairslipFields[10].Descriptor()
+	// repairslip.DefaultResponseBreached holds the default value on creation for the response_breached field.
+	repairslip.DefaultResponseBreached = repairslipDescResponseBreached.Default.(bool)
+	// repairslipDescResolutionBreached is the schema descriptor for resolution_breached field.
+	repairslipDescResolutionBreached := repairslipFields[11].Descriptor()
+	// repairslip.DefaultResolutionBreached holds the default value on creation for the resolution_breached field.
+	repairslip.DefaultResolutionBreached = repairslipDescResolutionBreached.Default.(bool)
+	slapolicy.Policy = schema.SLAPolicy{}.Policy()
+	slapolicy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := slapolicy.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	slapolicyFields := schema.SLAPolicy{}.Fields()
+	_ = slapolicyFields
+	// slapolicyDescCategory is the schema descriptor for category field.
+	slapolicyDescCategory := slapolicyFields[1].Descriptor()
+	// slapolicy.DefaultCategory holds the default value on creation for the category field.
+	slapolicy.DefaultCategory = slapolicyDescCategory.Default.(string)
+	// slapolicyDescResponseMinutes is the schema descriptor for response_minutes field.
+	slapolicyDescResponseMinutes := slapolicyFields[2].Descriptor()
+	// slapolicy.ResponseMinutesValidator is a validator for the "response_minutes" field. It is called by the builders before save.
+	slapolicy.ResponseMinutesValidator = slapolicyDescResponseMinutes.Validators[0].(func(int) error)
+	// slapolicyDescResolutionMinutes is the schema descriptor for resolution_minutes field.
+	slapolicyDescResolutionMinutes := slapolicyFields[3].Descriptor()
+	// slapolicy.ResolutionMinutesValidator is a validator for the "resolution_minutes" field. It is called by the builders before save.
+	slapolicy.ResolutionMinutesValidator = slapolicyDescResolutionMinutes.Validators[0].(func(int) error)
+	stocklevel.Policy = schema.StockLevel{}.Policy()
+	stocklevel.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := stocklevel.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	stocklevelFields := schema.StockLevel{}.Fields()
+	_ = stocklevelFields
+	// stocklevelDescLocation is the schema descriptor for location field.
+	stocklevelDescLocation := stocklevelFields[0].Descriptor()
+	// stocklevel.LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	stocklevel.LocationValidator = stocklevelDescLocation.Validators[0].(func(string) error)
+	// stocklevelDescOnHand is the schema descriptor for on_hand field.
+	stocklevelDescOnHand := stocklevelFields[1].Descriptor()
+	// stocklevel.DefaultOnHand holds the default value on creation for the on_hand field.
+	stocklevel.DefaultOnHand = stocklevelDescOnHand.Default.(int)
+	// stocklevel.OnHandValidator is a validator for the "on_hand" field. It is called by the builders before save.
+	stocklevel.OnHandValidator = stocklevelDescOnHand.Validators[0].(func(int) error)
+	stockmovementMixin := schema.StockMovement{}.Mixin()
+	stockmovement.Policy = schema.StockMovement{}.Policy()
+	stockmovement.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := stockmovement.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	stockmovementMixinFields0 := stockmovementMixin[0].Fields()
+	stockmovementFields := schema.StockMovement{}.Fields()
+	_ = stockmovementFields
+	// stockmovementDescCreateTime is the schema descriptor for create_time field.
+	stockmovementDescCreateTime := stockmovementMixinFields0[0].Descriptor()
+	// stockmovement.DefaultCreateTime holds the default value on creation for the create_time field.
+	stockmovement.DefaultCreateTime = stockmovementDescCreateTime.Default.(func() time.Time)
+	// stockmovementDescLocation is the schema descriptor for location field.
+	stockmovementDescLocation := stockmovementFields[2].Descriptor()
+	// stockmovement.LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	stockmovement.LocationValidator = stockmovementDescLocation.Validators[0].(func(string) error)
+	user.Policy = schema.User{}.Policy()
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescAge is the schema descriptor for age field.
+	userDescAge := userFields[0].Descriptor()
+	// user.AgeValidator is a validator for the "age" field. It is called by the builders before save.
+	user.AgeValidator = userDescAge.Validators[0].(func(int) error)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[1].Descriptor()
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescDisabled is the schema descriptor for disabled field.
+	userDescDisabled := userFields[8].Descriptor()
+	// user.DefaultDisabled holds the default value on creation for the disabled field.
+	user.DefaultDisabled = userDescDisabled.Default.(bool)
+	// userDescAssignmentVersion is the schema descriptor for assignment_version field.
+	userDescAssignmentVersion := userFields[9].Descriptor()
+	// user.DefaultAssignmentVersion holds the default value on creation for the assignment_version field.
+	user.DefaultAssignmentVersion = userDescAssignmentVersion.Default.(int)
+	// user.AssignmentVersionValidator is a validator for the "assignment_version" field. It is called by the builders before save.
+	user.AssignmentVersionValidator = userDescAssignmentVersion.Validators[0].(func(int) error)
+	warrantyterm.Policy = schema.WarrantyTerm{}.Policy()
+	warrantyterm.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := warrantyterm.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	warrantytermFields := schema.WarrantyTerm{}.Fields()
+	_ = warrantytermFields
+	// warrantytermDescName is the schema descriptor for name field.
+	warrantytermDescName := warrantytermFields[0].Descriptor()
+	// warrantyterm.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	warrantyterm.NameValidator = warrantytermDescName.Validators[0].(func(string) error)
+	// warrantytermDescProvider is the schema descriptor for provider field.
+	warrantytermDescProvider := warrantytermFields[1].Descriptor()
+	// warrantyterm.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	warrantyterm.ProviderValidator = warrantytermDescProvider.Validators[0].(func(string) error)
+	// warrantytermDescMonths is the schema descriptor for months field.
+	warrantytermDescMonths := warrantytermFields[2].Descriptor()
+	// warrantyterm.MonthsValidator is a validator for the "months" field. It is called by the builders before save.
+	warrantyterm.MonthsValidator = warrantytermDescMonths.Validators[0].(func(int) error)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhook.Policy = schema.Webhook{}.Policy()
+	webhook.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhook.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreateTime is the schema descriptor for create_time field.
+	webhookDescCreateTime := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhook.DefaultCreateTime = webhookDescCreateTime.Default.(func() time.Time)
+	// webhookDescUpdateTime is the schema descriptor for update_time field.
+	webhookDescUpdateTime := webhookMixinFields0[1].Descriptor()
+	// webhook.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhook.DefaultUpdateTime = webhookDescUpdateTime.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhook.UpdateDefaultUpdateTime = webhookDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[0].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[1].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[3].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescFailures is the schema descriptor for failures field.
+	webhookDescFailures := webhookFields[4].Descriptor()
+	// webhook.DefaultFailures holds the default value on creation for the failures field.
+	webhook.DefaultFailures = webhookDescFailures.Default.(int)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdelivery.Policy = schema.WebhookDelivery{}.Policy()
+	webhookdelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhookdelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreateTime is the schema descriptor for create_time field.
+	webhookdeliveryDescCreateTime := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhookdelivery.DefaultCreateTime = webhookdeliveryDescCreateTime.Default.(func() time.Time)
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+}
 
 const (
-	Version = "v0.2.7"                                          // Version of ent codegen.
-	Sum     = "h1:8aBKtC2cBnjbu2y0LnHHYhfJhWXHVvyCbxtNO6jyBm4=" // Sum of ent codegen.
+	Version = "v0.2.7" // Version of ent codegen.
 )
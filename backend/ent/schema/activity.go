@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// Activity holds the schema definition for the Activity entity. Activities
+// record the changes of a repair slip for its timeline.
+type Activity struct {
+	ent.Schema
+}
+
+// Mixin of the Activity.
+func (Activity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Activity.
+func (Activity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("status_changed", "priority_changed", "assigned", "unassigned", "escalated").
+			Immutable(),
+		// from and to are the old and new status or priority.
+		field.String("from").
+			Optional().
+			Immutable(),
+		field.String("to").
+			Optional().
+			Immutable(),
+	}
+}
+
+// Edges of the Activity.
+func (Activity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("repair_slip", RepairSlip.Type).
+			Ref("activities").
+			Required().
+			Unique(),
+		// actor is the signed in user who made the change; changes made
+		// by the system have none.
+		edge.To("actor", User.Type).
+			Unique(),
+		// technician is who was assigned or unassigned.
+		edge.To("technician", User.Type).
+			Unique(),
+	}
+}
+
+// Policy of the Activity.
+func (Activity) Policy() ent.Policy {
+	return tenant.Policy()
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// Attachment holds the schema definition for the Attachment entity. An
+// attachment is a photo or document uploaded to a repair slip; its content
+// lives in the file storage under key.
+type Attachment struct {
+	ent.Schema
+}
+
+// Mixin of the Attachment.
+func (Attachment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		// filename is the name the file was uploaded with.
+		field.String("filename").
+			NotEmpty().
+			Immutable(),
+		// content_type is detected from the content, not taken from the
+		// upload.
+		field.String("content_type").
+			NotEmpty().
+			Immutable(),
+		field.Int64("size").
+			Immutable().
+			StructTag(`json:"size"`),
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Sensitive(),
+		// thumbnail tells whether a thumbnail was stored next to the
+		// content; it is for images only.
+		field.Bool("thumbnail").
+			Default(false).
+			Immutable().
+			StructTag(`json:"thumbnail"`),
+	}
+}
+
+// Edges of the Attachment.
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("repair_slip", RepairSlip.Type).
+			Ref("attachments").
+			Required().
+			Unique(),
+		edge.From("uploader", User.Type).
+			Ref("attachments").
+			Unique(),
+	}
+}
+
+// Policy of the Attachment.
+func (Attachment) Policy() ent.Policy {
+	return tenant.Policy()
+}
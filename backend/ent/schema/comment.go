@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// Comment holds the schema definition for the Comment entity. Comments are
+// the notes written on a repair slip; internal ones are hidden from the
+// staff who reported it.
+type Comment struct {
+	ent.Schema
+}
+
+// Mixin of the Comment.
+func (Comment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		// body is markdown; users are mentioned as @ and their email.
+		field.Text("body").
+			NotEmpty(),
+		field.Enum("visibility").
+			Values("public", "internal").
+			Default("public"),
+		// edited tells whether the body was changed since it was posted;
+		// the earlier bodies are kept as revisions.
+		field.Bool("edited").
+			Default(false).
+			StructTag(`json:"edited"`),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("repair_slip", RepairSlip.Type).
+			Ref("comments").
+			Required().
+			Unique(),
+		edge.To("author", User.Type).
+			Required().
+			Unique(),
+		edge.To("mentions", User.Type),
+		edge.To("revisions", CommentRevision.Type),
+	}
+}
+
+// Policy of the Comment.
+func (Comment) Policy() ent.Policy {
+	return tenant.Policy()
+}
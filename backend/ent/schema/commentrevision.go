@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// CommentRevision holds the schema definition for the CommentRevision
+// entity. A revision is the body a comment had before an edit.
+type CommentRevision struct {
+	ent.Schema
+}
+
+// Mixin of the CommentRevision.
+func (CommentRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the CommentRevision.
+func (CommentRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("body").
+			Immutable(),
+	}
+}
+
+// Edges of the CommentRevision.
+func (CommentRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("comment", Comment.Type).
+			Ref("revisions").
+			Required().
+			Unique(),
+	}
+}
+
+// Policy of the CommentRevision.
+func (CommentRevision) Policy() ent.Policy {
+	return tenant.Policy()
+}
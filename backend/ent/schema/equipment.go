@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"regexp"
+
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/labels"
+	"github.com/darksford123x/app/tenant"
+	"github.com/darksford123x/app/warranty"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// Equipment holds the schema definition for the Equipment entity.
+type Equipment struct {
+	ent.Schema
+}
+
+// Mixin of the Equipment.
+func (Equipment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Equipment.
+func (Equipment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		// serial_number is unique in the organization, like asset_tag:
+		// organizations may own equipment of the same serial number.
+		field.String("serial_number").
+			NotEmpty(),
+		field.String("model").Optional(),
+		// asset_tag is printed on the label of the equipment, as text, a
+		// QR code and a barcode. Equipment created without one is given
+		// the next tag of the sequence of its organization.
+		field.String("asset_tag").
+			Optional().
+			MaxLen(32).
+			Match(regexp.MustCompile(`^[0-9A-Z][0-9A-Z.-]*$`)),
+		// The warranty covers repairs from warranty_start to warranty_end,
+		// both inclusive. Repairs in that period are claimed from the
+		// warranty_provider instead of being done in-house.
+		field.Time("warranty_start").
+			Optional().
+			Nillable(),
+		field.Time("warranty_end").
+			Optional().
+			Nillable(),
+		field.String("warranty_provider").Optional(),
+	}
+}
+
+// Edges of the Equipment.
+func (Equipment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("warranty_term", WarrantyTerm.Type).
+			Ref("equipment").
+			Unique(),
+		edge.To("repair_slips", RepairSlip.Type),
+		// The changes of location are recorded as moves by the hook of
+		// the locations package.
+		edge.From("location", Location.Type).
+			Ref("equipment").
+			Unique(),
+		edge.To("moves", EquipmentMove.Type),
+	}
+}
+
+// Indexes of the Equipment.
+func (Equipment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("serial_number").
+			Edges("organization").
+			Unique(),
+		index.Fields("asset_tag").
+			Edges("organization").
+			Unique(),
+	}
+}
+
+// Hooks of the Equipment.
+func (Equipment) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(warranty.ValidatePeriod, ent.OpCreate|ent.OpUpdateOne),
+		hook.On(labels.AssignTag, ent.OpCreate|ent.OpUpdateOne),
+	}
+}
+
+// Policy of the Equipment.
+func (Equipment) Policy() ent.Policy {
+	return tenant.Policy()
+}
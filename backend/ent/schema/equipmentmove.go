@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// EquipmentMove holds the schema definition for the EquipmentMove entity.
+// Moves form the history of where equipment was kept: every change of the
+// location of equipment is recorded with one.
+type EquipmentMove struct {
+	ent.Schema
+}
+
+// Mixin of the EquipmentMove.
+func (EquipmentMove) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the EquipmentMove.
+func (EquipmentMove) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("note").Optional().Immutable(),
+	}
+}
+
+// Edges of the EquipmentMove.
+func (EquipmentMove) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("equipment", Equipment.Type).
+			Ref("moves").
+			Unique().
+			Required(),
+		// from_location is empty for the move placing equipment for the
+		// first time, and to_location for the move taking it out of its
+		// location.
+		edge.To("from_location", Location.Type).
+			Unique(),
+		edge.To("to_location", Location.Type).
+			Unique(),
+		edge.To("moved_by", User.Type).
+			Unique(),
+	}
+}
+
+// Policy of the EquipmentMove.
+func (EquipmentMove) Policy() ent.Policy {
+	return tenant.Policy()
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+)
+
+// Holiday holds the schema definition for the Holiday entity.
+// Holidays are not business days when SLA deadlines are computed.
+type Holiday struct {
+	ent.Schema
+}
+
+// Mixin of the Holiday.
+func (Holiday) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Holiday.
+func (Holiday) Fields() []ent.Field {
+	return []ent.Field{
+		// date is midnight of the holiday in the business time zone.
+		field.Time("date"),
+		field.String("name").NotEmpty(),
+	}
+}
+
+// Indexes of the Holiday.
+func (Holiday) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("date").
+			Edges("organization").
+			Unique(),
+	}
+}
+
+// Policy of the Holiday.
+func (Holiday) Policy() ent.Policy {
+	return tenant.Policy()
+}
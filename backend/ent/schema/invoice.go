@@ -0,0 +1,135 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+// Invoices are issued when they are created and are never edited
+// afterwards; corrections are made by issuing a credit note against them.
+// Only the payment fields change, and only as payments are recorded and
+// credit notes issued.
+type Invoice struct {
+	ent.Schema
+}
+
+// Mixin of the Invoice.
+func (Invoice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Enum("kind").
+			Values("invoice", "credit_note").
+			Default("invoice").
+			Immutable(),
+		field.String("currency").
+			Default("THB").
+			Immutable(),
+		field.Int64("subtotal").
+			GoType(money.Amount(0)).
+			StructTag(`json:"subtotal"`).
+			Immutable(),
+		field.Int64("discount").
+			GoType(money.Amount(0)).
+			StructTag(`json:"discount"`).
+			Immutable(),
+		field.Int64("vat_rate").
+			GoType(money.Rate(0)).
+			StructTag(`json:"vat_rate"`).
+			Immutable(),
+		field.Int64("vat").
+			GoType(money.Amount(0)).
+			StructTag(`json:"vat"`).
+			Immutable(),
+		field.Int64("total").
+			GoType(money.Amount(0)).
+			StructTag(`json:"total"`).
+			Immutable(),
+		// reason explains why a credit note was issued.
+		field.String("reason").
+			Optional().
+			Immutable(),
+		// payment_status and amount_paid are derived from the payments
+		// recorded against the invoice and the credit notes issued against
+		// it. A refund is due when more was paid than is left after the
+		// credit notes.
+		field.Enum("payment_status").
+			Values("unpaid", "partial", "paid", "refund_due").
+			Default("unpaid"),
+		field.Int64("amount_paid").
+			GoType(money.Amount(0)).
+			StructTag(`json:"amount_paid"`),
+		// amount_credited is the total of the credit notes issued against
+		// the invoice.
+		field.Int64("amount_credited").
+			GoType(money.Amount(0)).
+			StructTag(`json:"amount_credited"`),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("repair_slip", RepairSlip.Type).
+			Ref("invoice").
+			Unique(),
+		edge.From("customer", User.Type).
+			Ref("invoices").
+			Unique(),
+		edge.To("lines", InvoiceLine.Type),
+		edge.To("credit_notes", Invoice.Type).
+			From("original").
+			Unique(),
+		edge.To("payments", Payment.Type),
+	}
+}
+
+// Hooks of the Invoice.
+func (Invoice) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Reject(ent.OpDelete | ent.OpDeleteOne),
+		hook.On(paymentFieldsOnly, ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// paymentFieldsOnly rejects updates that touch anything but the payment
+// fields of an invoice.
+func paymentFieldsOnly(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		changed := append(m.Fields(), m.AddedFields()...)
+		changed = append(changed, m.ClearedFields()...)
+		for _, f := range changed {
+			if f != "payment_status" && f != "amount_paid" && f != "amount_credited" {
+				return nil, fmt.Errorf("invoice: issued invoices cannot be edited (field %q)", f)
+			}
+		}
+		if len(m.AddedEdges()) > 0 || len(m.RemovedEdges()) > 0 || len(m.ClearedEdges()) > 0 {
+			return nil, fmt.Errorf("invoice: issued invoices cannot be edited")
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// Policy of the Invoice.
+func (Invoice) Policy() ent.Policy {
+	return tenant.Policy()
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceLine holds the schema definition for the InvoiceLine entity.
+type InvoiceLine struct {
+	ent.Schema
+}
+
+// Mixin of the InvoiceLine.
+func (InvoiceLine) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the InvoiceLine.
+func (InvoiceLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("labour", "part", "fee").
+			Immutable(),
+		field.String("description").
+			NotEmpty().
+			Immutable(),
+		field.Int64("quantity").
+			GoType(money.Quantity(0)).
+			StructTag(`json:"quantity"`).
+			Immutable(),
+		field.Int64("unit_price").
+			GoType(money.Amount(0)).
+			StructTag(`json:"unit_price"`).
+			Immutable(),
+		field.Int64("amount").
+			GoType(money.Amount(0)).
+			StructTag(`json:"amount"`).
+			Immutable(),
+	}
+}
+
+// Edges of the InvoiceLine.
+func (InvoiceLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("invoice", Invoice.Type).
+			Ref("lines").
+			Unique().
+			Required(),
+	}
+}
+
+// Hooks of the InvoiceLine.
+func (InvoiceLine) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne),
+	}
+}
+
+// Policy of the InvoiceLine.
+func (InvoiceLine) Policy() ent.Policy {
+	return tenant.Policy()
+}
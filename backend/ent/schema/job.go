@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// Job holds the schema definition for the Job entity.
+// A job is a unit of background work run by the worker pool. Workers of any
+// backend instance claim pending jobs that are due by taking a lease on them.
+type Job struct {
+	ent.Schema
+}
+
+// Mixin of the Job.
+func (Job) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		// kind selects the handler that runs the job, e.g. "email.send".
+		field.String("kind").
+			NotEmpty().
+			Immutable(),
+		// payload is the JSON encoded argument of the handler.
+		field.Text("payload").
+			Default("{}").
+			Immutable(),
+		// status is dead once the job failed max_attempts times.
+		field.Enum("status").
+			Values("pending", "running", "succeeded", "dead", "cancelled").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0).
+			StructTag(`json:"attempts"`),
+		field.Int("max_attempts").
+			Default(5).
+			Positive(),
+		// run_at is when the job is due; failed jobs are retried later.
+		field.Time("run_at").
+			Default(time.Now),
+		// locked_by is the worker running the job, which holds the lease
+		// until locked_until. A job whose lease expired is claimed again.
+		field.String("locked_by").
+			Optional(),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+		field.Text("last_error").
+			Optional(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_at"),
+	}
+}
+
+// Policy of the Job.
+func (Job) Policy() ent.Policy {
+	return tenant.Policy()
+}
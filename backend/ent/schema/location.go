@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/locations"
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// Location holds the schema definition for the Location entity.
+// Locations form a tree: campuses have buildings, buildings have floors and
+// floors have rooms. Equipment is kept at one location of the tree.
+type Location struct {
+	ent.Schema
+}
+
+// Mixin of the Location.
+func (Location) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Location.
+func (Location) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		// kind is the level of the location in the tree. The parent of a
+		// location is of the level above; campuses have no parent.
+		field.Enum("kind").
+			Values("campus", "building", "floor", "room"),
+	}
+}
+
+// Edges of the Location.
+func (Location) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", Location.Type).
+			From("parent").
+			Unique(),
+		edge.To("equipment", Equipment.Type),
+	}
+}
+
+// Indexes of the Location.
+func (Location) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Edges("parent", "organization").
+			Unique(),
+	}
+}
+
+// Hooks of the Location.
+func (Location) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(locations.ValidateParent, ent.OpCreate|ent.OpUpdateOne),
+	}
+}
+
+// Policy of the Location.
+func (Location) Policy() ent.Policy {
+	return tenant.Policy()
+}
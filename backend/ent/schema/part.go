@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// Part holds the schema definition for the Part entity.
+type Part struct {
+	ent.Schema
+}
+
+// Mixin of the Part.
+func (Part) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the Part.
+func (Part) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("sku").NotEmpty().Unique(),
+		field.String("name").NotEmpty(),
+		field.String("unit").Default("pcs"),
+		field.Int64("unit_price").
+			GoType(money.Amount(0)).
+			StructTag(`json:"unit_price"`),
+	}
+}
+
+// Edges of the Part.
+func (Part) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("stock_levels", StockLevel.Type),
+		edge.To("movements", StockMovement.Type),
+	}
+}
+
+// Policy of the Part.
+func (Part) Policy() ent.Policy {
+	return tenant.Policy()
+}
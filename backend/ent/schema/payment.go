@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/darksford123x/app/billing"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// Payment holds the schema definition for the Payment entity.
+// A payment of kind refund gives money back to the customer. Payments are
+// never edited; a mistaken payment is reversed with a refund.
+type Payment struct {
+	ent.Schema
+}
+
+// Mixin of the Payment.
+func (Payment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Payment.
+func (Payment) Fields() []ent.Field {
+	return []ent.Field{
+		// number is the receipt number, e.g. RC-2020-000001.
+		field.String("number").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Enum("kind").
+			Values("payment", "refund").
+			Default("payment").
+			Immutable(),
+		field.Enum("method").
+			Values("cash", "bank_transfer", "promptpay").
+			Immutable(),
+		field.Int64("amount").
+			GoType(money.Amount(0)).
+			StructTag(`json:"amount"`).
+			Immutable(),
+		// reference is the bank or PromptPay transaction reference.
+		field.String("reference").
+			Optional().
+			Immutable(),
+		field.Time("paid_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Payment.
+func (Payment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("invoice", Invoice.Type).
+			Ref("payments").
+			Required().
+			Unique(),
+	}
+}
+
+// Hooks of the Payment.
+func (Payment) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(billing.SettlePayment, ent.OpCreate),
+		hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne),
+	}
+}
+
+// Policy of the Payment.
+func (Payment) Policy() ent.Policy {
+	return tenant.Policy()
+}
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// RepairSlip holds the schema definition for the RepairSlip entity.
+type RepairSlip struct {
+	ent.Schema
+}
+
+// Mixin of the RepairSlip.
+func (RepairSlip) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the RepairSlip.
+func (RepairSlip) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("symptom").NotEmpty(),
+		field.String("category").NotEmpty(),
+		field.Enum("status").
+			Values("received", "in_progress", "waiting_parts", "ready", "closed").
+			Default("received"),
+		// under_warranty and route are set when the slip is created for
+		// equipment under warranty; such repairs are claimed from the
+		// warranty provider instead of being done in-house.
+		field.Bool("under_warranty").
+			Default(false).
+			StructTag(`json:"under_warranty"`),
+		field.Enum("route").
+			Values("in_house", "warranty_claim").
+			Default("in_house"),
+		field.Enum("priority").
+			Values("low", "normal", "high", "urgent").
+			Default("normal"),
+		// The due dates are computed from the SLA policy of the priority
+		// and category. The slip is responded to when work on it starts,
+		// and resolved when it is ready or closed.
+		field.Time("response_due").
+			Optional().
+			Nillable(),
+		field.Time("resolution_due").
+			Optional().
+			Nillable(),
+		field.Time("responded_at").
+			Optional().
+			Nillable(),
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+		field.Bool("response_breached").
+			Default(false).
+			StructTag(`json:"response_breached"`),
+		field.Bool("resolution_breached").
+			Default(false).
+			StructTag(`json:"resolution_breached"`),
+		field.Time("escalated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the RepairSlip.
+func (RepairSlip) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("reporter", User.Type).
+			Ref("reported_slips").
+			Unique(),
+		edge.From("assignee", User.Type).
+			Ref("assigned_slips").
+			Unique(),
+		edge.To("parts_used", StockMovement.Type),
+		edge.To("invoice", Invoice.Type).
+			Unique(),
+		edge.From("equipment", Equipment.Type).
+			Ref("repair_slips").
+			Unique(),
+		edge.To("attachments", Attachment.Type),
+		edge.To("comments", Comment.Type),
+		edge.To("activities", Activity.Type),
+	}
+}
+
+// Policy of the RepairSlip.
+func (RepairSlip) Policy() ent.Policy {
+	return tenant.Policy()
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+)
+
+// SLAPolicy holds the schema definition for the SLAPolicy entity.
+// A policy sets the response and resolution targets of the repair slips of
+// a priority and category, in business minutes. A policy with an empty
+// category applies to the categories without a policy of their own.
+type SLAPolicy struct {
+	ent.Schema
+}
+
+// Mixin of the SLAPolicy.
+func (SLAPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the SLAPolicy.
+func (SLAPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("priority").
+			Values("low", "normal", "high", "urgent"),
+		field.String("category").
+			Default("").
+			StructTag(`json:"category"`),
+		field.Int("response_minutes").Positive(),
+		field.Int("resolution_minutes").Positive(),
+	}
+}
+
+// Indexes of the SLAPolicy.
+func (SLAPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("priority", "category").
+			Edges("organization").
+			Unique(),
+	}
+}
+
+// Policy of the SLAPolicy.
+func (SLAPolicy) Policy() ent.Policy {
+	return tenant.Policy()
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+)
+
+// StockLevel holds the schema definition for the StockLevel entity.
+// It keeps the on-hand quantity of a part at one location, and is only
+// changed together with a StockMovement that explains the change.
+type StockLevel struct {
+	ent.Schema
+}
+
+// Mixin of the StockLevel.
+func (StockLevel) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the StockLevel.
+func (StockLevel) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("location").NotEmpty(),
+		field.Int("on_hand").
+			Min(0).
+			Default(0).
+			StructTag(`json:"on_hand"`),
+	}
+}
+
+// Edges of the StockLevel.
+func (StockLevel) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("part", Part.Type).
+			Ref("stock_levels").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the StockLevel.
+func (StockLevel) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("location").
+			Edges("part").
+			Unique(),
+	}
+}
+
+// Policy of the StockLevel.
+func (StockLevel) Policy() ent.Policy {
+	return tenant.Policy()
+}
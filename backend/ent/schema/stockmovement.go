@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// StockMovement holds the schema definition for the StockMovement entity.
+// Movements form the ledger of the inventory: quantity is positive for stock
+// coming in and negative for stock going out.
+type StockMovement struct {
+	ent.Schema
+}
+
+// Mixin of the StockMovement.
+func (StockMovement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the StockMovement.
+func (StockMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("receipt", "consumption", "adjustment").
+			Immutable(),
+		field.Int("quantity").Immutable(),
+		field.String("location").NotEmpty().Immutable(),
+		// reason_code explains an adjustment. It is empty for receipts
+		// and consumptions.
+		field.Enum("reason_code").
+			Values("count_correction", "damaged", "lost", "found", "returned_to_vendor", "other").
+			Optional().
+			Immutable(),
+		field.String("note").Optional().Immutable(),
+	}
+}
+
+// Edges of the StockMovement.
+func (StockMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("part", Part.Type).
+			Ref("movements").
+			Unique().
+			Required(),
+		edge.From("repair_slip", RepairSlip.Type).
+			Ref("parts_used").
+			Unique(),
+	}
+}
+
+// Policy of the StockMovement.
+func (StockMovement) Policy() ent.Policy {
+	return tenant.Policy()
+}
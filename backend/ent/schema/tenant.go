@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// TenantMixin adds the organization owning the entities of a schema. The
+// edge is set from the tenant of the context when an entity is created, and
+// the tenant policy of the schema keeps the entities of other organizations
+// out of its queries and mutations.
+type TenantMixin struct {
+	mixin.Schema
+}
+
+// Edges of the TenantMixin.
+func (TenantMixin) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The edge is not required, so that the policy can set it after
+		// the builder checked the required edges; rows written before
+		// there were tenants are given one by tenant.Adopt.
+		edge.To("organization", Organization.Type).
+			Unique().
+			StorageKey(edge.Column("organization_id")),
+	}
+}
@@ -1,7 +1,9 @@
 package schema
 
 import (
+	"github.com/darksford123x/app/tenant"
 	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
 	"github.com/facebookincubator/ent/schema/field"
 )
 
@@ -10,15 +12,68 @@ type User struct {
 	ent.Schema
 }
 
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("age").Positive(),
 		field.String("name").NotEmpty(),
+		field.Enum("role").
+			Values("staff", "technician", "supervisor", "admin").
+			Default("staff"),
+		// skill is the repair category a technician handles, e.g. "computer"
+		// or "projector". It is matched against RepairSlip.category when
+		// slips are assigned automatically.
+		field.String("skill").Optional(),
+		// department the user belongs to, used to report outstanding
+		// balances per department.
+		field.String("department").Optional(),
+		// email receives the notifications about the user's repair slips.
+		field.String("email").
+			Optional().
+			Unique(),
+		// locale is the language of the notifications sent to the user.
+		field.Enum("locale").
+			Values("th", "en").
+			Default("th"),
+		// password_hash is the bcrypt hash of the password the user signs
+		// in with; users without one cannot sign in.
+		field.String("password_hash").
+			Optional().
+			Sensitive(),
+		// disabled users cannot sign in, and the tokens issued to them are
+		// refused.
+		field.Bool("disabled").
+			Default(false),
+		// assignment_version changes whenever a slip is assigned to the
+		// user. The balancer claims the version it read the workload at, so
+		// that concurrent assignments never pile onto one technician.
+		field.Int("assignment_version").
+			NonNegative().
+			Default(0).
+			StructTag(`json:"-"`),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("reported_slips", RepairSlip.Type),
+		edge.To("assigned_slips", RepairSlip.Type),
+		edge.To("invoices", Invoice.Type),
+		edge.To("attachments", Attachment.Type),
+		edge.From("mentioned_in", Comment.Type).
+			Ref("mentions"),
+	}
+}
+
+// Policy of the User.
+func (User) Policy() ent.Policy {
+	return tenant.Policy()
 }
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// WarrantyTerm holds the schema definition for the WarrantyTerm entity.
+// A term is a warranty offered by a vendor, e.g. a three year on-site
+// warranty, and is shared by all the equipment bought with it.
+type WarrantyTerm struct {
+	ent.Schema
+}
+
+// Mixin of the WarrantyTerm.
+func (WarrantyTerm) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the WarrantyTerm.
+func (WarrantyTerm) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("provider").NotEmpty(),
+		// months is how long the warranty lasts from its start.
+		field.Int("months").Positive(),
+		field.Enum("coverage").
+			Values("parts", "labour", "parts_and_labour").
+			Default("parts_and_labour"),
+		// claim_contact tells staff how to file a claim with the provider.
+		field.String("claim_contact").Optional(),
+		field.Text("conditions").Optional(),
+	}
+}
+
+// Edges of the WarrantyTerm.
+func (WarrantyTerm) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("equipment", Equipment.Type),
+	}
+}
+
+// Policy of the WarrantyTerm.
+func (WarrantyTerm) Policy() ent.Policy {
+	return tenant.Policy()
+}
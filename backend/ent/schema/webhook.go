@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// A webhook subscribes an external URL to the changes of users and repair
+// slips.
+type Webhook struct {
+	ent.Schema
+}
+
+// Mixin of the Webhook.
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").
+			Validate(validateURL),
+		// secret signs the deliveries; it is only shown when the webhook
+		// is created.
+		field.String("secret").
+			NotEmpty().
+			Sensitive(),
+		// events are the event types delivered, e.g. "repairslip.updated"
+		// or "user.*"; all events are delivered when it is empty.
+		field.Strings("events").
+			Optional(),
+		// active is cleared when the endpoint failed too many times in a
+		// row.
+		field.Bool("active").
+			Default(true).
+			StructTag(`json:"active"`),
+		// failures counts the failed delivery attempts since the last
+		// successful one.
+		field.Int("failures").
+			Default(0).
+			StructTag(`json:"failures"`),
+		field.Time("disabled_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// validateURL accepts absolute http and https URLs.
+func validateURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("webhook url %q is not an http or https URL", s)
+	}
+	return nil
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+// Policy of the Webhook.
+func (Webhook) Policy() ent.Policy {
+	return tenant.Policy()
+}
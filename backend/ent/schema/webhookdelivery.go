@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/mixin"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// A delivery is one event sent to a webhook, with the outcome of its last
+// attempt.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+		TenantMixin{},
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		// event is the type of the event delivered, e.g. "user.created".
+		field.String("event").
+			NotEmpty().
+			Immutable(),
+		// payload is the JSON body posted to the webhook.
+		field.Text("payload").
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0).
+			StructTag(`json:"attempts"`),
+		// response_code and response_body are what the endpoint answered
+		// to the last attempt; error is set when it did not answer or
+		// answered with an error status.
+		field.Int("response_code").
+			Optional(),
+		field.Text("response_body").
+			Optional(),
+		field.Text("error").
+			Optional(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Required().
+			Unique(),
+	}
+}
+
+// Policy of the WebhookDelivery.
+func (WebhookDelivery) Policy() ent.Policy {
+	return tenant.Policy()
+}
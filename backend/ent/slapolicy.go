@@ -0,0 +1,165 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// SLAPolicy is the model entity for the SLAPolicy schema.
+type SLAPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority slapolicy.Priority `json:"priority,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category"`
+	// ResponseMinutes holds the value of the "response_minutes" field.
+	ResponseMinutes int `json:"response_minutes,omitempty"`
+	// ResolutionMinutes holds the value of the "resolution_minutes" field.
+	ResolutionMinutes int `json:"resolution_minutes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SLAPolicyQuery when eager-loading is set.
+	Edges           SLAPolicyEdges `json:"edges"`
+	organization_id *int
+}
+
+// SLAPolicyEdges holds the relations/edges for other nodes in the graph.
+type SLAPolicyEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SLAPolicyEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SLAPolicy) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // priority
+		&sql.NullString{}, // category
+		&sql.NullInt64{},  // response_minutes
+		&sql.NullInt64{},  // resolution_minutes
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*SLAPolicy) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SLAPolicy fields.
+func (sp *SLAPolicy) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(slapolicy.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	sp.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field priority", values[0])
+	} else if value.Valid {
+		sp.Priority = slapolicy.Priority(value.String)
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field category", values[1])
+	} else if value.Valid {
+		sp.Category = value.String
+	}
+	if value, ok := values[2].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field response_minutes", values[2])
+	} else if value.Valid {
+		sp.ResponseMinutes = int(value.Int64)
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field resolution_minutes", values[3])
+	} else if value.Valid {
+		sp.ResolutionMinutes = int(value.Int64)
+	}
+	values = values[4:]
+	if len(values) == len(slapolicy.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			sp.organization_id = new(int)
+			*sp.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the SLAPolicy.
+func (sp *SLAPolicy) QueryOrganization() *OrganizationQuery {
+	return (&SLAPolicyClient{config: sp.config}).QueryOrganization(sp)
+}
+
+// Update returns a builder for updating this SLAPolicy.
+// Note that, you need to call SLAPolicy.Unwrap() before calling this method, if this SLAPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sp *SLAPolicy) Update() *SLAPolicyUpdateOne {
+	return (&SLAPolicyClient{config: sp.config}).UpdateOne(sp)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (sp *SLAPolicy) Unwrap() *SLAPolicy {
+	tx, ok := sp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SLAPolicy is not a transactional entity")
+	}
+	sp.config.driver = tx.drv
+	return sp
+}
+
+// String implements the fmt.Stringer.
+func (sp *SLAPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("SLAPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v", sp.ID))
+	builder.WriteString(", priority=")
+	builder.WriteString(fmt.Sprintf("%v", sp.Priority))
+	builder.WriteString(", category=")
+	builder.WriteString(sp.Category)
+	builder.WriteString(", response_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", sp.ResponseMinutes))
+	builder.WriteString(", resolution_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", sp.ResolutionMinutes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SLAPolicies is a parsable slice of SLAPolicy.
+type SLAPolicies []*SLAPolicy
+
+func (sp SLAPolicies) config(cfg config) {
+	for _i := range sp {
+		sp[_i].config = cfg
+	}
+}
@@ -0,0 +1,92 @@
+// Code generated by entc, DO NOT EDIT.
+
+package slapolicy
+
+import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the slapolicy type in the database.
+	Label = "sla_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldResponseMinutes holds the string denoting the response_minutes field in the database.
+	FieldResponseMinutes = "response_minutes"
+	// FieldResolutionMinutes holds the string denoting the resolution_minutes field in the database.
+	FieldResolutionMinutes = "resolution_minutes"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+
+	// Table holds the table name of the slapolicy in the database.
+	Table = "sla_policies"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "sla_policies"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+)
+
+// Columns holds all SQL columns for slapolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldPriority,
+	FieldCategory,
+	FieldResponseMinutes,
+	FieldResolutionMinutes,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the SLAPolicy type.
+var ForeignKeys = []string{
+	"organization_id",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCategory holds the default value on creation for the category field.
+	DefaultCategory string
+	// ResponseMinutesValidator is a validator for the "response_minutes" field. It is called by the builders before save.
+	ResponseMinutesValidator func(int) error
+	// ResolutionMinutesValidator is a validator for the "resolution_minutes" field. It is called by the builders before save.
+	ResolutionMinutesValidator func(int) error
+)
+
+// Priority defines the type for the priority enum field.
+type Priority string
+
+// Priority values.
+const (
+	PriorityLow    Priority = "low"
+	PriorityNormal Priority = "normal"
+	PriorityHigh   Priority = "high"
+	PriorityUrgent Priority = "urgent"
+)
+
+func (pr Priority) String() string {
+	return string(pr)
+}
+
+// PriorityValidator is a validator for the "pr" field enum values. It is called by the builders before save.
+func PriorityValidator(pr Priority) error {
+	switch pr {
+	case PriorityLow, PriorityNormal, PriorityHigh, PriorityUrgent:
+		return nil
+	default:
+		return fmt.Errorf("slapolicy: invalid enum value for priority field: %q", pr)
+	}
+}
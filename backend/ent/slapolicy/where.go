@@ -0,0 +1,484 @@
+// Code generated by entc, DO NOT EDIT.
+
+package slapolicy
+
+import (
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// ResponseMinutes applies equality check predicate on the "response_minutes" field. It's identical to ResponseMinutesEQ.
+func ResponseMinutes(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponseMinutes), v))
+	})
+}
+
+// ResolutionMinutes applies equality check predicate on the "resolution_minutes" field. It's identical to ResolutionMinutesEQ.
+func ResolutionMinutes(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolutionMinutes), v))
+	})
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.SLAPolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPriority), v...))
+	})
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.SLAPolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPriority), v...))
+	})
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.SLAPolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.SLAPolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCategory), v))
+	})
+}
+
+// ResponseMinutesEQ applies the EQ predicate on the "response_minutes" field.
+func ResponseMinutesEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponseMinutes), v))
+	})
+}
+
+// ResponseMinutesNEQ applies the NEQ predicate on the "response_minutes" field.
+func ResponseMinutesNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResponseMinutes), v))
+	})
+}
+
+// ResponseMinutesIn applies the In predicate on the "response_minutes" field.
+func ResponseMinutesIn(vs ...int) predicate.SLAPolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResponseMinutes), v...))
+	})
+}
+
+// ResponseMinutesNotIn applies the NotIn predicate on the "response_minutes" field.
+func ResponseMinutesNotIn(vs ...int) predicate.SLAPolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResponseMinutes), v...))
+	})
+}
+
+// ResponseMinutesGT applies the GT predicate on the "response_minutes" field.
+func ResponseMinutesGT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldResponseMinutes), v))
+	})
+}
+
+// ResponseMinutesGTE applies the GTE predicate on the "response_minutes" field.
+func ResponseMinutesGTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldResponseMinutes), v))
+	})
+}
+
+// ResponseMinutesLT applies the LT predicate on the "response_minutes" field.
+func ResponseMinutesLT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldResponseMinutes), v))
+	})
+}
+
+// ResponseMinutesLTE applies the LTE predicate on the "response_minutes" field.
+func ResponseMinutesLTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldResponseMinutes), v))
+	})
+}
+
+// ResolutionMinutesEQ applies the EQ predicate on the "resolution_minutes" field.
+func ResolutionMinutesEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolutionMinutes), v))
+	})
+}
+
+// ResolutionMinutesNEQ applies the NEQ predicate on the "resolution_minutes" field.
+func ResolutionMinutesNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResolutionMinutes), v))
+	})
+}
+
+// ResolutionMinutesIn applies the In predicate on the "resolution_minutes" field.
+func ResolutionMinutesIn(vs ...int) predicate.SLAPolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResolutionMinutes), v...))
+	})
+}
+
+// ResolutionMinutesNotIn applies the NotIn predicate on the "resolution_minutes" field.
+func ResolutionMinutesNotIn(vs ...int) predicate.SLAPolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResolutionMinutes), v...))
+	})
+}
+
+// ResolutionMinutesGT applies the GT predicate on the "resolution_minutes" field.
+func ResolutionMinutesGT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldResolutionMinutes), v))
+	})
+}
+
+// ResolutionMinutesGTE applies the GTE predicate on the "resolution_minutes" field.
+func ResolutionMinutesGTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldResolutionMinutes), v))
+	})
+}
+
+// ResolutionMinutesLT applies the LT predicate on the "resolution_minutes" field.
+func ResolutionMinutesLT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldResolutionMinutes), v))
+	})
+}
+
+// ResolutionMinutesLTE applies the LTE predicate on the "resolution_minutes" field.
+func ResolutionMinutesLTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldResolutionMinutes), v))
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.SLAPolicy) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.SLAPolicy) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SLAPolicy) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,221 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// SLAPolicyCreate is the builder for creating a SLAPolicy entity.
+type SLAPolicyCreate struct {
+	config
+	mutation *SLAPolicyMutation
+	hooks    []Hook
+}
+
+// SetPriority sets the priority field.
+func (spc *SLAPolicyCreate) SetPriority(s slapolicy.Priority) *SLAPolicyCreate {
+	spc.mutation.SetPriority(s)
+	return spc
+}
+
+// SetCategory sets the category field.
+func (spc *SLAPolicyCreate) SetCategory(s string) *SLAPolicyCreate {
+	spc.mutation.SetCategory(s)
+	return spc
+}
+
+// SetNillableCategory sets the category field if the given value is not nil.
+func (spc *SLAPolicyCreate) SetNillableCategory(s *string) *SLAPolicyCreate {
+	if s != nil {
+		spc.SetCategory(*s)
+	}
+	return spc
+}
+
+// SetResponseMinutes sets the response_minutes field.
+func (spc *SLAPolicyCreate) SetResponseMinutes(i int) *SLAPolicyCreate {
+	spc.mutation.SetResponseMinutes(i)
+	return spc
+}
+
+// SetResolutionMinutes sets the resolution_minutes field.
+func (spc *SLAPolicyCreate) SetResolutionMinutes(i int) *SLAPolicyCreate {
+	spc.mutation.SetResolutionMinutes(i)
+	return spc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (spc *SLAPolicyCreate) SetOrganizationID(id int) *SLAPolicyCreate {
+	spc.mutation.SetOrganizationID(id)
+	return spc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (spc *SLAPolicyCreate) SetNillableOrganizationID(id *int) *SLAPolicyCreate {
+	if id != nil {
+		spc = spc.SetOrganizationID(*id)
+	}
+	return spc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (spc *SLAPolicyCreate) SetOrganization(o *Organization) *SLAPolicyCreate {
+	return spc.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the SLAPolicyMutation object of the builder.
+func (spc *SLAPolicyCreate) Mutation() *SLAPolicyMutation {
+	return spc.mutation
+}
+
+// Save creates the SLAPolicy in the database.
+func (spc *SLAPolicyCreate) Save(ctx context.Context) (*SLAPolicy, error) {
+	if _, ok := spc.mutation.Priority(); !ok {
+		return nil, &ValidationError{Name: "priority", err: errors.New("ent: missing required field \"priority\"")}
+	}
+	if v, ok := spc.mutation.Priority(); ok {
+		if err := slapolicy.PriorityValidator(v); err != nil {
+			return nil, &ValidationError{Name: "priority", err: fmt.Errorf("ent: validator failed for field \"priority\": %w", err)}
+		}
+	}
+	if _, ok := spc.mutation.Category(); !ok {
+		v := slapolicy.DefaultCategory
+		spc.mutation.SetCategory(v)
+	}
+	if _, ok := spc.mutation.ResponseMinutes(); !ok {
+		return nil, &ValidationError{Name: "response_minutes", err: errors.New("ent: missing required field \"response_minutes\"")}
+	}
+	if v, ok := spc.mutation.ResponseMinutes(); ok {
+		if err := slapolicy.ResponseMinutesValidator(v); err != nil {
+			return nil, &ValidationError{Name: "response_minutes", err: fmt.Errorf("ent: validator failed for field \"response_minutes\": %w", err)}
+		}
+	}
+	if _, ok := spc.mutation.ResolutionMinutes(); !ok {
+		return nil, &ValidationError{Name: "resolution_minutes", err: errors.New("ent: missing required field \"resolution_minutes\"")}
+	}
+	if v, ok := spc.mutation.ResolutionMinutes(); ok {
+		if err := slapolicy.ResolutionMinutesValidator(v); err != nil {
+			return nil, &ValidationError{Name: "resolution_minutes", err: fmt.Errorf("ent: validator failed for field \"resolution_minutes\": %w", err)}
+		}
+	}
+	var (
+		err  error
+		node *SLAPolicy
+	)
+	if len(spc.hooks) == 0 {
+		node, err = spc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SLAPolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			spc.mutation = mutation
+			node, err = spc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(spc.hooks) - 1; i >= 0; i-- {
+			mut = spc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, spc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (spc *SLAPolicyCreate) SaveX(ctx context.Context) *SLAPolicy {
+	v, err := spc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (spc *SLAPolicyCreate) sqlSave(ctx context.Context) (*SLAPolicy, error) {
+	sp, _spec := spc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, spc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	sp.ID = int(id)
+	return sp, nil
+}
+
+func (spc *SLAPolicyCreate) createSpec() (*SLAPolicy, *sqlgraph.CreateSpec) {
+	var (
+		sp    = &SLAPolicy{config: spc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: slapolicy.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: slapolicy.FieldID,
+			},
+		}
+	)
+	if value, ok := spc.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: slapolicy.FieldPriority,
+		})
+		sp.Priority = value
+	}
+	if value, ok := spc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: slapolicy.FieldCategory,
+		})
+		sp.Category = value
+	}
+	if value, ok := spc.mutation.ResponseMinutes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResponseMinutes,
+		})
+		sp.ResponseMinutes = value
+	}
+	if value, ok := spc.mutation.ResolutionMinutes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResolutionMinutes,
+		})
+		sp.ResolutionMinutes = value
+	}
+	if nodes := spc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   slapolicy.OrganizationTable,
+			Columns: []string{slapolicy.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return sp, _spec
+}
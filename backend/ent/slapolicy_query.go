@@ -0,0 +1,943 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// SLAPolicyQuery is the builder for querying SLAPolicy entities.
+type SLAPolicyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.SLAPolicy
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (spq *SLAPolicyQuery) Where(ps ...predicate.SLAPolicy) *SLAPolicyQuery {
+	spq.predicates = append(spq.predicates, ps...)
+	return spq
+}
+
+// Limit adds a limit step to the query.
+func (spq *SLAPolicyQuery) Limit(limit int) *SLAPolicyQuery {
+	spq.limit = &limit
+	return spq
+}
+
+// Offset adds an offset step to the query.
+func (spq *SLAPolicyQuery) Offset(offset int) *SLAPolicyQuery {
+	spq.offset = &offset
+	return spq
+}
+
+// Order adds an order step to the query.
+func (spq *SLAPolicyQuery) Order(o ...OrderFunc) *SLAPolicyQuery {
+	spq.order = append(spq.order, o...)
+	return spq
+}
+
+// QueryOrganization chains the current query on the organization edge.
+func (spq *SLAPolicyQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: spq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slapolicy.Table, slapolicy.FieldID, spq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, slapolicy.OrganizationTable, slapolicy.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SLAPolicy entity in the query. Returns *NotFoundError when no slapolicy was found.
+func (spq *SLAPolicyQuery) First(ctx context.Context) (*SLAPolicy, error) {
+	sps, err := spq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(sps) == 0 {
+		return nil, &NotFoundError{slapolicy.Label}
+	}
+	return sps[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (spq *SLAPolicyQuery) FirstX(ctx context.Context) *SLAPolicy {
+	sp, err := spq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return sp
+}
+
+// FirstID returns the first SLAPolicy id in the query. Returns *NotFoundError when no id was found.
+func (spq *SLAPolicyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = spq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{slapolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (spq *SLAPolicyQuery) FirstXID(ctx context.Context) int {
+	id, err := spq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only SLAPolicy entity in the query, returns an error if not exactly one entity was returned.
+func (spq *SLAPolicyQuery) Only(ctx context.Context) (*SLAPolicy, error) {
+	sps, err := spq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(sps) {
+	case 1:
+		return sps[0], nil
+	case 0:
+		return nil, &NotFoundError{slapolicy.Label}
+	default:
+		return nil, &NotSingularError{slapolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (spq *SLAPolicyQuery) OnlyX(ctx context.Context) *SLAPolicy {
+	sp, err := spq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return sp
+}
+
+// OnlyID returns the only SLAPolicy id in the query, returns an error if not exactly one id was returned.
+func (spq *SLAPolicyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = spq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{slapolicy.Label}
+	default:
+		err = &NotSingularError{slapolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (spq *SLAPolicyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := spq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SLAPolicies.
+func (spq *SLAPolicyQuery) All(ctx context.Context) ([]*SLAPolicy, error) {
+	if err := spq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return spq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (spq *SLAPolicyQuery) AllX(ctx context.Context) []*SLAPolicy {
+	sps, err := spq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return sps
+}
+
+// IDs executes the query and returns a list of SLAPolicy ids.
+func (spq *SLAPolicyQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := spq.Select(slapolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (spq *SLAPolicyQuery) IDsX(ctx context.Context) []int {
+	ids, err := spq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (spq *SLAPolicyQuery) Count(ctx context.Context) (int, error) {
+	if err := spq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return spq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (spq *SLAPolicyQuery) CountX(ctx context.Context) int {
+	count, err := spq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (spq *SLAPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := spq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return spq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (spq *SLAPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := spq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (spq *SLAPolicyQuery) Clone() *SLAPolicyQuery {
+	return &SLAPolicyQuery{
+		config:     spq.config,
+		limit:      spq.limit,
+		offset:     spq.offset,
+		order:      append([]OrderFunc{}, spq.order...),
+		unique:     append([]string{}, spq.unique...),
+		predicates: append([]predicate.SLAPolicy{}, spq.predicates...),
+		// clone intermediate query.
+		sql:  spq.sql.Clone(),
+		path: spq.path,
+	}
+}
+
+//	WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (spq *SLAPolicyQuery) WithOrganization(opts ...func(*OrganizationQuery)) *SLAPolicyQuery {
+	query := &OrganizationQuery{config: spq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withOrganization = query
+	return spq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Priority slapolicy.Priority `json:"priority,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SLAPolicy.Query().
+//		GroupBy(slapolicy.FieldPriority).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (spq *SLAPolicyQuery) GroupBy(field string, fields ...string) *SLAPolicyGroupBy {
+	group := &SLAPolicyGroupBy{config: spq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return spq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Priority slapolicy.Priority `json:"priority,omitempty"`
+//	}
+//
+//	client.SLAPolicy.Query().
+//		Select(slapolicy.FieldPriority).
+//		Scan(ctx, &v)
+//
+func (spq *SLAPolicyQuery) Select(field string, fields ...string) *SLAPolicySelect {
+	selector := &SLAPolicySelect{config: spq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return spq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (spq *SLAPolicyQuery) prepareQuery(ctx context.Context) error {
+	if spq.path != nil {
+		prev, err := spq.path(ctx)
+		if err != nil {
+			return err
+		}
+		spq.sql = prev
+	}
+	if err := slapolicy.Policy.EvalQuery(ctx, spq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (spq *SLAPolicyQuery) sqlAll(ctx context.Context) ([]*SLAPolicy, error) {
+	var (
+		nodes       = []*SLAPolicy{}
+		withFKs     = spq.withFKs
+		_spec       = spq.querySpec()
+		loadedTypes = [1]bool{
+			spq.withOrganization != nil,
+		}
+	)
+	if spq.withOrganization != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, slapolicy.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &SLAPolicy{config: spq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, spq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := spq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*SLAPolicy)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (spq *SLAPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := spq.querySpec()
+	return sqlgraph.CountNodes(ctx, spq.driver, _spec)
+}
+
+func (spq *SLAPolicyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := spq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (spq *SLAPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   slapolicy.Table,
+			Columns: slapolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: slapolicy.FieldID,
+			},
+		},
+		From:   spq.sql,
+		Unique: true,
+	}
+	if ps := spq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := spq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := spq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := spq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (spq *SLAPolicyQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(spq.driver.Dialect())
+	t1 := builder.Table(slapolicy.Table)
+	selector := builder.Select(t1.Columns(slapolicy.Columns...)...).From(t1)
+	if spq.sql != nil {
+		selector = spq.sql
+		selector.Select(selector.Columns(slapolicy.Columns...)...)
+	}
+	for _, p := range spq.predicates {
+		p(selector)
+	}
+	for _, p := range spq.order {
+		p(selector)
+	}
+	if offset := spq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := spq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SLAPolicyGroupBy is the builder for group-by SLAPolicy entities.
+type SLAPolicyGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (spgb *SLAPolicyGroupBy) Aggregate(fns ...AggregateFunc) *SLAPolicyGroupBy {
+	spgb.fns = append(spgb.fns, fns...)
+	return spgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (spgb *SLAPolicyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := spgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	spgb.sql = query
+	return spgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (spgb *SLAPolicyGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := spgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (spgb *SLAPolicyGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(spgb.fields) > 1 {
+		return nil, errors.New("ent: SLAPolicyGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := spgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (spgb *SLAPolicyGroupBy) StringsX(ctx context.Context) []string {
+	v, err := spgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (spgb *SLAPolicyGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = spgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{slapolicy.Label}
+	default:
+		err = fmt.Errorf("ent: SLAPolicyGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (spgb *SLAPolicyGroupBy) StringX(ctx context.Context) string {
+	v, err := spgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (spgb *SLAPolicyGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(spgb.fields) > 1 {
+		return nil, errors.New("ent: SLAPolicyGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := spgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (spgb *SLAPolicyGroupBy) IntsX(ctx context.Context) []int {
+	v, err := spgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (spgb *SLAPolicyGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = spgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{slapolicy.Label}
+	default:
+		err = fmt.Errorf("ent: SLAPolicyGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (spgb *SLAPolicyGroupBy) IntX(ctx context.Context) int {
+	v, err := spgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (spgb *SLAPolicyGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(spgb.fields) > 1 {
+		return nil, errors.New("ent: SLAPolicyGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := spgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (spgb *SLAPolicyGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := spgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (spgb *SLAPolicyGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = spgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{slapolicy.Label}
+	default:
+		err = fmt.Errorf("ent: SLAPolicyGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (spgb *SLAPolicyGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := spgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (spgb *SLAPolicyGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(spgb.fields) > 1 {
+		return nil, errors.New("ent: SLAPolicyGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := spgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (spgb *SLAPolicyGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := spgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (spgb *SLAPolicyGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = spgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{slapolicy.Label}
+	default:
+		err = fmt.Errorf("ent: SLAPolicyGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (spgb *SLAPolicyGroupBy) BoolX(ctx context.Context) bool {
+	v, err := spgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (spgb *SLAPolicyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := spgb.sqlQuery().Query()
+	if err := spgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (spgb *SLAPolicyGroupBy) sqlQuery() *sql.Selector {
+	selector := spgb.sql
+	columns := make([]string, 0, len(spgb.fields)+len(spgb.fns))
+	columns = append(columns, spgb.fields...)
+	for _, fn := range spgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(spgb.fields...)
+}
+
+// SLAPolicySelect is the builder for select fields of SLAPolicy entities.
+type SLAPolicySelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (sps *SLAPolicySelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := sps.path(ctx)
+	if err != nil {
+		return err
+	}
+	sps.sql = query
+	return sps.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sps *SLAPolicySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := sps.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (sps *SLAPolicySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(sps.fields) > 1 {
+		return nil, errors.New("ent: SLAPolicySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := sps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sps *SLAPolicySelect) StringsX(ctx context.Context) []string {
+	v, err := sps.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (sps *SLAPolicySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sps.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{slapolicy.Label}
+	default:
+		err = fmt.Errorf("ent: SLAPolicySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sps *SLAPolicySelect) StringX(ctx context.Context) string {
+	v, err := sps.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (sps *SLAPolicySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(sps.fields) > 1 {
+		return nil, errors.New("ent: SLAPolicySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := sps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sps *SLAPolicySelect) IntsX(ctx context.Context) []int {
+	v, err := sps.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (sps *SLAPolicySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sps.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{slapolicy.Label}
+	default:
+		err = fmt.Errorf("ent: SLAPolicySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sps *SLAPolicySelect) IntX(ctx context.Context) int {
+	v, err := sps.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (sps *SLAPolicySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sps.fields) > 1 {
+		return nil, errors.New("ent: SLAPolicySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := sps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sps *SLAPolicySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := sps.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (sps *SLAPolicySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sps.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{slapolicy.Label}
+	default:
+		err = fmt.Errorf("ent: SLAPolicySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sps *SLAPolicySelect) Float64X(ctx context.Context) float64 {
+	v, err := sps.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (sps *SLAPolicySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(sps.fields) > 1 {
+		return nil, errors.New("ent: SLAPolicySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := sps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sps *SLAPolicySelect) BoolsX(ctx context.Context) []bool {
+	v, err := sps.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (sps *SLAPolicySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sps.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{slapolicy.Label}
+	default:
+		err = fmt.Errorf("ent: SLAPolicySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sps *SLAPolicySelect) BoolX(ctx context.Context) bool {
+	v, err := sps.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sps *SLAPolicySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sps.sqlQuery().Query()
+	if err := sps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sps *SLAPolicySelect) sqlQuery() sql.Querier {
+	selector := sps.sql
+	selector.Select(selector.Columns(sps.fields...)...)
+	return selector
+}
@@ -0,0 +1,535 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// SLAPolicyUpdate is the builder for updating SLAPolicy entities.
+type SLAPolicyUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *SLAPolicyMutation
+	predicates []predicate.SLAPolicy
+}
+
+// Where adds a new predicate for the builder.
+func (spu *SLAPolicyUpdate) Where(ps ...predicate.SLAPolicy) *SLAPolicyUpdate {
+	spu.predicates = append(spu.predicates, ps...)
+	return spu
+}
+
+// SetPriority sets the priority field.
+func (spu *SLAPolicyUpdate) SetPriority(s slapolicy.Priority) *SLAPolicyUpdate {
+	spu.mutation.SetPriority(s)
+	return spu
+}
+
+// SetCategory sets the category field.
+func (spu *SLAPolicyUpdate) SetCategory(s string) *SLAPolicyUpdate {
+	spu.mutation.SetCategory(s)
+	return spu
+}
+
+// SetNillableCategory sets the category field if the given value is not nil.
+func (spu *SLAPolicyUpdate) SetNillableCategory(s *string) *SLAPolicyUpdate {
+	if s != nil {
+		spu.SetCategory(*s)
+	}
+	return spu
+}
+
+// SetResponseMinutes sets the response_minutes field.
+func (spu *SLAPolicyUpdate) SetResponseMinutes(i int) *SLAPolicyUpdate {
+	spu.mutation.ResetResponseMinutes()
+	spu.mutation.SetResponseMinutes(i)
+	return spu
+}
+
+// AddResponseMinutes adds i to response_minutes.
+func (spu *SLAPolicyUpdate) AddResponseMinutes(i int) *SLAPolicyUpdate {
+	spu.mutation.AddResponseMinutes(i)
+	return spu
+}
+
+// SetResolutionMinutes sets the resolution_minutes field.
+func (spu *SLAPolicyUpdate) SetResolutionMinutes(i int) *SLAPolicyUpdate {
+	spu.mutation.ResetResolutionMinutes()
+	spu.mutation.SetResolutionMinutes(i)
+	return spu
+}
+
+// AddResolutionMinutes adds i to resolution_minutes.
+func (spu *SLAPolicyUpdate) AddResolutionMinutes(i int) *SLAPolicyUpdate {
+	spu.mutation.AddResolutionMinutes(i)
+	return spu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (spu *SLAPolicyUpdate) SetOrganizationID(id int) *SLAPolicyUpdate {
+	spu.mutation.SetOrganizationID(id)
+	return spu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (spu *SLAPolicyUpdate) SetNillableOrganizationID(id *int) *SLAPolicyUpdate {
+	if id != nil {
+		spu = spu.SetOrganizationID(*id)
+	}
+	return spu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (spu *SLAPolicyUpdate) SetOrganization(o *Organization) *SLAPolicyUpdate {
+	return spu.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the SLAPolicyMutation object of the builder.
+func (spu *SLAPolicyUpdate) Mutation() *SLAPolicyMutation {
+	return spu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (spu *SLAPolicyUpdate) ClearOrganization() *SLAPolicyUpdate {
+	spu.mutation.ClearOrganization()
+	return spu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (spu *SLAPolicyUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := spu.mutation.Priority(); ok {
+		if err := slapolicy.PriorityValidator(v); err != nil {
+			return 0, &ValidationError{Name: "priority", err: fmt.Errorf("ent: validator failed for field \"priority\": %w", err)}
+		}
+	}
+	if v, ok := spu.mutation.ResponseMinutes(); ok {
+		if err := slapolicy.ResponseMinutesValidator(v); err != nil {
+			return 0, &ValidationError{Name: "response_minutes", err: fmt.Errorf("ent: validator failed for field \"response_minutes\": %w", err)}
+		}
+	}
+	if v, ok := spu.mutation.ResolutionMinutes(); ok {
+		if err := slapolicy.ResolutionMinutesValidator(v); err != nil {
+			return 0, &ValidationError{Name: "resolution_minutes", err: fmt.Errorf("ent: validator failed for field \"resolution_minutes\": %w", err)}
+		}
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(spu.hooks) == 0 {
+		affected, err = spu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SLAPolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			spu.mutation = mutation
+			affected, err = spu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(spu.hooks) - 1; i >= 0; i-- {
+			mut = spu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, spu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spu *SLAPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := spu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (spu *SLAPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := spu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spu *SLAPolicyUpdate) ExecX(ctx context.Context) {
+	if err := spu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (spu *SLAPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   slapolicy.Table,
+			Columns: slapolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: slapolicy.FieldID,
+			},
+		},
+	}
+	if ps := spu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := spu.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: slapolicy.FieldPriority,
+		})
+	}
+	if value, ok := spu.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: slapolicy.FieldCategory,
+		})
+	}
+	if value, ok := spu.mutation.ResponseMinutes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResponseMinutes,
+		})
+	}
+	if value, ok := spu.mutation.AddedResponseMinutes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResponseMinutes,
+		})
+	}
+	if value, ok := spu.mutation.ResolutionMinutes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResolutionMinutes,
+		})
+	}
+	if value, ok := spu.mutation.AddedResolutionMinutes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResolutionMinutes,
+		})
+	}
+	if spu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   slapolicy.OrganizationTable,
+			Columns: []string{slapolicy.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   slapolicy.OrganizationTable,
+			Columns: []string{slapolicy.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, spu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slapolicy.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SLAPolicyUpdateOne is the builder for updating a single SLAPolicy entity.
+type SLAPolicyUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *SLAPolicyMutation
+}
+
+// SetPriority sets the priority field.
+func (spuo *SLAPolicyUpdateOne) SetPriority(s slapolicy.Priority) *SLAPolicyUpdateOne {
+	spuo.mutation.SetPriority(s)
+	return spuo
+}
+
+// SetCategory sets the category field.
+func (spuo *SLAPolicyUpdateOne) SetCategory(s string) *SLAPolicyUpdateOne {
+	spuo.mutation.SetCategory(s)
+	return spuo
+}
+
+// SetNillableCategory sets the category field if the given value is not nil.
+func (spuo *SLAPolicyUpdateOne) SetNillableCategory(s *string) *SLAPolicyUpdateOne {
+	if s != nil {
+		spuo.SetCategory(*s)
+	}
+	return spuo
+}
+
+// SetResponseMinutes sets the response_minutes field.
+func (spuo *SLAPolicyUpdateOne) SetResponseMinutes(i int) *SLAPolicyUpdateOne {
+	spuo.mutation.ResetResponseMinutes()
+	spuo.mutation.SetResponseMinutes(i)
+	return spuo
+}
+
+// AddResponseMinutes adds i to response_minutes.
+func (spuo *SLAPolicyUpdateOne) AddResponseMinutes(i int) *SLAPolicyUpdateOne {
+	spuo.mutation.AddResponseMinutes(i)
+	return spuo
+}
+
+// SetResolutionMinutes sets the resolution_minutes field.
+func (spuo *SLAPolicyUpdateOne) SetResolutionMinutes(i int) *SLAPolicyUpdateOne {
+	spuo.mutation.ResetResolutionMinutes()
+	spuo.mutation.SetResolutionMinutes(i)
+	return spuo
+}
+
+// AddResolutionMinutes adds i to resolution_minutes.
+func (spuo *SLAPolicyUpdateOne) AddResolutionMinutes(i int) *SLAPolicyUpdateOne {
+	spuo.mutation.AddResolutionMinutes(i)
+	return spuo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (spuo *SLAPolicyUpdateOne) SetOrganizationID(id int) *SLAPolicyUpdateOne {
+	spuo.mutation.SetOrganizationID(id)
+	return spuo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (spuo *SLAPolicyUpdateOne) SetNillableOrganizationID(id *int) *SLAPolicyUpdateOne {
+	if id != nil {
+		spuo = spuo.SetOrganizationID(*id)
+	}
+	return spuo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (spuo *SLAPolicyUpdateOne) SetOrganization(o *Organization) *SLAPolicyUpdateOne {
+	return spuo.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the SLAPolicyMutation object of the builder.
+func (spuo *SLAPolicyUpdateOne) Mutation() *SLAPolicyMutation {
+	return spuo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (spuo *SLAPolicyUpdateOne) ClearOrganization() *SLAPolicyUpdateOne {
+	spuo.mutation.ClearOrganization()
+	return spuo
+}
+
+// Save executes the query and returns the updated entity.
+func (spuo *SLAPolicyUpdateOne) Save(ctx context.Context) (*SLAPolicy, error) {
+	if v, ok := spuo.mutation.Priority(); ok {
+		if err := slapolicy.PriorityValidator(v); err != nil {
+			return nil, &ValidationError{Name: "priority", err: fmt.Errorf("ent: validator failed for field \"priority\": %w", err)}
+		}
+	}
+	if v, ok := spuo.mutation.ResponseMinutes(); ok {
+		if err := slapolicy.ResponseMinutesValidator(v); err != nil {
+			return nil, &ValidationError{Name: "response_minutes", err: fmt.Errorf("ent: validator failed for field \"response_minutes\": %w", err)}
+		}
+	}
+	if v, ok := spuo.mutation.ResolutionMinutes(); ok {
+		if err := slapolicy.ResolutionMinutesValidator(v); err != nil {
+			return nil, &ValidationError{Name: "resolution_minutes", err: fmt.Errorf("ent: validator failed for field \"resolution_minutes\": %w", err)}
+		}
+	}
+
+	var (
+		err  error
+		node *SLAPolicy
+	)
+	if len(spuo.hooks) == 0 {
+		node, err = spuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SLAPolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			spuo.mutation = mutation
+			node, err = spuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(spuo.hooks) - 1; i >= 0; i-- {
+			mut = spuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, spuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spuo *SLAPolicyUpdateOne) SaveX(ctx context.Context) *SLAPolicy {
+	sp, err := spuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return sp
+}
+
+// Exec executes the query on the entity.
+func (spuo *SLAPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := spuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spuo *SLAPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := spuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (spuo *SLAPolicyUpdateOne) sqlSave(ctx context.Context) (sp *SLAPolicy, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   slapolicy.Table,
+			Columns: slapolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: slapolicy.FieldID,
+			},
+		},
+	}
+	id, ok := spuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing SLAPolicy.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := spuo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: slapolicy.FieldPriority,
+		})
+	}
+	if value, ok := spuo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: slapolicy.FieldCategory,
+		})
+	}
+	if value, ok := spuo.mutation.ResponseMinutes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResponseMinutes,
+		})
+	}
+	if value, ok := spuo.mutation.AddedResponseMinutes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResponseMinutes,
+		})
+	}
+	if value, ok := spuo.mutation.ResolutionMinutes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResolutionMinutes,
+		})
+	}
+	if value, ok := spuo.mutation.AddedResolutionMinutes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: slapolicy.FieldResolutionMinutes,
+		})
+	}
+	if spuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   slapolicy.OrganizationTable,
+			Columns: []string{slapolicy.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   slapolicy.OrganizationTable,
+			Columns: []string{slapolicy.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	sp = &SLAPolicy{config: spuo.config}
+	_spec.Assign = sp.assignValues
+	_spec.ScanValues = sp.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, spuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slapolicy.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return sp, nil
+}
@@ -0,0 +1,175 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// StockLevel is the model entity for the StockLevel schema.
+type StockLevel struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// OnHand holds the value of the "on_hand" field.
+	OnHand int `json:"on_hand"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StockLevelQuery when eager-loading is set.
+	Edges             StockLevelEdges `json:"edges"`
+	part_stock_levels *int
+	organization_id   *int
+}
+
+// StockLevelEdges holds the relations/edges for other nodes in the graph.
+type StockLevelEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Part holds the value of the part edge.
+	Part *Part
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockLevelEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// PartOrErr returns the Part value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockLevelEdges) PartOrErr() (*Part, error) {
+	if e.loadedTypes[1] {
+		if e.Part == nil {
+			// The edge part was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: part.Label}
+		}
+		return e.Part, nil
+	}
+	return nil, &NotLoadedError{edge: "part"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockLevel) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // location
+		&sql.NullInt64{},  // on_hand
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*StockLevel) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // part_stock_levels
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockLevel fields.
+func (sl *StockLevel) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(stocklevel.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	sl.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field location", values[0])
+	} else if value.Valid {
+		sl.Location = value.String
+	}
+	if value, ok := values[1].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field on_hand", values[1])
+	} else if value.Valid {
+		sl.OnHand = int(value.Int64)
+	}
+	values = values[2:]
+	if len(values) == len(stocklevel.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field part_stock_levels", value)
+		} else if value.Valid {
+			sl.part_stock_levels = new(int)
+			*sl.part_stock_levels = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			sl.organization_id = new(int)
+			*sl.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the StockLevel.
+func (sl *StockLevel) QueryOrganization() *OrganizationQuery {
+	return (&StockLevelClient{config: sl.config}).QueryOrganization(sl)
+}
+
+// QueryPart queries the part edge of the StockLevel.
+func (sl *StockLevel) QueryPart() *PartQuery {
+	return (&StockLevelClient{config: sl.config}).QueryPart(sl)
+}
+
+// Update returns a builder for updating this StockLevel.
+// Note that, you need to call StockLevel.Unwrap() before calling this method, if this StockLevel
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sl *StockLevel) Update() *StockLevelUpdateOne {
+	return (&StockLevelClient{config: sl.config}).UpdateOne(sl)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (sl *StockLevel) Unwrap() *StockLevel {
+	tx, ok := sl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockLevel is not a transactional entity")
+	}
+	sl.config.driver = tx.drv
+	return sl
+}
+
+// String implements the fmt.Stringer.
+func (sl *StockLevel) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockLevel(")
+	builder.WriteString(fmt.Sprintf("id=%v", sl.ID))
+	builder.WriteString(", location=")
+	builder.WriteString(sl.Location)
+	builder.WriteString(", on_hand=")
+	builder.WriteString(fmt.Sprintf("%v", sl.OnHand))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockLevels is a parsable slice of StockLevel.
+type StockLevels []*StockLevel
+
+func (sl StockLevels) config(cfg config) {
+	for _i := range sl {
+		sl[_i].config = cfg
+	}
+}
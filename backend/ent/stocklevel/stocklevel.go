@@ -0,0 +1,69 @@
+// Code generated by entc, DO NOT EDIT.
+
+package stocklevel
+
+import (
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the stocklevel type in the database.
+	Label = "stock_level"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldOnHand holds the string denoting the on_hand field in the database.
+	FieldOnHand = "on_hand"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgePart holds the string denoting the part edge name in mutations.
+	EdgePart = "part"
+
+	// Table holds the table name of the stocklevel in the database.
+	Table = "stock_levels"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "stock_levels"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// PartTable is the table the holds the part relation/edge.
+	PartTable = "stock_levels"
+	// PartInverseTable is the table name for the Part entity.
+	// It exists in this package in order to avoid circular dependency with the "part" package.
+	PartInverseTable = "parts"
+	// PartColumn is the table column denoting the part relation/edge.
+	PartColumn = "part_stock_levels"
+)
+
+// Columns holds all SQL columns for stocklevel fields.
+var Columns = []string{
+	FieldID,
+	FieldLocation,
+	FieldOnHand,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the StockLevel type.
+var ForeignKeys = []string{
+	"part_stock_levels",
+	"organization_id",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	LocationValidator func(string) error
+	// DefaultOnHand holds the default value on creation for the on_hand field.
+	DefaultOnHand int
+	// OnHandValidator is a validator for the "on_hand" field. It is called by the builders before save.
+	OnHandValidator func(int) error
+)
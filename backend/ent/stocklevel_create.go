@@ -0,0 +1,216 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// StockLevelCreate is the builder for creating a StockLevel entity.
+type StockLevelCreate struct {
+	config
+	mutation *StockLevelMutation
+	hooks    []Hook
+}
+
+// SetLocation sets the location field.
+func (slc *StockLevelCreate) SetLocation(s string) *StockLevelCreate {
+	slc.mutation.SetLocation(s)
+	return slc
+}
+
+// SetOnHand sets the on_hand field.
+func (slc *StockLevelCreate) SetOnHand(i int) *StockLevelCreate {
+	slc.mutation.SetOnHand(i)
+	return slc
+}
+
+// SetNillableOnHand sets the on_hand field if the given value is not nil.
+func (slc *StockLevelCreate) SetNillableOnHand(i *int) *StockLevelCreate {
+	if i != nil {
+		slc.SetOnHand(*i)
+	}
+	return slc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (slc *StockLevelCreate) SetOrganizationID(id int) *StockLevelCreate {
+	slc.mutation.SetOrganizationID(id)
+	return slc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (slc *StockLevelCreate) SetNillableOrganizationID(id *int) *StockLevelCreate {
+	if id != nil {
+		slc = slc.SetOrganizationID(*id)
+	}
+	return slc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (slc *StockLevelCreate) SetOrganization(o *Organization) *StockLevelCreate {
+	return slc.SetOrganizationID(o.ID)
+}
+
+// SetPartID sets the part edge to Part by id.
+func (slc *StockLevelCreate) SetPartID(id int) *StockLevelCreate {
+	slc.mutation.SetPartID(id)
+	return slc
+}
+
+// SetPart sets the part edge to Part.
+func (slc *StockLevelCreate) SetPart(p *Part) *StockLevelCreate {
+	return slc.SetPartID(p.ID)
+}
+
+// Mutation returns the StockLevelMutation object of the builder.
+func (slc *StockLevelCreate) Mutation() *StockLevelMutation {
+	return slc.mutation
+}
+
+// Save creates the StockLevel in the database.
+func (slc *StockLevelCreate) Save(ctx context.Context) (*StockLevel, error) {
+	if _, ok := slc.mutation.Location(); !ok {
+		return nil, &ValidationError{Name: "location", err: errors.New("ent: missing required field \"location\"")}
+	}
+	if v, ok := slc.mutation.Location(); ok {
+		if err := stocklevel.LocationValidator(v); err != nil {
+			return nil, &ValidationError{Name: "location", err: fmt.Errorf("ent: validator failed for field \"location\": %w", err)}
+		}
+	}
+	if _, ok := slc.mutation.OnHand(); !ok {
+		v := stocklevel.DefaultOnHand
+		slc.mutation.SetOnHand(v)
+	}
+	if v, ok := slc.mutation.OnHand(); ok {
+		if err := stocklevel.OnHandValidator(v); err != nil {
+			return nil, &ValidationError{Name: "on_hand", err: fmt.Errorf("ent: validator failed for field \"on_hand\": %w", err)}
+		}
+	}
+	if _, ok := slc.mutation.PartID(); !ok {
+		return nil, &ValidationError{Name: "part", err: errors.New("ent: missing required edge \"part\"")}
+	}
+	var (
+		err  error
+		node *StockLevel
+	)
+	if len(slc.hooks) == 0 {
+		node, err = slc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockLevelMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			slc.mutation = mutation
+			node, err = slc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(slc.hooks) - 1; i >= 0; i-- {
+			mut = slc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, slc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (slc *StockLevelCreate) SaveX(ctx context.Context) *StockLevel {
+	v, err := slc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (slc *StockLevelCreate) sqlSave(ctx context.Context) (*StockLevel, error) {
+	sl, _spec := slc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, slc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	sl.ID = int(id)
+	return sl, nil
+}
+
+func (slc *StockLevelCreate) createSpec() (*StockLevel, *sqlgraph.CreateSpec) {
+	var (
+		sl    = &StockLevel{config: slc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: stocklevel.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stocklevel.FieldID,
+			},
+		}
+	)
+	if value, ok := slc.mutation.Location(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stocklevel.FieldLocation,
+		})
+		sl.Location = value
+	}
+	if value, ok := slc.mutation.OnHand(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stocklevel.FieldOnHand,
+		})
+		sl.OnHand = value
+	}
+	if nodes := slc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stocklevel.OrganizationTable,
+			Columns: []string{stocklevel.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := slc.mutation.PartIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.PartTable,
+			Columns: []string{stocklevel.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return sl, _spec
+}
@@ -0,0 +1,999 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// StockLevelQuery is the builder for querying StockLevel entities.
+type StockLevelQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.StockLevel
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withPart         *PartQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (slq *StockLevelQuery) Where(ps ...predicate.StockLevel) *StockLevelQuery {
+	slq.predicates = append(slq.predicates, ps...)
+	return slq
+}
+
+// Limit adds a limit step to the query.
+func (slq *StockLevelQuery) Limit(limit int) *StockLevelQuery {
+	slq.limit = &limit
+	return slq
+}
+
+// Offset adds an offset step to the query.
+func (slq *StockLevelQuery) Offset(offset int) *StockLevelQuery {
+	slq.offset = &offset
+	return slq
+}
+
+// Order adds an order step to the query.
+func (slq *StockLevelQuery) Order(o ...OrderFunc) *StockLevelQuery {
+	slq.order = append(slq.order, o...)
+	return slq
+}
+
+// QueryOrganization chains the current query on the organization edge.
+func (slq *StockLevelQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: slq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := slq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stocklevel.Table, stocklevel.FieldID, slq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, stocklevel.OrganizationTable, stocklevel.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(slq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPart chains the current query on the part edge.
+func (slq *StockLevelQuery) QueryPart() *PartQuery {
+	query := &PartQuery{config: slq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := slq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stocklevel.Table, stocklevel.FieldID, slq.sqlQuery()),
+			sqlgraph.To(part.Table, part.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stocklevel.PartTable, stocklevel.PartColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(slq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StockLevel entity in the query. Returns *NotFoundError when no stocklevel was found.
+func (slq *StockLevelQuery) First(ctx context.Context) (*StockLevel, error) {
+	sls, err := slq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(sls) == 0 {
+		return nil, &NotFoundError{stocklevel.Label}
+	}
+	return sls[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (slq *StockLevelQuery) FirstX(ctx context.Context) *StockLevel {
+	sl, err := slq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return sl
+}
+
+// FirstID returns the first StockLevel id in the query. Returns *NotFoundError when no id was found.
+func (slq *StockLevelQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = slq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{stocklevel.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (slq *StockLevelQuery) FirstXID(ctx context.Context) int {
+	id, err := slq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only StockLevel entity in the query, returns an error if not exactly one entity was returned.
+func (slq *StockLevelQuery) Only(ctx context.Context) (*StockLevel, error) {
+	sls, err := slq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(sls) {
+	case 1:
+		return sls[0], nil
+	case 0:
+		return nil, &NotFoundError{stocklevel.Label}
+	default:
+		return nil, &NotSingularError{stocklevel.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (slq *StockLevelQuery) OnlyX(ctx context.Context) *StockLevel {
+	sl, err := slq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return sl
+}
+
+// OnlyID returns the only StockLevel id in the query, returns an error if not exactly one id was returned.
+func (slq *StockLevelQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = slq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{stocklevel.Label}
+	default:
+		err = &NotSingularError{stocklevel.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (slq *StockLevelQuery) OnlyIDX(ctx context.Context) int {
+	id, err := slq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StockLevels.
+func (slq *StockLevelQuery) All(ctx context.Context) ([]*StockLevel, error) {
+	if err := slq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return slq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (slq *StockLevelQuery) AllX(ctx context.Context) []*StockLevel {
+	sls, err := slq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return sls
+}
+
+// IDs executes the query and returns a list of StockLevel ids.
+func (slq *StockLevelQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := slq.Select(stocklevel.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (slq *StockLevelQuery) IDsX(ctx context.Context) []int {
+	ids, err := slq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (slq *StockLevelQuery) Count(ctx context.Context) (int, error) {
+	if err := slq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return slq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (slq *StockLevelQuery) CountX(ctx context.Context) int {
+	count, err := slq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (slq *StockLevelQuery) Exist(ctx context.Context) (bool, error) {
+	if err := slq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return slq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (slq *StockLevelQuery) ExistX(ctx context.Context) bool {
+	exist, err := slq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (slq *StockLevelQuery) Clone() *StockLevelQuery {
+	return &StockLevelQuery{
+		config:     slq.config,
+		limit:      slq.limit,
+		offset:     slq.offset,
+		order:      append([]OrderFunc{}, slq.order...),
+		unique:     append([]string{}, slq.unique...),
+		predicates: append([]predicate.StockLevel{}, slq.predicates...),
+		// clone intermediate query.
+		sql:  slq.sql.Clone(),
+		path: slq.path,
+	}
+}
+
+//  WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (slq *StockLevelQuery) WithOrganization(opts ...func(*OrganizationQuery)) *StockLevelQuery {
+	query := &OrganizationQuery{config: slq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	slq.withOrganization = query
+	return slq
+}
+
+//  WithPart tells the query-builder to eager-loads the nodes that are connected to
+// the "part" edge. The optional arguments used to configure the query builder of the edge.
+func (slq *StockLevelQuery) WithPart(opts ...func(*PartQuery)) *StockLevelQuery {
+	query := &PartQuery{config: slq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	slq.withPart = query
+	return slq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Location string `json:"location,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StockLevel.Query().
+//		GroupBy(stocklevel.FieldLocation).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (slq *StockLevelQuery) GroupBy(field string, fields ...string) *StockLevelGroupBy {
+	group := &StockLevelGroupBy{config: slq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := slq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return slq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Location string `json:"location,omitempty"`
+//	}
+//
+//	client.StockLevel.Query().
+//		Select(stocklevel.FieldLocation).
+//		Scan(ctx, &v)
+//
+func (slq *StockLevelQuery) Select(field string, fields ...string) *StockLevelSelect {
+	selector := &StockLevelSelect{config: slq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := slq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return slq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (slq *StockLevelQuery) prepareQuery(ctx context.Context) error {
+	if slq.path != nil {
+		prev, err := slq.path(ctx)
+		if err != nil {
+			return err
+		}
+		slq.sql = prev
+	}
+	if err := stocklevel.Policy.EvalQuery(ctx, slq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (slq *StockLevelQuery) sqlAll(ctx context.Context) ([]*StockLevel, error) {
+	var (
+		nodes       = []*StockLevel{}
+		withFKs     = slq.withFKs
+		_spec       = slq.querySpec()
+		loadedTypes = [2]bool{
+			slq.withOrganization != nil,
+			slq.withPart != nil,
+		}
+	)
+	if slq.withOrganization != nil || slq.withPart != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, stocklevel.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &StockLevel{config: slq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, slq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := slq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*StockLevel)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	if query := slq.withPart; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*StockLevel)
+		for i := range nodes {
+			if fk := nodes[i].part_stock_levels; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(part.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "part_stock_levels" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Part = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (slq *StockLevelQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := slq.querySpec()
+	return sqlgraph.CountNodes(ctx, slq.driver, _spec)
+}
+
+func (slq *StockLevelQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := slq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (slq *StockLevelQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stocklevel.Table,
+			Columns: stocklevel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stocklevel.FieldID,
+			},
+		},
+		From:   slq.sql,
+		Unique: true,
+	}
+	if ps := slq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := slq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := slq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := slq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (slq *StockLevelQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(slq.driver.Dialect())
+	t1 := builder.Table(stocklevel.Table)
+	selector := builder.Select(t1.Columns(stocklevel.Columns...)...).From(t1)
+	if slq.sql != nil {
+		selector = slq.sql
+		selector.Select(selector.Columns(stocklevel.Columns...)...)
+	}
+	for _, p := range slq.predicates {
+		p(selector)
+	}
+	for _, p := range slq.order {
+		p(selector)
+	}
+	if offset := slq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := slq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StockLevelGroupBy is the builder for group-by StockLevel entities.
+type StockLevelGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (slgb *StockLevelGroupBy) Aggregate(fns ...AggregateFunc) *StockLevelGroupBy {
+	slgb.fns = append(slgb.fns, fns...)
+	return slgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (slgb *StockLevelGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := slgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	slgb.sql = query
+	return slgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (slgb *StockLevelGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := slgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (slgb *StockLevelGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(slgb.fields) > 1 {
+		return nil, errors.New("ent: StockLevelGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := slgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (slgb *StockLevelGroupBy) StringsX(ctx context.Context) []string {
+	v, err := slgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (slgb *StockLevelGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = slgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stocklevel.Label}
+	default:
+		err = fmt.Errorf("ent: StockLevelGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (slgb *StockLevelGroupBy) StringX(ctx context.Context) string {
+	v, err := slgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (slgb *StockLevelGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(slgb.fields) > 1 {
+		return nil, errors.New("ent: StockLevelGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := slgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (slgb *StockLevelGroupBy) IntsX(ctx context.Context) []int {
+	v, err := slgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (slgb *StockLevelGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = slgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stocklevel.Label}
+	default:
+		err = fmt.Errorf("ent: StockLevelGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (slgb *StockLevelGroupBy) IntX(ctx context.Context) int {
+	v, err := slgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (slgb *StockLevelGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(slgb.fields) > 1 {
+		return nil, errors.New("ent: StockLevelGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := slgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (slgb *StockLevelGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := slgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (slgb *StockLevelGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = slgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stocklevel.Label}
+	default:
+		err = fmt.Errorf("ent: StockLevelGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (slgb *StockLevelGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := slgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (slgb *StockLevelGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(slgb.fields) > 1 {
+		return nil, errors.New("ent: StockLevelGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := slgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (slgb *StockLevelGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := slgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (slgb *StockLevelGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = slgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stocklevel.Label}
+	default:
+		err = fmt.Errorf("ent: StockLevelGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (slgb *StockLevelGroupBy) BoolX(ctx context.Context) bool {
+	v, err := slgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (slgb *StockLevelGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := slgb.sqlQuery().Query()
+	if err := slgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (slgb *StockLevelGroupBy) sqlQuery() *sql.Selector {
+	selector := slgb.sql
+	columns := make([]string, 0, len(slgb.fields)+len(slgb.fns))
+	columns = append(columns, slgb.fields...)
+	for _, fn := range slgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(slgb.fields...)
+}
+
+// StockLevelSelect is the builder for select fields of StockLevel entities.
+type StockLevelSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (sls *StockLevelSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := sls.path(ctx)
+	if err != nil {
+		return err
+	}
+	sls.sql = query
+	return sls.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sls *StockLevelSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := sls.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (sls *StockLevelSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(sls.fields) > 1 {
+		return nil, errors.New("ent: StockLevelSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := sls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sls *StockLevelSelect) StringsX(ctx context.Context) []string {
+	v, err := sls.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (sls *StockLevelSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sls.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stocklevel.Label}
+	default:
+		err = fmt.Errorf("ent: StockLevelSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sls *StockLevelSelect) StringX(ctx context.Context) string {
+	v, err := sls.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (sls *StockLevelSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(sls.fields) > 1 {
+		return nil, errors.New("ent: StockLevelSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := sls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sls *StockLevelSelect) IntsX(ctx context.Context) []int {
+	v, err := sls.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (sls *StockLevelSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sls.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stocklevel.Label}
+	default:
+		err = fmt.Errorf("ent: StockLevelSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sls *StockLevelSelect) IntX(ctx context.Context) int {
+	v, err := sls.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (sls *StockLevelSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sls.fields) > 1 {
+		return nil, errors.New("ent: StockLevelSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := sls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sls *StockLevelSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := sls.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (sls *StockLevelSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sls.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stocklevel.Label}
+	default:
+		err = fmt.Errorf("ent: StockLevelSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sls *StockLevelSelect) Float64X(ctx context.Context) float64 {
+	v, err := sls.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (sls *StockLevelSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(sls.fields) > 1 {
+		return nil, errors.New("ent: StockLevelSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := sls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sls *StockLevelSelect) BoolsX(ctx context.Context) []bool {
+	v, err := sls.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (sls *StockLevelSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sls.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stocklevel.Label}
+	default:
+		err = fmt.Errorf("ent: StockLevelSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sls *StockLevelSelect) BoolX(ctx context.Context) bool {
+	v, err := sls.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sls *StockLevelSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sls.sqlQuery().Query()
+	if err := sls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sls *StockLevelSelect) sqlQuery() sql.Querier {
+	selector := sls.sql
+	selector.Select(selector.Columns(sls.fields...)...)
+	return selector
+}
@@ -0,0 +1,557 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// StockLevelUpdate is the builder for updating StockLevel entities.
+type StockLevelUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *StockLevelMutation
+	predicates []predicate.StockLevel
+}
+
+// Where adds a new predicate for the builder.
+func (slu *StockLevelUpdate) Where(ps ...predicate.StockLevel) *StockLevelUpdate {
+	slu.predicates = append(slu.predicates, ps...)
+	return slu
+}
+
+// SetLocation sets the location field.
+func (slu *StockLevelUpdate) SetLocation(s string) *StockLevelUpdate {
+	slu.mutation.SetLocation(s)
+	return slu
+}
+
+// SetOnHand sets the on_hand field.
+func (slu *StockLevelUpdate) SetOnHand(i int) *StockLevelUpdate {
+	slu.mutation.ResetOnHand()
+	slu.mutation.SetOnHand(i)
+	return slu
+}
+
+// SetNillableOnHand sets the on_hand field if the given value is not nil.
+func (slu *StockLevelUpdate) SetNillableOnHand(i *int) *StockLevelUpdate {
+	if i != nil {
+		slu.SetOnHand(*i)
+	}
+	return slu
+}
+
+// AddOnHand adds i to on_hand.
+func (slu *StockLevelUpdate) AddOnHand(i int) *StockLevelUpdate {
+	slu.mutation.AddOnHand(i)
+	return slu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (slu *StockLevelUpdate) SetOrganizationID(id int) *StockLevelUpdate {
+	slu.mutation.SetOrganizationID(id)
+	return slu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (slu *StockLevelUpdate) SetNillableOrganizationID(id *int) *StockLevelUpdate {
+	if id != nil {
+		slu = slu.SetOrganizationID(*id)
+	}
+	return slu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (slu *StockLevelUpdate) SetOrganization(o *Organization) *StockLevelUpdate {
+	return slu.SetOrganizationID(o.ID)
+}
+
+// SetPartID sets the part edge to Part by id.
+func (slu *StockLevelUpdate) SetPartID(id int) *StockLevelUpdate {
+	slu.mutation.SetPartID(id)
+	return slu
+}
+
+// SetPart sets the part edge to Part.
+func (slu *StockLevelUpdate) SetPart(p *Part) *StockLevelUpdate {
+	return slu.SetPartID(p.ID)
+}
+
+// Mutation returns the StockLevelMutation object of the builder.
+func (slu *StockLevelUpdate) Mutation() *StockLevelMutation {
+	return slu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (slu *StockLevelUpdate) ClearOrganization() *StockLevelUpdate {
+	slu.mutation.ClearOrganization()
+	return slu
+}
+
+// ClearPart clears the part edge to Part.
+func (slu *StockLevelUpdate) ClearPart() *StockLevelUpdate {
+	slu.mutation.ClearPart()
+	return slu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (slu *StockLevelUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := slu.mutation.Location(); ok {
+		if err := stocklevel.LocationValidator(v); err != nil {
+			return 0, &ValidationError{Name: "location", err: fmt.Errorf("ent: validator failed for field \"location\": %w", err)}
+		}
+	}
+	if v, ok := slu.mutation.OnHand(); ok {
+		if err := stocklevel.OnHandValidator(v); err != nil {
+			return 0, &ValidationError{Name: "on_hand", err: fmt.Errorf("ent: validator failed for field \"on_hand\": %w", err)}
+		}
+	}
+
+	if _, ok := slu.mutation.PartID(); slu.mutation.PartCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"part\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(slu.hooks) == 0 {
+		affected, err = slu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockLevelMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			slu.mutation = mutation
+			affected, err = slu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(slu.hooks) - 1; i >= 0; i-- {
+			mut = slu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, slu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (slu *StockLevelUpdate) SaveX(ctx context.Context) int {
+	affected, err := slu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (slu *StockLevelUpdate) Exec(ctx context.Context) error {
+	_, err := slu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slu *StockLevelUpdate) ExecX(ctx context.Context) {
+	if err := slu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (slu *StockLevelUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stocklevel.Table,
+			Columns: stocklevel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stocklevel.FieldID,
+			},
+		},
+	}
+	if ps := slu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := slu.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stocklevel.FieldLocation,
+		})
+	}
+	if value, ok := slu.mutation.OnHand(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stocklevel.FieldOnHand,
+		})
+	}
+	if value, ok := slu.mutation.AddedOnHand(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stocklevel.FieldOnHand,
+		})
+	}
+	if slu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stocklevel.OrganizationTable,
+			Columns: []string{stocklevel.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := slu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stocklevel.OrganizationTable,
+			Columns: []string{stocklevel.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if slu.mutation.PartCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.PartTable,
+			Columns: []string{stocklevel.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := slu.mutation.PartIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.PartTable,
+			Columns: []string{stocklevel.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, slu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stocklevel.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// StockLevelUpdateOne is the builder for updating a single StockLevel entity.
+type StockLevelUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *StockLevelMutation
+}
+
+// SetLocation sets the location field.
+func (sluo *StockLevelUpdateOne) SetLocation(s string) *StockLevelUpdateOne {
+	sluo.mutation.SetLocation(s)
+	return sluo
+}
+
+// SetOnHand sets the on_hand field.
+func (sluo *StockLevelUpdateOne) SetOnHand(i int) *StockLevelUpdateOne {
+	sluo.mutation.ResetOnHand()
+	sluo.mutation.SetOnHand(i)
+	return sluo
+}
+
+// SetNillableOnHand sets the on_hand field if the given value is not nil.
+func (sluo *StockLevelUpdateOne) SetNillableOnHand(i *int) *StockLevelUpdateOne {
+	if i != nil {
+		sluo.SetOnHand(*i)
+	}
+	return sluo
+}
+
+// AddOnHand adds i to on_hand.
+func (sluo *StockLevelUpdateOne) AddOnHand(i int) *StockLevelUpdateOne {
+	sluo.mutation.AddOnHand(i)
+	return sluo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (sluo *StockLevelUpdateOne) SetOrganizationID(id int) *StockLevelUpdateOne {
+	sluo.mutation.SetOrganizationID(id)
+	return sluo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (sluo *StockLevelUpdateOne) SetNillableOrganizationID(id *int) *StockLevelUpdateOne {
+	if id != nil {
+		sluo = sluo.SetOrganizationID(*id)
+	}
+	return sluo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (sluo *StockLevelUpdateOne) SetOrganization(o *Organization) *StockLevelUpdateOne {
+	return sluo.SetOrganizationID(o.ID)
+}
+
+// SetPartID sets the part edge to Part by id.
+func (sluo *StockLevelUpdateOne) SetPartID(id int) *StockLevelUpdateOne {
+	sluo.mutation.SetPartID(id)
+	return sluo
+}
+
+// SetPart sets the part edge to Part.
+func (sluo *StockLevelUpdateOne) SetPart(p *Part) *StockLevelUpdateOne {
+	return sluo.SetPartID(p.ID)
+}
+
+// Mutation returns the StockLevelMutation object of the builder.
+func (sluo *StockLevelUpdateOne) Mutation() *StockLevelMutation {
+	return sluo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (sluo *StockLevelUpdateOne) ClearOrganization() *StockLevelUpdateOne {
+	sluo.mutation.ClearOrganization()
+	return sluo
+}
+
+// ClearPart clears the part edge to Part.
+func (sluo *StockLevelUpdateOne) ClearPart() *StockLevelUpdateOne {
+	sluo.mutation.ClearPart()
+	return sluo
+}
+
+// Save executes the query and returns the updated entity.
+func (sluo *StockLevelUpdateOne) Save(ctx context.Context) (*StockLevel, error) {
+	if v, ok := sluo.mutation.Location(); ok {
+		if err := stocklevel.LocationValidator(v); err != nil {
+			return nil, &ValidationError{Name: "location", err: fmt.Errorf("ent: validator failed for field \"location\": %w", err)}
+		}
+	}
+	if v, ok := sluo.mutation.OnHand(); ok {
+		if err := stocklevel.OnHandValidator(v); err != nil {
+			return nil, &ValidationError{Name: "on_hand", err: fmt.Errorf("ent: validator failed for field \"on_hand\": %w", err)}
+		}
+	}
+
+	if _, ok := sluo.mutation.PartID(); sluo.mutation.PartCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"part\"")
+	}
+	var (
+		err  error
+		node *StockLevel
+	)
+	if len(sluo.hooks) == 0 {
+		node, err = sluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockLevelMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sluo.mutation = mutation
+			node, err = sluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sluo.hooks) - 1; i >= 0; i-- {
+			mut = sluo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sluo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sluo *StockLevelUpdateOne) SaveX(ctx context.Context) *StockLevel {
+	sl, err := sluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return sl
+}
+
+// Exec executes the query on the entity.
+func (sluo *StockLevelUpdateOne) Exec(ctx context.Context) error {
+	_, err := sluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sluo *StockLevelUpdateOne) ExecX(ctx context.Context) {
+	if err := sluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sluo *StockLevelUpdateOne) sqlSave(ctx context.Context) (sl *StockLevel, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stocklevel.Table,
+			Columns: stocklevel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stocklevel.FieldID,
+			},
+		},
+	}
+	id, ok := sluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing StockLevel.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := sluo.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stocklevel.FieldLocation,
+		})
+	}
+	if value, ok := sluo.mutation.OnHand(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stocklevel.FieldOnHand,
+		})
+	}
+	if value, ok := sluo.mutation.AddedOnHand(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stocklevel.FieldOnHand,
+		})
+	}
+	if sluo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stocklevel.OrganizationTable,
+			Columns: []string{stocklevel.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sluo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stocklevel.OrganizationTable,
+			Columns: []string{stocklevel.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sluo.mutation.PartCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.PartTable,
+			Columns: []string{stocklevel.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sluo.mutation.PartIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocklevel.PartTable,
+			Columns: []string{stocklevel.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	sl = &StockLevel{config: sluo.config}
+	_spec.Assign = sl.assignValues
+	_spec.ScanValues = sl.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, sluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stocklevel.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return sl, nil
+}
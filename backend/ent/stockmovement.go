@@ -0,0 +1,246 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// StockMovement is the model entity for the StockMovement schema.
+type StockMovement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind stockmovement.Kind `json:"kind,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// ReasonCode holds the value of the "reason_code" field.
+	ReasonCode stockmovement.ReasonCode `json:"reason_code,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StockMovementQuery when eager-loading is set.
+	Edges                  StockMovementEdges `json:"edges"`
+	part_movements         *int
+	repair_slip_parts_used *int
+	organization_id        *int
+}
+
+// StockMovementEdges holds the relations/edges for other nodes in the graph.
+type StockMovementEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Part holds the value of the part edge.
+	Part *Part
+	// RepairSlip holds the value of the repair_slip edge.
+	RepairSlip *RepairSlip
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// PartOrErr returns the Part value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) PartOrErr() (*Part, error) {
+	if e.loadedTypes[1] {
+		if e.Part == nil {
+			// The edge part was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: part.Label}
+		}
+		return e.Part, nil
+	}
+	return nil, &NotLoadedError{edge: "part"}
+}
+
+// RepairSlipOrErr returns the RepairSlip value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockMovementEdges) RepairSlipOrErr() (*RepairSlip, error) {
+	if e.loadedTypes[2] {
+		if e.RepairSlip == nil {
+			// The edge repair_slip was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: repairslip.Label}
+		}
+		return e.RepairSlip, nil
+	}
+	return nil, &NotLoadedError{edge: "repair_slip"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockMovement) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullString{}, // kind
+		&sql.NullInt64{},  // quantity
+		&sql.NullString{}, // location
+		&sql.NullString{}, // reason_code
+		&sql.NullString{}, // note
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*StockMovement) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // part_movements
+		&sql.NullInt64{}, // repair_slip_parts_used
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockMovement fields.
+func (sm *StockMovement) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(stockmovement.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	sm.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		sm.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field kind", values[1])
+	} else if value.Valid {
+		sm.Kind = stockmovement.Kind(value.String)
+	}
+	if value, ok := values[2].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field quantity", values[2])
+	} else if value.Valid {
+		sm.Quantity = int(value.Int64)
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field location", values[3])
+	} else if value.Valid {
+		sm.Location = value.String
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field reason_code", values[4])
+	} else if value.Valid {
+		sm.ReasonCode = stockmovement.ReasonCode(value.String)
+	}
+	if value, ok := values[5].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field note", values[5])
+	} else if value.Valid {
+		sm.Note = value.String
+	}
+	values = values[6:]
+	if len(values) == len(stockmovement.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field part_movements", value)
+		} else if value.Valid {
+			sm.part_movements = new(int)
+			*sm.part_movements = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field repair_slip_parts_used", value)
+		} else if value.Valid {
+			sm.repair_slip_parts_used = new(int)
+			*sm.repair_slip_parts_used = int(value.Int64)
+		}
+		if value, ok := values[2].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			sm.organization_id = new(int)
+			*sm.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the StockMovement.
+func (sm *StockMovement) QueryOrganization() *OrganizationQuery {
+	return (&StockMovementClient{config: sm.config}).QueryOrganization(sm)
+}
+
+// QueryPart queries the part edge of the StockMovement.
+func (sm *StockMovement) QueryPart() *PartQuery {
+	return (&StockMovementClient{config: sm.config}).QueryPart(sm)
+}
+
+// QueryRepairSlip queries the repair_slip edge of the StockMovement.
+func (sm *StockMovement) QueryRepairSlip() *RepairSlipQuery {
+	return (&StockMovementClient{config: sm.config}).QueryRepairSlip(sm)
+}
+
+// Update returns a builder for updating this StockMovement.
+// Note that, you need to call StockMovement.Unwrap() before calling this method, if this StockMovement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sm *StockMovement) Update() *StockMovementUpdateOne {
+	return (&StockMovementClient{config: sm.config}).UpdateOne(sm)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (sm *StockMovement) Unwrap() *StockMovement {
+	tx, ok := sm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockMovement is not a transactional entity")
+	}
+	sm.config.driver = tx.drv
+	return sm
+}
+
+// String implements the fmt.Stringer.
+func (sm *StockMovement) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockMovement(")
+	builder.WriteString(fmt.Sprintf("id=%v", sm.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(sm.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", kind=")
+	builder.WriteString(fmt.Sprintf("%v", sm.Kind))
+	builder.WriteString(", quantity=")
+	builder.WriteString(fmt.Sprintf("%v", sm.Quantity))
+	builder.WriteString(", location=")
+	builder.WriteString(sm.Location)
+	builder.WriteString(", reason_code=")
+	builder.WriteString(fmt.Sprintf("%v", sm.ReasonCode))
+	builder.WriteString(", note=")
+	builder.WriteString(sm.Note)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockMovements is a parsable slice of StockMovement.
+type StockMovements []*StockMovement
+
+func (sm StockMovements) config(cfg config) {
+	for _i := range sm {
+		sm[_i].config = cfg
+	}
+}
@@ -0,0 +1,143 @@
+// Code generated by entc, DO NOT EDIT.
+
+package stockmovement
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the stockmovement type in the database.
+	Label = "stock_movement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldReasonCode holds the string denoting the reason_code field in the database.
+	FieldReasonCode = "reason_code"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgePart holds the string denoting the part edge name in mutations.
+	EdgePart = "part"
+	// EdgeRepairSlip holds the string denoting the repair_slip edge name in mutations.
+	EdgeRepairSlip = "repair_slip"
+
+	// Table holds the table name of the stockmovement in the database.
+	Table = "stock_movements"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "stock_movements"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// PartTable is the table the holds the part relation/edge.
+	PartTable = "stock_movements"
+	// PartInverseTable is the table name for the Part entity.
+	// It exists in this package in order to avoid circular dependency with the "part" package.
+	PartInverseTable = "parts"
+	// PartColumn is the table column denoting the part relation/edge.
+	PartColumn = "part_movements"
+	// RepairSlipTable is the table the holds the repair_slip relation/edge.
+	RepairSlipTable = "stock_movements"
+	// RepairSlipInverseTable is the table name for the RepairSlip entity.
+	// It exists in this package in order to avoid circular dependency with the "repairslip" package.
+	RepairSlipInverseTable = "repair_slips"
+	// RepairSlipColumn is the table column denoting the repair_slip relation/edge.
+	RepairSlipColumn = "repair_slip_parts_used"
+)
+
+// Columns holds all SQL columns for stockmovement fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldKind,
+	FieldQuantity,
+	FieldLocation,
+	FieldReasonCode,
+	FieldNote,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the StockMovement type.
+var ForeignKeys = []string{
+	"part_movements",
+	"repair_slip_parts_used",
+	"organization_id",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the create_time field.
+	DefaultCreateTime func() time.Time
+	// LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	LocationValidator func(string) error
+)
+
+// Kind defines the type for the kind enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindReceipt     Kind = "receipt"
+	KindConsumption Kind = "consumption"
+	KindAdjustment  Kind = "adjustment"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "k" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindReceipt, KindConsumption, KindAdjustment:
+		return nil
+	default:
+		return fmt.Errorf("stockmovement: invalid enum value for kind field: %q", k)
+	}
+}
+
+// ReasonCode defines the type for the reason_code enum field.
+type ReasonCode string
+
+// ReasonCode values.
+const (
+	ReasonCodeCountCorrection  ReasonCode = "count_correction"
+	ReasonCodeDamaged          ReasonCode = "damaged"
+	ReasonCodeLost             ReasonCode = "lost"
+	ReasonCodeFound            ReasonCode = "found"
+	ReasonCodeReturnedToVendor ReasonCode = "returned_to_vendor"
+	ReasonCodeOther            ReasonCode = "other"
+)
+
+func (rc ReasonCode) String() string {
+	return string(rc)
+}
+
+// ReasonCodeValidator is a validator for the "rc" field enum values. It is called by the builders before save.
+func ReasonCodeValidator(rc ReasonCode) error {
+	switch rc {
+	case ReasonCodeCountCorrection, ReasonCodeDamaged, ReasonCodeLost, ReasonCodeFound, ReasonCodeReturnedToVendor, ReasonCodeOther:
+		return nil
+	default:
+		return fmt.Errorf("stockmovement: invalid enum value for reason_code field: %q", rc)
+	}
+}
@@ -0,0 +1,339 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// StockMovementCreate is the builder for creating a StockMovement entity.
+type StockMovementCreate struct {
+	config
+	mutation *StockMovementMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (smc *StockMovementCreate) SetCreateTime(t time.Time) *StockMovementCreate {
+	smc.mutation.SetCreateTime(t)
+	return smc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (smc *StockMovementCreate) SetNillableCreateTime(t *time.Time) *StockMovementCreate {
+	if t != nil {
+		smc.SetCreateTime(*t)
+	}
+	return smc
+}
+
+// SetKind sets the kind field.
+func (smc *StockMovementCreate) SetKind(s stockmovement.Kind) *StockMovementCreate {
+	smc.mutation.SetKind(s)
+	return smc
+}
+
+// SetQuantity sets the quantity field.
+func (smc *StockMovementCreate) SetQuantity(i int) *StockMovementCreate {
+	smc.mutation.SetQuantity(i)
+	return smc
+}
+
+// SetLocation sets the location field.
+func (smc *StockMovementCreate) SetLocation(s string) *StockMovementCreate {
+	smc.mutation.SetLocation(s)
+	return smc
+}
+
+// SetReasonCode sets the reason_code field.
+func (smc *StockMovementCreate) SetReasonCode(sc stockmovement.ReasonCode) *StockMovementCreate {
+	smc.mutation.SetReasonCode(sc)
+	return smc
+}
+
+// SetNillableReasonCode sets the reason_code field if the given value is not nil.
+func (smc *StockMovementCreate) SetNillableReasonCode(sc *stockmovement.ReasonCode) *StockMovementCreate {
+	if sc != nil {
+		smc.SetReasonCode(*sc)
+	}
+	return smc
+}
+
+// SetNote sets the note field.
+func (smc *StockMovementCreate) SetNote(s string) *StockMovementCreate {
+	smc.mutation.SetNote(s)
+	return smc
+}
+
+// SetNillableNote sets the note field if the given value is not nil.
+func (smc *StockMovementCreate) SetNillableNote(s *string) *StockMovementCreate {
+	if s != nil {
+		smc.SetNote(*s)
+	}
+	return smc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (smc *StockMovementCreate) SetOrganizationID(id int) *StockMovementCreate {
+	smc.mutation.SetOrganizationID(id)
+	return smc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (smc *StockMovementCreate) SetNillableOrganizationID(id *int) *StockMovementCreate {
+	if id != nil {
+		smc = smc.SetOrganizationID(*id)
+	}
+	return smc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (smc *StockMovementCreate) SetOrganization(o *Organization) *StockMovementCreate {
+	return smc.SetOrganizationID(o.ID)
+}
+
+// SetPartID sets the part edge to Part by id.
+func (smc *StockMovementCreate) SetPartID(id int) *StockMovementCreate {
+	smc.mutation.SetPartID(id)
+	return smc
+}
+
+// SetPart sets the part edge to Part.
+func (smc *StockMovementCreate) SetPart(p *Part) *StockMovementCreate {
+	return smc.SetPartID(p.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (smc *StockMovementCreate) SetRepairSlipID(id int) *StockMovementCreate {
+	smc.mutation.SetRepairSlipID(id)
+	return smc
+}
+
+// SetNillableRepairSlipID sets the repair_slip edge to RepairSlip by id if the given value is not nil.
+func (smc *StockMovementCreate) SetNillableRepairSlipID(id *int) *StockMovementCreate {
+	if id != nil {
+		smc = smc.SetRepairSlipID(*id)
+	}
+	return smc
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (smc *StockMovementCreate) SetRepairSlip(r *RepairSlip) *StockMovementCreate {
+	return smc.SetRepairSlipID(r.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (smc *StockMovementCreate) Mutation() *StockMovementMutation {
+	return smc.mutation
+}
+
+// Save creates the StockMovement in the database.
+func (smc *StockMovementCreate) Save(ctx context.Context) (*StockMovement, error) {
+	if _, ok := smc.mutation.CreateTime(); !ok {
+		v := stockmovement.DefaultCreateTime()
+		smc.mutation.SetCreateTime(v)
+	}
+	if _, ok := smc.mutation.Kind(); !ok {
+		return nil, &ValidationError{Name: "kind", err: errors.New("ent: missing required field \"kind\"")}
+	}
+	if v, ok := smc.mutation.Kind(); ok {
+		if err := stockmovement.KindValidator(v); err != nil {
+			return nil, &ValidationError{Name: "kind", err: fmt.Errorf("ent: validator failed for field \"kind\": %w", err)}
+		}
+	}
+	if _, ok := smc.mutation.Quantity(); !ok {
+		return nil, &ValidationError{Name: "quantity", err: errors.New("ent: missing required field \"quantity\"")}
+	}
+	if _, ok := smc.mutation.Location(); !ok {
+		return nil, &ValidationError{Name: "location", err: errors.New("ent: missing required field \"location\"")}
+	}
+	if v, ok := smc.mutation.Location(); ok {
+		if err := stockmovement.LocationValidator(v); err != nil {
+			return nil, &ValidationError{Name: "location", err: fmt.Errorf("ent: validator failed for field \"location\": %w", err)}
+		}
+	}
+	if v, ok := smc.mutation.ReasonCode(); ok {
+		if err := stockmovement.ReasonCodeValidator(v); err != nil {
+			return nil, &ValidationError{Name: "reason_code", err: fmt.Errorf("ent: validator failed for field \"reason_code\": %w", err)}
+		}
+	}
+	if _, ok := smc.mutation.PartID(); !ok {
+		return nil, &ValidationError{Name: "part", err: errors.New("ent: missing required edge \"part\"")}
+	}
+	var (
+		err  error
+		node *StockMovement
+	)
+	if len(smc.hooks) == 0 {
+		node, err = smc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockMovementMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			smc.mutation = mutation
+			node, err = smc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(smc.hooks) - 1; i >= 0; i-- {
+			mut = smc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, smc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (smc *StockMovementCreate) SaveX(ctx context.Context) *StockMovement {
+	v, err := smc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (smc *StockMovementCreate) sqlSave(ctx context.Context) (*StockMovement, error) {
+	sm, _spec := smc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, smc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	sm.ID = int(id)
+	return sm, nil
+}
+
+func (smc *StockMovementCreate) createSpec() (*StockMovement, *sqlgraph.CreateSpec) {
+	var (
+		sm    = &StockMovement{config: smc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: stockmovement.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockmovement.FieldID,
+			},
+		}
+	)
+	if value, ok := smc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockmovement.FieldCreateTime,
+		})
+		sm.CreateTime = value
+	}
+	if value, ok := smc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: stockmovement.FieldKind,
+		})
+		sm.Kind = value
+	}
+	if value, ok := smc.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockmovement.FieldQuantity,
+		})
+		sm.Quantity = value
+	}
+	if value, ok := smc.mutation.Location(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockmovement.FieldLocation,
+		})
+		sm.Location = value
+	}
+	if value, ok := smc.mutation.ReasonCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: stockmovement.FieldReasonCode,
+		})
+		sm.ReasonCode = value
+	}
+	if value, ok := smc.mutation.Note(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockmovement.FieldNote,
+		})
+		sm.Note = value
+	}
+	if nodes := smc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.OrganizationTable,
+			Columns: []string{stockmovement.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := smc.mutation.PartIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.PartTable,
+			Columns: []string{stockmovement.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := smc.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.RepairSlipTable,
+			Columns: []string{stockmovement.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return sm, _spec
+}
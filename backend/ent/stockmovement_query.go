@@ -0,0 +1,1056 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// StockMovementQuery is the builder for querying StockMovement entities.
+type StockMovementQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.StockMovement
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withPart         *PartQuery
+	withRepairSlip   *RepairSlipQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (smq *StockMovementQuery) Where(ps ...predicate.StockMovement) *StockMovementQuery {
+	smq.predicates = append(smq.predicates, ps...)
+	return smq
+}
+
+// Limit adds a limit step to the query.
+func (smq *StockMovementQuery) Limit(limit int) *StockMovementQuery {
+	smq.limit = &limit
+	return smq
+}
+
+// Offset adds an offset step to the query.
+func (smq *StockMovementQuery) Offset(offset int) *StockMovementQuery {
+	smq.offset = &offset
+	return smq
+}
+
+// Order adds an order step to the query.
+func (smq *StockMovementQuery) Order(o ...OrderFunc) *StockMovementQuery {
+	smq.order = append(smq.order, o...)
+	return smq
+}
+
+// QueryOrganization chains the current query on the organization edge.
+func (smq *StockMovementQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: smq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, smq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, stockmovement.OrganizationTable, stockmovement.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPart chains the current query on the part edge.
+func (smq *StockMovementQuery) QueryPart() *PartQuery {
+	query := &PartQuery{config: smq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, smq.sqlQuery()),
+			sqlgraph.To(part.Table, part.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.PartTable, stockmovement.PartColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRepairSlip chains the current query on the repair_slip edge.
+func (smq *StockMovementQuery) QueryRepairSlip() *RepairSlipQuery {
+	query := &RepairSlipQuery{config: smq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, smq.sqlQuery()),
+			sqlgraph.To(repairslip.Table, repairslip.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.RepairSlipTable, stockmovement.RepairSlipColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StockMovement entity in the query. Returns *NotFoundError when no stockmovement was found.
+func (smq *StockMovementQuery) First(ctx context.Context) (*StockMovement, error) {
+	sms, err := smq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(sms) == 0 {
+		return nil, &NotFoundError{stockmovement.Label}
+	}
+	return sms[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (smq *StockMovementQuery) FirstX(ctx context.Context) *StockMovement {
+	sm, err := smq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return sm
+}
+
+// FirstID returns the first StockMovement id in the query. Returns *NotFoundError when no id was found.
+func (smq *StockMovementQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = smq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{stockmovement.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (smq *StockMovementQuery) FirstXID(ctx context.Context) int {
+	id, err := smq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only StockMovement entity in the query, returns an error if not exactly one entity was returned.
+func (smq *StockMovementQuery) Only(ctx context.Context) (*StockMovement, error) {
+	sms, err := smq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(sms) {
+	case 1:
+		return sms[0], nil
+	case 0:
+		return nil, &NotFoundError{stockmovement.Label}
+	default:
+		return nil, &NotSingularError{stockmovement.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (smq *StockMovementQuery) OnlyX(ctx context.Context) *StockMovement {
+	sm, err := smq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return sm
+}
+
+// OnlyID returns the only StockMovement id in the query, returns an error if not exactly one id was returned.
+func (smq *StockMovementQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = smq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = &NotSingularError{stockmovement.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (smq *StockMovementQuery) OnlyIDX(ctx context.Context) int {
+	id, err := smq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StockMovements.
+func (smq *StockMovementQuery) All(ctx context.Context) ([]*StockMovement, error) {
+	if err := smq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return smq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (smq *StockMovementQuery) AllX(ctx context.Context) []*StockMovement {
+	sms, err := smq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return sms
+}
+
+// IDs executes the query and returns a list of StockMovement ids.
+func (smq *StockMovementQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := smq.Select(stockmovement.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (smq *StockMovementQuery) IDsX(ctx context.Context) []int {
+	ids, err := smq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (smq *StockMovementQuery) Count(ctx context.Context) (int, error) {
+	if err := smq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return smq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (smq *StockMovementQuery) CountX(ctx context.Context) int {
+	count, err := smq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (smq *StockMovementQuery) Exist(ctx context.Context) (bool, error) {
+	if err := smq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return smq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (smq *StockMovementQuery) ExistX(ctx context.Context) bool {
+	exist, err := smq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (smq *StockMovementQuery) Clone() *StockMovementQuery {
+	return &StockMovementQuery{
+		config:     smq.config,
+		limit:      smq.limit,
+		offset:     smq.offset,
+		order:      append([]OrderFunc{}, smq.order...),
+		unique:     append([]string{}, smq.unique...),
+		predicates: append([]predicate.StockMovement{}, smq.predicates...),
+		// clone intermediate query.
+		sql:  smq.sql.Clone(),
+		path: smq.path,
+	}
+}
+
+//  WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (smq *StockMovementQuery) WithOrganization(opts ...func(*OrganizationQuery)) *StockMovementQuery {
+	query := &OrganizationQuery{config: smq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withOrganization = query
+	return smq
+}
+
+//  WithPart tells the query-builder to eager-loads the nodes that are connected to
+// the "part" edge. The optional arguments used to configure the query builder of the edge.
+func (smq *StockMovementQuery) WithPart(opts ...func(*PartQuery)) *StockMovementQuery {
+	query := &PartQuery{config: smq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withPart = query
+	return smq
+}
+
+//  WithRepairSlip tells the query-builder to eager-loads the nodes that are connected to
+// the "repair_slip" edge. The optional arguments used to configure the query builder of the edge.
+func (smq *StockMovementQuery) WithRepairSlip(opts ...func(*RepairSlipQuery)) *StockMovementQuery {
+	query := &RepairSlipQuery{config: smq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withRepairSlip = query
+	return smq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StockMovement.Query().
+//		GroupBy(stockmovement.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (smq *StockMovementQuery) GroupBy(field string, fields ...string) *StockMovementGroupBy {
+	group := &StockMovementGroupBy{config: smq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return smq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.StockMovement.Query().
+//		Select(stockmovement.FieldCreateTime).
+//		Scan(ctx, &v)
+//
+func (smq *StockMovementQuery) Select(field string, fields ...string) *StockMovementSelect {
+	selector := &StockMovementSelect{config: smq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return smq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (smq *StockMovementQuery) prepareQuery(ctx context.Context) error {
+	if smq.path != nil {
+		prev, err := smq.path(ctx)
+		if err != nil {
+			return err
+		}
+		smq.sql = prev
+	}
+	if err := stockmovement.Policy.EvalQuery(ctx, smq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (smq *StockMovementQuery) sqlAll(ctx context.Context) ([]*StockMovement, error) {
+	var (
+		nodes       = []*StockMovement{}
+		withFKs     = smq.withFKs
+		_spec       = smq.querySpec()
+		loadedTypes = [3]bool{
+			smq.withOrganization != nil,
+			smq.withPart != nil,
+			smq.withRepairSlip != nil,
+		}
+	)
+	if smq.withOrganization != nil || smq.withPart != nil || smq.withRepairSlip != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, stockmovement.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &StockMovement{config: smq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, smq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := smq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*StockMovement)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	if query := smq.withPart; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*StockMovement)
+		for i := range nodes {
+			if fk := nodes[i].part_movements; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(part.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "part_movements" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Part = n
+			}
+		}
+	}
+
+	if query := smq.withRepairSlip; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*StockMovement)
+		for i := range nodes {
+			if fk := nodes[i].repair_slip_parts_used; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(repairslip.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "repair_slip_parts_used" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.RepairSlip = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (smq *StockMovementQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := smq.querySpec()
+	return sqlgraph.CountNodes(ctx, smq.driver, _spec)
+}
+
+func (smq *StockMovementQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := smq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (smq *StockMovementQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockmovement.Table,
+			Columns: stockmovement.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockmovement.FieldID,
+			},
+		},
+		From:   smq.sql,
+		Unique: true,
+	}
+	if ps := smq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := smq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := smq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := smq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (smq *StockMovementQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(smq.driver.Dialect())
+	t1 := builder.Table(stockmovement.Table)
+	selector := builder.Select(t1.Columns(stockmovement.Columns...)...).From(t1)
+	if smq.sql != nil {
+		selector = smq.sql
+		selector.Select(selector.Columns(stockmovement.Columns...)...)
+	}
+	for _, p := range smq.predicates {
+		p(selector)
+	}
+	for _, p := range smq.order {
+		p(selector)
+	}
+	if offset := smq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := smq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StockMovementGroupBy is the builder for group-by StockMovement entities.
+type StockMovementGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (smgb *StockMovementGroupBy) Aggregate(fns ...AggregateFunc) *StockMovementGroupBy {
+	smgb.fns = append(smgb.fns, fns...)
+	return smgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (smgb *StockMovementGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := smgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	smgb.sql = query
+	return smgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (smgb *StockMovementGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := smgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (smgb *StockMovementGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(smgb.fields) > 1 {
+		return nil, errors.New("ent: StockMovementGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := smgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (smgb *StockMovementGroupBy) StringsX(ctx context.Context) []string {
+	v, err := smgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (smgb *StockMovementGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = smgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = fmt.Errorf("ent: StockMovementGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (smgb *StockMovementGroupBy) StringX(ctx context.Context) string {
+	v, err := smgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (smgb *StockMovementGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(smgb.fields) > 1 {
+		return nil, errors.New("ent: StockMovementGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := smgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (smgb *StockMovementGroupBy) IntsX(ctx context.Context) []int {
+	v, err := smgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (smgb *StockMovementGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = smgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = fmt.Errorf("ent: StockMovementGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (smgb *StockMovementGroupBy) IntX(ctx context.Context) int {
+	v, err := smgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (smgb *StockMovementGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(smgb.fields) > 1 {
+		return nil, errors.New("ent: StockMovementGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := smgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (smgb *StockMovementGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := smgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (smgb *StockMovementGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = smgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = fmt.Errorf("ent: StockMovementGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (smgb *StockMovementGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := smgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (smgb *StockMovementGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(smgb.fields) > 1 {
+		return nil, errors.New("ent: StockMovementGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := smgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (smgb *StockMovementGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := smgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (smgb *StockMovementGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = smgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = fmt.Errorf("ent: StockMovementGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (smgb *StockMovementGroupBy) BoolX(ctx context.Context) bool {
+	v, err := smgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (smgb *StockMovementGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := smgb.sqlQuery().Query()
+	if err := smgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (smgb *StockMovementGroupBy) sqlQuery() *sql.Selector {
+	selector := smgb.sql
+	columns := make([]string, 0, len(smgb.fields)+len(smgb.fns))
+	columns = append(columns, smgb.fields...)
+	for _, fn := range smgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(smgb.fields...)
+}
+
+// StockMovementSelect is the builder for select fields of StockMovement entities.
+type StockMovementSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (sms *StockMovementSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := sms.path(ctx)
+	if err != nil {
+		return err
+	}
+	sms.sql = query
+	return sms.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sms *StockMovementSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := sms.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (sms *StockMovementSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(sms.fields) > 1 {
+		return nil, errors.New("ent: StockMovementSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := sms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sms *StockMovementSelect) StringsX(ctx context.Context) []string {
+	v, err := sms.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (sms *StockMovementSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sms.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = fmt.Errorf("ent: StockMovementSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sms *StockMovementSelect) StringX(ctx context.Context) string {
+	v, err := sms.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (sms *StockMovementSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(sms.fields) > 1 {
+		return nil, errors.New("ent: StockMovementSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := sms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sms *StockMovementSelect) IntsX(ctx context.Context) []int {
+	v, err := sms.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (sms *StockMovementSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sms.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = fmt.Errorf("ent: StockMovementSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sms *StockMovementSelect) IntX(ctx context.Context) int {
+	v, err := sms.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (sms *StockMovementSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sms.fields) > 1 {
+		return nil, errors.New("ent: StockMovementSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := sms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sms *StockMovementSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := sms.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (sms *StockMovementSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sms.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = fmt.Errorf("ent: StockMovementSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sms *StockMovementSelect) Float64X(ctx context.Context) float64 {
+	v, err := sms.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (sms *StockMovementSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(sms.fields) > 1 {
+		return nil, errors.New("ent: StockMovementSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := sms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sms *StockMovementSelect) BoolsX(ctx context.Context) []bool {
+	v, err := sms.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (sms *StockMovementSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sms.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{stockmovement.Label}
+	default:
+		err = fmt.Errorf("ent: StockMovementSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sms *StockMovementSelect) BoolX(ctx context.Context) bool {
+	v, err := sms.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sms *StockMovementSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sms.sqlQuery().Query()
+	if err := sms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sms *StockMovementSelect) sqlQuery() sql.Querier {
+	selector := sms.sql
+	selector.Select(selector.Columns(sms.fields...)...)
+	return selector
+}
@@ -0,0 +1,588 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// StockMovementUpdate is the builder for updating StockMovement entities.
+type StockMovementUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *StockMovementMutation
+	predicates []predicate.StockMovement
+}
+
+// Where adds a new predicate for the builder.
+func (smu *StockMovementUpdate) Where(ps ...predicate.StockMovement) *StockMovementUpdate {
+	smu.predicates = append(smu.predicates, ps...)
+	return smu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (smu *StockMovementUpdate) SetOrganizationID(id int) *StockMovementUpdate {
+	smu.mutation.SetOrganizationID(id)
+	return smu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (smu *StockMovementUpdate) SetNillableOrganizationID(id *int) *StockMovementUpdate {
+	if id != nil {
+		smu = smu.SetOrganizationID(*id)
+	}
+	return smu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (smu *StockMovementUpdate) SetOrganization(o *Organization) *StockMovementUpdate {
+	return smu.SetOrganizationID(o.ID)
+}
+
+// SetPartID sets the part edge to Part by id.
+func (smu *StockMovementUpdate) SetPartID(id int) *StockMovementUpdate {
+	smu.mutation.SetPartID(id)
+	return smu
+}
+
+// SetPart sets the part edge to Part.
+func (smu *StockMovementUpdate) SetPart(p *Part) *StockMovementUpdate {
+	return smu.SetPartID(p.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (smu *StockMovementUpdate) SetRepairSlipID(id int) *StockMovementUpdate {
+	smu.mutation.SetRepairSlipID(id)
+	return smu
+}
+
+// SetNillableRepairSlipID sets the repair_slip edge to RepairSlip by id if the given value is not nil.
+func (smu *StockMovementUpdate) SetNillableRepairSlipID(id *int) *StockMovementUpdate {
+	if id != nil {
+		smu = smu.SetRepairSlipID(*id)
+	}
+	return smu
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (smu *StockMovementUpdate) SetRepairSlip(r *RepairSlip) *StockMovementUpdate {
+	return smu.SetRepairSlipID(r.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (smu *StockMovementUpdate) Mutation() *StockMovementMutation {
+	return smu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (smu *StockMovementUpdate) ClearOrganization() *StockMovementUpdate {
+	smu.mutation.ClearOrganization()
+	return smu
+}
+
+// ClearPart clears the part edge to Part.
+func (smu *StockMovementUpdate) ClearPart() *StockMovementUpdate {
+	smu.mutation.ClearPart()
+	return smu
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (smu *StockMovementUpdate) ClearRepairSlip() *StockMovementUpdate {
+	smu.mutation.ClearRepairSlip()
+	return smu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (smu *StockMovementUpdate) Save(ctx context.Context) (int, error) {
+
+	if _, ok := smu.mutation.PartID(); smu.mutation.PartCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"part\"")
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(smu.hooks) == 0 {
+		affected, err = smu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockMovementMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			smu.mutation = mutation
+			affected, err = smu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(smu.hooks) - 1; i >= 0; i-- {
+			mut = smu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, smu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smu *StockMovementUpdate) SaveX(ctx context.Context) int {
+	affected, err := smu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (smu *StockMovementUpdate) Exec(ctx context.Context) error {
+	_, err := smu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smu *StockMovementUpdate) ExecX(ctx context.Context) {
+	if err := smu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (smu *StockMovementUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockmovement.Table,
+			Columns: stockmovement.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockmovement.FieldID,
+			},
+		},
+	}
+	if ps := smu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if smu.mutation.ReasonCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Column: stockmovement.FieldReasonCode,
+		})
+	}
+	if smu.mutation.NoteCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: stockmovement.FieldNote,
+		})
+	}
+	if smu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.OrganizationTable,
+			Columns: []string{stockmovement.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.OrganizationTable,
+			Columns: []string{stockmovement.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smu.mutation.PartCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.PartTable,
+			Columns: []string{stockmovement.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.PartIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.PartTable,
+			Columns: []string{stockmovement.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smu.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.RepairSlipTable,
+			Columns: []string{stockmovement.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.RepairSlipTable,
+			Columns: []string{stockmovement.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, smu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockmovement.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// StockMovementUpdateOne is the builder for updating a single StockMovement entity.
+type StockMovementUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *StockMovementMutation
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (smuo *StockMovementUpdateOne) SetOrganizationID(id int) *StockMovementUpdateOne {
+	smuo.mutation.SetOrganizationID(id)
+	return smuo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (smuo *StockMovementUpdateOne) SetNillableOrganizationID(id *int) *StockMovementUpdateOne {
+	if id != nil {
+		smuo = smuo.SetOrganizationID(*id)
+	}
+	return smuo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (smuo *StockMovementUpdateOne) SetOrganization(o *Organization) *StockMovementUpdateOne {
+	return smuo.SetOrganizationID(o.ID)
+}
+
+// SetPartID sets the part edge to Part by id.
+func (smuo *StockMovementUpdateOne) SetPartID(id int) *StockMovementUpdateOne {
+	smuo.mutation.SetPartID(id)
+	return smuo
+}
+
+// SetPart sets the part edge to Part.
+func (smuo *StockMovementUpdateOne) SetPart(p *Part) *StockMovementUpdateOne {
+	return smuo.SetPartID(p.ID)
+}
+
+// SetRepairSlipID sets the repair_slip edge to RepairSlip by id.
+func (smuo *StockMovementUpdateOne) SetRepairSlipID(id int) *StockMovementUpdateOne {
+	smuo.mutation.SetRepairSlipID(id)
+	return smuo
+}
+
+// SetNillableRepairSlipID sets the repair_slip edge to RepairSlip by id if the given value is not nil.
+func (smuo *StockMovementUpdateOne) SetNillableRepairSlipID(id *int) *StockMovementUpdateOne {
+	if id != nil {
+		smuo = smuo.SetRepairSlipID(*id)
+	}
+	return smuo
+}
+
+// SetRepairSlip sets the repair_slip edge to RepairSlip.
+func (smuo *StockMovementUpdateOne) SetRepairSlip(r *RepairSlip) *StockMovementUpdateOne {
+	return smuo.SetRepairSlipID(r.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (smuo *StockMovementUpdateOne) Mutation() *StockMovementMutation {
+	return smuo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (smuo *StockMovementUpdateOne) ClearOrganization() *StockMovementUpdateOne {
+	smuo.mutation.ClearOrganization()
+	return smuo
+}
+
+// ClearPart clears the part edge to Part.
+func (smuo *StockMovementUpdateOne) ClearPart() *StockMovementUpdateOne {
+	smuo.mutation.ClearPart()
+	return smuo
+}
+
+// ClearRepairSlip clears the repair_slip edge to RepairSlip.
+func (smuo *StockMovementUpdateOne) ClearRepairSlip() *StockMovementUpdateOne {
+	smuo.mutation.ClearRepairSlip()
+	return smuo
+}
+
+// Save executes the query and returns the updated entity.
+func (smuo *StockMovementUpdateOne) Save(ctx context.Context) (*StockMovement, error) {
+
+	if _, ok := smuo.mutation.PartID(); smuo.mutation.PartCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"part\"")
+	}
+
+	var (
+		err  error
+		node *StockMovement
+	)
+	if len(smuo.hooks) == 0 {
+		node, err = smuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockMovementMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			smuo.mutation = mutation
+			node, err = smuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(smuo.hooks) - 1; i >= 0; i-- {
+			mut = smuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, smuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smuo *StockMovementUpdateOne) SaveX(ctx context.Context) *StockMovement {
+	sm, err := smuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return sm
+}
+
+// Exec executes the query on the entity.
+func (smuo *StockMovementUpdateOne) Exec(ctx context.Context) error {
+	_, err := smuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smuo *StockMovementUpdateOne) ExecX(ctx context.Context) {
+	if err := smuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (smuo *StockMovementUpdateOne) sqlSave(ctx context.Context) (sm *StockMovement, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockmovement.Table,
+			Columns: stockmovement.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockmovement.FieldID,
+			},
+		},
+	}
+	id, ok := smuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing StockMovement.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if smuo.mutation.ReasonCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Column: stockmovement.FieldReasonCode,
+		})
+	}
+	if smuo.mutation.NoteCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: stockmovement.FieldNote,
+		})
+	}
+	if smuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.OrganizationTable,
+			Columns: []string{stockmovement.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.OrganizationTable,
+			Columns: []string{stockmovement.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smuo.mutation.PartCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.PartTable,
+			Columns: []string{stockmovement.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.PartIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.PartTable,
+			Columns: []string{stockmovement.PartColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: part.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smuo.mutation.RepairSlipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.RepairSlipTable,
+			Columns: []string{stockmovement.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.RepairSlipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.RepairSlipTable,
+			Columns: []string{stockmovement.RepairSlipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	sm = &StockMovement{config: smuo.config}
+	_spec.Assign = sm.assignValues
+	_spec.ScanValues = sm.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, smuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockmovement.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return sm, nil
+}
@@ -12,8 +12,50 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Activity is the client for interacting with the Activity builders.
+	Activity *ActivityClient
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// CommentRevision is the client for interacting with the CommentRevision builders.
+	CommentRevision *CommentRevisionClient
+	// Equipment is the client for interacting with the Equipment builders.
+	Equipment *EquipmentClient
+	// EquipmentMove is the client for interacting with the EquipmentMove builders.
+	EquipmentMove *EquipmentMoveClient
+	// Holiday is the client for interacting with the Holiday builders.
+	Holiday *HolidayClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// InvoiceLine is the client for interacting with the InvoiceLine builders.
+	InvoiceLine *InvoiceLineClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// Location is the client for interacting with the Location builders.
+	Location *LocationClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
+	// Part is the client for interacting with the Part builders.
+	Part *PartClient
+	// Payment is the client for interacting with the Payment builders.
+	Payment *PaymentClient
+	// RepairSlip is the client for interacting with the RepairSlip builders.
+	RepairSlip *RepairSlipClient
+	// SLAPolicy is the client for interacting with the SLAPolicy builders.
+	SLAPolicy *SLAPolicyClient
+	// StockLevel is the client for interacting with the StockLevel builders.
+	StockLevel *StockLevelClient
+	// StockMovement is the client for interacting with the StockMovement builders.
+	StockMovement *StockMovementClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WarrantyTerm is the client for interacting with the WarrantyTerm builders.
+	WarrantyTerm *WarrantyTermClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,7 +191,28 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Activity = NewActivityClient(tx.config)
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
+	tx.CommentRevision = NewCommentRevisionClient(tx.config)
+	tx.Equipment = NewEquipmentClient(tx.config)
+	tx.EquipmentMove = NewEquipmentMoveClient(tx.config)
+	tx.Holiday = NewHolidayClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.InvoiceLine = NewInvoiceLineClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
+	tx.Location = NewLocationClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
+	tx.Part = NewPartClient(tx.config)
+	tx.Payment = NewPaymentClient(tx.config)
+	tx.RepairSlip = NewRepairSlipClient(tx.config)
+	tx.SLAPolicy = NewSLAPolicyClient(tx.config)
+	tx.StockLevel = NewStockLevelClient(tx.config)
+	tx.StockMovement = NewStockMovementClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WarrantyTerm = NewWarrantyTermClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -159,7 +222,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: Activity.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
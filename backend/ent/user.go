@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/darksford123x/app/ent/organization"
 	"github.com/darksford123x/app/ent/user"
 	"github.com/facebookincubator/ent/dialect/sql"
 )
@@ -19,6 +20,104 @@ type User struct {
 	Age int `json:"age,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
+	// Skill holds the value of the "skill" field.
+	Skill string `json:"skill,omitempty"`
+	// Department holds the value of the "department" field.
+	Department string `json:"department,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale user.Locale `json:"locale,omitempty"`
+	// PasswordHash holds the value of the "password_hash" field.
+	PasswordHash string `json:"-"`
+	// Disabled holds the value of the "disabled" field.
+	Disabled bool `json:"disabled,omitempty"`
+	// AssignmentVersion holds the value of the "assignment_version" field.
+	AssignmentVersion int `json:"-"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges           UserEdges `json:"edges"`
+	organization_id *int
+}
+
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// ReportedSlips holds the value of the reported_slips edge.
+	ReportedSlips []*RepairSlip
+	// AssignedSlips holds the value of the assigned_slips edge.
+	AssignedSlips []*RepairSlip
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment
+	// MentionedIn holds the value of the mentioned_in edge.
+	MentionedIn []*Comment
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// ReportedSlipsOrErr returns the ReportedSlips value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReportedSlipsOrErr() ([]*RepairSlip, error) {
+	if e.loadedTypes[1] {
+		return e.ReportedSlips, nil
+	}
+	return nil, &NotLoadedError{edge: "reported_slips"}
+}
+
+// AssignedSlipsOrErr returns the AssignedSlips value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AssignedSlipsOrErr() ([]*RepairSlip, error) {
+	if e.loadedTypes[2] {
+		return e.AssignedSlips, nil
+	}
+	return nil, &NotLoadedError{edge: "assigned_slips"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[3] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[4] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// MentionedInOrErr returns the MentionedIn value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MentionedInOrErr() ([]*Comment, error) {
+	if e.loadedTypes[5] {
+		return e.MentionedIn, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_in"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -27,6 +126,21 @@ func (*User) scanValues() []interface{} {
 		&sql.NullInt64{},  // id
 		&sql.NullInt64{},  // age
 		&sql.NullString{}, // name
+		&sql.NullString{}, // role
+		&sql.NullString{}, // skill
+		&sql.NullString{}, // department
+		&sql.NullString{}, // email
+		&sql.NullString{}, // locale
+		&sql.NullString{}, // password_hash
+		&sql.NullBool{},   // disabled
+		&sql.NullInt64{},  // assignment_version
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*User) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
 	}
 }
 
@@ -52,9 +166,88 @@ func (u *User) assignValues(values ...interface{}) error {
 	} else if value.Valid {
 		u.Name = value.String
 	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field role", values[2])
+	} else if value.Valid {
+		u.Role = user.Role(value.String)
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field skill", values[3])
+	} else if value.Valid {
+		u.Skill = value.String
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field department", values[4])
+	} else if value.Valid {
+		u.Department = value.String
+	}
+	if value, ok := values[5].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field email", values[5])
+	} else if value.Valid {
+		u.Email = value.String
+	}
+	if value, ok := values[6].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field locale", values[6])
+	} else if value.Valid {
+		u.Locale = user.Locale(value.String)
+	}
+	if value, ok := values[7].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field password_hash", values[7])
+	} else if value.Valid {
+		u.PasswordHash = value.String
+	}
+	if value, ok := values[8].(*sql.NullBool); !ok {
+		return fmt.Errorf("unexpected type %T for field disabled", values[8])
+	} else if value.Valid {
+		u.Disabled = value.Bool
+	}
+	if value, ok := values[9].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field assignment_version", values[9])
+	} else if value.Valid {
+		u.AssignmentVersion = int(value.Int64)
+	}
+	values = values[10:]
+	if len(values) == len(user.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			u.organization_id = new(int)
+			*u.organization_id = int(value.Int64)
+		}
+	}
 	return nil
 }
 
+// QueryOrganization queries the organization edge of the User.
+func (u *User) QueryOrganization() *OrganizationQuery {
+	return (&UserClient{config: u.config}).QueryOrganization(u)
+}
+
+// QueryReportedSlips queries the reported_slips edge of the User.
+func (u *User) QueryReportedSlips() *RepairSlipQuery {
+	return (&UserClient{config: u.config}).QueryReportedSlips(u)
+}
+
+// QueryAssignedSlips queries the assigned_slips edge of the User.
+func (u *User) QueryAssignedSlips() *RepairSlipQuery {
+	return (&UserClient{config: u.config}).QueryAssignedSlips(u)
+}
+
+// QueryInvoices queries the invoices edge of the User.
+func (u *User) QueryInvoices() *InvoiceQuery {
+	return (&UserClient{config: u.config}).QueryInvoices(u)
+}
+
+// QueryAttachments queries the attachments edge of the User.
+func (u *User) QueryAttachments() *AttachmentQuery {
+	return (&UserClient{config: u.config}).QueryAttachments(u)
+}
+
+// QueryMentionedIn queries the mentioned_in edge of the User.
+func (u *User) QueryMentionedIn() *CommentQuery {
+	return (&UserClient{config: u.config}).QueryMentionedIn(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that, you need to call User.Unwrap() before calling this method, if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -82,6 +275,21 @@ func (u *User) String() string {
 	builder.WriteString(fmt.Sprintf("%v", u.Age))
 	builder.WriteString(", name=")
 	builder.WriteString(u.Name)
+	builder.WriteString(", role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
+	builder.WriteString(", skill=")
+	builder.WriteString(u.Skill)
+	builder.WriteString(", department=")
+	builder.WriteString(u.Department)
+	builder.WriteString(", email=")
+	builder.WriteString(u.Email)
+	builder.WriteString(", locale=")
+	builder.WriteString(fmt.Sprintf("%v", u.Locale))
+	builder.WriteString(", password_hash=<sensitive>")
+	builder.WriteString(", disabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.Disabled))
+	builder.WriteString(", assignment_version=")
+	builder.WriteString(fmt.Sprintf("%v", u.AssignmentVersion))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -2,6 +2,12 @@
 
 package user
 
+import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+)
+
 const (
 	// Label holds the string label denoting the user type in the database.
 	Label = "user"
@@ -11,9 +17,78 @@ const (
 	FieldAge = "age"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldSkill holds the string denoting the skill field in the database.
+	FieldSkill = "skill"
+	// FieldDepartment holds the string denoting the department field in the database.
+	FieldDepartment = "department"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldPasswordHash holds the string denoting the password_hash field in the database.
+	FieldPasswordHash = "password_hash"
+	// FieldDisabled holds the string denoting the disabled field in the database.
+	FieldDisabled = "disabled"
+	// FieldAssignmentVersion holds the string denoting the assignment_version field in the database.
+	FieldAssignmentVersion = "assignment_version"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeReportedSlips holds the string denoting the reported_slips edge name in mutations.
+	EdgeReportedSlips = "reported_slips"
+	// EdgeAssignedSlips holds the string denoting the assigned_slips edge name in mutations.
+	EdgeAssignedSlips = "assigned_slips"
+	// EdgeInvoices holds the string denoting the invoices edge name in mutations.
+	EdgeInvoices = "invoices"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeMentionedIn holds the string denoting the mentioned_in edge name in mutations.
+	EdgeMentionedIn = "mentioned_in"
 
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "users"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// ReportedSlipsTable is the table the holds the reported_slips relation/edge.
+	ReportedSlipsTable = "repair_slips"
+	// ReportedSlipsInverseTable is the table name for the RepairSlip entity.
+	// It exists in this package in order to avoid circular dependency with the "repairslip" package.
+	ReportedSlipsInverseTable = "repair_slips"
+	// ReportedSlipsColumn is the table column denoting the reported_slips relation/edge.
+	ReportedSlipsColumn = "user_reported_slips"
+	// AssignedSlipsTable is the table the holds the assigned_slips relation/edge.
+	AssignedSlipsTable = "repair_slips"
+	// AssignedSlipsInverseTable is the table name for the RepairSlip entity.
+	// It exists in this package in order to avoid circular dependency with the "repairslip" package.
+	AssignedSlipsInverseTable = "repair_slips"
+	// AssignedSlipsColumn is the table column denoting the assigned_slips relation/edge.
+	AssignedSlipsColumn = "user_assigned_slips"
+	// InvoicesTable is the table the holds the invoices relation/edge.
+	InvoicesTable = "invoices"
+	// InvoicesInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoicesInverseTable = "invoices"
+	// InvoicesColumn is the table column denoting the invoices relation/edge.
+	InvoicesColumn = "user_invoices"
+	// AttachmentsTable is the table the holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "user_attachments"
+	// MentionedInTable is the table the holds the mentioned_in relation/edge. The primary key declared below.
+	MentionedInTable = "comment_mentions"
+	// MentionedInInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	MentionedInInverseTable = "comments"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -21,11 +96,97 @@ var Columns = []string{
 	FieldID,
 	FieldAge,
 	FieldName,
+	FieldRole,
+	FieldSkill,
+	FieldDepartment,
+	FieldEmail,
+	FieldLocale,
+	FieldPasswordHash,
+	FieldDisabled,
+	FieldAssignmentVersion,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the User type.
+var ForeignKeys = []string{
+	"organization_id",
+}
+
+var (
+	// MentionedInPrimaryKey and MentionedInColumn2 are the table columns denoting the
+	// primary key for the mentioned_in relation (M2M).
+	MentionedInPrimaryKey = []string{"comment_id", "user_id"}
+)
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// AgeValidator is a validator for the "age" field. It is called by the builders before save.
 	AgeValidator func(int) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultDisabled holds the default value on creation for the disabled field.
+	DefaultDisabled bool
+	// DefaultAssignmentVersion holds the default value on creation for the assignment_version field.
+	DefaultAssignmentVersion int
+	// AssignmentVersionValidator is a validator for the "assignment_version" field. It is called by the builders before save.
+	AssignmentVersionValidator func(int) error
+)
+
+// Role defines the type for the role enum field.
+type Role string
+
+// RoleStaff is the default Role.
+const DefaultRole = RoleStaff
+
+// Role values.
+const (
+	RoleStaff      Role = "staff"
+	RoleTechnician Role = "technician"
+	RoleSupervisor Role = "supervisor"
+	RoleAdmin      Role = "admin"
 )
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "r" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleStaff, RoleTechnician, RoleSupervisor, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
+// Locale defines the type for the locale enum field.
+type Locale string
+
+// LocaleTh is the default Locale.
+const DefaultLocale = LocaleTh
+
+// Locale values.
+const (
+	LocaleTh Locale = "th"
+	LocaleEn Locale = "en"
+)
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+// LocaleValidator is a validator for the "l" field enum values. It is called by the builders before save.
+func LocaleValidator(l Locale) error {
+	switch l {
+	case LocaleTh, LocaleEn:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for locale field: %q", l)
+	}
+}
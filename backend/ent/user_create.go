@@ -7,6 +7,11 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/repairslip"
 	"github.com/darksford123x/app/ent/user"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/schema/field"
@@ -31,6 +36,212 @@ func (uc *UserCreate) SetName(s string) *UserCreate {
 	return uc
 }
 
+// SetRole sets the role field.
+func (uc *UserCreate) SetRole(u user.Role) *UserCreate {
+	uc.mutation.SetRole(u)
+	return uc
+}
+
+// SetNillableRole sets the role field if the given value is not nil.
+func (uc *UserCreate) SetNillableRole(u *user.Role) *UserCreate {
+	if u != nil {
+		uc.SetRole(*u)
+	}
+	return uc
+}
+
+// SetSkill sets the skill field.
+func (uc *UserCreate) SetSkill(s string) *UserCreate {
+	uc.mutation.SetSkill(s)
+	return uc
+}
+
+// SetNillableSkill sets the skill field if the given value is not nil.
+func (uc *UserCreate) SetNillableSkill(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSkill(*s)
+	}
+	return uc
+}
+
+// SetDepartment sets the department field.
+func (uc *UserCreate) SetDepartment(s string) *UserCreate {
+	uc.mutation.SetDepartment(s)
+	return uc
+}
+
+// SetNillableDepartment sets the department field if the given value is not nil.
+func (uc *UserCreate) SetNillableDepartment(s *string) *UserCreate {
+	if s != nil {
+		uc.SetDepartment(*s)
+	}
+	return uc
+}
+
+// SetEmail sets the email field.
+func (uc *UserCreate) SetEmail(s string) *UserCreate {
+	uc.mutation.SetEmail(s)
+	return uc
+}
+
+// SetNillableEmail sets the email field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
+	if s != nil {
+		uc.SetEmail(*s)
+	}
+	return uc
+}
+
+// SetLocale sets the locale field.
+func (uc *UserCreate) SetLocale(u user.Locale) *UserCreate {
+	uc.mutation.SetLocale(u)
+	return uc
+}
+
+// SetNillableLocale sets the locale field if the given value is not nil.
+func (uc *UserCreate) SetNillableLocale(u *user.Locale) *UserCreate {
+	if u != nil {
+		uc.SetLocale(*u)
+	}
+	return uc
+}
+
+// SetPasswordHash sets the password_hash field.
+func (uc *UserCreate) SetPasswordHash(s string) *UserCreate {
+	uc.mutation.SetPasswordHash(s)
+	return uc
+}
+
+// SetNillablePasswordHash sets the password_hash field if the given value is not nil.
+func (uc *UserCreate) SetNillablePasswordHash(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPasswordHash(*s)
+	}
+	return uc
+}
+
+// SetDisabled sets the disabled field.
+func (uc *UserCreate) SetDisabled(b bool) *UserCreate {
+	uc.mutation.SetDisabled(b)
+	return uc
+}
+
+// SetNillableDisabled sets the disabled field if the given value is not nil.
+func (uc *UserCreate) SetNillableDisabled(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetDisabled(*b)
+	}
+	return uc
+}
+
+// SetAssignmentVersion sets the assignment_version field.
+func (uc *UserCreate) SetAssignmentVersion(i int) *UserCreate {
+	uc.mutation.SetAssignmentVersion(i)
+	return uc
+}
+
+// SetNillableAssignmentVersion sets the assignment_version field if the given value is not nil.
+func (uc *UserCreate) SetNillableAssignmentVersion(i *int) *UserCreate {
+	if i != nil {
+		uc.SetAssignmentVersion(*i)
+	}
+	return uc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (uc *UserCreate) SetOrganizationID(id int) *UserCreate {
+	uc.mutation.SetOrganizationID(id)
+	return uc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (uc *UserCreate) SetNillableOrganizationID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetOrganizationID(*id)
+	}
+	return uc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (uc *UserCreate) SetOrganization(o *Organization) *UserCreate {
+	return uc.SetOrganizationID(o.ID)
+}
+
+// AddReportedSlipIDs adds the reported_slips edge to RepairSlip by ids.
+func (uc *UserCreate) AddReportedSlipIDs(ids ...int) *UserCreate {
+	uc.mutation.AddReportedSlipIDs(ids...)
+	return uc
+}
+
+// AddReportedSlips adds the reported_slips edges to RepairSlip.
+func (uc *UserCreate) AddReportedSlips(r ...*RepairSlip) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddReportedSlipIDs(ids...)
+}
+
+// AddAssignedSlipIDs adds the assigned_slips edge to RepairSlip by ids.
+func (uc *UserCreate) AddAssignedSlipIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAssignedSlipIDs(ids...)
+	return uc
+}
+
+// AddAssignedSlips adds the assigned_slips edges to RepairSlip.
+func (uc *UserCreate) AddAssignedSlips(r ...*RepairSlip) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddAssignedSlipIDs(ids...)
+}
+
+// AddInvoiceIDs adds the invoices edge to Invoice by ids.
+func (uc *UserCreate) AddInvoiceIDs(ids ...int) *UserCreate {
+	uc.mutation.AddInvoiceIDs(ids...)
+	return uc
+}
+
+// AddInvoices adds the invoices edges to Invoice.
+func (uc *UserCreate) AddInvoices(i ...*Invoice) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddInvoiceIDs(ids...)
+}
+
+// AddAttachmentIDs adds the attachments edge to Attachment by ids.
+func (uc *UserCreate) AddAttachmentIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAttachmentIDs(ids...)
+	return uc
+}
+
+// AddAttachments adds the attachments edges to Attachment.
+func (uc *UserCreate) AddAttachments(a ...*Attachment) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAttachmentIDs(ids...)
+}
+
+// AddMentionedInIDs adds the mentioned_in edge to Comment by ids.
+func (uc *UserCreate) AddMentionedInIDs(ids ...int) *UserCreate {
+	uc.mutation.AddMentionedInIDs(ids...)
+	return uc
+}
+
+// AddMentionedIn adds the mentioned_in edges to Comment.
+func (uc *UserCreate) AddMentionedIn(c ...*Comment) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddMentionedInIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -54,6 +265,37 @@ func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
 			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
 		}
 	}
+	if _, ok := uc.mutation.Role(); !ok {
+		v := user.DefaultRole
+		uc.mutation.SetRole(v)
+	}
+	if v, ok := uc.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return nil, &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if _, ok := uc.mutation.Locale(); !ok {
+		v := user.DefaultLocale
+		uc.mutation.SetLocale(v)
+	}
+	if v, ok := uc.mutation.Locale(); ok {
+		if err := user.LocaleValidator(v); err != nil {
+			return nil, &ValidationError{Name: "locale", err: fmt.Errorf("ent: validator failed for field \"locale\": %w", err)}
+		}
+	}
+	if _, ok := uc.mutation.Disabled(); !ok {
+		v := user.DefaultDisabled
+		uc.mutation.SetDisabled(v)
+	}
+	if _, ok := uc.mutation.AssignmentVersion(); !ok {
+		v := user.DefaultAssignmentVersion
+		uc.mutation.SetAssignmentVersion(v)
+	}
+	if v, ok := uc.mutation.AssignmentVersion(); ok {
+		if err := user.AssignmentVersionValidator(v); err != nil {
+			return nil, &ValidationError{Name: "assignment_version", err: fmt.Errorf("ent: validator failed for field \"assignment_version\": %w", err)}
+		}
+	}
 	var (
 		err  error
 		node *User
@@ -130,5 +372,183 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		u.Name = value
 	}
+	if value, ok := uc.mutation.Role(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldRole,
+		})
+		u.Role = value
+	}
+	if value, ok := uc.mutation.Skill(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldSkill,
+		})
+		u.Skill = value
+	}
+	if value, ok := uc.mutation.Department(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldDepartment,
+		})
+		u.Department = value
+	}
+	if value, ok := uc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldEmail,
+		})
+		u.Email = value
+	}
+	if value, ok := uc.mutation.Locale(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldLocale,
+		})
+		u.Locale = value
+	}
+	if value, ok := uc.mutation.PasswordHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldPasswordHash,
+		})
+		u.PasswordHash = value
+	}
+	if value, ok := uc.mutation.Disabled(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldDisabled,
+		})
+		u.Disabled = value
+	}
+	if value, ok := uc.mutation.AssignmentVersion(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAssignmentVersion,
+		})
+		u.AssignmentVersion = value
+	}
+	if nodes := uc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ReportedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportedSlipsTable,
+			Columns: []string{user.ReportedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AssignedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AssignedSlipsTable,
+			Columns: []string{user.AssignedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvoicesTable,
+			Columns: []string{user.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MentionedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInTable,
+			Columns: user.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return u, _spec
 }
@@ -4,11 +4,17 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
 
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
 	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
 	"github.com/darksford123x/app/ent/user"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -23,6 +29,14 @@ type UserQuery struct {
 	order      []OrderFunc
 	unique     []string
 	predicates []predicate.User
+	// eager-loading edges.
+	withOrganization  *OrganizationQuery
+	withReportedSlips *RepairSlipQuery
+	withAssignedSlips *RepairSlipQuery
+	withInvoices      *InvoiceQuery
+	withAttachments   *AttachmentQuery
+	withMentionedIn   *CommentQuery
+	withFKs           bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -52,6 +66,114 @@ func (uq *UserQuery) Order(o ...OrderFunc) *UserQuery {
 	return uq
 }
 
+// QueryOrganization chains the current query on the organization edge.
+func (uq *UserQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, user.OrganizationTable, user.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReportedSlips chains the current query on the reported_slips edge.
+func (uq *UserQuery) QueryReportedSlips() *RepairSlipQuery {
+	query := &RepairSlipQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(repairslip.Table, repairslip.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReportedSlipsTable, user.ReportedSlipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignedSlips chains the current query on the assigned_slips edge.
+func (uq *UserQuery) QueryAssignedSlips() *RepairSlipQuery {
+	query := &RepairSlipQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(repairslip.Table, repairslip.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AssignedSlipsTable, user.AssignedSlipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoices chains the current query on the invoices edge.
+func (uq *UserQuery) QueryInvoices() *InvoiceQuery {
+	query := &InvoiceQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InvoicesTable, user.InvoicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the attachments edge.
+func (uq *UserQuery) QueryAttachments() *AttachmentQuery {
+	query := &AttachmentQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AttachmentsTable, user.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMentionedIn chains the current query on the mentioned_in edge.
+func (uq *UserQuery) QueryMentionedIn() *CommentQuery {
+	query := &CommentQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.MentionedInTable, user.MentionedInPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity in the query. Returns *NotFoundError when no user was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
 	us, err := uq.Limit(1).All(ctx)
@@ -231,6 +353,72 @@ func (uq *UserQuery) Clone() *UserQuery {
 	}
 }
 
+//  WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithOrganization(opts ...func(*OrganizationQuery)) *UserQuery {
+	query := &OrganizationQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withOrganization = query
+	return uq
+}
+
+//  WithReportedSlips tells the query-builder to eager-loads the nodes that are connected to
+// the "reported_slips" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithReportedSlips(opts ...func(*RepairSlipQuery)) *UserQuery {
+	query := &RepairSlipQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withReportedSlips = query
+	return uq
+}
+
+//  WithAssignedSlips tells the query-builder to eager-loads the nodes that are connected to
+// the "assigned_slips" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithAssignedSlips(opts ...func(*RepairSlipQuery)) *UserQuery {
+	query := &RepairSlipQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAssignedSlips = query
+	return uq
+}
+
+//  WithInvoices tells the query-builder to eager-loads the nodes that are connected to
+// the "invoices" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithInvoices(opts ...func(*InvoiceQuery)) *UserQuery {
+	query := &InvoiceQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withInvoices = query
+	return uq
+}
+
+//  WithAttachments tells the query-builder to eager-loads the nodes that are connected to
+// the "attachments" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithAttachments(opts ...func(*AttachmentQuery)) *UserQuery {
+	query := &AttachmentQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAttachments = query
+	return uq
+}
+
+//  WithMentionedIn tells the query-builder to eager-loads the nodes that are connected to
+// the "mentioned_in" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithMentionedIn(opts ...func(*CommentQuery)) *UserQuery {
+	query := &CommentQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMentionedIn = query
+	return uq
+}
+
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -290,18 +478,39 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 		}
 		uq.sql = prev
 	}
+	if err := user.Policy.EvalQuery(ctx, uq); err != nil {
+		return err
+	}
 	return nil
 }
 
 func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	var (
-		nodes = []*User{}
-		_spec = uq.querySpec()
+		nodes       = []*User{}
+		withFKs     = uq.withFKs
+		_spec       = uq.querySpec()
+		loadedTypes = [6]bool{
+			uq.withOrganization != nil,
+			uq.withReportedSlips != nil,
+			uq.withAssignedSlips != nil,
+			uq.withInvoices != nil,
+			uq.withAttachments != nil,
+			uq.withMentionedIn != nil,
+		}
 	)
+	if uq.withOrganization != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, user.ForeignKeys...)
+	}
 	_spec.ScanValues = func() []interface{} {
 		node := &User{config: uq.config}
 		nodes = append(nodes, node)
 		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
 		return values
 	}
 	_spec.Assign = func(values ...interface{}) error {
@@ -309,6 +518,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(values...)
 	}
 	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
@@ -317,6 +527,207 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+
+	if query := uq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*User)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	if query := uq.withReportedSlips; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.RepairSlip(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.ReportedSlipsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_reported_slips
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_reported_slips" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_reported_slips" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.ReportedSlips = append(node.Edges.ReportedSlips, n)
+		}
+	}
+
+	if query := uq.withAssignedSlips; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.RepairSlip(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.AssignedSlipsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_assigned_slips
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_assigned_slips" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_assigned_slips" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.AssignedSlips = append(node.Edges.AssignedSlips, n)
+		}
+	}
+
+	if query := uq.withInvoices; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Invoice(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.InvoicesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_invoices
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_invoices" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_invoices" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Invoices = append(node.Edges.Invoices, n)
+		}
+	}
+
+	if query := uq.withAttachments; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Attachment(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.AttachmentsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_attachments
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_attachments" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_attachments" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Attachments = append(node.Edges.Attachments, n)
+		}
+	}
+
+	if query := uq.withMentionedIn; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*User, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*User)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: true,
+				Table:   user.MentionedInTable,
+				Columns: user.MentionedInPrimaryKey,
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.InValues(user.MentionedInPrimaryKey[1], fks...))
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				edgeids = append(edgeids, inValue)
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		if err := sqlgraph.QueryEdges(ctx, uq.driver, _spec); err != nil {
+			return nil, fmt.Errorf(`query edges "mentioned_in": %v`, err)
+		}
+		query.Where(comment.IDIn(edgeids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := edges[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "mentioned_in" node returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.MentionedIn = append(nodes[i].Edges.MentionedIn, n)
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
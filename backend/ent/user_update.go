@@ -6,7 +6,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/darksford123x/app/ent/attachment"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/organization"
 	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
 	"github.com/darksford123x/app/ent/user"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -46,11 +51,329 @@ func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	return uu
 }
 
+// SetRole sets the role field.
+func (uu *UserUpdate) SetRole(u user.Role) *UserUpdate {
+	uu.mutation.SetRole(u)
+	return uu
+}
+
+// SetNillableRole sets the role field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
+	if u != nil {
+		uu.SetRole(*u)
+	}
+	return uu
+}
+
+// SetSkill sets the skill field.
+func (uu *UserUpdate) SetSkill(s string) *UserUpdate {
+	uu.mutation.SetSkill(s)
+	return uu
+}
+
+// SetNillableSkill sets the skill field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSkill(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetSkill(*s)
+	}
+	return uu
+}
+
+// ClearSkill clears the value of skill.
+func (uu *UserUpdate) ClearSkill() *UserUpdate {
+	uu.mutation.ClearSkill()
+	return uu
+}
+
+// SetDepartment sets the department field.
+func (uu *UserUpdate) SetDepartment(s string) *UserUpdate {
+	uu.mutation.SetDepartment(s)
+	return uu
+}
+
+// SetNillableDepartment sets the department field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDepartment(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetDepartment(*s)
+	}
+	return uu
+}
+
+// ClearDepartment clears the value of department.
+func (uu *UserUpdate) ClearDepartment() *UserUpdate {
+	uu.mutation.ClearDepartment()
+	return uu
+}
+
+// SetEmail sets the email field.
+func (uu *UserUpdate) SetEmail(s string) *UserUpdate {
+	uu.mutation.SetEmail(s)
+	return uu
+}
+
+// SetNillableEmail sets the email field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetEmail(*s)
+	}
+	return uu
+}
+
+// ClearEmail clears the value of email.
+func (uu *UserUpdate) ClearEmail() *UserUpdate {
+	uu.mutation.ClearEmail()
+	return uu
+}
+
+// SetLocale sets the locale field.
+func (uu *UserUpdate) SetLocale(u user.Locale) *UserUpdate {
+	uu.mutation.SetLocale(u)
+	return uu
+}
+
+// SetNillableLocale sets the locale field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLocale(u *user.Locale) *UserUpdate {
+	if u != nil {
+		uu.SetLocale(*u)
+	}
+	return uu
+}
+
+// SetPasswordHash sets the password_hash field.
+func (uu *UserUpdate) SetPasswordHash(s string) *UserUpdate {
+	uu.mutation.SetPasswordHash(s)
+	return uu
+}
+
+// SetNillablePasswordHash sets the password_hash field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePasswordHash(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPasswordHash(*s)
+	}
+	return uu
+}
+
+// ClearPasswordHash clears the value of password_hash.
+func (uu *UserUpdate) ClearPasswordHash() *UserUpdate {
+	uu.mutation.ClearPasswordHash()
+	return uu
+}
+
+// SetDisabled sets the disabled field.
+func (uu *UserUpdate) SetDisabled(b bool) *UserUpdate {
+	uu.mutation.SetDisabled(b)
+	return uu
+}
+
+// SetNillableDisabled sets the disabled field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDisabled(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetDisabled(*b)
+	}
+	return uu
+}
+
+// SetAssignmentVersion sets the assignment_version field.
+func (uu *UserUpdate) SetAssignmentVersion(i int) *UserUpdate {
+	uu.mutation.ResetAssignmentVersion()
+	uu.mutation.SetAssignmentVersion(i)
+	return uu
+}
+
+// SetNillableAssignmentVersion sets the assignment_version field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAssignmentVersion(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetAssignmentVersion(*i)
+	}
+	return uu
+}
+
+// AddAssignmentVersion adds i to assignment_version.
+func (uu *UserUpdate) AddAssignmentVersion(i int) *UserUpdate {
+	uu.mutation.AddAssignmentVersion(i)
+	return uu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (uu *UserUpdate) SetOrganizationID(id int) *UserUpdate {
+	uu.mutation.SetOrganizationID(id)
+	return uu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (uu *UserUpdate) SetNillableOrganizationID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetOrganizationID(*id)
+	}
+	return uu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (uu *UserUpdate) SetOrganization(o *Organization) *UserUpdate {
+	return uu.SetOrganizationID(o.ID)
+}
+
+// AddReportedSlipIDs adds the reported_slips edge to RepairSlip by ids.
+func (uu *UserUpdate) AddReportedSlipIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddReportedSlipIDs(ids...)
+	return uu
+}
+
+// AddReportedSlips adds the reported_slips edges to RepairSlip.
+func (uu *UserUpdate) AddReportedSlips(r ...*RepairSlip) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddReportedSlipIDs(ids...)
+}
+
+// AddAssignedSlipIDs adds the assigned_slips edge to RepairSlip by ids.
+func (uu *UserUpdate) AddAssignedSlipIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAssignedSlipIDs(ids...)
+	return uu
+}
+
+// AddAssignedSlips adds the assigned_slips edges to RepairSlip.
+func (uu *UserUpdate) AddAssignedSlips(r ...*RepairSlip) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddAssignedSlipIDs(ids...)
+}
+
+// AddInvoiceIDs adds the invoices edge to Invoice by ids.
+func (uu *UserUpdate) AddInvoiceIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddInvoiceIDs(ids...)
+	return uu
+}
+
+// AddInvoices adds the invoices edges to Invoice.
+func (uu *UserUpdate) AddInvoices(i ...*Invoice) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddInvoiceIDs(ids...)
+}
+
+// AddAttachmentIDs adds the attachments edge to Attachment by ids.
+func (uu *UserUpdate) AddAttachmentIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAttachmentIDs(ids...)
+	return uu
+}
+
+// AddAttachments adds the attachments edges to Attachment.
+func (uu *UserUpdate) AddAttachments(a ...*Attachment) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAttachmentIDs(ids...)
+}
+
+// AddMentionedInIDs adds the mentioned_in edge to Comment by ids.
+func (uu *UserUpdate) AddMentionedInIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddMentionedInIDs(ids...)
+	return uu
+}
+
+// AddMentionedIn adds the mentioned_in edges to Comment.
+func (uu *UserUpdate) AddMentionedIn(c ...*Comment) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddMentionedInIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
 }
 
+// ClearOrganization clears the organization edge to Organization.
+func (uu *UserUpdate) ClearOrganization() *UserUpdate {
+	uu.mutation.ClearOrganization()
+	return uu
+}
+
+// RemoveReportedSlipIDs removes the reported_slips edge to RepairSlip by ids.
+func (uu *UserUpdate) RemoveReportedSlipIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveReportedSlipIDs(ids...)
+	return uu
+}
+
+// RemoveReportedSlips removes reported_slips edges to RepairSlip.
+func (uu *UserUpdate) RemoveReportedSlips(r ...*RepairSlip) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveReportedSlipIDs(ids...)
+}
+
+// RemoveAssignedSlipIDs removes the assigned_slips edge to RepairSlip by ids.
+func (uu *UserUpdate) RemoveAssignedSlipIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAssignedSlipIDs(ids...)
+	return uu
+}
+
+// RemoveAssignedSlips removes assigned_slips edges to RepairSlip.
+func (uu *UserUpdate) RemoveAssignedSlips(r ...*RepairSlip) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveAssignedSlipIDs(ids...)
+}
+
+// RemoveInvoiceIDs removes the invoices edge to Invoice by ids.
+func (uu *UserUpdate) RemoveInvoiceIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveInvoiceIDs(ids...)
+	return uu
+}
+
+// RemoveInvoices removes invoices edges to Invoice.
+func (uu *UserUpdate) RemoveInvoices(i ...*Invoice) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveInvoiceIDs(ids...)
+}
+
+// RemoveAttachmentIDs removes the attachments edge to Attachment by ids.
+func (uu *UserUpdate) RemoveAttachmentIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAttachmentIDs(ids...)
+	return uu
+}
+
+// RemoveAttachments removes attachments edges to Attachment.
+func (uu *UserUpdate) RemoveAttachments(a ...*Attachment) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAttachmentIDs(ids...)
+}
+
+// RemoveMentionedInIDs removes the mentioned_in edge to Comment by ids.
+func (uu *UserUpdate) RemoveMentionedInIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveMentionedInIDs(ids...)
+	return uu
+}
+
+// RemoveMentionedIn removes mentioned_in edges to Comment.
+func (uu *UserUpdate) RemoveMentionedIn(c ...*Comment) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveMentionedInIDs(ids...)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	if v, ok := uu.mutation.Age(); ok {
@@ -63,6 +386,22 @@ func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 			return 0, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
 		}
 	}
+	if v, ok := uu.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return 0, &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if v, ok := uu.mutation.Locale(); ok {
+		if err := user.LocaleValidator(v); err != nil {
+			return 0, &ValidationError{Name: "locale", err: fmt.Errorf("ent: validator failed for field \"locale\": %w", err)}
+		}
+	}
+	if v, ok := uu.mutation.AssignmentVersion(); ok {
+		if err := user.AssignmentVersionValidator(v); err != nil {
+			return 0, &ValidationError{Name: "assignment_version", err: fmt.Errorf("ent: validator failed for field \"assignment_version\": %w", err)}
+		}
+	}
+
 	var (
 		err      error
 		affected int
@@ -151,6 +490,318 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldName,
 		})
 	}
+	if value, ok := uu.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldRole,
+		})
+	}
+	if value, ok := uu.mutation.Skill(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldSkill,
+		})
+	}
+	if uu.mutation.SkillCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldSkill,
+		})
+	}
+	if value, ok := uu.mutation.Department(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldDepartment,
+		})
+	}
+	if uu.mutation.DepartmentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldDepartment,
+		})
+	}
+	if value, ok := uu.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldEmail,
+		})
+	}
+	if uu.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldEmail,
+		})
+	}
+	if value, ok := uu.mutation.Locale(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldLocale,
+		})
+	}
+	if value, ok := uu.mutation.PasswordHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldPasswordHash,
+		})
+	}
+	if uu.mutation.PasswordHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldPasswordHash,
+		})
+	}
+	if value, ok := uu.mutation.Disabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldDisabled,
+		})
+	}
+	if value, ok := uu.mutation.AssignmentVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAssignmentVersion,
+		})
+	}
+	if value, ok := uu.mutation.AddedAssignmentVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAssignmentVersion,
+		})
+	}
+	if uu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uu.mutation.RemovedReportedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportedSlipsTable,
+			Columns: []string{user.ReportedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ReportedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportedSlipsTable,
+			Columns: []string{user.ReportedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uu.mutation.RemovedAssignedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AssignedSlipsTable,
+			Columns: []string{user.AssignedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AssignedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AssignedSlipsTable,
+			Columns: []string{user.AssignedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uu.mutation.RemovedInvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvoicesTable,
+			Columns: []string{user.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvoicesTable,
+			Columns: []string{user.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uu.mutation.RemovedMentionedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInTable,
+			Columns: user.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MentionedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInTable,
+			Columns: user.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -188,11 +839,329 @@ func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
 	return uuo
 }
 
+// SetRole sets the role field.
+func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
+	uuo.mutation.SetRole(u)
+	return uuo
+}
+
+// SetNillableRole sets the role field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
+	if u != nil {
+		uuo.SetRole(*u)
+	}
+	return uuo
+}
+
+// SetSkill sets the skill field.
+func (uuo *UserUpdateOne) SetSkill(s string) *UserUpdateOne {
+	uuo.mutation.SetSkill(s)
+	return uuo
+}
+
+// SetNillableSkill sets the skill field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSkill(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSkill(*s)
+	}
+	return uuo
+}
+
+// ClearSkill clears the value of skill.
+func (uuo *UserUpdateOne) ClearSkill() *UserUpdateOne {
+	uuo.mutation.ClearSkill()
+	return uuo
+}
+
+// SetDepartment sets the department field.
+func (uuo *UserUpdateOne) SetDepartment(s string) *UserUpdateOne {
+	uuo.mutation.SetDepartment(s)
+	return uuo
+}
+
+// SetNillableDepartment sets the department field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDepartment(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetDepartment(*s)
+	}
+	return uuo
+}
+
+// ClearDepartment clears the value of department.
+func (uuo *UserUpdateOne) ClearDepartment() *UserUpdateOne {
+	uuo.mutation.ClearDepartment()
+	return uuo
+}
+
+// SetEmail sets the email field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the email field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// ClearEmail clears the value of email.
+func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
+	uuo.mutation.ClearEmail()
+	return uuo
+}
+
+// SetLocale sets the locale field.
+func (uuo *UserUpdateOne) SetLocale(u user.Locale) *UserUpdateOne {
+	uuo.mutation.SetLocale(u)
+	return uuo
+}
+
+// SetNillableLocale sets the locale field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLocale(u *user.Locale) *UserUpdateOne {
+	if u != nil {
+		uuo.SetLocale(*u)
+	}
+	return uuo
+}
+
+// SetPasswordHash sets the password_hash field.
+func (uuo *UserUpdateOne) SetPasswordHash(s string) *UserUpdateOne {
+	uuo.mutation.SetPasswordHash(s)
+	return uuo
+}
+
+// SetNillablePasswordHash sets the password_hash field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePasswordHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPasswordHash(*s)
+	}
+	return uuo
+}
+
+// ClearPasswordHash clears the value of password_hash.
+func (uuo *UserUpdateOne) ClearPasswordHash() *UserUpdateOne {
+	uuo.mutation.ClearPasswordHash()
+	return uuo
+}
+
+// SetDisabled sets the disabled field.
+func (uuo *UserUpdateOne) SetDisabled(b bool) *UserUpdateOne {
+	uuo.mutation.SetDisabled(b)
+	return uuo
+}
+
+// SetNillableDisabled sets the disabled field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDisabled(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetDisabled(*b)
+	}
+	return uuo
+}
+
+// SetAssignmentVersion sets the assignment_version field.
+func (uuo *UserUpdateOne) SetAssignmentVersion(i int) *UserUpdateOne {
+	uuo.mutation.ResetAssignmentVersion()
+	uuo.mutation.SetAssignmentVersion(i)
+	return uuo
+}
+
+// SetNillableAssignmentVersion sets the assignment_version field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAssignmentVersion(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetAssignmentVersion(*i)
+	}
+	return uuo
+}
+
+// AddAssignmentVersion adds i to assignment_version.
+func (uuo *UserUpdateOne) AddAssignmentVersion(i int) *UserUpdateOne {
+	uuo.mutation.AddAssignmentVersion(i)
+	return uuo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (uuo *UserUpdateOne) SetOrganizationID(id int) *UserUpdateOne {
+	uuo.mutation.SetOrganizationID(id)
+	return uuo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableOrganizationID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetOrganizationID(*id)
+	}
+	return uuo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (uuo *UserUpdateOne) SetOrganization(o *Organization) *UserUpdateOne {
+	return uuo.SetOrganizationID(o.ID)
+}
+
+// AddReportedSlipIDs adds the reported_slips edge to RepairSlip by ids.
+func (uuo *UserUpdateOne) AddReportedSlipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddReportedSlipIDs(ids...)
+	return uuo
+}
+
+// AddReportedSlips adds the reported_slips edges to RepairSlip.
+func (uuo *UserUpdateOne) AddReportedSlips(r ...*RepairSlip) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddReportedSlipIDs(ids...)
+}
+
+// AddAssignedSlipIDs adds the assigned_slips edge to RepairSlip by ids.
+func (uuo *UserUpdateOne) AddAssignedSlipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAssignedSlipIDs(ids...)
+	return uuo
+}
+
+// AddAssignedSlips adds the assigned_slips edges to RepairSlip.
+func (uuo *UserUpdateOne) AddAssignedSlips(r ...*RepairSlip) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddAssignedSlipIDs(ids...)
+}
+
+// AddInvoiceIDs adds the invoices edge to Invoice by ids.
+func (uuo *UserUpdateOne) AddInvoiceIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddInvoiceIDs(ids...)
+	return uuo
+}
+
+// AddInvoices adds the invoices edges to Invoice.
+func (uuo *UserUpdateOne) AddInvoices(i ...*Invoice) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddInvoiceIDs(ids...)
+}
+
+// AddAttachmentIDs adds the attachments edge to Attachment by ids.
+func (uuo *UserUpdateOne) AddAttachmentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAttachmentIDs(ids...)
+	return uuo
+}
+
+// AddAttachments adds the attachments edges to Attachment.
+func (uuo *UserUpdateOne) AddAttachments(a ...*Attachment) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAttachmentIDs(ids...)
+}
+
+// AddMentionedInIDs adds the mentioned_in edge to Comment by ids.
+func (uuo *UserUpdateOne) AddMentionedInIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddMentionedInIDs(ids...)
+	return uuo
+}
+
+// AddMentionedIn adds the mentioned_in edges to Comment.
+func (uuo *UserUpdateOne) AddMentionedIn(c ...*Comment) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddMentionedInIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
 }
 
+// ClearOrganization clears the organization edge to Organization.
+func (uuo *UserUpdateOne) ClearOrganization() *UserUpdateOne {
+	uuo.mutation.ClearOrganization()
+	return uuo
+}
+
+// RemoveReportedSlipIDs removes the reported_slips edge to RepairSlip by ids.
+func (uuo *UserUpdateOne) RemoveReportedSlipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveReportedSlipIDs(ids...)
+	return uuo
+}
+
+// RemoveReportedSlips removes reported_slips edges to RepairSlip.
+func (uuo *UserUpdateOne) RemoveReportedSlips(r ...*RepairSlip) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveReportedSlipIDs(ids...)
+}
+
+// RemoveAssignedSlipIDs removes the assigned_slips edge to RepairSlip by ids.
+func (uuo *UserUpdateOne) RemoveAssignedSlipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAssignedSlipIDs(ids...)
+	return uuo
+}
+
+// RemoveAssignedSlips removes assigned_slips edges to RepairSlip.
+func (uuo *UserUpdateOne) RemoveAssignedSlips(r ...*RepairSlip) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveAssignedSlipIDs(ids...)
+}
+
+// RemoveInvoiceIDs removes the invoices edge to Invoice by ids.
+func (uuo *UserUpdateOne) RemoveInvoiceIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveInvoiceIDs(ids...)
+	return uuo
+}
+
+// RemoveInvoices removes invoices edges to Invoice.
+func (uuo *UserUpdateOne) RemoveInvoices(i ...*Invoice) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveInvoiceIDs(ids...)
+}
+
+// RemoveAttachmentIDs removes the attachments edge to Attachment by ids.
+func (uuo *UserUpdateOne) RemoveAttachmentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAttachmentIDs(ids...)
+	return uuo
+}
+
+// RemoveAttachments removes attachments edges to Attachment.
+func (uuo *UserUpdateOne) RemoveAttachments(a ...*Attachment) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAttachmentIDs(ids...)
+}
+
+// RemoveMentionedInIDs removes the mentioned_in edge to Comment by ids.
+func (uuo *UserUpdateOne) RemoveMentionedInIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveMentionedInIDs(ids...)
+	return uuo
+}
+
+// RemoveMentionedIn removes mentioned_in edges to Comment.
+func (uuo *UserUpdateOne) RemoveMentionedIn(c ...*Comment) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveMentionedInIDs(ids...)
+}
+
 // Save executes the query and returns the updated entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
 	if v, ok := uuo.mutation.Age(); ok {
@@ -205,6 +1174,22 @@ func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
 			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
 		}
 	}
+	if v, ok := uuo.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return nil, &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if v, ok := uuo.mutation.Locale(); ok {
+		if err := user.LocaleValidator(v); err != nil {
+			return nil, &ValidationError{Name: "locale", err: fmt.Errorf("ent: validator failed for field \"locale\": %w", err)}
+		}
+	}
+	if v, ok := uuo.mutation.AssignmentVersion(); ok {
+		if err := user.AssignmentVersionValidator(v); err != nil {
+			return nil, &ValidationError{Name: "assignment_version", err: fmt.Errorf("ent: validator failed for field \"assignment_version\": %w", err)}
+		}
+	}
+
 	var (
 		err  error
 		node *User
@@ -291,6 +1276,318 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			Column: user.FieldName,
 		})
 	}
+	if value, ok := uuo.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldRole,
+		})
+	}
+	if value, ok := uuo.mutation.Skill(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldSkill,
+		})
+	}
+	if uuo.mutation.SkillCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldSkill,
+		})
+	}
+	if value, ok := uuo.mutation.Department(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldDepartment,
+		})
+	}
+	if uuo.mutation.DepartmentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldDepartment,
+		})
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldEmail,
+		})
+	}
+	if uuo.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldEmail,
+		})
+	}
+	if value, ok := uuo.mutation.Locale(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldLocale,
+		})
+	}
+	if value, ok := uuo.mutation.PasswordHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldPasswordHash,
+		})
+	}
+	if uuo.mutation.PasswordHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldPasswordHash,
+		})
+	}
+	if value, ok := uuo.mutation.Disabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldDisabled,
+		})
+	}
+	if value, ok := uuo.mutation.AssignmentVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAssignmentVersion,
+		})
+	}
+	if value, ok := uuo.mutation.AddedAssignmentVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAssignmentVersion,
+		})
+	}
+	if uuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uuo.mutation.RemovedReportedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportedSlipsTable,
+			Columns: []string{user.ReportedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ReportedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportedSlipsTable,
+			Columns: []string{user.ReportedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uuo.mutation.RemovedAssignedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AssignedSlipsTable,
+			Columns: []string{user.AssignedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AssignedSlipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AssignedSlipsTable,
+			Columns: []string{user.AssignedSlipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: repairslip.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uuo.mutation.RemovedInvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvoicesTable,
+			Columns: []string{user.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvoicesTable,
+			Columns: []string{user.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: attachment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uuo.mutation.RemovedMentionedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInTable,
+			Columns: user.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MentionedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInTable,
+			Columns: user.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: comment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	u = &User{config: uuo.config}
 	_spec.Assign = u.assignValues
 	_spec.ScanValues = u.scanValues()
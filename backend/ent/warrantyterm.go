@@ -0,0 +1,201 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// WarrantyTerm is the model entity for the WarrantyTerm schema.
+type WarrantyTerm struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Months holds the value of the "months" field.
+	Months int `json:"months,omitempty"`
+	// Coverage holds the value of the "coverage" field.
+	Coverage warrantyterm.Coverage `json:"coverage,omitempty"`
+	// ClaimContact holds the value of the "claim_contact" field.
+	ClaimContact string `json:"claim_contact,omitempty"`
+	// Conditions holds the value of the "conditions" field.
+	Conditions string `json:"conditions,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WarrantyTermQuery when eager-loading is set.
+	Edges           WarrantyTermEdges `json:"edges"`
+	organization_id *int
+}
+
+// WarrantyTermEdges holds the relations/edges for other nodes in the graph.
+type WarrantyTermEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Equipment holds the value of the equipment edge.
+	Equipment []*Equipment
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WarrantyTermEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// EquipmentOrErr returns the Equipment value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarrantyTermEdges) EquipmentOrErr() ([]*Equipment, error) {
+	if e.loadedTypes[1] {
+		return e.Equipment, nil
+	}
+	return nil, &NotLoadedError{edge: "equipment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WarrantyTerm) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // name
+		&sql.NullString{}, // provider
+		&sql.NullInt64{},  // months
+		&sql.NullString{}, // coverage
+		&sql.NullString{}, // claim_contact
+		&sql.NullString{}, // conditions
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*WarrantyTerm) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WarrantyTerm fields.
+func (wt *WarrantyTerm) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(warrantyterm.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	wt.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[0])
+	} else if value.Valid {
+		wt.Name = value.String
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field provider", values[1])
+	} else if value.Valid {
+		wt.Provider = value.String
+	}
+	if value, ok := values[2].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field months", values[2])
+	} else if value.Valid {
+		wt.Months = int(value.Int64)
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field coverage", values[3])
+	} else if value.Valid {
+		wt.Coverage = warrantyterm.Coverage(value.String)
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field claim_contact", values[4])
+	} else if value.Valid {
+		wt.ClaimContact = value.String
+	}
+	if value, ok := values[5].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field conditions", values[5])
+	} else if value.Valid {
+		wt.Conditions = value.String
+	}
+	values = values[6:]
+	if len(values) == len(warrantyterm.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			wt.organization_id = new(int)
+			*wt.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the WarrantyTerm.
+func (wt *WarrantyTerm) QueryOrganization() *OrganizationQuery {
+	return (&WarrantyTermClient{config: wt.config}).QueryOrganization(wt)
+}
+
+// QueryEquipment queries the equipment edge of the WarrantyTerm.
+func (wt *WarrantyTerm) QueryEquipment() *EquipmentQuery {
+	return (&WarrantyTermClient{config: wt.config}).QueryEquipment(wt)
+}
+
+// Update returns a builder for updating this WarrantyTerm.
+// Note that, you need to call WarrantyTerm.Unwrap() before calling this method, if this WarrantyTerm
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wt *WarrantyTerm) Update() *WarrantyTermUpdateOne {
+	return (&WarrantyTermClient{config: wt.config}).UpdateOne(wt)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (wt *WarrantyTerm) Unwrap() *WarrantyTerm {
+	tx, ok := wt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WarrantyTerm is not a transactional entity")
+	}
+	wt.config.driver = tx.drv
+	return wt
+}
+
+// String implements the fmt.Stringer.
+func (wt *WarrantyTerm) String() string {
+	var builder strings.Builder
+	builder.WriteString("WarrantyTerm(")
+	builder.WriteString(fmt.Sprintf("id=%v", wt.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(wt.Name)
+	builder.WriteString(", provider=")
+	builder.WriteString(wt.Provider)
+	builder.WriteString(", months=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Months))
+	builder.WriteString(", coverage=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Coverage))
+	builder.WriteString(", claim_contact=")
+	builder.WriteString(wt.ClaimContact)
+	builder.WriteString(", conditions=")
+	builder.WriteString(wt.Conditions)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WarrantyTerms is a parsable slice of WarrantyTerm.
+type WarrantyTerms []*WarrantyTerm
+
+func (wt WarrantyTerms) config(cfg config) {
+	for _i := range wt {
+		wt[_i].config = cfg
+	}
+}
@@ -0,0 +1,109 @@
+// Code generated by entc, DO NOT EDIT.
+
+package warrantyterm
+
+import (
+	"fmt"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the warrantyterm type in the database.
+	Label = "warranty_term"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldMonths holds the string denoting the months field in the database.
+	FieldMonths = "months"
+	// FieldCoverage holds the string denoting the coverage field in the database.
+	FieldCoverage = "coverage"
+	// FieldClaimContact holds the string denoting the claim_contact field in the database.
+	FieldClaimContact = "claim_contact"
+	// FieldConditions holds the string denoting the conditions field in the database.
+	FieldConditions = "conditions"
+
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeEquipment holds the string denoting the equipment edge name in mutations.
+	EdgeEquipment = "equipment"
+
+	// Table holds the table name of the warrantyterm in the database.
+	Table = "warranty_terms"
+	// OrganizationTable is the table the holds the organization relation/edge.
+	OrganizationTable = "warranty_terms"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// EquipmentTable is the table the holds the equipment relation/edge.
+	EquipmentTable = "equipment"
+	// EquipmentInverseTable is the table name for the Equipment entity.
+	// It exists in this package in order to avoid circular dependency with the "equipment" package.
+	EquipmentInverseTable = "equipment"
+	// EquipmentColumn is the table column denoting the equipment relation/edge.
+	EquipmentColumn = "warranty_term_equipment"
+)
+
+// Columns holds all SQL columns for warrantyterm fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldProvider,
+	FieldMonths,
+	FieldCoverage,
+	FieldClaimContact,
+	FieldConditions,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the WarrantyTerm type.
+var ForeignKeys = []string{
+	"organization_id",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/darksford123x/app/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// MonthsValidator is a validator for the "months" field. It is called by the builders before save.
+	MonthsValidator func(int) error
+)
+
+// Coverage defines the type for the coverage enum field.
+type Coverage string
+
+// CoveragePartsAndLabour is the default Coverage.
+const DefaultCoverage = CoveragePartsAndLabour
+
+// Coverage values.
+const (
+	CoverageParts          Coverage = "parts"
+	CoverageLabour         Coverage = "labour"
+	CoveragePartsAndLabour Coverage = "parts_and_labour"
+)
+
+func (c Coverage) String() string {
+	return string(c)
+}
+
+// CoverageValidator is a validator for the "c" field enum values. It is called by the builders before save.
+func CoverageValidator(c Coverage) error {
+	switch c {
+	case CoverageParts, CoverageLabour, CoveragePartsAndLabour:
+		return nil
+	default:
+		return fmt.Errorf("warrantyterm: invalid enum value for coverage field: %q", c)
+	}
+}
@@ -0,0 +1,811 @@
+// Code generated by entc, DO NOT EDIT.
+
+package warrantyterm
+
+import (
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProvider), v))
+	})
+}
+
+// Months applies equality check predicate on the "months" field. It's identical to MonthsEQ.
+func Months(v int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMonths), v))
+	})
+}
+
+// ClaimContact applies equality check predicate on the "claim_contact" field. It's identical to ClaimContactEQ.
+func ClaimContact(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimContact), v))
+	})
+}
+
+// Conditions applies equality check predicate on the "conditions" field. It's identical to ConditionsEQ.
+func Conditions(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConditions), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProvider), v...))
+	})
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProvider), v...))
+	})
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldProvider), v))
+	})
+}
+
+// MonthsEQ applies the EQ predicate on the "months" field.
+func MonthsEQ(v int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMonths), v))
+	})
+}
+
+// MonthsNEQ applies the NEQ predicate on the "months" field.
+func MonthsNEQ(v int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMonths), v))
+	})
+}
+
+// MonthsIn applies the In predicate on the "months" field.
+func MonthsIn(vs ...int) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMonths), v...))
+	})
+}
+
+// MonthsNotIn applies the NotIn predicate on the "months" field.
+func MonthsNotIn(vs ...int) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMonths), v...))
+	})
+}
+
+// MonthsGT applies the GT predicate on the "months" field.
+func MonthsGT(v int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMonths), v))
+	})
+}
+
+// MonthsGTE applies the GTE predicate on the "months" field.
+func MonthsGTE(v int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMonths), v))
+	})
+}
+
+// MonthsLT applies the LT predicate on the "months" field.
+func MonthsLT(v int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMonths), v))
+	})
+}
+
+// MonthsLTE applies the LTE predicate on the "months" field.
+func MonthsLTE(v int) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMonths), v))
+	})
+}
+
+// CoverageEQ applies the EQ predicate on the "coverage" field.
+func CoverageEQ(v Coverage) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageNEQ applies the NEQ predicate on the "coverage" field.
+func CoverageNEQ(v Coverage) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCoverage), v))
+	})
+}
+
+// CoverageIn applies the In predicate on the "coverage" field.
+func CoverageIn(vs ...Coverage) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCoverage), v...))
+	})
+}
+
+// CoverageNotIn applies the NotIn predicate on the "coverage" field.
+func CoverageNotIn(vs ...Coverage) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCoverage), v...))
+	})
+}
+
+// ClaimContactEQ applies the EQ predicate on the "claim_contact" field.
+func ClaimContactEQ(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactNEQ applies the NEQ predicate on the "claim_contact" field.
+func ClaimContactNEQ(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactIn applies the In predicate on the "claim_contact" field.
+func ClaimContactIn(vs ...string) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClaimContact), v...))
+	})
+}
+
+// ClaimContactNotIn applies the NotIn predicate on the "claim_contact" field.
+func ClaimContactNotIn(vs ...string) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClaimContact), v...))
+	})
+}
+
+// ClaimContactGT applies the GT predicate on the "claim_contact" field.
+func ClaimContactGT(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactGTE applies the GTE predicate on the "claim_contact" field.
+func ClaimContactGTE(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactLT applies the LT predicate on the "claim_contact" field.
+func ClaimContactLT(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactLTE applies the LTE predicate on the "claim_contact" field.
+func ClaimContactLTE(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactContains applies the Contains predicate on the "claim_contact" field.
+func ClaimContactContains(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactHasPrefix applies the HasPrefix predicate on the "claim_contact" field.
+func ClaimContactHasPrefix(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactHasSuffix applies the HasSuffix predicate on the "claim_contact" field.
+func ClaimContactHasSuffix(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactIsNil applies the IsNil predicate on the "claim_contact" field.
+func ClaimContactIsNil() predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldClaimContact)))
+	})
+}
+
+// ClaimContactNotNil applies the NotNil predicate on the "claim_contact" field.
+func ClaimContactNotNil() predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldClaimContact)))
+	})
+}
+
+// ClaimContactEqualFold applies the EqualFold predicate on the "claim_contact" field.
+func ClaimContactEqualFold(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldClaimContact), v))
+	})
+}
+
+// ClaimContactContainsFold applies the ContainsFold predicate on the "claim_contact" field.
+func ClaimContactContainsFold(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldClaimContact), v))
+	})
+}
+
+// ConditionsEQ applies the EQ predicate on the "conditions" field.
+func ConditionsEQ(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsNEQ applies the NEQ predicate on the "conditions" field.
+func ConditionsNEQ(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsIn applies the In predicate on the "conditions" field.
+func ConditionsIn(vs ...string) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConditions), v...))
+	})
+}
+
+// ConditionsNotIn applies the NotIn predicate on the "conditions" field.
+func ConditionsNotIn(vs ...string) predicate.WarrantyTerm {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConditions), v...))
+	})
+}
+
+// ConditionsGT applies the GT predicate on the "conditions" field.
+func ConditionsGT(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsGTE applies the GTE predicate on the "conditions" field.
+func ConditionsGTE(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsLT applies the LT predicate on the "conditions" field.
+func ConditionsLT(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsLTE applies the LTE predicate on the "conditions" field.
+func ConditionsLTE(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsContains applies the Contains predicate on the "conditions" field.
+func ConditionsContains(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsHasPrefix applies the HasPrefix predicate on the "conditions" field.
+func ConditionsHasPrefix(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsHasSuffix applies the HasSuffix predicate on the "conditions" field.
+func ConditionsHasSuffix(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsIsNil applies the IsNil predicate on the "conditions" field.
+func ConditionsIsNil() predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldConditions)))
+	})
+}
+
+// ConditionsNotNil applies the NotNil predicate on the "conditions" field.
+func ConditionsNotNil() predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldConditions)))
+	})
+}
+
+// ConditionsEqualFold applies the EqualFold predicate on the "conditions" field.
+func ConditionsEqualFold(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldConditions), v))
+	})
+}
+
+// ConditionsContainsFold applies the ContainsFold predicate on the "conditions" field.
+func ConditionsContainsFold(v string) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldConditions), v))
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEquipment applies the HasEdge predicate on the "equipment" edge.
+func HasEquipment() predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EquipmentTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EquipmentTable, EquipmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEquipmentWith applies the HasEdge predicate on the "equipment" edge with a given conditions (other predicates).
+func HasEquipmentWith(preds ...predicate.Equipment) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EquipmentInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EquipmentTable, EquipmentColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.WarrantyTerm) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.WarrantyTerm) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WarrantyTerm) predicate.WarrantyTerm {
+	return predicate.WarrantyTerm(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,305 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// WarrantyTermCreate is the builder for creating a WarrantyTerm entity.
+type WarrantyTermCreate struct {
+	config
+	mutation *WarrantyTermMutation
+	hooks    []Hook
+}
+
+// SetName sets the name field.
+func (wtc *WarrantyTermCreate) SetName(s string) *WarrantyTermCreate {
+	wtc.mutation.SetName(s)
+	return wtc
+}
+
+// SetProvider sets the provider field.
+func (wtc *WarrantyTermCreate) SetProvider(s string) *WarrantyTermCreate {
+	wtc.mutation.SetProvider(s)
+	return wtc
+}
+
+// SetMonths sets the months field.
+func (wtc *WarrantyTermCreate) SetMonths(i int) *WarrantyTermCreate {
+	wtc.mutation.SetMonths(i)
+	return wtc
+}
+
+// SetCoverage sets the coverage field.
+func (wtc *WarrantyTermCreate) SetCoverage(w warrantyterm.Coverage) *WarrantyTermCreate {
+	wtc.mutation.SetCoverage(w)
+	return wtc
+}
+
+// SetNillableCoverage sets the coverage field if the given value is not nil.
+func (wtc *WarrantyTermCreate) SetNillableCoverage(w *warrantyterm.Coverage) *WarrantyTermCreate {
+	if w != nil {
+		wtc.SetCoverage(*w)
+	}
+	return wtc
+}
+
+// SetClaimContact sets the claim_contact field.
+func (wtc *WarrantyTermCreate) SetClaimContact(s string) *WarrantyTermCreate {
+	wtc.mutation.SetClaimContact(s)
+	return wtc
+}
+
+// SetNillableClaimContact sets the claim_contact field if the given value is not nil.
+func (wtc *WarrantyTermCreate) SetNillableClaimContact(s *string) *WarrantyTermCreate {
+	if s != nil {
+		wtc.SetClaimContact(*s)
+	}
+	return wtc
+}
+
+// SetConditions sets the conditions field.
+func (wtc *WarrantyTermCreate) SetConditions(s string) *WarrantyTermCreate {
+	wtc.mutation.SetConditions(s)
+	return wtc
+}
+
+// SetNillableConditions sets the conditions field if the given value is not nil.
+func (wtc *WarrantyTermCreate) SetNillableConditions(s *string) *WarrantyTermCreate {
+	if s != nil {
+		wtc.SetConditions(*s)
+	}
+	return wtc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (wtc *WarrantyTermCreate) SetOrganizationID(id int) *WarrantyTermCreate {
+	wtc.mutation.SetOrganizationID(id)
+	return wtc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (wtc *WarrantyTermCreate) SetNillableOrganizationID(id *int) *WarrantyTermCreate {
+	if id != nil {
+		wtc = wtc.SetOrganizationID(*id)
+	}
+	return wtc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (wtc *WarrantyTermCreate) SetOrganization(o *Organization) *WarrantyTermCreate {
+	return wtc.SetOrganizationID(o.ID)
+}
+
+// AddEquipmentIDs adds the equipment edge to Equipment by ids.
+func (wtc *WarrantyTermCreate) AddEquipmentIDs(ids ...int) *WarrantyTermCreate {
+	wtc.mutation.AddEquipmentIDs(ids...)
+	return wtc
+}
+
+// AddEquipment adds the equipment edges to Equipment.
+func (wtc *WarrantyTermCreate) AddEquipment(e ...*Equipment) *WarrantyTermCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return wtc.AddEquipmentIDs(ids...)
+}
+
+// Mutation returns the WarrantyTermMutation object of the builder.
+func (wtc *WarrantyTermCreate) Mutation() *WarrantyTermMutation {
+	return wtc.mutation
+}
+
+// Save creates the WarrantyTerm in the database.
+func (wtc *WarrantyTermCreate) Save(ctx context.Context) (*WarrantyTerm, error) {
+	if _, ok := wtc.mutation.Name(); !ok {
+		return nil, &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := wtc.mutation.Name(); ok {
+		if err := warrantyterm.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := wtc.mutation.Provider(); !ok {
+		return nil, &ValidationError{Name: "provider", err: errors.New("ent: missing required field \"provider\"")}
+	}
+	if v, ok := wtc.mutation.Provider(); ok {
+		if err := warrantyterm.ProviderValidator(v); err != nil {
+			return nil, &ValidationError{Name: "provider", err: fmt.Errorf("ent: validator failed for field \"provider\": %w", err)}
+		}
+	}
+	if _, ok := wtc.mutation.Months(); !ok {
+		return nil, &ValidationError{Name: "months", err: errors.New("ent: missing required field \"months\"")}
+	}
+	if v, ok := wtc.mutation.Months(); ok {
+		if err := warrantyterm.MonthsValidator(v); err != nil {
+			return nil, &ValidationError{Name: "months", err: fmt.Errorf("ent: validator failed for field \"months\": %w", err)}
+		}
+	}
+	if _, ok := wtc.mutation.Coverage(); !ok {
+		v := warrantyterm.DefaultCoverage
+		wtc.mutation.SetCoverage(v)
+	}
+	if v, ok := wtc.mutation.Coverage(); ok {
+		if err := warrantyterm.CoverageValidator(v); err != nil {
+			return nil, &ValidationError{Name: "coverage", err: fmt.Errorf("ent: validator failed for field \"coverage\": %w", err)}
+		}
+	}
+	var (
+		err  error
+		node *WarrantyTerm
+	)
+	if len(wtc.hooks) == 0 {
+		node, err = wtc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WarrantyTermMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wtc.mutation = mutation
+			node, err = wtc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wtc.hooks) - 1; i >= 0; i-- {
+			mut = wtc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wtc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wtc *WarrantyTermCreate) SaveX(ctx context.Context) *WarrantyTerm {
+	v, err := wtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wtc *WarrantyTermCreate) sqlSave(ctx context.Context) (*WarrantyTerm, error) {
+	wt, _spec := wtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wtc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	wt.ID = int(id)
+	return wt, nil
+}
+
+func (wtc *WarrantyTermCreate) createSpec() (*WarrantyTerm, *sqlgraph.CreateSpec) {
+	var (
+		wt    = &WarrantyTerm{config: wtc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: warrantyterm.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: warrantyterm.FieldID,
+			},
+		}
+	)
+	if value, ok := wtc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldName,
+		})
+		wt.Name = value
+	}
+	if value, ok := wtc.mutation.Provider(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldProvider,
+		})
+		wt.Provider = value
+	}
+	if value, ok := wtc.mutation.Months(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantyterm.FieldMonths,
+		})
+		wt.Months = value
+	}
+	if value, ok := wtc.mutation.Coverage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: warrantyterm.FieldCoverage,
+		})
+		wt.Coverage = value
+	}
+	if value, ok := wtc.mutation.ClaimContact(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldClaimContact,
+		})
+		wt.ClaimContact = value
+	}
+	if value, ok := wtc.mutation.Conditions(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldConditions,
+		})
+		wt.Conditions = value
+	}
+	if nodes := wtc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   warrantyterm.OrganizationTable,
+			Columns: []string{warrantyterm.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wtc.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantyterm.EquipmentTable,
+			Columns: []string{warrantyterm.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return wt, _spec
+}
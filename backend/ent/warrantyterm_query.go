@@ -0,0 +1,1003 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// WarrantyTermQuery is the builder for querying WarrantyTerm entities.
+type WarrantyTermQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.WarrantyTerm
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withEquipment    *EquipmentQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (wtq *WarrantyTermQuery) Where(ps ...predicate.WarrantyTerm) *WarrantyTermQuery {
+	wtq.predicates = append(wtq.predicates, ps...)
+	return wtq
+}
+
+// Limit adds a limit step to the query.
+func (wtq *WarrantyTermQuery) Limit(limit int) *WarrantyTermQuery {
+	wtq.limit = &limit
+	return wtq
+}
+
+// Offset adds an offset step to the query.
+func (wtq *WarrantyTermQuery) Offset(offset int) *WarrantyTermQuery {
+	wtq.offset = &offset
+	return wtq
+}
+
+// Order adds an order step to the query.
+func (wtq *WarrantyTermQuery) Order(o ...OrderFunc) *WarrantyTermQuery {
+	wtq.order = append(wtq.order, o...)
+	return wtq
+}
+
+// QueryOrganization chains the current query on the organization edge.
+func (wtq *WarrantyTermQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: wtq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warrantyterm.Table, warrantyterm.FieldID, wtq.sqlQuery()),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, warrantyterm.OrganizationTable, warrantyterm.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEquipment chains the current query on the equipment edge.
+func (wtq *WarrantyTermQuery) QueryEquipment() *EquipmentQuery {
+	query := &EquipmentQuery{config: wtq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warrantyterm.Table, warrantyterm.FieldID, wtq.sqlQuery()),
+			sqlgraph.To(equipment.Table, equipment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warrantyterm.EquipmentTable, warrantyterm.EquipmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WarrantyTerm entity in the query. Returns *NotFoundError when no warrantyterm was found.
+func (wtq *WarrantyTermQuery) First(ctx context.Context) (*WarrantyTerm, error) {
+	wts, err := wtq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(wts) == 0 {
+		return nil, &NotFoundError{warrantyterm.Label}
+	}
+	return wts[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wtq *WarrantyTermQuery) FirstX(ctx context.Context) *WarrantyTerm {
+	wt, err := wtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return wt
+}
+
+// FirstID returns the first WarrantyTerm id in the query. Returns *NotFoundError when no id was found.
+func (wtq *WarrantyTermQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wtq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{warrantyterm.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (wtq *WarrantyTermQuery) FirstXID(ctx context.Context) int {
+	id, err := wtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only WarrantyTerm entity in the query, returns an error if not exactly one entity was returned.
+func (wtq *WarrantyTermQuery) Only(ctx context.Context) (*WarrantyTerm, error) {
+	wts, err := wtq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(wts) {
+	case 1:
+		return wts[0], nil
+	case 0:
+		return nil, &NotFoundError{warrantyterm.Label}
+	default:
+		return nil, &NotSingularError{warrantyterm.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wtq *WarrantyTermQuery) OnlyX(ctx context.Context) *WarrantyTerm {
+	wt, err := wtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return wt
+}
+
+// OnlyID returns the only WarrantyTerm id in the query, returns an error if not exactly one id was returned.
+func (wtq *WarrantyTermQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wtq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{warrantyterm.Label}
+	default:
+		err = &NotSingularError{warrantyterm.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wtq *WarrantyTermQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WarrantyTerms.
+func (wtq *WarrantyTermQuery) All(ctx context.Context) ([]*WarrantyTerm, error) {
+	if err := wtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wtq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wtq *WarrantyTermQuery) AllX(ctx context.Context) []*WarrantyTerm {
+	wts, err := wtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return wts
+}
+
+// IDs executes the query and returns a list of WarrantyTerm ids.
+func (wtq *WarrantyTermQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wtq.Select(warrantyterm.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wtq *WarrantyTermQuery) IDsX(ctx context.Context) []int {
+	ids, err := wtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wtq *WarrantyTermQuery) Count(ctx context.Context) (int, error) {
+	if err := wtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wtq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wtq *WarrantyTermQuery) CountX(ctx context.Context) int {
+	count, err := wtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wtq *WarrantyTermQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wtq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wtq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wtq *WarrantyTermQuery) ExistX(ctx context.Context) bool {
+	exist, err := wtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wtq *WarrantyTermQuery) Clone() *WarrantyTermQuery {
+	return &WarrantyTermQuery{
+		config:     wtq.config,
+		limit:      wtq.limit,
+		offset:     wtq.offset,
+		order:      append([]OrderFunc{}, wtq.order...),
+		unique:     append([]string{}, wtq.unique...),
+		predicates: append([]predicate.WarrantyTerm{}, wtq.predicates...),
+		// clone intermediate query.
+		sql:  wtq.sql.Clone(),
+		path: wtq.path,
+	}
+}
+
+//  WithOrganization tells the query-builder to eager-loads the nodes that are connected to
+// the "organization" edge. The optional arguments used to configure the query builder of the edge.
+func (wtq *WarrantyTermQuery) WithOrganization(opts ...func(*OrganizationQuery)) *WarrantyTermQuery {
+	query := &OrganizationQuery{config: wtq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wtq.withOrganization = query
+	return wtq
+}
+
+//  WithEquipment tells the query-builder to eager-loads the nodes that are connected to
+// the "equipment" edge. The optional arguments used to configure the query builder of the edge.
+func (wtq *WarrantyTermQuery) WithEquipment(opts ...func(*EquipmentQuery)) *WarrantyTermQuery {
+	query := &EquipmentQuery{config: wtq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wtq.withEquipment = query
+	return wtq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WarrantyTerm.Query().
+//		GroupBy(warrantyterm.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (wtq *WarrantyTermQuery) GroupBy(field string, fields ...string) *WarrantyTermGroupBy {
+	group := &WarrantyTermGroupBy{config: wtq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wtq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.WarrantyTerm.Query().
+//		Select(warrantyterm.FieldName).
+//		Scan(ctx, &v)
+//
+func (wtq *WarrantyTermQuery) Select(field string, fields ...string) *WarrantyTermSelect {
+	selector := &WarrantyTermSelect{config: wtq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wtq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (wtq *WarrantyTermQuery) prepareQuery(ctx context.Context) error {
+	if wtq.path != nil {
+		prev, err := wtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wtq.sql = prev
+	}
+	if err := warrantyterm.Policy.EvalQuery(ctx, wtq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (wtq *WarrantyTermQuery) sqlAll(ctx context.Context) ([]*WarrantyTerm, error) {
+	var (
+		nodes       = []*WarrantyTerm{}
+		withFKs     = wtq.withFKs
+		_spec       = wtq.querySpec()
+		loadedTypes = [2]bool{
+			wtq.withOrganization != nil,
+			wtq.withEquipment != nil,
+		}
+	)
+	if wtq.withOrganization != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, warrantyterm.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &WarrantyTerm{config: wtq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := wtq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*WarrantyTerm)
+		for i := range nodes {
+			if fk := nodes[i].organization_id; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	if query := wtq.withEquipment; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*WarrantyTerm)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Equipment(func(s *sql.Selector) {
+			s.Where(sql.InValues(warrantyterm.EquipmentColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.warranty_term_equipment
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "warranty_term_equipment" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "warranty_term_equipment" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Equipment = append(node.Edges.Equipment, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (wtq *WarrantyTermQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wtq.querySpec()
+	return sqlgraph.CountNodes(ctx, wtq.driver, _spec)
+}
+
+func (wtq *WarrantyTermQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wtq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (wtq *WarrantyTermQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   warrantyterm.Table,
+			Columns: warrantyterm.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: warrantyterm.FieldID,
+			},
+		},
+		From:   wtq.sql,
+		Unique: true,
+	}
+	if ps := wtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wtq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wtq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wtq *WarrantyTermQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(wtq.driver.Dialect())
+	t1 := builder.Table(warrantyterm.Table)
+	selector := builder.Select(t1.Columns(warrantyterm.Columns...)...).From(t1)
+	if wtq.sql != nil {
+		selector = wtq.sql
+		selector.Select(selector.Columns(warrantyterm.Columns...)...)
+	}
+	for _, p := range wtq.predicates {
+		p(selector)
+	}
+	for _, p := range wtq.order {
+		p(selector)
+	}
+	if offset := wtq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wtq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WarrantyTermGroupBy is the builder for group-by WarrantyTerm entities.
+type WarrantyTermGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wtgb *WarrantyTermGroupBy) Aggregate(fns ...AggregateFunc) *WarrantyTermGroupBy {
+	wtgb.fns = append(wtgb.fns, fns...)
+	return wtgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (wtgb *WarrantyTermGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wtgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wtgb.sql = query
+	return wtgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wtgb *WarrantyTermGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wtgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (wtgb *WarrantyTermGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wtgb.fields) > 1 {
+		return nil, errors.New("ent: WarrantyTermGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wtgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wtgb *WarrantyTermGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wtgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (wtgb *WarrantyTermGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wtgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{warrantyterm.Label}
+	default:
+		err = fmt.Errorf("ent: WarrantyTermGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wtgb *WarrantyTermGroupBy) StringX(ctx context.Context) string {
+	v, err := wtgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (wtgb *WarrantyTermGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wtgb.fields) > 1 {
+		return nil, errors.New("ent: WarrantyTermGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wtgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wtgb *WarrantyTermGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wtgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (wtgb *WarrantyTermGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wtgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{warrantyterm.Label}
+	default:
+		err = fmt.Errorf("ent: WarrantyTermGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wtgb *WarrantyTermGroupBy) IntX(ctx context.Context) int {
+	v, err := wtgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (wtgb *WarrantyTermGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wtgb.fields) > 1 {
+		return nil, errors.New("ent: WarrantyTermGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wtgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wtgb *WarrantyTermGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wtgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (wtgb *WarrantyTermGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wtgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{warrantyterm.Label}
+	default:
+		err = fmt.Errorf("ent: WarrantyTermGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wtgb *WarrantyTermGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wtgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (wtgb *WarrantyTermGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wtgb.fields) > 1 {
+		return nil, errors.New("ent: WarrantyTermGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wtgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wtgb *WarrantyTermGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wtgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (wtgb *WarrantyTermGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wtgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{warrantyterm.Label}
+	default:
+		err = fmt.Errorf("ent: WarrantyTermGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wtgb *WarrantyTermGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wtgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wtgb *WarrantyTermGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wtgb.sqlQuery().Query()
+	if err := wtgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wtgb *WarrantyTermGroupBy) sqlQuery() *sql.Selector {
+	selector := wtgb.sql
+	columns := make([]string, 0, len(wtgb.fields)+len(wtgb.fns))
+	columns = append(columns, wtgb.fields...)
+	for _, fn := range wtgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(wtgb.fields...)
+}
+
+// WarrantyTermSelect is the builder for select fields of WarrantyTerm entities.
+type WarrantyTermSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (wts *WarrantyTermSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := wts.path(ctx)
+	if err != nil {
+		return err
+	}
+	wts.sql = query
+	return wts.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wts *WarrantyTermSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := wts.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (wts *WarrantyTermSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(wts.fields) > 1 {
+		return nil, errors.New("ent: WarrantyTermSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := wts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wts *WarrantyTermSelect) StringsX(ctx context.Context) []string {
+	v, err := wts.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (wts *WarrantyTermSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wts.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{warrantyterm.Label}
+	default:
+		err = fmt.Errorf("ent: WarrantyTermSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wts *WarrantyTermSelect) StringX(ctx context.Context) string {
+	v, err := wts.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (wts *WarrantyTermSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(wts.fields) > 1 {
+		return nil, errors.New("ent: WarrantyTermSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := wts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wts *WarrantyTermSelect) IntsX(ctx context.Context) []int {
+	v, err := wts.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (wts *WarrantyTermSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wts.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{warrantyterm.Label}
+	default:
+		err = fmt.Errorf("ent: WarrantyTermSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wts *WarrantyTermSelect) IntX(ctx context.Context) int {
+	v, err := wts.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (wts *WarrantyTermSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wts.fields) > 1 {
+		return nil, errors.New("ent: WarrantyTermSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := wts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wts *WarrantyTermSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := wts.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (wts *WarrantyTermSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wts.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{warrantyterm.Label}
+	default:
+		err = fmt.Errorf("ent: WarrantyTermSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wts *WarrantyTermSelect) Float64X(ctx context.Context) float64 {
+	v, err := wts.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (wts *WarrantyTermSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(wts.fields) > 1 {
+		return nil, errors.New("ent: WarrantyTermSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := wts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wts *WarrantyTermSelect) BoolsX(ctx context.Context) []bool {
+	v, err := wts.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (wts *WarrantyTermSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wts.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{warrantyterm.Label}
+	default:
+		err = fmt.Errorf("ent: WarrantyTermSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wts *WarrantyTermSelect) BoolX(ctx context.Context) bool {
+	v, err := wts.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wts *WarrantyTermSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wts.sqlQuery().Query()
+	if err := wts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wts *WarrantyTermSelect) sqlQuery() sql.Querier {
+	selector := wts.sql
+	selector.Select(selector.Columns(wts.fields...)...)
+	return selector
+}
@@ -0,0 +1,786 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// WarrantyTermUpdate is the builder for updating WarrantyTerm entities.
+type WarrantyTermUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *WarrantyTermMutation
+	predicates []predicate.WarrantyTerm
+}
+
+// Where adds a new predicate for the builder.
+func (wtu *WarrantyTermUpdate) Where(ps ...predicate.WarrantyTerm) *WarrantyTermUpdate {
+	wtu.predicates = append(wtu.predicates, ps...)
+	return wtu
+}
+
+// SetName sets the name field.
+func (wtu *WarrantyTermUpdate) SetName(s string) *WarrantyTermUpdate {
+	wtu.mutation.SetName(s)
+	return wtu
+}
+
+// SetProvider sets the provider field.
+func (wtu *WarrantyTermUpdate) SetProvider(s string) *WarrantyTermUpdate {
+	wtu.mutation.SetProvider(s)
+	return wtu
+}
+
+// SetMonths sets the months field.
+func (wtu *WarrantyTermUpdate) SetMonths(i int) *WarrantyTermUpdate {
+	wtu.mutation.ResetMonths()
+	wtu.mutation.SetMonths(i)
+	return wtu
+}
+
+// AddMonths adds i to months.
+func (wtu *WarrantyTermUpdate) AddMonths(i int) *WarrantyTermUpdate {
+	wtu.mutation.AddMonths(i)
+	return wtu
+}
+
+// SetCoverage sets the coverage field.
+func (wtu *WarrantyTermUpdate) SetCoverage(w warrantyterm.Coverage) *WarrantyTermUpdate {
+	wtu.mutation.SetCoverage(w)
+	return wtu
+}
+
+// SetNillableCoverage sets the coverage field if the given value is not nil.
+func (wtu *WarrantyTermUpdate) SetNillableCoverage(w *warrantyterm.Coverage) *WarrantyTermUpdate {
+	if w != nil {
+		wtu.SetCoverage(*w)
+	}
+	return wtu
+}
+
+// SetClaimContact sets the claim_contact field.
+func (wtu *WarrantyTermUpdate) SetClaimContact(s string) *WarrantyTermUpdate {
+	wtu.mutation.SetClaimContact(s)
+	return wtu
+}
+
+// SetNillableClaimContact sets the claim_contact field if the given value is not nil.
+func (wtu *WarrantyTermUpdate) SetNillableClaimContact(s *string) *WarrantyTermUpdate {
+	if s != nil {
+		wtu.SetClaimContact(*s)
+	}
+	return wtu
+}
+
+// ClearClaimContact clears the value of claim_contact.
+func (wtu *WarrantyTermUpdate) ClearClaimContact() *WarrantyTermUpdate {
+	wtu.mutation.ClearClaimContact()
+	return wtu
+}
+
+// SetConditions sets the conditions field.
+func (wtu *WarrantyTermUpdate) SetConditions(s string) *WarrantyTermUpdate {
+	wtu.mutation.SetConditions(s)
+	return wtu
+}
+
+// SetNillableConditions sets the conditions field if the given value is not nil.
+func (wtu *WarrantyTermUpdate) SetNillableConditions(s *string) *WarrantyTermUpdate {
+	if s != nil {
+		wtu.SetConditions(*s)
+	}
+	return wtu
+}
+
+// ClearConditions clears the value of conditions.
+func (wtu *WarrantyTermUpdate) ClearConditions() *WarrantyTermUpdate {
+	wtu.mutation.ClearConditions()
+	return wtu
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (wtu *WarrantyTermUpdate) SetOrganizationID(id int) *WarrantyTermUpdate {
+	wtu.mutation.SetOrganizationID(id)
+	return wtu
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (wtu *WarrantyTermUpdate) SetNillableOrganizationID(id *int) *WarrantyTermUpdate {
+	if id != nil {
+		wtu = wtu.SetOrganizationID(*id)
+	}
+	return wtu
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (wtu *WarrantyTermUpdate) SetOrganization(o *Organization) *WarrantyTermUpdate {
+	return wtu.SetOrganizationID(o.ID)
+}
+
+// AddEquipmentIDs adds the equipment edge to Equipment by ids.
+func (wtu *WarrantyTermUpdate) AddEquipmentIDs(ids ...int) *WarrantyTermUpdate {
+	wtu.mutation.AddEquipmentIDs(ids...)
+	return wtu
+}
+
+// AddEquipment adds the equipment edges to Equipment.
+func (wtu *WarrantyTermUpdate) AddEquipment(e ...*Equipment) *WarrantyTermUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return wtu.AddEquipmentIDs(ids...)
+}
+
+// Mutation returns the WarrantyTermMutation object of the builder.
+func (wtu *WarrantyTermUpdate) Mutation() *WarrantyTermMutation {
+	return wtu.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (wtu *WarrantyTermUpdate) ClearOrganization() *WarrantyTermUpdate {
+	wtu.mutation.ClearOrganization()
+	return wtu
+}
+
+// RemoveEquipmentIDs removes the equipment edge to Equipment by ids.
+func (wtu *WarrantyTermUpdate) RemoveEquipmentIDs(ids ...int) *WarrantyTermUpdate {
+	wtu.mutation.RemoveEquipmentIDs(ids...)
+	return wtu
+}
+
+// RemoveEquipment removes equipment edges to Equipment.
+func (wtu *WarrantyTermUpdate) RemoveEquipment(e ...*Equipment) *WarrantyTermUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return wtu.RemoveEquipmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (wtu *WarrantyTermUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := wtu.mutation.Name(); ok {
+		if err := warrantyterm.NameValidator(v); err != nil {
+			return 0, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := wtu.mutation.Provider(); ok {
+		if err := warrantyterm.ProviderValidator(v); err != nil {
+			return 0, &ValidationError{Name: "provider", err: fmt.Errorf("ent: validator failed for field \"provider\": %w", err)}
+		}
+	}
+	if v, ok := wtu.mutation.Months(); ok {
+		if err := warrantyterm.MonthsValidator(v); err != nil {
+			return 0, &ValidationError{Name: "months", err: fmt.Errorf("ent: validator failed for field \"months\": %w", err)}
+		}
+	}
+	if v, ok := wtu.mutation.Coverage(); ok {
+		if err := warrantyterm.CoverageValidator(v); err != nil {
+			return 0, &ValidationError{Name: "coverage", err: fmt.Errorf("ent: validator failed for field \"coverage\": %w", err)}
+		}
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(wtu.hooks) == 0 {
+		affected, err = wtu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WarrantyTermMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wtu.mutation = mutation
+			affected, err = wtu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wtu.hooks) - 1; i >= 0; i-- {
+			mut = wtu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wtu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtu *WarrantyTermUpdate) SaveX(ctx context.Context) int {
+	affected, err := wtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wtu *WarrantyTermUpdate) Exec(ctx context.Context) error {
+	_, err := wtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtu *WarrantyTermUpdate) ExecX(ctx context.Context) {
+	if err := wtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wtu *WarrantyTermUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   warrantyterm.Table,
+			Columns: warrantyterm.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: warrantyterm.FieldID,
+			},
+		},
+	}
+	if ps := wtu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wtu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldName,
+		})
+	}
+	if value, ok := wtu.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldProvider,
+		})
+	}
+	if value, ok := wtu.mutation.Months(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantyterm.FieldMonths,
+		})
+	}
+	if value, ok := wtu.mutation.AddedMonths(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantyterm.FieldMonths,
+		})
+	}
+	if value, ok := wtu.mutation.Coverage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: warrantyterm.FieldCoverage,
+		})
+	}
+	if value, ok := wtu.mutation.ClaimContact(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldClaimContact,
+		})
+	}
+	if wtu.mutation.ClaimContactCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: warrantyterm.FieldClaimContact,
+		})
+	}
+	if value, ok := wtu.mutation.Conditions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldConditions,
+		})
+	}
+	if wtu.mutation.ConditionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: warrantyterm.FieldConditions,
+		})
+	}
+	if wtu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   warrantyterm.OrganizationTable,
+			Columns: []string{warrantyterm.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wtu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   warrantyterm.OrganizationTable,
+			Columns: []string{warrantyterm.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := wtu.mutation.RemovedEquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantyterm.EquipmentTable,
+			Columns: []string{warrantyterm.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wtu.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantyterm.EquipmentTable,
+			Columns: []string{warrantyterm.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{warrantyterm.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WarrantyTermUpdateOne is the builder for updating a single WarrantyTerm entity.
+type WarrantyTermUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *WarrantyTermMutation
+}
+
+// SetName sets the name field.
+func (wtuo *WarrantyTermUpdateOne) SetName(s string) *WarrantyTermUpdateOne {
+	wtuo.mutation.SetName(s)
+	return wtuo
+}
+
+// SetProvider sets the provider field.
+func (wtuo *WarrantyTermUpdateOne) SetProvider(s string) *WarrantyTermUpdateOne {
+	wtuo.mutation.SetProvider(s)
+	return wtuo
+}
+
+// SetMonths sets the months field.
+func (wtuo *WarrantyTermUpdateOne) SetMonths(i int) *WarrantyTermUpdateOne {
+	wtuo.mutation.ResetMonths()
+	wtuo.mutation.SetMonths(i)
+	return wtuo
+}
+
+// AddMonths adds i to months.
+func (wtuo *WarrantyTermUpdateOne) AddMonths(i int) *WarrantyTermUpdateOne {
+	wtuo.mutation.AddMonths(i)
+	return wtuo
+}
+
+// SetCoverage sets the coverage field.
+func (wtuo *WarrantyTermUpdateOne) SetCoverage(w warrantyterm.Coverage) *WarrantyTermUpdateOne {
+	wtuo.mutation.SetCoverage(w)
+	return wtuo
+}
+
+// SetNillableCoverage sets the coverage field if the given value is not nil.
+func (wtuo *WarrantyTermUpdateOne) SetNillableCoverage(w *warrantyterm.Coverage) *WarrantyTermUpdateOne {
+	if w != nil {
+		wtuo.SetCoverage(*w)
+	}
+	return wtuo
+}
+
+// SetClaimContact sets the claim_contact field.
+func (wtuo *WarrantyTermUpdateOne) SetClaimContact(s string) *WarrantyTermUpdateOne {
+	wtuo.mutation.SetClaimContact(s)
+	return wtuo
+}
+
+// SetNillableClaimContact sets the claim_contact field if the given value is not nil.
+func (wtuo *WarrantyTermUpdateOne) SetNillableClaimContact(s *string) *WarrantyTermUpdateOne {
+	if s != nil {
+		wtuo.SetClaimContact(*s)
+	}
+	return wtuo
+}
+
+// ClearClaimContact clears the value of claim_contact.
+func (wtuo *WarrantyTermUpdateOne) ClearClaimContact() *WarrantyTermUpdateOne {
+	wtuo.mutation.ClearClaimContact()
+	return wtuo
+}
+
+// SetConditions sets the conditions field.
+func (wtuo *WarrantyTermUpdateOne) SetConditions(s string) *WarrantyTermUpdateOne {
+	wtuo.mutation.SetConditions(s)
+	return wtuo
+}
+
+// SetNillableConditions sets the conditions field if the given value is not nil.
+func (wtuo *WarrantyTermUpdateOne) SetNillableConditions(s *string) *WarrantyTermUpdateOne {
+	if s != nil {
+		wtuo.SetConditions(*s)
+	}
+	return wtuo
+}
+
+// ClearConditions clears the value of conditions.
+func (wtuo *WarrantyTermUpdateOne) ClearConditions() *WarrantyTermUpdateOne {
+	wtuo.mutation.ClearConditions()
+	return wtuo
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (wtuo *WarrantyTermUpdateOne) SetOrganizationID(id int) *WarrantyTermUpdateOne {
+	wtuo.mutation.SetOrganizationID(id)
+	return wtuo
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (wtuo *WarrantyTermUpdateOne) SetNillableOrganizationID(id *int) *WarrantyTermUpdateOne {
+	if id != nil {
+		wtuo = wtuo.SetOrganizationID(*id)
+	}
+	return wtuo
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (wtuo *WarrantyTermUpdateOne) SetOrganization(o *Organization) *WarrantyTermUpdateOne {
+	return wtuo.SetOrganizationID(o.ID)
+}
+
+// AddEquipmentIDs adds the equipment edge to Equipment by ids.
+func (wtuo *WarrantyTermUpdateOne) AddEquipmentIDs(ids ...int) *WarrantyTermUpdateOne {
+	wtuo.mutation.AddEquipmentIDs(ids...)
+	return wtuo
+}
+
+// AddEquipment adds the equipment edges to Equipment.
+func (wtuo *WarrantyTermUpdateOne) AddEquipment(e ...*Equipment) *WarrantyTermUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return wtuo.AddEquipmentIDs(ids...)
+}
+
+// Mutation returns the WarrantyTermMutation object of the builder.
+func (wtuo *WarrantyTermUpdateOne) Mutation() *WarrantyTermMutation {
+	return wtuo.mutation
+}
+
+// ClearOrganization clears the organization edge to Organization.
+func (wtuo *WarrantyTermUpdateOne) ClearOrganization() *WarrantyTermUpdateOne {
+	wtuo.mutation.ClearOrganization()
+	return wtuo
+}
+
+// RemoveEquipmentIDs removes the equipment edge to Equipment by ids.
+func (wtuo *WarrantyTermUpdateOne) RemoveEquipmentIDs(ids ...int) *WarrantyTermUpdateOne {
+	wtuo.mutation.RemoveEquipmentIDs(ids...)
+	return wtuo
+}
+
+// RemoveEquipment removes equipment edges to Equipment.
+func (wtuo *WarrantyTermUpdateOne) RemoveEquipment(e ...*Equipment) *WarrantyTermUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return wtuo.RemoveEquipmentIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (wtuo *WarrantyTermUpdateOne) Save(ctx context.Context) (*WarrantyTerm, error) {
+	if v, ok := wtuo.mutation.Name(); ok {
+		if err := warrantyterm.NameValidator(v); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := wtuo.mutation.Provider(); ok {
+		if err := warrantyterm.ProviderValidator(v); err != nil {
+			return nil, &ValidationError{Name: "provider", err: fmt.Errorf("ent: validator failed for field \"provider\": %w", err)}
+		}
+	}
+	if v, ok := wtuo.mutation.Months(); ok {
+		if err := warrantyterm.MonthsValidator(v); err != nil {
+			return nil, &ValidationError{Name: "months", err: fmt.Errorf("ent: validator failed for field \"months\": %w", err)}
+		}
+	}
+	if v, ok := wtuo.mutation.Coverage(); ok {
+		if err := warrantyterm.CoverageValidator(v); err != nil {
+			return nil, &ValidationError{Name: "coverage", err: fmt.Errorf("ent: validator failed for field \"coverage\": %w", err)}
+		}
+	}
+
+	var (
+		err  error
+		node *WarrantyTerm
+	)
+	if len(wtuo.hooks) == 0 {
+		node, err = wtuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WarrantyTermMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wtuo.mutation = mutation
+			node, err = wtuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wtuo.hooks) - 1; i >= 0; i-- {
+			mut = wtuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wtuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtuo *WarrantyTermUpdateOne) SaveX(ctx context.Context) *WarrantyTerm {
+	wt, err := wtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return wt
+}
+
+// Exec executes the query on the entity.
+func (wtuo *WarrantyTermUpdateOne) Exec(ctx context.Context) error {
+	_, err := wtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtuo *WarrantyTermUpdateOne) ExecX(ctx context.Context) {
+	if err := wtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wtuo *WarrantyTermUpdateOne) sqlSave(ctx context.Context) (wt *WarrantyTerm, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   warrantyterm.Table,
+			Columns: warrantyterm.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: warrantyterm.FieldID,
+			},
+		},
+	}
+	id, ok := wtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing WarrantyTerm.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := wtuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldName,
+		})
+	}
+	if value, ok := wtuo.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldProvider,
+		})
+	}
+	if value, ok := wtuo.mutation.Months(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantyterm.FieldMonths,
+		})
+	}
+	if value, ok := wtuo.mutation.AddedMonths(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: warrantyterm.FieldMonths,
+		})
+	}
+	if value, ok := wtuo.mutation.Coverage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: warrantyterm.FieldCoverage,
+		})
+	}
+	if value, ok := wtuo.mutation.ClaimContact(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldClaimContact,
+		})
+	}
+	if wtuo.mutation.ClaimContactCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: warrantyterm.FieldClaimContact,
+		})
+	}
+	if value, ok := wtuo.mutation.Conditions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: warrantyterm.FieldConditions,
+		})
+	}
+	if wtuo.mutation.ConditionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: warrantyterm.FieldConditions,
+		})
+	}
+	if wtuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   warrantyterm.OrganizationTable,
+			Columns: []string{warrantyterm.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wtuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   warrantyterm.OrganizationTable,
+			Columns: []string{warrantyterm.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := wtuo.mutation.RemovedEquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantyterm.EquipmentTable,
+			Columns: []string{warrantyterm.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wtuo.mutation.EquipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warrantyterm.EquipmentTable,
+			Columns: []string{warrantyterm.EquipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: equipment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	wt = &WarrantyTerm{config: wtuo.config}
+	_spec.Assign = wt.assignValues
+	_spec.ScanValues = wt.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, wtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{warrantyterm.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return wt, nil
+}
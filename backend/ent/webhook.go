@@ -0,0 +1,228 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/webhook"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// Webhook is the model entity for the Webhook schema.
+type Webhook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Events holds the value of the "events" field.
+	Events []string `json:"events,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active"`
+	// Failures holds the value of the "failures" field.
+	Failures int `json:"failures"`
+	// DisabledAt holds the value of the "disabled_at" field.
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookQuery when eager-loading is set.
+	Edges           WebhookEdges `json:"edges"`
+	organization_id *int
+}
+
+// WebhookEdges holds the relations/edges for other nodes in the graph.
+type WebhookEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebhookDelivery
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WebhookEdges) DeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[1] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Webhook) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullTime{},   // create_time
+		&sql.NullTime{},   // update_time
+		&sql.NullString{}, // url
+		&sql.NullString{}, // secret
+		&[]byte{},         // events
+		&sql.NullBool{},   // active
+		&sql.NullInt64{},  // failures
+		&sql.NullTime{},   // disabled_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Webhook) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // organization_id
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Webhook fields.
+func (w *Webhook) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(webhook.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	w.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field create_time", values[0])
+	} else if value.Valid {
+		w.CreateTime = value.Time
+	}
+	if value, ok := values[1].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field update_time", values[1])
+	} else if value.Valid {
+		w.UpdateTime = value.Time
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field url", values[2])
+	} else if value.Valid {
+		w.URL = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field secret", values[3])
+	} else if value.Valid {
+		w.Secret = value.String
+	}
+
+	if value, ok := values[4].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field events", values[4])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &w.Events); err != nil {
+			return fmt.Errorf("unmarshal field events: %v", err)
+		}
+	}
+	if value, ok := values[5].(*sql.NullBool); !ok {
+		return fmt.Errorf("unexpected type %T for field active", values[5])
+	} else if value.Valid {
+		w.Active = value.Bool
+	}
+	if value, ok := values[6].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field failures", values[6])
+	} else if value.Valid {
+		w.Failures = int(value.Int64)
+	}
+	if value, ok := values[7].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field disabled_at", values[7])
+	} else if value.Valid {
+		w.DisabledAt = new(time.Time)
+		*w.DisabledAt = value.Time
+	}
+	values = values[8:]
+	if len(values) == len(webhook.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field organization_id", value)
+		} else if value.Valid {
+			w.organization_id = new(int)
+			*w.organization_id = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the organization edge of the Webhook.
+func (w *Webhook) QueryOrganization() *OrganizationQuery {
+	return (&WebhookClient{config: w.config}).QueryOrganization(w)
+}
+
+// QueryDeliveries queries the deliveries edge of the Webhook.
+func (w *Webhook) QueryDeliveries() *WebhookDeliveryQuery {
+	return (&WebhookClient{config: w.config}).QueryDeliveries(w)
+}
+
+// Update returns a builder for updating this Webhook.
+// Note that, you need to call Webhook.Unwrap() before calling this method, if this Webhook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Webhook) Update() *WebhookUpdateOne {
+	return (&WebhookClient{config: w.config}).UpdateOne(w)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (w *Webhook) Unwrap() *Webhook {
+	tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Webhook is not a transactional entity")
+	}
+	w.config.driver = tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Webhook) String() string {
+	var builder strings.Builder
+	builder.WriteString("Webhook(")
+	builder.WriteString(fmt.Sprintf("id=%v", w.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(w.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", update_time=")
+	builder.WriteString(w.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", url=")
+	builder.WriteString(w.URL)
+	builder.WriteString(", secret=<sensitive>")
+	builder.WriteString(", events=")
+	builder.WriteString(fmt.Sprintf("%v", w.Events))
+	builder.WriteString(", active=")
+	builder.WriteString(fmt.Sprintf("%v", w.Active))
+	builder.WriteString(", failures=")
+	builder.WriteString(fmt.Sprintf("%v", w.Failures))
+	if v := w.DisabledAt; v != nil {
+		builder.WriteString(", disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Webhooks is a parsable slice of Webhook.
+type Webhooks []*Webhook
+
+func (w Webhooks) config(cfg config) {
+	for _i := range w {
+		w[_i].config = cfg
+	}
+}
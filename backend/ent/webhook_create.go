@@ -0,0 +1,349 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/webhook"
+	"github.com/darksford123x/app/ent/webhookdelivery"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// WebhookCreate is the builder for creating a Webhook entity.
+type WebhookCreate struct {
+	config
+	mutation *WebhookMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the create_time field.
+func (wc *WebhookCreate) SetCreateTime(t time.Time) *WebhookCreate {
+	wc.mutation.SetCreateTime(t)
+	return wc
+}
+
+// SetNillableCreateTime sets the create_time field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableCreateTime(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetCreateTime(*t)
+	}
+	return wc
+}
+
+// SetUpdateTime sets the update_time field.
+func (wc *WebhookCreate) SetUpdateTime(t time.Time) *WebhookCreate {
+	wc.mutation.SetUpdateTime(t)
+	return wc
+}
+
+// SetNillableUpdateTime sets the update_time field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableUpdateTime(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetUpdateTime(*t)
+	}
+	return wc
+}
+
+// SetURL sets the url field.
+func (wc *WebhookCreate) SetURL(s string) *WebhookCreate {
+	wc.mutation.SetURL(s)
+	return wc
+}
+
+// SetSecret sets the secret field.
+func (wc *WebhookCreate) SetSecret(s string) *WebhookCreate {
+	wc.mutation.SetSecret(s)
+	return wc
+}
+
+// SetEvents sets the events field.
+func (wc *WebhookCreate) SetEvents(s []string) *WebhookCreate {
+	wc.mutation.SetEvents(s)
+	return wc
+}
+
+// SetActive sets the active field.
+func (wc *WebhookCreate) SetActive(b bool) *WebhookCreate {
+	wc.mutation.SetActive(b)
+	return wc
+}
+
+// SetNillableActive sets the active field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableActive(b *bool) *WebhookCreate {
+	if b != nil {
+		wc.SetActive(*b)
+	}
+	return wc
+}
+
+// SetFailures sets the failures field.
+func (wc *WebhookCreate) SetFailures(i int) *WebhookCreate {
+	wc.mutation.SetFailures(i)
+	return wc
+}
+
+// SetNillableFailures sets the failures field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableFailures(i *int) *WebhookCreate {
+	if i != nil {
+		wc.SetFailures(*i)
+	}
+	return wc
+}
+
+// SetDisabledAt sets the disabled_at field.
+func (wc *WebhookCreate) SetDisabledAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetDisabledAt(t)
+	return wc
+}
+
+// SetNillableDisabledAt sets the disabled_at field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableDisabledAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetDisabledAt(*t)
+	}
+	return wc
+}
+
+// SetOrganizationID sets the organization edge to Organization by id.
+func (wc *WebhookCreate) SetOrganizationID(id int) *WebhookCreate {
+	wc.mutation.SetOrganizationID(id)
+	return wc
+}
+
+// SetNillableOrganizationID sets the organization edge to Organization by id if the given value is not nil.
+func (wc *WebhookCreate) SetNillableOrganizationID(id *int) *WebhookCreate {
+	if id != nil {
+		wc = wc.SetOrganizationID(*id)
+	}
+	return wc
+}
+
+// SetOrganization sets the organization edge to Organization.
+func (wc *WebhookCreate) SetOrganization(o *Organization) *WebhookCreate {
+	return wc.SetOrganizationID(o.ID)
+}
+
+// AddDeliveryIDs adds the deliveries edge to WebhookDelivery by ids.
+func (wc *WebhookCreate) AddDeliveryIDs(ids ...int) *WebhookCreate {
+	wc.mutation.AddDeliveryIDs(ids...)
+	return wc
+}
+
+// AddDeliveries adds the deliveries edges to WebhookDelivery.
+func (wc *WebhookCreate) AddDeliveries(w ...*WebhookDelivery) *WebhookCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wc *WebhookCreate) Mutation() *WebhookMutation {
+	return wc.mutation
+}
+
+// Save creates the Webhook in the database.
+func (wc *WebhookCreate) Save(ctx context.Context) (*Webhook, error) {
+	if _, ok := wc.mutation.CreateTime(); !ok {
+		v := webhook.DefaultCreateTime()
+		wc.mutation.SetCreateTime(v)
+	}
+	if _, ok := wc.mutation.UpdateTime(); !ok {
+		v := webhook.DefaultUpdateTime()
+		wc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := wc.mutation.URL(); !ok {
+		return nil, &ValidationError{Name: "url", err: errors.New("ent: missing required field \"url\"")}
+	}
+	if v, ok := wc.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return nil, &ValidationError{Name: "url", err: fmt.Errorf("ent: validator failed for field \"url\": %w", err)}
+		}
+	}
+	if _, ok := wc.mutation.Secret(); !ok {
+		return nil, &ValidationError{Name: "secret", err: errors.New("ent: missing required field \"secret\"")}
+	}
+	if v, ok := wc.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return nil, &ValidationError{Name: "secret", err: fmt.Errorf("ent: validator failed for field \"secret\": %w", err)}
+		}
+	}
+	if _, ok := wc.mutation.Active(); !ok {
+		v := webhook.DefaultActive
+		wc.mutation.SetActive(v)
+	}
+	if _, ok := wc.mutation.Failures(); !ok {
+		v := webhook.DefaultFailures
+		wc.mutation.SetFailures(v)
+	}
+	var (
+		err  error
+		node *Webhook
+	)
+	if len(wc.hooks) == 0 {
+		node, err = wc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wc.mutation = mutation
+			node, err = wc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wc.hooks) - 1; i >= 0; i-- {
+			mut = wc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WebhookCreate) SaveX(ctx context.Context) *Webhook {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wc *WebhookCreate) sqlSave(ctx context.Context) (*Webhook, error) {
+	w, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	w.ID = int(id)
+	return w, nil
+}
+
+func (wc *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
+	var (
+		w     = &Webhook{config: wc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webhook.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhook.FieldID,
+			},
+		}
+	)
+	if value, ok := wc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldCreateTime,
+		})
+		w.CreateTime = value
+	}
+	if value, ok := wc.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldUpdateTime,
+		})
+		w.UpdateTime = value
+	}
+	if value, ok := wc.mutation.URL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldURL,
+		})
+		w.URL = value
+	}
+	if value, ok := wc.mutation.Secret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldSecret,
+		})
+		w.Secret = value
+	}
+	if value, ok := wc.mutation.Events(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhook.FieldEvents,
+		})
+		w.Events = value
+	}
+	if value, ok := wc.mutation.Active(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webhook.FieldActive,
+		})
+		w.Active = value
+	}
+	if value, ok := wc.mutation.Failures(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhook.FieldFailures,
+		})
+		w.Failures = value
+	}
+	if value, ok := wc.mutation.DisabledAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldDisabledAt,
+		})
+		w.DisabledAt = &value
+	}
+	if nodes := wc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   webhook.OrganizationTable,
+			Columns: []string{webhook.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return w, _spec
+}
@@ -0,0 +1,284 @@
+// Package events publishes the changes made to entities once they are
+// committed, so that notifications and other side effects never happen for
+// changes that were rolled back.
+package events
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/tenant"
+)
+
+// Ops of events.
+const (
+	Created = "created"
+	Updated = "updated"
+	Deleted = "deleted"
+)
+
+// Event is a committed change of an entity.
+type Event struct {
+	// Type is the entity and the operation, e.g. "repairslip.updated".
+	Type   string `json:"type"`
+	Entity string `json:"entity"`
+	Op     string `json:"op"`
+	ID     int    `json:"id"`
+	// Organization is the tenant the entity belongs to.
+	Organization int `json:"-"`
+	// Fields are the fields and edges an update changed.
+	Fields []string `json:"fields,omitempty"`
+	// Old holds the values the changed fields had before an update.
+	Old map[string]ent.Value `json:"-"`
+	// Data is the entity after it was created or updated, or before it was
+	// deleted.
+	Data ent.Value `json:"data,omitempty"`
+	Time time.Time `json:"time"`
+}
+
+// Changed reports whether an update changed the given field or edge.
+func (e Event) Changed(field string) bool {
+	for _, f := range e.Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Listener is called with every published event. It runs on the goroutine
+// that committed the change, so it must not block.
+type Listener func(Event)
+
+// Loader reads the entity of an event, with the edges its listeners need.
+type Loader func(ctx context.Context, client *ent.Client, id int) (ent.Value, error)
+
+// Bus delivers events to its listeners.
+type Bus struct {
+	client    *ent.Client
+	mu        sync.RWMutex
+	listeners []Listener
+	loaders   map[string]Loader
+	// pending are the events of the open transactions, in order.
+	pending map[*ent.Tx][]Event
+}
+
+// NewBus creates a bus without listeners for the entities of client.
+func NewBus(client *ent.Client) *Bus {
+	return &Bus{
+		client:  client,
+		loaders: make(map[string]Loader),
+		pending: make(map[*ent.Tx][]Event),
+	}
+}
+
+// Load sets the loader of the data of an entity's events. The data of a
+// delete is loaded before the entity is deleted. Without a loader the data
+// is the entity returned by the mutation, and deletes have none.
+func (b *Bus) Load(entity string, l Loader) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.loaders[entity] = l
+}
+
+// load reads the entity of an event with its loader, if there is one.
+func (b *Bus) load(ctx context.Context, e Event) (ent.Value, bool, error) {
+	b.mu.RLock()
+	l, ok := b.loaders[e.Entity]
+	b.mu.RUnlock()
+	if !ok {
+		return nil, false, nil
+	}
+	client := b.client
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		client = tx.Client()
+	}
+	v, err := l(ctx, client, e.ID)
+	return v, err == nil, err
+}
+
+// Subscribe adds a listener to the bus.
+func (b *Bus) Subscribe(l Listener) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.listeners = append(b.listeners, l)
+}
+
+// Publish calls the listeners with the event.
+func (b *Bus) Publish(e Event) {
+	b.mu.RLock()
+	listeners := b.listeners
+	b.mu.RUnlock()
+	for _, l := range listeners {
+		l(e)
+	}
+}
+
+// Hook returns the hook that publishes the creates, updates and deletes of
+// single entities. Inside a transaction the event is published after the
+// transaction commits and dropped when it rolls back; otherwise the change is
+// committed already when the mutation returns. Bulk updates and deletes are
+// not published.
+//
+// The hook should be registered last, so that it sees the fields set by the
+// other hooks.
+func (b *Bus) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			e := Event{Entity: strings.ToLower(m.Type())}
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				e.Op = Created
+			case m.Op().Is(ent.OpUpdateOne):
+				e.Op = Updated
+				e.Fields = changes(m)
+				e.Old = old(ctx, m)
+			case m.Op().Is(ent.OpDeleteOne):
+				e.Op = Deleted
+				if id, ok := m.(interface{ ID() (int, bool) }); ok {
+					e.ID, _ = id.ID()
+				}
+				data, _, err := b.load(ctx, e)
+				if err != nil && !ent.IsNotFound(err) {
+					return nil, err
+				}
+				e.Data = data
+			default:
+				return next.Mutate(ctx, m)
+			}
+			e.Type = e.Entity + "." + e.Op
+			e.Organization, _ = tenant.FromContext(ctx)
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			if ids := m.AddedIDs(tenant.Edge); len(ids) > 0 && e.Op == Created {
+				// The organization of a created entity is set by its
+				// policy, or given when created with the system context.
+				e.Organization, _ = ids[0].(int)
+			}
+			if e.Op != Deleted {
+				e.Data = v
+				e.ID = idOf(v)
+				data, ok, err := b.load(ctx, e)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					e.Data = data
+				}
+			}
+
+			if tx := ent.TxFromContext(ctx); tx != nil {
+				b.hold(tx, e)
+			} else {
+				e.Time = time.Now()
+				b.Publish(e)
+			}
+			return v, nil
+		})
+	}
+}
+
+// hold holds the event back until the transaction commits.
+func (b *Bus) hold(tx *ent.Tx, e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	events, ok := b.pending[tx]
+	b.pending[tx] = append(events, e)
+	if ok {
+		return
+	}
+	// The first event of the transaction hooks its end; the events are
+	// then published in the order of the changes.
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			err := next.Commit(ctx, tx)
+			events := b.take(tx)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			for _, e := range events {
+				e.Time = now
+				b.Publish(e)
+			}
+			return nil
+		})
+	})
+	tx.OnRollback(func(next ent.Rollbacker) ent.Rollbacker {
+		return ent.RollbackFunc(func(ctx context.Context, tx *ent.Tx) error {
+			b.take(tx)
+			return next.Rollback(ctx, tx)
+		})
+	})
+}
+
+// take removes the held back events of the transaction.
+func (b *Bus) take(tx *ent.Tx) []Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	events := b.pending[tx]
+	delete(b.pending, tx)
+	return events
+}
+
+// idOf returns the ID of an entity returned by a mutation. The mutations of
+// creates do not know it.
+func idOf(v ent.Value) int {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return 0
+	}
+	if id := rv.FieldByName("ID"); id.Kind() == reflect.Int {
+		return int(id.Int())
+	}
+	return 0
+}
+
+// changes returns the fields and edges changed by the mutation.
+func changes(m ent.Mutation) []string {
+	var fields []string
+	fields = append(fields, m.Fields()...)
+	fields = append(fields, m.AddedFields()...)
+	fields = append(fields, m.ClearedFields()...)
+	fields = append(fields, m.AddedEdges()...)
+	fields = append(fields, m.RemovedEdges()...)
+	fields = append(fields, m.ClearedEdges()...)
+	seen := make(map[string]bool, len(fields))
+	unique := fields[:0]
+	for _, f := range fields {
+		if !seen[f] {
+			seen[f] = true
+			unique = append(unique, f)
+		}
+	}
+	return unique
+}
+
+// old returns the values the fields changed by the mutation had before.
+func old(ctx context.Context, m ent.Mutation) map[string]ent.Value {
+	o, ok := m.(interface {
+		OldField(context.Context, string) (ent.Value, error)
+	})
+	if !ok {
+		return nil
+	}
+	values := make(map[string]ent.Value)
+	fields := append(append(m.Fields(), m.AddedFields()...), m.ClearedFields()...)
+	for _, f := range fields {
+		v, err := o.OldField(ctx, f)
+		if err != nil {
+			log.Printf("events: reading the old %s of a %s: %v", f, m.Type(), err)
+			continue
+		}
+		values[f] = v
+	}
+	return values
+}
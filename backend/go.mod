@@ -4,11 +4,22 @@ go 1.14
 
 require (
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
+	github.com/boombuler/barcode v1.1.0
 	github.com/facebookincubator/ent v0.2.7
 	github.com/gin-contrib/cors v1.3.1
-	github.com/gin-gonic/gin v1.6.3
+	// gin 1.7 routes a static segment beside a parameter, which
+	// GET /equipment/by-tag/:tag needs next to /equipment/:id; 1.6 panics.
+	github.com/gin-gonic/gin v1.7.7
+	github.com/golang/protobuf v1.4.1
+	github.com/graphql-go/graphql v0.8.1
+	github.com/jung-kurt/gofpdf v1.16.2
+	github.com/lib/pq v1.2.0
 	github.com/mattn/go-sqlite3 v1.13.0
 	github.com/swaggo/files v0.0.0-20190704085106-630677cd5c14
 	github.com/swaggo/gin-swagger v1.2.0
 	github.com/swaggo/swag v1.6.7
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
+	golang.org/x/net v0.0.0-20200226121028-0de0cce0169b
+	google.golang.org/grpc v1.33.2
+	google.golang.org/protobuf v1.25.0
 )
@@ -0,0 +1,158 @@
+// Package inventory keeps track of spare part stock. Every change of an
+// on-hand quantity is recorded as a StockMovement in the same transaction, so
+// the movement ledger always explains the current stock levels.
+package inventory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/txn"
+)
+
+var (
+	// ErrInsufficientStock is returned when a movement would take the
+	// on-hand quantity of a location below zero.
+	ErrInsufficientStock = errors.New("inventory: insufficient stock")
+	// ErrInvalidQuantity is returned for quantities that do not fit
+	// the kind of movement.
+	ErrInvalidQuantity = errors.New("inventory: invalid quantity")
+	// ErrSlipClosed is returned when parts are consumed for a repair
+	// slip that is already closed.
+	ErrSlipClosed = errors.New("inventory: repair slip is closed")
+)
+
+// attempts bounds how often Book runs a transaction again.
+const attempts = 3
+
+// Book runs fn, which books movements with the functions of this package, in
+// a transaction. The first movements of a part into a location race to
+// create its stock level: the transaction that loses fails on the unique
+// index of the stock levels, and is run again to add to the level the other
+// one created.
+func Book(ctx context.Context, client *ent.Client, fn func(ctx context.Context, tx *ent.Tx) error) (err error) {
+	for i := 0; i < attempts; i++ {
+		err = txn.WithTx(ctx, client, fn)
+		if !ent.IsConstraintError(err) {
+			break
+		}
+	}
+	return err
+}
+
+// Receive books quantity units of the part into location.
+func Receive(ctx context.Context, tx *ent.Tx, partID int, location string, quantity int, note string) (*ent.StockMovement, error) {
+	if quantity <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+	if err := apply(ctx, tx, partID, location, quantity); err != nil {
+		return nil, err
+	}
+	return tx.StockMovement.
+		Create().
+		SetKind(stockmovement.KindReceipt).
+		SetQuantity(quantity).
+		SetLocation(location).
+		SetNote(note).
+		SetPartID(partID).
+		Save(ctx)
+}
+
+// Adjust corrects the stock of the part at location by delta, which may be
+// negative. The reason code is stored with the movement for auditing.
+func Adjust(ctx context.Context, tx *ent.Tx, partID int, location string, delta int, reason stockmovement.ReasonCode, note string) (*ent.StockMovement, error) {
+	if delta == 0 {
+		return nil, ErrInvalidQuantity
+	}
+	if err := stockmovement.ReasonCodeValidator(reason); err != nil {
+		return nil, err
+	}
+	if err := apply(ctx, tx, partID, location, delta); err != nil {
+		return nil, err
+	}
+	return tx.StockMovement.
+		Create().
+		SetKind(stockmovement.KindAdjustment).
+		SetQuantity(delta).
+		SetLocation(location).
+		SetReasonCode(reason).
+		SetNote(note).
+		SetPartID(partID).
+		Save(ctx)
+}
+
+// Consume takes quantity units of the part out of location and attaches them
+// to the repair slip. The slip is updated in the same transaction, so its
+// update time reflects the last part used.
+func Consume(ctx context.Context, tx *ent.Tx, slipID, partID int, location string, quantity int) (*ent.StockMovement, error) {
+	if quantity <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+	slip, err := tx.RepairSlip.Get(ctx, slipID)
+	if err != nil {
+		return nil, err
+	}
+	if slip.Status == repairslip.StatusClosed {
+		return nil, ErrSlipClosed
+	}
+	if err := apply(ctx, tx, partID, location, -quantity); err != nil {
+		return nil, err
+	}
+	mv, err := tx.StockMovement.
+		Create().
+		SetKind(stockmovement.KindConsumption).
+		SetQuantity(-quantity).
+		SetLocation(location).
+		SetPartID(partID).
+		SetRepairSlip(slip).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := slip.Update().Save(ctx); err != nil {
+		return nil, err
+	}
+	return mv, nil
+}
+
+// apply changes the on-hand quantity of the part at location by delta.
+// Decrements are done with a conditional update, so concurrent transactions
+// can never take the stock below zero. The stock level is created by the
+// first increment; see Book for when two transactions create it at once.
+func apply(ctx context.Context, tx *ent.Tx, partID int, location string, delta int) error {
+	if _, err := tx.Part.Get(ctx, partID); err != nil {
+		return err
+	}
+	update := tx.StockLevel.
+		Update().
+		Where(
+			stocklevel.LocationEQ(location),
+			stocklevel.HasPartWith(part.IDEQ(partID)),
+		).
+		AddOnHand(delta)
+	if delta < 0 {
+		update.Where(stocklevel.OnHandGTE(-delta))
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	switch {
+	case n > 0:
+		return nil
+	case delta < 0:
+		return ErrInsufficientStock
+	}
+	_, err = tx.StockLevel.
+		Create().
+		SetLocation(location).
+		SetOnHand(delta).
+		SetPartID(partID).
+		Save(ctx)
+	return err
+}
@@ -0,0 +1,141 @@
+// Package jobs runs slow work such as emails, exports and syncs in the
+// background. Jobs are stored in the database, so they survive restarts, and
+// are claimed with a lease so that the workers of several backend instances
+// never run the same job at the same time.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/job"
+)
+
+var (
+	// ErrNotRetryable is returned by Retry for jobs that are still pending
+	// or running, or that succeeded.
+	ErrNotRetryable = errors.New("jobs: only dead or cancelled jobs can be retried")
+	// ErrNotCancellable is returned by Cancel for jobs that already
+	// finished.
+	ErrNotCancellable = errors.New("jobs: only pending or running jobs can be cancelled")
+)
+
+// Option changes how a job is enqueued.
+type Option func(*ent.JobCreate)
+
+// At schedules the job to run no earlier than t.
+func At(t time.Time) Option {
+	return func(c *ent.JobCreate) {
+		// Times are compared as text by SQLite, so they are all kept in
+		// the local time zone.
+		c.SetRunAt(t.In(time.Local))
+	}
+}
+
+// After schedules the job to run once d has passed.
+func After(d time.Duration) Option {
+	return At(time.Now().Add(d))
+}
+
+// MaxAttempts sets how many times the job is tried before it is dead.
+func MaxAttempts(n int) Option {
+	return func(c *ent.JobCreate) {
+		c.SetMaxAttempts(n)
+	}
+}
+
+// Enqueue stores a job of the given kind with the JSON encoding of payload as
+// its argument. When ctx carries a transaction the job is only enqueued if
+// the transaction commits.
+func Enqueue(ctx context.Context, client *ent.Client, kind string, payload interface{}, opts ...Option) (*ent.Job, error) {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		client = tx.Client()
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	create := client.Job.
+		Create().
+		SetKind(kind).
+		SetPayload(string(data))
+	for _, opt := range opts {
+		opt(create)
+	}
+	return create.Save(ctx)
+}
+
+// Decode unmarshals the payload of the job into v.
+func Decode(j *ent.Job, v interface{}) error {
+	if err := json.Unmarshal([]byte(j.Payload), v); err != nil {
+		return Permanent(err)
+	}
+	return nil
+}
+
+// Retry puts a dead or cancelled job back in the queue, to run now with a
+// fresh set of attempts.
+func Retry(ctx context.Context, client *ent.Client, id int) (*ent.Job, error) {
+	if err := exists(ctx, client, id); err != nil {
+		return nil, err
+	}
+	n, err := client.Job.
+		Update().
+		Where(
+			job.ID(id),
+			job.StatusIn(job.StatusDead, job.StatusCancelled),
+		).
+		SetStatus(job.StatusPending).
+		SetAttempts(0).
+		SetRunAt(time.Now()).
+		ClearLockedBy().
+		ClearLockedUntil().
+		ClearFinishedAt().
+		Save(ctx)
+	return changed(ctx, client, id, n, err, ErrNotRetryable)
+}
+
+// Cancel stops a pending job from running. A running job is left to finish,
+// but its outcome is discarded and it is not retried.
+func Cancel(ctx context.Context, client *ent.Client, id int) (*ent.Job, error) {
+	if err := exists(ctx, client, id); err != nil {
+		return nil, err
+	}
+	n, err := client.Job.
+		Update().
+		Where(
+			job.ID(id),
+			job.StatusIn(job.StatusPending, job.StatusRunning),
+		).
+		SetStatus(job.StatusCancelled).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+	return changed(ctx, client, id, n, err, ErrNotCancellable)
+}
+
+// exists checks that the job can be read with the context before it is
+// changed by a conditional update, which the tenant policy does not
+// restrict to the jobs of the organization of the context.
+func exists(ctx context.Context, client *ent.Client, id int) error {
+	_, err := client.Job.Get(ctx, id)
+	return err
+}
+
+// changed returns the job after a conditional update of it, or errWrongStatus
+// when the update did not apply because the job exists in another status.
+func changed(ctx context.Context, client *ent.Client, id, n int, err, errWrongStatus error) (*ent.Job, error) {
+	if err != nil {
+		return nil, err
+	}
+	j, err := client.Job.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, errWrongStatus
+	}
+	return j, nil
+}
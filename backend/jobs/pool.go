@@ -0,0 +1,266 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/job"
+	"github.com/darksford123x/app/tenant"
+)
+
+// Handler runs a job. A job whose handler returns an error is retried with
+// exponential backoff until it used up its attempts, unless the error is
+// permanent. The context is cancelled when the lease on the job expires.
+type Handler func(ctx context.Context, j *ent.Job) error
+
+// permanent marks an error retrying will not fix.
+type permanent struct{ err error }
+
+func (p permanent) Error() string { return p.err.Error() }
+func (p permanent) Unwrap() error { return p.err }
+
+// Permanent wraps err so that the job failing with it is dead at once
+// instead of being retried.
+func Permanent(err error) error {
+	return permanent{err}
+}
+
+// IsPermanent reports whether err was marked as permanent.
+func IsPermanent(err error) bool {
+	var p permanent
+	return errors.As(err, &p)
+}
+
+// Options configure a pool.
+type Options struct {
+	// Workers is the number of jobs run at the same time.
+	Workers int
+	// PollInterval is how long an idle worker waits before looking for
+	// due jobs again.
+	PollInterval time.Duration
+	// Lease is how long a job may run before another worker may claim it.
+	Lease time.Duration
+	// Backoff is the delay before the first retry; it doubles with every
+	// failed attempt up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// Pool is a set of workers running the jobs of the kinds it has handlers
+// for.
+type Pool struct {
+	client   *ent.Client
+	opts     Options
+	name     string
+	handlers map[string]Handler
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewPool creates a pool of workers. Handlers must be registered before the
+// pool is started.
+func NewPool(client *ent.Client, opts Options) *Pool {
+	host, _ := os.Hostname()
+	return &Pool{
+		client:   client,
+		opts:     opts,
+		name:     fmt.Sprintf("%s-%d", host, os.Getpid()),
+		handlers: make(map[string]Handler),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Handle registers the handler of a kind of job.
+func (p *Pool) Handle(kind string, h Handler) {
+	p.handlers[kind] = h
+}
+
+// Start starts the workers. They run until Stop is called.
+func (p *Pool) Start() {
+	for i := 0; i < p.opts.Workers; i++ {
+		p.wg.Add(1)
+		go p.work(fmt.Sprintf("%s/%d", p.name, i))
+	}
+}
+
+// Stop stops claiming jobs and waits for the running ones to finish, or for
+// ctx to be done.
+func (p *Pool) Stop(ctx context.Context) error {
+	close(p.stop)
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// work claims and runs jobs until the pool is stopped.
+func (p *Pool) work(worker string) {
+	defer p.wg.Done()
+	for {
+		select {
+		case <-p.stop:
+			return
+		default:
+		}
+		j, err := p.claim(tenant.System(context.Background()), worker)
+		if err != nil {
+			log.Printf("jobs: %s: claiming a job: %v", worker, err)
+		}
+		if j == nil {
+			select {
+			case <-p.stop:
+				return
+			case <-time.After(p.opts.PollInterval):
+			}
+			continue
+		}
+		p.run(worker, j)
+	}
+}
+
+// claim takes the lease on the next due job of any organization, with the
+// organization it is run for. It returns nil when there is none.
+func (p *Pool) claim(ctx context.Context, worker string) (*ent.Job, error) {
+	if len(p.handlers) == 0 {
+		return nil, nil
+	}
+	kinds := make([]string, 0, len(p.handlers))
+	for k := range p.handlers {
+		kinds = append(kinds, k)
+	}
+
+	now := time.Now()
+	candidates, err := p.client.Job.
+		Query().
+		Where(
+			job.KindIn(kinds...),
+			job.Or(
+				job.And(job.StatusEQ(job.StatusPending), job.RunAtLTE(now)),
+				// The worker holding the lease died.
+				job.And(job.StatusEQ(job.StatusRunning), job.LockedUntilLT(now)),
+			),
+		).
+		Order(ent.Asc(job.FieldRunAt), ent.Asc(job.FieldID)).
+		Limit(p.opts.Workers).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range candidates {
+		// The update only applies if no other worker claimed the job
+		// since it was read: every claim changes the attempts.
+		n, err := p.client.Job.
+			Update().
+			Where(
+				job.ID(c.ID),
+				job.StatusEQ(c.Status),
+				job.Attempts(c.Attempts),
+			).
+			SetStatus(job.StatusRunning).
+			SetLockedBy(worker).
+			SetLockedUntil(now.Add(p.opts.Lease)).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 1 {
+			return p.client.Job.
+				Query().
+				Where(job.ID(c.ID)).
+				WithOrganization().
+				Only(ctx)
+		}
+	}
+	return nil, nil
+}
+
+// run runs a claimed job and records the outcome.
+func (p *Pool) run(worker string, j *ent.Job) {
+	var err error
+	if j.Attempts > j.MaxAttempts {
+		// The job was claimed again after its last attempt lost the
+		// lease.
+		err = Permanent(fmt.Errorf("lease expired on the last attempt (previous error: %s)", j.LastError))
+	} else {
+		err = p.call(j)
+	}
+
+	ctx := tenant.System(context.Background())
+	update := p.client.Job.
+		Update().
+		Where(
+			job.ID(j.ID),
+			job.StatusEQ(job.StatusRunning),
+			job.LockedBy(worker),
+			job.Attempts(j.Attempts),
+		).
+		ClearLockedBy().
+		ClearLockedUntil()
+
+	now := time.Now()
+	switch {
+	case err == nil:
+		update.SetStatus(job.StatusSucceeded).SetFinishedAt(now)
+	case IsPermanent(err) || j.Attempts >= j.MaxAttempts:
+		log.Printf("jobs: %s job %d is dead after %d attempts: %v", j.Kind, j.ID, j.Attempts, err)
+		update.SetStatus(job.StatusDead).SetFinishedAt(now).SetLastError(err.Error())
+	default:
+		update.
+			SetStatus(job.StatusPending).
+			SetRunAt(now.Add(p.backoff(j.Attempts))).
+			SetLastError(err.Error())
+	}
+	// No rows change when the job was cancelled or its lease was taken
+	// over meanwhile; the outcome is then discarded.
+	if _, err := update.Save(ctx); err != nil {
+		log.Printf("jobs: %s: recording the outcome of job %d: %v", worker, j.ID, err)
+	}
+}
+
+// call runs the handler of the job for the organization that enqueued it,
+// turning a panic into an error.
+func (p *Pool) call(j *ent.Job) (err error) {
+	org := j.Edges.Organization
+	if org == nil {
+		return Permanent(tenant.ErrMissing)
+	}
+	ctx, cancel := context.WithTimeout(tenant.NewContext(context.Background(), org.ID), p.opts.Lease)
+	defer cancel()
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("panic: %v", v)
+		}
+	}()
+	return p.handlers[j.Kind](ctx, j)
+}
+
+// backoff returns the delay before the retry following the given attempt.
+// A random part of up to a quarter of the delay spreads out retries of jobs
+// that failed together.
+func (p *Pool) backoff(attempt int) time.Duration {
+	d := p.opts.Backoff
+	for i := 1; i < attempt && d < p.opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.opts.MaxBackoff {
+		d = p.opts.MaxBackoff
+	}
+	return d + time.Duration(rand.Int63n(int64(d)/4+1))
+}
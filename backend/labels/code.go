@@ -0,0 +1,125 @@
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/qr"
+)
+
+// Symbology is the kind of code content is rendered as.
+type Symbology string
+
+// The symbologies of the labels: QR codes for the cameras of phones and
+// Code128 barcodes for handheld scanners.
+const (
+	QR      Symbology = "qr"
+	Code128 Symbology = "code128"
+)
+
+// ErrSymbology is returned for symbologies other than QR and Code128.
+var ErrSymbology = errors.New("labels: unknown symbology")
+
+// Code is the matrix of modules of an encoded code, with its quiet zone.
+type Code struct {
+	bc barcode.Barcode
+	// cols and rows are the modules of the code, and quiet the modules of
+	// the quiet zone around it. The rows of linear codes are barHeight
+	// modules high.
+	cols, rows, quiet, barHeight int
+}
+
+// Encode encodes content as a code of a symbology.
+func Encode(s Symbology, content string) (*Code, error) {
+	var (
+		bc  barcode.Barcode
+		err error
+	)
+	switch s {
+	case QR:
+		bc, err = qr.Encode(content, qr.M, qr.Auto)
+	case Code128:
+		bc, err = code128.Encode(content)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrSymbology, s)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("labels: encoding %q: %w", content, err)
+	}
+	b := bc.Bounds()
+	if b.Dy() == 1 {
+		return &Code{bc: bc, cols: b.Dx(), rows: 1, quiet: 10, barHeight: 40}, nil
+	}
+	return &Code{bc: bc, cols: b.Dx(), rows: b.Dy(), quiet: 4, barHeight: 1}, nil
+}
+
+// Size returns the width and height of the code in modules, with the quiet
+// zone. Linear codes have no quiet zone above and below.
+func (c *Code) Size() (width, height int) {
+	if c.rows == 1 {
+		return c.cols + 2*c.quiet, c.barHeight
+	}
+	return c.cols + 2*c.quiet, c.rows + 2*c.quiet
+}
+
+// runs calls f with the position and length of the runs of dark modules of
+// each row, and the height of the row, in modules from the top left corner
+// of the quiet zone.
+func (c *Code) runs(f func(x, y, n, height int)) {
+	b := c.bc.Bounds()
+	dark := func(x, y int) bool {
+		g := color.GrayModel.Convert(c.bc.At(b.Min.X+x, b.Min.Y+y)).(color.Gray)
+		return g.Y < 0x80
+	}
+	top := c.quiet
+	if c.rows == 1 {
+		top = 0
+	}
+	for y := 0; y < c.rows; y++ {
+		for x := 0; x < c.cols; {
+			if !dark(x, y) {
+				x++
+				continue
+			}
+			n := 1
+			for x+n < c.cols && dark(x+n, y) {
+				n++
+			}
+			f(c.quiet+x, top+y*c.barHeight, n, c.barHeight)
+			x += n
+		}
+	}
+}
+
+// PNG writes the code as a PNG image of scale pixels per module.
+func (c *Code) PNG(w io.Writer, scale int) error {
+	width, height := c.Size()
+	img := image.NewGray(image.Rect(0, 0, width*scale, height*scale))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	c.runs(func(x, y, n, h int) {
+		r := image.Rect(x*scale, y*scale, (x+n)*scale, (y+h)*scale)
+		draw.Draw(img, r, image.Black, image.Point{}, draw.Src)
+	})
+	return png.Encode(w, img)
+}
+
+// SVG writes the code as an SVG image of scale pixels per module.
+func (c *Code) SVG(w io.Writer, scale int) error {
+	width, height := c.Size()
+	var path strings.Builder
+	c.runs(func(x, y, n, h int) {
+		fmt.Fprintf(&path, "M%d %dh%dv%dh-%dz", x, y, n, h, n)
+	})
+	_, err := fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+		`<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="%s"/></svg>`,
+		width*scale, height*scale, width, height, width, height, path.String())
+	return err
+}
@@ -0,0 +1,139 @@
+// Package labels gives equipment the asset tags printed on their labels, and
+// renders the labels: the tags as QR codes and Code128 barcodes in PNG or
+// SVG, and sheets of labels in PDF for adhesive label paper.
+package labels
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/tenant"
+)
+
+// Prefix starts the asset tags given to equipment, followed by the number
+// of the tag in the sequence of the organization.
+const Prefix = "EQ-"
+
+// ErrReservedTag is returned when equipment is given an asset tag starting
+// with Prefix, which are only given by AssignTag.
+var ErrReservedTag = fmt.Errorf("labels: asset tags starting with %s are given automatically", Prefix)
+
+// attempts bounds how often AssignTag creates equipment again with the
+// following tag.
+const attempts = 3
+
+// Tag returns the asset tag of a number of the sequence.
+func Tag(n int) string {
+	return fmt.Sprintf("%s%06d", Prefix, n)
+}
+
+// Content returns what the QR code of a tag encodes: the URL scanning it
+// opens, which is the tag appended to scanURL, or the tag itself when
+// scanURL is empty.
+func Content(scanURL, tag string) string {
+	return scanURL + tag
+}
+
+// AssignTag is the hook on equipment that gives the equipment created
+// without an asset tag the next one of its organization. The tags of the
+// sequence cannot be given by hand, so that it never runs into them.
+func AssignTag(next ent.Mutator) ent.Mutator {
+	return hook.EquipmentFunc(func(ctx context.Context, m *ent.EquipmentMutation) (ent.Value, error) {
+		tag, ok := m.AssetTag()
+		switch {
+		case ok && strings.HasPrefix(tag, Prefix):
+			if m.Op().Is(ent.OpUpdateOne) {
+				if old, err := m.OldAssetTag(ctx); err == nil && old == tag {
+					break
+				}
+			}
+			return nil, ErrReservedTag
+		case !ok && m.Op().Is(ent.OpCreate):
+			return createTagged(ctx, m, next)
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// createTagged creates the equipment of m with the next tag. Equipment
+// created concurrently may take the tag first, failing the unique index of
+// the tags of the organization, and the equipment is then given the
+// following one.
+func createTagged(ctx context.Context, m *ent.EquipmentMutation, next ent.Mutator) (v ent.Value, err error) {
+	for i := 0; i < attempts; i++ {
+		var tag string
+		if tag, err = nextTag(ctx, m.Client()); err != nil {
+			return nil, err
+		}
+		m.SetAssetTag(tag)
+		v, err = next.Mutate(ctx, m)
+		if !ent.IsConstraintError(err) {
+			break
+		}
+	}
+	return v, err
+}
+
+// nextTag returns the tag following the last one of the sequence of the
+// organization of the context.
+func nextTag(ctx context.Context, client *ent.Client) (string, error) {
+	last, err := client.Equipment.
+		Query().
+		Where(equipment.AssetTagHasPrefix(Prefix)).
+		Order(ent.Desc(equipment.FieldAssetTag)).
+		First(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return Tag(1), nil
+	case err != nil:
+		return "", err
+	}
+	var seq int
+	if _, err := fmt.Sscanf(last.AssetTag[len(Prefix):], "%d", &seq); err != nil {
+		return "", fmt.Errorf("labels: malformed asset tag %q", last.AssetTag)
+	}
+	return Tag(seq + 1), nil
+}
+
+// Backfill gives the equipment created before there were asset tags the
+// next tags of their organizations, in the order it was created. It returns
+// the number of tags given, and is run after the schema is migrated.
+func Backfill(ctx context.Context, client *ent.Client) (int, error) {
+	untagged, err := client.Equipment.
+		Query().
+		Where(equipment.AssetTagIsNil()).
+		WithOrganization().
+		Order(ent.Asc(equipment.FieldID)).
+		All(tenant.System(ctx))
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, eq := range untagged {
+		org := eq.Edges.Organization
+		if org == nil {
+			continue
+		}
+		ctx := tenant.NewContext(ctx, org.ID)
+		tag, err := nextTag(ctx, client)
+		if err != nil {
+			return n, err
+		}
+		// The tag is set with a bulk update of the equipment, which
+		// AssignTag does not refuse.
+		err = client.Equipment.
+			Update().
+			Where(equipment.ID(eq.ID)).
+			SetAssetTag(tag).
+			Exec(ctx)
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
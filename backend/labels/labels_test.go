@@ -0,0 +1,71 @@
+package labels_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/labels"
+	"github.com/darksford123x/app/servertest"
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// tagged matches the tags of the sequence in the statements.
+var tagged = regexp.MustCompile(labels.Prefix + `\d{6}`)
+
+func TestAssignTagRace(t *testing.T) {
+	for _, tt := range []struct {
+		conflicts int
+		want      string
+	}{
+		{conflicts: 0, want: labels.Tag(2)},
+		{conflicts: 2, want: labels.Tag(4)},
+		{conflicts: 3},
+	} {
+		t.Run(fmt.Sprint(tt.conflicts), func(t *testing.T) {
+			h := servertest.New(t)
+			ctx := h.Context()
+			h.Equipment().SaveX(ctx)
+			spare := h.Equipment().SetAssetTag("SPARE-1").SaveX(ctx)
+
+			// Before inserting equipment, other equipment takes its tag, as
+			// if created concurrently since the tag was chosen.
+			conflicts := 0
+			drv := dialect.DebugWithContext(h.Driver, func(_ context.Context, v ...interface{}) {
+				stmt := fmt.Sprint(v...)
+				if conflicts == tt.conflicts || !strings.Contains(stmt, "INSERT INTO `equipment`") {
+					return
+				}
+				conflicts++
+				h.Client.Equipment.
+					Update().
+					Where(equipment.ID(spare.ID)).
+					SetAssetTag(tagged.FindString(stmt)).
+					ExecX(ctx)
+			})
+			racing := ent.NewClient(ent.Driver(drv))
+			eq, err := racing.Equipment.
+				Create().
+				SetName("Scope").
+				SetSerialNumber("SC-1").
+				SetModel("TBS1052C").
+				Save(ctx)
+			if tt.want == "" {
+				if !ent.IsConstraintError(err) {
+					t.Errorf("creating equipment losing every tag: %v, want a constraint error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if eq.AssetTag != tt.want {
+				t.Errorf("asset tag = %s, want %s", eq.AssetTag, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package labels
+
+import (
+	"io"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Label is what is printed on the label of a piece of equipment.
+type Label struct {
+	// Tag is printed as text and a Code128 barcode, and Content as a QR
+	// code.
+	Tag     string
+	Content string
+	// Name and Location describe the equipment above the tag.
+	Name     string
+	Location string
+}
+
+// The sheets are A4 pages of 3 by 8 labels of 70 by 37.125 mm, the most
+// common adhesive label paper, with a margin inside each label.
+const (
+	columns     = 3
+	rows        = 8
+	labelWidth  = 70.0
+	labelHeight = 37.125
+	margin      = 3.0
+	// maxModule is the widest module of the barcodes, in mm.
+	maxModule = 0.35
+)
+
+// Sheet writes the labels as a PDF of sheets of labels, in order from the top
+// left label of the first page.
+func Sheet(w io.Writer, labels []Label) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	if len(labels) == 0 {
+		pdf.AddPage()
+	}
+	for i, l := range labels {
+		if i%(columns*rows) == 0 {
+			pdf.AddPage()
+		}
+		cell := i % (columns * rows)
+		x := float64(cell%columns)*labelWidth + margin
+		y := float64(cell/columns)*labelHeight + margin
+		if err := label(pdf, tr, x, y, l); err != nil {
+			return err
+		}
+	}
+	return pdf.Output(w)
+}
+
+// label draws a label at x, y, inside the margin: the QR code on the left,
+// and the name, location, tag and barcode on the right.
+func label(pdf *gofpdf.Fpdf, tr func(string) string, x, y float64, l Label) error {
+	size := labelHeight - 2*margin
+	qr, err := Encode(QR, l.Content)
+	if err != nil {
+		return err
+	}
+	drawCode(pdf, qr, x, y, size, size)
+
+	x += size + 2
+	width := labelWidth - 2*margin - size - 2
+	text := func(s string, style string, pt float64, gray int, top float64) {
+		pdf.SetFont("Helvetica", style, pt)
+		pdf.SetTextColor(gray, gray, gray)
+		s = tr(s)
+		for s != "" && pdf.GetStringWidth(s) > width {
+			s = s[:len(s)-1]
+		}
+		pdf.Text(x, y+top, s)
+	}
+	text(l.Name, "", 8, 0, 4)
+	text(l.Location, "", 7, 96, 8)
+	text(l.Tag, "B", 11, 0, 15)
+
+	bar, err := Encode(Code128, l.Tag)
+	if err != nil {
+		return err
+	}
+	cols, _ := bar.Size()
+	barWidth := width
+	if module := barWidth / float64(cols); module > maxModule {
+		barWidth = maxModule * float64(cols)
+	}
+	drawCode(pdf, bar, x, y+size-9, barWidth, 9)
+	return nil
+}
+
+// drawCode draws a code in the rectangle at x, y of width w and height h, in mm.
+func drawCode(pdf *gofpdf.Fpdf, c *Code, x, y, w, h float64) {
+	cols, rows := c.Size()
+	mx, my := w/float64(cols), h/float64(rows)
+	pdf.SetFillColor(0, 0, 0)
+	c.runs(func(cx, cy, n, height int) {
+		pdf.Rect(x+float64(cx)*mx, y+float64(cy)*my, float64(n)*mx, float64(height)*my, "F")
+	})
+}
@@ -0,0 +1,314 @@
+// Package locations keeps the tree of places equipment is kept at: campuses,
+// the buildings of a campus, the floors of a building and the rooms of a
+// floor. Every change of the location of equipment is recorded as a move by
+// the hook of the equipment, so the moves of equipment explain where it is.
+package locations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/equipmentmove"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/location"
+)
+
+var (
+	// ErrHierarchy is returned when a location is not in a location of
+	// the level above, or a campus is in another location.
+	ErrHierarchy = errors.New("locations: invalid parent")
+	// ErrInUse is returned when a location that has locations or
+	// equipment, or is in the moves of equipment, is deleted.
+	ErrInUse = errors.New("locations: location is in use")
+	// ErrSameLocation is returned when equipment is moved to the location
+	// it is at.
+	ErrSameLocation = errors.New("locations: equipment is already at the location")
+)
+
+// levels are the kinds of locations from the root of the tree down.
+var levels = []location.Kind{
+	location.KindCampus,
+	location.KindBuilding,
+	location.KindFloor,
+	location.KindRoom,
+}
+
+// parentKind returns the kind of the parent of a location of the kind, and
+// false for campuses, which have none.
+func parentKind(kind location.Kind) (location.Kind, bool) {
+	for i, k := range levels {
+		if k == kind && i > 0 {
+			return levels[i-1], true
+		}
+	}
+	return "", false
+}
+
+// childKind returns the kind of the children of a location of the kind, and
+// false for rooms, which have none.
+func childKind(kind location.Kind) (location.Kind, bool) {
+	for i, k := range levels {
+		if k == kind && i+1 < len(levels) {
+			return levels[i+1], true
+		}
+	}
+	return "", false
+}
+
+// ValidateParent is the hook on locations that keeps the levels of the tree:
+// the parent of a location must be of the level above it, and the children
+// of a location whose kind changes must remain of the level below. As a
+// location is always a level below its parent, the tree has no cycles.
+func ValidateParent(next ent.Mutator) ent.Mutator {
+	return hook.LocationFunc(func(ctx context.Context, m *ent.LocationMutation) (ent.Value, error) {
+		client := m.Client()
+		id, exists := m.ID()
+		kind, kindSet := m.Kind()
+		if !kindSet && exists {
+			old, err := m.OldKind(ctx)
+			if err != nil {
+				return nil, err
+			}
+			kind = old
+		}
+
+		var parent *ent.Location
+		parentID, parentSet := m.ParentID()
+		switch {
+		case parentSet:
+			p, err := client.Location.Get(ctx, parentID)
+			if err != nil {
+				return nil, err
+			}
+			parent = p
+		case exists && !m.ParentCleared() && kindSet:
+			p, err := client.Location.
+				Query().
+				Where(location.HasChildrenWith(location.ID(id))).
+				Only(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return nil, err
+			}
+			parent = p
+		case exists && !m.ParentCleared():
+			// Neither the kind nor the parent changes.
+			return next.Mutate(ctx, m)
+		}
+
+		want, ok := parentKind(kind)
+		switch {
+		case !ok && parent != nil:
+			return nil, fmt.Errorf("%w: a %s is in no location", ErrHierarchy, kind)
+		case ok && parent == nil:
+			return nil, fmt.Errorf("%w: a %s is in a %s", ErrHierarchy, kind, want)
+		case ok && parent.Kind != want:
+			return nil, fmt.Errorf("%w: a %s is in a %s, not a %s", ErrHierarchy, kind, want, parent.Kind)
+		}
+
+		if exists && kindSet {
+			children := client.Location.
+				Query().
+				Where(location.HasParentWith(location.ID(id)))
+			if child, ok := childKind(kind); ok {
+				children.Where(location.KindNEQ(child))
+			}
+			n, err := children.Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if n > 0 {
+				return nil, fmt.Errorf("%w: the locations in a %s are not all of the level below it", ErrHierarchy, kind)
+			}
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// Descendants returns the ID of a location and those of all the locations
+// under it. It does not check that the location exists.
+func Descendants(ctx context.Context, client *ent.Client, id int) ([]int, error) {
+	ids := []int{id}
+	for level := ids; len(level) > 0; {
+		children, err := client.Location.
+			Query().
+			Where(location.HasParentWith(location.IDIn(level...))).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, children...)
+		level = children
+	}
+	return ids, nil
+}
+
+// Tree returns a location with the locations under it loaded as its
+// children, recursively, in the order of their names.
+func Tree(ctx context.Context, client *ent.Client, id int) (*ent.Location, error) {
+	root, err := client.Location.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	nodes := map[int]*ent.Location{root.ID: root}
+	for level := []int{root.ID}; len(level) > 0; {
+		children, err := client.Location.
+			Query().
+			Where(location.HasParentWith(location.IDIn(level...))).
+			WithParent().
+			Order(ent.Asc(location.FieldName), ent.Asc(location.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		level = level[:0]
+		for _, c := range children {
+			parent := nodes[c.Edges.Parent.ID]
+			parent.Edges.Children = append(parent.Edges.Children, c)
+			c.Edges.Parent = nil
+			nodes[c.ID] = c
+			level = append(level, c.ID)
+		}
+	}
+	return root, nil
+}
+
+// Equipment returns the equipment kept at a location or any location under
+// it, with their locations loaded.
+func Equipment(ctx context.Context, client *ent.Client, id int) ([]*ent.Equipment, error) {
+	if _, err := client.Location.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	ids, err := Descendants(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	return client.Equipment.
+		Query().
+		Where(equipment.HasLocationWith(location.IDIn(ids...))).
+		WithLocation().
+		Order(ent.Asc(equipment.FieldName), ent.Asc(equipment.FieldID)).
+		All(ctx)
+}
+
+// noteKey is the key of the note of a move in the context of the update
+// moving equipment.
+type noteKey struct{}
+
+// Hook records the moves of equipment: every change of the location of
+// equipment, including the first one, adds an EquipmentMove with the signed
+// in user of the context as the mover.
+func Hook() ent.Hook {
+	hk := func(next ent.Mutator) ent.Mutator {
+		return hook.EquipmentFunc(func(ctx context.Context, m *ent.EquipmentMutation) (ent.Value, error) {
+			to, set := m.LocationID()
+			if !set && !m.LocationCleared() {
+				return next.Mutate(ctx, m)
+			}
+			client := m.Client()
+			var from int
+			if id, exists := m.ID(); exists {
+				old, err := client.Equipment.
+					Query().
+					Where(equipment.ID(id)).
+					QueryLocation().
+					OnlyID(ctx)
+				if err != nil && !ent.IsNotFound(err) {
+					return nil, err
+				}
+				from = old
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil || from == to {
+				return v, err
+			}
+			eq, ok := v.(*ent.Equipment)
+			if !ok {
+				return nil, fmt.Errorf("locations: unexpected value %T of an equipment mutation", v)
+			}
+			move := client.EquipmentMove.
+				Create().
+				SetEquipmentID(eq.ID)
+			if from != 0 {
+				move.SetFromLocationID(from)
+			}
+			if to != 0 {
+				move.SetToLocationID(to)
+			}
+			if u := auth.FromContext(ctx); u != nil {
+				move.SetMovedByID(u.ID)
+			}
+			if note, ok := ctx.Value(noteKey{}).(string); ok {
+				move.SetNote(note)
+			}
+			if _, err := move.Save(ctx); err != nil {
+				return nil, err
+			}
+			return v, nil
+		})
+	}
+	return hook.On(hk, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// Move moves equipment to a location and returns the move recorded for it
+// by Hook, which must be used by the client of the transaction, with the
+// note and the locations and mover loaded.
+func Move(ctx context.Context, tx *ent.Tx, equipmentID, locationID int, note string) (*ent.EquipmentMove, error) {
+	eq, err := tx.Equipment.
+		Query().
+		Where(equipment.ID(equipmentID)).
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Location.Get(ctx, locationID); err != nil {
+		return nil, err
+	}
+	if eq.Edges.Location != nil && eq.Edges.Location.ID == locationID {
+		return nil, ErrSameLocation
+	}
+	_, err = tx.Equipment.
+		UpdateOne(eq).
+		SetLocationID(locationID).
+		Save(context.WithValue(ctx, noteKey{}, note))
+	if err != nil {
+		return nil, err
+	}
+	return tx.EquipmentMove.
+		Query().
+		Where(equipmentmove.HasEquipmentWith(equipment.ID(eq.ID))).
+		WithFromLocation().
+		WithToLocation().
+		WithMovedBy().
+		Order(ent.Desc(equipmentmove.FieldID)).
+		First(ctx)
+}
+
+// Delete deletes a location that has no locations or equipment in it and was
+// never moved to or from, so that the moves of equipment keep their places.
+func Delete(ctx context.Context, tx *ent.Tx, id int) error {
+	if _, err := tx.Location.Get(ctx, id); err != nil {
+		return err
+	}
+	for _, count := range []func(context.Context) (int, error){
+		tx.Location.Query().Where(location.HasParentWith(location.ID(id))).Count,
+		tx.Equipment.Query().Where(equipment.HasLocationWith(location.ID(id))).Count,
+		tx.EquipmentMove.Query().Where(equipmentmove.Or(
+			equipmentmove.HasFromLocationWith(location.ID(id)),
+			equipmentmove.HasToLocationWith(location.ID(id)),
+		)).Count,
+	} {
+		n, err := count(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return ErrInUse
+		}
+	}
+	return tx.Location.DeleteOneID(id).Exec(ctx)
+}
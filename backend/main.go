@@ -1,19 +1,68 @@
+// Command app serves the repair service and administers its database.
+//
+// It is configured by environment variables, see package config. The
+// subcommands are:
+//
+//	serve          serve the REST API, GraphQL and gRPC; the default
+//	migrate        create or update the tables of the database
+//	user           create, list, disable and change the role of users
+//	org            create and list the organizations sharing the deployment
+//	seed           fill an empty database with demo data
+//	backup         write the data of the database to an archive
+//	restore        restore an archive into the database
+//	check-config   check the configuration and the services it names
+//
+// The user and org subcommands change the database directly, without the
+// server, so that the first administrator can be created before anyone can
+// sign in:
+//
+//	app user create -email admin@example.com -name Admin -age 30 -role admin
+//
+// Each organization, such as a faculty, only sees its own data. The first
+// administrator of another one is created in it:
+//
+//	app org create Science
+//	app user create -org Science -email dean@example.com -name Dean -age 50 -role admin
 package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 
-	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/config"
 	_ "github.com/darksford123x/app/docs"
 	"github.com/darksford123x/app/ent"
-	"github.com/gin-contrib/cors"
-	"github.com/gin-gonic/gin"
+	_ "github.com/darksford123x/app/ent/runtime"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
+	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
-	swaggerFiles "github.com/swaggo/files"
-	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
+// command is a subcommand of the binary.
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, args []string) error
+}
+
+// commands are the subcommands, in the order of the usage.
+var commands = []command{
+	{"serve", "serve the REST API, GraphQL and gRPC", runServe},
+	{"migrate", "create or update the tables of the database", runMigrate},
+	{"user", "create, list, disable and change the role of users", runUser},
+	{"org", "create and list the organizations sharing the deployment", runOrg},
+	{"seed", "fill an empty database with demo data", runSeed},
+	{"backup", "write the data of the database to an archive", runBackup},
+	{"restore", "restore an archive into the database", runRestore},
+	{"check-config", "check the configuration and the services it names", runCheckConfig},
+}
+
 // @title SUT SA Example API
 // @version 1.0
 // @description This is a sample server for SUT SE 2563
@@ -28,49 +77,95 @@ import (
 // @host localhost:8080
 // @BasePath /api/v1
 
-// @securityDefinitions.basic BasicAuth
-
 // @securityDefinitions.apikey ApiKeyAuth
 // @in header
 // @name Authorization
+func main() {
+	args := os.Args[1:]
+	// Without a subcommand the binary serves, as it did before it had any.
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") && !help(args[0]) {
+		args = append([]string{"serve"}, args...)
+	}
+	if help(args[0]) {
+		usage(os.Stdout, "", commands)
+		return
+	}
+	cmd, ok := lookup(commands, args[0])
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", args[0])
+		usage(os.Stderr, "", commands)
+		os.Exit(2)
+	}
+	if err := cmd.run(context.Background(), args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%s %s: %v\n", program(), cmd.name, err)
+		os.Exit(1)
+	}
+}
 
-// @securitydefinitions.oauth2.application OAuth2Application
-// @tokenUrl https://example.com/oauth/token
-// @scope.write Grants write access
-// @scope.admin Grants read and write access to administrative information
+// help reports whether an argument asks for the usage.
+func help(arg string) bool {
+	return arg == "help" || arg == "-h" || arg == "-help" || arg == "--help"
+}
 
-// @securitydefinitions.oauth2.implicit OAuth2Implicit
-// @authorizationUrl https://example.com/oauth/authorize
-// @scope.write Grants write access
-// @scope.admin Grants read and write access to administrative information
+// lookup returns the command of the given name.
+func lookup(commands []command, name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
 
-// @securitydefinitions.oauth2.password OAuth2Password
-// @tokenUrl https://example.com/oauth/token
-// @scope.read Grants read access
-// @scope.write Grants write access
-// @scope.admin Grants read and write access to administrative information
+// usage writes the usage of a command with subcommands, the binary itself
+// when parent is empty.
+func usage(w io.Writer, parent string, commands []command) {
+	name := program()
+	if parent != "" {
+		name += " " + parent
+	}
+	fmt.Fprintf(w, "usage: %s <command> [flags] [arguments]\n\ncommands:\n", name)
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-14s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintf(w, "\nrun %s <command> -h for the flags of a command\n", name)
+}
 
-// @securitydefinitions.oauth2.accessCode OAuth2AccessCode
-// @tokenUrl https://example.com/oauth/token
-// @authorizationUrl https://example.com/oauth/authorize
-// @scope.admin Grants read and write access to administrative information
-func main() {
-	router := gin.Default()
-	router.Use(cors.Default())
+// program returns the name the binary was run as.
+func program() string {
+	return filepath.Base(os.Args[0])
+}
 
-	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
-	if err != nil {
-		log.Fatalf("fail to open sqlite3: %v", err)
+// flags returns the flag set of a subcommand, whose usage shows how it is
+// called with the given synopsis of its arguments.
+func flags(name, synopsis string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: %s %s %s\n", program(), name, synopsis)
+		fs.PrintDefaults()
 	}
-	defer client.Close()
+	return fs
+}
 
-	if err := client.Schema.Create(context.Background()); err != nil {
-		log.Fatalf("failed creating schema resources: %v", err)
+// open loads the configuration and opens its database, without migrating
+// it nor registering any hook on the client.
+func open() (*config.Config, *entsql.Driver, *ent.Client, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, nil, err
 	}
+	drv, err := entsql.Open(cfg.DatabaseDriver, cfg.DatabaseURL)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("opening %s: %w", cfg.DatabaseDriver, err)
+	}
+	return cfg, drv, ent.NewClient(ent.Driver(drv)), nil
+}
 
-	v1 := router.Group("/api/v1")
-	controllers.NewUserController(v1, client)
-
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
-	router.Run()
+// persistent refuses the in-memory database of the default DATABASE_URL to
+// the commands that write data, which would be gone when they exit.
+func persistent(cfg *config.Config) error {
+	if strings.Contains(cfg.DatabaseURL, "mode=memory") || strings.Contains(cfg.DatabaseURL, ":memory:") {
+		return errors.New("DATABASE_URL is an in-memory database, which is gone when the command exits; set it to a file, e.g. file:dev.db?_fk=1")
+	}
+	return nil
 }
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// setenv sets an environment variable until the test ends.
+func setenv(t *testing.T, key, value string) {
+	t.Helper()
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+// database configures a database in a file of a temporary directory, which
+// is removed when the test ends.
+func database(t *testing.T) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	setenv(t, "DATABASE_DRIVER", "sqlite3")
+	setenv(t, "DATABASE_URL", "file:"+filepath.Join(dir, "app.db")+"?_fk=1")
+	setenv(t, "STORAGE_DIR", filepath.Join(dir, "uploads"))
+}
+
+// stdout returns what run writes to the standard output.
+func stdout(t *testing.T, run func() error) (string, error) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := os.Stdout
+	os.Stdout = w
+	out := make(chan string)
+	go func() {
+		var b bytes.Buffer
+		io.Copy(&b, r)
+		out <- b.String()
+	}()
+	err = run()
+	os.Stdout = saved
+	w.Close()
+	return <-out, err
+}
+
+// stdin makes input the standard input until the test ends.
+func stdin(t *testing.T, input string) {
+	t.Helper()
+	f, err := ioutil.TempFile("", "stdin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		f.Close()
+		os.Remove(f.Name())
+	})
+	if _, err := f.WriteString(input); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	saved := os.Stdin
+	os.Stdin = f
+	t.Cleanup(func() { os.Stdin = saved })
+}
+
+func TestUsage(t *testing.T) {
+	var b bytes.Buffer
+	usage(&b, "user", userCommands)
+	for _, c := range userCommands {
+		if !strings.Contains(b.String(), "  "+c.name+" ") {
+			t.Errorf("usage of user does not list %s:\n%s", c.name, b.String())
+		}
+	}
+	if !strings.Contains(b.String(), " user <command>") {
+		t.Errorf("usage of user is not of its subcommands:\n%s", b.String())
+	}
+
+	for _, name := range []string{"serve", "migrate", "user", "seed", "check-config"} {
+		if c, ok := lookup(commands, name); !ok || c.run == nil {
+			t.Errorf("lookup(%q) found no command", name)
+		}
+	}
+	if _, ok := lookup(commands, "-migrate"); ok {
+		t.Error("lookup of a flag found a command")
+	}
+	for _, arg := range []string{"help", "-h", "-help", "--help"} {
+		if !help(arg) {
+			t.Errorf("help(%q) = false, want true", arg)
+		}
+	}
+	if help("-migrate=false") {
+		t.Error("help of a flag of serve = true, want false")
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/migrate"
+	"github.com/darksford123x/app/labels"
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent/dialect"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
+)
+
+// runMigrate creates the missing tables, columns and indexes of the
+// database, or prints the statements that would with -dry-run. Serving
+// migrates as well unless told not to, so that deployments can migrate in a
+// step of their own.
+func runMigrate(ctx context.Context, args []string) error {
+	fs := flags("migrate", "[-dry-run]")
+	dryRun := fs.Bool("dry-run", false, "print the statements instead of running them")
+	fs.Parse(args)
+
+	_, drv, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if *dryRun {
+		return client.Schema.WriteTo(ctx, os.Stdout, migrate.WithDropIndex(true))
+	}
+	if err := migrateSchema(ctx, drv, client); err != nil {
+		return err
+	}
+	fmt.Println("the database is up to date")
+	return nil
+}
+
+// migrateSchema creates the missing tables, columns and indexes of the
+// database and drops the indexes no longer in the schema, such as the
+// unique indexes that became unique by organization. The rows written
+// before the deployment had organizations are then given the default one,
+// and the equipment created before there were asset tags is tagged. SQLite
+// databases first have the unique constraint of the serial numbers of the
+// equipment removed, see dropUniqueSerials.
+func migrateSchema(ctx context.Context, drv *entsql.Driver, client *ent.Client) error {
+	if err := dropUniqueSerials(ctx, drv); err != nil {
+		return fmt.Errorf("failed making serial numbers unique by organization: %w", err)
+	}
+	if err := client.Schema.Create(ctx, migrate.WithDropIndex(true)); err != nil {
+		return fmt.Errorf("failed creating schema resources: %w", err)
+	}
+	n, err := tenant.Adopt(ctx, drv, client)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		fmt.Fprintf(os.Stderr, "gave %d rows without an organization to the %s organization\n", n, tenant.DefaultName)
+	}
+	if n, err = labels.Backfill(ctx, client); err != nil {
+		return fmt.Errorf("failed tagging equipment: %w", err)
+	}
+	if n > 0 {
+		fmt.Fprintf(os.Stderr, "gave asset tags to %d pieces of equipment\n", n)
+	}
+	return nil
+}
+
+// uniqueSerials is how SQLite databases created while serial numbers were
+// unique across the organizations declare the column.
+const uniqueSerials = "`serial_number` varchar(255) UNIQUE NOT NULL"
+
+// equipmentTable matches the start of the statement creating the table of
+// the equipment.
+var equipmentTable = regexp.MustCompile("^CREATE TABLE (IF NOT EXISTS )?[`\"]equipment[`\"]")
+
+// dropUniqueSerials lets the organizations of a SQLite database own
+// equipment of the same serial number, which is now unique by organization.
+// Postgres drops the unique constraint of the column with the other indexes
+// no longer in the schema, but SQLite cannot drop a constraint declared on a
+// column: the table is created again without it, and the migration then
+// adds the indexes of the schema.
+func dropUniqueSerials(ctx context.Context, drv *entsql.Driver) error {
+	if drv.Dialect() != dialect.SQLite {
+		return nil
+	}
+	db := drv.DB()
+	var create string
+	err := db.QueryRowContext(ctx, "SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'equipment'").Scan(&create)
+	if err == sql.ErrNoRows || err == nil && !strings.Contains(create, uniqueSerials) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	create = strings.Replace(create, uniqueSerials, "`serial_number` varchar(255) NOT NULL", 1)
+	// SQLite quotes the name of renamed tables differently.
+	create = equipmentTable.ReplaceAllString(create, "CREATE TABLE `equipment_rebuilt`")
+
+	// Dropping the table must not set the equipment of the slips and moves
+	// to null, so the foreign keys are off on the connection rebuilding it.
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return err
+	}
+	defer conn.ExecContext(ctx, "PRAGMA foreign_keys = ON")
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// The sequence of the IDs is kept, so that the IDs of deleted equipment
+	// are not given again.
+	var seq int64
+	if err := tx.QueryRowContext(ctx, "SELECT seq FROM sqlite_sequence WHERE name = 'equipment'").Scan(&seq); err != nil && err != sql.ErrNoRows {
+		tx.Rollback()
+		return err
+	}
+	for _, stmt := range []string{
+		create,
+		"INSERT INTO `equipment_rebuilt` SELECT * FROM `equipment`",
+		"DROP TABLE `equipment`",
+		"ALTER TABLE `equipment_rebuilt` RENAME TO `equipment`",
+	} {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE sqlite_sequence SET seq = ? WHERE name = 'equipment' AND seq < ?", seq, seq); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
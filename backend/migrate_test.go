@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/tenant"
+)
+
+func TestMigrate(t *testing.T) {
+	database(t)
+	out, err := stdout(t, func() error { return runMigrate(context.Background(), []string{"-dry-run"}) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "CREATE TABLE") || !strings.Contains(out, "repair_slips") {
+		t.Errorf("migrate -dry-run printed %q, want the statements creating the tables", out)
+	}
+	// Migrating an up to date database changes nothing.
+	for i := 0; i < 2; i++ {
+		if out, err = stdout(t, func() error { return runMigrate(context.Background(), nil) }); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out, "up to date") {
+			t.Errorf("migrate printed %q", out)
+		}
+	}
+	out, err = stdout(t, func() error { return runMigrate(context.Background(), []string{"-dry-run"}) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "CREATE TABLE") {
+		t.Errorf("migrate -dry-run of a migrated database printed %q", out)
+	}
+}
+
+// legacySerials makes the serial numbers of a migrated SQLite database
+// unique across the organizations, as they were declared before they were
+// unique by organization.
+func legacySerials(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	_, drv, client, err := open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	conn, err := drv.DB().Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	var create string
+	if err := conn.QueryRowContext(ctx, "SELECT sql FROM sqlite_master WHERE name = 'equipment'").Scan(&create); err != nil {
+		t.Fatal(err)
+	}
+	create = strings.Replace(create, "`serial_number` varchar(255) NOT NULL", uniqueSerials, 1)
+	create = strings.Replace(create, "`equipment`", "`equipment_legacy`", 1)
+	for _, stmt := range []string{
+		"PRAGMA foreign_keys = OFF",
+		create,
+		"INSERT INTO `equipment_legacy` SELECT * FROM `equipment`",
+		"DROP TABLE `equipment`",
+		"ALTER TABLE `equipment_legacy` RENAME TO `equipment`",
+		"PRAGMA foreign_keys = ON",
+	} {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+}
+
+func TestMigrateSerials(t *testing.T) {
+	database(t)
+	ctx := context.Background()
+	migrated := func() {
+		t.Helper()
+		if _, err := stdout(t, func() error { return runMigrate(ctx, nil) }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	migrated()
+	_, _, client, err := open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	science := client.Organization.Create().SetName("Science").SaveX(ctx)
+	arts := client.Organization.Create().SetName("Arts").SaveX(ctx)
+	ctx = tenant.NewContext(ctx, science.ID)
+	reporter := client.User.Create().SetName("Malee").SetAge(30).SetEmail("malee@example.com").SaveX(ctx)
+	eq := client.Equipment.Create().SetName("Projector").SetSerialNumber("SN-1").SaveX(ctx)
+	slip := client.RepairSlip.Create().SetSymptom("Does not turn on").SetCategory("projector").SetReporter(reporter).SetEquipment(eq).SaveX(ctx)
+	legacySerials(t)
+	deleted := client.Equipment.Create().SetName("Projector").SetSerialNumber("SN-2").SaveX(ctx)
+	client.Equipment.DeleteOne(deleted).ExecX(ctx)
+	if _, err := client.Equipment.Create().SetName("Projector").SetSerialNumber("SN-1").Save(tenant.NewContext(ctx, arts.ID)); !ent.IsConstraintError(err) {
+		t.Fatalf("creating equipment of a taken serial number before migrating: %v, want a constraint error", err)
+	}
+
+	// The equipment keeps its slips and the IDs of deleted equipment are
+	// not given again.
+	migrated()
+	migrated()
+	if got := client.RepairSlip.QueryEquipment(slip).OnlyIDX(ctx); got != eq.ID {
+		t.Errorf("the slip is of equipment %d, want %d", got, eq.ID)
+	}
+	theirs, err := client.Equipment.Create().SetName("Projector").SetSerialNumber("SN-1").Save(tenant.NewContext(ctx, arts.ID))
+	if err != nil {
+		t.Fatalf("creating equipment of a serial number of another organization: %v", err)
+	}
+	if theirs.ID <= deleted.ID {
+		t.Errorf("new equipment has ID %d, want after the deleted %d", theirs.ID, deleted.ID)
+	}
+	if _, err := client.Equipment.Create().SetName("Projector").SetSerialNumber("SN-1").Save(ctx); !ent.IsConstraintError(err) {
+		t.Errorf("creating equipment of a serial number of the organization: %v, want a constraint error", err)
+	}
+}
@@ -0,0 +1,174 @@
+// Package money implements decimal-safe arithmetic for prices and taxes.
+//
+// Amounts, quantities and rates are fixed-point numbers with two decimal
+// places stored in an int64, so "123.45" baht is kept as 12345 satang and a
+// rate of "7" percent is kept as 700 basis points. No value ever passes
+// through a float.
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrSyntax is returned when a decimal string cannot be parsed.
+var ErrSyntax = errors.New("money: invalid decimal")
+
+// Amount is a monetary amount in satang (1/100 baht).
+type Amount int64
+
+// Quantity is a quantity with two decimal places, e.g. 1.5 hours of labour.
+type Quantity int64
+
+// Rate is a percentage with two decimal places, e.g. 7% VAT is 700.
+type Rate int64
+
+// ParseAmount parses a decimal string such as "1250.50".
+func ParseAmount(s string) (Amount, error) {
+	v, err := parse(s)
+	return Amount(v), err
+}
+
+// ParseQuantity parses a decimal string such as "1.5".
+func ParseQuantity(s string) (Quantity, error) {
+	v, err := parse(s)
+	return Quantity(v), err
+}
+
+// ParseRate parses a percentage such as "7" or "7.5".
+func ParseRate(s string) (Rate, error) {
+	v, err := parse(s)
+	return Rate(v), err
+}
+
+// Units returns the quantity of n whole units.
+func Units(n int) Quantity {
+	return Quantity(n * 100)
+}
+
+// Times returns the amount multiplied by q, rounded half away from zero.
+func (a Amount) Times(q Quantity) Amount {
+	return Amount(divRound(int64(a)*int64(q), 100))
+}
+
+// Percent returns r percent of the amount, rounded half away from zero.
+func (a Amount) Percent(r Rate) Amount {
+	return Amount(divRound(int64(a)*int64(r), 10000))
+}
+
+// String formats the amount with two decimal places.
+func (a Amount) String() string { return format(int64(a)) }
+
+// String formats the quantity with two decimal places.
+func (q Quantity) String() string { return format(int64(q)) }
+
+// String formats the rate with two decimal places.
+func (r Rate) String() string { return format(int64(r)) }
+
+// MarshalJSON encodes the amount as a decimal string.
+func (a Amount) MarshalJSON() ([]byte, error) { return json.Marshal(a.String()) }
+
+// MarshalJSON encodes the quantity as a decimal string.
+func (q Quantity) MarshalJSON() ([]byte, error) { return json.Marshal(q.String()) }
+
+// MarshalJSON encodes the rate as a decimal string.
+func (r Rate) MarshalJSON() ([]byte, error) { return json.Marshal(r.String()) }
+
+// UnmarshalJSON accepts a decimal string or a JSON number.
+func (a *Amount) UnmarshalJSON(b []byte) error {
+	v, err := unmarshal(b)
+	*a = Amount(v)
+	return err
+}
+
+// UnmarshalJSON accepts a decimal string or a JSON number.
+func (q *Quantity) UnmarshalJSON(b []byte) error {
+	v, err := unmarshal(b)
+	*q = Quantity(v)
+	return err
+}
+
+// UnmarshalJSON accepts a decimal string or a JSON number.
+func (r *Rate) UnmarshalJSON(b []byte) error {
+	v, err := unmarshal(b)
+	*r = Rate(v)
+	return err
+}
+
+func unmarshal(b []byte) (int64, error) {
+	s := string(b)
+	if s == "null" {
+		return 0, nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return 0, err
+		}
+	}
+	return parse(s)
+}
+
+// parse converts a decimal string with at most two fraction digits to its
+// fixed-point representation.
+func parse(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	if neg || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	if whole == "" && frac == "" || len(frac) > 2 || !digits(whole) || !digits(frac) {
+		return 0, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	frac += strings.Repeat("0", 2-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+	v, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	if neg {
+		v = -v
+	}
+	return v, nil
+}
+
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func format(v int64) string {
+	sign := ""
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+// divRound divides n by d, rounding half away from zero.
+func divRound(n, d int64) int64 {
+	q, r := n/d, n%d
+	if r < 0 {
+		r = -r
+	}
+	if 2*r >= d {
+		if n < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
@@ -0,0 +1,124 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDivRound(t *testing.T) {
+	for _, tc := range []struct {
+		n, d, want int64
+	}{
+		{10, 4, 3},
+		{9, 4, 2},
+		{11, 4, 3},
+		{-10, 4, -3},
+		{-9, 4, -2},
+		{-11, 4, -3},
+		{12, 4, 3},
+		{0, 4, 0},
+		{49, 100, 0},
+		{50, 100, 1},
+		{-50, 100, -1},
+	} {
+		if got := divRound(tc.n, tc.d); got != tc.want {
+			t.Errorf("divRound(%d, %d) = %d, want %d", tc.n, tc.d, got, tc.want)
+		}
+	}
+}
+
+func TestRounding(t *testing.T) {
+	// 7% VAT of 0.07 baht is 0.49 satang, and of 0.08 baht 0.56 satang.
+	for _, tc := range []struct {
+		amount Amount
+		rate   Rate
+		want   Amount
+	}{
+		{7, 700, 0},
+		{8, 700, 1},
+		{50, 100, 1},
+		{149, 100, 1},
+		{150, 100, 2},
+		{-150, 100, -2},
+		{93458, 700, 6542},
+		{100000, 1250, 12500},
+	} {
+		if got := tc.amount.Percent(tc.rate); got != tc.want {
+			t.Errorf("%s percent of %s = %s, want %s", tc.rate, tc.amount, got, tc.want)
+		}
+	}
+	for _, tc := range []struct {
+		amount Amount
+		q      Quantity
+		want   Amount
+	}{
+		{50000, 150, 75000},
+		{33333, 50, 16667},
+		{33333, 33, 11000},
+		{1, 50, 1},
+		{-1, 50, -1},
+		{250000, Units(3), 750000},
+	} {
+		if got := tc.amount.Times(tc.q); got != tc.want {
+			t.Errorf("%s times %s = %s, want %s", tc.amount, tc.q, got, tc.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want Amount
+	}{
+		{"1250.50", 125050},
+		{"1250.5", 125050},
+		{"1250", 125000},
+		{"1250.", 125000},
+		{".5", 50},
+		{"0.01", 1},
+		{"-3.25", -325},
+		{"+3", 300},
+		{" 12.00 ", 1200},
+	} {
+		got, err := ParseAmount(tc.s)
+		if err != nil || got != tc.want {
+			t.Errorf("ParseAmount(%q) = %d, %v, want %d", tc.s, got, err, tc.want)
+		}
+	}
+	for _, s := range []string{"", ".", "-", "1.234", "1,000", "1e3", "12a", "1.-5", "--1", "99999999999999999999"} {
+		if _, err := ParseAmount(s); !errors.Is(err, ErrSyntax) {
+			t.Errorf("ParseAmount(%q): %v, want %v", s, err, ErrSyntax)
+		}
+	}
+	if r, err := ParseRate("7"); err != nil || r != 700 {
+		t.Errorf(`ParseRate("7") = %d, %v, want 700`, r, err)
+	}
+	if q, err := ParseQuantity("1.5"); err != nil || q != 150 {
+		t.Errorf(`ParseQuantity("1.5") = %d, %v, want 150`, q, err)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var v struct {
+		Amount   Amount   `json:"amount"`
+		Quantity Quantity `json:"quantity"`
+		Rate     Rate     `json:"rate"`
+	}
+	if err := json.Unmarshal([]byte(`{"amount": "-0.05", "quantity": 2, "rate": 7.5}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Amount != -5 || v.Quantity != 200 || v.Rate != 750 {
+		t.Errorf("decoded %+v, want -5 satang, 2 units and 7.5%%", v)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"amount":"-0.05","quantity":"2.00","rate":"7.50"}`; string(b) != want {
+		t.Errorf("encoded %s, want %s", b, want)
+	}
+	if err := json.Unmarshal([]byte(`{"amount": "1.005"}`), &v); !errors.Is(err, ErrSyntax) {
+		t.Errorf("decoding 1.005: %v, want %v", err, ErrSyntax)
+	}
+}
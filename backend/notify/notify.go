@@ -0,0 +1,169 @@
+// Package notify emails reporters about the progress of their repair slips,
+// and supervisors about slips that missed their SLA targets. The emails are
+// sent by background jobs, so a slow or unreachable mail server never holds
+// up a request.
+package notify
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/events"
+	"github.com/darksford123x/app/jobs"
+	"github.com/darksford123x/app/sla"
+	"github.com/darksford123x/app/tenant"
+)
+
+// JobKind is the kind of the jobs sending notifications.
+const JobKind = "notify.email"
+
+// notice is the payload of a job sending a notification.
+type notice struct {
+	Template string `json:"template"`
+	Slip     int    `json:"slip"`
+	// To is the recipient; the reporter of the slip when zero.
+	To       int    `json:"to,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Previous string `json:"previous,omitempty"`
+	Breach   string `json:"breach,omitempty"`
+}
+
+// Notifier turns repair slip events into emails.
+type Notifier struct {
+	client    *ent.Client
+	sender    Sender
+	templates *templates
+}
+
+// NewNotifier creates a notifier sending emails with sender. Dates in the
+// emails are shown in the given time zone.
+func NewNotifier(client *ent.Client, sender Sender, loc *time.Location) *Notifier {
+	return &Notifier{
+		client:    client,
+		sender:    sender,
+		templates: newTemplates(loc),
+	}
+}
+
+// Listen queues the notifications of a committed event.
+func (n *Notifier) Listen(e events.Event) {
+	if e.Entity != "repairslip" {
+		return
+	}
+	slip, ok := e.Data.(*ent.RepairSlip)
+	if !ok {
+		return
+	}
+
+	var notices []notice
+	switch e.Op {
+	case events.Created:
+		notices = append(notices, notice{Template: Received, Slip: slip.ID})
+	case events.Updated:
+		if previous, ok := e.Old["status"].(repairslip.Status); ok && previous != slip.Status {
+			template := StatusChanged
+			if slip.Status == repairslip.StatusReady {
+				template = Ready
+			}
+			notices = append(notices, notice{
+				Template: template,
+				Slip:     slip.ID,
+				Status:   string(slip.Status),
+				Previous: string(previous),
+			})
+		}
+		if breached(e, "response_breached", slip.ResponseBreached) {
+			notices = append(notices, notice{Template: Overdue, Slip: slip.ID, Breach: string(sla.ResponseBreach)})
+		}
+		if breached(e, "resolution_breached", slip.ResolutionBreached) {
+			notices = append(notices, notice{Template: Overdue, Slip: slip.ID, Breach: string(sla.ResolutionBreach)})
+		}
+	}
+
+	ctx := tenant.NewContext(context.Background(), e.Organization)
+	for _, nt := range notices {
+		n.enqueue(ctx, nt)
+	}
+}
+
+// breached reports whether the update marked a breach that was not marked
+// before.
+func breached(e events.Event, field string, now bool) bool {
+	before, _ := e.Old[field].(bool)
+	return e.Changed(field) && now && !before
+}
+
+// Notify queues emails to the supervisors about a slip that missed a target.
+// It implements sla.Notifier.
+func (n *Notifier) Notify(ctx context.Context, sn sla.Notice) error {
+	for _, s := range sn.Supervisors {
+		if s.Email == "" {
+			continue
+		}
+		err := n.enqueue(ctx, notice{
+			Template: Escalated,
+			Slip:     sn.Slip.ID,
+			To:       s.ID,
+			Status:   string(sn.Slip.Status),
+			Breach:   string(sn.Breach),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *Notifier) enqueue(ctx context.Context, nt notice) error {
+	_, err := jobs.Enqueue(ctx, n.client, JobKind, nt)
+	if err != nil {
+		log.Printf("notify: queueing the %s email about repair slip %d: %v", nt.Template, nt.Slip, err)
+	}
+	return err
+}
+
+// Handle sends the email of a notification job. Notifications to users
+// without an email address are dropped.
+func (n *Notifier) Handle(ctx context.Context, j *ent.Job) error {
+	var nt notice
+	if err := jobs.Decode(j, &nt); err != nil {
+		return err
+	}
+	slip, err := n.client.RepairSlip.Get(ctx, nt.Slip)
+	if ent.IsNotFound(err) {
+		return jobs.Permanent(err)
+	}
+	if err != nil {
+		return err
+	}
+	var recipient *ent.User
+	if nt.To == 0 {
+		recipient, err = slip.QueryReporter().Only(ctx)
+	} else {
+		recipient, err = n.client.User.Get(ctx, nt.To)
+	}
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if recipient.Email == "" {
+		return nil
+	}
+
+	msg, err := n.templates.render(nt.Template, view{
+		Recipient: recipient,
+		Slip:      slip,
+		Status:    nt.Status,
+		Previous:  nt.Previous,
+		Breach:    nt.Breach,
+	})
+	if err != nil {
+		return jobs.Permanent(err)
+	}
+	return n.sender.Send(ctx, msg)
+}
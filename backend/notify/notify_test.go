@@ -0,0 +1,89 @@
+package notify_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/job"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/notify"
+	"github.com/darksford123x/app/servertest"
+)
+
+// outbox is a sender keeping the emails it is given.
+type outbox []notify.Message
+
+func (o *outbox) Send(ctx context.Context, msg notify.Message) error {
+	*o = append(*o, msg)
+	return nil
+}
+
+func TestNotifications(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	var sent outbox
+	n := notify.NewNotifier(h.Client, &sent, time.UTC)
+	// deliver sends the emails queued since it was last called, as the
+	// workers would.
+	delivered := map[int]bool{}
+	deliver := func() []string {
+		t.Helper()
+		sent = nil
+		js := h.Client.Job.Query().Where(job.Kind(notify.JobKind)).Order(ent.Asc(job.FieldID)).AllX(ctx)
+		for _, j := range js {
+			if delivered[j.ID] {
+				continue
+			}
+			delivered[j.ID] = true
+			if err := n.Handle(ctx, j); err != nil {
+				t.Fatalf("sending the email of job %d: %v", j.ID, err)
+			}
+		}
+		var subjects []string
+		for _, msg := range sent {
+			subjects = append(subjects, msg.To+": "+msg.Subject)
+		}
+		return subjects
+	}
+
+	reporter := h.User().SetLocale(user.LocaleEn).SaveX(ctx)
+	slip := h.RepairSlip(reporter).SaveX(ctx)
+	if got, want := fmt.Sprint(deliver()), fmt.Sprintf("[%s: Repair slip %d received]", reporter.Email, slip.ID); got != want {
+		t.Errorf("emails on creation = %s, want %s", got, want)
+	}
+	if !strings.HasPrefix(sent[0].Body, "Dear "+reporter.Name+",") {
+		t.Errorf("email addressed as %q, want to %s", sent[0].Body, reporter.Name)
+	}
+
+	h.Client.RepairSlip.UpdateOne(slip).SetStatus(repairslip.StatusInProgress).ExecX(ctx)
+	if got, want := fmt.Sprint(deliver()), fmt.Sprintf("[%s: Repair slip %d: in progress]", reporter.Email, slip.ID); got != want {
+		t.Errorf("emails on a status change = %s, want %s", got, want)
+	}
+	if !strings.Contains(sent[0].Body, `changed from "received" to "in progress"`) {
+		t.Errorf("email of a status change = %q", sent[0].Body)
+	}
+	h.Client.RepairSlip.UpdateOne(slip).SetSymptom("Flickers").ExecX(ctx)
+	if got := deliver(); len(got) != 0 {
+		t.Errorf("emails on an edit = %v, want none", got)
+	}
+	h.Client.RepairSlip.UpdateOne(slip).SetStatus(repairslip.StatusReady).ExecX(ctx)
+	if got, want := fmt.Sprint(deliver()), fmt.Sprintf("[%s: Repair slip %d is ready for pickup]", reporter.Email, slip.ID); got != want {
+		t.Errorf("emails when ready = %s, want %s", got, want)
+	}
+
+	// Emails are in the language of the recipient, Thai by default, and
+	// users without an address get none.
+	thai := h.RepairSlip(h.User().SaveX(ctx)).SaveX(ctx)
+	if got, want := fmt.Sprint(deliver()), fmt.Sprintf("ได้รับแจ้งซ่อมหมายเลข %d แล้ว]", thai.ID); !strings.HasSuffix(got, want) {
+		t.Errorf("emails to a Thai reporter = %s, want %s", got, want)
+	}
+	h.RepairSlip(h.User().SetEmail("").SaveX(ctx)).SaveX(ctx)
+	if got := deliver(); len(got) != 0 {
+		t.Errorf("emails to a reporter without an address = %v, want none", got)
+	}
+}
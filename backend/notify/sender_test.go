@@ -0,0 +1,134 @@
+package notify_test
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/darksford123x/app/jobs"
+	"github.com/darksford123x/app/notify"
+)
+
+// smtpServer is a fake SMTP server accepting one email per connection,
+// and rejecting recipients with the reply of reject.
+type smtpServer struct {
+	ln     net.Listener
+	reject string
+	data   chan string
+}
+
+func newSMTPServer(t *testing.T, reject string) *smtpServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	s := &smtpServer{ln: ln, reject: reject, data: make(chan string, 1)}
+	go s.serve()
+	return s
+}
+
+func (s *smtpServer) serve() {
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	c := textproto.NewConn(conn)
+	c.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		switch cmd := strings.ToUpper(strings.Fields(line)[0]); cmd {
+		case "EHLO", "HELO", "MAIL":
+			c.PrintfLine("250 OK")
+		case "RCPT":
+			if s.reject != "" {
+				c.PrintfLine(s.reject)
+			} else {
+				c.PrintfLine("250 OK")
+			}
+		case "DATA":
+			c.PrintfLine("354 Go ahead")
+			b, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.data <- string(b)
+			c.PrintfLine("250 Queued")
+		case "QUIT":
+			c.PrintfLine("221 Bye")
+			return
+		default:
+			c.PrintfLine("502 Unknown")
+		}
+	}
+}
+
+// sender returns a sender of the server.
+func (s *smtpServer) sender() *notify.SMTPSender {
+	host, port, _ := net.SplitHostPort(s.ln.Addr().String())
+	return &notify.SMTPSender{Host: host, Port: port, From: "repairs@example.com"}
+}
+
+func TestSMTPSender(t *testing.T) {
+	ctx := context.Background()
+	s := newSMTPServer(t, "")
+	msg := notify.Message{
+		To:      "somchai@example.com",
+		Subject: "ได้รับแจ้งซ่อมหมายเลข 42 แล้ว",
+		Body:    "เรียน คุณสมชาย\n\nเราได้รับแจ้งซ่อมหมายเลข 42 เรียบร้อยแล้ว\n",
+	}
+	if err := s.sender().Send(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(<-s.data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dec mime.WordDecoder
+	subject, err := dec.DecodeHeader(m.Header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(quotedprintable.NewReader(m.Body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct{ name, got, want string }{
+		{"From", m.Header.Get("From"), "repairs@example.com"},
+		{"To", m.Header.Get("To"), msg.To},
+		{"Subject", subject, msg.Subject},
+		{"Content-Type", m.Header.Get("Content-Type"), "text/plain; charset=utf-8"},
+		{"body", strings.ReplaceAll(string(body), "\r\n", "\n"), msg.Body},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s = %q, want %q", tc.name, tc.got, tc.want)
+		}
+	}
+
+	// Rejections are not retried, unlike temporary failures.
+	for _, tc := range []struct {
+		reply     string
+		permanent bool
+	}{
+		{"550 No such user", true},
+		{"451 Try again later", false},
+	} {
+		err := newSMTPServer(t, tc.reply).sender().Send(ctx, msg)
+		if err == nil || jobs.IsPermanent(err) != tc.permanent {
+			t.Errorf("sending when the server replies %q: %v, permanent %v", tc.reply, err, tc.permanent)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+)
+
+// Templates of the notifications.
+const (
+	// Received is sent to the reporter when a slip is created.
+	Received = "received"
+	// StatusChanged is sent to the reporter when the status of a slip
+	// changes to anything but ready.
+	StatusChanged = "status"
+	// Ready is sent to the reporter when the equipment can be picked up.
+	Ready = "ready"
+	// Overdue is sent to the reporter when a slip misses a target.
+	Overdue = "overdue"
+	// Escalated is sent to supervisors about a slip that missed a target.
+	Escalated = "escalated"
+)
+
+// view is what templates are rendered with.
+type view struct {
+	Recipient *ent.User
+	Slip      *ent.RepairSlip
+	Status    string
+	Previous  string
+	Breach    string
+}
+
+// text is the source of a template in one language.
+type text struct {
+	subject, body string
+}
+
+var sources = map[user.Locale]map[string]text{
+	user.LocaleTh: {
+		Received: {
+			subject: "ได้รับแจ้งซ่อมหมายเลข {{.Slip.ID}} แล้ว",
+			body: `เรียน คุณ{{.Recipient.Name}}
+
+เราได้รับแจ้งซ่อมหมายเลข {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}}) เรียบร้อยแล้ว
+{{- with .Slip.ResolutionDue}}
+กำหนดซ่อมเสร็จภายใน {{date .}}
+{{- end}}
+
+ระบบแจ้งซ่อม
+`,
+		},
+		StatusChanged: {
+			subject: "แจ้งซ่อมหมายเลข {{.Slip.ID}}: {{.Status}}",
+			body: `เรียน คุณ{{.Recipient.Name}}
+
+สถานะของแจ้งซ่อมหมายเลข {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}})
+เปลี่ยนจาก "{{.Previous}}" เป็น "{{.Status}}"
+
+ระบบแจ้งซ่อม
+`,
+		},
+		Ready: {
+			subject: "อุปกรณ์ของแจ้งซ่อมหมายเลข {{.Slip.ID}} พร้อมรับคืนแล้ว",
+			body: `เรียน คุณ{{.Recipient.Name}}
+
+อุปกรณ์ของแจ้งซ่อมหมายเลข {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}})
+ซ่อมเสร็จแล้ว กรุณาติดต่อรับคืนได้ในเวลาทำการ
+
+ระบบแจ้งซ่อม
+`,
+		},
+		Overdue: {
+			subject: "แจ้งซ่อมหมายเลข {{.Slip.ID}} ล่าช้ากว่ากำหนด",
+			body: `เรียน คุณ{{.Recipient.Name}}
+
+ขออภัย แจ้งซ่อมหมายเลข {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}})
+{{if eq .Breach "response"}}ยังไม่ได้รับการตอบสนองภายในเวลาที่กำหนด{{else}}ยังซ่อมไม่เสร็จภายในเวลาที่กำหนด{{end}}
+เราได้เร่งติดตามเรื่องนี้แล้ว และจะดำเนินการโดยเร็วที่สุด
+
+ระบบแจ้งซ่อม
+`,
+		},
+		Escalated: {
+			subject: "แจ้งซ่อมหมายเลข {{.Slip.ID}} เกินกำหนด SLA",
+			body: `เรียน คุณ{{.Recipient.Name}}
+
+แจ้งซ่อมหมายเลข {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}}, ความสำคัญ {{.Slip.Priority}})
+{{if eq .Breach "response"}}ไม่ได้รับการตอบสนองภายใน {{date .Slip.ResponseDue}} และไม่มีช่างว่างให้มอบหมายใหม่{{else}}ซ่อมไม่เสร็จภายใน {{date .Slip.ResolutionDue}}{{end}}
+สถานะปัจจุบัน: {{.Status}}
+
+ระบบแจ้งซ่อม
+`,
+		},
+	},
+	user.LocaleEn: {
+		Received: {
+			subject: "Repair slip {{.Slip.ID}} received",
+			body: `Dear {{.Recipient.Name}},
+
+We received your repair slip {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}}).
+{{- with .Slip.ResolutionDue}}
+We aim to complete the repair by {{date .}}.
+{{- end}}
+
+Repair service
+`,
+		},
+		StatusChanged: {
+			subject: "Repair slip {{.Slip.ID}}: {{.Status}}",
+			body: `Dear {{.Recipient.Name}},
+
+The status of repair slip {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}})
+changed from "{{.Previous}}" to "{{.Status}}".
+
+Repair service
+`,
+		},
+		Ready: {
+			subject: "Repair slip {{.Slip.ID}} is ready for pickup",
+			body: `Dear {{.Recipient.Name}},
+
+The repair of slip {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}}) is done.
+Please pick up your equipment during business hours.
+
+Repair service
+`,
+		},
+		Overdue: {
+			subject: "Repair slip {{.Slip.ID}} is delayed",
+			body: `Dear {{.Recipient.Name}},
+
+We are sorry: repair slip {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}})
+{{if eq .Breach "response"}}was not responded to in time{{else}}was not resolved in time{{end}}.
+We have escalated it and will follow up as soon as possible.
+
+Repair service
+`,
+		},
+		Escalated: {
+			subject: "Repair slip {{.Slip.ID}} missed its SLA target",
+			body: `Dear {{.Recipient.Name}},
+
+Repair slip {{.Slip.ID}} ({{.Slip.Category}}: {{.Slip.Symptom}}, priority {{.Slip.Priority}})
+{{if eq .Breach "response"}}was not responded to by {{date .Slip.ResponseDue}} and no other technician is available{{else}}was not resolved by {{date .Slip.ResolutionDue}}{{end}}.
+Current status: {{.Status}}
+
+Repair service
+`,
+		},
+	},
+}
+
+// statuses are the names of the repair slip statuses in each language.
+var statuses = map[user.Locale]map[string]string{
+	user.LocaleTh: {
+		"received":      "รับเรื่องแล้ว",
+		"in_progress":   "กำลังซ่อม",
+		"waiting_parts": "รออะไหล่",
+		"ready":         "พร้อมรับคืน",
+		"closed":        "ปิดงาน",
+	},
+	user.LocaleEn: {
+		"received":      "received",
+		"in_progress":   "in progress",
+		"waiting_parts": "waiting for parts",
+		"ready":         "ready for pickup",
+		"closed":        "closed",
+	},
+}
+
+// templates render notifications in the language of their recipient.
+type templates struct {
+	parsed map[user.Locale]map[string][2]*template.Template
+}
+
+// newTemplates parses the templates. Dates are shown in the given time
+// zone.
+func newTemplates(loc *time.Location) *templates {
+	funcs := template.FuncMap{
+		"date": func(t *time.Time) string {
+			if t == nil {
+				return "-"
+			}
+			return t.In(loc).Format("02/01/2006 15:04")
+		},
+	}
+	t := &templates{parsed: make(map[user.Locale]map[string][2]*template.Template)}
+	for locale, texts := range sources {
+		t.parsed[locale] = make(map[string][2]*template.Template)
+		for name, src := range texts {
+			t.parsed[locale][name] = [2]*template.Template{
+				template.Must(template.New(name).Funcs(funcs).Parse(src.subject)),
+				template.Must(template.New(name).Funcs(funcs).Parse(src.body)),
+			}
+		}
+	}
+	return t
+}
+
+// render returns the notification of the given template for the recipient.
+func (t *templates) render(name string, v view) (Message, error) {
+	locale := v.Recipient.Locale
+	if _, ok := t.parsed[locale]; !ok {
+		locale = user.LocaleTh
+	}
+	tmpl, ok := t.parsed[locale][name]
+	if !ok {
+		return Message{}, fmt.Errorf("notify: no template %q", name)
+	}
+	v.Status = statuses[locale][v.Status]
+	v.Previous = statuses[locale][v.Previous]
+
+	var subject, body bytes.Buffer
+	if err := tmpl[0].Execute(&subject, v); err != nil {
+		return Message{}, err
+	}
+	if err := tmpl[1].Execute(&body, v); err != nil {
+		return Message{}, err
+	}
+	return Message{
+		To:      v.Recipient.Email,
+		Subject: subject.String(),
+		Body:    body.String(),
+	}, nil
+}
@@ -0,0 +1,167 @@
+package openapi
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/darksford123x/app/ent/entdoc"
+	"github.com/darksford123x/app/money"
+)
+
+// entitySchemas sets the schemas of the entities as they are encoded in
+// responses, of their edges, and of the inputs of the entities sent in
+// request bodies. ent.Value is any entity.
+func entitySchemas(schemas object, types []entdoc.Type, inputs map[string]bool) {
+	var entities []interface{}
+	for _, t := range types {
+		name := "ent." + t.Name
+		entities = append(entities, object{"$ref": schemaPrefix + name})
+		descriptions := obj(obj(schemas[name])["properties"])
+
+		props := object{}
+		var required []string
+		for _, f := range t.Fields {
+			// Optional fields encoded even when they are empty are not
+			// validated: they are empty when they were never set.
+			s := fieldSchema(f, !f.Optional || f.OmitEmpty)
+			if d, ok := obj(descriptions[f.Name])["description"]; ok {
+				s["description"] = d
+			}
+			if f.Name == "id" {
+				s["readOnly"] = true
+			}
+			props[f.Name] = s
+			if !f.OmitEmpty {
+				required = append(required, f.Name)
+			}
+		}
+		if len(t.Edges) > 0 {
+			edges := object{}
+			var names []string
+			for _, e := range t.Edges {
+				ref := object{"$ref": schemaPrefix + "ent." + e.Type}
+				if e.Unique {
+					edges[e.Name] = object{"anyOf": []interface{}{ref, object{"type": "null"}}}
+				} else {
+					edges[e.Name] = object{"type": []interface{}{"array", "null"}, "items": ref}
+				}
+				names = append(names, e.Name)
+			}
+			sort.Strings(names)
+			schemas[name+"Edges"] = object{
+				"description": "The edges loaded with the entity; those not loaded are null.",
+				"type":        "object",
+				"properties":  edges,
+				"required":    names,
+			}
+			props["edges"] = object{"$ref": schemaPrefix + name + "Edges"}
+			required = append(required, "edges")
+		}
+		sort.Strings(required)
+		schemas[name] = object{"type": "object", "properties": props, "required": required}
+
+		if !inputs[name] {
+			continue
+		}
+		props = object{}
+		required = nil
+		for _, f := range t.Fields {
+			if f.Name == "id" {
+				continue
+			}
+			props[f.Name] = fieldSchema(f, true)
+			if !f.Optional && !f.Default && !f.Nillable {
+				required = append(required, f.Name)
+			}
+		}
+		sort.Strings(required)
+		schemas[name+"Input"] = object{"type": "object", "properties": props, "required": required}
+	}
+	schemas["ent.Value"] = object{"anyOf": entities}
+}
+
+// fieldSchema returns the schema of a field, with the constraints of its
+// enums and validator when constrained is set.
+func fieldSchema(f entdoc.Field, constrained bool) object {
+	s := valueSchema(reflect.TypeOf(f.Value))
+	if f.Nillable {
+		if t, ok := s["type"].(string); ok {
+			s["type"] = []interface{}{t, "null"}
+		}
+	}
+	if !constrained {
+		return s
+	}
+	if len(f.Enums) > 0 {
+		s["enum"] = f.Enums
+	}
+	validator := reflect.ValueOf(f.Validator)
+	if f.Validator == nil || validator.IsNil() {
+		return s
+	}
+	in := validator.Type().In(0)
+	valid := func(v interface{}) bool {
+		out := validator.Call([]reflect.Value{reflect.ValueOf(v).Convert(in)})
+		return out[0].IsNil()
+	}
+	// The constraints of the validators are found by trying values on them:
+	// the schemas only use NotEmpty, Positive and the URL validator.
+	switch in.Kind() {
+	case reflect.String:
+		if !valid("") {
+			s["minLength"] = 1
+		}
+		if !valid("example") && valid("https://example.com") {
+			s["format"] = "uri"
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case !valid(0) && valid(1):
+			s["minimum"] = 1
+		case !valid(-1) && valid(0):
+			s["minimum"] = 0
+		}
+	}
+	return s
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	decimalTypes = map[reflect.Type]bool{
+		reflect.TypeOf(money.Amount(0)):   true,
+		reflect.TypeOf(money.Quantity(0)): true,
+		reflect.TypeOf(money.Rate(0)):     true,
+	}
+)
+
+// valueSchema returns the schema of the JSON encoding of a Go type.
+func valueSchema(t reflect.Type) object {
+	switch {
+	case t == timeType:
+		return object{"type": "string", "format": "date-time"}
+	case decimalTypes[t]:
+		return decimal()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return object{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return object{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return object{"type": "number"}
+	case reflect.String:
+		return object{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return object{"type": []interface{}{"array", "null"}, "items": valueSchema(t.Elem())}
+	case reflect.Ptr:
+		s := valueSchema(t.Elem())
+		if typ, ok := s["type"].(string); ok {
+			s["type"] = []interface{}{typ, "null"}
+		}
+		return s
+	default:
+		return object{}
+	}
+}
@@ -0,0 +1,283 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// operation is what is checked of an operation.
+type operation struct {
+	params       []parameter
+	body         object
+	bodyRequired bool
+	// responses are the schemas of the JSON responses by status code;
+	// responses without a body have a nil schema.
+	responses map[string]object
+	// json is set when the operation only responds with JSON, so that its
+	// responses can be buffered and checked.
+	json bool
+}
+
+type parameter struct {
+	name     string
+	in       string
+	required bool
+	schema   object
+}
+
+// index indexes the operations of the document.
+func (d *Document) index() error {
+	d.operations = map[string]*operation{}
+	for path, item := range obj(d.doc["paths"]) {
+		for method, op := range obj(item) {
+			op := obj(op)
+			o := &operation{responses: map[string]object{}, json: true}
+			for _, p := range list(op["parameters"]) {
+				p := obj(p)
+				o.params = append(o.params, parameter{
+					name:     str(p["name"]),
+					in:       str(p["in"]),
+					required: p["required"] == true,
+					schema:   obj(p["schema"]),
+				})
+			}
+			if body := obj(op["requestBody"]); body != nil {
+				if media := obj(obj(body["content"])["application/json"]); media != nil {
+					o.body = obj(media["schema"])
+					o.bodyRequired = body["required"] == true
+				}
+			}
+			for code, r := range obj(op["responses"]) {
+				if code == strconv.Itoa(http.StatusSwitchingProtocols) {
+					o.json = false
+				}
+				var schema object
+				for mediaType, media := range obj(obj(r)["content"]) {
+					if !isJSON(mediaType) {
+						o.json = false
+						continue
+					}
+					schema = obj(obj(media)["schema"])
+				}
+				o.responses[code] = schema
+			}
+			d.operations[strings.ToUpper(method)+" "+path] = o
+		}
+	}
+	if len(d.operations) == 0 {
+		return fmt.Errorf("openapi: the document has no operations")
+	}
+	return nil
+}
+
+// operation returns the operation of a route, or nil if it is not
+// documented.
+func (d *Document) operation(method, route string) *operation {
+	if !strings.HasPrefix(route, d.basePath) {
+		return nil
+	}
+	segments := strings.Split(strings.TrimPrefix(route, d.basePath), "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, ":") || strings.HasPrefix(s, "*") {
+			segments[i] = "{" + s[1:] + "}"
+		}
+	}
+	return d.operations[method+" "+strings.Join(segments, "/")]
+}
+
+// Middleware checks the requests of the documented operations against the
+// document: their path and query parameters and their JSON bodies. Invalid
+// requests are answered with 400 before they reach the handlers.
+//
+// In test mode the JSON responses are buffered and checked as well, and
+// replaced by a 500 when they do not match, so that tests catch the handlers
+// and their documentation drifting apart.
+func (d *Document) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op := d.operation(c.Request.Method, c.FullPath())
+		if op == nil {
+			c.Next()
+			return
+		}
+		if err := d.checkRequest(c, op); err != nil {
+			c.AbortWithStatusJSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		if gin.Mode() != gin.TestMode || !op.json {
+			c.Next()
+			return
+		}
+
+		w := &bufferedWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+		if err := d.checkResponse(op, w); err != nil {
+			c.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+		c.Writer.WriteHeader(w.status)
+		c.Writer.Write(w.body.Bytes())
+	}
+}
+
+// checkRequest checks the parameters and the body of a request.
+func (d *Document) checkRequest(c *gin.Context, op *operation) error {
+	for _, p := range op.params {
+		var raw string
+		var ok bool
+		switch p.in {
+		case "path":
+			raw = c.Param(p.name)
+			ok = raw != ""
+		case "query":
+			raw, ok = c.GetQuery(p.name)
+		case "header":
+			raw = c.GetHeader(p.name)
+			ok = raw != ""
+		default:
+			continue
+		}
+		name := p.in + " parameter " + p.name
+		if !ok {
+			if p.required {
+				return fmt.Errorf("%s is required", name)
+			}
+			continue
+		}
+		var v interface{}
+		var err error
+		if typ := str(p.schema["type"]); typ == "array" && p.in == "query" {
+			v, err = parseArray(c.QueryArray(p.name), str(obj(p.schema["items"])["type"]))
+		} else {
+			v, err = parseParam(raw, typ)
+		}
+		if err != nil {
+			return fmt.Errorf("%s must be of type %s", name, p.schema["type"])
+		}
+		if err := d.validate(p.schema, v, name); err != nil {
+			return err
+		}
+	}
+
+	if op.body == nil || c.ContentType() != "application/json" {
+		return nil
+	}
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		return fmt.Errorf("reading the body: %w", err)
+	}
+	c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if len(bytes.TrimSpace(body)) == 0 {
+		if op.bodyRequired {
+			return fmt.Errorf("body is required")
+		}
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Errorf("body is not valid JSON: %w", err)
+	}
+	return d.validate(op.body, v, "body")
+}
+
+// parseParam parses a parameter as a value of the type of its schema.
+func parseParam(raw, typ string) (interface{}, error) {
+	switch typ {
+	case "integer":
+		n, err := strconv.ParseInt(raw, 10, 64)
+		return float64(n), err
+	case "number":
+		return strconv.ParseFloat(raw, 64)
+	case "boolean":
+		return strconv.ParseBool(raw)
+	default:
+		return raw, nil
+	}
+}
+
+// parseArray parses the values of a query parameter repeated for each item
+// of an array, as with the multi collection format.
+func parseArray(raws []string, typ string) (interface{}, error) {
+	items := make([]interface{}, len(raws))
+	for i, raw := range raws {
+		v, err := parseParam(raw, typ)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = v
+	}
+	return items, nil
+}
+
+// checkResponse checks a buffered response.
+func (d *Document) checkResponse(op *operation, w *bufferedWriter) error {
+	schema, ok := op.responses[strconv.Itoa(w.status)]
+	if !ok {
+		schema, ok = op.responses["default"]
+	}
+	if !ok {
+		return fmt.Errorf("the response status %d is not documented", w.status)
+	}
+	if schema == nil {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(w.body.Bytes(), &v); err != nil {
+		return fmt.Errorf("the response is not valid JSON: %w", err)
+	}
+	if err := d.validate(schema, v, "response"); err != nil {
+		return fmt.Errorf("the response does not match the document: %w", err)
+	}
+	return nil
+}
+
+// bufferedWriter holds a response back until it is checked.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if code > 0 && !w.written {
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {
+	w.written = true
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	w.written = true
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.written
+}
@@ -0,0 +1,369 @@
+// Package openapi serves the OpenAPI 3.1 document of the API and checks
+// requests and responses against it. The document is converted from the
+// Swagger 2.0 document swag generates from the annotations of the
+// controllers, with the schemas of the entities built from the ent schemas
+// instead: swag only sees the generated structs, so it misses the JSON names
+// of the edges, the enums and the constraints of the fields.
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	_ "github.com/darksford123x/app/docs" // registers the Swagger document
+	"github.com/darksford123x/app/ent/entdoc"
+	"github.com/gin-gonic/gin"
+	"github.com/swaggo/swag"
+)
+
+// object is a JSON object of the document.
+type object = map[string]interface{}
+
+// Document is an OpenAPI 3.1 document.
+type Document struct {
+	raw      []byte
+	doc      object
+	basePath string
+	// operations are the operations by method and path, e.g.
+	// "GET /users/{id}".
+	operations map[string]*operation
+	patterns   sync.Map
+}
+
+// Load converts the Swagger document of the API.
+func Load() (*Document, error) {
+	s, err := swag.ReadDoc()
+	if err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+	return New([]byte(s), entdoc.Types())
+}
+
+// New converts a Swagger 2.0 document, replacing the schemas of the
+// entities, named "ent." and the type, by schemas of the given types.
+func New(swagger []byte, types []entdoc.Type) (*Document, error) {
+	var src object
+	if err := json.Unmarshal(swagger, &src); err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+	if v, _ := src["swagger"].(string); v != "2.0" {
+		return nil, errors.New("openapi: not a Swagger 2.0 document")
+	}
+	basePath := strings.TrimSuffix(str(src["basePath"]), "/")
+
+	schemas := object{}
+	for name, s := range obj(src["definitions"]) {
+		schemas[name] = convertSchema(s)
+	}
+	// Entities sent in request bodies get an input schema, without the ID
+	// and the edges and requiring the fields that must be set.
+	inputs := map[string]bool{}
+	paths := object{}
+	for path, item := range obj(src["paths"]) {
+		ops := object{}
+		for method, op := range obj(item) {
+			ops[method] = convertOperation(src, obj(op), inputs)
+		}
+		paths[path] = ops
+	}
+	entitySchemas(schemas, types, inputs)
+
+	securitySchemes := object{}
+	for name, def := range obj(src["securityDefinitions"]) {
+		def := obj(def)
+		switch str(def["type"]) {
+		case "apiKey":
+			securitySchemes[name] = object{"type": "apiKey", "name": def["name"], "in": def["in"]}
+		case "basic":
+			securitySchemes[name] = object{"type": "http", "scheme": "basic"}
+		default:
+			return nil, fmt.Errorf("openapi: unsupported security scheme %q", name)
+		}
+	}
+
+	server := basePath
+	if server == "" {
+		server = "/"
+	}
+	doc := object{
+		"openapi": "3.1.0",
+		"info":    src["info"],
+		"servers": []interface{}{object{"url": server}},
+		"paths":   paths,
+		"components": object{
+			"schemas":         schemas,
+			"securitySchemes": securitySchemes,
+		},
+	}
+	raw, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+	d := &Document{raw: raw, basePath: basePath}
+	// The document is read back so that the validator only deals with the
+	// types JSON decodes to.
+	if err := json.Unmarshal(raw, &d.doc); err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+	if err := d.index(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// MarshalJSON returns the document.
+func (d *Document) MarshalJSON() ([]byte, error) {
+	return d.raw, nil
+}
+
+// Handler serves the document.
+func (d *Document) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", d.raw)
+	}
+}
+
+// convertOperation converts an operation, moving the body and form
+// parameters into its request body and the schemas of its responses into
+// their content.
+func convertOperation(src, op object, inputs map[string]bool) object {
+	consumes := mediaTypes(op["consumes"], src["consumes"])
+	produces := mediaTypes(op["produces"], src["produces"])
+	out := object{}
+	for _, key := range []string{"summary", "description", "operationId", "tags", "security", "deprecated"} {
+		if v, ok := op[key]; ok {
+			out[key] = v
+		}
+	}
+
+	var params []interface{}
+	form := object{}
+	var formRequired []string
+	for _, p := range list(op["parameters"]) {
+		p := obj(p)
+		name := str(p["name"])
+		switch str(p["in"]) {
+		case "body":
+			schema := obj(convertSchema(p["schema"]))
+			if ref := str(schema["$ref"]); strings.HasPrefix(ref, schemaPrefix+"ent.") {
+				schema["$ref"] = ref + "Input"
+				inputs[strings.TrimPrefix(ref, schemaPrefix)] = true
+			}
+			body := object{
+				"required": p["required"] == true,
+				"content":  content(consumes, schema),
+			}
+			if d, ok := p["description"]; ok {
+				body["description"] = d
+			}
+			out["requestBody"] = body
+		case "formData":
+			form[name] = paramSchema(p)
+			if p["required"] == true {
+				formRequired = append(formRequired, name)
+			}
+		default:
+			param := object{
+				"name":     name,
+				"in":       p["in"],
+				"required": p["required"] == true || p["in"] == "path",
+				"schema":   paramSchema(p),
+			}
+			if d, ok := p["description"]; ok {
+				param["description"] = d
+			}
+			params = append(params, param)
+		}
+	}
+	if len(params) > 0 {
+		out["parameters"] = params
+	}
+	if len(form) > 0 {
+		schema := object{"type": "object", "properties": form}
+		if len(formRequired) > 0 {
+			sort.Strings(formRequired)
+			schema["required"] = formRequired
+		}
+		out["requestBody"] = object{
+			"required": len(formRequired) > 0,
+			"content":  object{"multipart/form-data": object{"schema": schema}},
+		}
+	}
+
+	responses := object{}
+	for code, r := range obj(op["responses"]) {
+		r := obj(r)
+		res := object{"description": str(r["description"])}
+		if res["description"] == "" {
+			n, _ := strconv.Atoi(code)
+			res["description"] = http.StatusText(n)
+		}
+		if s, ok := r["schema"]; ok {
+			res["content"] = content(produces, obj(convertSchema(s)))
+		}
+		responses[code] = res
+	}
+	out["responses"] = responses
+	return out
+}
+
+// content returns the content of a body in each of the media types.
+func content(types []string, schema object) object {
+	c := object{}
+	for _, t := range types {
+		s := schema
+		if !isJSON(t) && str(schema["contentMediaType"]) != "" {
+			s = object{"type": "string", "contentMediaType": t}
+		}
+		c[t] = object{"schema": s}
+	}
+	return c
+}
+
+// mediaTypes returns the media types of an operation, falling back to those
+// of the document and then to JSON.
+func mediaTypes(op, doc interface{}) []string {
+	for _, v := range []interface{}{op, doc} {
+		var types []string
+		for _, t := range list(v) {
+			types = append(types, str(t))
+		}
+		if len(types) > 0 {
+			return types
+		}
+	}
+	return []string{"application/json"}
+}
+
+// paramKeys are the keys of a Swagger parameter that belong to its schema.
+var paramKeys = []string{
+	"type", "format", "items", "enum", "default",
+	"minimum", "maximum", "minLength", "maxLength", "pattern",
+}
+
+// paramSchema returns the schema of a parameter.
+func paramSchema(p object) object {
+	s := object{}
+	for _, key := range paramKeys {
+		if v, ok := p[key]; ok {
+			s[key] = v
+		}
+	}
+	return obj(convertSchema(s))
+}
+
+// The prefixes of the references to the schemas of Swagger and OpenAPI
+// documents.
+const (
+	refPrefix    = "#/definitions/"
+	schemaPrefix = "#/components/schemas/"
+)
+
+// decimal is the schema of money amounts, quantities and rates, which are
+// written as decimal strings and read from strings or numbers.
+func decimal() object {
+	return object{
+		"type":    []interface{}{"string", "number"},
+		"format":  "decimal",
+		"pattern": `^-?[0-9]+(\.[0-9]{1,2})?$`,
+	}
+}
+
+// convertSchema converts a Swagger schema: references point to the
+// components, files become binary strings, x-nullable becomes a null type
+// and decimal strings also accept numbers.
+func convertSchema(v interface{}) interface{} {
+	s, ok := v.(object)
+	if !ok {
+		return v
+	}
+	if len(s) == 1 && s["type"] == "object" {
+		// swag documents interface{}, e.g. the cells of a stats table, as
+		// an object with nothing else: it is any value.
+		return object{}
+	}
+	out := object{}
+	for key, v := range s {
+		switch key {
+		case "properties":
+			props := object{}
+			for name, p := range obj(v) {
+				props[name] = convertSchema(p)
+			}
+			out[key] = props
+		case "items", "additionalProperties":
+			out[key] = convertSchema(v)
+		case "allOf", "anyOf", "oneOf":
+			var schemas []interface{}
+			for _, s := range list(v) {
+				schemas = append(schemas, convertSchema(s))
+			}
+			out[key] = schemas
+		case "$ref":
+			out[key] = schemaPrefix + strings.TrimPrefix(str(v), refPrefix)
+		case "x-nullable":
+		default:
+			out[key] = v
+		}
+	}
+	if _, ok := out["$ref"]; ok {
+		// swag gives the references to structs the object type as well.
+		delete(out, "type")
+	}
+	switch {
+	case out["type"] == "file":
+		out["type"] = "string"
+		out["contentMediaType"] = "application/octet-stream"
+	case out["format"] == "decimal":
+		for key, v := range decimal() {
+			out[key] = v
+		}
+	}
+	if s["x-nullable"] == true {
+		if t, ok := out["type"].(string); ok {
+			out["type"] = []interface{}{t, "null"}
+		}
+	}
+	return out
+}
+
+// isJSON reports whether a media type is JSON.
+func isJSON(mediaType string) bool {
+	mediaType = strings.TrimSpace(strings.Split(mediaType, ";")[0])
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// pattern returns the compiled regular expression of a pattern.
+func (d *Document) pattern(p string) (*regexp.Regexp, error) {
+	if re, ok := d.patterns.Load(p); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(p)
+	if err != nil {
+		return nil, err
+	}
+	d.patterns.Store(p, re)
+	return re, nil
+}
+
+func obj(v interface{}) object {
+	o, _ := v.(object)
+	return o
+}
+
+func list(v interface{}) []interface{} {
+	l, _ := v.([]interface{})
+	return l
+}
+
+func str(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
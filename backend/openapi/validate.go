@@ -0,0 +1,213 @@
+package openapi
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// validate checks a value decoded from JSON against a schema. It supports the
+// keywords the document uses; the errors name the value by its path, e.g.
+// "body.lines[0].amount".
+func (d *Document) validate(s object, v interface{}, path string) error {
+	if ref := str(s["$ref"]); ref != "" {
+		target := obj(obj(obj(d.doc["components"])["schemas"])[strings.TrimPrefix(ref, schemaPrefix)])
+		if target == nil {
+			return fmt.Errorf("%s: unknown schema %s", path, ref)
+		}
+		if err := d.validate(target, v, path); err != nil {
+			return err
+		}
+	}
+	if t, ok := s["type"]; ok && !hasType(t, v) {
+		return fmt.Errorf("%s must be of type %s", path, typeName(t))
+	}
+	if enum, ok := s["enum"]; ok && !contains(list(enum), v) {
+		return fmt.Errorf("%s must be one of %s", path, enumNames(list(enum)))
+	}
+
+	switch v := v.(type) {
+	case string:
+		if err := d.validateString(s, v, path); err != nil {
+			return err
+		}
+	case float64:
+		if min, ok := s["minimum"].(float64); ok && v < min {
+			return fmt.Errorf("%s must be at least %v", path, min)
+		}
+		if max, ok := s["maximum"].(float64); ok && v > max {
+			return fmt.Errorf("%s must be at most %v", path, max)
+		}
+	case []interface{}:
+		if items := obj(s["items"]); items != nil {
+			for i, item := range v {
+				if err := d.validate(items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range list(s["required"]) {
+			if _, ok := v[str(name)]; !ok {
+				return fmt.Errorf("%s.%s is required", path, name)
+			}
+		}
+		props := obj(s["properties"])
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			p := obj(props[name])
+			if p == nil {
+				p = obj(s["additionalProperties"])
+			}
+			if p == nil {
+				continue
+			}
+			if err := d.validate(p, v[name], path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, sub := range list(s["allOf"]) {
+		if err := d.validate(obj(sub), v, path); err != nil {
+			return err
+		}
+	}
+	if anyOf := list(s["anyOf"]); len(anyOf) > 0 {
+		var first error
+		for _, sub := range anyOf {
+			err := d.validate(obj(sub), v, path)
+			if err == nil {
+				first = nil
+				break
+			}
+			if first == nil {
+				first = err
+			}
+		}
+		if first != nil {
+			return first
+		}
+	}
+	if oneOf := list(s["oneOf"]); len(oneOf) > 0 {
+		n := 0
+		for _, sub := range oneOf {
+			if d.validate(obj(sub), v, path) == nil {
+				n++
+			}
+		}
+		if n != 1 {
+			return fmt.Errorf("%s must match exactly one schema, matches %d", path, n)
+		}
+	}
+	return nil
+}
+
+// validateString checks the length, pattern and format of a string.
+func (d *Document) validateString(s object, v, path string) error {
+	n := float64(utf8.RuneCountInString(v))
+	if min, ok := s["minLength"].(float64); ok && n < min {
+		return fmt.Errorf("%s must have at least %v characters", path, min)
+	}
+	if max, ok := s["maxLength"].(float64); ok && n > max {
+		return fmt.Errorf("%s must have at most %v characters", path, max)
+	}
+	if p := str(s["pattern"]); p != "" {
+		re, err := d.pattern(p)
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		if !re.MatchString(v) {
+			return fmt.Errorf("%s must match %s", path, p)
+		}
+	}
+	var err error
+	switch str(s["format"]) {
+	case "date-time":
+		_, err = time.Parse(time.RFC3339Nano, v)
+	case "date":
+		_, err = time.Parse("2006-01-02", v)
+	case "uri":
+		var u *url.URL
+		if u, err = url.Parse(v); err == nil && (!u.IsAbs() || u.Host == "") {
+			err = fmt.Errorf("not an absolute URL")
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%s must be a %s", path, s["format"])
+	}
+	return nil
+}
+
+// hasType reports whether a value has the type, or one of the types, of a
+// schema.
+func hasType(t, v interface{}) bool {
+	if types, ok := t.([]interface{}); ok {
+		for _, t := range types {
+			if hasType(t, v) {
+				return true
+			}
+		}
+		return false
+	}
+	switch t {
+	case "null":
+		return v == nil
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "number":
+		_, ok := v.(float64)
+		return ok
+	case "integer":
+		n, ok := v.(float64)
+		return ok && n == math.Trunc(n)
+	case "array":
+		_, ok := v.([]interface{})
+		return ok
+	case "object":
+		_, ok := v.(map[string]interface{})
+		return ok
+	}
+	return true
+}
+
+func typeName(t interface{}) string {
+	if types, ok := t.([]interface{}); ok {
+		names := make([]string, len(types))
+		for i, t := range types {
+			names[i] = str(t)
+		}
+		return strings.Join(names, " or ")
+	}
+	return str(t)
+}
+
+func contains(values []interface{}, v interface{}) bool {
+	for _, e := range values {
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func enumNames(values []interface{}) string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = fmt.Sprint(v)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/tenant"
+)
+
+// orgCommands are the subcommands of org.
+var orgCommands = []command{
+	{"create", "create an organization", runOrgCreate},
+	{"list", "list the organizations", runOrgList},
+}
+
+// runOrg runs a subcommand of org. The organizations, such as faculties,
+// share the deployment without seeing each other's data; their first users
+// are created with user create -org.
+func runOrg(ctx context.Context, args []string) error {
+	if len(args) == 0 || help(args[0]) {
+		usage(os.Stderr, "org", orgCommands)
+		os.Exit(2)
+	}
+	cmd, ok := lookup(orgCommands, args[0])
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", args[0])
+		usage(os.Stderr, "org", orgCommands)
+		os.Exit(2)
+	}
+	if err := cmd.run(tenant.System(ctx), args[1:]); err != nil {
+		return fmt.Errorf("%s: %w", cmd.name, err)
+	}
+	return nil
+}
+
+func runOrgCreate(ctx context.Context, args []string) error {
+	fs := flags("org create", "name")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cfg, _, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := persistent(cfg); err != nil {
+		return err
+	}
+
+	o, err := client.Organization.
+		Create().
+		SetName(fs.Arg(0)).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("an organization named %s already exists", fs.Arg(0))
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("created organization %d, %s\n", o.ID, o.Name)
+	return nil
+}
+
+func runOrgList(ctx context.Context, args []string) error {
+	fs := flags("org list", "")
+	fs.Parse(args)
+
+	_, _, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	orgs, err := client.Organization.
+		Query().
+		Order(ent.Asc(organization.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tUSERS\tCREATED")
+	for _, o := range orgs {
+		n, err := client.User.
+			Query().
+			Where(user.HasOrganizationWith(organization.ID(o.ID))).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\n", o.ID, o.Name, n, o.CreateTime.Format("2006-01-02"))
+	}
+	return w.Flush()
+}
+
+// findOrganization returns the organization of an ID or name. Without one,
+// it returns the only organization, which is created with the name
+// tenant.DefaultName when there is none yet.
+func findOrganization(ctx context.Context, client *ent.Client, ref string) (*ent.Organization, error) {
+	if ref == "" {
+		orgs, err := client.Organization.Query().Limit(2).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch len(orgs) {
+		case 0:
+			return client.Organization.
+				Create().
+				SetName(tenant.DefaultName).
+				Save(ctx)
+		case 1:
+			return orgs[0], nil
+		}
+		return nil, errors.New("there are several organizations; give one with -org")
+	}
+	var (
+		o   *ent.Organization
+		err error
+	)
+	if id, convErr := strconv.Atoi(ref); convErr == nil {
+		o, err = client.Organization.Get(ctx, id)
+	} else {
+		o, err = client.Organization.Query().Where(organization.NameEQ(ref)).Only(ctx)
+	}
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("no organization %s", ref)
+	}
+	return o, err
+}
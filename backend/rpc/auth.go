@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// authenticate returns the context of a call carrying the caller identified
+// from the bearer token of its authorization metadata and acting for the
+// organization of the token, as auth.Middleware does for HTTP requests.
+// Calls without a token, who would act for no organization, and calls with
+// an invalid one are refused, as they are by the REST endpoints.
+func authenticate(ctx context.Context, client *ent.Client, tokens *auth.Tokens) (context.Context, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, h := range md.Get("authorization") {
+			if strings.HasPrefix(h, "Bearer ") {
+				token = strings.TrimPrefix(h, "Bearer ")
+			}
+		}
+	}
+	if token == "" {
+		return nil, auth.ErrUnauthenticated
+	}
+	id, org, err := tokens.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	ctx = tenant.NewContext(ctx, org)
+	u, err := client.User.Get(ctx, id)
+	if err != nil {
+		return nil, auth.ErrUnauthenticated
+	}
+	if u.Disabled {
+		return nil, auth.ErrDisabled
+	}
+	return auth.NewContext(ctx, u), nil
+}
+
+// unaryAuth authenticates the callers of unary RPCs.
+func unaryAuth(client *ent.Client, tokens *auth.Tokens) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, client, tokens)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamAuth authenticates the callers of streaming RPCs.
+func streamAuth(client *ent.Client, tokens *auth.Tokens) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), client, tokens)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream is a server stream with the context of the caller.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the caller.
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
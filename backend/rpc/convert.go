@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/rpc/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toUser returns the message of a user.
+func toUser(u *ent.User) *pb.User {
+	if u == nil {
+		return nil
+	}
+	return &pb.User{
+		Id:         int64(u.ID),
+		Age:        int32(u.Age),
+		Name:       u.Name,
+		Role:       string(u.Role),
+		Skill:      u.Skill,
+		Department: u.Department,
+		Email:      u.Email,
+		Locale:     string(u.Locale),
+	}
+}
+
+// toRepairSlip returns the message of a repair slip, with the reporter,
+// assignee and equipment that were loaded.
+func toRepairSlip(rs *ent.RepairSlip) *pb.RepairSlip {
+	if rs == nil {
+		return nil
+	}
+	m := &pb.RepairSlip{
+		Id:                 int64(rs.ID),
+		Symptom:            rs.Symptom,
+		Category:           rs.Category,
+		Status:             string(rs.Status),
+		UnderWarranty:      rs.UnderWarranty,
+		Route:              string(rs.Route),
+		Priority:           string(rs.Priority),
+		ResponseDue:        toTimestamp(rs.ResponseDue),
+		ResolutionDue:      toTimestamp(rs.ResolutionDue),
+		RespondedAt:        toTimestamp(rs.RespondedAt),
+		ResolvedAt:         toTimestamp(rs.ResolvedAt),
+		ResponseBreached:   rs.ResponseBreached,
+		ResolutionBreached: rs.ResolutionBreached,
+		Reporter:           toUser(rs.Edges.Reporter),
+		Assignee:           toUser(rs.Edges.Assignee),
+		CreateTime:         timestamppb.New(rs.CreateTime),
+		UpdateTime:         timestamppb.New(rs.UpdateTime),
+	}
+	if eq := rs.Edges.Equipment; eq != nil {
+		m.EquipmentId = int64(eq.ID)
+	}
+	return m
+}
+
+// toTimestamp returns the timestamp of an optional time.
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
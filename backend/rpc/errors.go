@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/darksford123x/app/assignment"
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus maps an error of a service to a gRPC status, as the controllers
+// map them to HTTP statuses. Statuses are returned as they are.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := codes.Internal
+	switch {
+	case ent.IsNotFound(err):
+		code = codes.NotFound
+	case ent.IsValidationError(err):
+		code = codes.InvalidArgument
+	case ent.IsConstraintError(err):
+		// A unique field taken or an edge to a missing entity.
+		code = codes.FailedPrecondition
+	case errors.Is(err, auth.ErrUnauthenticated), errors.Is(err, auth.ErrInvalidToken), errors.Is(err, auth.ErrDisabled):
+		code = codes.Unauthenticated
+	case errors.Is(err, auth.ErrForbidden):
+		code = codes.PermissionDenied
+	case errors.Is(err, assignment.ErrNoTechnician), errors.Is(err, assignment.ErrNotOpen),
+		errors.Is(err, assignment.ErrTechnicianDisabled):
+		code = codes.FailedPrecondition
+	case errors.Is(err, assignment.ErrWorkloadChanged):
+		code = codes.Aborted
+	case errors.Is(err, assignment.ErrNotTechnician):
+		code = codes.InvalidArgument
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	}
+	return status.Error(code, err.Error())
+}
+
+// unaryErrors maps the errors of unary RPCs to statuses.
+func unaryErrors(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatus(err)
+}
+
+// streamErrors maps the errors of streaming RPCs to statuses.
+func streamErrors(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatus(handler(srv, ss))
+}
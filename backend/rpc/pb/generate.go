@@ -0,0 +1,5 @@
+// Package pb holds the messages and services of the gRPC API, generated
+// from the .proto files by buf with protoc-gen-go and protoc-gen-go-grpc.
+package pb
+
+//go:generate buf generate
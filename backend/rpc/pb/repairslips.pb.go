@@ -0,0 +1,1317 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        (unknown)
+// source: repairslips.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type RepairSlip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symptom  string `protobuf:"bytes,2,opt,name=symptom,proto3" json:"symptom,omitempty"`
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// status is received, in_progress, waiting_parts, ready or closed.
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	UnderWarranty bool   `protobuf:"varint,5,opt,name=under_warranty,json=underWarranty,proto3" json:"under_warranty,omitempty"`
+	// route is in_house or warranty_claim.
+	Route string `protobuf:"bytes,6,opt,name=route,proto3" json:"route,omitempty"`
+	// priority is low, normal, high or urgent.
+	Priority           string                 `protobuf:"bytes,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	ResponseDue        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=response_due,json=responseDue,proto3" json:"response_due,omitempty"`
+	ResolutionDue      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=resolution_due,json=resolutionDue,proto3" json:"resolution_due,omitempty"`
+	RespondedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	ResolvedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ResponseBreached   bool                   `protobuf:"varint,12,opt,name=response_breached,json=responseBreached,proto3" json:"response_breached,omitempty"`
+	ResolutionBreached bool                   `protobuf:"varint,13,opt,name=resolution_breached,json=resolutionBreached,proto3" json:"resolution_breached,omitempty"`
+	Reporter           *User                  `protobuf:"bytes,14,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Assignee           *User                  `protobuf:"bytes,15,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	EquipmentId        int64                  `protobuf:"varint,16,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	CreateTime         *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *RepairSlip) Reset() {
+	*x = RepairSlip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairSlip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairSlip) ProtoMessage() {}
+
+func (x *RepairSlip) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairSlip.ProtoReflect.Descriptor instead.
+func (*RepairSlip) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RepairSlip) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RepairSlip) GetSymptom() string {
+	if x != nil {
+		return x.Symptom
+	}
+	return ""
+}
+
+func (x *RepairSlip) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *RepairSlip) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RepairSlip) GetUnderWarranty() bool {
+	if x != nil {
+		return x.UnderWarranty
+	}
+	return false
+}
+
+func (x *RepairSlip) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *RepairSlip) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *RepairSlip) GetResponseDue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResponseDue
+	}
+	return nil
+}
+
+func (x *RepairSlip) GetResolutionDue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolutionDue
+	}
+	return nil
+}
+
+func (x *RepairSlip) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *RepairSlip) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *RepairSlip) GetResponseBreached() bool {
+	if x != nil {
+		return x.ResponseBreached
+	}
+	return false
+}
+
+func (x *RepairSlip) GetResolutionBreached() bool {
+	if x != nil {
+		return x.ResolutionBreached
+	}
+	return false
+}
+
+func (x *RepairSlip) GetReporter() *User {
+	if x != nil {
+		return x.Reporter
+	}
+	return nil
+}
+
+func (x *RepairSlip) GetAssignee() *User {
+	if x != nil {
+		return x.Assignee
+	}
+	return nil
+}
+
+func (x *RepairSlip) GetEquipmentId() int64 {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return 0
+}
+
+func (x *RepairSlip) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RepairSlip) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type CreateRepairSlipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReporterId  int64  `protobuf:"varint,1,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Symptom     string `protobuf:"bytes,2,opt,name=symptom,proto3" json:"symptom,omitempty"`
+	Category    string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	EquipmentId int64  `protobuf:"varint,4,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	// priority is normal when empty.
+	Priority   string `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	AutoAssign bool   `protobuf:"varint,6,opt,name=auto_assign,json=autoAssign,proto3" json:"auto_assign,omitempty"`
+}
+
+func (x *CreateRepairSlipRequest) Reset() {
+	*x = CreateRepairSlipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRepairSlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRepairSlipRequest) ProtoMessage() {}
+
+func (x *CreateRepairSlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRepairSlipRequest.ProtoReflect.Descriptor instead.
+func (*CreateRepairSlipRequest) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRepairSlipRequest) GetReporterId() int64 {
+	if x != nil {
+		return x.ReporterId
+	}
+	return 0
+}
+
+func (x *CreateRepairSlipRequest) GetSymptom() string {
+	if x != nil {
+		return x.Symptom
+	}
+	return ""
+}
+
+func (x *CreateRepairSlipRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateRepairSlipRequest) GetEquipmentId() int64 {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return 0
+}
+
+func (x *CreateRepairSlipRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *CreateRepairSlipRequest) GetAutoAssign() bool {
+	if x != nil {
+		return x.AutoAssign
+	}
+	return false
+}
+
+type GetRepairSlipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRepairSlipRequest) Reset() {
+	*x = GetRepairSlipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRepairSlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepairSlipRequest) ProtoMessage() {}
+
+func (x *GetRepairSlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepairSlipRequest.ProtoReflect.Descriptor instead.
+func (*GetRepairSlipRequest) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRepairSlipRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListRepairSlipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// limit is 10 when zero.
+	Limit  int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The slips are filtered by the fields that are set.
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	AssigneeId  int64  `protobuf:"varint,4,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	Route       string `protobuf:"bytes,5,opt,name=route,proto3" json:"route,omitempty"`
+	EquipmentId int64  `protobuf:"varint,6,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Priority    string `protobuf:"bytes,7,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *ListRepairSlipsRequest) Reset() {
+	*x = ListRepairSlipsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRepairSlipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepairSlipsRequest) ProtoMessage() {}
+
+func (x *ListRepairSlipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepairSlipsRequest.ProtoReflect.Descriptor instead.
+func (*ListRepairSlipsRequest) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRepairSlipsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRepairSlipsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListRepairSlipsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRepairSlipsRequest) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *ListRepairSlipsRequest) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *ListRepairSlipsRequest) GetEquipmentId() int64 {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return 0
+}
+
+func (x *ListRepairSlipsRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+type ListRepairSlipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepairSlips []*RepairSlip `protobuf:"bytes,1,rep,name=repair_slips,json=repairSlips,proto3" json:"repair_slips,omitempty"`
+}
+
+func (x *ListRepairSlipsResponse) Reset() {
+	*x = ListRepairSlipsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRepairSlipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepairSlipsResponse) ProtoMessage() {}
+
+func (x *ListRepairSlipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepairSlipsResponse.ProtoReflect.Descriptor instead.
+func (*ListRepairSlipsResponse) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRepairSlipsResponse) GetRepairSlips() []*RepairSlip {
+	if x != nil {
+		return x.RepairSlips
+	}
+	return nil
+}
+
+type DeleteRepairSlipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteRepairSlipRequest) Reset() {
+	*x = DeleteRepairSlipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRepairSlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRepairSlipRequest) ProtoMessage() {}
+
+func (x *DeleteRepairSlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRepairSlipRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRepairSlipRequest) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteRepairSlipRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateRepairSlipStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UpdateRepairSlipStatusRequest) Reset() {
+	*x = UpdateRepairSlipStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRepairSlipStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRepairSlipStatusRequest) ProtoMessage() {}
+
+func (x *UpdateRepairSlipStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRepairSlipStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRepairSlipStatusRequest) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateRepairSlipStatusRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRepairSlipStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateRepairSlipPriorityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Priority string `protobuf:"bytes,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *UpdateRepairSlipPriorityRequest) Reset() {
+	*x = UpdateRepairSlipPriorityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRepairSlipPriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRepairSlipPriorityRequest) ProtoMessage() {}
+
+func (x *UpdateRepairSlipPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRepairSlipPriorityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRepairSlipPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateRepairSlipPriorityRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRepairSlipPriorityRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+type AssignRepairSlipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TechnicianId int64 `protobuf:"varint,2,opt,name=technician_id,json=technicianId,proto3" json:"technician_id,omitempty"`
+}
+
+func (x *AssignRepairSlipRequest) Reset() {
+	*x = AssignRepairSlipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignRepairSlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRepairSlipRequest) ProtoMessage() {}
+
+func (x *AssignRepairSlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRepairSlipRequest.ProtoReflect.Descriptor instead.
+func (*AssignRepairSlipRequest) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AssignRepairSlipRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssignRepairSlipRequest) GetTechnicianId() int64 {
+	if x != nil {
+		return x.TechnicianId
+	}
+	return 0
+}
+
+type UnassignRepairSlipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UnassignRepairSlipRequest) Reset() {
+	*x = UnassignRepairSlipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnassignRepairSlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignRepairSlipRequest) ProtoMessage() {}
+
+func (x *UnassignRepairSlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignRepairSlipRequest.ProtoReflect.Descriptor instead.
+func (*UnassignRepairSlipRequest) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnassignRepairSlipRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AutoAssignRepairSlipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AutoAssignRepairSlipRequest) Reset() {
+	*x = AutoAssignRepairSlipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoAssignRepairSlipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoAssignRepairSlipRequest) ProtoMessage() {}
+
+func (x *AutoAssignRepairSlipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoAssignRepairSlipRequest.ProtoReflect.Descriptor instead.
+func (*AutoAssignRepairSlipRequest) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AutoAssignRepairSlipRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RepairSlipEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// event_id is the ID to resume from.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// op is created, updated or deleted, or reset when events were missed
+	// and the slips should be read again.
+	Op string `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Id int64  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// fields are the fields and edges an update changed.
+	Fields []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	// repair_slip is the slip after it was created or updated, or before it
+	// was deleted.
+	RepairSlip *RepairSlip            `protobuf:"bytes,5,opt,name=repair_slip,json=repairSlip,proto3" json:"repair_slip,omitempty"`
+	Time       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *RepairSlipEvent) Reset() {
+	*x = RepairSlipEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repairslips_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairSlipEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairSlipEvent) ProtoMessage() {}
+
+func (x *RepairSlipEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_repairslips_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairSlipEvent.ProtoReflect.Descriptor instead.
+func (*RepairSlipEvent) Descriptor() ([]byte, []int) {
+	return file_repairslips_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RepairSlipEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RepairSlipEvent) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *RepairSlipEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RepairSlipEvent) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *RepairSlipEvent) GetRepairSlip() *RepairSlip {
+	if x != nil {
+		return x.RepairSlip
+	}
+	return nil
+}
+
+func (x *RepairSlipEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+var File_repairslips_proto protoreflect.FileDescriptor
+
+var file_repairslips_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x73, 0x6c, 0x69, 0x70, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x06, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x06, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x70, 0x74, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x70, 0x74, 0x6f, 0x6d, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x61, 0x72,
+	0x72, 0x61, 0x6e, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x57, 0x61, 0x72, 0x72, 0x61, 0x6e, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x75, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x65, 0x12, 0x3d,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x70, 0x74, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x70, 0x74, 0x6f, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x75, 0x74, 0x6f, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x26, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x50, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x5f, 0x73, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70,
+	0x52, 0x0b, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x22, 0x29, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x4d, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x53, 0x6c, 0x69, 0x70, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x22, 0x4e, 0x0a, 0x17, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x49, 0x64,
+	0x22, 0x2b, 0x0a, 0x19, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a,
+	0x1b, 0x41, 0x75, 0x74, 0x6f, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc9, 0x01, 0x0a,
+	0x0f, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x73, 0x6c,
+	0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x0a, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x32, 0x94, 0x06, 0x0a, 0x0b, 0x52, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x1f, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69,
+	0x70, 0x12, 0x41, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c,
+	0x69, 0x70, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x53, 0x6c, 0x69, 0x70, 0x12, 0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x1f, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x25, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x57, 0x0a, 0x18, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53,
+	0x6c, 0x69, 0x70, 0x12, 0x47, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x4b, 0x0a, 0x12,
+	0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c,
+	0x69, 0x70, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x4f, 0x0a, 0x14, 0x41, 0x75, 0x74,
+	0x6f, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69,
+	0x70, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x43, 0x0a, 0x10, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x14,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x53, 0x6c, 0x69, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42,
+	0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
+	0x72, 0x6b, 0x73, 0x66, 0x6f, 0x72, 0x64, 0x31, 0x32, 0x33, 0x78, 0x2f, 0x61, 0x70, 0x70, 0x2f,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_repairslips_proto_rawDescOnce sync.Once
+	file_repairslips_proto_rawDescData = file_repairslips_proto_rawDesc
+)
+
+func file_repairslips_proto_rawDescGZIP() []byte {
+	file_repairslips_proto_rawDescOnce.Do(func() {
+		file_repairslips_proto_rawDescData = protoimpl.X.CompressGZIP(file_repairslips_proto_rawDescData)
+	})
+	return file_repairslips_proto_rawDescData
+}
+
+var file_repairslips_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_repairslips_proto_goTypes = []interface{}{
+	(*RepairSlip)(nil),                      // 0: app.v1.RepairSlip
+	(*CreateRepairSlipRequest)(nil),         // 1: app.v1.CreateRepairSlipRequest
+	(*GetRepairSlipRequest)(nil),            // 2: app.v1.GetRepairSlipRequest
+	(*ListRepairSlipsRequest)(nil),          // 3: app.v1.ListRepairSlipsRequest
+	(*ListRepairSlipsResponse)(nil),         // 4: app.v1.ListRepairSlipsResponse
+	(*DeleteRepairSlipRequest)(nil),         // 5: app.v1.DeleteRepairSlipRequest
+	(*UpdateRepairSlipStatusRequest)(nil),   // 6: app.v1.UpdateRepairSlipStatusRequest
+	(*UpdateRepairSlipPriorityRequest)(nil), // 7: app.v1.UpdateRepairSlipPriorityRequest
+	(*AssignRepairSlipRequest)(nil),         // 8: app.v1.AssignRepairSlipRequest
+	(*UnassignRepairSlipRequest)(nil),       // 9: app.v1.UnassignRepairSlipRequest
+	(*AutoAssignRepairSlipRequest)(nil),     // 10: app.v1.AutoAssignRepairSlipRequest
+	(*RepairSlipEvent)(nil),                 // 11: app.v1.RepairSlipEvent
+	(*timestamppb.Timestamp)(nil),           // 12: google.protobuf.Timestamp
+	(*User)(nil),                            // 13: app.v1.User
+	(*WatchRequest)(nil),                    // 14: app.v1.WatchRequest
+	(*emptypb.Empty)(nil),                   // 15: google.protobuf.Empty
+}
+var file_repairslips_proto_depIdxs = []int32{
+	12, // 0: app.v1.RepairSlip.response_due:type_name -> google.protobuf.Timestamp
+	12, // 1: app.v1.RepairSlip.resolution_due:type_name -> google.protobuf.Timestamp
+	12, // 2: app.v1.RepairSlip.responded_at:type_name -> google.protobuf.Timestamp
+	12, // 3: app.v1.RepairSlip.resolved_at:type_name -> google.protobuf.Timestamp
+	13, // 4: app.v1.RepairSlip.reporter:type_name -> app.v1.User
+	13, // 5: app.v1.RepairSlip.assignee:type_name -> app.v1.User
+	12, // 6: app.v1.RepairSlip.create_time:type_name -> google.protobuf.Timestamp
+	12, // 7: app.v1.RepairSlip.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 8: app.v1.ListRepairSlipsResponse.repair_slips:type_name -> app.v1.RepairSlip
+	0,  // 9: app.v1.RepairSlipEvent.repair_slip:type_name -> app.v1.RepairSlip
+	12, // 10: app.v1.RepairSlipEvent.time:type_name -> google.protobuf.Timestamp
+	1,  // 11: app.v1.RepairSlips.CreateRepairSlip:input_type -> app.v1.CreateRepairSlipRequest
+	2,  // 12: app.v1.RepairSlips.GetRepairSlip:input_type -> app.v1.GetRepairSlipRequest
+	3,  // 13: app.v1.RepairSlips.ListRepairSlips:input_type -> app.v1.ListRepairSlipsRequest
+	5,  // 14: app.v1.RepairSlips.DeleteRepairSlip:input_type -> app.v1.DeleteRepairSlipRequest
+	6,  // 15: app.v1.RepairSlips.UpdateRepairSlipStatus:input_type -> app.v1.UpdateRepairSlipStatusRequest
+	7,  // 16: app.v1.RepairSlips.UpdateRepairSlipPriority:input_type -> app.v1.UpdateRepairSlipPriorityRequest
+	8,  // 17: app.v1.RepairSlips.AssignRepairSlip:input_type -> app.v1.AssignRepairSlipRequest
+	9,  // 18: app.v1.RepairSlips.UnassignRepairSlip:input_type -> app.v1.UnassignRepairSlipRequest
+	10, // 19: app.v1.RepairSlips.AutoAssignRepairSlip:input_type -> app.v1.AutoAssignRepairSlipRequest
+	14, // 20: app.v1.RepairSlips.WatchRepairSlips:input_type -> app.v1.WatchRequest
+	0,  // 21: app.v1.RepairSlips.CreateRepairSlip:output_type -> app.v1.RepairSlip
+	0,  // 22: app.v1.RepairSlips.GetRepairSlip:output_type -> app.v1.RepairSlip
+	4,  // 23: app.v1.RepairSlips.ListRepairSlips:output_type -> app.v1.ListRepairSlipsResponse
+	15, // 24: app.v1.RepairSlips.DeleteRepairSlip:output_type -> google.protobuf.Empty
+	0,  // 25: app.v1.RepairSlips.UpdateRepairSlipStatus:output_type -> app.v1.RepairSlip
+	0,  // 26: app.v1.RepairSlips.UpdateRepairSlipPriority:output_type -> app.v1.RepairSlip
+	0,  // 27: app.v1.RepairSlips.AssignRepairSlip:output_type -> app.v1.RepairSlip
+	0,  // 28: app.v1.RepairSlips.UnassignRepairSlip:output_type -> app.v1.RepairSlip
+	0,  // 29: app.v1.RepairSlips.AutoAssignRepairSlip:output_type -> app.v1.RepairSlip
+	11, // 30: app.v1.RepairSlips.WatchRepairSlips:output_type -> app.v1.RepairSlipEvent
+	21, // [21:31] is the sub-list for method output_type
+	11, // [11:21] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_repairslips_proto_init() }
+func file_repairslips_proto_init() {
+	if File_repairslips_proto != nil {
+		return
+	}
+	file_users_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_repairslips_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairSlip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRepairSlipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRepairSlipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepairSlipsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepairSlipsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRepairSlipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRepairSlipStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRepairSlipPriorityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignRepairSlipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnassignRepairSlipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoAssignRepairSlipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repairslips_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairSlipEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_repairslips_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_repairslips_proto_goTypes,
+		DependencyIndexes: file_repairslips_proto_depIdxs,
+		MessageInfos:      file_repairslips_proto_msgTypes,
+	}.Build()
+	File_repairslips_proto = out.File
+	file_repairslips_proto_rawDesc = nil
+	file_repairslips_proto_goTypes = nil
+	file_repairslips_proto_depIdxs = nil
+}
@@ -0,0 +1,472 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// RepairSlipsClient is the client API for RepairSlips service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RepairSlipsClient interface {
+	// CreateRepairSlip creates a slip, optionally assigning it to the least
+	// loaded technician of its category. Slips for equipment under warranty
+	// are routed to a warranty claim and are not assigned.
+	CreateRepairSlip(ctx context.Context, in *CreateRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error)
+	GetRepairSlip(ctx context.Context, in *GetRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error)
+	ListRepairSlips(ctx context.Context, in *ListRepairSlipsRequest, opts ...grpc.CallOption) (*ListRepairSlipsResponse, error)
+	DeleteRepairSlip(ctx context.Context, in *DeleteRepairSlipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateRepairSlipStatus(ctx context.Context, in *UpdateRepairSlipStatusRequest, opts ...grpc.CallOption) (*RepairSlip, error)
+	// UpdateRepairSlipPriority changes the priority; the SLA due dates are
+	// computed again.
+	UpdateRepairSlipPriority(ctx context.Context, in *UpdateRepairSlipPriorityRequest, opts ...grpc.CallOption) (*RepairSlip, error)
+	AssignRepairSlip(ctx context.Context, in *AssignRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error)
+	UnassignRepairSlip(ctx context.Context, in *UnassignRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error)
+	// AutoAssignRepairSlip assigns the slip to the technician of its
+	// category with the fewest open slips. It fails with FAILED_PRECONDITION
+	// when there is none.
+	AutoAssignRepairSlip(ctx context.Context, in *AutoAssignRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error)
+	// WatchRepairSlips streams the committed changes of the slips the caller
+	// may see: all of them for admins and supervisors, those they reported
+	// or are assigned otherwise.
+	WatchRepairSlips(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (RepairSlips_WatchRepairSlipsClient, error)
+}
+
+type repairSlipsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRepairSlipsClient(cc grpc.ClientConnInterface) RepairSlipsClient {
+	return &repairSlipsClient{cc}
+}
+
+func (c *repairSlipsClient) CreateRepairSlip(ctx context.Context, in *CreateRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error) {
+	out := new(RepairSlip)
+	err := c.cc.Invoke(ctx, "/app.v1.RepairSlips/CreateRepairSlip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repairSlipsClient) GetRepairSlip(ctx context.Context, in *GetRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error) {
+	out := new(RepairSlip)
+	err := c.cc.Invoke(ctx, "/app.v1.RepairSlips/GetRepairSlip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repairSlipsClient) ListRepairSlips(ctx context.Context, in *ListRepairSlipsRequest, opts ...grpc.CallOption) (*ListRepairSlipsResponse, error) {
+	out := new(ListRepairSlipsResponse)
+	err := c.cc.Invoke(ctx, "/app.v1.RepairSlips/ListRepairSlips", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repairSlipsClient) DeleteRepairSlip(ctx context.Context, in *DeleteRepairSlipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/app.v1.RepairSlips/DeleteRepairSlip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repairSlipsClient) UpdateRepairSlipStatus(ctx context.Context, in *UpdateRepairSlipStatusRequest, opts ...grpc.CallOption) (*RepairSlip, error) {
+	out := new(RepairSlip)
+	err := c.cc.Invoke(ctx, "/app.v1.RepairSlips/UpdateRepairSlipStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repairSlipsClient) UpdateRepairSlipPriority(ctx context.Context, in *UpdateRepairSlipPriorityRequest, opts ...grpc.CallOption) (*RepairSlip, error) {
+	out := new(RepairSlip)
+	err := c.cc.Invoke(ctx, "/app.v1.RepairSlips/UpdateRepairSlipPriority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repairSlipsClient) AssignRepairSlip(ctx context.Context, in *AssignRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error) {
+	out := new(RepairSlip)
+	err := c.cc.Invoke(ctx, "/app.v1.RepairSlips/AssignRepairSlip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repairSlipsClient) UnassignRepairSlip(ctx context.Context, in *UnassignRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error) {
+	out := new(RepairSlip)
+	err := c.cc.Invoke(ctx, "/app.v1.RepairSlips/UnassignRepairSlip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repairSlipsClient) AutoAssignRepairSlip(ctx context.Context, in *AutoAssignRepairSlipRequest, opts ...grpc.CallOption) (*RepairSlip, error) {
+	out := new(RepairSlip)
+	err := c.cc.Invoke(ctx, "/app.v1.RepairSlips/AutoAssignRepairSlip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repairSlipsClient) WatchRepairSlips(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (RepairSlips_WatchRepairSlipsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RepairSlips_serviceDesc.Streams[0], "/app.v1.RepairSlips/WatchRepairSlips", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repairSlipsWatchRepairSlipsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepairSlips_WatchRepairSlipsClient interface {
+	Recv() (*RepairSlipEvent, error)
+	grpc.ClientStream
+}
+
+type repairSlipsWatchRepairSlipsClient struct {
+	grpc.ClientStream
+}
+
+func (x *repairSlipsWatchRepairSlipsClient) Recv() (*RepairSlipEvent, error) {
+	m := new(RepairSlipEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RepairSlipsServer is the server API for RepairSlips service.
+// All implementations must embed UnimplementedRepairSlipsServer
+// for forward compatibility
+type RepairSlipsServer interface {
+	// CreateRepairSlip creates a slip, optionally assigning it to the least
+	// loaded technician of its category. Slips for equipment under warranty
+	// are routed to a warranty claim and are not assigned.
+	CreateRepairSlip(context.Context, *CreateRepairSlipRequest) (*RepairSlip, error)
+	GetRepairSlip(context.Context, *GetRepairSlipRequest) (*RepairSlip, error)
+	ListRepairSlips(context.Context, *ListRepairSlipsRequest) (*ListRepairSlipsResponse, error)
+	DeleteRepairSlip(context.Context, *DeleteRepairSlipRequest) (*emptypb.Empty, error)
+	UpdateRepairSlipStatus(context.Context, *UpdateRepairSlipStatusRequest) (*RepairSlip, error)
+	// UpdateRepairSlipPriority changes the priority; the SLA due dates are
+	// computed again.
+	UpdateRepairSlipPriority(context.Context, *UpdateRepairSlipPriorityRequest) (*RepairSlip, error)
+	AssignRepairSlip(context.Context, *AssignRepairSlipRequest) (*RepairSlip, error)
+	UnassignRepairSlip(context.Context, *UnassignRepairSlipRequest) (*RepairSlip, error)
+	// AutoAssignRepairSlip assigns the slip to the technician of its
+	// category with the fewest open slips. It fails with FAILED_PRECONDITION
+	// when there is none.
+	AutoAssignRepairSlip(context.Context, *AutoAssignRepairSlipRequest) (*RepairSlip, error)
+	// WatchRepairSlips streams the committed changes of the slips the caller
+	// may see: all of them for admins and supervisors, those they reported
+	// or are assigned otherwise.
+	WatchRepairSlips(*WatchRequest, RepairSlips_WatchRepairSlipsServer) error
+	mustEmbedUnimplementedRepairSlipsServer()
+}
+
+// UnimplementedRepairSlipsServer must be embedded to have forward compatible implementations.
+type UnimplementedRepairSlipsServer struct {
+}
+
+func (UnimplementedRepairSlipsServer) CreateRepairSlip(context.Context, *CreateRepairSlipRequest) (*RepairSlip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRepairSlip not implemented")
+}
+func (UnimplementedRepairSlipsServer) GetRepairSlip(context.Context, *GetRepairSlipRequest) (*RepairSlip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepairSlip not implemented")
+}
+func (UnimplementedRepairSlipsServer) ListRepairSlips(context.Context, *ListRepairSlipsRequest) (*ListRepairSlipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepairSlips not implemented")
+}
+func (UnimplementedRepairSlipsServer) DeleteRepairSlip(context.Context, *DeleteRepairSlipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepairSlip not implemented")
+}
+func (UnimplementedRepairSlipsServer) UpdateRepairSlipStatus(context.Context, *UpdateRepairSlipStatusRequest) (*RepairSlip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepairSlipStatus not implemented")
+}
+func (UnimplementedRepairSlipsServer) UpdateRepairSlipPriority(context.Context, *UpdateRepairSlipPriorityRequest) (*RepairSlip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepairSlipPriority not implemented")
+}
+func (UnimplementedRepairSlipsServer) AssignRepairSlip(context.Context, *AssignRepairSlipRequest) (*RepairSlip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRepairSlip not implemented")
+}
+func (UnimplementedRepairSlipsServer) UnassignRepairSlip(context.Context, *UnassignRepairSlipRequest) (*RepairSlip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignRepairSlip not implemented")
+}
+func (UnimplementedRepairSlipsServer) AutoAssignRepairSlip(context.Context, *AutoAssignRepairSlipRequest) (*RepairSlip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoAssignRepairSlip not implemented")
+}
+func (UnimplementedRepairSlipsServer) WatchRepairSlips(*WatchRequest, RepairSlips_WatchRepairSlipsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRepairSlips not implemented")
+}
+func (UnimplementedRepairSlipsServer) mustEmbedUnimplementedRepairSlipsServer() {}
+
+// UnsafeRepairSlipsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RepairSlipsServer will
+// result in compilation errors.
+type UnsafeRepairSlipsServer interface {
+	mustEmbedUnimplementedRepairSlipsServer()
+}
+
+func RegisterRepairSlipsServer(s grpc.ServiceRegistrar, srv RepairSlipsServer) {
+	s.RegisterService(&_RepairSlips_serviceDesc, srv)
+}
+
+func _RepairSlips_CreateRepairSlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRepairSlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepairSlipsServer).CreateRepairSlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.v1.RepairSlips/CreateRepairSlip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepairSlipsServer).CreateRepairSlip(ctx, req.(*CreateRepairSlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepairSlips_GetRepairSlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepairSlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepairSlipsServer).GetRepairSlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.v1.RepairSlips/GetRepairSlip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepairSlipsServer).GetRepairSlip(ctx, req.(*GetRepairSlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepairSlips_ListRepairSlips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepairSlipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepairSlipsServer).ListRepairSlips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.v1.RepairSlips/ListRepairSlips",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepairSlipsServer).ListRepairSlips(ctx, req.(*ListRepairSlipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepairSlips_DeleteRepairSlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepairSlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepairSlipsServer).DeleteRepairSlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.v1.RepairSlips/DeleteRepairSlip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepairSlipsServer).DeleteRepairSlip(ctx, req.(*DeleteRepairSlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepairSlips_UpdateRepairSlipStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepairSlipStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepairSlipsServer).UpdateRepairSlipStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.v1.RepairSlips/UpdateRepairSlipStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepairSlipsServer).UpdateRepairSlipStatus(ctx, req.(*UpdateRepairSlipStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepairSlips_UpdateRepairSlipPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepairSlipPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepairSlipsServer).UpdateRepairSlipPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.v1.RepairSlips/UpdateRepairSlipPriority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepairSlipsServer).UpdateRepairSlipPriority(ctx, req.(*UpdateRepairSlipPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepairSlips_AssignRepairSlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRepairSlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepairSlipsServer).AssignRepairSlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.v1.RepairSlips/AssignRepairSlip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepairSlipsServer).AssignRepairSlip(ctx, req.(*AssignRepairSlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepairSlips_UnassignRepairSlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignRepairSlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepairSlipsServer).UnassignRepairSlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.v1.RepairSlips/UnassignRepairSlip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepairSlipsServer).UnassignRepairSlip(ctx, req.(*UnassignRepairSlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepairSlips_AutoAssignRepairSlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoAssignRepairSlipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepairSlipsServer).AutoAssignRepairSlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.v1.RepairSlips/AutoAssignRepairSlip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepairSlipsServer).AutoAssignRepairSlip(ctx, req.(*AutoAssignRepairSlipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepairSlips_WatchRepairSlips_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepairSlipsServer).WatchRepairSlips(m, &repairSlipsWatchRepairSlipsServer{stream})
+}
+
+type RepairSlips_WatchRepairSlipsServer interface {
+	Send(*RepairSlipEvent) error
+	grpc.ServerStream
+}
+
+type repairSlipsWatchRepairSlipsServer struct {
+	grpc.ServerStream
+}
+
+func (x *repairSlipsWatchRepairSlipsServer) Send(m *RepairSlipEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _RepairSlips_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "app.v1.RepairSlips",
+	HandlerType: (*RepairSlipsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRepairSlip",
+			Handler:    _RepairSlips_CreateRepairSlip_Handler,
+		},
+		{
+			MethodName: "GetRepairSlip",
+			Handler:    _RepairSlips_GetRepairSlip_Handler,
+		},
+		{
+			MethodName: "ListRepairSlips",
+			Handler:    _RepairSlips_ListRepairSlips_Handler,
+		},
+		{
+			MethodName: "DeleteRepairSlip",
+			Handler:    _RepairSlips_DeleteRepairSlip_Handler,
+		},
+		{
+			MethodName: "UpdateRepairSlipStatus",
+			Handler:    _RepairSlips_UpdateRepairSlipStatus_Handler,
+		},
+		{
+			MethodName: "UpdateRepairSlipPriority",
+			Handler:    _RepairSlips_UpdateRepairSlipPriority_Handler,
+		},
+		{
+			MethodName: "AssignRepairSlip",
+			Handler:    _RepairSlips_AssignRepairSlip_Handler,
+		},
+		{
+			MethodName: "UnassignRepairSlip",
+			Handler:    _RepairSlips_UnassignRepairSlip_Handler,
+		},
+		{
+			MethodName: "AutoAssignRepairSlip",
+			Handler:    _RepairSlips_AutoAssignRepairSlip_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRepairSlips",
+			Handler:       _RepairSlips_WatchRepairSlips_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "repairslips.proto",
+}
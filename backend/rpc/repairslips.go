@@ -0,0 +1,225 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/darksford123x/app/assignment"
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/rpc/pb"
+	"github.com/darksford123x/app/stream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// repairSlips is the RepairSlips service.
+type repairSlips struct {
+	pb.UnimplementedRepairSlipsServer
+	client   *ent.Client
+	balancer *assignment.Balancer
+	broker   *stream.Broker
+}
+
+// CreateRepairSlip creates a repair slip and assigns it in the same
+// transaction when asked to.
+func (s *repairSlips) CreateRepairSlip(ctx context.Context, req *pb.CreateRepairSlipRequest) (*pb.RepairSlip, error) {
+	rs, err := s.balancer.Create(ctx, func(client *ent.Client) *ent.RepairSlipCreate {
+		builder := client.RepairSlip.
+			Create().
+			SetSymptom(req.Symptom).
+			SetCategory(req.Category)
+		if req.ReporterId != 0 {
+			builder.SetReporterID(int(req.ReporterId))
+		}
+		if req.EquipmentId != 0 {
+			builder.SetEquipmentID(int(req.EquipmentId))
+		}
+		if req.Priority != "" {
+			builder.SetPriority(repairslip.Priority(req.Priority))
+		}
+		return builder
+	}, req.AutoAssign)
+	if err != nil {
+		return nil, err
+	}
+	return s.get(ctx, rs.ID)
+}
+
+// GetRepairSlip gets a repair slip by ID.
+func (s *repairSlips) GetRepairSlip(ctx context.Context, req *pb.GetRepairSlipRequest) (*pb.RepairSlip, error) {
+	return s.get(ctx, int(req.Id))
+}
+
+// ListRepairSlips lists the repair slips matching the filters.
+func (s *repairSlips) ListRepairSlips(ctx context.Context, req *pb.ListRepairSlipsRequest) (*pb.ListRepairSlipsResponse, error) {
+	limit := 10
+	if req.Limit > 0 {
+		limit = int(req.Limit)
+	}
+	query := s.client.RepairSlip.
+		Query().
+		WithReporter().
+		WithAssignee().
+		WithEquipment().
+		Order(ent.Asc(repairslip.FieldID))
+	if req.Status != "" {
+		st := repairslip.Status(req.Status)
+		if err := repairslip.StatusValidator(st); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		query.Where(repairslip.StatusEQ(st))
+	}
+	if req.AssigneeId != 0 {
+		query.Where(repairslip.HasAssigneeWith(user.IDEQ(int(req.AssigneeId))))
+	}
+	if req.Route != "" {
+		r := repairslip.Route(req.Route)
+		if err := repairslip.RouteValidator(r); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		query.Where(repairslip.RouteEQ(r))
+	}
+	if req.EquipmentId != 0 {
+		query.Where(repairslip.HasEquipmentWith(equipment.IDEQ(int(req.EquipmentId))))
+	}
+	if req.Priority != "" {
+		p := repairslip.Priority(req.Priority)
+		if err := repairslip.PriorityValidator(p); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		query.Where(repairslip.PriorityEQ(p))
+	}
+	slips, err := query.
+		Limit(limit).
+		Offset(int(req.Offset)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListRepairSlipsResponse{RepairSlips: make([]*pb.RepairSlip, len(slips))}
+	for i, rs := range slips {
+		resp.RepairSlips[i] = toRepairSlip(rs)
+	}
+	return resp, nil
+}
+
+// mayManage refuses the callers who may not delete or assign repair slips:
+// only supervisors and admins may, as over REST.
+func mayManage(ctx context.Context) error {
+	u := auth.FromContext(ctx)
+	if u == nil || !auth.HasRole(u, user.RoleSupervisor, user.RoleAdmin) {
+		return auth.ErrForbidden
+	}
+	return nil
+}
+
+// DeleteRepairSlip deletes a repair slip by ID; only supervisors and admins
+// may.
+func (s *repairSlips) DeleteRepairSlip(ctx context.Context, req *pb.DeleteRepairSlipRequest) (*emptypb.Empty, error) {
+	if err := mayManage(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.client.RepairSlip.DeleteOneID(int(req.Id)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateRepairSlipStatus changes the status of a repair slip.
+func (s *repairSlips) UpdateRepairSlipStatus(ctx context.Context, req *pb.UpdateRepairSlipStatusRequest) (*pb.RepairSlip, error) {
+	err := s.client.RepairSlip.
+		UpdateOneID(int(req.Id)).
+		SetStatus(repairslip.Status(req.Status)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.get(ctx, int(req.Id))
+}
+
+// UpdateRepairSlipPriority changes the priority of a repair slip.
+func (s *repairSlips) UpdateRepairSlipPriority(ctx context.Context, req *pb.UpdateRepairSlipPriorityRequest) (*pb.RepairSlip, error) {
+	err := s.client.RepairSlip.
+		UpdateOneID(int(req.Id)).
+		SetPriority(repairslip.Priority(req.Priority)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.get(ctx, int(req.Id))
+}
+
+// AssignRepairSlip assigns a repair slip to a technician; only supervisors
+// and admins may.
+func (s *repairSlips) AssignRepairSlip(ctx context.Context, req *pb.AssignRepairSlipRequest) (*pb.RepairSlip, error) {
+	if err := mayManage(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.balancer.Assign(ctx, int(req.Id), int(req.TechnicianId)); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, int(req.Id))
+}
+
+// UnassignRepairSlip clears the technician assigned to a repair slip; only
+// supervisors and admins may.
+func (s *repairSlips) UnassignRepairSlip(ctx context.Context, req *pb.UnassignRepairSlipRequest) (*pb.RepairSlip, error) {
+	if err := mayManage(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.balancer.Unassign(ctx, int(req.Id)); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, int(req.Id))
+}
+
+// AutoAssignRepairSlip assigns a repair slip to the least loaded technician
+// of its category; only supervisors and admins may.
+func (s *repairSlips) AutoAssignRepairSlip(ctx context.Context, req *pb.AutoAssignRepairSlipRequest) (*pb.RepairSlip, error) {
+	if err := mayManage(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.balancer.AutoAssign(ctx, int(req.Id)); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, int(req.Id))
+}
+
+// WatchRepairSlips streams the changes of the repair slips the caller may
+// see.
+func (s *repairSlips) WatchRepairSlips(req *pb.WatchRequest, ss pb.RepairSlips_WatchRepairSlipsServer) error {
+	return watch(ss.Context(), s.broker, "repairslip", req.LastEventId, func(m stream.Message) error {
+		e := &pb.RepairSlipEvent{
+			EventId: m.ID,
+			Op:      op(m),
+			Id:      int64(m.Event.ID),
+			Fields:  m.Fields,
+			Time:    timestamppb.New(m.Time),
+		}
+		if rs, ok := m.Data.(*ent.RepairSlip); ok {
+			e.RepairSlip = toRepairSlip(rs)
+		}
+		return ss.Send(e)
+	})
+}
+
+// get returns the repair slip with its reporter, assignee and equipment
+// loaded.
+func (s *repairSlips) get(ctx context.Context, id int) (*pb.RepairSlip, error) {
+	rs, err := s.client.RepairSlip.
+		Query().
+		Where(repairslip.IDEQ(id)).
+		WithReporter().
+		WithAssignee().
+		WithEquipment().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRepairSlip(rs), nil
+}
@@ -0,0 +1,123 @@
+package rpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/rpc/pb"
+	"github.com/darksford123x/app/servertest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRepairSlips(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	supervisor := h.User().SetRole(user.RoleSupervisor).SaveX(ctx)
+	tech := h.User().SetRole(user.RoleTechnician).SetSkill("projector").SaveX(ctx)
+	eq := h.Equipment().SaveX(ctx)
+	slips := pb.NewRepairSlipsClient(dial(h))
+
+	rs, err := slips.CreateRepairSlip(as(h, staff), &pb.CreateRepairSlipRequest{
+		Symptom:     "Does not turn on",
+		Category:    "projector",
+		ReporterId:  int64(staff.ID),
+		EquipmentId: int64(eq.ID),
+		Priority:    "high",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs.Status != "received" || rs.Priority != "high" || rs.Reporter.GetId() != int64(staff.ID) || rs.Assignee != nil {
+		t.Errorf("CreateRepairSlip = %v, want a received slip of high priority reported by %d", rs, staff.ID)
+	}
+	if _, err := slips.CreateRepairSlip(as(h, staff), &pb.CreateRepairSlipRequest{Category: "projector"}); code(err) != codes.InvalidArgument {
+		t.Errorf("CreateRepairSlip without a symptom: %v, want %s", err, codes.InvalidArgument)
+	}
+	other, err := slips.CreateRepairSlip(as(h, staff), &pb.CreateRepairSlipRequest{Symptom: "Noisy fan", Category: "projector"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only supervisors and admins assign and delete slips.
+	if _, err := slips.AssignRepairSlip(as(h, staff), &pb.AssignRepairSlipRequest{Id: rs.Id, TechnicianId: int64(tech.ID)}); code(err) != codes.PermissionDenied {
+		t.Errorf("AssignRepairSlip by staff: %v, want %s", err, codes.PermissionDenied)
+	}
+	if _, err := slips.AssignRepairSlip(as(h, supervisor), &pb.AssignRepairSlipRequest{Id: rs.Id, TechnicianId: int64(staff.ID)}); code(err) != codes.InvalidArgument {
+		t.Errorf("AssignRepairSlip to staff: %v, want %s", err, codes.InvalidArgument)
+	}
+	rs, err = slips.AssignRepairSlip(as(h, supervisor), &pb.AssignRepairSlipRequest{Id: rs.Id, TechnicianId: int64(tech.ID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs.Assignee.GetId() != int64(tech.ID) {
+		t.Errorf("AssignRepairSlip = %v, want assigned to %d", rs, tech.ID)
+	}
+	if _, err := slips.DeleteRepairSlip(as(h, staff), &pb.DeleteRepairSlipRequest{Id: other.Id}); code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteRepairSlip by staff: %v, want %s", err, codes.PermissionDenied)
+	}
+	if _, err := slips.DeleteRepairSlip(as(h, supervisor), &pb.DeleteRepairSlipRequest{Id: other.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := slips.GetRepairSlip(as(h, staff), &pb.GetRepairSlipRequest{Id: other.Id}); code(err) != codes.NotFound {
+		t.Errorf("GetRepairSlip of a deleted slip: %v, want %s", err, codes.NotFound)
+	}
+
+	// Changes are streamed to the watchers. An unknown last event starts
+	// the stream with a reset, once the watcher is subscribed.
+	wctx, cancel := context.WithTimeout(as(h, staff), 10*time.Second)
+	defer cancel()
+	watch, err := slips.WatchRepairSlips(wctx, &pb.WatchRequest{LastEventId: "unknown"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, err := watch.Recv(); err != nil || e.Op != "reset" {
+		t.Fatalf("first event = %v, %v, want a reset", e, err)
+	}
+	rs, err = slips.UpdateRepairSlipStatus(as(h, tech), &pb.UpdateRepairSlipStatusRequest{Id: rs.Id, Status: "in_progress"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := slips.UpdateRepairSlipPriority(as(h, tech), &pb.UpdateRepairSlipPriorityRequest{Id: rs.Id, Priority: "someday"}); code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateRepairSlipPriority to an unknown priority: %v, want %s", err, codes.InvalidArgument)
+	}
+	e, err := watch.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Op != "updated" || e.Id != rs.Id || e.RepairSlip.GetStatus() != "in_progress" {
+		t.Errorf("event = %v, want slip %d updated to in_progress", e, rs.Id)
+	}
+
+	list := func(req *pb.ListRepairSlipsRequest) string {
+		t.Helper()
+		resp, err := slips.ListRepairSlips(as(h, staff), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []int64
+		for _, rs := range resp.RepairSlips {
+			ids = append(ids, rs.Id)
+		}
+		return fmt.Sprint(ids)
+	}
+	h.RepairSlip(staff).SaveX(ctx)
+	want := fmt.Sprint([]int64{rs.Id})
+	for _, req := range []*pb.ListRepairSlipsRequest{
+		{Status: "in_progress"},
+		{AssigneeId: int64(tech.ID)},
+		{EquipmentId: int64(eq.ID)},
+		{Priority: "high"},
+		{Limit: 1},
+	} {
+		if got := list(req); got != want {
+			t.Errorf("ListRepairSlips(%v) = %s, want %s", req, got, want)
+		}
+	}
+	if _, err := slips.ListRepairSlips(as(h, staff), &pb.ListRepairSlipsRequest{Status: "lost"}); code(err) != codes.InvalidArgument {
+		t.Errorf("ListRepairSlips of an unknown status: %v, want %s", err, codes.InvalidArgument)
+	}
+}
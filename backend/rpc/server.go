@@ -0,0 +1,27 @@
+// Package rpc serves the users and repair slips over gRPC, for tools that
+// want a typed and streaming interface instead of JSON over HTTP. The
+// services do what their REST endpoints do; callers are identified from the
+// same bearer tokens, and the watch RPCs stream the same events as the
+// event stream of the REST API.
+package rpc
+
+import (
+	"github.com/darksford123x/app/assignment"
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/rpc/pb"
+	"github.com/darksford123x/app/stream"
+	"google.golang.org/grpc"
+)
+
+// NewServer creates the gRPC server of the users and repair slips services.
+// The watch RPCs end when the broker is closed.
+func NewServer(client *ent.Client, tokens *auth.Tokens, balancer *assignment.Balancer, broker *stream.Broker) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryErrors, unaryAuth(client, tokens)),
+		grpc.ChainStreamInterceptor(streamErrors, streamAuth(client, tokens)),
+	)
+	pb.RegisterUsersServer(srv, &users{client: client, broker: broker})
+	pb.RegisterRepairSlipsServer(srv, &repairSlips{client: client, balancer: balancer, broker: broker})
+	return srv
+}
@@ -0,0 +1,82 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/rpc/pb"
+	"github.com/darksford123x/app/servertest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dial serves the gRPC services of the harness in memory and connects to
+// them. The server stops when the test ends.
+func dial(h *servertest.Harness) *grpc.ClientConn {
+	h.T.Helper()
+	ln := bufconn.Listen(1 << 20)
+	srv := h.Server.GRPC()
+	go srv.Serve(ln)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return ln.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		h.T.Fatal(err)
+	}
+	h.T.Cleanup(func() {
+		conn.Close()
+		srv.Stop()
+	})
+	return conn
+}
+
+// as returns the context of calls made by the user.
+func as(h *servertest.Harness, u *ent.User) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+h.Token(u))
+}
+
+// code returns the gRPC code of an error.
+func code(err error) codes.Code {
+	return status.Code(err)
+}
+
+func TestAuthentication(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	u := h.User().SaveX(ctx)
+	disabled := h.User().SetDisabled(true).SaveX(ctx)
+	conn := dial(h)
+	users := pb.NewUsersClient(conn)
+	slips := pb.NewRepairSlipsClient(conn)
+
+	// Callers are identified by the bearer tokens of the REST API, on
+	// unary and streaming calls alike.
+	for name, ctx := range map[string]context.Context{
+		"no token":      context.Background(),
+		"a bad token":   metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer nonsense"),
+		"a basic token": metadata.AppendToOutgoingContext(context.Background(), "authorization", "Basic "+h.Token(u)),
+		"disabled":      as(h, disabled),
+	} {
+		if _, err := users.GetUser(ctx, &pb.GetUserRequest{Id: int64(u.ID)}); code(err) != codes.Unauthenticated {
+			t.Errorf("GetUser with %s: %v, want %s", name, err, codes.Unauthenticated)
+		}
+		stream, err := slips.WatchRepairSlips(ctx, &pb.WatchRequest{})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if code(err) != codes.Unauthenticated {
+			t.Errorf("WatchRepairSlips with %s: %v, want %s", name, err, codes.Unauthenticated)
+		}
+	}
+	if _, err := users.GetUser(as(h, u), &pb.GetUserRequest{Id: int64(u.ID)}); err != nil {
+		t.Errorf("GetUser with a token: %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/rpc/pb"
+	"github.com/darksford123x/app/stream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// userFields are the fields UpdateUser sets by default.
+var userFields = []string{
+	user.FieldAge,
+	user.FieldName,
+	user.FieldRole,
+	user.FieldSkill,
+	user.FieldDepartment,
+	user.FieldEmail,
+	user.FieldLocale,
+}
+
+// users is the Users service.
+type users struct {
+	pb.UnimplementedUsersServer
+	client *ent.Client
+	broker *stream.Broker
+}
+
+// CreateUser creates a user; only admins may.
+func (s *users) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	if !auth.MayManageUsers(auth.FromContext(ctx)) {
+		return nil, auth.ErrForbidden
+	}
+	builder := s.client.User.
+		Create().
+		SetAge(int(req.Age)).
+		SetName(req.Name)
+	if req.Role != "" {
+		builder.SetRole(user.Role(req.Role))
+	}
+	if req.Skill != "" {
+		builder.SetSkill(req.Skill)
+	}
+	if req.Department != "" {
+		builder.SetDepartment(req.Department)
+	}
+	if req.Email != "" {
+		builder.SetEmail(req.Email)
+	}
+	if req.Locale != "" {
+		builder.SetLocale(user.Locale(req.Locale))
+	}
+	if req.Password != "" {
+		hash, err := auth.HashPassword(req.Password)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		builder.SetPasswordHash(hash)
+	}
+	u, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toUser(u), nil
+}
+
+// GetUser gets a user by ID.
+func (s *users) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	u, err := s.client.User.Get(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return toUser(u), nil
+}
+
+// ListUsers lists the users.
+func (s *users) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	limit := 10
+	if req.Limit > 0 {
+		limit = int(req.Limit)
+	}
+	us, err := s.client.User.
+		Query().
+		Order(ent.Asc(user.FieldID)).
+		Limit(limit).
+		Offset(int(req.Offset)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListUsersResponse{Users: make([]*pb.User, len(us))}
+	for i, u := range us {
+		resp.Users[i] = toUser(u)
+	}
+	return resp, nil
+}
+
+// UpdateUser sets the fields of the update mask of a user. Optional fields
+// set empty are cleared. Users may update themselves, and admins anyone;
+// only admins may change roles.
+func (s *users) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	if req.User == nil {
+		return nil, status.Error(codes.InvalidArgument, "user is required")
+	}
+	fields := userFields
+	if paths := req.UpdateMask.GetPaths(); len(paths) > 0 {
+		fields = paths
+	}
+	in := req.User
+	var role *user.Role
+	for _, f := range fields {
+		if f == user.FieldRole {
+			r := user.Role(in.Role)
+			role = &r
+		}
+	}
+	if err := auth.CheckUserUpdate(auth.FromContext(ctx), int(in.Id), role); err != nil {
+		return nil, err
+	}
+	builder := s.client.User.UpdateOneID(int(in.Id))
+	for _, f := range fields {
+		switch f {
+		case user.FieldAge:
+			builder.SetAge(int(in.Age))
+		case user.FieldName:
+			builder.SetName(in.Name)
+		case user.FieldRole:
+			builder.SetRole(user.Role(in.Role))
+		case user.FieldSkill:
+			if in.Skill == "" {
+				builder.ClearSkill()
+			} else {
+				builder.SetSkill(in.Skill)
+			}
+		case user.FieldDepartment:
+			if in.Department == "" {
+				builder.ClearDepartment()
+			} else {
+				builder.SetDepartment(in.Department)
+			}
+		case user.FieldEmail:
+			if in.Email == "" {
+				builder.ClearEmail()
+			} else {
+				builder.SetEmail(in.Email)
+			}
+		case user.FieldLocale:
+			builder.SetLocale(user.Locale(in.Locale))
+		default:
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown field %q", f))
+		}
+	}
+	u, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toUser(u), nil
+}
+
+// DeleteUser deletes a user by ID; only admins may.
+func (s *users) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*emptypb.Empty, error) {
+	if !auth.MayManageUsers(auth.FromContext(ctx)) {
+		return nil, auth.ErrForbidden
+	}
+	if err := s.client.User.DeleteOneID(int(req.Id)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// WatchUsers streams the changes of the users the caller may see.
+func (s *users) WatchUsers(req *pb.WatchRequest, ss pb.Users_WatchUsersServer) error {
+	return watch(ss.Context(), s.broker, "user", req.LastEventId, func(m stream.Message) error {
+		e := &pb.UserEvent{
+			EventId: m.ID,
+			Op:      op(m),
+			Id:      int64(m.Event.ID),
+			Fields:  m.Fields,
+			Time:    timestamppb.New(m.Time),
+		}
+		if u, ok := m.Data.(*ent.User); ok {
+			e.User = toUser(u)
+		}
+		return ss.Send(e)
+	})
+}
@@ -0,0 +1,92 @@
+package rpc_test
+
+import (
+	"testing"
+
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/rpc/pb"
+	"github.com/darksford123x/app/servertest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUsers(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	staff := h.User().SaveX(ctx)
+	admin := h.User().SetRole(user.RoleAdmin).SaveX(ctx)
+	users := pb.NewUsersClient(dial(h))
+
+	u, err := users.GetUser(as(h, staff), &pb.GetUserRequest{Id: int64(admin.ID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Name != admin.Name || u.Role != "admin" || u.Email != admin.Email {
+		t.Errorf("GetUser = %v, want %s", u, admin.Name)
+	}
+	if _, err := users.GetUser(as(h, staff), &pb.GetUserRequest{Id: 0}); code(err) != codes.NotFound {
+		t.Errorf("GetUser of nobody: %v, want %s", err, codes.NotFound)
+	}
+
+	// Only admins create and delete users.
+	create := &pb.CreateUserRequest{Name: "Somchai", Age: 40, Role: "technician", Skill: "projector", Email: "somchai@example.com"}
+	if _, err := users.CreateUser(as(h, staff), create); code(err) != codes.PermissionDenied {
+		t.Errorf("CreateUser by staff: %v, want %s", err, codes.PermissionDenied)
+	}
+	tech, err := users.CreateUser(as(h, admin), create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := users.CreateUser(as(h, admin), create); code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateUser with a taken email: %v, want %s", err, codes.FailedPrecondition)
+	}
+	list, err := users.ListUsers(as(h, staff), &pb.ListUsersRequest{Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Users) != 2 || list.Users[0].Id != int64(admin.ID) || list.Users[1].Id != tech.Id {
+		t.Errorf("ListUsers = %v, want users %d and %d", list.Users, admin.ID, tech.Id)
+	}
+
+	// Users update themselves, but not their role, and only the fields of
+	// the mask.
+	update := func(caller *pb.User, paths ...string) (*pb.User, error) {
+		return users.UpdateUser(as(h, staff), &pb.UpdateUserRequest{
+			User:       caller,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+	}
+	got, err := update(&pb.User{Id: int64(staff.ID), Name: "Malee", Department: "", Role: "admin"}, "name", "department")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "Malee" || got.Role != "staff" || got.Email != staff.Email {
+		t.Errorf("UpdateUser = %v, want only the name changed", got)
+	}
+	if _, err := update(&pb.User{Id: int64(staff.ID), Role: "admin"}, "role"); code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateUser of the own role: %v, want %s", err, codes.PermissionDenied)
+	}
+	if _, err := update(&pb.User{Id: tech.Id, Name: "Somsak"}, "name"); code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateUser of another user: %v, want %s", err, codes.PermissionDenied)
+	}
+	if _, err := update(&pb.User{Id: int64(staff.ID)}, "password_hash"); code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateUser of an unknown field: %v, want %s", err, codes.InvalidArgument)
+	}
+
+	if _, err := users.DeleteUser(as(h, staff), &pb.DeleteUserRequest{Id: tech.Id}); code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteUser by staff: %v, want %s", err, codes.PermissionDenied)
+	}
+	if _, err := users.DeleteUser(as(h, admin), &pb.DeleteUserRequest{Id: tech.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := users.GetUser(as(h, admin), &pb.GetUserRequest{Id: tech.Id}); code(err) != codes.NotFound {
+		t.Errorf("GetUser of a deleted user: %v, want %s", err, codes.NotFound)
+	}
+
+	// The users of other organizations do not exist for the caller.
+	other := h.Tenant("Science")
+	theirs := other.User().SaveX(other.Context())
+	if _, err := users.GetUser(as(h, admin), &pb.GetUserRequest{Id: int64(theirs.ID)}); code(err) != codes.NotFound {
+		t.Errorf("GetUser of another organization: %v, want %s", err, codes.NotFound)
+	}
+}
@@ -0,0 +1,54 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/stream"
+	"github.com/darksford123x/app/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watch sends the events of the entity the caller may see, after lastID,
+// until the call ends. Reset messages are sent for every entity.
+func watch(ctx context.Context, broker *stream.Broker, entity, lastID string, send func(stream.Message) error) error {
+	org, _ := tenant.FromContext(ctx)
+	sub, backlog := broker.Subscribe(org, auth.FromContext(ctx), lastID)
+	defer sub.Close()
+
+	for _, m := range backlog {
+		if m.Type != stream.Reset && m.Entity != entity {
+			continue
+		}
+		if err := send(m); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case m, ok := <-sub.C:
+			if !ok {
+				// The server is shutting down or the caller fell behind;
+				// either way it should resume from its last event.
+				return status.Error(codes.Unavailable, "stream: watch ended, resume from the last event")
+			}
+			if m.Entity != entity {
+				continue
+			}
+			if err := send(m); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// op returns the op of a message sent to watchers.
+func op(m stream.Message) string {
+	if m.Type == stream.Reset {
+		return stream.Reset
+	}
+	return m.Op
+}
@@ -0,0 +1,153 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// postgres keeps the documents with their tokens as a tsvector of the
+// simple configuration, which only splits at the spaces, like the tokens
+// are made by this package.
+type postgres struct {
+	db *sql.DB
+}
+
+func (p *postgres) create(ctx context.Context) (bool, error) {
+	var exists bool
+	err := p.db.QueryRowContext(ctx,
+		`SELECT to_regclass('search_documents') IS NOT NULL`,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("search: %w", err)
+	}
+	if exists {
+		// The documents indexed before there were organizations are
+		// indexed again with theirs.
+		var n int
+		err := p.db.QueryRowContext(ctx,
+			`SELECT count(*) FROM information_schema.columns
+			WHERE table_name = 'search_documents' AND column_name = 'organization'`,
+		).Scan(&n)
+		if err != nil {
+			return false, fmt.Errorf("search: %w", err)
+		}
+		if n > 0 {
+			return false, nil
+		}
+		_, err = p.db.ExecContext(ctx,
+			`ALTER TABLE search_documents ADD COLUMN organization INTEGER NOT NULL DEFAULT 0`,
+		)
+		if err != nil {
+			return false, fmt.Errorf("search: %w", err)
+		}
+		return true, nil
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE search_documents (
+			id SERIAL PRIMARY KEY,
+			kind TEXT NOT NULL,
+			ref INTEGER NOT NULL,
+			organization INTEGER NOT NULL DEFAULT 0,
+			parent INTEGER NOT NULL DEFAULT 0,
+			internal BOOLEAN NOT NULL DEFAULT FALSE,
+			body TEXT NOT NULL,
+			tokens TSVECTOR NOT NULL,
+			UNIQUE (kind, ref)
+		)`,
+		`CREATE INDEX search_documents_tokens ON search_documents USING GIN (tokens)`,
+	} {
+		if _, err := p.db.ExecContext(ctx, stmt); err != nil {
+			return false, fmt.Errorf("search: %w", err)
+		}
+	}
+	return true, nil
+}
+
+func (p *postgres) put(ctx context.Context, d document) error {
+	_, err := p.db.ExecContext(ctx, `INSERT INTO search_documents (kind, ref, organization, parent, internal, body, tokens)
+		VALUES ($1, $2, $3, $4, $5, $6, to_tsvector('simple', $7))
+		ON CONFLICT (kind, ref) DO UPDATE SET
+			organization = EXCLUDED.organization,
+			parent = EXCLUDED.parent,
+			internal = EXCLUDED.internal,
+			body = EXCLUDED.body,
+			tokens = EXCLUDED.tokens`,
+		d.Kind, d.ID, d.Organization, d.Parent, d.Internal, d.Text, tokens(d.Text),
+	)
+	if err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+	return nil
+}
+
+func (p *postgres) remove(ctx context.Context, kind string, id int) error {
+	_, err := p.db.ExecContext(ctx, `DELETE FROM search_documents WHERE kind = $1 AND ref = $2`, kind, id)
+	if err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+	return nil
+}
+
+func (p *postgres) removeChildren(ctx context.Context, kind string, parent int) error {
+	_, err := p.db.ExecContext(ctx, `DELETE FROM search_documents WHERE kind = $1 AND parent = $2`, kind, parent)
+	if err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+	return nil
+}
+
+func (p *postgres) search(ctx context.Context, terms []term, org int, q Query) ([]match, error) {
+	args := []interface{}{p.match(terms), org}
+	where := []string{`tokens @@ q`, `organization = $2`}
+	if len(q.Kinds) > 0 {
+		marks := make([]string, len(q.Kinds))
+		for i, k := range q.Kinds {
+			args = append(args, k)
+			marks[i] = fmt.Sprintf("$%d", len(args))
+		}
+		where = append(where, `kind IN (`+strings.Join(marks, ", ")+`)`)
+	}
+	if q.hideInternal() {
+		where = append(where, `NOT internal`)
+	}
+	args = append(args, q.Limit, q.Offset)
+	query := `SELECT kind, ref, parent, internal, body, ts_rank_cd(tokens, q)
+		FROM search_documents, to_tsquery('simple', $1) q
+		WHERE ` + strings.Join(where, " AND ") + fmt.Sprintf(`
+		ORDER BY 6 DESC, id LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+	defer rows.Close()
+	var matches []match
+	for rows.Next() {
+		var m match
+		if err := rows.Scan(&m.Kind, &m.ID, &m.Parent, &m.Internal, &m.Text, &m.Score); err != nil {
+			return nil, fmt.Errorf("search: %w", err)
+		}
+		matches = append(matches, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+	return matches, nil
+}
+
+// match returns the tsquery of the terms: words as prefixes and the bigrams
+// of Thai as phrases. Tokens hold only letters, digits and marks, so they
+// need no quoting.
+func (p *postgres) match(terms []term) string {
+	parts := make([]string, len(terms))
+	for i, t := range terms {
+		phrase := strings.Join(t.tokens, " <-> ")
+		if t.prefix {
+			phrase += ":*"
+		}
+		parts[i] = "(" + phrase + ")"
+	}
+	return strings.Join(parts, " & ")
+}
@@ -0,0 +1,327 @@
+// Package search finds repair slips, comments, equipment and users by their
+// text. The text of every entity is kept in a search index of the database,
+// SQLite FTS5 or PostgreSQL tsvector depending on the dialect, and kept up
+// to date from the events of the bus.
+package search
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/darksford123x/app/comments"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/events"
+	"github.com/darksford123x/app/tenant"
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// The kinds of documents, named as the entities.
+const (
+	KindRepairSlip = "repairslip"
+	KindComment    = "comment"
+	KindEquipment  = "equipment"
+	KindUser       = "user"
+)
+
+// Kinds are the kinds of documents searched.
+var Kinds = []string{KindRepairSlip, KindComment, KindEquipment, KindUser}
+
+// ErrEmptyQuery is returned for queries without any word.
+var ErrEmptyQuery = errors.New("search: query has no words")
+
+// Result is a document matching a query.
+type Result struct {
+	Kind string `json:"kind"`
+	ID   int    `json:"id"`
+	// RepairSlip is the slip a comment is on.
+	RepairSlip int `json:"repair_slip,omitempty"`
+	// Snippet is an HTML excerpt of the text with the matches in <mark>.
+	Snippet string `json:"snippet"`
+	// Score is the relevance of the document; higher is better.
+	Score float64 `json:"score"`
+}
+
+// Query is a search.
+type Query struct {
+	Text string
+	// Kinds limits the search to documents of these kinds.
+	Kinds []string
+	// Reader is the user searching; internal comments are only found by
+	// those who may read them.
+	Reader *ent.User
+	Limit  int
+	Offset int
+}
+
+// document is the indexed text of an entity.
+type document struct {
+	Kind string
+	ID   int
+	// Organization is the tenant of the entity, whose users only find
+	// the documents of their own organization.
+	Organization int
+	Parent       int
+	Internal     bool
+	Text         string
+}
+
+// match is a document found by an engine, with its text and score.
+type match struct {
+	document
+	Score float64
+}
+
+// engine stores and searches documents in one kind of database.
+type engine interface {
+	// create creates the tables of the index, reporting whether they were
+	// missing.
+	create(ctx context.Context) (bool, error)
+	put(ctx context.Context, d document) error
+	remove(ctx context.Context, kind string, id int) error
+	// removeChildren removes the documents of the given kind whose parent
+	// is the given entity.
+	removeChildren(ctx context.Context, kind string, parent int) error
+	// search finds the documents of the organization.
+	search(ctx context.Context, terms []term, org int, q Query) ([]match, error)
+}
+
+// Index is the search index.
+type Index struct {
+	client *ent.Client
+	engine engine
+}
+
+// Open opens the index of the database, creating and filling it when it is
+// missing. The dialect is that of the ent driver of db.
+func Open(ctx context.Context, client *ent.Client, name string, db *sql.DB) (*Index, error) {
+	x := &Index{client: client}
+	switch name {
+	case dialect.SQLite:
+		x.engine = &sqlite{db: db}
+	case dialect.Postgres:
+		x.engine = &postgres{db: db}
+	default:
+		return nil, fmt.Errorf("search: dialect %s is not supported", name)
+	}
+	created, err := x.engine.create(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		if err := x.Reindex(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return x, nil
+}
+
+// Search returns the documents of the organization of the context matching
+// every word of the query, most relevant first.
+func (x *Index) Search(ctx context.Context, q Query) ([]Result, error) {
+	org, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, tenant.ErrMissing
+	}
+	terms, words := parse(q.Text)
+	if len(terms) == 0 {
+		return nil, ErrEmptyQuery
+	}
+	matches, err := x.engine.search(ctx, terms, org, q)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]Result, len(matches))
+	for i, m := range matches {
+		results[i] = Result{
+			Kind:       m.Kind,
+			ID:         m.ID,
+			RepairSlip: m.Parent,
+			Snippet:    snippet(m.Text, words),
+			Score:      m.Score,
+		}
+	}
+	return results, nil
+}
+
+// Listen updates the index with a committed event.
+func (x *Index) Listen(e events.Event) {
+	if !known(e.Entity) {
+		return
+	}
+	ctx := tenant.System(context.Background())
+	var err error
+	switch {
+	case e.Op == events.Deleted:
+		err = x.engine.remove(ctx, e.Entity, e.ID)
+		// The comments of a slip are deleted with it.
+		if err == nil && e.Entity == KindRepairSlip {
+			err = x.engine.removeChildren(ctx, KindComment, e.ID)
+		}
+	case e.Entity == KindEquipment:
+		err = x.update(ctx, e.Entity, e.ID)
+		// The slips of equipment are found by its name and serial.
+		if err == nil && e.Op == events.Updated {
+			err = x.updateSlipsOf(ctx, e.ID)
+		}
+	default:
+		err = x.update(ctx, e.Entity, e.ID)
+	}
+	if err != nil {
+		log.Printf("search: indexing %s %d: %v", e.Entity, e.ID, err)
+	}
+}
+
+// Reindex indexes every entity of every organization again.
+func (x *Index) Reindex(ctx context.Context) error {
+	ctx = tenant.System(ctx)
+	ids := map[string]func() ([]int, error){
+		KindRepairSlip: func() ([]int, error) { return x.client.RepairSlip.Query().IDs(ctx) },
+		KindComment:    func() ([]int, error) { return x.client.Comment.Query().IDs(ctx) },
+		KindEquipment:  func() ([]int, error) { return x.client.Equipment.Query().IDs(ctx) },
+		KindUser:       func() ([]int, error) { return x.client.User.Query().IDs(ctx) },
+	}
+	for _, kind := range Kinds {
+		list, err := ids[kind]()
+		if err != nil {
+			return err
+		}
+		for _, id := range list {
+			if err := x.update(ctx, kind, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// update indexes the current text of an entity.
+func (x *Index) update(ctx context.Context, kind string, id int) error {
+	d, err := x.document(ctx, kind, id)
+	if ent.IsNotFound(err) {
+		return x.engine.remove(ctx, kind, id)
+	}
+	if err != nil {
+		return err
+	}
+	return x.engine.put(ctx, d)
+}
+
+// updateSlipsOf indexes the repair slips of equipment again.
+func (x *Index) updateSlipsOf(ctx context.Context, equipmentID int) error {
+	ids, err := x.client.RepairSlip.
+		Query().
+		Where(repairslip.HasEquipmentWith(equipment.ID(equipmentID))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := x.update(ctx, KindRepairSlip, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// document loads the text of an entity and its organization. The context
+// must see the entities of every organization.
+func (x *Index) document(ctx context.Context, kind string, id int) (document, error) {
+	d := document{Kind: kind, ID: id}
+	var org *ent.Organization
+	switch kind {
+	case KindRepairSlip:
+		s, err := x.client.RepairSlip.
+			Query().
+			Where(repairslip.ID(id)).
+			WithEquipment().
+			WithOrganization().
+			Only(ctx)
+		if err != nil {
+			return d, err
+		}
+		org = s.Edges.Organization
+		d.Text = join(s.Symptom, s.Category)
+		if eq := s.Edges.Equipment; eq != nil {
+			d.Text = join(d.Text, eq.Name, eq.Model, eq.SerialNumber)
+		}
+	case KindComment:
+		c, err := x.client.Comment.
+			Query().
+			Where(comment.ID(id)).
+			WithRepairSlip().
+			WithOrganization().
+			Only(ctx)
+		if err != nil {
+			return d, err
+		}
+		org = c.Edges.Organization
+		d.Text = c.Body
+		d.Internal = c.Visibility == comment.VisibilityInternal
+		if s := c.Edges.RepairSlip; s != nil {
+			d.Parent = s.ID
+		}
+	case KindEquipment:
+		eq, err := x.client.Equipment.
+			Query().
+			Where(equipment.ID(id)).
+			WithOrganization().
+			Only(ctx)
+		if err != nil {
+			return d, err
+		}
+		org = eq.Edges.Organization
+		d.Text = join(eq.Name, eq.Model, eq.SerialNumber)
+	case KindUser:
+		u, err := x.client.User.
+			Query().
+			Where(user.ID(id)).
+			WithOrganization().
+			Only(ctx)
+		if err != nil {
+			return d, err
+		}
+		org = u.Edges.Organization
+		d.Text = join(u.Name, u.Email, u.Department)
+	default:
+		return d, fmt.Errorf("search: unknown kind %s", kind)
+	}
+	if org != nil {
+		d.Organization = org.ID
+	}
+	return d, nil
+}
+
+// join joins the non-empty parts of a text with new lines.
+func join(parts ...string) string {
+	var nonEmpty []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, "\n")
+}
+
+// known reports whether documents of the kind are indexed.
+func known(kind string) bool {
+	for _, k := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// hideInternal reports whether internal comments are hidden from the
+// reader.
+func (q Query) hideInternal() bool {
+	return !comments.CanReadInternal(q.Reader)
+}
@@ -0,0 +1,81 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// snippetLength is the number of characters of the text around the first
+// match shown in a snippet.
+const snippetLength = 160
+
+// snippet returns an excerpt of the text around the first match of a word,
+// with the matches wrapped in <mark> and the rest HTML escaped. Words match
+// anywhere in Thai text, and at the start of words elsewhere.
+func snippet(text string, words []string) string {
+	rs := []rune(text)
+	lower := make([]rune, len(rs))
+	for i, r := range rs {
+		lower[i] = unicode.ToLower(r)
+	}
+	marked := make([]bool, len(rs))
+	first := -1
+	for _, w := range words {
+		wr := []rune(w)
+		for i := 0; i+len(wr) <= len(lower); i++ {
+			if !equal(lower[i:i+len(wr)], wr) {
+				continue
+			}
+			if !isThai(wr[0]) && i > 0 && isWord(lower[i-1]) {
+				continue
+			}
+			for j := i; j < i+len(wr); j++ {
+				marked[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+
+	start, end := 0, len(rs)
+	if len(rs) > snippetLength {
+		if first > snippetLength/4 {
+			start = first - snippetLength/4
+		}
+		if start+snippetLength < end {
+			end = start + snippetLength
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && marked[j] == marked[i] {
+			j++
+		}
+		part := html.EscapeString(string(rs[i:j]))
+		if marked[i] {
+			part = "<mark>" + part + "</mark>"
+		}
+		b.WriteString(part)
+		i = j
+	}
+	if end < len(rs) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+func equal(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSnippet(t *testing.T) {
+	long := strings.Repeat("x ", 100) + "lamp" + strings.Repeat(" y", 100)
+	for _, tc := range []struct {
+		text  string
+		words []string
+		want  string
+	}{
+		{"Projector lamp is broken", []string{"lamp"}, "Projector <mark>lamp</mark> is broken"},
+		{"LAMP", []string{"lamp"}, "<mark>LAMP</mark>"},
+		// Words of Latin script match at the start of words only.
+		{"clamp and lamps", []string{"lamp"}, "clamp and <mark>lamp</mark>s"},
+		{"Epson lamp", []string{"lamp", "epson"}, "<mark>Epson</mark> <mark>lamp</mark>"},
+		// Thai matches anywhere.
+		{"เปลี่ยนหลอดภาพ", []string{"หลอด"}, "เปลี่ยน<mark>หลอด</mark>ภาพ"},
+		{"<b>lamp</b> & fan", []string{"lamp"}, "&lt;b&gt;<mark>lamp</mark>&lt;/b&gt; &amp; fan"},
+		{"no match", []string{"lamp"}, "no match"},
+		// Long texts are cut around the first match.
+		{long, []string{"lamp"}, "…" + strings.Repeat("x ", 20) + "<mark>lamp</mark>" + strings.Repeat(" y", 58) + "…"},
+		{long, []string{"fan"}, strings.Repeat("x ", 80) + "…"},
+	} {
+		if got := snippet(tc.text, tc.words); got != tc.want {
+			t.Errorf("snippet(%q, %q) = %q, want %q", tc.text, tc.words, got, tc.want)
+		}
+	}
+}
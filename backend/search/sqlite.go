@@ -0,0 +1,270 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// sqlite keeps the documents in a table and their tokens in an FTS5 table
+// with the same row IDs. FTS5 is only compiled into the SQLite driver with
+// the sqlite_fts5 build tag; without it, FTS4 is used and the results are
+// ranked here.
+type sqlite struct {
+	db   *sql.DB
+	fts4 bool
+}
+
+func (s *sqlite) create(ctx context.Context) (bool, error) {
+	var n int
+	err := s.db.QueryRowContext(ctx,
+		`SELECT count(*) FROM sqlite_master WHERE name = 'search_fts'`,
+	).Scan(&n)
+	if err != nil {
+		return false, fmt.Errorf("search: %w", err)
+	}
+	if n > 0 {
+		var def string
+		err := s.db.QueryRowContext(ctx,
+			`SELECT sql FROM sqlite_master WHERE name = 'search_fts'`,
+		).Scan(&def)
+		if err != nil {
+			return false, fmt.Errorf("search: %w", err)
+		}
+		s.fts4 = strings.Contains(strings.ToLower(def), "fts4")
+		// The documents indexed before there were organizations are
+		// indexed again with theirs.
+		err = s.db.QueryRowContext(ctx,
+			`SELECT count(*) FROM pragma_table_info('search_documents') WHERE name = 'organization'`,
+		).Scan(&n)
+		if err != nil {
+			return false, fmt.Errorf("search: %w", err)
+		}
+		if n > 0 {
+			return false, nil
+		}
+		_, err = s.db.ExecContext(ctx,
+			`ALTER TABLE search_documents ADD COLUMN organization INTEGER NOT NULL DEFAULT 0`,
+		)
+		if err != nil {
+			return false, fmt.Errorf("search: %w", err)
+		}
+		return true, nil
+	}
+
+	_, err = s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS search_documents (
+		id INTEGER PRIMARY KEY,
+		kind TEXT NOT NULL,
+		ref INTEGER NOT NULL,
+		organization INTEGER NOT NULL DEFAULT 0,
+		parent INTEGER NOT NULL DEFAULT 0,
+		internal BOOLEAN NOT NULL DEFAULT 0,
+		body TEXT NOT NULL,
+		UNIQUE (kind, ref)
+	)`)
+	if err != nil {
+		return false, fmt.Errorf("search: %w", err)
+	}
+	// The tokens are made by this package; the ascii and simple
+	// tokenizers only split them at the spaces.
+	_, err = s.db.ExecContext(ctx, `CREATE VIRTUAL TABLE search_fts USING fts5(tokens, tokenize = 'ascii')`)
+	if err != nil && strings.Contains(err.Error(), "no such module") {
+		s.fts4 = true
+		_, err = s.db.ExecContext(ctx, `CREATE VIRTUAL TABLE search_fts USING fts4(tokens, tokenize = simple)`)
+	}
+	if err != nil {
+		return false, fmt.Errorf("search: %w", err)
+	}
+	return true, nil
+}
+
+func (s *sqlite) put(ctx context.Context, d document) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		var rowid int64
+		err := tx.QueryRowContext(ctx,
+			`SELECT id FROM search_documents WHERE kind = ? AND ref = ?`, d.Kind, d.ID,
+		).Scan(&rowid)
+		switch {
+		case err == sql.ErrNoRows:
+			res, err := tx.ExecContext(ctx,
+				`INSERT INTO search_documents (kind, ref, organization, parent, internal, body) VALUES (?, ?, ?, ?, ?, ?)`,
+				d.Kind, d.ID, d.Organization, d.Parent, d.Internal, d.Text,
+			)
+			if err != nil {
+				return err
+			}
+			if rowid, err = res.LastInsertId(); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		default:
+			_, err := tx.ExecContext(ctx,
+				`UPDATE search_documents SET organization = ?, parent = ?, internal = ?, body = ? WHERE id = ?`,
+				d.Organization, d.Parent, d.Internal, d.Text, rowid,
+			)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM search_fts WHERE rowid = ?`, rowid); err != nil {
+				return err
+			}
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO search_fts (rowid, tokens) VALUES (?, ?)`, rowid, tokens(d.Text),
+		)
+		return err
+	})
+}
+
+func (s *sqlite) remove(ctx context.Context, kind string, id int) error {
+	return s.removeWhere(ctx, `kind = ? AND ref = ?`, kind, id)
+}
+
+func (s *sqlite) removeChildren(ctx context.Context, kind string, parent int) error {
+	return s.removeWhere(ctx, `kind = ? AND parent = ?`, kind, parent)
+}
+
+func (s *sqlite) removeWhere(ctx context.Context, where string, args ...interface{}) error {
+	return s.tx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx,
+			`DELETE FROM search_fts WHERE rowid IN (SELECT id FROM search_documents WHERE `+where+`)`, args...,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `DELETE FROM search_documents WHERE `+where, args...)
+		return err
+	})
+}
+
+func (s *sqlite) search(ctx context.Context, terms []term, org int, q Query) ([]match, error) {
+	where := []string{`search_fts MATCH ?`, `d.organization = ?`}
+	args := []interface{}{s.match(terms), org}
+	if len(q.Kinds) > 0 {
+		where = append(where, `d.kind IN (?`+strings.Repeat(`, ?`, len(q.Kinds)-1)+`)`)
+		for _, k := range q.Kinds {
+			args = append(args, k)
+		}
+	}
+	if q.hideInternal() {
+		where = append(where, `NOT d.internal`)
+	}
+	rank := `bm25(search_fts)`
+	if s.fts4 {
+		rank = `matchinfo(search_fts, 'pcnalx')`
+	}
+	query := `SELECT d.kind, d.ref, d.parent, d.internal, d.body, ` + rank + `
+		FROM search_fts JOIN search_documents d ON d.id = search_fts.rowid
+		WHERE ` + strings.Join(where, " AND ")
+	if !s.fts4 {
+		// bm25 is lower for better matches.
+		query += ` ORDER BY 6, d.id LIMIT ? OFFSET ?`
+		args = append(args, q.Limit, q.Offset)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+	defer rows.Close()
+	var matches []match
+	for rows.Next() {
+		var m match
+		var rank interface{}
+		if err := rows.Scan(&m.Kind, &m.ID, &m.Parent, &m.Internal, &m.Text, &rank); err != nil {
+			return nil, fmt.Errorf("search: %w", err)
+		}
+		switch r := rank.(type) {
+		case float64:
+			m.Score = -r
+		case []byte:
+			m.Score = bm25(r)
+		}
+		matches = append(matches, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+	if s.fts4 {
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].Score > matches[j].Score
+		})
+		matches = page(matches, q.Limit, q.Offset)
+	}
+	return matches, nil
+}
+
+// match returns the full-text query of the terms. Tokens hold only letters,
+// digits and marks, so they need no escaping inside the quotes.
+func (s *sqlite) match(terms []term) string {
+	parts := make([]string, len(terms))
+	for i, t := range terms {
+		phrase := strings.Join(t.tokens, " ")
+		switch {
+		case t.prefix && s.fts4:
+			parts[i] = `"` + phrase + `*"`
+		case t.prefix:
+			parts[i] = `"` + phrase + `"*`
+		default:
+			parts[i] = `"` + phrase + `"`
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func (s *sqlite) tx(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("search: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+	return nil
+}
+
+// bm25 computes the Okapi BM25 score of a row from the FTS4 matchinfo
+// 'pcnalx' of its single column, as FTS5 would.
+func bm25(info []byte) float64 {
+	const k1, b = 1.2, 0.75
+	n := len(info) / 4
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = float64(binary.LittleEndian.Uint32(info[i*4:]))
+	}
+	if n < 5 {
+		return 0
+	}
+	phrases, docs, avg, length := int(v[0]), v[2], v[3], v[4]
+	var score float64
+	for p := 0; p < phrases && 5+p*3+2 < n; p++ {
+		hits, found := v[5+p*3], v[5+p*3+2]
+		idf := math.Log((docs - found + 0.5) / (found + 0.5))
+		if idf < 1e-6 {
+			idf = 1e-6
+		}
+		score += idf * hits * (k1 + 1) / (hits + k1*(1-b+b*length/avg))
+	}
+	return score
+}
+
+// page returns the page of the matches at offset.
+func page(matches []match, limit, offset int) []match {
+	if offset >= len(matches) {
+		return nil
+	}
+	matches = matches[offset:]
+	if limit < len(matches) {
+		matches = matches[:limit]
+	}
+	return matches
+}
@@ -0,0 +1,118 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// openSQLite returns the engine of a new in-memory database.
+func openSQLite(t *testing.T) *sqlite {
+	t.Helper()
+	db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	s := &sqlite{db: db}
+	created, err := s.create(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !created {
+		t.Fatal("the index was not created")
+	}
+	return s
+}
+
+func TestSQLite(t *testing.T) {
+	ctx := context.Background()
+	s := openSQLite(t)
+	for _, d := range []document{
+		{Kind: KindRepairSlip, ID: 1, Organization: 1, Text: "Projector lamp flickers\nEpson EB-X05"},
+		{Kind: KindRepairSlip, ID: 2, Organization: 1, Text: "Lamp lamp lamp replaced"},
+		{Kind: KindComment, ID: 3, Organization: 1, Parent: 1, Internal: true, Text: "Lamp ordered from supplier"},
+		{Kind: KindRepairSlip, ID: 4, Organization: 1, Text: "จอภาพไม่ติด"},
+		{Kind: KindEquipment, ID: 5, Organization: 2, Text: "Lamp"},
+	} {
+		if err := s.put(ctx, d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	technician := &ent.User{Role: user.RoleTechnician}
+	staff := &ent.User{Role: user.RoleStaff}
+
+	// search returns the kinds and IDs of the documents of the first
+	// organization matching the query, checking they are ranked.
+	search := func(q Query) string {
+		t.Helper()
+		if q.Limit == 0 {
+			q.Limit = 20
+		}
+		terms, _ := parse(q.Text)
+		matches, err := s.search(ctx, terms, 1, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var found []string
+		for i, m := range matches {
+			if i > 0 && m.Score > matches[i-1].Score {
+				t.Errorf("%q: %s %d scored %f above %f", q.Text, m.Kind, m.ID, m.Score, matches[i-1].Score)
+			}
+			found = append(found, fmt.Sprintf("%s %d", m.Kind, m.ID))
+		}
+		return strings.Join(found, ", ")
+	}
+	for _, tc := range []struct {
+		q    Query
+		want string
+	}{
+		// The more often and the shorter, the better the match.
+		{Query{Text: "lamp", Reader: technician}, "repairslip 2, comment 3, repairslip 1"},
+		{Query{Text: "LAM", Reader: technician}, "repairslip 2, comment 3, repairslip 1"},
+		{Query{Text: "lamp epson", Reader: technician}, "repairslip 1"},
+		{Query{Text: "amp", Reader: technician}, ""},
+		// Internal comments are hidden from staff and anonymous readers.
+		{Query{Text: "lamp", Reader: staff}, "repairslip 2, repairslip 1"},
+		{Query{Text: "supplier"}, ""},
+		{Query{Text: "supplier", Reader: technician}, "comment 3"},
+		{Query{Text: "lamp", Reader: technician, Kinds: []string{KindComment, KindEquipment}}, "comment 3"},
+		{Query{Text: "lamp", Reader: technician, Limit: 1, Offset: 1}, "comment 3"},
+		{Query{Text: "lamp", Reader: technician, Offset: 3}, ""},
+		// Thai is found anywhere in the text, as a phrase.
+		{Query{Text: "ภาพ"}, "repairslip 4"},
+		{Query{Text: "ไม่ติด จอ"}, "repairslip 4"},
+		{Query{Text: "ภาจอ"}, ""},
+	} {
+		if got := search(tc.q); got != tc.want {
+			t.Errorf("search %+v = %q, want %q", tc.q, got, tc.want)
+		}
+	}
+
+	// Documents are indexed again when their text changes, and removed
+	// with their children.
+	if err := s.put(ctx, document{Kind: KindRepairSlip, ID: 1, Organization: 1, Text: "Fan is noisy"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := search(Query{Text: "lamp", Reader: technician}), "repairslip 2, comment 3"; got != want {
+		t.Errorf("search after an update = %q, want %q", got, want)
+	}
+	if got, want := search(Query{Text: "fan"}), "repairslip 1"; got != want {
+		t.Errorf("search after an update = %q, want %q", got, want)
+	}
+	if err := s.removeChildren(ctx, KindComment, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.remove(ctx, KindRepairSlip, 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := search(Query{Text: "lamp", Reader: technician}); got != "" {
+		t.Errorf("search after removals = %q, want nothing", got)
+	}
+}
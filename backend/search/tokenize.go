@@ -0,0 +1,101 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Thai is written without spaces between words, so no tokenizer of the
+// databases finds its words. Thai text is indexed as overlapping pairs of
+// characters instead: "จอภาพ" becomes "จอ อภ ภา าพ", and is found by
+// searching for the pairs of the query as a phrase. Other scripts are split
+// at spaces and punctuation.
+
+// isThai reports whether r belongs to the Thai block.
+func isThai(r rune) bool {
+	return r >= 0x0E00 && r <= 0x0E7F
+}
+
+// isWord reports whether r is part of a word. Marks are, since Thai vowels
+// and tone marks are.
+func isWord(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+// segments splits text into lower case runs of word characters of one
+// script, Thai or not.
+func segments(text string) []string {
+	var segs []string
+	var cur []rune
+	thai := false
+	flush := func() {
+		if len(cur) > 0 {
+			segs = append(segs, string(cur))
+			cur = cur[:0]
+		}
+	}
+	for _, r := range text {
+		if !isWord(r) {
+			flush()
+			continue
+		}
+		// Marks stay with the character they modify.
+		if len(cur) > 0 && isThai(r) != thai && !unicode.IsMark(r) {
+			flush()
+		}
+		if len(cur) == 0 {
+			thai = isThai(r)
+		}
+		cur = append(cur, unicode.ToLower(r))
+	}
+	flush()
+	return segs
+}
+
+// bigrams returns the overlapping pairs of characters of a Thai segment,
+// or the segment itself when it has a single character.
+func bigrams(seg string) []string {
+	rs := []rune(seg)
+	if len(rs) < 2 {
+		return []string{seg}
+	}
+	grams := make([]string, len(rs)-1)
+	for i := range grams {
+		grams[i] = string(rs[i : i+2])
+	}
+	return grams
+}
+
+// tokens returns the text as the space separated tokens it is indexed by.
+func tokens(text string) string {
+	var toks []string
+	for _, seg := range segments(text) {
+		if isThai([]rune(seg)[0]) {
+			toks = append(toks, bigrams(seg)...)
+		} else {
+			toks = append(toks, seg)
+		}
+	}
+	return strings.Join(toks, " ")
+}
+
+// term is a part of a query that documents must contain: a word, matched
+// as a prefix, or the bigrams of Thai text, matched as a phrase.
+type term struct {
+	tokens []string
+	prefix bool
+}
+
+// parse splits a query into its terms, and returns the segments to
+// highlight in the results.
+func parse(query string) (terms []term, words []string) {
+	for _, seg := range segments(query) {
+		words = append(words, seg)
+		if isThai([]rune(seg)[0]) {
+			terms = append(terms, term{tokens: bigrams(seg)})
+		} else {
+			terms = append(terms, term{tokens: []string{seg}, prefix: true})
+		}
+	}
+	return terms, words
+}
@@ -0,0 +1,44 @@
+package search
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	for _, tc := range []struct {
+		text string
+		want string
+	}{
+		{"Projector lamp", "projector lamp"},
+		{"EB-X05, (broken)!", "eb x05 broken"},
+		// Thai is indexed as the overlapping pairs of its characters,
+		// vowels and tone marks included.
+		{"จอภาพ", "จอ อภ ภา าพ"},
+		{"ไม่ติด", "ไม ม่ ่ต ติ ิด"},
+		{"ก", "ก"},
+		// Scripts are split even without a space between them.
+		{"Epson จอภาพ", "epson จอ อภ ภา าพ"},
+		{"HDMIไม่ติด", "hdmi ไม ม่ ่ต ติ ิด"},
+		{"จอ ภาพ", "จอ ภา าพ"},
+		{"", ""},
+		{" -- ", ""},
+	} {
+		if got := tokens(tc.text); got != tc.want {
+			t.Errorf("tokens(%q) = %q, want %q", tc.text, got, tc.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	terms, words := parse("Epson จอภาพ, lamp")
+	if got, want := fmt.Sprint(terms), "[{[epson] true} {[จอ อภ ภา าพ] false} {[lamp] true}]"; got != want {
+		t.Errorf("terms = %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(words), "[epson จอภาพ lamp]"; got != want {
+		t.Errorf("words = %s, want %s", got, want)
+	}
+	if terms, _ := parse(" ?! "); len(terms) != 0 {
+		t.Errorf("terms of punctuation = %v, want none", terms)
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/search"
+	"github.com/darksford123x/app/seed"
+	"github.com/darksford123x/app/sla"
+	"github.com/darksford123x/app/tenant"
+)
+
+// runSeed fills an empty database with demo data of an organization,
+// created if missing. The same -seed and -now give the same data.
+func runSeed(ctx context.Context, args []string) error {
+	var sizes []string
+	for name := range seed.Sizes {
+		sizes = append(sizes, name)
+	}
+	sort.Strings(sizes)
+	fs := flags("seed", "[-size size] [-scenario names] [flags]")
+	var (
+		randSeed  = fs.Int64("seed", 1, "seed of the random data")
+		size      = fs.String("size", "small", "size of the data: "+strings.Join(sizes, ", "))
+		scenarios = fs.String("scenario", "", "comma separated scenarios to add, see -list")
+		now       = fs.String("now", "", "time the data is generated at, RFC 3339 or a date; now by default")
+		list      = fs.Bool("list", false, "list the scenarios and exit")
+		org       = fs.String("org", tenant.DefaultName, "name of the organization of the data")
+	)
+	fs.Parse(args)
+
+	if *list {
+		for _, s := range seed.Scenarios() {
+			fmt.Printf("%-20s %s\n", s.Name, s.Description)
+		}
+		return nil
+	}
+
+	cfg, drv, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := persistent(cfg); err != nil {
+		return err
+	}
+	opts := seed.Options{
+		Seed: *randSeed,
+		Hours: sla.Hours{
+			Location: cfg.Location,
+			Opens:    cfg.OpensAt,
+			Closes:   cfg.ClosesAt,
+		},
+		VATRate: cfg.VATRate,
+	}
+	var ok bool
+	if opts.Size, ok = seed.Sizes[*size]; !ok {
+		return fmt.Errorf("unknown size %q, want one of %s", *size, strings.Join(sizes, ", "))
+	}
+	for _, name := range strings.Split(*scenarios, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			opts.Scenarios = append(opts.Scenarios, name)
+		}
+	}
+	if *now != "" {
+		if opts.Now, err = parseTime(*now, cfg.Location); err != nil {
+			return fmt.Errorf("invalid -now: %w", err)
+		}
+	}
+
+	if err := migrateSchema(ctx, drv, client); err != nil {
+		return err
+	}
+	index, err := search.Open(ctx, client, drv.Dialect(), drv.DB())
+	if err != nil {
+		return fmt.Errorf("failed opening the search index: %w", err)
+	}
+	o, err := client.Organization.
+		Query().
+		Where(organization.NameEQ(*org)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		o, err = client.Organization.
+			Create().
+			SetName(*org).
+			Save(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed creating the organization: %w", err)
+	}
+	ctx = tenant.NewContext(ctx, o.ID)
+
+	start := time.Now()
+	counts, err := seed.Run(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+	if err := index.Reindex(ctx); err != nil {
+		return fmt.Errorf("failed indexing the data: %w", err)
+	}
+	fmt.Printf("seeded %s in %s:\n", o.Name, time.Since(start).Round(time.Millisecond))
+	fmt.Printf("  %d users\n", counts.Users)
+	fmt.Printf("  %d equipment\n", counts.Equipment)
+	fmt.Printf("  %d parts with %d stock movements\n", counts.Parts, counts.StockMovements)
+	fmt.Printf("  %d repair slips with %d comments\n", counts.RepairSlips, counts.Comments)
+	fmt.Printf("  %d invoices with %d payments\n", counts.Invoices, counts.Payments)
+	fmt.Printf("sign in as admin@example.com, or any user, with the password %q\n", seed.Password)
+	return nil
+}
+
+// parseTime parses an RFC 3339 time, or a date at noon in loc.
+func parseTime(s string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", s, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("want RFC 3339 or YYYY-MM-DD, got %q", s)
+	}
+	return t.Add(12 * time.Hour), nil
+}
@@ -0,0 +1,226 @@
+package seed
+
+import (
+	"time"
+
+	"github.com/darksford123x/app/money"
+)
+
+// The catalogs the data is drawn from. Their order matters: the generator
+// picks from them by index, so reordering them changes the data of a seed.
+
+var firstNames = []string{
+	"Somchai", "Somsak", "Malee", "Suda", "Nattapong", "Kittisak", "Siriporn",
+	"Waraporn", "Anan", "Prasert", "Chanida", "Pimchanok", "Thanawat",
+	"Wichai", "Supaporn", "Jirawat", "Kanokwan", "Narongrit", "Orawan",
+	"Pornthip", "Sakda", "Tanyarat", "Yupin", "Ekkachai", "Rattana",
+	"Surachai", "Nipaporn", "Apichart", "Duangjai", "Boonsong",
+}
+
+var lastNames = []string{
+	"Saetang", "Srisuk", "Wongsawat", "Chaiyaporn", "Boonmee", "Rattanakul",
+	"Kaewkla", "Thongdee", "Phromma", "Jantarasri", "Sukprasert",
+	"Intharakul", "Meesuk", "Chantawong", "Pongpanit", "Yodkhao",
+	"Siriwan", "Khamphaeng", "Nakprasit", "Suwannarat",
+}
+
+var departments = []string{
+	"Finance", "Human Resources", "Registrar", "Library", "Engineering",
+	"Science", "Agriculture", "Medicine", "Nursing", "Public Health",
+	"Social Technology", "Computer Center",
+}
+
+// categories are the repair slip categories, which are also the skills of
+// the technicians.
+var categories = []string{"computer", "projector", "printer", "network", "aircon"}
+
+// provider is a warranty provider and the term of its warranties.
+type provider struct {
+	name    string
+	months  int
+	contact string
+}
+
+var providers = []provider{
+	{"Dell", 36, "support@dell.example"},
+	{"Lenovo", 24, "warranty@lenovo.example"},
+	{"Epson", 24, "service@epson.example"},
+	{"HP", 12, "care@hp.example"},
+	{"Canon", 12, "service@canon.example"},
+	{"Cisco", 60, "tac@cisco.example"},
+	{"Ubiquiti", 12, "rma@ui.example"},
+	{"Daikin", 12, "service@daikin.example"},
+}
+
+// model is a kind of equipment.
+type model struct {
+	name     string
+	model    string
+	category string
+	// provider indexes providers.
+	provider int
+	// prefix starts the serial numbers.
+	prefix string
+}
+
+var models = []model{
+	{"Desktop computer", "OptiPlex 7080", "computer", 0, "DLD"},
+	{"Laptop", "ThinkPad T14", "computer", 1, "LNT"},
+	{"Projector", "EB-X51", "projector", 2, "EPX"},
+	{"Laser printer", "LaserJet Pro M404dn", "printer", 3, "HPL"},
+	{"Multifunction printer", "imageRUNNER 2630i", "printer", 4, "CIR"},
+	{"Network switch", "Catalyst 2960-X", "network", 5, "CSW"},
+	{"Wireless access point", "UniFi U6-Pro", "network", 6, "UAP"},
+	{"Air conditioner", "FTKC24", "aircon", 7, "DKA"},
+}
+
+// campus is the campus of the buildings.
+const campus = "Main Campus"
+
+// buildings are where equipment is installed, in rooms numbered by floor.
+var buildings = []string{"F1", "F2", "F3", "F4", "F5", "F9", "F10", "F11", "C", "B"}
+
+// floors is the number of floors of each building.
+const floors = 4
+
+// spare is a spare part of a category.
+type spare struct {
+	sku      string
+	name     string
+	unit     string
+	price    money.Amount
+	category string
+}
+
+var parts = []spare{
+	{"CMP-RAM8", "RAM DDR4 8GB", "pcs", 95000, "computer"},
+	{"CMP-SSD480", "SSD 480GB", "pcs", 120000, "computer"},
+	{"CMP-PSU500", "Power supply 500W", "pcs", 89000, "computer"},
+	{"CMP-KB", "USB keyboard", "pcs", 25000, "computer"},
+	{"CMP-MOUSE", "USB mouse", "pcs", 15000, "computer"},
+	{"CMP-FAN", "CPU fan", "pcs", 35000, "computer"},
+	{"PRJ-LAMP", "Projector lamp ELPLP96", "pcs", 320000, "projector"},
+	{"PRJ-FILTER", "Projector air filter", "pcs", 45000, "projector"},
+	{"PRJ-REMOTE", "Projector remote", "pcs", 60000, "projector"},
+	{"PRJ-HDMI", "HDMI cable 10m", "pcs", 55000, "projector"},
+	{"PRN-TONER", "Toner cartridge CF259A", "pcs", 280000, "printer"},
+	{"PRN-FUSER", "Fuser unit", "pcs", 450000, "printer"},
+	{"PRN-ROLLER", "Pickup roller", "pcs", 40000, "printer"},
+	{"PRN-DRUM", "Drum unit", "pcs", 210000, "printer"},
+	{"NET-SFP", "SFP module 1000BASE-LX", "pcs", 150000, "network"},
+	{"NET-CAT6", "Patch cable Cat6 2m", "pcs", 8000, "network"},
+	{"NET-POE", "PoE injector", "pcs", 65000, "network"},
+	{"NET-RJ45", "RJ45 connector", "box", 30000, "network"},
+	{"AC-CAP", "Run capacitor 35uF", "pcs", 38000, "aircon"},
+	{"AC-R32", "Refrigerant R32", "kg", 60000, "aircon"},
+	{"AC-FAN", "Indoor fan motor", "pcs", 250000, "aircon"},
+	{"AC-FILTER", "Air conditioner filter", "pcs", 20000, "aircon"},
+}
+
+// stores are the stock locations; the first one is where parts are taken
+// from.
+var stores = []string{"Main store", "F10 store"}
+
+// symptoms are the reported problems of each category.
+var symptoms = map[string][]string{
+	"computer": {
+		"Does not turn on",
+		"Blue screen after Windows update",
+		"Very slow, fan is loud",
+		"Keyboard keys not working",
+		"Cannot connect to the network drive",
+		"Hard disk clicking noise",
+	},
+	"projector": {
+		"Image is dim and yellow",
+		"Lamp warning light blinking",
+		"No signal from the HDMI input",
+		"Overheats and shuts down after 20 minutes",
+		"Remote does not work",
+	},
+	"printer": {
+		"Paper jam in tray 2",
+		"Prints are faded",
+		"Streaks on every page",
+		"Offline on the network",
+		"Does not pick up paper",
+	},
+	"network": {
+		"No internet in the room",
+		"Wi-Fi keeps disconnecting",
+		"Switch port lights off",
+		"Very slow connection in the afternoon",
+	},
+	"aircon": {
+		"Not cooling",
+		"Water dripping from the indoor unit",
+		"Loud noise when starting",
+		"Remote display blank",
+		"Bad smell when running",
+	},
+}
+
+// comments are written on slips by their reporters and technicians.
+var (
+	reporterComments = []string{
+		"Any update on this? We have a class in this room tomorrow.",
+		"It happened again this morning.",
+		"Thank you, it works now.",
+		"Can someone come after 2pm?",
+		"The room key is at the faculty office.",
+	}
+	technicianComments = []string{
+		"Checked on site, waiting for the part to arrive.",
+		"Replaced the part and tested, working normally.",
+		"Could not reproduce, will monitor for a week.",
+		"Cleaned the unit, problem solved for now.",
+		"Ordered from the vendor, expected next week.",
+	}
+	internalComments = []string{
+		"Same fault as last month, consider replacing the unit.",
+		"The vendor quoted a high price, ask the supervisor.",
+		"Warranty claim rejected, physical damage.",
+	}
+)
+
+// holiday is a public holiday on the same date every year.
+type holiday struct {
+	month time.Month
+	day   int
+	name  string
+}
+
+var holidays = []holiday{
+	{time.January, 1, "New Year's Day"},
+	{time.April, 6, "Chakri Memorial Day"},
+	{time.April, 13, "Songkran Festival"},
+	{time.April, 14, "Songkran Festival"},
+	{time.April, 15, "Songkran Festival"},
+	{time.May, 1, "Labour Day"},
+	{time.May, 4, "Coronation Day"},
+	{time.June, 3, "Queen Suthida's Birthday"},
+	{time.July, 28, "King Vajiralongkorn's Birthday"},
+	{time.August, 12, "Mother's Day"},
+	{time.October, 13, "King Bhumibol Memorial Day"},
+	{time.October, 23, "Chulalongkorn Day"},
+	{time.December, 5, "Father's Day"},
+	{time.December, 10, "Constitution Day"},
+	{time.December, 31, "New Year's Eve"},
+}
+
+// policy is the SLA policy of a priority, in business minutes.
+type policy struct {
+	priority   string
+	category   string
+	response   int
+	resolution int
+}
+
+var policies = []policy{
+	{"urgent", "", 30, 4 * 60},
+	{"high", "", 60, 8 * 60},
+	{"normal", "", 4 * 60, 24 * 60},
+	{"low", "", 8 * 60, 72 * 60},
+	// A network outage stops a whole building.
+	{"urgent", "network", 15, 2 * 60},
+}
@@ -0,0 +1,217 @@
+package seed
+
+import (
+	"context"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/part"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/stocklevel"
+	"github.com/darksford123x/app/ent/stockmovement"
+	"github.com/darksford123x/app/inventory"
+	"github.com/darksford123x/app/warranty"
+)
+
+// Scenario is a named fixture added on top of the generated data, to
+// reproduce a situation.
+type Scenario struct {
+	Name        string
+	Description string
+	apply       func(ctx context.Context, g *generator) error
+}
+
+var scenarios = []Scenario{
+	{
+		Name:        "overdue-backlog",
+		Description: "urgent and high priority slips received days ago and still open, most of them unassigned and the rest piled on one technician",
+		apply:       overdueBacklog,
+	},
+	{
+		Name:        "expiring-warranties",
+		Description: "equipment whose warranty ends within 30 days, and new slips for equipment whose warranty ended last week",
+		apply:       expiringWarranties,
+	},
+	{
+		Name:        "low-stock",
+		Description: "a part of each category out of stock and another nearly so, with slips waiting for them",
+		apply:       lowStock,
+	},
+	{
+		Name:        "unpaid-invoices",
+		Description: "a few customers with several invoices of slips closed months ago that are unpaid or partly paid",
+		apply:       unpaidInvoices,
+	},
+}
+
+// Scenarios returns the scenarios in the order they are listed.
+func Scenarios() []Scenario {
+	return append([]Scenario(nil), scenarios...)
+}
+
+// Lookup returns the scenario of the given name.
+func Lookup(name string) (Scenario, bool) {
+	for _, s := range scenarios {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return Scenario{}, false
+}
+
+// scaled returns n for every per slips of the size, but at least min.
+func (g *generator) scaled(per, min int) int {
+	if n := g.size.RepairSlips / per; n > min {
+		return n
+	}
+	return min
+}
+
+func overdueBacklog(ctx context.Context, g *generator) error {
+	// The slips that are assigned are all on the first technician.
+	var tech int
+	for _, c := range categories {
+		if techs := g.technicians[c]; len(techs) > 0 {
+			tech = techs[0]
+			break
+		}
+	}
+	n := g.scaled(20, 10)
+	return g.batch(ctx, n, func(ctx context.Context, tx *ent.Tx, i int) error {
+		s := g.plan(g.received(1, 10))
+		s.priority = repairslip.PriorityHigh
+		if g.chance(0.4) {
+			s.priority = repairslip.PriorityUrgent
+		}
+		s.status, s.assignee, s.responded, s.resolved, s.parts = repairslip.StatusReceived, 0, nil, nil, false
+		if tech != 0 && g.chance(0.3) {
+			responded := g.after(s.received, 12*time.Hour)
+			s.status, s.assignee, s.responded = repairslip.StatusInProgress, tech, &responded
+		}
+		_, err := g.create(ctx, tx, s)
+		return err
+	})
+}
+
+func expiringWarranties(ctx context.Context, g *generator) error {
+	today := g.midnight(g.now)
+	n := g.scaled(100, 5)
+	return g.batch(ctx, n, func(ctx context.Context, tx *ent.Tx, i int) error {
+		m := models[g.rand.Intn(len(models))]
+		term := g.warranties[m.provider]
+		end := today.AddDate(0, 0, g.rand.Intn(31))
+		if i%2 == 1 {
+			end = today.AddDate(0, 0, -1-g.rand.Intn(7))
+		}
+		// The warranty ends the day before its anniversary.
+		start := end.AddDate(0, -term.Months, 1)
+		it, err := g.item(ctx, tx, m, &start, start)
+		if err != nil {
+			return err
+		}
+		if !end.Before(today) {
+			return nil
+		}
+		s := g.plan(g.received(0, 0))
+		s.item, s.status, s.assignee, s.responded, s.resolved, s.parts = it, repairslip.StatusReceived, 0, nil, nil, false
+		_, err = g.create(ctx, tx, s)
+		return err
+	})
+}
+
+func lowStock(ctx context.Context, g *generator) error {
+	var short []int
+	for _, c := range categories {
+		var found int
+		for i, st := range g.stock {
+			if st.category == c && found < 2 {
+				short = append(short, i)
+				found++
+			}
+		}
+	}
+	err := g.batch(ctx, len(short), func(ctx context.Context, tx *ent.Tx, i int) error {
+		st := &g.stock[short[i]]
+		level, err := tx.StockLevel.
+			Query().
+			Where(
+				stocklevel.Location(stores[0]),
+				stocklevel.HasPartWith(part.ID(st.id)),
+			).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		// The first part of a category runs out, the second nearly.
+		left := 0
+		if i%2 == 1 {
+			left = 1 + g.rand.Intn(3)
+		}
+		if delta := left - level.OnHand; delta != 0 {
+			_, err := inventory.Adjust(ctx, tx, st.id, stores[0], delta, stockmovement.ReasonCodeCountCorrection, "Stock count")
+			if err != nil {
+				return err
+			}
+		}
+		st.onHand = left
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	n := g.scaled(50, 5)
+	return g.batch(ctx, n, func(ctx context.Context, tx *ent.Tx, i int) error {
+		st := g.stock[short[2*g.rand.Intn(len(short)/2)]]
+		s := g.plan(g.received(0, 7))
+		for s.item.category != st.category {
+			s.item = g.items[g.rand.Intn(len(g.items))]
+		}
+		responded := g.after(s.received, time.Hour)
+		s.status, s.responded, s.resolved, s.parts = repairslip.StatusWaitingParts, &responded, nil, false
+		_, err := g.create(ctx, tx, s)
+		return err
+	})
+}
+
+func unpaidInvoices(ctx context.Context, g *generator) error {
+	customers := g.staff
+	if len(customers) > 3 {
+		customers = customers[:3]
+	}
+	g.closed = nil
+	n := g.scaled(50, 6)
+	err := g.batch(ctx, n, func(ctx context.Context, tx *ent.Tx, i int) error {
+		s := g.plan(g.received(35, 120))
+		if warranty.Covers(s.item.Equipment, s.received) {
+			// Warranty repairs are not invoiced; look for equipment out of
+			// warranty.
+			for _, it := range g.items {
+				if !warranty.Covers(it.Equipment, s.received) {
+					s.item = it
+					break
+				}
+			}
+		}
+		responded := g.after(s.received, time.Hour)
+		resolved := g.after(responded, 24*time.Hour)
+		s.reporter = customers[i%len(customers)]
+		s.status, s.responded, s.resolved = repairslip.StatusClosed, &responded, &resolved
+		_, err := g.create(ctx, tx, s)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, c := range g.closed {
+		inv, err := g.invoice(ctx, c)
+		if err != nil {
+			return err
+		}
+		if g.chance(0.3) {
+			if err := g.pay(ctx, inv, inv.Total/3, g.after(c.closedAt, 14*24*time.Hour)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
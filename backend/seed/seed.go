@@ -0,0 +1,926 @@
+// Package seed fills an empty database with realistic data for development
+// and demos: users, equipment under warranty in the rooms of a campus, parts
+// in stock, repair slips spread over the past weeks with their comments and
+// history, and the invoices and payments of the closed ones.
+//
+// The data is drawn from a random source seeded by Options.Seed, so the same
+// seed, size, scenarios and Now give the same data. Scenarios add named
+// fixtures on top of it, to reproduce a situation such as an overdue
+// backlog.
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/billing"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/activity"
+	"github.com/darksford123x/app/ent/comment"
+	"github.com/darksford123x/app/ent/location"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/slapolicy"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/ent/warrantyterm"
+	"github.com/darksford123x/app/inventory"
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/sla"
+	"github.com/darksford123x/app/tenant"
+	"github.com/darksford123x/app/txn"
+	"github.com/darksford123x/app/warranty"
+)
+
+// ErrNotEmpty is returned when seeding a database that already has users.
+var ErrNotEmpty = errors.New("seed: the database is not empty")
+
+// Password is the password of every seeded user. The administrator signs in
+// as admin@example.com.
+const Password = "password"
+
+// batchSize is how many rows are created in a transaction. ent has no bulk
+// insert, so the builders are saved in batches of transactions instead,
+// which keeps seeding large databases fast on SQLite.
+const batchSize = 500
+
+// Size is how much data is generated.
+type Size struct {
+	// Users includes the technicians, the supervisors and an administrator.
+	Users       int
+	Technicians int
+	Equipment   int
+	RepairSlips int
+	// Days is how far back the repair slips were received.
+	Days int
+}
+
+// Sizes are the preset sizes.
+var Sizes = map[string]Size{
+	"small":  {Users: 20, Technicians: 5, Equipment: 30, RepairSlips: 50, Days: 30},
+	"medium": {Users: 100, Technicians: 20, Equipment: 300, RepairSlips: 1000, Days: 180},
+	"large":  {Users: 1000, Technicians: 100, Equipment: 3000, RepairSlips: 10000, Days: 365},
+}
+
+// Options configure the data generated.
+type Options struct {
+	Seed      int64
+	Size      Size
+	Scenarios []string
+	// Now is when the data is generated, the current time by default. The
+	// times of the data are relative to it.
+	Now time.Time
+	// Hours are the business hours the SLA deadlines are counted in.
+	Hours sla.Hours
+	// VATRate is charged on the invoices.
+	VATRate money.Rate
+}
+
+// Counts are the numbers of rows generated.
+type Counts struct {
+	Users          int
+	Equipment      int
+	Parts          int
+	StockMovements int
+	RepairSlips    int
+	Comments       int
+	Invoices       int
+	Payments       int
+}
+
+// Run fills the database of the client, which must be empty, with the data
+// of the organization ctx acts for.
+//
+// The slips are created with their deadlines, warranty routing, breaches and
+// activities set as the hooks of the server would have over their lifetime,
+// so Run needs none of the hooks on the client and creates no jobs.
+func Run(ctx context.Context, client *ent.Client, opts Options) (Counts, error) {
+	var apply []Scenario
+	for _, name := range opts.Scenarios {
+		s, ok := Lookup(name)
+		if !ok {
+			return Counts{}, fmt.Errorf("seed: unknown scenario %q", name)
+		}
+		apply = append(apply, s)
+	}
+	// The emails of the users are unique across the organizations.
+	if n, err := client.User.Query().Count(tenant.System(ctx)); err != nil {
+		return Counts{}, err
+	} else if n > 0 {
+		return Counts{}, ErrNotEmpty
+	}
+	if opts.Size.Users < opts.Size.Technicians+2 || opts.Size.Equipment <= 0 || opts.Size.Days <= 0 {
+		return Counts{}, errors.New("seed: the size needs users besides the technicians, equipment and days")
+	}
+
+	g, err := newGenerator(client, opts)
+	if err != nil {
+		return Counts{}, err
+	}
+	steps := []struct {
+		name string
+		run  func(context.Context) error
+	}{
+		{"holidays", g.holidays},
+		{"SLA policies", g.policies},
+		{"warranty terms", g.terms},
+		{"users", g.users},
+		{"locations", g.locations},
+		{"equipment", g.equipment},
+		{"parts", g.parts},
+		{"repair slips", g.slips},
+		{"invoices", g.invoices},
+	}
+	for _, s := range steps {
+		if err := s.run(ctx); err != nil {
+			return Counts{}, fmt.Errorf("seed: %s: %w", s.name, err)
+		}
+	}
+	for _, s := range apply {
+		if err := s.apply(ctx, g); err != nil {
+			return Counts{}, fmt.Errorf("seed: scenario %s: %w", s.Name, err)
+		}
+	}
+	return count(ctx, client)
+}
+
+// count counts the rows generated.
+func count(ctx context.Context, client *ent.Client) (Counts, error) {
+	var c Counts
+	counts := []struct {
+		dst   *int
+		count func(context.Context) (int, error)
+	}{
+		{&c.Users, client.User.Query().Count},
+		{&c.Equipment, client.Equipment.Query().Count},
+		{&c.Parts, client.Part.Query().Count},
+		{&c.StockMovements, client.StockMovement.Query().Count},
+		{&c.RepairSlips, client.RepairSlip.Query().Count},
+		{&c.Comments, client.Comment.Query().Count},
+		{&c.Invoices, client.Invoice.Query().Count},
+		{&c.Payments, client.Payment.Query().Count},
+	}
+	for _, n := range counts {
+		var err error
+		if *n.dst, err = n.count(ctx); err != nil {
+			return Counts{}, err
+		}
+	}
+	return c, nil
+}
+
+// generator generates the data and keeps what later steps refer to.
+type generator struct {
+	client  *ent.Client
+	size    Size
+	now     time.Time
+	hours   sla.Hours
+	rand    *rand.Rand
+	tracker *sla.Tracker
+	biller  *billing.Biller
+	hash    string
+
+	warranties  []*ent.WarrantyTerm
+	staff       []int
+	supervisors []int
+	technicians map[string][]int
+	items       []item
+	stock       []stock
+	// floors are the floors of the buildings by building and number, and
+	// rooms the rooms created for the equipment by name.
+	floors map[string]int
+	rooms  map[string]int
+	// closed are the closed in-house slips, which are invoiced.
+	closed []closed
+}
+
+// item is a piece of equipment.
+type item struct {
+	*ent.Equipment
+	category string
+}
+
+// stock is a part and how many of it are left in the first store.
+type stock struct {
+	id       int
+	category string
+	onHand   int
+}
+
+// closed is a closed in-house slip.
+type closed struct {
+	id       int
+	closedAt time.Time
+}
+
+func newGenerator(client *ent.Client, opts Options) (*generator, error) {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if opts.Hours.Location == nil {
+		opts.Hours.Location = time.Local
+	}
+	hash, err := auth.HashPassword(Password)
+	if err != nil {
+		return nil, err
+	}
+	return &generator{
+		client:      client,
+		size:        opts.Size,
+		now:         now.In(opts.Hours.Location),
+		hours:       opts.Hours,
+		rand:        rand.New(rand.NewSource(opts.Seed)),
+		tracker:     sla.NewTracker(client, opts.Hours),
+		biller:      billing.NewBiller(client, opts.VATRate),
+		hash:        hash,
+		technicians: map[string][]int{},
+		floors:      map[string]int{},
+		rooms:       map[string]int{},
+	}, nil
+}
+
+// batch calls create for 0 to n-1 in transactions of batchSize calls.
+func (g *generator) batch(ctx context.Context, n int, create func(ctx context.Context, tx *ent.Tx, i int) error) error {
+	for start := 0; start < n; start += batchSize {
+		end := start + batchSize
+		if end > n {
+			end = n
+		}
+		err := txn.WithTx(ctx, g.client, func(ctx context.Context, tx *ent.Tx) error {
+			for i := start; i < end; i++ {
+				if err := create(ctx, tx, i); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pick returns a random element of s.
+func (g *generator) pick(s []string) string {
+	return s[g.rand.Intn(len(s))]
+}
+
+// chance returns true with probability p.
+func (g *generator) chance(p float64) bool {
+	return g.rand.Float64() < p
+}
+
+// midnight returns the start of the day of t in the business time zone.
+func (g *generator) midnight(t time.Time) time.Time {
+	t = t.In(g.hours.Location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, g.hours.Location)
+}
+
+// received returns a random time in the business hours of a day between
+// from and to days ago, and not after now.
+func (g *generator) received(from, to int) time.Time {
+	day := g.midnight(g.now).AddDate(0, 0, -(from + g.rand.Intn(to-from+1)))
+	open := g.hours.Closes - g.hours.Opens
+	if open <= 0 {
+		open = 8 * time.Hour
+	}
+	t := day.Add(g.hours.Opens + time.Duration(g.rand.Int63n(int64(open))))
+	if t.After(g.now) {
+		t = g.now.Add(-time.Duration(1+g.rand.Intn(60)) * time.Minute)
+	}
+	return t.Truncate(time.Second)
+}
+
+// after returns t plus a random duration of about mean, but not after
+// now.
+func (g *generator) after(t time.Time, mean time.Duration) time.Time {
+	d := time.Duration(g.rand.ExpFloat64() * float64(mean))
+	if d < time.Minute {
+		d = time.Minute
+	}
+	if t = t.Add(d); t.After(g.now) {
+		t = g.now
+	}
+	return t.Truncate(time.Second)
+}
+
+func (g *generator) holidays(ctx context.Context) error {
+	from := g.now.AddDate(0, 0, -g.size.Days).Year()
+	var days []time.Time
+	var names []string
+	for year := from; year <= g.now.Year()+1; year++ {
+		for _, h := range holidays {
+			days = append(days, time.Date(year, h.month, h.day, 0, 0, 0, 0, g.hours.Location))
+			names = append(names, h.name)
+		}
+	}
+	return g.batch(ctx, len(days), func(ctx context.Context, tx *ent.Tx, i int) error {
+		_, err := tx.Holiday.Create().SetDate(days[i]).SetName(names[i]).Save(ctx)
+		return err
+	})
+}
+
+func (g *generator) policies(ctx context.Context) error {
+	return g.batch(ctx, len(policies), func(ctx context.Context, tx *ent.Tx, i int) error {
+		p := policies[i]
+		_, err := tx.SLAPolicy.
+			Create().
+			SetPriority(slapolicy.Priority(p.priority)).
+			SetCategory(p.category).
+			SetResponseMinutes(p.response).
+			SetResolutionMinutes(p.resolution).
+			Save(ctx)
+		return err
+	})
+}
+
+func (g *generator) terms(ctx context.Context) error {
+	g.warranties = make([]*ent.WarrantyTerm, len(providers))
+	return g.batch(ctx, len(providers), func(ctx context.Context, tx *ent.Tx, i int) error {
+		p := providers[i]
+		var err error
+		g.warranties[i], err = tx.WarrantyTerm.
+			Create().
+			SetName(fmt.Sprintf("%s standard warranty", p.name)).
+			SetProvider(p.name).
+			SetMonths(p.months).
+			SetCoverage(warrantyterm.CoveragePartsAndLabour).
+			SetClaimContact(p.contact).
+			SetConditions("Does not cover physical damage, misuse or consumables.").
+			Save(ctx)
+		return err
+	})
+}
+
+// users creates an administrator, a supervisor for every 25 users, the
+// technicians with their skills spread over the categories, and staff.
+func (g *generator) users(ctx context.Context) error {
+	supervisors := g.size.Users / 25
+	if supervisors == 0 {
+		supervisors = 1
+	}
+	if supervisors > g.size.Users-g.size.Technicians-1 {
+		supervisors = g.size.Users - g.size.Technicians - 1
+	}
+	emails := map[string]int{}
+	return g.batch(ctx, g.size.Users, func(ctx context.Context, tx *ent.Tx, i int) error {
+		first, last := g.pick(firstNames), g.pick(lastNames)
+		local := strings.ToLower(first + "." + last)
+		if n := emails[local]; n > 0 {
+			local = fmt.Sprintf("%s%d", local, n+1)
+		}
+		emails[strings.ToLower(first+"."+last)]++
+		builder := tx.User.
+			Create().
+			SetName(first + " " + last).
+			SetAge(22 + g.rand.Intn(40)).
+			SetEmail(local + "@example.com").
+			SetPasswordHash(g.hash).
+			SetDepartment(g.pick(departments))
+		if g.chance(0.3) {
+			builder.SetLocale(user.LocaleEn)
+		}
+		role := user.RoleStaff
+		switch {
+		case i == 0:
+			role = user.RoleAdmin
+			builder.SetName("Administrator").SetEmail("admin@example.com").SetDepartment("Computer Center")
+		case i <= supervisors:
+			role = user.RoleSupervisor
+			builder.SetDepartment("Computer Center")
+		case i <= supervisors+g.size.Technicians:
+			role = user.RoleTechnician
+			builder.SetDepartment("Computer Center").SetSkill(categories[(i-supervisors-1)%len(categories)])
+		}
+		u, err := builder.SetRole(role).Save(ctx)
+		if err != nil {
+			return err
+		}
+		switch role {
+		case user.RoleStaff:
+			g.staff = append(g.staff, u.ID)
+		case user.RoleSupervisor:
+			g.supervisors = append(g.supervisors, u.ID)
+		case user.RoleTechnician:
+			g.technicians[u.Skill] = append(g.technicians[u.Skill], u.ID)
+		}
+		return nil
+	})
+}
+
+// locations creates the campus with its buildings and their floors. The rooms
+// are created as equipment is installed in them.
+func (g *generator) locations(ctx context.Context) error {
+	return txn.WithTx(ctx, g.client, func(ctx context.Context, tx *ent.Tx) error {
+		c, err := tx.Location.
+			Create().
+			SetName(campus).
+			SetKind(location.KindCampus).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		for _, name := range buildings {
+			b, err := tx.Location.
+				Create().
+				SetName(name).
+				SetKind(location.KindBuilding).
+				SetParent(c).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			for n := 1; n <= floors; n++ {
+				f, err := tx.Location.
+					Create().
+					SetName(fmt.Sprintf("Floor %d", n)).
+					SetKind(location.KindFloor).
+					SetParent(b).
+					Save(ctx)
+				if err != nil {
+					return err
+				}
+				g.floors[fmt.Sprintf("%s-%d", name, n)] = f.ID
+			}
+		}
+		return nil
+	})
+}
+
+// room returns the room of a number on a floor of a building, creating it
+// the first time.
+func (g *generator) room(ctx context.Context, tx *ent.Tx, building string, floor, number int) (string, int, error) {
+	name := fmt.Sprintf("%s-%d%02d", building, floor, number)
+	if id, ok := g.rooms[name]; ok {
+		return name, id, nil
+	}
+	r, err := tx.Location.
+		Create().
+		SetName(name).
+		SetKind(location.KindRoom).
+		SetParentID(g.floors[fmt.Sprintf("%s-%d", building, floor)]).
+		Save(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	g.rooms[name] = r.ID
+	return name, r.ID, nil
+}
+
+func (g *generator) equipment(ctx context.Context) error {
+	return g.batch(ctx, g.size.Equipment, func(ctx context.Context, tx *ent.Tx, i int) error {
+		m := models[g.rand.Intn(len(models))]
+		bought := g.midnight(g.now).AddDate(0, 0, -g.rand.Intn(5*365))
+		var start *time.Time
+		if g.chance(0.85) {
+			start = &bought
+		}
+		_, err := g.item(ctx, tx, m, start, bought)
+		return err
+	})
+}
+
+// item creates a piece of equipment of the model bought at bought and
+// installed in a room then, under the warranty of its provider from start
+// when start is not nil.
+func (g *generator) item(ctx context.Context, tx *ent.Tx, m model, start *time.Time, bought time.Time) (item, error) {
+	n := len(g.items) + 1
+	room, roomID, err := g.room(ctx, tx, g.pick(buildings), 1+g.rand.Intn(floors), 1+g.rand.Intn(20))
+	if err != nil {
+		return item{}, err
+	}
+	builder := tx.Equipment.
+		Create().
+		SetName(fmt.Sprintf("%s %s", m.name, room)).
+		SetSerialNumber(fmt.Sprintf("%s%02d%06d", m.prefix, bought.Year()%100, n)).
+		SetModel(m.model).
+		SetLocationID(roomID).
+		SetCreateTime(bought).
+		SetUpdateTime(bought)
+	if start != nil {
+		term := g.warranties[m.provider]
+		builder.
+			SetWarrantyStart(*start).
+			SetWarrantyEnd(warranty.End(*start, term)).
+			SetWarrantyProvider(term.Provider).
+			SetWarrantyTerm(term)
+	}
+	eq, err := builder.Save(ctx)
+	if err != nil {
+		return item{}, err
+	}
+	_, err = tx.EquipmentMove.
+		Create().
+		SetCreateTime(bought).
+		SetEquipment(eq).
+		SetToLocationID(roomID).
+		Save(ctx)
+	if err != nil {
+		return item{}, err
+	}
+	it := item{Equipment: eq, category: m.category}
+	g.items = append(g.items, it)
+	return it, nil
+}
+
+// parts creates the parts of the catalog and receives enough of them for
+// the repair slips in the stores.
+func (g *generator) parts(ctx context.Context) error {
+	g.stock = make([]stock, len(parts))
+	return g.batch(ctx, len(parts), func(ctx context.Context, tx *ent.Tx, i int) error {
+		p := parts[i]
+		created, err := tx.Part.
+			Create().
+			SetSku(p.sku).
+			SetName(p.name).
+			SetUnit(p.unit).
+			SetUnitPrice(p.price).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		onHand := 5 + g.rand.Intn(10) + g.size.RepairSlips/40
+		if _, err := inventory.Receive(ctx, tx, created.ID, stores[0], onHand, "Opening stock"); err != nil {
+			return err
+		}
+		if _, err := inventory.Receive(ctx, tx, created.ID, stores[1], 1+g.rand.Intn(5), "Opening stock"); err != nil {
+			return err
+		}
+		g.stock[i] = stock{id: created.ID, category: p.category, onHand: onHand}
+		return nil
+	})
+}
+
+// slip is a repair slip to create. The zero values of the optional fields
+// are filled in by create.
+type slip struct {
+	received time.Time
+	item     item
+	priority repairslip.Priority
+	status   repairslip.Status
+	reporter int
+	assignee int
+	// responded and resolved are when work started and when the slip
+	// became ready, if it did.
+	responded *time.Time
+	resolved  *time.Time
+	// parts is whether parts are used for the repair.
+	parts bool
+}
+
+// plan returns a slip received at the given time, at random. Its status
+// depends on how long ago it was received.
+func (g *generator) plan(received time.Time) slip {
+	s := slip{
+		received: received,
+		item:     g.items[g.rand.Intn(len(g.items))],
+		reporter: g.staff[g.rand.Intn(len(g.staff))],
+	}
+	switch r := g.rand.Intn(10); {
+	case r < 2:
+		s.priority = repairslip.PriorityLow
+	case r < 7:
+		s.priority = repairslip.PriorityNormal
+	case r < 9:
+		s.priority = repairslip.PriorityHigh
+	default:
+		s.priority = repairslip.PriorityUrgent
+	}
+
+	age := g.now.Sub(received)
+	var weights []int
+	switch {
+	case age > 14*24*time.Hour:
+		weights = []int{0, 0, 3, 3, 94}
+	case age > 3*24*time.Hour:
+		weights = []int{5, 15, 15, 15, 50}
+	default:
+		weights = []int{40, 40, 10, 10, 0}
+	}
+	statuses := []repairslip.Status{
+		repairslip.StatusReceived,
+		repairslip.StatusInProgress,
+		repairslip.StatusWaitingParts,
+		repairslip.StatusReady,
+		repairslip.StatusClosed,
+	}
+	r := g.rand.Intn(100)
+	for i, w := range weights {
+		if r < w {
+			s.status = statuses[i]
+			break
+		}
+		r -= w
+	}
+
+	techs := g.technicians[s.item.category]
+	if len(techs) > 0 && (s.status != repairslip.StatusReceived || g.chance(0.5)) {
+		s.assignee = techs[g.rand.Intn(len(techs))]
+	}
+	if s.status != repairslip.StatusReceived {
+		responded := g.after(received, respondWithin[s.priority])
+		s.responded = &responded
+	}
+	if sla.Resolved(s.status) {
+		resolved := g.after(*s.responded, resolveWithin[s.priority])
+		s.resolved = &resolved
+	}
+	s.parts = s.status != repairslip.StatusReceived && s.status != repairslip.StatusInProgress && g.chance(0.6)
+	return s
+}
+
+// respondWithin and resolveWithin are the average times slips of a
+// priority take to be responded to and resolved, in wall time. A few miss
+// their deadlines.
+var (
+	respondWithin = map[repairslip.Priority]time.Duration{
+		repairslip.PriorityUrgent: 20 * time.Minute,
+		repairslip.PriorityHigh:   45 * time.Minute,
+		repairslip.PriorityNormal: 3 * time.Hour,
+		repairslip.PriorityLow:    8 * time.Hour,
+	}
+	resolveWithin = map[repairslip.Priority]time.Duration{
+		repairslip.PriorityUrgent: 3 * time.Hour,
+		repairslip.PriorityHigh:   8 * time.Hour,
+		repairslip.PriorityNormal: 24 * time.Hour,
+		repairslip.PriorityLow:    3 * 24 * time.Hour,
+	}
+)
+
+func (g *generator) slips(ctx context.Context) error {
+	received := make([]time.Time, g.size.RepairSlips)
+	for i := range received {
+		received[i] = g.received(0, g.size.Days-1)
+	}
+	// The slips are numbered in the order they were received.
+	sort.Slice(received, func(i, j int) bool { return received[i].Before(received[j]) })
+	return g.batch(ctx, len(received), func(ctx context.Context, tx *ent.Tx, i int) error {
+		_, err := g.create(ctx, tx, g.plan(received[i]))
+		return err
+	})
+}
+
+// event is a change in the history of a slip.
+type event struct {
+	at     time.Time
+	create *ent.ActivityCreate
+}
+
+// create creates a slip with its deadlines, breaches, history, comments and
+// parts used.
+func (g *generator) create(ctx context.Context, tx *ent.Tx, s slip) (*ent.RepairSlip, error) {
+	category := s.item.category
+	underWarranty := warranty.Covers(s.item.Equipment, s.received)
+	if underWarranty {
+		// Warranty repairs use the parts of the provider.
+		s.parts = false
+	}
+	response, resolution, err := g.tracker.Due(ctx, tx.Client(), s.priority, category, s.received)
+	if err != nil {
+		return nil, err
+	}
+	responded, resolved := g.now, g.now
+	if s.responded != nil {
+		responded = *s.responded
+	}
+	if s.resolved != nil {
+		resolved = *s.resolved
+	}
+	var escalated *time.Time
+	responseBreached := response != nil && responded.After(*response)
+	resolutionBreached := resolution != nil && resolved.After(*resolution)
+	switch {
+	case responseBreached:
+		escalated = response
+	case resolutionBreached:
+		escalated = resolution
+	}
+
+	// The slip is closed after its parts are used, which closed slips
+	// cannot be.
+	status := s.status
+	if status == repairslip.StatusClosed {
+		status = repairslip.StatusReady
+	}
+	builder := tx.RepairSlip.
+		Create().
+		SetCreateTime(s.received).
+		SetSymptom(g.pick(symptoms[category])).
+		SetCategory(category).
+		SetStatus(status).
+		SetUnderWarranty(underWarranty).
+		SetPriority(s.priority).
+		SetNillableResponseDue(response).
+		SetNillableResolutionDue(resolution).
+		SetNillableRespondedAt(s.responded).
+		SetNillableResolvedAt(s.resolved).
+		SetResponseBreached(responseBreached).
+		SetResolutionBreached(resolutionBreached).
+		SetNillableEscalatedAt(escalated).
+		SetReporterID(s.reporter).
+		SetEquipmentID(s.item.ID)
+	if underWarranty {
+		builder.SetRoute(repairslip.RouteWarrantyClaim)
+	}
+	if s.assignee != 0 {
+		builder.SetAssigneeID(s.assignee)
+	}
+
+	// The history of the slip, replayed from the times planned.
+	var events []event
+	change := func(at time.Time, kind activity.Kind) *ent.ActivityCreate {
+		c := tx.Activity.Create().SetCreateTime(at).SetKind(kind)
+		events = append(events, event{at: at, create: c})
+		return c
+	}
+	if s.assignee != 0 {
+		at := s.received.Add(time.Duration(1+g.rand.Intn(10)) * time.Minute)
+		if s.responded != nil && s.responded.Before(at) {
+			at = *s.responded
+		}
+		if at.After(g.now) {
+			at = g.now
+		}
+		change(at, activity.KindAssigned).SetTechnicianID(s.assignee)
+	}
+	last := string(repairslip.StatusReceived)
+	step := func(at time.Time, to repairslip.Status) {
+		change(at, activity.KindStatusChanged).SetFrom(last).SetTo(string(to)).SetNillableActorID(nonzero(s.assignee))
+		last = string(to)
+	}
+	if s.responded != nil {
+		step(*s.responded, repairslip.StatusInProgress)
+		if s.status == repairslip.StatusWaitingParts {
+			step(g.after(*s.responded, time.Hour), repairslip.StatusWaitingParts)
+		}
+	}
+	if s.resolved != nil {
+		step(*s.resolved, repairslip.StatusReady)
+	}
+	if s.status == repairslip.StatusClosed {
+		step(g.after(*s.resolved, 4*time.Hour), repairslip.StatusClosed)
+	}
+	if escalated != nil {
+		change(*escalated, activity.KindEscalated)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].at.Before(events[j].at) })
+	updated := s.received
+	if len(events) > 0 {
+		updated = events[len(events)-1].at
+	}
+
+	created, err := builder.SetUpdateTime(updated).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		if _, err := e.create.SetRepairSlip(created).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err := g.comment(ctx, tx, created, s, updated); err != nil {
+		return nil, err
+	}
+	if s.parts {
+		if err := g.use(ctx, tx, created.ID, category); err != nil {
+			return nil, err
+		}
+	}
+	if s.parts || s.status != status {
+		// Using parts updates the slip; the update is done with a bulk
+		// update, which the hooks of the slips leave alone.
+		update := tx.RepairSlip.
+			Update().
+			Where(repairslip.ID(created.ID)).
+			SetStatus(s.status)
+		update.Mutation().SetUpdateTime(updated)
+		if err := update.Exec(ctx); err != nil {
+			return nil, err
+		}
+		created.Status = s.status
+	}
+	if s.status == repairslip.StatusClosed && !underWarranty {
+		g.closed = append(g.closed, closed{id: created.ID, closedAt: updated})
+	}
+	return created, nil
+}
+
+// nonzero returns a pointer to id, or nil when it is zero.
+func nonzero(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+// comment adds a few comments of the reporter and the technician to a slip,
+// between its creation and last change.
+func (g *generator) comment(ctx context.Context, tx *ent.Tx, created *ent.RepairSlip, s slip, until time.Time) error {
+	for n := g.rand.Intn(4); n > 0; n-- {
+		at := s.received
+		if span := until.Sub(s.received); span > 0 {
+			at = at.Add(time.Duration(g.rand.Int63n(int64(span)))).Truncate(time.Second)
+		}
+		builder := tx.Comment.
+			Create().
+			SetCreateTime(at).
+			SetUpdateTime(at).
+			SetRepairSlip(created)
+		switch {
+		case s.assignee != 0 && g.chance(0.2):
+			builder.SetAuthorID(s.assignee).SetBody(g.pick(internalComments)).SetVisibility(comment.VisibilityInternal)
+		case s.assignee != 0 && g.chance(0.5):
+			builder.SetAuthorID(s.assignee).SetBody(g.pick(technicianComments))
+		default:
+			builder.SetAuthorID(s.reporter).SetBody(g.pick(reporterComments))
+		}
+		if _, err := builder.Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// use consumes one or two parts of the category for a slip, from the first
+// store, while they are in stock.
+func (g *generator) use(ctx context.Context, tx *ent.Tx, slipID int, category string) error {
+	var candidates []int
+	for i, st := range g.stock {
+		if st.category == category {
+			candidates = append(candidates, i)
+		}
+	}
+	for n := 1 + g.rand.Intn(2); n > 0; n-- {
+		st := &g.stock[candidates[g.rand.Intn(len(candidates))]]
+		quantity := 1 + g.rand.Intn(2)
+		if st.onHand < quantity {
+			continue
+		}
+		if _, err := inventory.Consume(ctx, tx, slipID, st.id, stores[0], quantity); err != nil {
+			return err
+		}
+		st.onHand -= quantity
+	}
+	return nil
+}
+
+// invoices invoices most closed in-house slips, and records payments for
+// most invoices.
+func (g *generator) invoices(ctx context.Context) error {
+	for _, c := range g.closed {
+		if g.chance(0.1) {
+			continue
+		}
+		inv, err := g.invoice(ctx, c)
+		if err != nil {
+			return err
+		}
+		paidAt := g.after(c.closedAt, 7*24*time.Hour)
+		switch r := g.rand.Float64(); {
+		case paidAt.Equal(g.now) || r < 0.2:
+		case r < 0.35:
+			err = g.pay(ctx, inv, inv.Total/2, paidAt)
+		default:
+			err = g.pay(ctx, inv, inv.Total, paidAt)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// invoice charges a closed slip for an hour or a few of labour, with a
+// call-out fee now and then.
+func (g *generator) invoice(ctx context.Context, c closed) (*ent.Invoice, error) {
+	req := billing.Request{
+		LabourHours: money.Units(1) / 2 * money.Quantity(1+g.rand.Intn(8)),
+		LabourRate:  35000,
+	}
+	if g.chance(0.2) {
+		req.Fees = []billing.Fee{{Description: "On-site call-out", Amount: 20000}}
+	}
+	return g.biller.Issue(ctx, c.id, req)
+}
+
+// pay records a payment of an invoice.
+func (g *generator) pay(ctx context.Context, inv *ent.Invoice, amount money.Amount, at time.Time) error {
+	methods := []payment.Method{payment.MethodCash, payment.MethodBankTransfer, payment.MethodPromptpay}
+	p := billing.Payment{
+		Method: methods[g.rand.Intn(len(methods))],
+		Amount: amount,
+		PaidAt: at,
+	}
+	if p.Method != payment.MethodCash {
+		p.Reference = fmt.Sprintf("TRX%010d", g.rand.Int63n(1e10))
+	}
+	_, err := g.biller.Pay(ctx, inv.ID, p)
+	return err
+}
@@ -0,0 +1,128 @@
+package seed_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/money"
+	"github.com/darksford123x/app/seed"
+	"github.com/darksford123x/app/servertest"
+	"github.com/darksford123x/app/sla"
+)
+
+// options are the options of a small seeding at a fixed time.
+func options(scenarios ...string) seed.Options {
+	bangkok := time.FixedZone("Asia/Bangkok", 7*60*60)
+	return seed.Options{
+		Seed:      42,
+		Size:      seed.Size{Users: 12, Technicians: 4, Equipment: 15, RepairSlips: 40, Days: 30},
+		Scenarios: scenarios,
+		Now:       time.Date(2020, 10, 14, 10, 0, 0, 0, bangkok),
+		Hours:     sla.Hours{Location: bangkok, Opens: 8*time.Hour + 30*time.Minute, Closes: 16*time.Hour + 30*time.Minute},
+		VATRate:   money.Rate(700),
+	}
+}
+
+// fingerprint describes the slips and invoices seeded, to compare them.
+func fingerprint(t *testing.T, h *servertest.Harness) string {
+	t.Helper()
+	ctx := h.Context()
+	var b strings.Builder
+	for _, rs := range h.Client.RepairSlip.Query().Order(ent.Asc(repairslip.FieldID)).AllX(ctx) {
+		fmt.Fprintf(&b, "%s %s %s %s %s\n", rs.Symptom, rs.Category, rs.Status, rs.Priority, rs.ResolutionDue.UTC())
+	}
+	for _, inv := range h.Client.Invoice.Query().Order(ent.Asc(invoice.FieldID)).AllX(ctx) {
+		fmt.Fprintf(&b, "%s %s %s\n", inv.Number, inv.Total, inv.PaymentStatus)
+	}
+	return b.String()
+}
+
+func TestRun(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	counts, err := seed.Run(ctx, h.Client, options())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts.Users != 12 || counts.Equipment != 15 || counts.RepairSlips != 40 {
+		t.Errorf("Run = %+v, want 12 users, 15 equipment and 40 repair slips", counts)
+	}
+	if counts.Parts == 0 || counts.StockMovements == 0 || counts.Comments == 0 || counts.Invoices == 0 {
+		t.Errorf("Run = %+v, want parts, stock movements, comments and invoices", counts)
+	}
+	if n := h.Client.User.Query().CountX(ctx); n != counts.Users {
+		t.Errorf("the organization has %d users, want %d", n, counts.Users)
+	}
+
+	// Seeding twice would mix two sets of data.
+	if _, err := seed.Run(ctx, h.Client, options()); !errors.Is(err, seed.ErrNotEmpty) {
+		t.Errorf("Run on seeded data: %v, want %v", err, seed.ErrNotEmpty)
+	}
+
+	// The same options give the same data.
+	again := servertest.New(t)
+	if _, err := seed.Run(again.Context(), again.Client, options()); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fingerprint(t, again), fingerprint(t, h); got != want {
+		t.Errorf("seeding twice with the same options gave\n%s\nthen\n%s", want, got)
+	}
+}
+
+func TestScenarios(t *testing.T) {
+	h := servertest.New(t)
+	ctx := h.Context()
+	if _, err := seed.Run(ctx, h.Client, options("no-such-scenario")); err == nil || !strings.Contains(err.Error(), "no-such-scenario") {
+		t.Errorf("Run of an unknown scenario: %v, want an error naming it", err)
+	}
+	if n := h.Client.User.Query().CountX(ctx); n != 0 {
+		t.Errorf("Run of an unknown scenario created %d users, want none", n)
+	}
+
+	base, err := seed.Run(ctx, h.Client, options())
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := servertest.New(t)
+	ctx = other.Context()
+	var names []string
+	for _, s := range seed.Scenarios() {
+		names = append(names, s.Name)
+	}
+	counts, err := seed.Run(ctx, other.Client, options(names...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts.RepairSlips <= base.RepairSlips || counts.Invoices <= base.Invoices {
+		t.Errorf("Run with the scenarios = %+v, want more slips and invoices than %+v", counts, base)
+	}
+
+	// The overdue backlog leaves urgent slips received and past their
+	// response deadline.
+	overdue := other.Client.RepairSlip.Query().
+		Where(
+			repairslip.StatusEQ(repairslip.StatusReceived),
+			repairslip.PriorityEQ(repairslip.PriorityUrgent),
+			repairslip.ResponseDueLT(options().Now),
+		).
+		CountX(ctx)
+	if overdue == 0 {
+		t.Error("the overdue backlog has no overdue urgent slips")
+	}
+	// The unpaid invoices are those of slips closed over a month ago.
+	unpaid := other.Client.Invoice.Query().
+		Where(
+			invoice.PaymentStatusIn(invoice.PaymentStatusUnpaid, invoice.PaymentStatusPartial),
+			invoice.HasRepairSlipWith(repairslip.ResolvedAtLT(options().Now.AddDate(0, 0, -30))),
+		).
+		CountX(ctx)
+	if unpaid == 0 {
+		t.Error("the unpaid invoices scenario has no old unpaid invoices")
+	}
+}
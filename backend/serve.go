@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/darksford123x/app/server"
+	"google.golang.org/grpc"
+)
+
+// runServe serves the REST API on the PORT variable, 8080 by default, and
+// the gRPC services on GRPC_PORT until SIGINT or SIGTERM.
+func runServe(ctx context.Context, args []string) error {
+	fs := flags("serve", "[-migrate=false]")
+	migrate := fs.Bool("migrate", true, "create or update the tables of the database before serving")
+	fs.Parse(args)
+
+	cfg, drv, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if *migrate {
+		if err := migrateSchema(ctx, drv, client); err != nil {
+			return err
+		}
+	}
+
+	srv, err := server.New(cfg, drv, client)
+	if err != nil {
+		return fmt.Errorf("failed creating the server: %w", err)
+	}
+	srv.Start()
+
+	httpServer := &http.Server{
+		Addr:    ":" + port(),
+		Handler: srv.Router,
+	}
+	// The event streams never end by themselves.
+	httpServer.RegisterOnShutdown(srv.Broker.Close)
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("failed serving: %v", err)
+		}
+	}()
+	var grpcServer *grpc.Server
+	if cfg.GRPCPort != "" {
+		lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+		if err != nil {
+			return fmt.Errorf("failed listening for gRPC: %w", err)
+		}
+		grpcServer = srv.GRPC()
+		go func() {
+			if err := grpcServer.Serve(lis); err != nil {
+				log.Fatalf("failed serving gRPC: %v", err)
+			}
+		}()
+	}
+
+	// Stop taking requests on SIGINT or SIGTERM, then let the running
+	// requests, jobs and SLA check finish before closing the database.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+	log.Println("shutting down")
+
+	shutdown, done := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer done()
+	if err := httpServer.Shutdown(shutdown); err != nil {
+		log.Printf("failed shutting down the server: %v", err)
+	}
+	if grpcServer != nil {
+		// The watches ended with the broker when the HTTP server shut down.
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-shutdown.Done():
+			grpcServer.Stop()
+		}
+	}
+	if err := srv.Stop(shutdown); err != nil {
+		log.Printf("failed stopping the workers: %v", err)
+	}
+	return nil
+}
+
+// port returns the port to listen on, from the PORT variable like gin does.
+func port() string {
+	if p := os.Getenv("PORT"); p != "" {
+		return p
+	}
+	return "8080"
+}
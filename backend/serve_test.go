@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/controllers"
+)
+
+// freePort returns a port nothing listens on.
+func freePort(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	_, port, err := net.SplitHostPort(ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return port
+}
+
+func TestServe(t *testing.T) {
+	database(t)
+	port := freePort(t)
+	setenv(t, "PORT", port)
+	setenv(t, "GRPC_PORT", "")
+	setenv(t, "AUTH_SECRET", "test")
+	setenv(t, "WORKERS", "1")
+	ctx := context.Background()
+	// The database is migrated by serving, so the first administrator can
+	// be created once it runs.
+	stdin(t, "correct horse\n")
+
+	// The signals meant for the server must not end the test before it
+	// listens for them.
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+
+	done := make(chan error, 1)
+	go func() { done <- runServe(ctx, nil) }()
+	base := "http://127.0.0.1:" + port
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		resp, err := http.Get(base + "/openapi.json")
+		if err == nil {
+			resp.Body.Close()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the server is not serving: %v", err)
+		}
+		select {
+		case err := <-done:
+			t.Fatalf("serve ended: %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	if _, err := stdout(t, func() error {
+		return runUser(ctx, []string{"create", "-email", "admin@example.com", "-name", "Admin", "-age", "30", "-role", "admin"})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	body, _ := json.Marshal(controllers.Login{Email: "admin@example.com", Password: "correct horse"})
+	resp, err := http.Post(base+"/api/v1/auth/login", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("signing in as the created administrator: %s", resp.Status)
+	}
+
+	// SIGINT stops the server. It is sent until the server is listening
+	// for it.
+	tick := time.NewTicker(50 * time.Millisecond)
+	defer tick.Stop()
+	timeout := time.After(10 * time.Second)
+	for {
+		syscall.Kill(os.Getpid(), syscall.SIGINT)
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("serve ended with %v", err)
+			}
+			if _, err := http.Get(base + "/openapi.json"); err == nil {
+				t.Error("the server still serves after SIGINT")
+			}
+			return
+		case <-tick.C:
+		case <-timeout:
+			t.Fatal("the server did not stop on SIGINT")
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// secretParams are the query parameters that are credentials, which are
+// not written to the log.
+var secretParams = []string{"ticket", "access_token"}
+
+// logFormatter formats the request log as gin's default one does, with the
+// credentials of the query redacted.
+func logFormatter(param gin.LogFormatterParams) string {
+	var statusColor, methodColor, resetColor string
+	if param.IsOutputColor() {
+		statusColor = param.StatusCodeColor()
+		methodColor = param.MethodColor()
+		resetColor = param.ResetColor()
+	}
+	if param.Latency > time.Minute {
+		param.Latency -= param.Latency % time.Second
+	}
+	return fmt.Sprintf("[GIN] %v |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
+		param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+		statusColor, param.StatusCode, resetColor,
+		param.Latency,
+		param.ClientIP,
+		methodColor, param.Method, resetColor,
+		redact(param.Path),
+		param.ErrorMessage,
+	)
+}
+
+// redact replaces the values of the secret parameters of the query of path.
+func redact(path string) string {
+	i := strings.IndexByte(path, '?')
+	if i < 0 {
+		return path
+	}
+	query, err := url.ParseQuery(path[i+1:])
+	if err != nil {
+		return path[:i] + "?REDACTED"
+	}
+	changed := false
+	for _, p := range secretParams {
+		if _, ok := query[p]; ok {
+			query[p] = []string{"REDACTED"}
+			changed = true
+		}
+	}
+	if !changed {
+		return path
+	}
+	return path[:i] + "?" + query.Encode()
+}
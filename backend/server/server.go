@@ -0,0 +1,248 @@
+// Package server wires the services of the backend to a database and serves
+// them: the controllers of the REST API on a gin router, the background
+// jobs, the SLA monitor and the gRPC services.
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+
+	"github.com/darksford123x/app/activity"
+	"github.com/darksford123x/app/assignment"
+	"github.com/darksford123x/app/attachments"
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/billing"
+	"github.com/darksford123x/app/config"
+	"github.com/darksford123x/app/controllers"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/gql"
+	"github.com/darksford123x/app/ent/repairslip"
+	_ "github.com/darksford123x/app/ent/runtime"
+	"github.com/darksford123x/app/events"
+	"github.com/darksford123x/app/jobs"
+	"github.com/darksford123x/app/locations"
+	"github.com/darksford123x/app/notify"
+	"github.com/darksford123x/app/openapi"
+	"github.com/darksford123x/app/rpc"
+	"github.com/darksford123x/app/search"
+	"github.com/darksford123x/app/sla"
+	"github.com/darksford123x/app/stats"
+	"github.com/darksford123x/app/storage"
+	"github.com/darksford123x/app/stream"
+	"github.com/darksford123x/app/warranty"
+	"github.com/darksford123x/app/webhooks"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/grpc"
+)
+
+// Server is the backend wired to a database.
+type Server struct {
+	// Router serves the REST API under /api/v1, its documentation and the
+	// GraphiQL playground.
+	Router *gin.Engine
+	Client *ent.Client
+	Tokens *auth.Tokens
+	// Broker streams the events; it is closed with the HTTP server, which
+	// its streams would otherwise keep from shutting down.
+	Broker *stream.Broker
+
+	cfg         *config.Config
+	balancer    *assignment.Balancer
+	notifier    *notify.Notifier
+	pool        *jobs.Pool
+	cancel      context.CancelFunc
+	monitorDone chan struct{}
+}
+
+// New wires the services to a client and its driver, whose schema must
+// already be migrated, and registers the hooks of the services on the
+// client. Nothing runs in the background until Start.
+func New(cfg *config.Config, drv *entsql.Driver, client *ent.Client) (*Server, error) {
+	tracker := sla.NewTracker(client, sla.Hours{
+		Location: cfg.Location,
+		Opens:    cfg.OpensAt,
+		Closes:   cfg.ClosesAt,
+	})
+	// The event hooks come last, so that they publish what the other hooks
+	// set.
+	bus := events.NewBus(client)
+	bus.Load("repairslip", func(ctx context.Context, client *ent.Client, id int) (ent.Value, error) {
+		return client.RepairSlip.
+			Query().
+			Where(repairslip.ID(id)).
+			WithReporter().
+			WithAssignee().
+			WithEquipment().
+			Only(ctx)
+	})
+	var backend storage.Backend
+	switch cfg.Storage {
+	case "s3":
+		backend = &storage.S3{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PathStyle: cfg.S3PathStyle,
+		}
+	default:
+		var err error
+		if backend, err = storage.NewLocal(cfg.StorageDir); err != nil {
+			return nil, fmt.Errorf("opening the storage: %w", err)
+		}
+	}
+	store := attachments.NewStore(client, backend, cfg.MaxUploadSize, cfg.UploadTypes)
+	client.RepairSlip.Use(warranty.Route(client), tracker.Hook(), activity.Hook(), store.Hook(), bus.Hook())
+	client.User.Use(bus.Hook())
+	client.Comment.Use(bus.Hook())
+	client.Equipment.Use(locations.Hook(), bus.Hook())
+
+	var sender notify.Sender = notify.LogSender{}
+	if cfg.SMTPHost != "" {
+		sender = &notify.SMTPSender{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.MailFrom,
+		}
+	}
+	notifier := notify.NewNotifier(client, sender, cfg.Location)
+	bus.Subscribe(notifier.Listen)
+	dispatcher := webhooks.NewDispatcher(client, webhooks.Options{
+		Timeout:      cfg.WebhookTimeout,
+		FailureLimit: cfg.WebhookFailureLimit,
+		AllowPrivate: cfg.WebhookAllowPrivate,
+	})
+	bus.Subscribe(dispatcher.Listen)
+	broker := stream.NewBroker(1000)
+	bus.Subscribe(broker.Listen)
+	index, err := search.Open(context.Background(), client, drv.Dialect(), drv.DB())
+	if err != nil {
+		return nil, fmt.Errorf("opening the search index: %w", err)
+	}
+	bus.Subscribe(index.Listen)
+	aggregator, err := stats.NewAggregator(client, drv.Dialect(), cfg.Location)
+	if err != nil {
+		return nil, fmt.Errorf("creating the aggregator: %w", err)
+	}
+	schema, err := gql.NewSchema(client, controllers.GraphQLOptions())
+	if err != nil {
+		return nil, fmt.Errorf("creating the GraphQL schema: %w", err)
+	}
+
+	balancer := assignment.NewBalancer(client)
+	pool := jobs.NewPool(client, jobs.Options{
+		Workers:      cfg.Workers,
+		PollInterval: cfg.JobPollInterval,
+		Lease:        cfg.JobLease,
+		Backoff:      cfg.JobBackoff,
+		MaxBackoff:   cfg.JobMaxBackoff,
+	})
+	pool.Handle(notify.JobKind, notifier.Handle)
+	pool.Handle(webhooks.JobKind, dispatcher.Handle)
+
+	secret := []byte(cfg.AuthSecret)
+	if len(secret) == 0 {
+		log.Println("AUTH_SECRET is not set, tokens will not survive a restart")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("generating the auth secret: %w", err)
+		}
+	}
+	tokens := auth.NewTokens(secret, cfg.TokenTTL)
+
+	doc, err := openapi.Load()
+	if err != nil {
+		return nil, fmt.Errorf("loading the OpenAPI document: %w", err)
+	}
+
+	router := gin.New()
+	router.Use(gin.LoggerWithFormatter(logFormatter), gin.Recovery())
+	// The frontends send the bearer token in the Authorization header.
+	corsConfig := cors.DefaultConfig()
+	corsConfig.AddAllowHeaders("Authorization", "Last-Event-ID")
+	if len(cfg.AllowedOrigins) > 0 {
+		corsConfig.AllowOrigins = cfg.AllowedOrigins
+	} else {
+		corsConfig.AllowAllOrigins = true
+	}
+	router.Use(cors.New(corsConfig))
+	v1 := router.Group("/api/v1")
+	v1.Use(auth.Middleware(client, tokens))
+	if cfg.ValidateRequests {
+		v1.Use(doc.Middleware())
+	}
+	controllers.NewAuthController(v1, client, tokens)
+	controllers.NewUserController(v1, client)
+	controllers.NewRepairSlipController(v1, client, balancer)
+	controllers.NewPartController(v1, client)
+	biller := billing.NewBiller(client, cfg.VATRate)
+	controllers.NewInvoiceController(v1, client, biller)
+	controllers.NewPaymentController(v1, client, biller)
+	controllers.NewEquipmentController(v1, client, cfg.ScanURL)
+	controllers.NewLocationController(v1, client)
+	controllers.NewSLAController(v1, client, tracker)
+	controllers.NewJobController(v1, client)
+	controllers.NewWebhookController(v1, client, dispatcher)
+	controllers.NewEventController(v1, client, broker, tokens, cfg.AllowedOrigins)
+	controllers.NewAttachmentController(v1, client, store)
+	controllers.NewCommentController(v1, client)
+	controllers.NewSearchController(v1, index)
+	controllers.NewStatsController(v1, aggregator, cfg.Location)
+	controllers.NewGraphQLController(v1, schema)
+
+	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	router.GET("/openapi.json", doc.Handler())
+	router.GET("/graphiql", controllers.GraphiQL("/api/v1/graphql"))
+
+	return &Server{
+		Router:   router,
+		Client:   client,
+		Tokens:   tokens,
+		Broker:   broker,
+		cfg:      cfg,
+		balancer: balancer,
+		notifier: notifier,
+		pool:     pool,
+	}, nil
+}
+
+// Start starts the background job workers and the SLA monitor.
+func (s *Server) Start() {
+	s.pool.Start()
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.monitorDone = make(chan struct{})
+	go func() {
+		sla.NewMonitor(s.Client, s.balancer, s.notifier, s.cfg.SLACheckInterval).Run(ctx)
+		close(s.monitorDone)
+	}()
+}
+
+// GRPC returns a gRPC server of the services.
+func (s *Server) GRPC() *grpc.Server {
+	return rpc.NewServer(s.Client, s.Tokens, s.balancer, s.Broker)
+}
+
+// Stop lets the running jobs and SLA check started by Start finish, until
+// ctx is done.
+func (s *Server) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	err := s.pool.Stop(ctx)
+	s.cancel()
+	select {
+	case <-s.monitorDone:
+	case <-ctx.Done():
+	}
+	return err
+}
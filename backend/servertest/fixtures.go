@@ -0,0 +1,85 @@
+package servertest
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/money"
+)
+
+// Password is the password of the users created by User.
+const Password = "servertest"
+
+var (
+	hashOnce     sync.Once
+	passwordHash string
+)
+
+// hash returns the hash of Password, computed once as bcrypt is slow on
+// purpose.
+func hash() string {
+	hashOnce.Do(func() {
+		h, err := auth.HashPassword(Password)
+		if err != nil {
+			panic(err)
+		}
+		passwordHash = h
+	})
+	return passwordHash
+}
+
+// next returns the next number of the fixtures of the test.
+func (h *Harness) next() int {
+	*h.seq++
+	return *h.seq
+}
+
+// The builders of the fixtures are saved with the Context of the harness,
+// which gives them its organization.
+
+// User returns the builder of a staff user with a unique name and email who
+// signs in with Password. The fields can be changed before saving it, e.g.
+//
+//	admin := h.User().SetRole(user.RoleAdmin).SaveX(h.Context())
+func (h *Harness) User() *ent.UserCreate {
+	n := h.next()
+	return h.Client.User.
+		Create().
+		SetName(fmt.Sprintf("User %d", n)).
+		SetAge(30).
+		SetEmail(fmt.Sprintf("user%d@example.com", n)).
+		SetPasswordHash(hash())
+}
+
+// Equipment returns the builder of equipment with a unique serial number.
+func (h *Harness) Equipment() *ent.EquipmentCreate {
+	n := h.next()
+	return h.Client.Equipment.
+		Create().
+		SetName(fmt.Sprintf("Projector %d", n)).
+		SetSerialNumber(fmt.Sprintf("SN-%04d", n)).
+		SetModel("EB-X41")
+}
+
+// RepairSlip returns the builder of a repair slip reported by a user.
+func (h *Harness) RepairSlip(reporter *ent.User) *ent.RepairSlipCreate {
+	n := h.next()
+	return h.Client.RepairSlip.
+		Create().
+		SetSymptom(fmt.Sprintf("Does not turn on (%d)", n)).
+		SetCategory("projector").
+		SetReporter(reporter)
+}
+
+// Part returns the builder of a spare part with a unique SKU, priced 2,500
+// baht.
+func (h *Harness) Part() *ent.PartCreate {
+	n := h.next()
+	return h.Client.Part.
+		Create().
+		SetSku(fmt.Sprintf("PART-%04d", n)).
+		SetName(fmt.Sprintf("Lamp %d", n)).
+		SetUnitPrice(money.Amount(250000))
+}
@@ -0,0 +1,87 @@
+package servertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of servertest with the responses")
+
+// volatile are the fields whose values change from run to run. They are
+// replaced by placeholders in the golden files.
+var volatile = map[string]string{
+	"token": "<token>",
+}
+
+// Golden compares the status code and the JSON body of the response with
+// testdata/<test name>/<name>.golden, after replacing the times and tokens
+// by placeholders. Run the tests with -update to write the golden files.
+func (r *Response) Golden(name string) *Response {
+	t := r.h.T
+	t.Helper()
+	var body interface{}
+	if r.Body.Len() > 0 {
+		if err := json.Unmarshal(r.Body.Bytes(), &body); err != nil {
+			t.Fatalf("%s %s: the body is not JSON: %v: %s", r.req.Method, r.req.Path, err, r.Body.String())
+		}
+	}
+	got, err := json.MarshalIndent(struct {
+		Status int         `json:"status"`
+		Body   interface{} `json:"body"`
+	}{r.Code, scrub("", body)}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+
+	path := filepath.Join("testdata", filepath.FromSlash(t.Name()), name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%s %s: reading the golden file: %v; run the tests with -update to write it", r.req.Method, r.req.Path, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s %s: the response differs from %s\ngot:\n%s\nwant:\n%s", r.req.Method, r.req.Path, path, got, want)
+	}
+	return r
+}
+
+// scrub replaces the volatile values of a decoded JSON value by
+// placeholders.
+func scrub(key string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = scrub(k, e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = scrub("", e)
+		}
+		return v
+	case string:
+		if p, ok := volatile[key]; ok {
+			return p
+		}
+		if _, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return "<time>"
+		}
+		return v
+	default:
+		return v
+	}
+}
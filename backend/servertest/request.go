@@ -0,0 +1,127 @@
+package servertest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/tenant"
+)
+
+// Request is a request to the router.
+type Request struct {
+	Method string
+	// Path is the path and query, e.g. "/api/v1/users?limit=5".
+	Path string
+	// Body is sent as it is when it is a string or []byte, and encoded as
+	// JSON otherwise, unless it is nil.
+	Body interface{}
+	// As is the user the request is signed by; nil sends it
+	// unauthenticated.
+	As     *ent.User
+	Header http.Header
+}
+
+// Do sends a request to the router and returns its response.
+func (h *Harness) Do(r Request) *Response {
+	h.T.Helper()
+	var body io.Reader
+	contentType := ""
+	switch b := r.Body.(type) {
+	case nil:
+	case string:
+		body = strings.NewReader(b)
+		contentType = "application/json"
+	case []byte:
+		body = bytes.NewReader(b)
+		contentType = "application/json"
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			h.T.Fatalf("encoding the body of %s %s: %v", r.Method, r.Path, err)
+		}
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+	req := httptest.NewRequest(r.Method, r.Path, body)
+	for key, values := range r.Header {
+		req.Header[key] = values
+	}
+	if contentType != "" && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if r.As != nil {
+		req.Header.Set("Authorization", "Bearer "+h.Token(r.As))
+	}
+	w := httptest.NewRecorder()
+	h.Server.Router.ServeHTTP(w, req)
+	return &Response{ResponseRecorder: w, h: h, req: r}
+}
+
+// Get sends a GET request as a user, or unauthenticated when as is nil.
+func (h *Harness) Get(path string, as *ent.User) *Response {
+	h.T.Helper()
+	return h.Do(Request{Method: http.MethodGet, Path: path, As: as})
+}
+
+// Post sends a POST request as a user, or unauthenticated when as is nil.
+func (h *Harness) Post(path string, body interface{}, as *ent.User) *Response {
+	h.T.Helper()
+	return h.Do(Request{Method: http.MethodPost, Path: path, Body: body, As: as})
+}
+
+// Put sends a PUT request as a user, or unauthenticated when as is nil.
+func (h *Harness) Put(path string, body interface{}, as *ent.User) *Response {
+	h.T.Helper()
+	return h.Do(Request{Method: http.MethodPut, Path: path, Body: body, As: as})
+}
+
+// Delete sends a DELETE request as a user, or unauthenticated when as is
+// nil.
+func (h *Harness) Delete(path string, as *ent.User) *Response {
+	h.T.Helper()
+	return h.Do(Request{Method: http.MethodDelete, Path: path, As: as})
+}
+
+// Token returns a bearer token of a user, for the organization of the user.
+func (h *Harness) Token(u *ent.User) string {
+	h.T.Helper()
+	org, err := u.QueryOrganization().OnlyID(tenant.System(context.Background()))
+	if err != nil {
+		h.T.Fatalf("finding the organization of user %d: %v", u.ID, err)
+	}
+	token, _, err := h.Server.Tokens.Issue(u.ID, org)
+	if err != nil {
+		h.T.Fatalf("issuing a token: %v", err)
+	}
+	return token
+}
+
+// Response is the response to a request.
+type Response struct {
+	*httptest.ResponseRecorder
+	h   *Harness
+	req Request
+}
+
+// Status fails the test unless the response has the given status code.
+func (r *Response) Status(code int) *Response {
+	r.h.T.Helper()
+	if r.Code != code {
+		r.h.T.Fatalf("%s %s: status %d, want %d: %s", r.req.Method, r.req.Path, r.Code, code, r.Body.String())
+	}
+	return r
+}
+
+// Decode decodes the JSON body of the response into v.
+func (r *Response) Decode(v interface{}) {
+	r.h.T.Helper()
+	if err := json.Unmarshal(r.Body.Bytes(), v); err != nil {
+		r.h.T.Fatalf("%s %s: decoding the body: %v: %s", r.req.Method, r.req.Path, err, r.Body.String())
+	}
+}
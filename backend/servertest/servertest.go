@@ -0,0 +1,143 @@
+// Package servertest boots the backend for tests, wired as it is served,
+// against an in-memory database of its own for each test. It creates
+// fixtures in an organization, sends requests to the router as the users of
+// the fixtures, and compares the responses with golden files.
+//
+// The responses of the documented operations are checked against the
+// OpenAPI document, so that tests fail when the handlers and their
+// documentation drift apart.
+package servertest
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/darksford123x/app/config"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/enttest"
+	"github.com/darksford123x/app/server"
+	"github.com/darksford123x/app/tenant"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+	gin.DefaultWriter = ioutil.Discard
+}
+
+// Harness is the backend booted for a test.
+type Harness struct {
+	T      *testing.T
+	Server *server.Server
+	Client *ent.Client
+	// Driver is the driver of the database, for tests working below ent.
+	Driver *entsql.Driver
+	// Organization is the organization of the fixtures.
+	Organization *ent.Organization
+
+	// seq numbers the fixtures of the backend, so that their unique
+	// fields differ.
+	seq *int
+}
+
+// databases numbers the in-memory databases, which must have different
+// names to be distinct.
+var databases int64
+
+// New boots the backend with the configuration of Config for a test. The
+// database and the files stored are removed when the test ends.
+func New(t *testing.T) *Harness {
+	t.Helper()
+	return NewWithConfig(t, Config(t))
+}
+
+// NewWithConfig boots the backend with the given configuration for a test,
+// with an organization named tenant.DefaultName for the fixtures.
+func NewWithConfig(t *testing.T, cfg *config.Config) *Harness {
+	t.Helper()
+	name := fmt.Sprintf("servertest%d", atomic.AddInt64(&databases, 1))
+	drv, err := entsql.Open("sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("opening the database: %v", err)
+	}
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	t.Cleanup(func() { client.Close() })
+
+	srv, err := server.New(cfg, drv, client)
+	if err != nil {
+		t.Fatalf("creating the server: %v", err)
+	}
+	h := &Harness{T: t, Server: srv, Client: client, Driver: drv, seq: new(int)}
+	h.Organization = h.organization(tenant.DefaultName)
+	return h
+}
+
+// Tenant returns a harness of the same backend whose fixtures belong to a
+// new organization of the given name.
+func (h *Harness) Tenant(name string) *Harness {
+	h.T.Helper()
+	other := *h
+	other.Organization = h.organization(name)
+	return &other
+}
+
+// organization creates an organization.
+func (h *Harness) organization(name string) *ent.Organization {
+	h.T.Helper()
+	org, err := h.Client.Organization.
+		Create().
+		SetName(name).
+		Save(context.Background())
+	if err != nil {
+		h.T.Fatalf("creating the organization: %v", err)
+	}
+	return org
+}
+
+// Context returns a context acting for the organization of the fixtures,
+// with which they are created and the entities of the organization are
+// read and changed directly on the client.
+func (h *Harness) Context() context.Context {
+	return tenant.NewContext(context.Background(), h.Organization.ID)
+}
+
+// Config returns the configuration of the environment with a fixed auth
+// secret, no job workers, attachments stored locally in a temporary
+// directory and the requests validated.
+func Config(t *testing.T) *config.Config {
+	t.Helper()
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading the config: %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "servertest")
+	if err != nil {
+		t.Fatalf("creating the storage directory: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	cfg.Storage = "local"
+	cfg.StorageDir = dir
+	cfg.AuthSecret = "servertest"
+	cfg.Workers = 0
+	cfg.GRPCPort = ""
+	cfg.ValidateRequests = true
+	return cfg
+}
+
+// Run runs f as a subtest, with a harness of the same backend that reports
+// to the subtest.
+func (h *Harness) Run(name string, f func(h *Harness)) bool {
+	h.T.Helper()
+	return h.T.Run(name, func(t *testing.T) {
+		sub := *h
+		sub.T = t
+		f(&sub)
+	})
+}
@@ -0,0 +1,73 @@
+package sla
+
+import (
+	"time"
+)
+
+// Calendar counts business time: the opening hours of the working days,
+// Monday to Friday, except holidays.
+type Calendar struct {
+	loc         *time.Location
+	open, close time.Duration
+	holidays    map[string]bool
+}
+
+// NewCalendar creates a calendar open from open to close after midnight on
+// working days in the given time zone.
+func NewCalendar(loc *time.Location, open, close time.Duration, holidays ...time.Time) *Calendar {
+	c := &Calendar{
+		loc:      loc,
+		open:     open,
+		close:    close,
+		holidays: make(map[string]bool, len(holidays)),
+	}
+	for _, h := range holidays {
+		c.holidays[h.In(loc).Format(dateLayout)] = true
+	}
+	return c
+}
+
+const dateLayout = "2006-01-02"
+
+// Location returns the time zone of the calendar.
+func (c *Calendar) Location() *time.Location {
+	return c.loc
+}
+
+// Workday reports whether t falls on a working day.
+func (c *Calendar) Workday(t time.Time) bool {
+	t = t.In(c.loc)
+	switch t.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return !c.holidays[t.Format(dateLayout)]
+}
+
+// Add returns the time at which d of business time has passed since t.
+func (c *Calendar) Add(t time.Time, d time.Duration) time.Time {
+	t = t.In(c.loc)
+	for {
+		day := midnight(t)
+		if c.Workday(day) {
+			open, close := day.Add(c.open), day.Add(c.close)
+			if t.Before(open) {
+				t = open
+			}
+			if t.Before(close) {
+				left := close.Sub(t)
+				if d <= left {
+					return t.Add(d)
+				}
+				d -= left
+			}
+		}
+		t = day.AddDate(0, 0, 1)
+	}
+}
+
+// midnight returns the start of the day of t in the location of t.
+func midnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,71 @@
+package sla_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/darksford123x/app/sla"
+)
+
+func TestCalendarAdd(t *testing.T) {
+	ict := time.FixedZone("ICT", 7*60*60)
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2020, time.April, day, hour, min, 0, 0, ict)
+	}
+	// Open 8:30 to 16:30, with Songkran on Monday 13 and Tuesday 14 April
+	// 2020.
+	c := sla.NewCalendar(ict, 8*time.Hour+30*time.Minute, 16*time.Hour+30*time.Minute, at(13, 0, 0), at(14, 0, 0))
+
+	for _, tc := range []struct {
+		name string
+		from time.Time
+		d    time.Duration
+		want time.Time
+	}{
+		{"within the day", at(8, 9, 0), 2 * time.Hour, at(8, 11, 0)},
+		{"until closing", at(8, 14, 30), 2 * time.Hour, at(8, 16, 30)},
+		{"before opening", at(8, 6, 0), time.Hour, at(8, 9, 30)},
+		{"after closing", at(8, 18, 0), time.Hour, at(9, 9, 30)},
+		{"at closing", at(8, 16, 30), 30 * time.Minute, at(9, 9, 0)},
+		{"over the night", at(8, 16, 0), time.Hour, at(9, 9, 0)},
+		{"over the weekend", at(3, 15, 30), 2 * time.Hour, at(6, 9, 30)},
+		{"on a Saturday", at(4, 10, 0), time.Hour, at(6, 9, 30)},
+		{"on a Sunday night", at(5, 23, 0), time.Hour, at(6, 9, 30)},
+		{"over the weekend and holidays", at(10, 16, 0), time.Hour, at(15, 9, 0)},
+		{"on a holiday", at(13, 10, 0), time.Hour, at(15, 9, 30)},
+		{"several days", at(1, 8, 30), 20 * time.Hour, at(3, 12, 30)},
+		{"several weeks", at(1, 8, 30), 10 * 8 * time.Hour, at(16, 16, 30)},
+		{"nothing", at(8, 10, 0), 0, at(8, 10, 0)},
+		{"from another zone", time.Date(2020, time.April, 8, 2, 0, 0, 0, time.UTC), time.Hour, at(8, 10, 0)},
+	} {
+		got := c.Add(tc.from, tc.d)
+		if !got.Equal(tc.want) {
+			t.Errorf("%s: Add(%s, %s) = %s, want %s", tc.name, tc.from, tc.d, got, tc.want)
+		}
+		if got.Location() != ict {
+			t.Errorf("%s: Add returned a time in %s, want the zone of the calendar", tc.name, got.Location())
+		}
+	}
+}
+
+func TestCalendarWorkday(t *testing.T) {
+	ict := time.FixedZone("ICT", 7*60*60)
+	c := sla.NewCalendar(ict, 8*time.Hour, 17*time.Hour, time.Date(2020, time.April, 13, 0, 0, 0, 0, ict))
+	for _, tc := range []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2020, time.April, 10, 12, 0, 0, 0, ict), true},
+		{time.Date(2020, time.April, 11, 12, 0, 0, 0, ict), false},
+		{time.Date(2020, time.April, 12, 12, 0, 0, 0, ict), false},
+		{time.Date(2020, time.April, 13, 12, 0, 0, 0, ict), false},
+		// Sunday evening in UTC is already the Monday holiday in Bangkok.
+		{time.Date(2020, time.April, 12, 20, 0, 0, 0, time.UTC), false},
+		// Monday evening in UTC is already Tuesday.
+		{time.Date(2020, time.April, 13, 20, 0, 0, 0, time.UTC), true},
+	} {
+		if got := c.Workday(tc.t); got != tc.want {
+			t.Errorf("Workday(%s) = %v, want %v", tc.t, got, tc.want)
+		}
+	}
+}
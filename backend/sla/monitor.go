@@ -0,0 +1,201 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/darksford123x/app/assignment"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/tenant"
+	"github.com/darksford123x/app/txn"
+)
+
+// Breach is the target a repair slip missed.
+type Breach string
+
+// Breaches of the SLA targets.
+const (
+	ResponseBreach   Breach = "response"
+	ResolutionBreach Breach = "resolution"
+)
+
+// Notice tells supervisors about a repair slip that missed its target.
+type Notice struct {
+	Slip        *ent.RepairSlip
+	Breach      Breach
+	Supervisors []*ent.User
+}
+
+// Notifier delivers notices about overdue repair slips.
+type Notifier interface {
+	Notify(ctx context.Context, n Notice) error
+}
+
+// LogNotifier writes notices to the standard logger.
+type LogNotifier struct{}
+
+// Notify logs the notice.
+func (LogNotifier) Notify(ctx context.Context, n Notice) error {
+	names := make([]string, len(n.Supervisors))
+	for i, s := range n.Supervisors {
+		names[i] = s.Name
+	}
+	log.Printf("sla: repair slip %d missed its %s target, notifying %v", n.Slip.ID, n.Breach, names)
+	return nil
+}
+
+// Monitor periodically marks the repair slips that missed their targets and
+// escalates them. A slip nobody responded to is reassigned to another
+// technician; supervisors are notified when that is not possible or when a
+// slip misses its resolution target.
+type Monitor struct {
+	client   *ent.Client
+	balancer *assignment.Balancer
+	notifier Notifier
+	interval time.Duration
+}
+
+// NewMonitor creates a monitor checking the slips every interval.
+func NewMonitor(client *ent.Client, balancer *assignment.Balancer, notifier Notifier, interval time.Duration) *Monitor {
+	return &Monitor{
+		client:   client,
+		balancer: balancer,
+		notifier: notifier,
+		interval: interval,
+	}
+}
+
+// Run checks the slips until the context is done.
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := m.Check(ctx, time.Now()); err != nil {
+			log.Printf("sla: check failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check marks and escalates the slips of every organization that are
+// overdue at now, and returns how many targets were newly missed. The slips
+// that fail to escalate are logged, so that one does not hold up the
+// others. Monitors of several servers may check at
+// the same time: every breach is escalated by one of them.
+func (m *Monitor) Check(ctx context.Context, now time.Time) (int, error) {
+	all := tenant.System(ctx)
+	unanswered, err := m.client.RepairSlip.
+		Query().
+		Where(
+			repairslip.StatusEQ(repairslip.StatusReceived),
+			repairslip.ResponseBreached(false),
+			repairslip.ResponseDueLT(now),
+		).
+		WithOrganization().
+		All(all)
+	if err != nil {
+		return 0, err
+	}
+	unresolved, err := m.client.RepairSlip.
+		Query().
+		Where(
+			repairslip.StatusIn(assignment.OpenStatuses...),
+			repairslip.ResolutionBreached(false),
+			repairslip.ResolutionDueLT(now),
+		).
+		WithOrganization().
+		All(all)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, s := range unanswered {
+		ok, err := m.escalate(ctx, s, ResponseBreach, now)
+		if err != nil {
+			log.Printf("sla: escalating the %s breach of repair slip %d: %v", ResponseBreach, s.ID, err)
+		}
+		if ok {
+			n++
+		}
+	}
+	for _, s := range unresolved {
+		ok, err := m.escalate(ctx, s, ResolutionBreach, now)
+		if err != nil {
+			log.Printf("sla: escalating the %s breach of repair slip %d: %v", ResolutionBreach, s.ID, err)
+		}
+		if ok {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// escalate marks the breach on the slip and hands it on, acting for the
+// organization of the slip. It reports whether it marked the breach, which
+// another monitor may have done since the slip was read.
+func (m *Monitor) escalate(ctx context.Context, s *ent.RepairSlip, breach Breach, now time.Time) (bool, error) {
+	if org := s.Edges.Organization; org != nil {
+		ctx = tenant.NewContext(ctx, org.ID)
+	}
+	claimed := false
+	err := txn.WithTx(ctx, m.client, func(ctx context.Context, tx *ent.Tx) error {
+		// The breach is claimed with an update that only applies while it
+		// is not marked, and marked again with the escalation time by an
+		// update of the slip, which the hooks see.
+		claim := tx.RepairSlip.
+			Update().
+			Where(repairslip.ID(s.ID))
+		update := tx.RepairSlip.
+			UpdateOneID(s.ID).
+			SetEscalatedAt(now)
+		if breach == ResponseBreach {
+			claim.Where(repairslip.ResponseBreached(false)).SetResponseBreached(true)
+			update.SetResponseBreached(true)
+		} else {
+			claim.Where(repairslip.ResolutionBreached(false)).SetResolutionBreached(true)
+			update.SetResolutionBreached(true)
+		}
+		n, err := claim.Save(ctx)
+		if err != nil || n != 1 {
+			return err
+		}
+		claimed = true
+		s, err = update.Save(ctx)
+		return err
+	})
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	if breach == ResponseBreach {
+		_, err := m.balancer.Reassign(ctx, s.ID)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, assignment.ErrNoTechnician) {
+			return true, err
+		}
+	}
+
+	supervisors, err := m.client.User.
+		Query().
+		Where(user.RoleEQ(user.RoleSupervisor)).
+		All(ctx)
+	if err != nil {
+		return true, err
+	}
+	if err := m.notifier.Notify(ctx, Notice{Slip: s, Breach: breach, Supervisors: supervisors}); err != nil {
+		// A failed notice must not hold up the other slips.
+		log.Printf("sla: notifying about repair slip %d: %v", s.ID, err)
+	}
+	return true, nil
+}
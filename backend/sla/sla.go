@@ -0,0 +1,204 @@
+// Package sla computes the response and resolution deadlines of repair slips
+// in business hours and escalates the slips that miss them.
+package sla
+
+import (
+	"context"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/holiday"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/slapolicy"
+)
+
+// Hours are the business hours deadlines are counted in.
+type Hours struct {
+	Location *time.Location
+	// Opens and Closes are offsets from midnight.
+	Opens  time.Duration
+	Closes time.Duration
+}
+
+// Tracker computes the deadlines of repair slips.
+type Tracker struct {
+	client *ent.Client
+	hours  Hours
+}
+
+// NewTracker creates a tracker counting deadlines in the given hours.
+func NewTracker(client *ent.Client, hours Hours) *Tracker {
+	return &Tracker{client: client, hours: hours}
+}
+
+// Location returns the time zone of the business hours.
+func (t *Tracker) Location() *time.Location {
+	return t.hours.Location
+}
+
+// Calendar returns the business calendar with the holidays from from on.
+func (t *Tracker) Calendar(ctx context.Context, client *ent.Client, from time.Time) (*Calendar, error) {
+	days, err := client.Holiday.
+		Query().
+		// Holidays are stored in the business time zone, and times are
+		// compared as text by SQLite.
+		Where(holiday.DateGTE(from.In(t.hours.Location).AddDate(0, 0, -1))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	holidays := make([]time.Time, len(days))
+	for i, d := range days {
+		holidays[i] = d.Date
+	}
+	return NewCalendar(t.hours.Location, t.hours.Opens, t.hours.Closes, holidays...), nil
+}
+
+// Due returns the response and resolution deadlines of a slip of the given
+// priority and category received at from. Both are nil when no policy
+// applies.
+func (t *Tracker) Due(ctx context.Context, client *ent.Client, priority repairslip.Priority, category string, from time.Time) (response, resolution *time.Time, err error) {
+	policy, err := client.SLAPolicy.
+		Query().
+		Where(
+			slapolicy.PriorityEQ(slapolicy.Priority(priority)),
+			slapolicy.CategoryIn(category, ""),
+		).
+		// The policy of the category wins over the catch-all one.
+		Order(ent.Desc(slapolicy.FieldCategory)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	cal, err := t.Calendar(ctx, client, from)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The deadlines are kept in the local time zone like the other
+	// timestamps, so that they compare with time.Now().
+	resp := cal.Add(from, time.Duration(policy.ResponseMinutes)*time.Minute).In(time.Local)
+	res := cal.Add(from, time.Duration(policy.ResolutionMinutes)*time.Minute).In(time.Local)
+	return &resp, &res, nil
+}
+
+// Hook returns the hook that sets the deadlines of repair slips when they
+// are created or their priority changes, and records when they are
+// responded to and resolved.
+func (t *Tracker) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.RepairSlipFunc(func(ctx context.Context, m *ent.RepairSlipMutation) (ent.Value, error) {
+			client := t.client
+			if tx := ent.TxFromContext(ctx); tx != nil {
+				client = tx.Client()
+			}
+			var err error
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				err = t.schedule(ctx, client, m)
+			case m.Op().Is(ent.OpUpdateOne):
+				err = t.track(ctx, client, m)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// schedule sets the deadlines of a slip being created.
+func (t *Tracker) schedule(ctx context.Context, client *ent.Client, m *ent.RepairSlipMutation) error {
+	priority, _ := m.Priority()
+	category, _ := m.Category()
+	from, ok := m.CreateTime()
+	if !ok {
+		from = time.Now()
+	}
+	response, resolution, err := t.Due(ctx, client, priority, category, from)
+	if err != nil {
+		return err
+	}
+	if response != nil {
+		m.SetResponseDue(*response)
+		m.SetResolutionDue(*resolution)
+	}
+	return nil
+}
+
+// track updates the deadlines and progress of a slip being updated.
+func (t *Tracker) track(ctx context.Context, client *ent.Client, m *ent.RepairSlipMutation) error {
+	now := time.Now()
+	if priority, ok := m.Priority(); ok {
+		category, err := m.OldCategory(ctx)
+		if err != nil {
+			return err
+		}
+		from, err := m.OldCreateTime(ctx)
+		if err != nil {
+			return err
+		}
+		response, resolution, err := t.Due(ctx, client, priority, category, from)
+		if err != nil {
+			return err
+		}
+		if response == nil {
+			m.ClearResponseDue()
+			m.ClearResolutionDue()
+		} else {
+			m.SetResponseDue(*response)
+			m.SetResolutionDue(*resolution)
+		}
+		// A target already missed stays missed, unless the new deadline
+		// is still ahead of a slip not yet responded to or resolved; the
+		// monitor marks it again if it misses that one.
+		responded, err := m.OldRespondedAt(ctx)
+		if err != nil {
+			return err
+		}
+		resolved, err := m.OldResolvedAt(ctx)
+		if err != nil {
+			return err
+		}
+		if responded == nil && response != nil && response.After(now) {
+			m.SetResponseBreached(false)
+		}
+		if resolved == nil && resolution != nil && resolution.After(now) {
+			m.SetResolutionBreached(false)
+		}
+	}
+
+	status, statusSet := m.Status()
+	if statusSet && status != repairslip.StatusReceived {
+		responded, err := m.OldRespondedAt(ctx)
+		if err != nil {
+			return err
+		}
+		if responded == nil {
+			m.SetRespondedAt(now)
+		}
+	}
+	if statusSet {
+		resolved, err := m.OldResolvedAt(ctx)
+		if err != nil {
+			return err
+		}
+		switch {
+		case Resolved(status) && resolved == nil:
+			m.SetResolvedAt(now)
+		case !Resolved(status) && resolved != nil:
+			// The slip was reopened.
+			m.ClearResolvedAt()
+		}
+	}
+	return nil
+}
+
+// Resolved reports whether the resolution target is met by a slip in the
+// given status.
+func Resolved(status repairslip.Status) bool {
+	return status == repairslip.StatusReady || status == repairslip.StatusClosed
+}
@@ -0,0 +1,225 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/invoice"
+	"github.com/darksford123x/app/ent/payment"
+	"github.com/darksford123x/app/ent/predicate"
+	"github.com/darksford123x/app/ent/repairslip"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// kind is the kind of values of a field.
+type kind int
+
+const (
+	kindString kind = iota
+	kindBool
+	kindInt
+	// kindAmount is a money.Amount, shown as a decimal string.
+	kindAmount
+	// kindRef is the ID of the entity of an edge.
+	kindRef
+	kindTime
+)
+
+// parse parses a value of a filter.
+func (k kind) parse(s string) (interface{}, error) {
+	switch k {
+	case kindBool:
+		return strconv.ParseBool(s)
+	case kindInt, kindRef:
+		return strconv.ParseInt(s, 10, 64)
+	case kindAmount:
+		return money.ParseAmount(s)
+	}
+	return s, nil
+}
+
+// convert returns the value shown for a value of the kind scanned from the
+// database.
+func (k kind) convert(v interface{}) interface{} {
+	switch k {
+	case kindBool:
+		if n, ok := toInt64(v).(int64); ok {
+			return n != 0
+		}
+	case kindInt, kindRef:
+		return toInt64(v)
+	case kindAmount:
+		return toAmount(v)
+	}
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v
+}
+
+// column is a column of a field or edge of an entity.
+type column struct {
+	name string
+	kind kind
+}
+
+// scanner is the generated GroupBy builder of an entity.
+type scanner interface {
+	Scan(ctx context.Context, v interface{}) error
+}
+
+// source is an entity that is aggregated.
+type source struct {
+	// columns are the fields and edges by their names in the API.
+	columns map[string]column
+	// time is the field bucketed by default, if any.
+	time string
+	// roles may aggregate the entity; anyone may when there are none.
+	roles   []user.Role
+	groupBy func(client *ent.Client, ps []func(*sql.Selector), fields []string, fns []ent.AggregateFunc) scanner
+}
+
+// aggregate returns the aggregate function and how its values are shown.
+func (src source) aggregate(entity string, agg Aggregate) (ent.AggregateFunc, func(interface{}) interface{}, error) {
+	if agg.Func == FuncCount {
+		if agg.Field != "" {
+			return nil, nil, fmt.Errorf("%w: count takes no field", ErrInvalid)
+		}
+		return ent.Count(), toInt64, nil
+	}
+	c, ok := src.columns[agg.Field]
+	if !ok || c.kind != kindInt && c.kind != kindAmount {
+		return nil, nil, fmt.Errorf("%w: %s has no number %q", ErrInvalid, entity, agg.Field)
+	}
+	switch agg.Func {
+	case FuncSum:
+		return ent.Sum(c.name), c.kind.convert, nil
+	case FuncMin:
+		return ent.Min(c.name), c.kind.convert, nil
+	case FuncMax:
+		return ent.Max(c.name), c.kind.convert, nil
+	case FuncMean:
+		if c.kind == kindAmount {
+			return ent.Mean(c.name), toAmount, nil
+		}
+		return ent.Mean(c.name), toFloat64, nil
+	}
+	return nil, nil, fmt.Errorf("%w: unknown function %q", ErrInvalid, agg.Func)
+}
+
+// sources are the entities that are aggregated, by name.
+var sources = map[string]source{
+	"user": {
+		columns: map[string]column{
+			user.FieldAge:        {user.FieldAge, kindInt},
+			user.FieldRole:       {user.FieldRole, kindString},
+			user.FieldSkill:      {user.FieldSkill, kindString},
+			user.FieldDepartment: {user.FieldDepartment, kindString},
+			user.FieldLocale:     {user.FieldLocale, kindString},
+		},
+		groupBy: func(client *ent.Client, ps []func(*sql.Selector), fields []string, fns []ent.AggregateFunc) scanner {
+			q := client.User.Query().Order(ent.Asc(fields...))
+			for _, p := range ps {
+				q.Where(predicate.User(p))
+			}
+			return q.GroupBy(fields[0], fields[1:]...).Aggregate(fns...)
+		},
+	},
+	"repairslip": {
+		columns: map[string]column{
+			repairslip.FieldCreateTime:         {repairslip.FieldCreateTime, kindTime},
+			repairslip.FieldUpdateTime:         {repairslip.FieldUpdateTime, kindTime},
+			repairslip.FieldCategory:           {repairslip.FieldCategory, kindString},
+			repairslip.FieldStatus:             {repairslip.FieldStatus, kindString},
+			repairslip.FieldUnderWarranty:      {repairslip.FieldUnderWarranty, kindBool},
+			repairslip.FieldRoute:              {repairslip.FieldRoute, kindString},
+			repairslip.FieldPriority:           {repairslip.FieldPriority, kindString},
+			repairslip.FieldResponseDue:        {repairslip.FieldResponseDue, kindTime},
+			repairslip.FieldResolutionDue:      {repairslip.FieldResolutionDue, kindTime},
+			repairslip.FieldRespondedAt:        {repairslip.FieldRespondedAt, kindTime},
+			repairslip.FieldResolvedAt:         {repairslip.FieldResolvedAt, kindTime},
+			repairslip.FieldResponseBreached:   {repairslip.FieldResponseBreached, kindBool},
+			repairslip.FieldResolutionBreached: {repairslip.FieldResolutionBreached, kindBool},
+			repairslip.EdgeReporter:            {repairslip.ReporterColumn, kindRef},
+			repairslip.EdgeAssignee:            {repairslip.AssigneeColumn, kindRef},
+			repairslip.EdgeEquipment:           {repairslip.EquipmentColumn, kindRef},
+		},
+		time: repairslip.FieldCreateTime,
+		groupBy: func(client *ent.Client, ps []func(*sql.Selector), fields []string, fns []ent.AggregateFunc) scanner {
+			q := client.RepairSlip.Query().Order(ent.Asc(fields...))
+			for _, p := range ps {
+				q.Where(predicate.RepairSlip(p))
+			}
+			return q.GroupBy(fields[0], fields[1:]...).Aggregate(fns...)
+		},
+	},
+	"equipment": {
+		columns: map[string]column{
+			equipment.FieldCreateTime:       {equipment.FieldCreateTime, kindTime},
+			equipment.FieldUpdateTime:       {equipment.FieldUpdateTime, kindTime},
+			equipment.FieldModel:            {equipment.FieldModel, kindString},
+			equipment.FieldWarrantyStart:    {equipment.FieldWarrantyStart, kindTime},
+			equipment.FieldWarrantyEnd:      {equipment.FieldWarrantyEnd, kindTime},
+			equipment.FieldWarrantyProvider: {equipment.FieldWarrantyProvider, kindString},
+		},
+		time: equipment.FieldCreateTime,
+		groupBy: func(client *ent.Client, ps []func(*sql.Selector), fields []string, fns []ent.AggregateFunc) scanner {
+			q := client.Equipment.Query().Order(ent.Asc(fields...))
+			for _, p := range ps {
+				q.Where(predicate.Equipment(p))
+			}
+			return q.GroupBy(fields[0], fields[1:]...).Aggregate(fns...)
+		},
+	},
+	"invoice": {
+		columns: map[string]column{
+			invoice.FieldCreateTime:    {invoice.FieldCreateTime, kindTime},
+			invoice.FieldKind:          {invoice.FieldKind, kindString},
+			invoice.FieldCurrency:      {invoice.FieldCurrency, kindString},
+			invoice.FieldSubtotal:      {invoice.FieldSubtotal, kindAmount},
+			invoice.FieldDiscount:      {invoice.FieldDiscount, kindAmount},
+			invoice.FieldVat:           {invoice.FieldVat, kindAmount},
+			invoice.FieldTotal:         {invoice.FieldTotal, kindAmount},
+			invoice.FieldPaymentStatus: {invoice.FieldPaymentStatus, kindString},
+			invoice.FieldAmountPaid:    {invoice.FieldAmountPaid, kindAmount},
+			invoice.EdgeCustomer:       {invoice.CustomerColumn, kindRef},
+		},
+		time: invoice.FieldCreateTime,
+		// The takings are for those who bill.
+		roles: []user.Role{user.RoleSupervisor, user.RoleAdmin},
+		groupBy: func(client *ent.Client, ps []func(*sql.Selector), fields []string, fns []ent.AggregateFunc) scanner {
+			q := client.Invoice.Query().Order(ent.Asc(fields...))
+			for _, p := range ps {
+				q.Where(predicate.Invoice(p))
+			}
+			return q.GroupBy(fields[0], fields[1:]...).Aggregate(fns...)
+		},
+	},
+	"payment": {
+		columns: map[string]column{
+			payment.FieldCreateTime: {payment.FieldCreateTime, kindTime},
+			payment.FieldKind:       {payment.FieldKind, kindString},
+			payment.FieldMethod:     {payment.FieldMethod, kindString},
+			payment.FieldAmount:     {payment.FieldAmount, kindAmount},
+			payment.FieldPaidAt:     {payment.FieldPaidAt, kindTime},
+			payment.EdgeInvoice:     {payment.InvoiceColumn, kindRef},
+		},
+		time:  payment.FieldPaidAt,
+		roles: []user.Role{user.RoleSupervisor, user.RoleAdmin},
+		groupBy: func(client *ent.Client, ps []func(*sql.Selector), fields []string, fns []ent.AggregateFunc) scanner {
+			q := client.Payment.Query().Order(ent.Asc(fields...))
+			for _, p := range ps {
+				q.Where(predicate.Payment(p))
+			}
+			return q.GroupBy(fields[0], fields[1:]...).Aggregate(fns...)
+		},
+	},
+}
+
+// Entities are the names of the entities that are aggregated.
+var Entities = []string{"user", "repairslip", "equipment", "invoice", "payment"}
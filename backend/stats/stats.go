@@ -0,0 +1,365 @@
+// Package stats aggregates entities into tables for charts: it counts them,
+// or sums, averages and takes the extremes of their numbers, grouped by their
+// fields and by day, week or month. The tables are computed by the generated
+// GroupBy builders, so the hooks and rules of queries apply to them as to any
+// other query.
+package stats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/money"
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
+// ErrInvalid is returned for queries naming unknown entities, fields or
+// functions, or fields that cannot be used the way they are asked to.
+var ErrInvalid = errors.New("stats: invalid query")
+
+// The aggregate functions.
+const (
+	FuncCount = "count"
+	FuncSum   = "sum"
+	FuncMean  = "mean"
+	FuncMin   = "min"
+	FuncMax   = "max"
+)
+
+// The sizes of the time buckets.
+const (
+	BucketDay   = "day"
+	BucketWeek  = "week"
+	BucketMonth = "month"
+)
+
+// Aggregate is an aggregate function of a field; count takes no field.
+type Aggregate struct {
+	Func  string
+	Field string
+}
+
+// ParseAggregate parses an aggregate such as "count" or "sum:total".
+func ParseAggregate(s string) Aggregate {
+	i := strings.IndexByte(s, ':')
+	if i < 0 {
+		return Aggregate{Func: s}
+	}
+	return Aggregate{Func: s[:i], Field: s[i+1:]}
+}
+
+// name is the name of the column of the aggregate in the table.
+func (a Aggregate) name() string {
+	if a.Field == "" {
+		return a.Func
+	}
+	return a.Func + "_" + a.Field
+}
+
+// Query is a table of aggregates to compute.
+type Query struct {
+	// Entity is the name of the entities aggregated, e.g. "repairslip".
+	Entity string
+	// GroupBy are the fields the entities are grouped by.
+	GroupBy []string
+	// Aggregates are computed for every group; a count when there are none.
+	Aggregates []Aggregate
+	// Filters limits the entities to those with one of the given values
+	// of every field.
+	Filters map[string][]string
+	// Bucket groups the entities by the day, week or month of their Time
+	// field as well. Weeks start on Monday.
+	Bucket string
+	// Time is the field bucketed and limited to From and To; the entity's
+	// own time field when empty.
+	Time string
+	// From and To limit the entities to those of the time from From and
+	// before To. They are ignored when zero.
+	From time.Time
+	To   time.Time
+}
+
+// Table is the result of a query. It has a column for each group by field,
+// one for the bucket named as it and one for each aggregate, named as its
+// function and field, e.g. "sum_total". The rows are ordered by the groups.
+type Table struct {
+	Columns []string        `json:"columns"`
+	Rows    [][]interface{} `json:"rows"`
+}
+
+// Aggregator computes the tables.
+type Aggregator struct {
+	client  *ent.Client
+	dialect string
+	// offset is the offset of the business time zone from UTC, which the
+	// days start in.
+	offset time.Duration
+}
+
+// NewAggregator creates an aggregator bucketing times in days of the given
+// location. The dialect is that of the ent driver of the client.
+func NewAggregator(client *ent.Client, name string, loc *time.Location) (*Aggregator, error) {
+	switch name {
+	case dialect.SQLite, dialect.Postgres:
+	default:
+		return nil, fmt.Errorf("stats: dialect %s is not supported", name)
+	}
+	// The databases take fixed offsets only, so days follow the offset of
+	// the zone as it is now.
+	_, offset := time.Now().In(loc).Zone()
+	return &Aggregator{
+		client:  client,
+		dialect: name,
+		offset:  time.Duration(offset) * time.Second,
+	}, nil
+}
+
+// result is a column of the scanned rows and how its values are shown.
+type result struct {
+	// name is the name of the column in the table.
+	name string
+	// tag is the name of the column in the result set, as ent's scanner
+	// names it.
+	tag     string
+	convert func(v interface{}) interface{}
+}
+
+// Aggregate computes the table of the query. Invoices and payments are
+// only aggregated for supervisors and admins, and auth.ErrForbidden is
+// returned to others.
+func (a *Aggregator) Aggregate(ctx context.Context, q Query) (*Table, error) {
+	src, ok := sources[q.Entity]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown entity %q", ErrInvalid, q.Entity)
+	}
+	if len(src.roles) > 0 {
+		if u := auth.FromContext(ctx); u == nil || !auth.HasRole(u, src.roles...) {
+			return nil, auth.ErrForbidden
+		}
+	}
+
+	var (
+		groups  []string
+		results []result
+		fns     []ent.AggregateFunc
+		ps      []func(*sql.Selector)
+	)
+	for _, name := range q.GroupBy {
+		c, ok := src.columns[name]
+		if !ok || c.kind == kindTime {
+			return nil, fmt.Errorf("%w: %s cannot be grouped by %q", ErrInvalid, q.Entity, name)
+		}
+		groups = append(groups, c.name)
+		results = append(results, result{name: name, tag: c.name, convert: c.kind.convert})
+	}
+
+	timeField := q.Time
+	if timeField == "" {
+		timeField = src.time
+	}
+	var timeColumn string
+	if timeField != "" {
+		c, ok := src.columns[timeField]
+		if !ok || c.kind != kindTime {
+			return nil, fmt.Errorf("%w: %s has no time field %q", ErrInvalid, q.Entity, timeField)
+		}
+		timeColumn = c.name
+	}
+	if q.Bucket != "" || !q.From.IsZero() || !q.To.IsZero() {
+		if timeColumn == "" {
+			return nil, fmt.Errorf("%w: %s has no time field", ErrInvalid, q.Entity)
+		}
+	}
+	if q.Bucket != "" {
+		expr, err := a.bucket(q.Bucket, timeColumn)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, expr)
+		results = append(results, result{name: q.Bucket, tag: scanName(expr), convert: kindString.convert})
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("%w: group by a field or a bucket", ErrInvalid)
+	}
+
+	aggregates := q.Aggregates
+	if len(aggregates) == 0 {
+		aggregates = []Aggregate{{Func: FuncCount}}
+	}
+	for _, agg := range aggregates {
+		fn, convert, err := src.aggregate(q.Entity, agg)
+		if err != nil {
+			return nil, err
+		}
+		fns = append(fns, ent.As(fn, agg.name()))
+		results = append(results, result{name: agg.name(), tag: agg.name(), convert: convert})
+	}
+
+	for name, values := range q.Filters {
+		c, ok := src.columns[name]
+		if !ok || c.kind == kindTime {
+			return nil, fmt.Errorf("%w: %s cannot be filtered by %q", ErrInvalid, q.Entity, name)
+		}
+		args := make([]interface{}, len(values))
+		for i, v := range values {
+			arg, err := c.kind.parse(v)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %v", ErrInvalid, name, err)
+			}
+			args[i] = arg
+		}
+		column := c.name
+		ps = append(ps, func(s *sql.Selector) {
+			s.Where(sql.In(s.C(column), args...))
+		})
+	}
+	// Times are compared as text by SQLite, and they are stored in the
+	// local time zone, as time.Now gives them.
+	if from := q.From; !from.IsZero() {
+		ps = append(ps, func(s *sql.Selector) {
+			s.Where(sql.GTE(s.C(timeColumn), from.In(time.Local)))
+		})
+	}
+	if to := q.To; !to.IsZero() {
+		ps = append(ps, func(s *sql.Selector) {
+			s.Where(sql.LT(s.C(timeColumn), to.In(time.Local)))
+		})
+	}
+
+	fields := make([]reflect.StructField, len(results))
+	for i, r := range results {
+		if i > 0 && hasTag(results[:i], r.tag) {
+			return nil, fmt.Errorf("%w: column %q is asked for twice", ErrInvalid, r.name)
+		}
+		fields[i] = reflect.StructField{
+			Name: "F" + strconv.Itoa(i),
+			Type: reflect.TypeOf((*interface{})(nil)).Elem(),
+			Tag:  reflect.StructTag(`sql:"` + r.tag + `"`),
+		}
+	}
+	rows := reflect.New(reflect.SliceOf(reflect.StructOf(fields)))
+	if err := src.groupBy(a.client, ps, groups, fns).Scan(ctx, rows.Interface()); err != nil {
+		return nil, err
+	}
+
+	t := &Table{
+		Columns: make([]string, len(results)),
+		Rows:    make([][]interface{}, rows.Elem().Len()),
+	}
+	for i, r := range results {
+		t.Columns[i] = r.name
+	}
+	for i := range t.Rows {
+		row := rows.Elem().Index(i)
+		t.Rows[i] = make([]interface{}, len(results))
+		for j, r := range results {
+			t.Rows[i][j] = r.convert(row.Field(j).Interface())
+		}
+	}
+	return t, nil
+}
+
+// bucket returns the expression of the first day of the bucket of the time
+// column, formatted as 2006-01-02.
+func (a *Aggregator) bucket(size, column string) (string, error) {
+	minutes := int(a.offset / time.Minute)
+	switch a.dialect {
+	case dialect.SQLite:
+		modifiers := fmt.Sprintf("'%+d minutes'", minutes)
+		switch size {
+		case BucketDay:
+		case BucketWeek:
+			// The next Sunday, or the day itself, less six days.
+			modifiers += ", 'weekday 0', '-6 days'"
+		case BucketMonth:
+			modifiers += ", 'start of month'"
+		default:
+			return "", fmt.Errorf("%w: unknown bucket %q", ErrInvalid, size)
+		}
+		return fmt.Sprintf("date(`%s`, %s)", column, modifiers), nil
+	default:
+		switch size {
+		case BucketDay, BucketWeek, BucketMonth:
+		default:
+			return "", fmt.Errorf("%w: unknown bucket %q", ErrInvalid, size)
+		}
+		sign := '+'
+		if minutes < 0 {
+			sign, minutes = '-', -minutes
+		}
+		return fmt.Sprintf("to_char(date_trunc('%s', `%s` AT TIME ZONE INTERVAL '%c%02d:%02d'), 'YYYY-MM-DD')",
+			size, column, sign, minutes/60, minutes%60), nil
+	}
+}
+
+// scanName returns the name ent's scanner gives the column of an
+// expression: the name of its function.
+func scanName(expr string) string {
+	return strings.ToLower(strings.Split(expr, "(")[0])
+}
+
+// hasTag reports whether one of the results is scanned from the column.
+func hasTag(results []result, tag string) bool {
+	for _, r := range results {
+		if r.tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// toInt64 returns the integer of a value scanned from the database. Sums of
+// integers are numeric in PostgreSQL and scanned as text.
+func toInt64(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(math.Round(v))
+	case []byte:
+		return toInt64(string(v))
+	case string:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return int64(math.Round(f))
+		}
+	}
+	return v
+}
+
+// toFloat64 returns the number of a value scanned from the database.
+func toFloat64(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	case []byte:
+		return toFloat64(string(v))
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	}
+	return v
+}
+
+// toAmount returns the amount of a value scanned from the database,
+// rounded to the satang.
+func toAmount(v interface{}) interface{} {
+	if n, ok := toInt64(v).(int64); ok {
+		return money.Amount(n)
+	}
+	return v
+}
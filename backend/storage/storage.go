@@ -0,0 +1,26 @@
+// Package storage keeps the content of uploaded files. The backends are
+// interchangeable; the database only records the key a file is stored
+// under.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned by Get for keys that hold no file.
+var ErrNotFound = errors.New("storage: file not found")
+
+// Backend stores files under keys such as "repairslips/12/3f9c0a". Keys
+// use slashes and never contain "..".
+type Backend interface {
+	// Put stores size bytes read from r under key, replacing what was
+	// there.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get opens the file stored under key; the caller closes it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the file stored under key. Deleting a missing file is
+	// not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,189 @@
+// Package stream pushes the committed changes of users and repair slips to
+// connected clients. Every event gets an ID, and the latest events are kept
+// so that a client reconnecting with the ID of the last event it saw misses
+// nothing.
+package stream
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/events"
+)
+
+// Reset is the type of the message telling a client that events were missed
+// and it should reload its data.
+const Reset = "reset"
+
+// Message is an event with the ID clients resume from. It is named event_id
+// in JSON, since id is the ID of the entity.
+type Message struct {
+	ID string `json:"event_id"`
+	events.Event
+}
+
+// Broker keeps the latest events and hands them to the subscribers.
+type Broker struct {
+	// epoch tells the IDs of this process from those of an earlier one.
+	epoch string
+
+	mu     sync.Mutex
+	seq    uint64
+	buffer []Message
+	size   int
+	subs   map[*Subscription]bool
+	closed bool
+}
+
+// NewBroker creates a broker keeping the latest size events.
+func NewBroker(size int) *Broker {
+	return &Broker{
+		epoch: strconv.FormatInt(time.Now().UnixNano(), 36),
+		size:  size,
+		subs:  make(map[*Subscription]bool),
+	}
+}
+
+// Subscription receives the events a user may see.
+type Subscription struct {
+	broker *Broker
+	// org is the organization the user acts for; the events of others
+	// are never seen.
+	org  int
+	user *ent.User
+	// C receives the messages. It is closed when the subscriber fell so
+	// far behind that messages were dropped; the client should reconnect
+	// and resume.
+	C chan Message
+}
+
+// Listen adds a committed event to the stream.
+func (b *Broker) Listen(e events.Event) {
+	if e.Entity != "user" && e.Entity != "repairslip" {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	m := Message{ID: fmt.Sprintf("%s-%d", b.epoch, b.seq), Event: e}
+	b.buffer = append(b.buffer, m)
+	if len(b.buffer) > b.size {
+		b.buffer = b.buffer[len(b.buffer)-b.size:]
+	}
+	for s := range b.subs {
+		if !s.sees(e) {
+			continue
+		}
+		select {
+		case s.C <- m:
+		default:
+			// The subscriber is stuck; it resumes after reconnecting.
+			delete(b.subs, s)
+			close(s.C)
+		}
+	}
+}
+
+// Subscribe subscribes the user acting for the organization to the events
+// after lastID, which is empty for a new client. The events the user missed
+// are returned; when some are no longer kept, the backlog starts with a
+// reset message instead.
+func (b *Broker) Subscribe(org int, u *ent.User, lastID string) (*Subscription, []Message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := &Subscription{broker: b, org: org, user: u, C: make(chan Message, 64)}
+	if b.closed {
+		close(s.C)
+		return s, nil
+	}
+	b.subs[s] = true
+
+	if lastID == "" {
+		return s, nil
+	}
+	var backlog []Message
+	seq, ok := b.parse(lastID)
+	if !ok || seq > b.seq || (len(b.buffer) > 0 && seq+1 < b.first()) {
+		backlog = append(backlog, Message{
+			ID:    fmt.Sprintf("%s-%d", b.epoch, b.seq),
+			Event: events.Event{Type: Reset, Time: time.Now()},
+		})
+		return s, backlog
+	}
+	for _, m := range b.buffer {
+		if n, _ := b.parse(m.ID); n > seq && s.sees(m.Event) {
+			backlog = append(backlog, m)
+		}
+	}
+	return s, backlog
+}
+
+// first returns the sequence number of the oldest kept event.
+func (b *Broker) first() uint64 {
+	n, _ := b.parse(b.buffer[0].ID)
+	return n
+}
+
+// parse returns the sequence number of an ID of this process.
+func (b *Broker) parse(id string) (uint64, bool) {
+	i := strings.LastIndexByte(id, '-')
+	if i < 0 || id[:i] != b.epoch {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(id[i+1:], 10, 64)
+	return n, err == nil
+}
+
+// Close ends all subscriptions and refuses new ones, so that the streams
+// finish when the server shuts down.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for s := range b.subs {
+		delete(b.subs, s)
+		close(s.C)
+	}
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	b := s.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subs[s] {
+		delete(b.subs, s)
+		close(s.C)
+	}
+}
+
+// sees reports whether the subscriber may see the event.
+func (s *Subscription) sees(e events.Event) bool {
+	return e.Organization == s.org && Visible(s.user, e)
+}
+
+// Visible reports whether the user may see the event of their organization. Admins and
+// supervisors see everything; other users see changes of their own user and
+// of the repair slips they reported or are assigned.
+func Visible(u *ent.User, e events.Event) bool {
+	if u.Role == user.RoleAdmin || u.Role == user.RoleSupervisor {
+		return true
+	}
+	switch data := e.Data.(type) {
+	case *ent.User:
+		return data.ID == u.ID
+	case *ent.RepairSlip:
+		if r := data.Edges.Reporter; r != nil && r.ID == u.ID {
+			return true
+		}
+		if a := data.Edges.Assignee; a != nil && a.ID == u.ID {
+			return true
+		}
+	}
+	return e.Entity == "user" && e.ID == u.ID
+}
@@ -0,0 +1,197 @@
+// Package tenant keeps the data of the organizations sharing a deployment
+// apart. The organization of a request is carried by its context, from the
+// token of the signed in user. The policy of the entities owned by an
+// organization restricts their queries to those of the organization of the
+// context, sets it on the entities created, and refuses to change or link
+// to the entities of other organizations, as if they did not exist.
+//
+// Bulk updates and deletes only have the edges they add checked: the
+// predicates selecting their rows are not seen by the policy, so they must
+// only select rows by the IDs of entities read or changed with the context.
+package tenant
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/migrate"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/privacy"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
+)
+
+var (
+	// ErrMissing is returned by the queries and mutations of entities
+	// owned by an organization when the context has none.
+	ErrMissing = errors.New("tenant: no organization")
+	// ErrImmutable is returned by mutations changing the organization of
+	// an entity, or creating one for another organization.
+	ErrImmutable = errors.New("tenant: the organization of an entity cannot be changed")
+)
+
+// Edge is the name of the edge to the organization owning an entity.
+const Edge = "organization"
+
+// Column is the column of the organization in the tables of the entities
+// owned by one.
+const Column = "organization_id"
+
+// DefaultName is the name of the organization given the entities written
+// before the deployment had organizations.
+const DefaultName = "Default"
+
+type ctxKey struct{}
+
+type systemKey struct{}
+
+// NewContext returns a context acting for the organization.
+func NewContext(ctx context.Context, org int) context.Context {
+	return context.WithValue(ctx, ctxKey{}, org)
+}
+
+// FromContext returns the organization the context acts for.
+func FromContext(ctx context.Context) (int, bool) {
+	org, ok := ctx.Value(ctxKey{}).(int)
+	return org, ok
+}
+
+// System returns a context for the work done across the organizations,
+// such as finding the due jobs and the overdue slips of all of them. It no
+// longer acts for the organization of ctx: unless it is given one again with
+// NewContext, its queries see the entities of every organization and its
+// mutations are not checked; the entities it creates must be given their
+// organization.
+func System(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, ctxKey{}, nil)
+	return context.WithValue(ctx, systemKey{}, true)
+}
+
+// system reports whether the context is one of System.
+func system(ctx context.Context) bool {
+	ok, _ := ctx.Value(systemKey{}).(bool)
+	return ok
+}
+
+// Policy returns the policy of the entities owned by an organization.
+func Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			privacy.QueryRuleFunc(filter),
+		},
+		Mutation: privacy.MutationPolicy{
+			privacy.MutationRuleFunc(check),
+		},
+	}
+}
+
+// filter restricts a query to the entities of the organization of the
+// context.
+func filter(ctx context.Context, q ent.Query) error {
+	if org, ok := FromContext(ctx); ok {
+		ent.FilterTenant(q, org)
+		return privacy.Skip
+	}
+	if system(ctx) {
+		return privacy.Allow
+	}
+	return ErrMissing
+}
+
+// check sets the organization of the context on the entities created, and
+// refuses mutations of and edges to the entities of other organizations
+// with the not found error their queries would return.
+func check(ctx context.Context, m ent.Mutation) error {
+	org, ok := FromContext(ctx)
+	if !ok {
+		if !system(ctx) {
+			return ErrMissing
+		}
+		if m.Op().Is(ent.OpCreate) && len(m.AddedIDs(Edge)) == 0 {
+			return fmt.Errorf("%w: creating a %s without one", ErrMissing, m.Type())
+		}
+		return privacy.Allow
+	}
+	switch ids := m.AddedIDs(Edge); {
+	case !m.Op().Is(ent.OpCreate):
+		if len(ids) > 0 || m.EdgeCleared(Edge) {
+			return ErrImmutable
+		}
+	case len(ids) == 0:
+		ent.SetTenant(m, org)
+	case ids[0] != org:
+		return ErrImmutable
+	}
+	if err := ent.CheckTenant(ctx, m); err != nil {
+		return err
+	}
+	return privacy.Skip
+}
+
+// Adopt gives the rows written before the deployment had organizations,
+// which have none, to the organization named DefaultName, creating it if
+// there are such rows. It returns the number of rows adopted. It is run
+// after the schema is migrated.
+func Adopt(ctx context.Context, drv *entsql.Driver, client *ent.Client) (int, error) {
+	db := drv.DB()
+	builder := entsql.Dialect(drv.Dialect())
+	var tables []string
+	for _, t := range migrate.Tables {
+		for _, c := range t.Columns {
+			if c.Name != Column {
+				continue
+			}
+			var n int
+			query, args := builder.
+				Select(entsql.Count("*")).
+				From(entsql.Table(t.Name)).
+				Where(entsql.IsNull(Column)).
+				Query()
+			if err := db.QueryRowContext(ctx, query, args...).Scan(&n); err != nil {
+				return 0, fmt.Errorf("tenant: counting the rows of %s: %w", t.Name, err)
+			}
+			if n > 0 {
+				tables = append(tables, t.Name)
+			}
+		}
+	}
+	if len(tables) == 0 {
+		return 0, nil
+	}
+
+	org, err := client.Organization.
+		Query().
+		Where(organization.NameEQ(DefaultName)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		org, err = client.Organization.
+			Create().
+			SetName(DefaultName).
+			Save(ctx)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("tenant: creating the %s organization: %w", DefaultName, err)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	adopted := 0
+	for _, table := range tables {
+		query, args := builder.
+			Update(table).
+			Set(Column, org.ID).
+			Where(entsql.IsNull(Column)).
+			Query()
+		var res sql.Result
+		if res, err = tx.ExecContext(ctx, query, args...); err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("tenant: adopting the rows of %s: %w", table, err)
+		}
+		n, _ := res.RowsAffected()
+		adopted += int(n)
+	}
+	return adopted, tx.Commit()
+}
@@ -0,0 +1,36 @@
+// Package txn provides helpers for running ent operations in a transaction.
+package txn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/darksford123x/app/ent"
+)
+
+// WithTx runs fn inside a transaction. The transaction is committed when fn
+// returns nil and rolled back when it returns an error or panics. The context
+// passed to fn carries the transaction, so hooks can reach it through
+// ent.TxFromContext.
+func WithTx(ctx context.Context, client *ent.Client, fn func(ctx context.Context, tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(ent.NewTxContext(ctx, tx), tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
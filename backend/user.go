@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/organization"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/tenant"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// userCommands are the subcommands of user.
+var userCommands = []command{
+	{"create", "create a user", runUserCreate},
+	{"list", "list the users", runUserList},
+	{"disable", "keep a user from signing in", runUserDisable(true)},
+	{"enable", "let a disabled user sign in again", runUserDisable(false)},
+	{"set-role", "change the role of a user", runUserSetRole},
+}
+
+// runUser runs a subcommand of user. They change the users directly on the
+// ent client: the hooks of the server do not run, so no event is published
+// and no webhook is called. They see the users of every organization.
+func runUser(ctx context.Context, args []string) error {
+	if len(args) == 0 || help(args[0]) {
+		usage(os.Stderr, "user", userCommands)
+		os.Exit(2)
+	}
+	cmd, ok := lookup(userCommands, args[0])
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", args[0])
+		usage(os.Stderr, "user", userCommands)
+		os.Exit(2)
+	}
+	if err := cmd.run(tenant.System(ctx), args[1:]); err != nil {
+		return fmt.Errorf("%s: %w", cmd.name, err)
+	}
+	return nil
+}
+
+func runUserCreate(ctx context.Context, args []string) error {
+	fs := flags("user create", "-email email -name name -age age [flags]")
+	var (
+		email      = fs.String("email", "", "email the user signs in with")
+		name       = fs.String("name", "", "name of the user")
+		age        = fs.Int("age", 0, "age of the user")
+		role       = fs.String("role", string(user.DefaultRole), "role: staff, technician, supervisor or admin")
+		department = fs.String("department", "", "department of the user")
+		skill      = fs.String("skill", "", "repair category a technician handles")
+		locale     = fs.String("locale", string(user.DefaultLocale), "language of the notifications: th or en")
+		org        = fs.String("org", "", "name or ID of the organization of the user, needed when there are several")
+		noPassword = fs.Bool("no-password", false, "create a user who cannot sign in, without asking for a password")
+	)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: %s user create -email email -name name -age age [flags]\n\n", program())
+		fmt.Fprintf(fs.Output(), "The password is asked for on a terminal, or read from the first line of\nthe standard input otherwise. Without -org, the user is created in the only\norganization, which is created with the name %s in a new database.\n\n", tenant.DefaultName)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if *email == "" || *name == "" || *age <= 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := user.RoleValidator(user.Role(*role)); err != nil {
+		return err
+	}
+	if err := user.LocaleValidator(user.Locale(*locale)); err != nil {
+		return err
+	}
+
+	cfg, _, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := persistent(cfg); err != nil {
+		return err
+	}
+
+	o, err := findOrganization(ctx, client, *org)
+	if err != nil {
+		return err
+	}
+	create := client.User.
+		Create().
+		SetOrganization(o).
+		SetEmail(*email).
+		SetName(*name).
+		SetAge(*age).
+		SetRole(user.Role(*role)).
+		SetLocale(user.Locale(*locale))
+	if *department != "" {
+		create.SetDepartment(*department)
+	}
+	if *skill != "" {
+		create.SetSkill(*skill)
+	}
+	if !*noPassword {
+		password, err := readPassword()
+		if err != nil {
+			return err
+		}
+		hash, err := auth.HashPassword(password)
+		if err != nil {
+			return err
+		}
+		create.SetPasswordHash(hash)
+	}
+	u, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("a user with the email %s already exists", *email)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("created user %d, %s <%s>, %s of %s\n", u.ID, u.Name, u.Email, u.Role, o.Name)
+	return nil
+}
+
+// readPassword asks for a password twice on a terminal, or reads it from
+// the first line of a redirected standard input.
+func readPassword() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return "", errors.New("no password on the standard input; give -no-password for a user who cannot sign in")
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+	fmt.Fprint(os.Stderr, "password: ")
+	password, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprint(os.Stderr, "password again: ")
+	again, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	if string(password) != string(again) {
+		return "", errors.New("the passwords differ")
+	}
+	return string(password), nil
+}
+
+func runUserList(ctx context.Context, args []string) error {
+	fs := flags("user list", "[-org name|id] [-role role] [-disabled]")
+	org := fs.String("org", "", "list only the users of the organization of the name or ID")
+	role := fs.String("role", "", "list only the users of the role")
+	disabled := fs.Bool("disabled", false, "list only the disabled users")
+	fs.Parse(args)
+
+	_, _, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	query := client.User.
+		Query().
+		WithOrganization().
+		Order(ent.Asc(user.FieldID))
+	if *org != "" {
+		o, err := findOrganization(ctx, client, *org)
+		if err != nil {
+			return err
+		}
+		query.Where(user.HasOrganizationWith(organization.ID(o.ID)))
+	}
+	if *role != "" {
+		if err := user.RoleValidator(user.Role(*role)); err != nil {
+			return err
+		}
+		query.Where(user.RoleEQ(user.Role(*role)))
+	}
+	if *disabled {
+		query.Where(user.Disabled(true))
+	}
+	users, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tEMAIL\tORGANIZATION\tROLE\tDEPARTMENT\tSIGN IN")
+	for _, u := range users {
+		org := ""
+		if u.Edges.Organization != nil {
+			org = u.Edges.Organization.Name
+		}
+		signIn := "yes"
+		switch {
+		case u.Disabled:
+			signIn = "disabled"
+		case u.PasswordHash == "":
+			signIn = "no password"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", u.ID, u.Name, u.Email, org, u.Role, u.Department, signIn)
+	}
+	return w.Flush()
+}
+
+// runUserDisable returns the command disabling or enabling a user.
+func runUserDisable(disable bool) func(ctx context.Context, args []string) error {
+	name := "user enable"
+	if disable {
+		name = "user disable"
+	}
+	return func(ctx context.Context, args []string) error {
+		fs := flags(name, "id|email")
+		fs.Parse(args)
+		if fs.NArg() != 1 {
+			fs.Usage()
+			os.Exit(2)
+		}
+
+		cfg, _, client, err := open()
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		if err := persistent(cfg); err != nil {
+			return err
+		}
+
+		u, err := find(ctx, client, fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		if u, err = u.Update().SetDisabled(disable).Save(ctx); err != nil {
+			return err
+		}
+		if disable {
+			fmt.Printf("disabled user %d <%s>\n", u.ID, u.Email)
+		} else {
+			fmt.Printf("enabled user %d <%s>\n", u.ID, u.Email)
+		}
+		return nil
+	}
+}
+
+func runUserSetRole(ctx context.Context, args []string) error {
+	fs := flags("user set-role", "id|email role")
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	role := user.Role(fs.Arg(1))
+	if err := user.RoleValidator(role); err != nil {
+		return err
+	}
+
+	cfg, _, client, err := open()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := persistent(cfg); err != nil {
+		return err
+	}
+
+	u, err := find(ctx, client, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	previous := u.Role
+	if u, err = u.Update().SetRole(role).Save(ctx); err != nil {
+		return err
+	}
+	fmt.Printf("changed the role of user %d <%s> from %s to %s\n", u.ID, u.Email, previous, u.Role)
+	return nil
+}
+
+// find returns the user of an ID or email.
+func find(ctx context.Context, client *ent.Client, ref string) (*ent.User, error) {
+	var (
+		u   *ent.User
+		err error
+	)
+	if id, convErr := strconv.Atoi(ref); convErr == nil {
+		u, err = client.User.Get(ctx, id)
+	} else {
+		u, err = client.User.Query().Where(user.EmailEQ(ref)).Only(ctx)
+	}
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("no user %s", ref)
+	}
+	return u, err
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/darksford123x/app/auth"
+	"github.com/darksford123x/app/ent/user"
+	"github.com/darksford123x/app/tenant"
+)
+
+func TestUser(t *testing.T) {
+	database(t)
+	ctx := context.Background()
+	if _, err := stdout(t, func() error { return runMigrate(ctx, nil) }); err != nil {
+		t.Fatal(err)
+	}
+	run := func(args ...string) (string, error) {
+		t.Helper()
+		return stdout(t, func() error { return runUser(ctx, args) })
+	}
+
+	// The first user is created in a new default organization, with the
+	// password on the standard input.
+	stdin(t, "correct horse\n")
+	out, err := run("create", "-email", "admin@example.com", "-name", "Admin", "-age", "30", "-role", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "admin of "+tenant.DefaultName) {
+		t.Errorf("create printed %q, want an admin of %s", out, tenant.DefaultName)
+	}
+	_, _, client, err := open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	sys := tenant.System(ctx)
+	if _, err := auth.Login(ctx, client, "admin@example.com", "correct horse"); err != nil {
+		t.Errorf("signing in with the password of the standard input: %v", err)
+	}
+
+	if _, err := run("create", "-email", "admin@example.com", "-name", "Again", "-age", "30", "-no-password"); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("create with a taken email: %v, want it refused", err)
+	}
+	if _, err := run("create", "-email", "boss@example.com", "-name", "Boss", "-age", "30", "-role", "boss", "-no-password"); err == nil {
+		t.Error("create with an unknown role succeeded")
+	}
+	if _, err := run("create", "-email", "tech@example.com", "-name", "Tech", "-age", "25", "-role", "technician", "-skill", "projector", "-no-password"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Users are found by ID or email.
+	if _, err := run("disable", "tech@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := run("set-role", "tech@example.com", "supervisor"); err != nil {
+		t.Fatal(err)
+	}
+	tech := client.User.Query().Where(user.EmailEQ("tech@example.com")).OnlyX(sys)
+	if !tech.Disabled || tech.Role != user.RoleSupervisor {
+		t.Errorf("user = %+v, want a disabled supervisor", tech)
+	}
+	if _, err := run("disable", "nobody@example.com"); err == nil || !strings.Contains(err.Error(), "no user nobody@example.com") {
+		t.Errorf("disable of nobody: %v, want no user", err)
+	}
+
+	out, err = run("list", "-disabled")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "tech@example.com") || strings.Contains(out, "admin@example.com") {
+		t.Errorf("list -disabled printed\n%s\nwant only the technician", out)
+	}
+	if _, err := run("enable", "tech@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	out, err = run("list", "-role", "supervisor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "no password") || strings.Contains(out, "disabled") {
+		t.Errorf("list -role supervisor printed\n%s\nwant the enabled technician without a password", out)
+	}
+
+	// Users are not written to an in-memory database, gone once the
+	// command exits.
+	setenv(t, "DATABASE_URL", "file:ent?mode=memory&cache=shared&_fk=1")
+	if _, err := run("create", "-email", "lost@example.com", "-name", "Lost", "-age", "30", "-no-password"); err == nil || !strings.Contains(err.Error(), "in-memory") {
+		t.Errorf("create in an in-memory database: %v, want it refused", err)
+	}
+}
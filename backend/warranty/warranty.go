@@ -0,0 +1,100 @@
+// Package warranty decides which repairs are claimed from a warranty
+// provider and reports warranties that are about to expire.
+package warranty
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/equipment"
+	"github.com/darksford123x/app/ent/hook"
+	"github.com/darksford123x/app/ent/repairslip"
+)
+
+// ErrInvalidPeriod is returned when a warranty ends before it starts.
+var ErrInvalidPeriod = errors.New("warranty: warranty ends before it starts")
+
+// Covers reports whether the equipment is under warranty at the given time.
+// The warranty covers its whole last day.
+func Covers(eq *ent.Equipment, at time.Time) bool {
+	if eq.WarrantyStart == nil || eq.WarrantyEnd == nil {
+		return false
+	}
+	end := eq.WarrantyEnd.AddDate(0, 0, 1)
+	return !at.Before(*eq.WarrantyStart) && at.Before(end)
+}
+
+// End returns the last day of a warranty of the term starting at start.
+// The warranty ends the day before its anniversary.
+func End(start time.Time, term *ent.WarrantyTerm) time.Time {
+	return start.AddDate(0, term.Months, -1)
+}
+
+// ValidatePeriod is the hook on equipment that rejects warranties ending
+// before they start.
+func ValidatePeriod(next ent.Mutator) ent.Mutator {
+	return hook.EquipmentFunc(func(ctx context.Context, m *ent.EquipmentMutation) (ent.Value, error) {
+		start, ok := m.WarrantyStart()
+		if !ok && m.Op().Is(ent.OpUpdateOne) && !m.WarrantyStartCleared() {
+			if old, err := m.OldWarrantyStart(ctx); err == nil && old != nil {
+				start, ok = *old, true
+			}
+		}
+		end, set := m.WarrantyEnd()
+		if !set && m.Op().Is(ent.OpUpdateOne) && !m.WarrantyEndCleared() {
+			if old, err := m.OldWarrantyEnd(ctx); err == nil && old != nil {
+				end, set = *old, true
+			}
+		}
+		if ok && set && end.Before(start) {
+			return nil, ErrInvalidPeriod
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// Route returns the hook that flags repair slips created for equipment under
+// warranty and routes them to a warranty claim. The client is used when the
+// slip is not created in a transaction.
+func Route(client *ent.Client) ent.Hook {
+	hk := func(next ent.Mutator) ent.Mutator {
+		return hook.RepairSlipFunc(func(ctx context.Context, m *ent.RepairSlipMutation) (ent.Value, error) {
+			id, ok := m.EquipmentID()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			c := client
+			if tx := ent.TxFromContext(ctx); tx != nil {
+				c = tx.Client()
+			}
+			eq, err := c.Equipment.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if Covers(eq, time.Now()) {
+				m.SetUnderWarranty(true)
+				m.SetRoute(repairslip.RouteWarrantyClaim)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	return hook.On(hk, ent.OpCreate)
+}
+
+// Expiring returns the equipment whose warranty is still running and ends
+// within the given number of days, soonest first.
+func Expiring(ctx context.Context, client *ent.Client, days int) ([]*ent.Equipment, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return client.Equipment.
+		Query().
+		Where(
+			equipment.WarrantyEndGTE(today),
+			equipment.WarrantyEndLT(today.AddDate(0, 0, days+1)),
+		).
+		WithWarrantyTerm().
+		Order(ent.Asc(equipment.FieldWarrantyEnd), ent.Asc(equipment.FieldID)).
+		All(ctx)
+}
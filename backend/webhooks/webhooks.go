@@ -0,0 +1,384 @@
+// Package webhooks delivers the changes of users and repair slips to the
+// URLs other systems subscribed.
+//
+// Every delivery is a POST of the JSON encoded event with these headers:
+//
+//	X-Webhook-Event:     the event type, e.g. repairslip.updated
+//	X-Webhook-Delivery:  the ID of the delivery
+//	X-Webhook-Timestamp: the Unix time the request was signed at
+//	X-Webhook-Signature: sha256=HEX
+//
+// where HEX is the HMAC-SHA256, keyed with the secret of the webhook, of the
+// timestamp, a dot and the body. Receivers should reject requests whose
+// timestamp is too old to prevent replays.
+//
+// Deliveries only reach public addresses: the responses kept in the delivery
+// log would otherwise let the admins of an organization read the services of
+// the server's own network.
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/darksford123x/app/ent"
+	"github.com/darksford123x/app/ent/webhook"
+	"github.com/darksford123x/app/ent/webhookdelivery"
+	"github.com/darksford123x/app/events"
+	"github.com/darksford123x/app/jobs"
+	"github.com/darksford123x/app/tenant"
+	"github.com/darksford123x/app/txn"
+)
+
+// JobKind is the kind of the jobs delivering events.
+const JobKind = "webhooks.deliver"
+
+var (
+	// ErrDisabled is returned by Redeliver for webhooks that are not
+	// active.
+	ErrDisabled = errors.New("webhooks: webhook is disabled")
+	// ErrPrivateAddress is returned for deliveries to addresses that are
+	// not public, unless Options.AllowPrivate is set.
+	ErrPrivateAddress = errors.New("webhooks: address is not public")
+)
+
+// maxResponseBody is how much of the responses is kept in the delivery log.
+const maxResponseBody = 1024
+
+// Dispatcher turns events into deliveries and sends them.
+type Dispatcher struct {
+	client *ent.Client
+	http   *http.Client
+	// failureLimit is the number of failed attempts in a row after which a
+	// webhook is disabled.
+	failureLimit int
+}
+
+// Options configure a dispatcher.
+type Options struct {
+	// Timeout is how long an endpoint may take to answer.
+	Timeout time.Duration
+	// FailureLimit is the number of failed attempts in a row after which a
+	// webhook is disabled.
+	FailureLimit int
+	// AllowPrivate lets deliveries reach the loopback and private and
+	// link-local addresses, for endpoints on the server's own network.
+	AllowPrivate bool
+}
+
+// NewDispatcher creates a dispatcher.
+func NewDispatcher(client *ent.Client, opts Options) *Dispatcher {
+	dialer := &net.Dialer{Timeout: opts.Timeout}
+	if !opts.AllowPrivate {
+		dialer.Control = refusePrivate
+	}
+	// No proxy, so that the addresses dialed are those of the endpoints.
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: opts.Timeout,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	return &Dispatcher{
+		client:       client,
+		http:         &http.Client{Timeout: opts.Timeout, Transport: transport},
+		failureLimit: opts.FailureLimit,
+	}
+}
+
+// privateNets are the networks of addresses that are not public, besides
+// the loopback, link-local, multicast and unspecified ones.
+var privateNets = func() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, cidr := range []string{
+		"0.0.0.0/8",
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"198.18.0.0/15",
+		"fc00::/7",
+	} {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}()
+
+// refusePrivate refuses connections to addresses that are not public. It
+// controls the dialer, so that it checks the address connected to, after
+// the name of the URL was resolved and for every redirect: checking the URL
+// alone would let names resolving to private addresses through.
+func refusePrivate(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+	}
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+		}
+	}
+	return nil
+}
+
+// NewSecret returns a random secret for a webhook.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature of a delivery body sent at timestamp.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Match reports whether an event type is selected by the patterns of a
+// webhook. A pattern is an event type, an entity followed by ".*", or "*".
+func Match(patterns []string, eventType string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		switch {
+		case p == "*", p == eventType:
+			return true
+		case strings.HasSuffix(p, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(p, "*")):
+			return true
+		}
+	}
+	return false
+}
+
+// Listen queues the deliveries of a committed event to the active webhooks
+// subscribed to it.
+func (d *Dispatcher) Listen(e events.Event) {
+	if e.Entity != "user" && e.Entity != "repairslip" {
+		return
+	}
+	// The webhooks of the organization of the entity are called.
+	ctx := tenant.NewContext(context.Background(), e.Organization)
+	hooks, err := d.client.Webhook.
+		Query().
+		Where(webhook.Active(true)).
+		All(ctx)
+	if err != nil {
+		log.Printf("webhooks: finding the webhooks of %s %d: %v", e.Type, e.ID, err)
+		return
+	}
+	for _, h := range hooks {
+		if !Match(h.Events, e.Type) {
+			continue
+		}
+		if _, err := d.deliver(ctx, h, e); err != nil {
+			log.Printf("webhooks: queueing %s %d to webhook %d: %v", e.Type, e.ID, h.ID, err)
+		}
+	}
+}
+
+// deliver records a delivery of the event to the webhook and queues it.
+func (d *Dispatcher) deliver(ctx context.Context, h *ent.Webhook, e events.Event) (*ent.WebhookDelivery, error) {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	var del *ent.WebhookDelivery
+	err = txn.WithTx(ctx, d.client, func(ctx context.Context, tx *ent.Tx) error {
+		var err error
+		del, err = tx.WebhookDelivery.
+			Create().
+			SetWebhook(h).
+			SetEvent(e.Type).
+			SetPayload(string(body)).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = jobs.Enqueue(ctx, tx.Client(), JobKind, job{Delivery: del.ID})
+		return err
+	})
+	return del, err
+}
+
+// job is the payload of a delivery job.
+type job struct {
+	Delivery int `json:"delivery"`
+}
+
+// Redeliver sends the event of a delivery to its webhook again, as a new
+// delivery with the same body.
+func (d *Dispatcher) Redeliver(ctx context.Context, deliveryID int) (*ent.WebhookDelivery, error) {
+	orig, err := d.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.ID(deliveryID)).
+		WithWebhook().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h := orig.Edges.Webhook
+	if !h.Active {
+		return nil, ErrDisabled
+	}
+	var del *ent.WebhookDelivery
+	err = txn.WithTx(ctx, d.client, func(ctx context.Context, tx *ent.Tx) error {
+		var err error
+		del, err = tx.WebhookDelivery.
+			Create().
+			SetWebhook(h).
+			SetEvent(orig.Event).
+			SetPayload(orig.Payload).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = jobs.Enqueue(ctx, tx.Client(), JobKind, job{Delivery: del.ID})
+		return err
+	})
+	return del, err
+}
+
+// Handle makes an attempt at a delivery job and records its outcome. Failed
+// attempts are retried by the job queue; the delivery is failed once the job
+// used up its attempts or the webhook was disabled.
+func (d *Dispatcher) Handle(ctx context.Context, j *ent.Job) error {
+	var p job
+	if err := jobs.Decode(j, &p); err != nil {
+		return err
+	}
+	del, err := d.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.ID(p.Delivery)).
+		WithWebhook().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return jobs.Permanent(err)
+	}
+	if err != nil {
+		return err
+	}
+	h := del.Edges.Webhook
+	if !h.Active {
+		return d.client.WebhookDelivery.
+			UpdateOne(del).
+			SetStatus(webhookdelivery.StatusFailed).
+			SetError(ErrDisabled.Error()).
+			Exec(ctx)
+	}
+
+	code, body, sendErr := d.send(ctx, h, del)
+	update := d.client.WebhookDelivery.
+		UpdateOne(del).
+		AddAttempts(1)
+	if code != 0 {
+		update.SetResponseCode(code).SetResponseBody(body)
+	} else {
+		update.ClearResponseCode().ClearResponseBody()
+	}
+	if sendErr == nil {
+		if err := update.
+			SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(time.Now()).
+			ClearError().
+			Exec(ctx); err != nil {
+			return err
+		}
+		return d.client.Webhook.
+			UpdateOne(h).
+			SetFailures(0).
+			Exec(ctx)
+	}
+
+	if j.Attempts >= j.MaxAttempts || jobs.IsPermanent(sendErr) {
+		update.SetStatus(webhookdelivery.StatusFailed)
+	}
+	if err := update.SetError(sendErr.Error()).Exec(ctx); err != nil {
+		return err
+	}
+	if err := d.fail(ctx, h); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// fail counts a failed attempt against the webhook and disables it when it
+// failed too often in a row.
+func (d *Dispatcher) fail(ctx context.Context, h *ent.Webhook) error {
+	h, err := d.client.Webhook.
+		UpdateOne(h).
+		AddFailures(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if h.Failures < d.failureLimit || !h.Active {
+		return nil
+	}
+	log.Printf("webhooks: disabling webhook %d after %d failed attempts", h.ID, h.Failures)
+	return d.client.Webhook.
+		UpdateOne(h).
+		SetActive(false).
+		SetDisabledAt(time.Now()).
+		Exec(ctx)
+}
+
+// send posts a delivery and returns the status code and the start of the
+// body of the response. Any response but a 2xx is an error.
+func (d *Dispatcher) send(ctx context.Context, h *ent.Webhook, del *ent.WebhookDelivery) (int, string, error) {
+	body := []byte(del.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, strings.NewReader(del.Payload))
+	if err != nil {
+		return 0, "", jobs.Permanent(err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "repair-webhooks/1.0")
+	req.Header.Set("X-Webhook-Event", del.Event)
+	req.Header.Set("X-Webhook-Delivery", strconv.Itoa(del.ID))
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", Sign(h.Secret, timestamp, body))
+
+	resp, err := d.http.Do(req)
+	if errors.Is(err, ErrPrivateAddress) {
+		return 0, "", jobs.Permanent(err)
+	}
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, string(b), fmt.Errorf("webhooks: endpoint answered %s", resp.Status)
+	}
+	return resp.StatusCode, string(b), nil
+}
@@ -0,0 +1,64 @@
+import { BASE_PATH, Configuration } from './api/runtime';
+
+// The bearer token of the signed in user is kept in localStorage until it
+// expires, so that it survives reloads.
+const TOKEN_KEY = 'token';
+const EXPIRES_KEY = 'token_expires_at';
+
+export function getToken(): string | null {
+  const token = localStorage.getItem(TOKEN_KEY);
+  const expires = localStorage.getItem(EXPIRES_KEY);
+  if (!token || !expires || Date.parse(expires) <= Date.now()) {
+    logout();
+    return null;
+  }
+  return token;
+}
+
+export async function login(email: string, password: string) {
+  const res = await fetch(`${BASE_PATH}/auth/login`, {
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json' },
+    body: JSON.stringify({ email, password }),
+  });
+  const body = await res.json();
+  if (!res.ok) {
+    throw new Error(body.error);
+  }
+  localStorage.setItem(TOKEN_KEY, body.token);
+  localStorage.setItem(EXPIRES_KEY, body.expires_at);
+  return body.user;
+}
+
+export function logout() {
+  localStorage.removeItem(TOKEN_KEY);
+  localStorage.removeItem(EXPIRES_KEY);
+}
+
+// apiConfiguration returns the configuration of the generated API client,
+// which signs its requests with the token of the signed in user.
+export function apiConfiguration() {
+  const token = getToken();
+  return new Configuration({
+    headers: token ? { Authorization: `Bearer ${token}` } : {},
+  });
+}
+
+// streamTicket returns a ticket to open one event stream with. EventSource
+// cannot send the Authorization header, and the ticket, unlike the token, is
+// of no use once it is in a log.
+export async function streamTicket(): Promise<string | null> {
+  const token = getToken();
+  if (!token) {
+    return null;
+  }
+  const res = await fetch(`${BASE_PATH}/events/ticket`, {
+    method: 'POST',
+    headers: { Authorization: `Bearer ${token}` },
+  });
+  if (!res.ok) {
+    return null;
+  }
+  const { ticket } = await res.json();
+  return ticket;
+}
@@ -0,0 +1,92 @@
+import React, { useState } from 'react';
+import { useNavigate } from 'react-router-dom';
+import {
+ Content,
+ Header,
+ Page,
+ pageTheme,
+ ContentHeader,
+} from '@backstage/core';
+import { makeStyles, Theme, createStyles } from '@material-ui/core/styles';
+import TextField from '@material-ui/core/TextField';
+import Button from '@material-ui/core/Button';
+import FormControl from '@material-ui/core/FormControl';
+import { Alert } from '@material-ui/lab';
+import { login } from '../../auth';
+
+const useStyles = makeStyles((theme: Theme) =>
+ createStyles({
+   root: {
+     display: 'flex',
+     flexWrap: 'wrap',
+     justifyContent: 'center',
+   },
+   margin: {
+     margin: theme.spacing(3),
+   },
+ }),
+);
+
+export default function Login() {
+ const classes = useStyles();
+ const navigate = useNavigate();
+
+ const [credentials, setCredentials] = useState({ email: '', password: '' });
+ const [error, setError] = useState('');
+
+ const handleInputChange = (event: any) => {
+   const { id, value } = event.target;
+   setCredentials({ ...credentials, [id]: value });
+ };
+
+ const signIn = async () => {
+   try {
+     await login(credentials.email, credentials.password);
+     navigate('/');
+   } catch (e) {
+     setError(e.message);
+   }
+ };
+
+ return (
+   <Page theme={pageTheme.home}>
+     <Header title="Repair Slip" subtitle="sign in to continue."></Header>
+     <Content>
+       <ContentHeader title="Sign in">
+         {error ? <Alert severity="error">{error}</Alert> : null}
+       </ContentHeader>
+       <div className={classes.root}>
+         <form noValidate autoComplete="off">
+           <FormControl fullWidth className={classes.margin} variant="outlined">
+             <TextField
+               id="email"
+               label="Email"
+               variant="outlined"
+               type="email"
+               size="medium"
+               onChange={handleInputChange}
+             />
+           </FormControl>
+
+           <FormControl fullWidth className={classes.margin} variant="outlined">
+             <TextField
+               id="password"
+               label="Password"
+               variant="outlined"
+               type="password"
+               size="medium"
+               onChange={handleInputChange}
+             />
+           </FormControl>
+
+           <div className={classes.margin}>
+             <Button onClick={signIn} variant="contained" color="primary">
+               Sign in
+             </Button>
+           </div>
+         </form>
+       </div>
+     </Content>
+   </Page>
+ );
+}
@@ -0,0 +1 @@
+export { default } from './Login';
\ No newline at end of file
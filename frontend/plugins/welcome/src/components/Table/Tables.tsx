@@ -9,6 +9,8 @@ import TableRow from '@material-ui/core/TableRow';
 import Paper from '@material-ui/core/Paper';
 import Button from '@material-ui/core/Button';
 import { DefaultApi } from '../../api/apis';
+import { BASE_PATH } from '../../api/runtime';
+import { apiConfiguration, getToken, streamTicket } from '../../auth';
  
 const useStyles = makeStyles({
  table: {
@@ -18,12 +20,15 @@ const useStyles = makeStyles({
  
 export default function ComponentsTable() {
  const classes = useStyles();
- const api = new DefaultApi();
+ const api = new DefaultApi(apiConfiguration());
  const [users, setUsers] = useState(Array);
  const [loading, setLoading] = useState(true);
  
  useEffect(() => {
    const getUsers = async () => {
+     if (!getToken()) {
+       return;
+     }
      const res = await api.listUser({ limit: 10, offset: 0 });
      setLoading(false);
      setUsers(res);
@@ -31,9 +36,62 @@ export default function ComponentsTable() {
    getUsers();
  }, [loading]);
  
+ // Apply the changes other clients make as they are committed. EventSource
+ // cannot send headers, so it opens the stream with a ticket, which is only
+ // good once: instead of letting it reconnect with the spent one, each
+ // reconnection gets a new ticket and resumes after the last event.
+ useEffect(() => {
+   let source: EventSource | null = null;
+   let lastEventId = '';
+   let retry: any = null;
+   let closed = false;
+   const connect = async () => {
+     const ticket = await streamTicket();
+     if (!ticket || closed) {
+       return;
+     }
+     const query = new URLSearchParams({ ticket });
+     if (lastEventId) {
+       query.set('last_event_id', lastEventId);
+     }
+     source = new EventSource(`${BASE_PATH}/events?${query}`);
+     const listen = (type: string, handle: (e: any) => void) =>
+       source!.addEventListener(type, (e: any) => {
+         lastEventId = e.lastEventId || lastEventId;
+         handle(e);
+       });
+     listen('user.created', (e: any) => {
+       const { data } = JSON.parse(e.data);
+       setUsers(users => [...users, data]);
+     });
+     listen('user.updated', (e: any) => {
+       const { data } = JSON.parse(e.data);
+       setUsers(users => users.map((u: any) => (u.id === data.id ? data : u)));
+     });
+     listen('user.deleted', (e: any) => {
+       const { id } = JSON.parse(e.data);
+       setUsers(users => users.filter((u: any) => u.id !== id));
+     });
+     // Events were missed while disconnected.
+     listen('reset', () => setLoading(true));
+     source.onerror = () => {
+       source!.close();
+       retry = setTimeout(connect, 3000);
+     };
+   };
+   connect();
+   return () => {
+     closed = true;
+     clearTimeout(retry);
+     if (source) {
+       source.close();
+     }
+   };
+ }, []);
+ 
  const deleteUsers = async (id: number) => {
-   const res = await api.deleteUser({ id: id });
-   setLoading(true);
+   await api.deleteUser({ id: id });
+   setUsers(users => users.filter((u: any) => u.id !== id));
  };
  
  return (
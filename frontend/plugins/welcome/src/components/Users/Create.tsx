@@ -13,6 +13,7 @@ import Button from '@material-ui/core/Button';
 import FormControl from '@material-ui/core/FormControl';
 import { Alert } from '@material-ui/lab';
 import { DefaultApi } from '../../api/apis';
+import { apiConfiguration } from '../../auth';
  
 const useStyles = makeStyles((theme: Theme) =>
  createStyles({
@@ -41,7 +42,7 @@ const initialUserState = {
 export default function Create() {
  const classes = useStyles();
  const profile = { givenName: 'Record' };
- const api = new DefaultApi();
+ const api = new DefaultApi(apiConfiguration());
  
  const [user, setUser] = useState(initialUserState);
  const [status, setStatus] = useState(false);
@@ -28,6 +28,11 @@ const WelcomePage: FC<{}> = () => {
              Add 
            </Button>
          </Link>
+         <Link component={RouterLink} to="/login">
+           <Button style={{ marginLeft: 20 }} variant="contained">
+             Sign in
+           </Button>
+         </Link>
        </ContentHeader>
        <ComponanceTable></ComponanceTable>
      </Content>